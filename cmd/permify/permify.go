@@ -30,13 +30,40 @@ func main() {
 	ast := cmd.NewGenerateASTCommand()
 	root.AddCommand(ast)
 
+	generate := cmd.NewGenerateCommand()
+	root.AddCommand(generate)
+
+	lsp := cmd.NewLSPCommand()
+	root.AddCommand(lsp)
+
 	migrate := cmd.NewMigrateCommand()
 	root.AddCommand(migrate)
 
+	backup := cmd.NewBackupCommand()
+	root.AddCommand(backup)
+
+	bundle := cmd.NewBundleCommand()
+	root.AddCommand(bundle)
+
+	replay := cmd.NewReplayCommand()
+	root.AddCommand(replay)
+
+	accessGraph := cmd.NewAccessGraphCommand()
+	root.AddCommand(accessGraph)
+
+	offboard := cmd.NewOffboardCommand()
+	root.AddCommand(offboard)
+
+	cleanup := cmd.NewCleanupCommand()
+	root.AddCommand(cleanup)
+
+	data := cmd.NewDataCommand()
+	root.AddCommand(data)
+
 	version := cmd.NewVersionCommand()
 	root.AddCommand(version)
 
 	if err := root.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 }
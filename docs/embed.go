@@ -0,0 +1,12 @@
+// Package docs embeds the API documentation generated from the protobuf definitions, so that the server can
+// serve the specification matching its own build.
+package docs
+
+import (
+	_ "embed"
+)
+
+// OpenAPI is the OpenAPI (Swagger 2.0) specification of the HTTP API, generated by buf from the proto files.
+//
+//go:embed apidocs.swagger.json
+var OpenAPI []byte
@@ -83,7 +83,9 @@ module.exports = {
 						slug: "/api-overview/schema",
 					},
 					items: [
-						"api-overview/schema/write-schema"
+						"api-overview/schema/write-schema",
+						"api-overview/schema/apply-bundle",
+						"api-overview/schema/migrate-schema"
 					],
 				},
 				{
@@ -98,7 +100,9 @@ module.exports = {
 						"api-overview/data/write-data",
 						"api-overview/data/read-relationships",
 						"api-overview/data/read-attributes",
-						"api-overview/data/delete-data"
+						"api-overview/data/read-history",
+						"api-overview/data/delete-data",
+						"api-overview/data/run-transaction"
 					],
 				},
 				{
@@ -114,7 +118,9 @@ module.exports = {
 						"api-overview/permission/lookup-entity",
 						"api-overview/permission/lookup-subject",
 						"api-overview/permission/expand-api",
-						"api-overview/permission/subject-permission"
+						"api-overview/permission/subject-permission",
+						"api-overview/permission/paths",
+						"api-overview/permission/decision"
 					],
 				},
 				{
@@ -180,7 +186,20 @@ module.exports = {
 				"reference/contextual-tuples",
 				"reference/snap-tokens",
 				"reference/cache",
-				"reference/tracing"
+				"reference/tracing",
+				"reference/backup",
+				"reference/git-sync",
+				"reference/replay",
+				"reference/decision-logs",
+				"reference/anomaly-detection",
+				"reference/access-graph",
+				"reference/offboarding",
+				"reference/cleanup",
+				"reference/relation-migration",
+				"reference/dynamodb",
+				"reference/mongodb",
+				"reference/spanner",
+				"reference/custom-storage-engines"
 			],
 			collapsed: true
 		},
@@ -0,0 +1,299 @@
+package accessgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	// DefaultDepth is the default number of relationships walked from the subject
+	DefaultDepth = 5
+	// DefaultMaxNodes is the default maximum number of nodes of a graph
+	DefaultMaxNodes = 1000
+	// pageSize is the number of relationships read at once
+	pageSize = 100
+	// checkDepth is the depth of the checks of the permissions of the subject on the entities reached
+	checkDepth = 20
+)
+
+// Node - Entity of a graph, along with the permissions and relations the subject holds on it
+type Node struct {
+	// ID is the entity as type:id
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Depth is the number of relationships walked from the subject to the entity
+	Depth int `json:"depth"`
+	// Permissions are the permissions and relations the subject holds on the entity, none for the subject itself
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// Edge - Relationship of a graph, from its subject to its entity
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+	// SubjectRelation is the relation of the subject set of the relationship, e.g. member for team:1#member
+	SubjectRelation string `json:"subject_relation,omitempty"`
+}
+
+// Graph - Entities a subject can reach through relationships, along with its permissions on each
+type Graph struct {
+	Subject string `json:"subject"`
+	Nodes   []Node `json:"nodes"`
+	Edges   []Edge `json:"edges"`
+	// Truncated is whether the walk stopped at the depth or the maximum number of nodes before reaching every
+	// entity
+	Truncated bool `json:"truncated"`
+}
+
+// Option - Option of a walk
+type Option func(w *walker)
+
+// Depth - Walks at most depth relationships from the subject
+func Depth(depth int) Option {
+	return func(w *walker) {
+		w.depth = depth
+	}
+}
+
+// MaxNodes - Stops the walk once the graph has n nodes
+func MaxNodes(n int) Option {
+	return func(w *walker) {
+		w.maxNodes = n
+	}
+}
+
+// SnapToken - Reads the relationships and checks the permissions at the snapshot of the token
+func SnapToken(token string) Option {
+	return func(w *walker) {
+		w.snapToken = token
+	}
+}
+
+// SchemaVersion - Checks the permissions against the schema version instead of the head version of the tenant
+func SchemaVersion(version string) Option {
+	return func(w *walker) {
+		w.schemaVersion = version
+	}
+}
+
+// walker - Options and state of a walk
+type walker struct {
+	depth         int
+	maxNodes      int
+	snapToken     string
+	schemaVersion string
+
+	data       base.DataClient
+	permission base.PermissionClient
+	tenantID   string
+
+	graph *Graph
+	// nodes are the indexes of the nodes of the graph by id
+	nodes map[string]int
+	// denied are the entities reached on which the subject holds nothing
+	denied map[string]struct{}
+	edges  map[Edge]struct{}
+}
+
+// item - Entity of the graph whose relationships are yet to be walked
+type item struct {
+	entity *base.Entity
+	depth  int
+	// follow are the subject relations of the relationships followed from the entity
+	follow map[string]struct{}
+}
+
+// Walk - Walks outward from the subject through the relationships whose subjects are the entities it reaches,
+// keeping the entities on which it holds a permission or a relation. A relationship of a subject set, such as
+// doc:1#viewer@team:1#member, is only followed if the subject holds the relation or the permission of the set.
+func Walk(ctx context.Context, data base.DataClient, permission base.PermissionClient, tenantID string, subject *base.Subject, opts ...Option) (*Graph, error) {
+	w := &walker{
+		depth:      DefaultDepth,
+		maxNodes:   DefaultMaxNodes,
+		data:       data,
+		permission: permission,
+		tenantID:   tenantID,
+		graph:      &Graph{Subject: tuple.SubjectToString(subject), Nodes: []Node{}, Edges: []Edge{}},
+		nodes:      map[string]int{},
+		denied:     map[string]struct{}{},
+		edges:      map[Edge]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	root := &base.Entity{Type: subject.GetType(), Id: subject.GetId()}
+	w.add(Node{ID: tuple.EntityToString(root), Type: root.GetType()})
+	queue := []item{{
+		entity: root,
+		follow: map[string]struct{}{tuple.NormalizeRelation(subject.GetRelation()): {}},
+	}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		next, err := w.expand(ctx, subject, current)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, next...)
+	}
+
+	return w.graph, nil
+}
+
+// expand adds the entities reached from the entity of the item to the graph, returning the new ones.
+func (w *walker) expand(ctx context.Context, subject *base.Subject, current item) ([]item, error) {
+	var next []item
+	from := tuple.EntityToString(current.entity)
+	token := ""
+	for {
+		response, err := w.data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+			TenantId: w.tenantID,
+			Metadata: &base.RelationshipReadRequestMetadata{SnapToken: w.snapToken},
+			Filter: &base.TupleFilter{
+				Subject: &base.SubjectFilter{Type: current.entity.GetType(), Ids: []string{current.entity.GetId()}},
+			},
+			PageSize:        pageSize,
+			ContinuousToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range response.GetTuples() {
+			relation := tuple.NormalizeRelation(t.GetSubject().GetRelation())
+			if _, ok := current.follow[relation]; !ok {
+				continue
+			}
+
+			to := tuple.EntityToString(t.GetEntity())
+			if _, ok := w.denied[to]; ok {
+				continue
+			}
+			edge := Edge{From: from, To: to, Relation: t.GetRelation(), SubjectRelation: relation}
+			if _, ok := w.nodes[to]; ok {
+				w.link(edge)
+				continue
+			}
+			if current.depth >= w.depth || len(w.graph.Nodes) >= w.maxNodes {
+				w.graph.Truncated = true
+				return next, nil
+			}
+
+			permissions, err := w.permissions(ctx, t.GetEntity(), subject)
+			if err != nil {
+				return nil, err
+			}
+			if len(permissions) == 0 {
+				w.denied[to] = struct{}{}
+				continue
+			}
+
+			w.add(Node{ID: to, Type: t.GetEntity().GetType(), Depth: current.depth + 1, Permissions: permissions})
+			w.link(edge)
+
+			follow := map[string]struct{}{"": {}}
+			for _, permission := range permissions {
+				follow[permission] = struct{}{}
+			}
+			next = append(next, item{entity: t.GetEntity(), depth: current.depth + 1, follow: follow})
+		}
+
+		token = response.GetContinuousToken()
+		if token == "" {
+			return next, nil
+		}
+	}
+}
+
+// permissions returns the sorted permissions and relations the subject holds on the entity.
+func (w *walker) permissions(ctx context.Context, entity *base.Entity, subject *base.Subject) ([]string, error) {
+	response, err := w.permission.SubjectPermission(ctx, &base.PermissionSubjectPermissionRequest{
+		TenantId: w.tenantID,
+		Metadata: &base.PermissionSubjectPermissionRequestMetadata{
+			SchemaVersion: w.schemaVersion,
+			SnapToken:     w.snapToken,
+			Depth:         checkDepth,
+		},
+		Entity:  entity,
+		Subject: subject,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for permission, result := range response.GetResults() {
+		if result == base.CheckResult_CHECK_RESULT_ALLOWED {
+			permissions = append(permissions, permission)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions, nil
+}
+
+// add adds the node to the graph.
+func (w *walker) add(node Node) {
+	w.nodes[node.ID] = len(w.graph.Nodes)
+	w.graph.Nodes = append(w.graph.Nodes, node)
+}
+
+// link adds the edge to the graph unless it already has it.
+func (w *walker) link(edge Edge) {
+	if _, ok := w.edges[edge]; ok {
+		return
+	}
+	w.edges[edge] = struct{}{}
+	w.graph.Edges = append(w.graph.Edges, edge)
+}
+
+// WriteJSON writes the graph as indented JSON.
+func (g *Graph) WriteJSON(wr io.Writer) error {
+	encoder := json.NewEncoder(wr)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the DOT language of GraphViz, the subject in bold and each entity labelled with the
+// permissions the subject holds on it.
+func (g *Graph) WriteDOT(wr io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, "digraph access {")
+	fmt.Fprintln(&b, "  rankdir=LR;")
+	fmt.Fprintln(&b, "  node [shape=box];")
+	if g.Truncated {
+		fmt.Fprintf(&b, "  label=%q;\n", g.Subject+" (truncated)")
+	} else {
+		fmt.Fprintf(&b, "  label=%q;\n", g.Subject)
+	}
+
+	for _, node := range g.Nodes {
+		if node.Depth == 0 {
+			fmt.Fprintf(&b, "  %q [label=%q, style=bold];\n", node.ID, g.Subject)
+			continue
+		}
+		fmt.Fprintf(&b, "  %q [label=%q];\n", node.ID, node.ID+"\n"+strings.Join(node.Permissions, ", "))
+	}
+
+	for _, edge := range g.Edges {
+		label := edge.Relation
+		if edge.SubjectRelation != "" {
+			label = edge.Relation + " (as " + edge.SubjectRelation + ")"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", edge.From, edge.To, label)
+	}
+	fmt.Fprintln(&b, "}")
+
+	_, err := io.WriteString(wr, b.String())
+	return err
+}
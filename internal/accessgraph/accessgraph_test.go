@@ -0,0 +1,207 @@
+package accessgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestAccessGraph -
+func TestAccessGraph(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "access-graph-suite")
+}
+
+// data - Data client reading relationships from a fixed list, one at a time
+type data struct {
+	base.DataClient
+
+	tuples []*base.Tuple
+}
+
+func (d *data) ReadRelationships(_ context.Context, in *base.RelationshipReadRequest, _ ...grpc.CallOption) (*base.RelationshipReadResponse, error) {
+	start := 0
+	if in.GetContinuousToken() != "" {
+		start, _ = strconv.Atoi(in.GetContinuousToken())
+	}
+
+	response := &base.RelationshipReadResponse{}
+	for i := start; i < len(d.tuples); i++ {
+		t := d.tuples[i]
+		if t.GetSubject().GetType() != in.GetFilter().GetSubject().GetType() || t.GetSubject().GetId() != in.GetFilter().GetSubject().GetIds()[0] {
+			continue
+		}
+		response.Tuples = append(response.Tuples, t)
+		response.ContinuousToken = strconv.Itoa(i + 1)
+		break
+	}
+	return response, nil
+}
+
+// permission - Permission client answering with the fixed permissions of the subject by entity
+type permission struct {
+	base.PermissionClient
+
+	allowed map[string][]string
+	checked []string
+}
+
+func (p *permission) SubjectPermission(_ context.Context, in *base.PermissionSubjectPermissionRequest, _ ...grpc.CallOption) (*base.PermissionSubjectPermissionResponse, error) {
+	entity := tuple.EntityToString(in.GetEntity())
+	p.checked = append(p.checked, entity)
+
+	response := &base.PermissionSubjectPermissionResponse{Results: map[string]base.CheckResult{"owner": base.CheckResult_CHECK_RESULT_DENIED}}
+	for _, name := range p.allowed[entity] {
+		response.Results[name] = base.CheckResult_CHECK_RESULT_ALLOWED
+	}
+	return response, nil
+}
+
+var _ = Describe("access graph", func() {
+	tuples := func(strs ...string) []*base.Tuple {
+		var tuples []*base.Tuple
+		for _, str := range strs {
+			t, err := tuple.Tuple(str)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		return tuples
+	}
+	user := &base.Subject{Type: "user", Id: "1"}
+
+	It("Walks the relationships, subject sets and parents the subject reaches", func() {
+		d := &data{tuples: tuples(
+			"team:1#member@user:1",
+			"doc:1#viewer@team:1#member",
+			"doc:2#viewer@team:1#admin",
+			"doc:3#parent@folder:1",
+			"folder:1#editor@user:1",
+			"doc:4#owner@user:2",
+		)}
+		p := &permission{allowed: map[string][]string{
+			"team:1":   {"member"},
+			"doc:1":    {"view", "viewer"},
+			"folder:1": {"edit", "editor"},
+			"doc:3":    {"edit"},
+		}}
+
+		graph, err := Walk(context.Background(), d, p, "t1", user)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(graph.Subject).Should(Equal("user:1"))
+		Expect(graph.Truncated).Should(BeFalse())
+		Expect(graph.Nodes).Should(Equal([]Node{
+			{ID: "user:1", Type: "user"},
+			{ID: "team:1", Type: "team", Depth: 1, Permissions: []string{"member"}},
+			{ID: "folder:1", Type: "folder", Depth: 1, Permissions: []string{"edit", "editor"}},
+			{ID: "doc:1", Type: "doc", Depth: 2, Permissions: []string{"view", "viewer"}},
+			{ID: "doc:3", Type: "doc", Depth: 2, Permissions: []string{"edit"}},
+		}))
+		Expect(graph.Edges).Should(Equal([]Edge{
+			{From: "user:1", To: "team:1", Relation: "member"},
+			{From: "user:1", To: "folder:1", Relation: "editor"},
+			{From: "team:1", To: "doc:1", Relation: "viewer", SubjectRelation: "member"},
+			{From: "folder:1", To: "doc:3", Relation: "parent"},
+		}))
+		// The relationship of team:1#admin is not followed since the subject is not an admin of the team
+		Expect(p.checked).ShouldNot(ContainElement("doc:2"))
+	})
+
+	It("Leaves out the entities the subject holds nothing on", func() {
+		d := &data{tuples: tuples(
+			"doc:1#parent@folder:1",
+			"folder:1#editor@user:1",
+		)}
+		p := &permission{allowed: map[string][]string{"folder:1": {"editor"}}}
+
+		graph, err := Walk(context.Background(), d, p, "t1", user)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(graph.Nodes).Should(HaveLen(2))
+		Expect(graph.Edges).Should(Equal([]Edge{{From: "user:1", To: "folder:1", Relation: "editor"}}))
+	})
+
+	It("Stops at the depth and the maximum number of nodes", func() {
+		d := &data{tuples: tuples(
+			"team:1#member@user:1",
+			"team:2#member@team:1#member",
+			"team:3#member@team:2#member",
+		)}
+		p := &permission{allowed: map[string][]string{
+			"team:1": {"member"},
+			"team:2": {"member"},
+			"team:3": {"member"},
+		}}
+
+		graph, err := Walk(context.Background(), d, p, "t1", user, Depth(2))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(graph.Nodes).Should(HaveLen(3))
+		Expect(graph.Truncated).Should(BeTrue())
+
+		graph, err = Walk(context.Background(), d, p, "t1", user, MaxNodes(2))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(graph.Nodes).Should(HaveLen(2))
+		Expect(graph.Truncated).Should(BeTrue())
+
+		graph, err = Walk(context.Background(), d, p, "t1", user)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(graph.Nodes).Should(HaveLen(4))
+		Expect(graph.Truncated).Should(BeFalse())
+	})
+
+	It("Walks from a subject set", func() {
+		d := &data{tuples: tuples(
+			"doc:1#viewer@team:1#member",
+			"doc:2#viewer@team:1#admin",
+		)}
+		p := &permission{allowed: map[string][]string{"doc:1": {"viewer"}, "doc:2": {"viewer"}}}
+
+		graph, err := Walk(context.Background(), d, p, "t1", &base.Subject{Type: "team", Id: "1", Relation: "member"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(graph.Subject).Should(Equal("team:1#member"))
+		Expect(graph.Edges).Should(Equal([]Edge{{From: "team:1", To: "doc:1", Relation: "viewer", SubjectRelation: "member"}}))
+	})
+
+	It("Exports the graph as JSON and DOT", func() {
+		graph := &Graph{
+			Subject: "user:1",
+			Nodes: []Node{
+				{ID: "user:1", Type: "user"},
+				{ID: "team:1", Type: "team", Depth: 1, Permissions: []string{"member"}},
+				{ID: "doc:1", Type: "doc", Depth: 2, Permissions: []string{"view", "viewer"}},
+			},
+			Edges: []Edge{
+				{From: "user:1", To: "team:1", Relation: "member"},
+				{From: "team:1", To: "doc:1", Relation: "viewer", SubjectRelation: "member"},
+			},
+		}
+
+		var buf bytes.Buffer
+		Expect(graph.WriteJSON(&buf)).Should(Succeed())
+		decoded := &Graph{}
+		Expect(json.Unmarshal(buf.Bytes(), decoded)).Should(Succeed())
+		Expect(decoded).Should(Equal(graph))
+
+		buf.Reset()
+		Expect(graph.WriteDOT(&buf)).Should(Succeed())
+		Expect(buf.String()).Should(Equal(`digraph access {
+  rankdir=LR;
+  node [shape=box];
+  label="user:1";
+  "user:1" [label="user:1", style=bold];
+  "team:1" [label="team:1\nmember"];
+  "doc:1" [label="doc:1\nview, viewer"];
+  "user:1" -> "team:1" [label="member"];
+  "team:1" -> "doc:1" [label="viewer (as member)"];
+}
+`))
+	})
+})
@@ -0,0 +1,273 @@
+package anomaly
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// smoothing is the weight of the count of the last window in the baseline of a spike
+const smoothing = 0.3
+
+// idleWindows is the number of windows without events after which the counts of a key are forgotten
+const idleWindows = 10
+
+// spikes - Counts of events by key in fixed windows, each compared with a baseline, the moving average of the
+// counts of the previous windows
+type spikes struct {
+	window time.Duration
+	// minimum is the minimum count of a spike, so that quiet keys don't alert on a handful of events
+	minimum int
+	// factor is the minimum ratio of the count of a spike to the baseline
+	factor float64
+
+	series map[string]*series
+	// sweep is when the idle keys are forgotten next
+	sweep time.Time
+}
+
+// series - Counts of the events of a key
+type series struct {
+	// start is when the current window started
+	start time.Time
+	count int
+	// baseline is the moving average of the counts of the previous windows
+	baseline float64
+	// windows is the number of previous windows, none while the first window of the key is counted
+	windows int
+	// alerted is whether the current window was already reported as a spike
+	alerted bool
+}
+
+// newSpikes returns spikes of at least minimum events and factor times the baseline, counted in windows of the
+// duration.
+func newSpikes(window time.Duration, minimum int, factor float64) *spikes {
+	if window <= 0 {
+		window = time.Minute
+	}
+	return &spikes{window: window, minimum: minimum, factor: factor, series: map[string]*series{}}
+}
+
+// add counts n events of the key at t, and returns the count and the baseline of the window the first time the
+// window is a spike. The first window of a key only sets its baseline.
+func (s *spikes) add(key string, t time.Time, n int) (count int, baseline float64, spike bool) {
+	if t.After(s.sweep) {
+		for k, sr := range s.series {
+			if t.Sub(sr.start) > idleWindows*s.window {
+				delete(s.series, k)
+			}
+		}
+		s.sweep = t.Add(s.window)
+	}
+
+	sr, ok := s.series[key]
+	if !ok {
+		sr = &series{start: t.Truncate(s.window)}
+		s.series[key] = sr
+	}
+	if elapsed := t.Sub(sr.start); elapsed >= s.window {
+		windows := int(elapsed / s.window)
+		sr.roll(windows)
+		sr.start = sr.start.Add(time.Duration(windows) * s.window)
+	}
+
+	sr.count += n
+	if sr.alerted || sr.windows == 0 || sr.count < s.minimum || float64(sr.count) < s.factor*sr.baseline {
+		return 0, 0, false
+	}
+	sr.alerted = true
+	return sr.count, sr.baseline, true
+}
+
+// roll moves the series to the window after the given number of windows, the windows in between having no events.
+func (sr *series) roll(windows int) {
+	if sr.windows == 0 {
+		sr.baseline = float64(sr.count)
+	} else {
+		sr.baseline += smoothing * (float64(sr.count) - sr.baseline)
+	}
+	for i := 1; i < windows; i++ {
+		sr.baseline *= 1 - smoothing
+	}
+	sr.windows += windows
+	sr.count = 0
+	sr.alerted = false
+}
+
+// GrantSpike - Analyzer flagging tenants whose relationships are written much faster than usual, such as a
+// compromised integration granting access in bulk
+type GrantSpike struct {
+	spikes *spikes
+}
+
+// NewGrantSpike - Creates new GrantSpike flagging windows of at least minimum relationships written and factor times
+// the usual number of the tenant
+func NewGrantSpike(window time.Duration, minimum int, factor float64) *GrantSpike {
+	return &GrantSpike{spikes: newSpikes(window, minimum, factor)}
+}
+
+// Name returns grant_spike
+func (a *GrantSpike) Name() string {
+	return "grant_spike"
+}
+
+// Analyze counts the relationships created by the changes
+func (a *GrantSpike) Analyze(event Event) []Alert {
+	n := 0
+	for _, change := range event.Changes.GetDataChanges() {
+		if change.GetOperation() == base.DataChange_OPERATION_CREATE && change.GetTuple() != nil {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+
+	count, baseline, ok := a.spikes.add(event.TenantID, event.Time, n)
+	if !ok {
+		return nil
+	}
+	return []Alert{{
+		Severity: SeverityMedium,
+		Summary:  fmt.Sprintf("%d relationships were written in %s, %.1f are usually", count, a.spikes.window, baseline),
+		Details: map[string]string{
+			"count":    strconv.Itoa(count),
+			"baseline": strconv.FormatFloat(baseline, 'f', 1, 64),
+			"window":   a.spikes.window.String(),
+		},
+	}}
+}
+
+// AccessSpike - Analyzer flagging subjects allowed many more checks than usual, such as a compromised account
+// crawling the resources it can access
+type AccessSpike struct {
+	spikes *spikes
+}
+
+// NewAccessSpike - Creates new AccessSpike flagging windows of at least minimum checks allowed to a subject and factor
+// times its usual number
+func NewAccessSpike(window time.Duration, minimum int, factor float64) *AccessSpike {
+	return &AccessSpike{spikes: newSpikes(window, minimum, factor)}
+}
+
+// Name returns access_spike
+func (a *AccessSpike) Name() string {
+	return "access_spike"
+}
+
+// Analyze counts the allowed checks by subject
+func (a *AccessSpike) Analyze(event Event) []Alert {
+	if event.Decision == nil || !event.Decision.Allowed {
+		return nil
+	}
+
+	subject := tuple.SubjectToString(&base.Subject{
+		Type:     event.Decision.SubjectType,
+		Id:       event.Decision.SubjectID,
+		Relation: event.Decision.SubjectRelation,
+	})
+	count, baseline, ok := a.spikes.add(event.TenantID+"/"+subject, event.Time, 1)
+	if !ok {
+		return nil
+	}
+	return []Alert{{
+		Severity: SeverityMedium,
+		Summary:  fmt.Sprintf("%s was allowed %d checks in %s, %.1f are usually", subject, count, a.spikes.window, baseline),
+		Details: map[string]string{
+			"subject":  subject,
+			"count":    strconv.Itoa(count),
+			"baseline": strconv.FormatFloat(baseline, 'f', 1, 64),
+			"window":   a.spikes.window.String(),
+		},
+	}}
+}
+
+// maxPaths is the maximum number of subject sets holding privileged relations remembered by tenant
+const maxPaths = 10_000
+
+// Escalation - Analyzer flagging the relationships granting privileged relations, such as organization#admin,
+// directly or through the subject sets already granted one. Granting a privileged relation to a subject set, e.g.
+// organization:1#admin@team:5#member, opens an escalation path: the subjects later added to the set, or to the
+// sets added to it, are flagged as they gain the privilege through the path.
+type Escalation struct {
+	// privileged are the privileged relations, as entity type#relation
+	privileged map[string]struct{}
+	// paths are the subject sets holding privileged relations by tenant, each with the path from the set to the
+	// privileged relation
+	paths map[string]map[string][]string
+}
+
+// NewEscalation - Creates new Escalation flagging the grants of the privileged relations, given as entity
+// type#relation
+func NewEscalation(privileged []string) *Escalation {
+	relations := make(map[string]struct{}, len(privileged))
+	for _, relation := range privileged {
+		relations[relation] = struct{}{}
+	}
+	return &Escalation{privileged: relations, paths: map[string]map[string][]string{}}
+}
+
+// Name returns privilege_escalation
+func (a *Escalation) Name() string {
+	return "privilege_escalation"
+}
+
+// Analyze flags the relationships created by the changes granting privileged relations
+func (a *Escalation) Analyze(event Event) (alerts []Alert) {
+	for _, change := range event.Changes.GetDataChanges() {
+		t := change.GetTuple()
+		if change.GetOperation() != base.DataChange_OPERATION_CREATE || t == nil {
+			continue
+		}
+
+		var path []string
+		granted := tuple.EntityAndRelationToString(t.GetEntity(), t.GetRelation())
+		if _, ok := a.privileged[t.GetEntity().GetType()+"#"+t.GetRelation()]; ok {
+			path = []string{granted}
+		} else if through, ok := a.paths[event.TenantID][granted]; ok {
+			path = append([]string{granted}, through...)
+		} else {
+			continue
+		}
+
+		subject := tuple.SubjectToString(t.GetSubject())
+		privilege := path[len(path)-1]
+
+		alert := Alert{
+			Severity: SeverityHigh,
+			Details: map[string]string{
+				"tuple":     tuple.ToString(t),
+				"privilege": privilege,
+				"path":      strings.Join(path, " -> "),
+			},
+		}
+		switch {
+		case !tuple.IsDirectSubject(t.GetSubject()):
+			alert.Summary = fmt.Sprintf("members of %s were granted %s", subject, privilege)
+			a.remember(event.TenantID, subject, path)
+		case len(path) == 1:
+			alert.Summary = fmt.Sprintf("%s was granted %s", subject, privilege)
+		default:
+			alert.Summary = fmt.Sprintf("%s gained %s through %s", subject, privilege, granted)
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// remember records that the subject set holds a privileged relation through the path.
+func (a *Escalation) remember(tenantID, set string, path []string) {
+	paths, ok := a.paths[tenantID]
+	if !ok {
+		paths = map[string][]string{}
+		a.paths[tenantID] = paths
+	}
+	if _, ok := paths[set]; ok || len(paths) >= maxPaths {
+		return
+	}
+	paths[set] = path
+}
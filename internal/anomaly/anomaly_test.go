@@ -0,0 +1,210 @@
+package anomaly
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/decisionlog"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestAnomaly -
+func TestAnomaly(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "anomaly-suite")
+}
+
+// memoryNotifier - Notifier keeping the alerts sent to it
+type memoryNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (n *memoryNotifier) Notify(_ context.Context, alert Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func (n *memoryNotifier) sent() (alerts []Alert) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append(alerts, n.alerts...)
+}
+
+// writes returns the event of the changes creating the tuples at t.
+func writes(t time.Time, tuples ...string) Event {
+	var tups []*base.Tuple
+	for _, s := range tuples {
+		tup, err := tuple.Tuple(s)
+		Expect(err).ShouldNot(HaveOccurred())
+		tups = append(tups, tup)
+	}
+	return Event{Time: t, TenantID: "t1", Changes: created("", tups, nil)}
+}
+
+var _ = Describe("anomaly", func() {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	Context("GrantSpike", func() {
+		It("Case 1 - Flags windows with many more relationships written than usual, once", func() {
+			a := NewGrantSpike(time.Minute, 5, 3)
+
+			// The first window sets the baseline
+			Expect(a.Analyze(writes(start, "doc:1#viewer@user:1", "doc:2#viewer@user:1"))).Should(BeEmpty())
+
+			// Usual number of relationships
+			Expect(a.Analyze(writes(start.Add(time.Minute), "doc:1#viewer@user:2", "doc:2#viewer@user:2"))).Should(BeEmpty())
+
+			// Spike
+			next := start.Add(2 * time.Minute)
+			Expect(a.Analyze(writes(next, "doc:1#viewer@user:3", "doc:2#viewer@user:3", "doc:3#viewer@user:3"))).Should(BeEmpty())
+			alerts := a.Analyze(writes(next, "doc:4#viewer@user:3", "doc:5#viewer@user:3", "doc:6#viewer@user:3"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Details).Should(HaveKeyWithValue("count", "6"))
+			Expect(alerts[0].Details).Should(HaveKeyWithValue("baseline", "2.0"))
+			Expect(a.Analyze(writes(next, "doc:7#viewer@user:3"))).Should(BeEmpty())
+		})
+
+		It("Case 2 - Decays the baseline over windows without relationships written", func() {
+			a := NewGrantSpike(time.Minute, 1, 2)
+
+			Expect(a.Analyze(writes(start, "doc:1#viewer@user:1", "doc:2#viewer@user:1", "doc:3#viewer@user:1", "doc:4#viewer@user:1"))).Should(BeEmpty())
+			// 4 windows later the baseline is 4 * 0.7^3
+			alerts := a.Analyze(writes(start.Add(4*time.Minute), "doc:1#viewer@user:2", "doc:2#viewer@user:2", "doc:3#viewer@user:2"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Details).Should(HaveKeyWithValue("baseline", "1.4"))
+		})
+	})
+
+	Context("AccessSpike", func() {
+		It("Case 1 - Flags subjects allowed many more checks than usual", func() {
+			a := NewAccessSpike(time.Minute, 2, 2)
+			decision := func(t time.Time, subject string, allowed bool) Event {
+				return Event{Time: t, TenantID: "t1", Decision: &decisionlog.Decision{SubjectType: "user", SubjectID: subject, Allowed: allowed}}
+			}
+
+			Expect(a.Analyze(decision(start, "1", true))).Should(BeEmpty())
+			Expect(a.Analyze(decision(start, "2", true))).Should(BeEmpty())
+
+			next := start.Add(time.Minute)
+			Expect(a.Analyze(decision(next, "1", true))).Should(BeEmpty())
+			Expect(a.Analyze(decision(next, "1", false))).Should(BeEmpty())
+			alerts := a.Analyze(decision(next, "1", true))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Summary).Should(Equal("user:1 was allowed 2 checks in 1m0s, 1.0 are usually"))
+			Expect(a.Analyze(decision(next, "2", true))).Should(BeEmpty())
+		})
+	})
+
+	Context("Escalation", func() {
+		It("Case 1 - Flags grants of privileged relations, directly or through subject sets", func() {
+			a := NewEscalation([]string{"organization#admin"})
+
+			Expect(a.Analyze(writes(start, "organization:1#member@user:1"))).Should(BeEmpty())
+
+			alerts := a.Analyze(writes(start, "organization:1#admin@user:2"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Severity).Should(Equal(SeverityHigh))
+			Expect(alerts[0].Summary).Should(Equal("user:2 was granted organization:1#admin"))
+
+			alerts = a.Analyze(writes(start, "organization:1#admin@team:5#member"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Summary).Should(Equal("members of team:5#member were granted organization:1#admin"))
+
+			alerts = a.Analyze(writes(start, "team:5#member@team:9#member", "team:6#member@user:4"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Summary).Should(Equal("members of team:9#member were granted organization:1#admin"))
+
+			alerts = a.Analyze(writes(start, "team:9#member@user:3"))
+			Expect(alerts).Should(HaveLen(1))
+			Expect(alerts[0].Summary).Should(Equal("user:3 gained organization:1#admin through team:9#member"))
+			Expect(alerts[0].Details).Should(HaveKeyWithValue("path", "team:9#member -> team:5#member -> organization:1#admin"))
+
+			// Paths are kept by tenant
+			other := writes(start, "team:9#member@user:3")
+			other.TenantID = "t2"
+			Expect(a.Analyze(other)).Should(BeEmpty())
+		})
+	})
+
+	Context("Detector", func() {
+		It("Case 1 - Feeds the checks and writes of the server to the analyzers and sends their alerts", func() {
+			notifier := &memoryNotifier{}
+			d := NewDetector(notifier, 10, NewEscalation([]string{"organization#admin"}), NewAccessSpike(time.Hour, 1, 1))
+
+			intercept := func(method string, req, resp interface{}) {
+				_, err := d.UnaryServerInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+					return resp, nil
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			admin, err := tuple.Tuple("organization:1#admin@user:2")
+			Expect(err).ShouldNot(HaveOccurred())
+			intercept(base.Data_WriteRelationships_FullMethodName, &base.RelationshipWriteRequest{TenantId: "t1", Tuples: []*base.Tuple{admin}}, &base.RelationshipWriteResponse{SnapToken: "snap"})
+			intercept(base.Permission_Check_FullMethodName, &base.PermissionCheckRequest{TenantId: "t1", Entity: &base.Entity{Type: "doc", Id: "1"}, Permission: "view", Subject: &base.Subject{Type: "user", Id: "2"}}, &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() { _ = d.Start(ctx) }()
+
+			Eventually(notifier.sent).Should(HaveLen(1))
+			alert := notifier.sent()[0]
+			Expect(alert.Analyzer).Should(Equal("privilege_escalation"))
+			Expect(alert.TenantID).Should(Equal("t1"))
+			Expect(alert.Time.IsZero()).Should(BeFalse())
+		})
+
+		It("Case 2 - Drops the events that don't fit the queue", func() {
+			d := NewDetector(nil, 2)
+			for i := 0; i < 5; i++ {
+				d.Observe(Event{TenantID: "t1"})
+			}
+			Expect(d.dropped.Load()).Should(Equal(int64(3)))
+		})
+	})
+
+	Context("WebhookNotifier", func() {
+		It("Case 1 - Posts signed alerts", func() {
+			var body []byte
+			var signature string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ = io.ReadAll(r.Body)
+				signature = r.Header.Get(SignatureHeader)
+			}))
+			defer server.Close()
+
+			n := NewWebhookNotifier(server.URL, "secret", time.Second)
+			Expect(n.Notify(context.Background(), Alert{Analyzer: "grant_spike", TenantID: "t1", Severity: SeverityMedium, Summary: "spike"})).Should(Succeed())
+
+			var alert Alert
+			Expect(json.Unmarshal(body, &alert)).Should(Succeed())
+			Expect(alert.Analyzer).Should(Equal("grant_spike"))
+			Expect(signature).Should(Equal(Sign([]byte("secret"), body)))
+		})
+
+		It("Case 2 - Fails when the webhook doesn't accept the alert", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get(SignatureHeader)).Should(BeEmpty())
+				http.Error(w, "unknown hook", http.StatusNotFound)
+			}))
+			defer server.Close()
+
+			n := NewWebhookNotifier(server.URL, "", time.Second)
+			Expect(n.Notify(context.Background(), Alert{})).Should(MatchError(ContainSubstring("unknown hook")))
+		})
+	})
+})
@@ -0,0 +1,179 @@
+package anomaly
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/decisionlog"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Event - Decision of a permission check or changes written, in the order the server served them
+type Event struct {
+	Time     time.Time
+	TenantID string
+	// Decision is the decision of the check, nil for changes
+	Decision *decisionlog.Decision
+	// Changes are the changes written, nil for decisions
+	Changes *base.DataChanges
+}
+
+// Alert - Unusual pattern an analyzer flagged
+type Alert struct {
+	Time     time.Time `json:"time"`
+	Analyzer string    `json:"analyzer"`
+	TenantID string    `json:"tenant_id"`
+	Severity Severity  `json:"severity"`
+	Summary  string    `json:"summary"`
+	// Details are the facts the alert is based on, such as the tuples or counts involved
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Severity - Severity of an alert
+type Severity string
+
+const (
+	SeverityLow    Severity = "low"
+	SeverityMedium Severity = "medium"
+	SeverityHigh   Severity = "high"
+)
+
+// Analyzer - Flags unusual patterns in the decisions and changes of the server. Analyzers are fed the events one
+// at a time, so they don't need to be safe for concurrent use, and should return quickly.
+type Analyzer interface {
+	// Name is the name of the analyzer the alerts are labeled with
+	Name() string
+	// Analyze returns the alerts the event raises, if any
+	Analyze(event Event) []Alert
+}
+
+// Detector - Feeds the decisions and changes of the server to analyzers, logs the alerts they raise and sends them
+// to a notifier. Events are queued and analyzed in the background, so detection never slows requests down: events are
+// dropped while the queue is full.
+type Detector struct {
+	analyzers []Analyzer
+	notifier  Notifier
+	events    chan Event
+	// dropped is the number of events dropped since it was last reported
+	dropped atomic.Int64
+}
+
+// NewDetector - Creates new Detector queueing up to queueSize events for the analyzers and sending their alerts to
+// the notifier, if it isn't nil
+func NewDetector(notifier Notifier, queueSize int, analyzers ...Analyzer) *Detector {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	return &Detector{
+		analyzers: analyzers,
+		notifier:  notifier,
+		events:    make(chan Event, queueSize),
+	}
+}
+
+// Observe queues the event to be analyzed, dropping it if the queue is full
+func (d *Detector) Observe(event Event) {
+	select {
+	case d.events <- event:
+	default:
+		d.dropped.Add(1)
+	}
+}
+
+// UnaryServerInterceptor - Returns an interceptor feeding the decisions of the successful checks and the changes
+// of the successful writes of the server to the detector
+func (d *Detector) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		switch request := req.(type) {
+		case *base.PermissionCheckRequest:
+			if response, ok := resp.(*base.PermissionCheckResponse); ok {
+				decision := decisionlog.NewDecision(start, time.Since(start), request, response, 1)
+				d.Observe(Event{Time: start, TenantID: request.GetTenantId(), Decision: &decision})
+			}
+		case *base.DataWriteRequest:
+			response, _ := resp.(*base.DataWriteResponse)
+			d.Observe(Event{Time: start, TenantID: request.GetTenantId(), Changes: created(response.GetSnapToken(), request.GetTuples(), request.GetAttributes())})
+		case *base.RelationshipWriteRequest:
+			response, _ := resp.(*base.RelationshipWriteResponse)
+			d.Observe(Event{Time: start, TenantID: request.GetTenantId(), Changes: created(response.GetSnapToken(), request.GetTuples(), nil)})
+		case *base.DataTransactionRequest:
+			response, _ := resp.(*base.DataTransactionResponse)
+			var tuples []*base.Tuple
+			var attributes []*base.Attribute
+			for _, operation := range request.GetOperations() {
+				tuples = append(tuples, operation.GetWrite().GetTuples()...)
+				attributes = append(attributes, operation.GetWrite().GetAttributes()...)
+			}
+			d.Observe(Event{Time: start, TenantID: request.GetTenantId(), Changes: created(response.GetSnapToken(), tuples, attributes)})
+		}
+		return resp, nil
+	}
+}
+
+// created returns the changes creating the tuples and attributes at the snapshot.
+func created(snap string, tuples []*base.Tuple, attributes []*base.Attribute) *base.DataChanges {
+	changes := &base.DataChanges{SnapToken: snap}
+	for _, t := range tuples {
+		changes.DataChanges = append(changes.DataChanges, &base.DataChange{
+			Operation: base.DataChange_OPERATION_CREATE,
+			Type:      &base.DataChange_Tuple{Tuple: t},
+		})
+	}
+	for _, a := range attributes {
+		changes.DataChanges = append(changes.DataChanges, &base.DataChange{
+			Operation: base.DataChange_OPERATION_CREATE,
+			Type:      &base.DataChange_Attribute{Attribute: a},
+		})
+	}
+	return changes
+}
+
+// Start analyzes the queued events and sends the alerts they raise until the context is done.
+func (d *Detector) Start(ctx context.Context) error {
+	for {
+		select {
+		case event := <-d.events:
+			if dropped := d.dropped.Swap(0); dropped > 0 {
+				slog.Warn("anomaly detection queue is full, events were dropped", slog.Int64("dropped", dropped))
+			}
+			for _, alert := range d.analyze(event) {
+				slog.Warn(alert.Summary, slog.String("analyzer", alert.Analyzer), slog.String("tenant_id", alert.TenantID), slog.String("severity", string(alert.Severity)))
+				if d.notifier == nil {
+					continue
+				}
+				if err := d.notifier.Notify(ctx, alert); err != nil {
+					slog.Error("failed to send anomaly alert", slog.String("analyzer", alert.Analyzer), slog.Any("error", err))
+				}
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// analyze returns the alerts the analyzers raise for the event, labeled with their names.
+func (d *Detector) analyze(event Event) (alerts []Alert) {
+	for _, analyzer := range d.analyzers {
+		for _, alert := range analyzer.Analyze(event) {
+			alert.Analyzer = analyzer.Name()
+			if alert.Time.IsZero() {
+				alert.Time = event.Time
+			}
+			if alert.TenantID == "" {
+				alert.TenantID = event.TenantID
+			}
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
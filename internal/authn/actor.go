@@ -0,0 +1,26 @@
+package authn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/Permify/permify/pkg/authn"
+)
+
+// ContextWithActor returns a copy of ctx carrying the given actor.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return authn.ContextWithActor(ctx, actor)
+}
+
+// ActorFromContext returns the actor stored in ctx, or an empty string
+// when the request was not authenticated.
+func ActorFromContext(ctx context.Context) string {
+	return authn.ActorFromContext(ctx)
+}
+
+// PresharedActor derives a stable, non-secret actor identifier from a preshared key.
+func PresharedActor(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "preshared:" + hex.EncodeToString(sum[:])[:12]
+}
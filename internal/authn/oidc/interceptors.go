@@ -4,6 +4,11 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/zitadel/oidc/pkg/oidc"
+
+	"github.com/Permify/permify/internal/authn"
 )
 
 // UnaryServerInterceptor returns a gRPC unary server interceptor that
@@ -17,7 +22,7 @@ func UnaryServerInterceptor(t Authenticator) grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 		// If authentication succeeds, proceed with the request.
-		return handler(ctx, req)
+		return handler(withSubject(ctx), req)
 	}
 }
 
@@ -26,7 +31,7 @@ func UnaryServerInterceptor(t Authenticator) grpc.UnaryServerInterceptor {
 func StreamServerInterceptor(t Authenticator) grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		// Wrap the stream with the authenticator.
-		wrapper := &authnWrapper{ServerStream: stream, authenticator: t}
+		wrapper := &authnWrapper{ServerStream: stream, authenticator: t, ctx: withSubject(stream.Context())}
 		return handler(srv, wrapper)
 	}
 }
@@ -36,6 +41,12 @@ func StreamServerInterceptor(t Authenticator) grpc.StreamServerInterceptor {
 type authnWrapper struct {
 	grpc.ServerStream
 	authenticator Authenticator
+	ctx           context.Context
+}
+
+// Context returns the stream context carrying the authenticated subject.
+func (s *authnWrapper) Context() context.Context {
+	return s.ctx
 }
 
 // RecvMsg intercepts the RecvMsg call of the wrapped grpc.ServerStream
@@ -54,3 +65,19 @@ func (s *authnWrapper) RecvMsg(req interface{}) error {
 	// If authentication succeeds, proceed with the message.
 	return nil
 }
+
+// withSubject attaches the subject of the bearer token in ctx as the request actor.
+// The token must already have been verified; when no subject can be read, ctx is returned unchanged.
+func withSubject(ctx context.Context) context.Context {
+	rawToken, err := grpcAuth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return ctx
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if _, err = oidc.ParseToken(rawToken, &claims); err != nil || claims.Subject == "" {
+		return ctx
+	}
+	return authn.ContextWithActor(ctx, claims.Subject)
+}
@@ -0,0 +1,23 @@
+package oidc
+
+import (
+	"context"
+)
+
+// Provider - Authentication provider that verifies OIDC tokens and attaches their subject as the actor
+type Provider struct {
+	authenticator Authenticator
+}
+
+// NewProvider - Creates a new authentication provider from an oidc authenticator
+func NewProvider(authenticator Authenticator) *Provider {
+	return &Provider{authenticator: authenticator}
+}
+
+// Authenticate - Verifies the token of the request and returns the context carrying its subject
+func (p *Provider) Authenticate(ctx context.Context) (context.Context, error) {
+	if err := p.authenticator.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return withSubject(ctx), nil
+}
@@ -0,0 +1,62 @@
+package peer
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/authn"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Header - Metadata key carrying the shared secret of the cluster on the requests its nodes dispatch to each other
+const Header = "x-permify-peer-secret"
+
+// Actor - Actor of the requests dispatched by the peers
+const Actor = "peer"
+
+// Provider - Authentication provider accepting the requests carrying the shared secret of the cluster
+type Provider struct {
+	secret []byte
+}
+
+// NewProvider - Creates a new authentication provider for the shared secret
+func NewProvider(secret string) *Provider {
+	return &Provider{secret: []byte(secret)}
+}
+
+// Authenticate - Checks the shared secret of the request and returns the context carrying the peer actor
+func (p *Provider) Authenticate(ctx context.Context) (context.Context, error) {
+	values := metadata.ValueFromIncomingContext(ctx, Header)
+	if len(values) != 1 {
+		return nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String())
+	}
+	if subtle.ConstantTimeCompare([]byte(values[0]), p.secret) != 1 {
+		return nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_INVALID_KEY.String())
+	}
+	return authn.ContextWithActor(ctx, Actor), nil
+}
+
+// Credentials - Per-RPC credentials attaching the shared secret of the cluster to the requests dispatched to the peers
+type Credentials struct {
+	secret     string
+	requireTLS bool
+}
+
+// NewCredentials - Creates new per-RPC credentials for the shared secret, sent only over TLS if requireTLS is set
+func NewCredentials(secret string, requireTLS bool) *Credentials {
+	return &Credentials{secret: secret, requireTLS: requireTLS}
+}
+
+// GetRequestMetadata - Returns the metadata carrying the shared secret
+func (c *Credentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{Header: c.secret}, nil
+}
+
+// RequireTransportSecurity - Returns whether the shared secret is sent only over TLS, the dispatches failing otherwise
+func (c *Credentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,51 @@
+package peer
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/authn"
+)
+
+func TestPeerAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authentication peer suite")
+}
+
+var _ = Describe("Peer", func() {
+	var provider *Provider
+
+	BeforeEach(func() {
+		provider = NewProvider("secret")
+	})
+
+	Describe("Authenticate", func() {
+		It("should authenticate the requests the credentials of the peers are attached to", func() {
+			md, err := NewCredentials("secret", true).GetRequestMetadata(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+
+			ctx, err := provider.Authenticate(metadata.NewIncomingContext(context.Background(), metadata.New(md)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(authn.ActorFromContext(ctx)).Should(Equal(Actor))
+		})
+
+		It("should reject a wrong secret", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, "wrong"))
+			_, err := provider.Authenticate(ctx)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject the requests of the clients, whatever their authorization", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+			_, err := provider.Authenticate(ctx)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package preshared
 
 import (
 	"context"
+	"sync"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,21 +21,34 @@ type KeyAuthenticator interface {
 
 // KeyAuthn - Authentication Keys Structure
 type KeyAuthn struct {
+	mu   sync.RWMutex
 	keys map[string]struct{}
 }
 
 // NewKeyAuthn - Create New Authenticated Keys
 func NewKeyAuthn(_ context.Context, cfg config.Preshared) (*KeyAuthn, error) {
-	if len(cfg.Keys) < 1 {
-		return nil, errors.New("pre shared key authn must have at least one key")
+	a := &KeyAuthn{}
+	if err := a.SetKeys(cfg.Keys); err != nil {
+		return nil, err
 	}
-	mapKeys := make(map[string]struct{})
-	for _, k := range cfg.Keys {
+	return a, nil
+}
+
+// SetKeys - Replaces the keys accepted by the authenticator, e.g. when the configuration is reloaded. The requests
+// authenticated with the keys it no longer holds are rejected from then on.
+func (a *KeyAuthn) SetKeys(keys []string) error {
+	if len(keys) < 1 {
+		return errors.New("pre shared key authn must have at least one key")
+	}
+	mapKeys := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
 		mapKeys[k] = struct{}{}
 	}
-	return &KeyAuthn{
-		keys: mapKeys,
-	}, nil
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys = mapKeys
+	return nil
 }
 
 // Authenticate - Checking whether any API request contain keys
@@ -43,7 +57,10 @@ func (a *KeyAuthn) Authenticate(ctx context.Context) error {
 	if err != nil {
 		return errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
 	}
-	if _, found := a.keys[key]; found {
+	a.mu.RLock()
+	_, found := a.keys[key]
+	a.mu.RUnlock()
+	if found {
 		return nil
 	}
 	return status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_INVALID_KEY.String())
@@ -72,4 +72,24 @@ var _ = Describe("KeyAuthn", func() {
 			})
 		})
 	})
+
+	Describe("SetKeys", func() {
+		It("should accept the new keys only", func() {
+			Expect(authenticator.SetKeys([]string{"key3"})).To(Succeed())
+
+			md := metadata.New(map[string]string{"authorization": "Bearer key3"})
+			Expect(authenticator.Authenticate(metadata.NewIncomingContext(context.Background(), md))).To(Succeed())
+
+			md = metadata.New(map[string]string{"authorization": "Bearer key1"})
+			err := authenticator.Authenticate(metadata.NewIncomingContext(context.Background(), md))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should keep the keys when the new ones are empty", func() {
+			Expect(authenticator.SetKeys(nil)).ToNot(Succeed())
+
+			md := metadata.New(map[string]string{"authorization": "Bearer key1"})
+			Expect(authenticator.Authenticate(metadata.NewIncomingContext(context.Background(), md))).To(Succeed())
+		})
+	})
 })
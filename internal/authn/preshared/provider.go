@@ -0,0 +1,31 @@
+package preshared
+
+import (
+	"context"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+
+	"github.com/Permify/permify/internal/authn"
+)
+
+// Provider - Authentication provider that checks preshared keys and attaches the actor derived from the key
+type Provider struct {
+	authenticator KeyAuthenticator
+}
+
+// NewProvider - Creates a new authentication provider from a key authenticator
+func NewProvider(authenticator KeyAuthenticator) *Provider {
+	return &Provider{authenticator: authenticator}
+}
+
+// Authenticate - Checks the key of the request and returns the context carrying its actor
+func (p *Provider) Authenticate(ctx context.Context) (context.Context, error) {
+	if err := p.authenticator.Authenticate(ctx); err != nil {
+		return nil, err
+	}
+	key, err := grpcAuth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return ctx, nil
+	}
+	return authn.ContextWithActor(ctx, authn.PresharedActor(key)), nil
+}
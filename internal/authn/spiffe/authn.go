@@ -0,0 +1,174 @@
+package spiffe
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Authn - SPIFFE workload identity authenticator, accepting X.509-SVIDs presented during the TLS handshake and
+// JWT-SVIDs sent as bearer tokens
+type Authn struct {
+	trustDomain string
+	audiences   []string
+	matcher     *Matcher
+	bundle      *bundleSource
+}
+
+// NewSpiffeAuthn - Create new SPIFFE authenticator
+func NewSpiffeAuthn(_ context.Context, cfg config.Spiffe) (*Authn, error) {
+	if err := validateTrustDomain(cfg.TrustDomain); err != nil {
+		return nil, err
+	}
+	if cfg.BundlePath == "" {
+		return nil, errors.New("spiffe authn must have a bundle path")
+	}
+	matcher, err := NewMatcher(cfg.AllowedIDs)
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := newBundleSource(cfg.BundlePath)
+	if err != nil {
+		return nil, err
+	}
+	return &Authn{
+		trustDomain: cfg.TrustDomain,
+		audiences:   cfg.Audiences,
+		matcher:     matcher,
+		bundle:      bundle,
+	}, nil
+}
+
+// Authenticate - Verifies the SVID of the caller and returns its SPIFFE ID. The X.509-SVID of the connection is
+// preferred over a JWT-SVID.
+func (a *Authn) Authenticate(ctx context.Context) (string, error) {
+	bundle, err := a.bundle.Get()
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	var id string
+	if chain := peerCertificates(ctx); len(chain) > 0 {
+		id, err = a.verifyX509SVID(bundle, chain)
+	} else {
+		rawToken, mdErr := grpcAuth.AuthFromMD(ctx, "Bearer")
+		if mdErr != nil {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
+		}
+		id, err = a.verifyJWTSVID(bundle, rawToken)
+	}
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !a.matcher.Match(id) {
+		return "", status.Error(codes.PermissionDenied, fmt.Sprintf("spiffe id %s is not allowed", id))
+	}
+	return id, nil
+}
+
+// verifyX509SVID - Verifies the certificate chain against the roots of the bundle and returns the SPIFFE ID of
+// its leaf
+func (a *Authn) verifyX509SVID(bundle *Bundle, chain []*x509.Certificate) (string, error) {
+	leaf := chain[0]
+	if leaf.IsCA {
+		return "", errors.New("x509-svid must not be a ca certificate")
+	}
+	if len(leaf.URIs) != 1 {
+		return "", errors.New("x509-svid must have exactly one uri san")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         bundle.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return "", err
+	}
+
+	id, err := ParseID(leaf.URIs[0].String(), a.trustDomain)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// verifyJWTSVID - Verifies the token against the keys of the bundle and the accepted audiences and returns its
+// subject
+func (a *Authn) verifyJWTSVID(bundle *Bundle, rawToken string) (string, error) {
+	if len(a.audiences) == 0 {
+		return "", errors.New("jwt-svids are not accepted without audiences")
+	}
+
+	token, err := jwt.ParseSigned(rawToken)
+	if err != nil {
+		return "", err
+	}
+	if len(token.Headers) != 1 {
+		return "", errors.New("jwt-svid must have exactly one signature")
+	}
+	key, ok := bundle.jwtKeys[token.Headers[0].KeyID]
+	if !ok {
+		return "", fmt.Errorf("jwt-svid key %s is not in the bundle", token.Headers[0].KeyID)
+	}
+
+	var claims jwt.Claims
+	if err = token.Claims(key, &claims); err != nil {
+		return "", err
+	}
+	if claims.Expiry == nil {
+		return "", errors.New("jwt-svid must have an expiry")
+	}
+	if err = claims.ValidateWithLeeway(jwt.Expected{Time: time.Now()}, 0); err != nil {
+		return "", err
+	}
+	if !a.acceptsAudience(claims.Audience) {
+		return "", errors.New("jwt-svid audience is not accepted")
+	}
+
+	id, err := ParseID(claims.Subject, a.trustDomain)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// acceptsAudience - Reports whether the audience contains one of the accepted audiences
+func (a *Authn) acceptsAudience(audience jwt.Audience) bool {
+	for _, aud := range a.audiences {
+		if audience.Contains(aud) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerCertificates - Returns the certificates presented by the peer of the connection, if any
+func peerCertificates(ctx context.Context) []*x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	return info.State.PeerCertificates
+}
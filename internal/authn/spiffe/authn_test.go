@@ -0,0 +1,273 @@
+package spiffe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestSpiffeAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authentication spiffe suite")
+}
+
+var _ = Describe("Matcher", func() {
+	It("should match ids against the patterns", func() {
+		matcher, err := NewMatcher([]string{"spiffe://example.org/ns/prod/*", "spiffe://example.org/admin"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(matcher.Match("spiffe://example.org/ns/prod/api")).To(BeTrue())
+		Expect(matcher.Match("spiffe://example.org/admin")).To(BeTrue())
+		Expect(matcher.Match("spiffe://example.org/ns/prod/api/v2")).To(BeFalse())
+		Expect(matcher.Match("spiffe://example.org/ns/dev/api")).To(BeFalse())
+	})
+
+	It("should allow every id without patterns", func() {
+		matcher, err := NewMatcher(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matcher.Match("spiffe://example.org/anything")).To(BeTrue())
+	})
+
+	It("should reject invalid patterns", func() {
+		_, err := NewMatcher([]string{"spiffe://example.org/["})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ParseID", func() {
+	It("should parse ids of the trust domain", func() {
+		id, err := ParseID("spiffe://example.org/ns/prod", "example.org")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(id.Path).To(Equal("/ns/prod"))
+	})
+
+	It("should reject ids of other trust domains and schemes", func() {
+		_, err := ParseID("spiffe://other.org/ns/prod", "example.org")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseID("https://example.org/ns/prod", "example.org")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseID("spiffe://example.org/ns/prod?x=1", "example.org")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Authn", func() {
+	var (
+		caKey  *ecdsa.PrivateKey
+		caCert *x509.Certificate
+		jwtKey *ecdsa.PrivateKey
+		cfg    config.Spiffe
+	)
+
+	BeforeEach(func() {
+		var err error
+		caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		caCert = newCertificate(&x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "ca"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+			URIs:                  []*url.URL{{Scheme: "spiffe", Host: "example.org"}},
+		}, nil, &caKey.PublicKey, caKey)
+
+		jwtKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		bundle, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &caKey.PublicKey, Use: x509SVIDUse, Certificates: []*x509.Certificate{caCert}},
+			{Key: &jwtKey.PublicKey, Use: jwtSVIDUse, KeyID: "key-1", Algorithm: string(jose.ES256)},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+
+		bundlePath := filepath.Join(GinkgoT().TempDir(), "bundle.json")
+		Expect(os.WriteFile(bundlePath, bundle, 0o600)).To(Succeed())
+
+		cfg = config.Spiffe{
+			TrustDomain: "example.org",
+			BundlePath:  bundlePath,
+			AllowedIDs:  []string{"spiffe://example.org/ns/prod/*"},
+			Audiences:   []string{"permify"},
+		}
+	})
+
+	x509Context := func(id string, signer *ecdsa.PrivateKey, parent *x509.Certificate) context.Context {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		u, err := url.Parse(id)
+		Expect(err).ToNot(HaveOccurred())
+		leaf := newCertificate(&x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			URIs:         []*url.URL{u},
+		}, parent, &key.PublicKey, signer)
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}},
+		})
+	}
+
+	jwtContext := func(claims jwt.Claims, key *ecdsa.PrivateKey) context.Context {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key},
+			(&jose.SignerOptions{}).WithHeader("kid", "key-1").WithType("JWT"))
+		Expect(err).ToNot(HaveOccurred())
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		Expect(err).ToNot(HaveOccurred())
+		md := metadata.New(map[string]string{"authorization": "Bearer " + token})
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	validClaims := func(subject string) jwt.Claims {
+		return jwt.Claims{
+			Subject:  subject,
+			Audience: jwt.Audience{"permify"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}
+	}
+
+	Describe("NewSpiffeAuthn", func() {
+		It("should require a trust domain and a bundle", func() {
+			_, err := NewSpiffeAuthn(context.Background(), config.Spiffe{BundlePath: cfg.BundlePath})
+			Expect(err).To(HaveOccurred())
+			_, err = NewSpiffeAuthn(context.Background(), config.Spiffe{TrustDomain: "example.org"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Authenticate with X.509-SVIDs", func() {
+		It("should return the spiffe id of an allowed svid", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			id, err := authenticator.Authenticate(x509Context("spiffe://example.org/ns/prod/api", caKey, caCert))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).To(Equal("spiffe://example.org/ns/prod/api"))
+		})
+
+		It("should deny ids that are not allowed", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = authenticator.Authenticate(x509Context("spiffe://example.org/ns/dev/api", caKey, caCert))
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+
+		It("should reject svids not signed by the bundle", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			otherCA := newCertificate(&x509.Certificate{
+				SerialNumber:          big.NewInt(3),
+				Subject:               pkix.Name{CommonName: "other"},
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}, nil, &otherKey.PublicKey, otherKey)
+			_, err = authenticator.Authenticate(x509Context("spiffe://example.org/ns/prod/api", otherKey, otherCA))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	Describe("Authenticate with JWT-SVIDs", func() {
+		It("should return the subject of a valid token", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			id, err := authenticator.Authenticate(jwtContext(validClaims("spiffe://example.org/ns/prod/api"), jwtKey))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).To(Equal("spiffe://example.org/ns/prod/api"))
+		})
+
+		It("should reject tokens with another audience", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			claims := validClaims("spiffe://example.org/ns/prod/api")
+			claims.Audience = jwt.Audience{"other"}
+			_, err = authenticator.Authenticate(jwtContext(claims, jwtKey))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject expired tokens", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			claims := validClaims("spiffe://example.org/ns/prod/api")
+			claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			_, err = authenticator.Authenticate(jwtContext(claims, jwtKey))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject tokens signed by unknown keys", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = authenticator.Authenticate(jwtContext(validClaims("spiffe://example.org/ns/prod/api"), otherKey))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject subjects of other trust domains", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = authenticator.Authenticate(jwtContext(validClaims("spiffe://other.org/ns/prod/api"), jwtKey))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should require a token without a certificate", func() {
+			authenticator, err := NewSpiffeAuthn(context.Background(), cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = authenticator.Authenticate(context.Background())
+			Expect(err).To(MatchError(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String()))
+		})
+	})
+})
+
+// newCertificate - Creates the certificate from the template, self-signed when there is no parent
+func newCertificate(template, parent *x509.Certificate, pub *ecdsa.PublicKey, signer *ecdsa.PrivateKey) *x509.Certificate {
+	if parent == nil {
+		parent = template
+	}
+	if template.NotAfter.IsZero() {
+		template.NotBefore = time.Now().Add(-time.Minute)
+		template.NotAfter = time.Now().Add(time.Hour)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signer)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return cert
+}
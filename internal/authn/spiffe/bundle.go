@@ -0,0 +1,119 @@
+package spiffe
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+)
+
+const (
+	// x509SVIDUse - Use of the bundle keys carrying the roots of X.509-SVIDs
+	x509SVIDUse = "x509-svid"
+	// jwtSVIDUse - Use of the bundle keys verifying JWT-SVIDs
+	jwtSVIDUse = "jwt-svid"
+	// _defaultRefreshInterval - How often the bundle file is checked for changes
+	_defaultRefreshInterval = 30 * time.Second
+)
+
+// Bundle - Trust bundle of a trust domain, holding the roots of its X.509-SVIDs and the keys of its JWT-SVIDs
+type Bundle struct {
+	roots   *x509.CertPool
+	jwtKeys map[string]interface{}
+}
+
+// ParseBundle - Parses a bundle in the SPIFFE bundle format, a JWKS whose keys are marked with their use
+func ParseBundle(data []byte) (*Bundle, error) {
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid spiffe bundle: %w", err)
+	}
+
+	bundle := &Bundle{
+		roots:   x509.NewCertPool(),
+		jwtKeys: map[string]interface{}{},
+	}
+	for _, key := range set.Keys {
+		switch key.Use {
+		case x509SVIDUse:
+			if len(key.Certificates) != 1 {
+				return nil, fmt.Errorf("x509-svid key of spiffe bundle must have exactly one certificate")
+			}
+			bundle.roots.AddCert(key.Certificates[0])
+		case jwtSVIDUse:
+			if key.KeyID == "" {
+				return nil, fmt.Errorf("jwt-svid key of spiffe bundle must have a key id")
+			}
+			if !key.IsPublic() {
+				return nil, fmt.Errorf("jwt-svid key %s of spiffe bundle must be a public key", key.KeyID)
+			}
+			bundle.jwtKeys[key.KeyID] = key.Key
+		}
+	}
+	return bundle, nil
+}
+
+// bundleSource - Bundle read from a file and read again whenever the file changes, so that rotated keys are
+// picked up without a restart
+type bundleSource struct {
+	path     string
+	interval time.Duration
+
+	mu        sync.Mutex
+	bundle    *Bundle
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+// newBundleSource - Creates a new bundle source and reads the bundle from the file
+func newBundleSource(path string) (*bundleSource, error) {
+	s := &bundleSource{path: path, interval: _defaultRefreshInterval}
+	if _, err := s.Get(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get - Returns the current bundle. The previous bundle is kept if the changed file cannot be read.
+func (s *bundleSource) Get() (*Bundle, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bundle != nil && time.Since(s.checkedAt) < s.interval {
+		return s.bundle, nil
+	}
+	s.checkedAt = time.Now()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return s.fallback(err)
+	}
+	if s.bundle != nil && info.ModTime().Equal(s.modTime) {
+		return s.bundle, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return s.fallback(err)
+	}
+	bundle, err := ParseBundle(data)
+	if err != nil {
+		return s.fallback(err)
+	}
+	s.bundle, s.modTime = bundle, info.ModTime()
+	return s.bundle, nil
+}
+
+// fallback - Returns the previous bundle, or the error if there is none
+func (s *bundleSource) fallback(err error) (*Bundle, error) {
+	if s.bundle == nil {
+		return nil, err
+	}
+	slog.Error("Failed to reload spiffe bundle: ", slog.Any("error", err))
+	return s.bundle, nil
+}
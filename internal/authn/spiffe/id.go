@@ -0,0 +1,71 @@
+package spiffe
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+)
+
+// trustDomainRegex - Characters allowed in a trust domain name by the SPIFFE ID specification
+var trustDomainRegex = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+// ParseID - Parses a SPIFFE ID, such as spiffe://example.org/ns/prod/sa/api, and checks that it belongs to the
+// trust domain
+func ParseID(id, trustDomain string) (*url.URL, error) {
+	u, err := url.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "spiffe" {
+		return nil, fmt.Errorf("spiffe id %s must use the spiffe scheme", id)
+	}
+	if u.User != nil || u.Port() != "" || u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("spiffe id %s must not have user info, port, query or fragment", id)
+	}
+	if u.Host != trustDomain {
+		return nil, fmt.Errorf("spiffe id %s is not a member of trust domain %s", id, trustDomain)
+	}
+	return u, nil
+}
+
+// Matcher - Checks SPIFFE IDs against the allowed patterns
+type Matcher struct {
+	patterns []string
+}
+
+// NewMatcher - Creates a new matcher from path.Match patterns, e.g. spiffe://example.org/ns/prod/*.
+// A matcher without patterns allows every ID.
+func NewMatcher(patterns []string) (*Matcher, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid spiffe id pattern %s: %w", pattern, err)
+		}
+	}
+	return &Matcher{patterns: patterns}, nil
+}
+
+// Match - Reports whether the ID is allowed
+func (m *Matcher) Match(id string) bool {
+	if len(m.patterns) == 0 {
+		return true
+	}
+	for _, pattern := range m.patterns {
+		if ok, _ := path.Match(pattern, id); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTrustDomain - Checks that the trust domain is a valid trust domain name
+func validateTrustDomain(trustDomain string) error {
+	if trustDomain == "" {
+		return errors.New("spiffe authn must have a trust domain")
+	}
+	if !trustDomainRegex.MatchString(trustDomain) {
+		return fmt.Errorf("invalid spiffe trust domain %s", trustDomain)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package spiffe
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/authn"
+)
+
+// Provider - Authentication provider that verifies SVIDs and attaches their SPIFFE ID as the actor
+type Provider struct {
+	authenticator *Authn
+}
+
+// NewProvider - Creates a new authentication provider from a spiffe authenticator
+func NewProvider(authenticator *Authn) *Provider {
+	return &Provider{authenticator: authenticator}
+}
+
+// Authenticate - Verifies the SVID of the request and returns the context carrying its SPIFFE ID
+func (p *Provider) Authenticate(ctx context.Context) (context.Context, error) {
+	id, err := p.authenticator.Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return authn.ContextWithActor(ctx, id), nil
+}
@@ -0,0 +1,224 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// FormatVersion is the version of the backup file format written by Create.
+const FormatVersion = 1
+
+// pageSize is the number of tuples or attributes read from the storage at a time.
+const pageSize = 1000
+
+// Kind identifies the type of a record in a backup file.
+type Kind string
+
+const (
+	KindHeader    Kind = "header"
+	KindTenant    Kind = "tenant"
+	KindSchema    Kind = "schema"
+	KindTuple     Kind = "tuple"
+	KindAttribute Kind = "attribute"
+)
+
+// Record is a single line of a backup file. A backup starts with a header record,
+// followed by a tenant record and then the schema, tuple and attribute records of that tenant, for each tenant.
+type Record struct {
+	Kind Kind `json:"kind"`
+
+	// Header fields.
+	Version   int        `json:"version,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Tenant fields. SnapToken is the snapshot the tenant's data was read at.
+	TenantID   string `json:"tenant_id,omitempty"`
+	TenantName string `json:"tenant_name,omitempty"`
+	SnapToken  string `json:"snap_token,omitempty"`
+
+	// Schema fields.
+	SchemaVersion string `json:"schema_version,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Definition    string `json:"definition,omitempty"`
+
+	// Data fields, encoded with protojson.
+	Tuple     json.RawMessage `json:"tuple,omitempty"`
+	Attribute json.RawMessage `json:"attribute,omitempty"`
+}
+
+// Backup reads tenants, schemas and data from the storage and writes them to a backup file.
+type Backup struct {
+	tr storage.TenantReader
+	sr storage.SchemaReader
+	dr storage.DataReader
+}
+
+// NewBackup creates a new Backup reading from the given storage readers.
+func NewBackup(tr storage.TenantReader, sr storage.SchemaReader, dr storage.DataReader) *Backup {
+	return &Backup{
+		tr: tr,
+		sr: sr,
+		dr: dr,
+	}
+}
+
+// Create writes a backup of the given tenants to w, or of all tenants when none are given.
+// The data of each tenant is read at a single snapshot, so the backup of a tenant is consistent
+// even if it is being written to while the backup is taken.
+func (b *Backup) Create(ctx context.Context, tenantIDs []string, w io.Writer) (err error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	now := time.Now().UTC()
+	if err = enc.Encode(Record{Kind: KindHeader, Version: FormatVersion, CreatedAt: &now}); err != nil {
+		return err
+	}
+
+	var tenants []*base.Tenant
+	tenants, err = b.tenants(ctx, tenantIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, tenant := range tenants {
+		if err = b.tenant(ctx, enc, tenant); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// tenants resolves the tenants to back up. Unknown tenant IDs are rejected.
+func (b *Backup) tenants(ctx context.Context, ids []string) ([]*base.Tenant, error) {
+	var all []*base.Tenant
+	ct := ""
+	for {
+		page, next, err := b.tr.ListTenants(ctx, database.NewPagination(database.Size(pageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	if len(ids) == 0 {
+		return all, nil
+	}
+
+	byID := make(map[string]*base.Tenant, len(all))
+	for _, t := range all {
+		byID[t.GetId()] = t
+	}
+
+	tenants := make([]*base.Tenant, 0, len(ids))
+	for _, id := range ids {
+		t, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("tenant %s not found", id)
+		}
+		tenants = append(tenants, t)
+	}
+	return tenants, nil
+}
+
+// tenant writes the records of a single tenant. All of its data is read at a single snapshot, pinned before its
+// schema versions are read, so that the backup has the schema versions every tuple and attribute was written against.
+func (b *Backup) tenant(ctx context.Context, enc *json.Encoder, tenant *base.Tenant) error {
+	st, err := b.dr.HeadSnapshot(ctx, tenant.GetId())
+	if err != nil {
+		return err
+	}
+	snap := st.Encode().String()
+
+	if err = enc.Encode(Record{Kind: KindTenant, TenantID: tenant.GetId(), TenantName: tenant.GetName(), SnapToken: snap}); err != nil {
+		return err
+	}
+
+	// Every version of the schema is backed up, oldest first, so that the head version stays the head once restored.
+	versions, err := b.sr.ReadSchemaVersions(ctx, tenant.GetId())
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		definitions, err := b.sr.ReadSchemaDefinitions(ctx, tenant.GetId(), version)
+		if err != nil {
+			return err
+		}
+		for _, d := range definitions {
+			if err = enc.Encode(Record{Kind: KindSchema, TenantID: tenant.GetId(), SchemaVersion: version, Name: d.Name, Definition: d.Serialized()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The data is read regardless of its entity type, so that the data of the types removed from the schema since it
+	// was written is backed up too.
+	if err = b.tuples(ctx, enc, tenant.GetId(), snap); err != nil {
+		return err
+	}
+	return b.attributes(ctx, enc, tenant.GetId(), snap)
+}
+
+// tuples writes the tuples of the tenant.
+func (b *Backup) tuples(ctx context.Context, enc *json.Encoder, tenantID, snap string) error {
+	filter := &base.TupleFilter{}
+	ct := ""
+	for {
+		collection, next, err := b.dr.ReadRelationships(ctx, tenantID, filter, snap, database.NewPagination(database.Size(pageSize), database.Token(ct)))
+		if err != nil {
+			return err
+		}
+		for _, t := range collection.GetTuples() {
+			raw, err := protojson.Marshal(t)
+			if err != nil {
+				return err
+			}
+			if err = enc.Encode(Record{Kind: KindTuple, TenantID: tenantID, Tuple: raw}); err != nil {
+				return err
+			}
+		}
+		ct = next.String()
+		if ct == "" {
+			return nil
+		}
+	}
+}
+
+// attributes writes the attributes of the tenant.
+func (b *Backup) attributes(ctx context.Context, enc *json.Encoder, tenantID, snap string) error {
+	filter := &base.AttributeFilter{}
+	ct := ""
+	for {
+		collection, next, err := b.dr.ReadAttributes(ctx, tenantID, filter, snap, database.NewPagination(database.Size(pageSize), database.Token(ct)))
+		if err != nil {
+			return err
+		}
+		for _, a := range collection.GetAttributes() {
+			raw, err := protojson.Marshal(a)
+			if err != nil {
+				return err
+			}
+			if err = enc.Encode(Record{Kind: KindAttribute, TenantID: tenantID, Attribute: raw}); err != nil {
+				return err
+			}
+		}
+		ct = next.String()
+		if ct == "" {
+			return nil
+		}
+	}
+}
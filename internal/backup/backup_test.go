@@ -0,0 +1,236 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/xid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestBackup is the entry point for the test suite of the backup package.
+func TestBackup(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "backup-suite")
+}
+
+var _ = Describe("Backup", func() {
+	schema := `
+	entity user {}
+
+	entity organization {
+		relation admin @user
+		attribute public boolean
+	}
+	`
+
+	// records decodes a backup, dropping the fields that change between backups of the same data.
+	records := func(b []byte) []Record {
+		var result []Record
+		for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			var r Record
+			Expect(json.Unmarshal([]byte(line), &r)).Should(Succeed())
+			if r.Kind == KindHeader {
+				continue
+			}
+			r.SnapToken = ""
+			result = append(result, r)
+		}
+		return result
+	}
+
+	// writeSchema writes a new version of the schema of the tenant t1, returning the version.
+	writeSchema := func(db database.Database, s string) string {
+		sch, err := parser.NewParser(s).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		version := xid.New().String()
+		definitions := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+		for _, st := range sch.Statements {
+			definitions = append(definitions, storage.SchemaDefinition{
+				TenantID:             "t1",
+				Version:              version,
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), definitions)).Should(Succeed())
+		return version
+	}
+
+	It("should restore a backup into a fresh database", func() {
+		ctx := context.Background()
+
+		source, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = factories.TenantWriterFactory(source).CreateTenant(ctx, "t1", "example tenant")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		version := writeSchema(source, schema)
+
+		tup1, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		tup2, err := tuple.Tuple("organization:2#admin@user:2")
+		Expect(err).ShouldNot(HaveOccurred())
+		attr, err := attribute.Attribute("organization:1$public|boolean:true")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = factories.DataWriterFactory(source).Write(ctx, "t1", database.NewTupleCollection(tup1, tup2), database.NewAttributeCollection(attr))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var first bytes.Buffer
+		err = NewBackup(
+			factories.TenantReaderFactory(source),
+			factories.SchemaReaderFactory(source),
+			factories.DataReaderFactory(source),
+		).Create(ctx, nil, &first)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		target, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = NewRestorer(
+			factories.TenantWriterFactory(target),
+			factories.SchemaWriterFactory(target),
+			factories.DataWriterFactory(target),
+		).Restore(ctx, bytes.NewReader(first.Bytes()))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var second bytes.Buffer
+		err = NewBackup(
+			factories.TenantReaderFactory(target),
+			factories.SchemaReaderFactory(target),
+			factories.DataReaderFactory(target),
+		).Create(ctx, []string{"t1"}, &second)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		restored := records(second.Bytes())
+		Expect(restored).Should(Equal(records(first.Bytes())))
+
+		kinds := map[Kind]int{}
+		for _, r := range restored {
+			kinds[r.Kind]++
+		}
+		Expect(kinds).Should(Equal(map[Kind]int{KindTenant: 1, KindSchema: 2, KindTuple: 2, KindAttribute: 1}))
+		Expect(restored[1].SchemaVersion).Should(Equal(version))
+	})
+
+	It("should back up every schema version and the data of the types removed from the schema", func() {
+		ctx := context.Background()
+
+		source, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = factories.TenantWriterFactory(source).CreateTenant(ctx, "t1", "example tenant")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		first := writeSchema(source, schema+`
+	entity team {
+		relation member @user
+	}
+	`)
+
+		tup1, err := tuple.Tuple("organization:1#admin@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		tup2, err := tuple.Tuple("team:1#member@user:1")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = factories.DataWriterFactory(source).Write(ctx, "t1", database.NewTupleCollection(tup1, tup2), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// The head version no longer has the team entity, whose tuple is still stored
+		second := writeSchema(source, schema)
+
+		var backup bytes.Buffer
+		err = NewBackup(
+			factories.TenantReaderFactory(source),
+			factories.SchemaReaderFactory(source),
+			factories.DataReaderFactory(source),
+		).Create(ctx, []string{"t1"}, &backup)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		versions := map[string]int{}
+		var tuples []string
+		for _, r := range records(backup.Bytes()) {
+			switch r.Kind {
+			case KindSchema:
+				versions[r.SchemaVersion]++
+			case KindTuple:
+				tuples = append(tuples, string(r.Tuple))
+			}
+		}
+		Expect(versions).Should(Equal(map[string]int{first: 3, second: 2}))
+		Expect(tuples).Should(HaveLen(2))
+
+		target, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = NewRestorer(
+			factories.TenantWriterFactory(target),
+			factories.SchemaWriterFactory(target),
+			factories.DataWriterFactory(target),
+		).Restore(ctx, bytes.NewReader(backup.Bytes()))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		head, err := factories.SchemaReaderFactory(target).HeadVersion(ctx, "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head).Should(Equal(second))
+
+		var restored bytes.Buffer
+		err = NewBackup(
+			factories.TenantReaderFactory(target),
+			factories.SchemaReaderFactory(target),
+			factories.DataReaderFactory(target),
+		).Create(ctx, []string{"t1"}, &restored)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(records(restored.Bytes())).Should(Equal(records(backup.Bytes())))
+	})
+
+	It("should reject the records of a backup coming before any tenant record", func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		restorer := NewRestorer(
+			factories.TenantWriterFactory(db),
+			factories.SchemaWriterFactory(db),
+			factories.DataWriterFactory(db),
+		)
+
+		for _, record := range []string{
+			`{"kind":"tuple","tuple":{"entity":{"type":"organization","id":"1"},"relation":"admin","subject":{"type":"user","id":"1"}}}`,
+			`{"kind":"attribute","attribute":{"entity":{"type":"organization","id":"1"},"attribute":"public"}}`,
+			`{"kind":"schema","name":"user","definition":"entity user {}"}`,
+		} {
+			header := fmt.Sprintf(`{"kind":"header","version":%d}`, FormatVersion)
+			err = restorer.Restore(context.Background(), strings.NewReader(header+"\n"+record+"\n"))
+			Expect(err).Should(MatchError(ContainSubstring("malformed backup")), record)
+		}
+	})
+
+	It("should reject unknown tenants", func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var buf bytes.Buffer
+		err = NewBackup(
+			factories.TenantReaderFactory(db),
+			factories.SchemaReaderFactory(db),
+			factories.DataReaderFactory(db),
+		).Create(context.Background(), []string{"unknown"}, &buf)
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,168 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// maxRecordSize is the largest line accepted when reading a backup file.
+const maxRecordSize = 16 * 1024 * 1024
+
+// Restorer writes the contents of a backup file into the storage.
+type Restorer struct {
+	tw storage.TenantWriter
+	sw storage.SchemaWriter
+	dw storage.DataWriter
+}
+
+// NewRestorer creates a new Restorer writing to the given storage writers.
+func NewRestorer(tw storage.TenantWriter, sw storage.SchemaWriter, dw storage.DataWriter) *Restorer {
+	return &Restorer{
+		tw: tw,
+		sw: sw,
+		dw: dw,
+	}
+}
+
+// pending holds the records of the tenant being restored that are not written yet.
+type pending struct {
+	tenantID    string
+	definitions []storage.SchemaDefinition
+	tuples      *database.TupleCollection
+	attributes  *database.AttributeCollection
+	size        int
+}
+
+// Restore reads a backup from r and restores it. It is meant to be run against a fresh database:
+// schemas keep their original versions and data is written in batches, so a failed restore
+// should be retried on an empty database.
+func (s *Restorer) Restore(ctx context.Context, r io.Reader) (err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	if !scanner.Scan() {
+		if err = scanner.Err(); err != nil {
+			return err
+		}
+		return errors.New("backup is empty")
+	}
+
+	var header Record
+	if err = json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return err
+	}
+	if header.Kind != KindHeader {
+		return errors.New("backup does not start with a header")
+	}
+	if header.Version != FormatVersion {
+		return fmt.Errorf("unsupported backup format version %d", header.Version)
+	}
+
+	p := &pending{}
+	for scanner.Scan() {
+		var record Record
+		if err = json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return err
+		}
+
+		// Every other record belongs to the tenant of the tenant record before it
+		if record.Kind != KindTenant && p.tuples == nil {
+			return fmt.Errorf("malformed backup: %q record before any tenant record", record.Kind)
+		}
+
+		switch record.Kind {
+		case KindTenant:
+			if err = s.flush(ctx, p); err != nil {
+				return err
+			}
+			_, err = s.tw.CreateTenant(ctx, record.TenantID, record.TenantName)
+			// Tenants created by migrations, such as the default one, already exist.
+			if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String() {
+				return err
+			}
+			*p = pending{tenantID: record.TenantID, tuples: database.NewTupleCollection(), attributes: database.NewAttributeCollection()}
+		case KindSchema:
+			if record.TenantID != p.tenantID {
+				return fmt.Errorf("schema record of tenant %s outside of its tenant", record.TenantID)
+			}
+			p.definitions = append(p.definitions, storage.SchemaDefinition{
+				TenantID:             record.TenantID,
+				Name:                 record.Name,
+				SerializedDefinition: []byte(record.Definition),
+				Version:              record.SchemaVersion,
+			})
+		case KindTuple:
+			if record.TenantID != p.tenantID {
+				return fmt.Errorf("tuple record of tenant %s outside of its tenant", record.TenantID)
+			}
+			t := &base.Tuple{}
+			if err = protojson.Unmarshal(record.Tuple, t); err != nil {
+				return err
+			}
+			p.tuples.Add(t)
+			p.size++
+		case KindAttribute:
+			if record.TenantID != p.tenantID {
+				return fmt.Errorf("attribute record of tenant %s outside of its tenant", record.TenantID)
+			}
+			a := &base.Attribute{}
+			if err = protojson.Unmarshal(record.Attribute, a); err != nil {
+				return err
+			}
+			p.attributes.Add(a)
+			p.size++
+		default:
+			return fmt.Errorf("unknown backup record kind %q", record.Kind)
+		}
+
+		if p.size >= pageSize {
+			if err = s.flush(ctx, p); err != nil {
+				return err
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	return s.flush(ctx, p)
+}
+
+// flush writes the pending schema and data of the current tenant.
+func (s *Restorer) flush(ctx context.Context, p *pending) (err error) {
+	// The schema versions are written one by one in their order, before any data so that the data is written
+	// against them.
+	for len(p.definitions) > 0 {
+		n := 1
+		for n < len(p.definitions) && p.definitions[n].Version == p.definitions[0].Version {
+			n++
+		}
+		if err = s.sw.WriteSchema(ctx, p.definitions[:n]); err != nil {
+			return err
+		}
+		p.definitions = p.definitions[n:]
+	}
+
+	if p.size == 0 {
+		return nil
+	}
+
+	if _, err = s.dw.Write(ctx, p.tenantID, p.tuples, p.attributes); err != nil {
+		return err
+	}
+
+	p.tuples = database.NewTupleCollection()
+	p.attributes = database.NewAttributeCollection()
+	p.size = 0
+	return nil
+}
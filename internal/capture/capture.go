@@ -0,0 +1,133 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Record - Captured request, along with the response the server returned for it and how long it took
+type Record struct {
+	Method   string          `json:"method"`
+	Time     time.Time       `json:"time"`
+	Duration string          `json:"duration"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the status message of the request if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// methods are the captured methods, those whose results can be compared against another server.
+var methods = map[string]bool{
+	base.Permission_Check_FullMethodName:         true,
+	base.Permission_LookupEntity_FullMethodName:  true,
+	base.Permission_LookupSubject_FullMethodName: true,
+}
+
+// Capture - Records a sample of the check and lookup requests of the server as JSON lines, to be replayed against
+// another server or schema version with Replay. Only the request messages are recorded, never their headers, and
+// the snapshot tokens and schema versions of the requests are cleared since they only make sense on the server
+// they were captured on.
+type Capture struct {
+	rate float64
+	// roll returns a number in [0, 1)
+	roll func() float64
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewCapture - Creates a new capture writing a share of the requests given by the rate, between 0 and 1, to w
+func NewCapture(w io.Writer, rate float64) (*Capture, error) {
+	if rate <= 0 || rate > 1 {
+		return nil, fmt.Errorf("capture sample rate must be greater than 0 and at most 1")
+	}
+	return &Capture{
+		rate:    rate,
+		roll:    rand.Float64,
+		encoder: json.NewEncoder(w),
+	}, nil
+}
+
+// UnaryServerInterceptor - Returns an interceptor capturing the sampled check and lookup requests of the server
+func (c *Capture) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !methods[info.FullMethod] || c.roll() >= c.rate {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		duration := time.Since(start)
+
+		if m, ok := req.(proto.Message); ok {
+			var response proto.Message
+			if r, ok := resp.(proto.Message); ok && err == nil {
+				response = r
+			}
+			c.record(info.FullMethod, start, duration, m, response, err)
+		}
+
+		return resp, err
+	}
+}
+
+// record writes the record of a request. Requests that cannot be encoded are skipped, capturing must never fail
+// the requests of the server.
+func (c *Capture) record(method string, start time.Time, duration time.Duration, request, response proto.Message, err error) {
+	req, e := protojson.Marshal(Sanitize(request))
+	if e != nil {
+		return
+	}
+
+	record := Record{
+		Method:   method,
+		Time:     start.UTC(),
+		Duration: duration.String(),
+		Request:  req,
+	}
+	if response != nil {
+		if record.Response, e = protojson.Marshal(response); e != nil {
+			return
+		}
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.encoder.Encode(record)
+}
+
+// Sanitize - Returns a copy of the request without the snapshot token, schema version and debug flag of its
+// metadata, so that it is evaluated on the head of the server it is replayed against.
+func Sanitize(request proto.Message) proto.Message {
+	request = proto.Clone(request)
+	switch r := request.(type) {
+	case *base.PermissionCheckRequest:
+		if m := r.GetMetadata(); m != nil {
+			m.SnapToken, m.SchemaVersion, m.SnapshotTime, m.Debug = "", "", nil, false
+		}
+	case *base.PermissionLookupEntityRequest:
+		if m := r.GetMetadata(); m != nil {
+			m.SnapToken, m.SchemaVersion = "", ""
+		}
+	case *base.PermissionLookupSubjectRequest:
+		if m := r.GetMetadata(); m != nil {
+			m.SnapToken, m.SchemaVersion = "", ""
+		}
+	}
+	return request
+}
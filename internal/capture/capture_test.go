@@ -0,0 +1,147 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TestCapture -
+func TestCapture(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "capture-suite")
+}
+
+// client - Permission client answering checks and entity lookups with fixed results
+type client struct {
+	base.PermissionClient
+
+	can        base.CheckResult
+	entityIDs  []string
+	schemaSeen string
+}
+
+func (c *client) Check(_ context.Context, in *base.PermissionCheckRequest, _ ...grpc.CallOption) (*base.PermissionCheckResponse, error) {
+	c.schemaSeen = in.GetMetadata().GetSchemaVersion()
+	return &base.PermissionCheckResponse{Can: c.can, Metadata: &base.PermissionCheckResponseMetadata{CheckCount: 3}}, nil
+}
+
+func (c *client) LookupEntity(_ context.Context, _ *base.PermissionLookupEntityRequest, _ ...grpc.CallOption) (*base.PermissionLookupEntityResponse, error) {
+	return &base.PermissionLookupEntityResponse{EntityIds: c.entityIDs}, nil
+}
+
+var _ = Describe("capture", func() {
+	check := &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "snap", SchemaVersion: "v1", Depth: 20},
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: "edit",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	}
+	lookup := &base.PermissionLookupEntityRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionLookupEntityRequestMetadata{Depth: 20},
+		EntityType: "doc",
+		Permission: "edit",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	}
+
+	capture := func(c *Capture, method string, req interface{}, resp interface{}, err error) {
+		_, _ = c.UnaryServerInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return resp, err
+		})
+	}
+
+	Context("UnaryServerInterceptor", func() {
+		It("Case 1 - Records sanitized requests of the captured methods", func() {
+			buf := &bytes.Buffer{}
+			c, err := NewCapture(buf, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			capture(c, base.Permission_Check_FullMethodName, check, &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED}, nil)
+			capture(c, base.Permission_LookupEntity_FullMethodName, lookup, nil, status.Error(codes.InvalidArgument, "invalid"))
+			capture(c, base.Schema_Write_FullMethodName, &base.SchemaWriteRequest{TenantId: "t1"}, &base.SchemaWriteResponse{}, nil)
+
+			var records []Record
+			decoder := json.NewDecoder(buf)
+			for decoder.More() {
+				var record Record
+				Expect(decoder.Decode(&record)).Should(Succeed())
+				records = append(records, record)
+			}
+			Expect(records).Should(HaveLen(2))
+
+			Expect(records[0].Method).Should(Equal(base.Permission_Check_FullMethodName))
+			Expect(string(records[0].Request)).ShouldNot(ContainSubstring("snap"))
+			Expect(string(records[0].Request)).ShouldNot(ContainSubstring("v1"))
+			Expect(string(records[0].Response)).Should(ContainSubstring("CHECK_RESULT_ALLOWED"))
+
+			Expect(records[1].Error).Should(Equal("invalid"))
+			Expect(records[1].Response).Should(BeEmpty())
+
+			// The request of the server is not changed
+			Expect(check.GetMetadata().GetSnapToken()).Should(Equal("snap"))
+		})
+
+		It("Case 2 - Sample rate", func() {
+			_, err := NewCapture(&bytes.Buffer{}, 0)
+			Expect(err).Should(HaveOccurred())
+			_, err = NewCapture(&bytes.Buffer{}, 1.5)
+			Expect(err).Should(HaveOccurred())
+
+			buf := &bytes.Buffer{}
+			c, err := NewCapture(buf, 0.5)
+			Expect(err).ShouldNot(HaveOccurred())
+			c.roll = func() float64 { return 0.7 }
+
+			capture(c, base.Permission_Check_FullMethodName, check, &base.PermissionCheckResponse{}, nil)
+			Expect(buf.Len()).Should(BeZero())
+		})
+	})
+
+	Context("Replay", func() {
+		It("Case 1 - Compares results with the capture", func() {
+			buf := &bytes.Buffer{}
+			c, err := NewCapture(buf, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			capture(c, base.Permission_Check_FullMethodName, check, &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED}, nil)
+			capture(c, base.Permission_LookupEntity_FullMethodName, lookup, &base.PermissionLookupEntityResponse{EntityIds: []string{"1", "2"}}, nil)
+
+			target := &client{can: base.CheckResult_CHECK_RESULT_ALLOWED, entityIDs: []string{"2", "1"}}
+			report, err := Replay(context.Background(), target, bytes.NewReader(buf.Bytes()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Total).Should(Equal(2))
+			Expect(report.Matched).Should(Equal(2))
+			Expect(target.schemaSeen).Should(BeEmpty())
+
+			target = &client{can: base.CheckResult_CHECK_RESULT_DENIED, entityIDs: []string{"1"}}
+			report, err = Replay(context.Background(), target, bytes.NewReader(buf.Bytes()), SchemaVersion("v2"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Matched).Should(BeZero())
+			Expect(report.Mismatches).Should(HaveLen(2))
+			Expect(report.Mismatches[0].Line).Should(Equal(1))
+			Expect(report.Mismatches[0].Captured).Should(Equal("CHECK_RESULT_ALLOWED"))
+			Expect(report.Mismatches[0].Replayed).Should(Equal("CHECK_RESULT_DENIED"))
+			Expect(report.Mismatches[1].Captured).Should(Equal("[1, 2]"))
+			Expect(report.Mismatches[1].Replayed).Should(Equal("[1]"))
+			Expect(target.schemaSeen).Should(Equal("v2"))
+		})
+
+		It("Case 2 - Invalid captures", func() {
+			_, err := Replay(context.Background(), &client{}, bytes.NewBufferString(`{"method": "/base.v1.Schema/Write", "duration": "1ms", "request": {}}`))
+			Expect(err).Should(HaveOccurred())
+			_, err = Replay(context.Background(), &client{}, bytes.NewBufferString("not json"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,222 @@
+package capture
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Mismatch - Replayed request whose result differs from the captured one
+type Mismatch struct {
+	// Line is the line of the record in the capture.
+	Line     int
+	Method   string
+	Request  string
+	Captured string
+	Replayed string
+}
+
+// Latencies - Percentiles of the durations of requests
+type Latencies struct {
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// ReplayReport - Comparison of the captured requests with their replay
+type ReplayReport struct {
+	Total      int
+	Matched    int
+	Mismatches []Mismatch
+	Captured   Latencies
+	Replayed   Latencies
+}
+
+// ReplayOption - Option of a replay
+type ReplayOption func(r *replayer)
+
+// SchemaVersion - Replays the requests against the schema version instead of the head version of their tenants
+func SchemaVersion(version string) ReplayOption {
+	return func(r *replayer) {
+		r.schemaVersion = version
+	}
+}
+
+// replayer - Options of a replay
+type replayer struct {
+	schemaVersion string
+}
+
+// Replay - Re-runs the captured requests read from r against the client one after another, comparing their
+// results and latencies with the captured ones. Failed requests match other failed requests whatever their errors.
+func Replay(ctx context.Context, client base.PermissionClient, r io.Reader, opts ...ReplayOption) (*ReplayReport, error) {
+	rp := &replayer{}
+	for _, opt := range opts {
+		opt(rp)
+	}
+
+	report := &ReplayReport{}
+	var captured, replayed []time.Duration
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		duration, err := time.ParseDuration(record.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		request, want, err := record.result()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		start := time.Now()
+		got := rp.invoke(ctx, client, request)
+		took := time.Since(start)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		report.Total++
+		captured = append(captured, duration)
+		replayed = append(replayed, took)
+
+		if same(want, got) {
+			report.Matched++
+			continue
+		}
+		report.Mismatches = append(report.Mismatches, Mismatch{
+			Line:     line,
+			Method:   record.Method,
+			Request:  string(record.Request),
+			Captured: want,
+			Replayed: got,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	report.Captured = percentiles(captured)
+	report.Replayed = percentiles(replayed)
+	return report, nil
+}
+
+// result decodes the request of the record, and returns it along with the result of its response.
+func (r Record) result() (proto.Message, string, error) {
+	var request, response proto.Message
+	switch r.Method {
+	case base.Permission_Check_FullMethodName:
+		request, response = &base.PermissionCheckRequest{}, &base.PermissionCheckResponse{}
+	case base.Permission_LookupEntity_FullMethodName:
+		request, response = &base.PermissionLookupEntityRequest{}, &base.PermissionLookupEntityResponse{}
+	case base.Permission_LookupSubject_FullMethodName:
+		request, response = &base.PermissionLookupSubjectRequest{}, &base.PermissionLookupSubjectResponse{}
+	default:
+		return nil, "", fmt.Errorf("method %s cannot be replayed", r.Method)
+	}
+
+	if err := protojson.Unmarshal(r.Request, request); err != nil {
+		return nil, "", err
+	}
+	if r.Error != "" || len(r.Response) == 0 {
+		return request, "error: " + r.Error, nil
+	}
+	if err := protojson.Unmarshal(r.Response, response); err != nil {
+		return nil, "", err
+	}
+	return request, result(response, nil), nil
+}
+
+// invoke runs the request against the client and returns its result.
+func (rp *replayer) invoke(ctx context.Context, client base.PermissionClient, request proto.Message) string {
+	switch req := request.(type) {
+	case *base.PermissionCheckRequest:
+		if rp.schemaVersion != "" {
+			if req.Metadata == nil {
+				req.Metadata = &base.PermissionCheckRequestMetadata{}
+			}
+			req.Metadata.SchemaVersion = rp.schemaVersion
+		}
+		return result(client.Check(ctx, req))
+	case *base.PermissionLookupEntityRequest:
+		if rp.schemaVersion != "" {
+			if req.Metadata == nil {
+				req.Metadata = &base.PermissionLookupEntityRequestMetadata{}
+			}
+			req.Metadata.SchemaVersion = rp.schemaVersion
+		}
+		return result(client.LookupEntity(ctx, req))
+	case *base.PermissionLookupSubjectRequest:
+		if rp.schemaVersion != "" {
+			if req.Metadata == nil {
+				req.Metadata = &base.PermissionLookupSubjectRequestMetadata{}
+			}
+			req.Metadata.SchemaVersion = rp.schemaVersion
+		}
+		return result(client.LookupSubject(ctx, req))
+	}
+	return ""
+}
+
+// result returns the part of a response that is compared, ignoring its metadata and the order of identifiers.
+func result[T proto.Message](response T, err error) string {
+	if err != nil {
+		return "error: " + status.Convert(err).Message()
+	}
+
+	var ids []string
+	switch r := any(response).(type) {
+	case *base.PermissionCheckResponse:
+		return r.GetCan().String()
+	case *base.PermissionLookupEntityResponse:
+		ids = append(ids, r.GetEntityIds()...)
+	case *base.PermissionLookupSubjectResponse:
+		ids = append(ids, r.GetSubjectIds()...)
+	}
+	sort.Strings(ids)
+	return "[" + strings.Join(ids, ", ") + "]"
+}
+
+// same reports whether two results match. Any two errors match.
+func same(captured, replayed string) bool {
+	if strings.HasPrefix(captured, "error: ") {
+		return strings.HasPrefix(replayed, "error: ")
+	}
+	return captured == replayed
+}
+
+// percentiles returns the percentiles of the durations.
+func percentiles(durations []time.Duration) Latencies {
+	if len(durations) == 0 {
+		return Latencies{}
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	at := func(p float64) time.Duration {
+		return sorted[int(p*float64(len(sorted)-1))]
+	}
+	return Latencies{P50: at(0.50), P95: at(0.95), P99: at(0.99)}
+}
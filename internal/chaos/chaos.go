@@ -0,0 +1,84 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Fault - Faults injected into the calls of an injector. Percentages are between 0 and 100.
+type Fault struct {
+	// Latency is added to the calls selected by LatencyPercentage.
+	Latency           time.Duration
+	LatencyPercentage float64
+	// ErrorPercentage is the share of calls that fail instead of reaching the delegate.
+	ErrorPercentage float64
+}
+
+// Injector - Injects latency and errors into a percentage of calls, to validate how timeouts and retries behave
+// before an incident does. It is only created when fault injection is explicitly enabled.
+type Injector struct {
+	fault Fault
+	// roll returns a number in [0, 100)
+	roll func() float64
+}
+
+// NewInjector - Creates a new injector of the fault
+func NewInjector(fault Fault) (*Injector, error) {
+	if fault.LatencyPercentage < 0 || fault.LatencyPercentage > 100 || fault.ErrorPercentage < 0 || fault.ErrorPercentage > 100 {
+		return nil, fmt.Errorf("fault percentages must be between 0 and 100")
+	}
+	if fault.Latency < 0 {
+		return nil, fmt.Errorf("fault latency must not be negative")
+	}
+	return &Injector{
+		fault: fault,
+		roll: func() float64 {
+			return rand.Float64() * 100
+		},
+	}, nil
+}
+
+// Inject - Delays the call of the operation and decides whether it fails. It returns the injected error, or the
+// error of the context when it is done while the call is delayed. A nil injector injects nothing.
+func (i *Injector) Inject(ctx context.Context, operation string) error {
+	if i == nil {
+		return nil
+	}
+
+	if i.fault.Latency > 0 && i.roll() < i.fault.LatencyPercentage {
+		timer := time.NewTimer(i.fault.Latency)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+
+	if i.roll() < i.fault.ErrorPercentage {
+		return fmt.Errorf("%s: fault injected into %s", base.ErrorCode_ERROR_CODE_INTERNAL.String(), operation)
+	}
+	return nil
+}
+
+// UnaryClientInterceptor - Returns an interceptor injecting the faults into the calls of a client. Injected errors
+// are returned as unavailable, like those of a peer that cannot be reached.
+func (i *Injector) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := i.Inject(ctx, method); err != nil {
+			if ctx.Err() != nil {
+				return status.FromContextError(err).Err()
+			}
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,106 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TestChaos -
+func TestChaos(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "chaos-suite")
+}
+
+var _ = Describe("chaos", func() {
+	Context("Inject", func() {
+		It("Case 1 - Errors", func() {
+			injector, err := NewInjector(Fault{ErrorPercentage: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			err = injector.Inject(context.Background(), "dataReader.queryRelationships")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix(base.ErrorCode_ERROR_CODE_INTERNAL.String() + ":"))
+
+			injector, err = NewInjector(Fault{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(injector.Inject(context.Background(), "dataReader.queryRelationships")).Should(Succeed())
+
+			var none *Injector
+			Expect(none.Inject(context.Background(), "dataReader.queryRelationships")).Should(Succeed())
+		})
+
+		It("Case 2 - Percentages", func() {
+			injector, err := NewInjector(Fault{ErrorPercentage: 25})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rolls := []float64{10, 30, 24.9, 99}
+			injector.roll = func() float64 {
+				r := rolls[0]
+				rolls = rolls[1:]
+				return r
+			}
+
+			failed := 0
+			for i := 0; i < 4; i++ {
+				if injector.Inject(context.Background(), "schemaWriter.writeSchema") != nil {
+					failed++
+				}
+			}
+			Expect(failed).Should(Equal(2))
+		})
+
+		It("Case 3 - Latency", func() {
+			injector, err := NewInjector(Fault{Latency: 20 * time.Millisecond, LatencyPercentage: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			start := time.Now()
+			Expect(injector.Inject(context.Background(), "dataWriter.write")).Should(Succeed())
+			Expect(time.Since(start)).Should(BeNumerically(">=", 20*time.Millisecond))
+
+			// The delay stops when the context is done
+			injector, err = NewInjector(Fault{Latency: time.Minute, LatencyPercentage: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			Expect(injector.Inject(ctx, "dataWriter.write")).Should(MatchError(context.DeadlineExceeded))
+		})
+
+		It("Case 4 - Invalid faults", func() {
+			_, err := NewInjector(Fault{ErrorPercentage: 120})
+			Expect(err).Should(HaveOccurred())
+			_, err = NewInjector(Fault{LatencyPercentage: -1})
+			Expect(err).Should(HaveOccurred())
+			_, err = NewInjector(Fault{Latency: -time.Second})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("UnaryClientInterceptor", func() {
+		It("Case 1", func() {
+			invoked := false
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				invoked = true
+				return nil
+			}
+
+			injector, err := NewInjector(Fault{ErrorPercentage: 100})
+			Expect(err).ShouldNot(HaveOccurred())
+			err = injector.UnaryClientInterceptor()(context.Background(), "/base.v1.Permission/Check", nil, nil, nil, invoker)
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+			Expect(invoked).Should(BeFalse())
+
+			injector, err = NewInjector(Fault{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(injector.UnaryClientInterceptor()(context.Background(), "/base.v1.Permission/Check", nil, nil, nil, invoker)).Should(Succeed())
+			Expect(invoked).Should(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,319 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// pageSize is the number of relationships and attributes read at once
+const pageSize = 100
+
+// Kind - Kind of issue found in the data of a tenant
+type Kind string
+
+const (
+	// DuplicateRelationship is a relationship stored more than once, or with both an empty and an ellipsis subject
+	// relation
+	DuplicateRelationship Kind = "duplicate_relationship"
+	// InvalidRelationship is a relationship of an undefined entity type or relation, or whose subject type the
+	// relation does not allow
+	InvalidRelationship Kind = "invalid_relationship"
+	// DuplicateAttribute is an attribute of an entity stored more than once with the same value
+	DuplicateAttribute Kind = "duplicate_attribute"
+	// ConflictingAttribute is an attribute of an entity stored more than once with different values
+	ConflictingAttribute Kind = "conflicting_attribute"
+	// InvalidAttribute is an attribute of an undefined entity type or attribute, or whose value is not of the type
+	// of its definition
+	InvalidAttribute Kind = "invalid_attribute"
+)
+
+// Issue - Relationship or attribute to clean up
+type Issue struct {
+	Kind Kind `json:"kind"`
+	// Item is the relationship or the attribute in their string forms
+	Item string `json:"item"`
+	// Detail is the error code of the invalid relationships and attributes
+	Detail string `json:"detail,omitempty"`
+	// Fixable is whether the issue is fixed automatically. Conflicting attributes are not, since only one of their
+	// values can be kept.
+	Fixable bool `json:"fixable"`
+
+	tuple     *base.Tuple
+	attribute *base.Attribute
+}
+
+// TenantReport - Issues found in the data of a tenant
+type TenantReport struct {
+	TenantID      string  `json:"tenant_id"`
+	SchemaVersion string  `json:"schema_version,omitempty"`
+	Relationships int     `json:"relationships"`
+	Attributes    int     `json:"attributes"`
+	Issues        []Issue `json:"issues"`
+
+	tuples     []*base.Tuple
+	attributes []*base.Attribute
+}
+
+// Scan - Reads all the relationships and attributes of the tenant and reports the duplicated ones, and the ones
+// the schema version does not allow, the head version of the tenant if empty
+func Scan(ctx context.Context, schema base.SchemaClient, data base.DataClient, tenantID, schemaVersion string) (*TenantReport, error) {
+	response, err := schema.Read(ctx, &base.SchemaReadRequest{
+		TenantId: tenantID,
+		Metadata: &base.SchemaReadRequestMetadata{SchemaVersion: schemaVersion},
+	})
+	if err != nil {
+		return nil, err
+	}
+	definitions := response.GetSchema().GetEntityDefinitions()
+
+	report := &TenantReport{TenantID: tenantID, SchemaVersion: schemaVersion, Issues: []Issue{}}
+	report.tuples, err = readRelationships(ctx, data, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	report.attributes, err = readAttributes(ctx, data, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	report.Relationships = len(report.tuples)
+	report.Attributes = len(report.attributes)
+
+	seen := map[string]struct{}{}
+	for _, t := range report.tuples {
+		item := tuple.ToString(t)
+		key := tuple.ToString(normalize(t))
+		if _, ok := seen[key]; ok {
+			report.Issues = append(report.Issues, Issue{Kind: DuplicateRelationship, Item: item, Fixable: true, tuple: t})
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if err := validateTuple(definitions, t); err != nil {
+			report.Issues = append(report.Issues, Issue{Kind: InvalidRelationship, Item: item, Detail: err.Error(), Fixable: true, tuple: t})
+		}
+	}
+
+	values := map[string]*base.Attribute{}
+	for _, a := range report.attributes {
+		item := attribute.ToString(a)
+		key := attribute.EntityAndCallOrAttributeToString(a.GetEntity(), a.GetAttribute())
+		if first, ok := values[key]; ok {
+			if proto.Equal(first.GetValue(), a.GetValue()) {
+				report.Issues = append(report.Issues, Issue{Kind: DuplicateAttribute, Item: item, Fixable: true, attribute: a})
+			} else {
+				report.Issues = append(report.Issues, Issue{Kind: ConflictingAttribute, Item: item, attribute: a})
+			}
+			continue
+		}
+		values[key] = a
+
+		if err := validateAttribute(definitions, a); err != nil {
+			report.Issues = append(report.Issues, Issue{Kind: InvalidAttribute, Item: item, Detail: err.Error(), Fixable: true, attribute: a})
+		}
+	}
+
+	return report, nil
+}
+
+// Fix - Fixes the fixable issues of the report, returning the number fixed. Since relationships and attributes
+// cannot be deleted one copy at a time, the relationships and attributes matching the issues are deleted, and a
+// single copy of the valid ones is written back in a second request.
+func Fix(ctx context.Context, data base.DataClient, report *TenantReport) (int, error) {
+	invalid := map[string]struct{}{}
+	for _, issue := range report.Issues {
+		switch issue.Kind {
+		case InvalidRelationship:
+			invalid[tuple.ToString(normalize(issue.tuple))] = struct{}{}
+		case InvalidAttribute:
+			invalid[attribute.EntityAndCallOrAttributeToString(issue.attribute.GetEntity(), issue.attribute.GetAttribute())] = struct{}{}
+		}
+	}
+	conflicting := map[string]struct{}{}
+	for _, issue := range report.Issues {
+		if issue.Kind == ConflictingAttribute {
+			conflicting[attribute.EntityAndCallOrAttributeToString(issue.attribute.GetEntity(), issue.attribute.GetAttribute())] = struct{}{}
+		}
+	}
+
+	copies := map[string]int{}
+	for _, t := range report.tuples {
+		copies[tuple.ToString(normalize(t))]++
+	}
+
+	fixed := 0
+	// applied are the deletions already made, the issues of the same relationship or attribute being fixed together
+	applied := map[string]struct{}{}
+	for _, issue := range report.Issues {
+		if !issue.Fixable {
+			continue
+		}
+
+		request := &base.DataWriteRequest{
+			TenantId: report.TenantID,
+			Metadata: &base.DataWriteRequestMetadata{SchemaVersion: report.SchemaVersion},
+		}
+		deletion := &base.DataDeleteRequest{
+			TenantId:        report.TenantID,
+			TupleFilter:     &base.TupleFilter{},
+			AttributeFilter: &base.AttributeFilter{},
+		}
+
+		if issue.tuple != nil {
+			deletion.TupleFilter = filter(issue.tuple)
+			// The relationships all the copies of which are deleted are written back, unless they are invalid
+			matched := map[string]int{}
+			for _, t := range report.tuples {
+				if matches(deletion.TupleFilter, t) {
+					matched[tuple.ToString(normalize(t))]++
+				}
+			}
+			for _, t := range report.tuples {
+				key := tuple.ToString(normalize(t))
+				_, bad := invalid[key]
+				if bad || matched[key] == 0 || matched[key] != copies[key] {
+					continue
+				}
+				matched[key] = 0
+				request.Tuples = append(request.Tuples, normalize(t))
+			}
+		} else {
+			key := attribute.EntityAndCallOrAttributeToString(issue.attribute.GetEntity(), issue.attribute.GetAttribute())
+			// The values of conflicting attributes are kept unless the attribute is invalid anyway
+			_, bad := invalid[key]
+			if _, ok := conflicting[key]; ok && !bad {
+				continue
+			}
+			deletion.AttributeFilter = &base.AttributeFilter{
+				Entity:     &base.EntityFilter{Type: issue.attribute.GetEntity().GetType(), Ids: []string{issue.attribute.GetEntity().GetId()}},
+				Attributes: []string{issue.attribute.GetAttribute()},
+			}
+			if !bad {
+				request.Attributes = append(request.Attributes, issue.attribute)
+			}
+		}
+
+		fixed++
+		key := deletion.GetTupleFilter().String() + deletion.GetAttributeFilter().String()
+		if _, ok := applied[key]; ok {
+			continue
+		}
+		applied[key] = struct{}{}
+
+		if _, err := data.Delete(ctx, deletion); err != nil {
+			return fixed - 1, err
+		}
+		if len(request.GetTuples()) > 0 || len(request.GetAttributes()) > 0 {
+			if _, err := data.Write(ctx, request); err != nil {
+				return fixed - 1, err
+			}
+		}
+	}
+	return fixed, nil
+}
+
+// readRelationships reads all the relationships of the tenant.
+func readRelationships(ctx context.Context, data base.DataClient, tenantID string) ([]*base.Tuple, error) {
+	var tuples []*base.Tuple
+	token := ""
+	for {
+		response, err := data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+			TenantId:        tenantID,
+			Metadata:        &base.RelationshipReadRequestMetadata{},
+			Filter:          &base.TupleFilter{},
+			PageSize:        pageSize,
+			ContinuousToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, response.GetTuples()...)
+
+		token = response.GetContinuousToken()
+		if token == "" {
+			return tuples, nil
+		}
+	}
+}
+
+// readAttributes reads all the attributes of the tenant.
+func readAttributes(ctx context.Context, data base.DataClient, tenantID string) ([]*base.Attribute, error) {
+	var attributes []*base.Attribute
+	token := ""
+	for {
+		response, err := data.ReadAttributes(ctx, &base.AttributeReadRequest{
+			TenantId:        tenantID,
+			Metadata:        &base.AttributeReadRequestMetadata{},
+			Filter:          &base.AttributeFilter{},
+			PageSize:        pageSize,
+			ContinuousToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, response.GetAttributes()...)
+
+		token = response.GetContinuousToken()
+		if token == "" {
+			return attributes, nil
+		}
+	}
+}
+
+// validateTuple validates the relationship against the definition of its entity type.
+func validateTuple(definitions map[string]*base.EntityDefinition, t *base.Tuple) error {
+	definition, ok := definitions[t.GetEntity().GetType()]
+	if !ok {
+		return errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+	}
+	return validation.ValidateTuple(definition, t)
+}
+
+// validateAttribute validates the attribute against the definition of its entity type.
+func validateAttribute(definitions map[string]*base.EntityDefinition, a *base.Attribute) error {
+	definition, ok := definitions[a.GetEntity().GetType()]
+	if !ok {
+		return errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+	}
+	return validation.ValidateAttribute(definition, a)
+}
+
+// normalize returns the relationship with an empty subject relation in place of an ellipsis.
+func normalize(t *base.Tuple) *base.Tuple {
+	if t.GetSubject().GetRelation() != tuple.ELLIPSIS {
+		return t
+	}
+	n := proto.Clone(t).(*base.Tuple)
+	n.Subject.Relation = ""
+	return n
+}
+
+// filter returns the filter matching the copies of the relationship. A relationship without a subject relation
+// cannot be matched exactly, its filter matching the relationships of every subject relation.
+func filter(t *base.Tuple) *base.TupleFilter {
+	return &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: t.GetEntity().GetType(), Ids: []string{t.GetEntity().GetId()}},
+		Relation: t.GetRelation(),
+		Subject: &base.SubjectFilter{
+			Type:     t.GetSubject().GetType(),
+			Ids:      []string{t.GetSubject().GetId()},
+			Relation: t.GetSubject().GetRelation(),
+		},
+	}
+}
+
+// matches returns whether the relationship matches the filter.
+func matches(f *base.TupleFilter, t *base.Tuple) bool {
+	return t.GetEntity().GetType() == f.GetEntity().GetType() &&
+		t.GetEntity().GetId() == f.GetEntity().GetIds()[0] &&
+		t.GetRelation() == f.GetRelation() &&
+		t.GetSubject().GetType() == f.GetSubject().GetType() &&
+		t.GetSubject().GetId() == f.GetSubject().GetIds()[0] &&
+		(f.GetSubject().GetRelation() == "" || t.GetSubject().GetRelation() == f.GetSubject().GetRelation())
+}
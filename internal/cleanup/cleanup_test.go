@@ -0,0 +1,205 @@
+package cleanup
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/schema"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestCleanup -
+func TestCleanup(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cleanup-suite")
+}
+
+// schemas - Schema client reading a fixed schema
+type schemas struct {
+	base.SchemaClient
+
+	definition *base.SchemaDefinition
+}
+
+func (s *schemas) Read(_ context.Context, _ *base.SchemaReadRequest, _ ...grpc.CallOption) (*base.SchemaReadResponse, error) {
+	return &base.SchemaReadResponse{Schema: s.definition}, nil
+}
+
+// data - Data client storing the relationships and attributes of a tenant in lists, duplicates allowed
+type data struct {
+	base.DataClient
+
+	tuples     []*base.Tuple
+	attributes []*base.Attribute
+}
+
+func (d *data) ReadRelationships(_ context.Context, _ *base.RelationshipReadRequest, _ ...grpc.CallOption) (*base.RelationshipReadResponse, error) {
+	return &base.RelationshipReadResponse{Tuples: d.tuples}, nil
+}
+
+func (d *data) ReadAttributes(_ context.Context, _ *base.AttributeReadRequest, _ ...grpc.CallOption) (*base.AttributeReadResponse, error) {
+	return &base.AttributeReadResponse{Attributes: d.attributes}, nil
+}
+
+func (d *data) Delete(_ context.Context, in *base.DataDeleteRequest, _ ...grpc.CallOption) (*base.DataDeleteResponse, error) {
+	if in.GetTupleFilter().GetEntity() != nil {
+		var kept []*base.Tuple
+		for _, t := range d.tuples {
+			if !matches(in.GetTupleFilter(), t) {
+				kept = append(kept, t)
+			}
+		}
+		d.tuples = kept
+	}
+	if in.GetAttributeFilter().GetEntity() != nil {
+		var kept []*base.Attribute
+		for _, a := range d.attributes {
+			if a.GetEntity().GetType() != in.GetAttributeFilter().GetEntity().GetType() ||
+				a.GetEntity().GetId() != in.GetAttributeFilter().GetEntity().GetIds()[0] ||
+				a.GetAttribute() != in.GetAttributeFilter().GetAttributes()[0] {
+				kept = append(kept, a)
+			}
+		}
+		d.attributes = kept
+	}
+	return &base.DataDeleteResponse{}, nil
+}
+
+func (d *data) Write(_ context.Context, in *base.DataWriteRequest, _ ...grpc.CallOption) (*base.DataWriteResponse, error) {
+	d.tuples = append(d.tuples, in.GetTuples()...)
+	d.attributes = append(d.attributes, in.GetAttributes()...)
+	return &base.DataWriteResponse{}, nil
+}
+
+var _ = Describe("cleanup", func() {
+	definition := schema.Schema(schema.Entities(
+		schema.Entity("user", nil, nil, nil),
+		schema.Entity("team", schema.Relations(schema.Relation("member", schema.Reference("user"))), nil, nil),
+		schema.Entity("doc",
+			schema.Relations(
+				schema.Relation("viewer", schema.Reference("user"), schema.Reference("team#member")),
+				schema.Relation("parent", schema.Reference("team")),
+			),
+			schema.Attributes(schema.Attribute("public", base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN)),
+			nil,
+		),
+	), nil)
+
+	tuples := func(strs ...string) []*base.Tuple {
+		var tuples []*base.Tuple
+		for _, str := range strs {
+			t, err := tuple.Tuple(str)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		return tuples
+	}
+	attributes := func(strs ...string) []*base.Attribute {
+		var attributes []*base.Attribute
+		for _, str := range strs {
+			a, err := attribute.Attribute(str)
+			Expect(err).ShouldNot(HaveOccurred())
+			attributes = append(attributes, a)
+		}
+		return attributes
+	}
+	issues := func(report *TenantReport) []Issue {
+		var issues []Issue
+		for _, issue := range report.Issues {
+			issues = append(issues, Issue{Kind: issue.Kind, Item: issue.Item, Detail: issue.Detail, Fixable: issue.Fixable})
+		}
+		return issues
+	}
+
+	It("Reports duplicate and invalid relationships and attributes", func() {
+		d := &data{
+			tuples: tuples(
+				"doc:1#viewer@user:1",
+				"doc:1#viewer@user:1",
+				"doc:1#viewer@team:1#member",
+				"doc:2#parent@team:1",
+				"doc:2#parent@team:1#...",
+				"doc:1#editor@user:1",
+				"folder:1#viewer@user:1",
+				"doc:1#parent@user:1",
+			),
+			attributes: attributes(
+				"doc:1$public|boolean:true",
+				"doc:1$public|boolean:true",
+				"doc:2$public|boolean:true",
+				"doc:2$public|boolean:false",
+				"doc:3$archived|boolean:true",
+				"doc:4$public|string:yes",
+			),
+		}
+
+		report, err := Scan(context.Background(), &schemas{definition: definition}, d, "t1", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Relationships).Should(Equal(8))
+		Expect(report.Attributes).Should(Equal(6))
+		Expect(issues(report)).Should(Equal([]Issue{
+			{Kind: DuplicateRelationship, Item: "doc:1#viewer@user:1", Fixable: true},
+			{Kind: DuplicateRelationship, Item: "doc:2#parent@team:1#...", Fixable: true},
+			{Kind: InvalidRelationship, Item: "doc:1#editor@user:1", Detail: base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(), Fixable: true},
+			{Kind: InvalidRelationship, Item: "folder:1#viewer@user:1", Detail: base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), Fixable: true},
+			{Kind: InvalidRelationship, Item: "doc:1#parent@user:1", Detail: base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND.String(), Fixable: true},
+			{Kind: DuplicateAttribute, Item: "doc:1$public|boolean:true", Fixable: true},
+			{Kind: ConflictingAttribute, Item: "doc:2$public|boolean:false"},
+			{Kind: InvalidAttribute, Item: "doc:3$archived|boolean:true", Detail: base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String(), Fixable: true},
+			{Kind: InvalidAttribute, Item: "doc:4$public|string:yes", Detail: base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String(), Fixable: true},
+		}))
+	})
+
+	It("Fixes the fixable issues, keeping a single copy of the valid relationships and attributes", func() {
+		d := &data{
+			tuples: tuples(
+				"doc:1#viewer@user:1",
+				"doc:1#viewer@user:1",
+				"doc:1#viewer@team:1#member",
+				"doc:2#parent@team:1",
+				"doc:2#parent@team:1#...",
+				"doc:1#editor@user:1",
+			),
+			attributes: attributes(
+				"doc:1$public|boolean:true",
+				"doc:1$public|boolean:true",
+				"doc:2$public|boolean:true",
+				"doc:2$public|boolean:false",
+				"doc:4$public|string:yes",
+			),
+		}
+
+		report, err := Scan(context.Background(), &schemas{definition: definition}, d, "t1", "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		fixed, err := Fix(context.Background(), d, report)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(fixed).Should(Equal(5))
+
+		report, err = Scan(context.Background(), &schemas{definition: definition}, d, "t1", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(issues(report)).Should(Equal([]Issue{
+			{Kind: ConflictingAttribute, Item: "doc:2$public|boolean:false"},
+		}))
+		Expect(d.tuples).Should(ConsistOf(tuples(
+			"doc:1#viewer@user:1",
+			"doc:1#viewer@team:1#member",
+			"doc:2#parent@team:1",
+		)))
+		var values []string
+		for _, a := range d.attributes {
+			values = append(values, attribute.ToString(a))
+		}
+		Expect(values).Should(ConsistOf(
+			"doc:1$public|boolean:true",
+			"doc:2$public|boolean:true",
+			"doc:2$public|boolean:false",
+		))
+	})
+})
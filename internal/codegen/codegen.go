@@ -0,0 +1,294 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Language - Language of generated code
+type Language string
+
+const (
+	GO         Language = "go"
+	TYPESCRIPT Language = "ts"
+)
+
+// String - Returns the name of the language
+func (l Language) String() string {
+	return string(l)
+}
+
+// Options - Options of generated code
+type Options struct {
+	// Package is the name of the package of generated Go code.
+	Package string
+}
+
+// entity - Entity of the schema along with the names of its members, sorted
+type entity struct {
+	Name        string
+	Relations   []string
+	Attributes  []string
+	Permissions []string
+}
+
+// Generate - Generates typed constants for the entity types, relations, attributes and permissions of the schema,
+// along with helpers that check the permissions of each entity, in the given language
+func Generate(language Language, schema *base.SchemaDefinition, options Options) ([]byte, error) {
+	var tmpl *template.Template
+	switch language {
+	case GO:
+		tmpl = goTemplate
+		if options.Package == "" {
+			options.Package = "permissions"
+		}
+	case TYPESCRIPT:
+		tmpl = tsTemplate
+	default:
+		return nil, fmt.Errorf("unsupported language %s, expected one of go, ts", language)
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		Package  string
+		Entities []entity
+	}{
+		Package:  options.Package,
+		Entities: entities(schema),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if language == GO {
+		return format.Source(buf.Bytes())
+	}
+	return buf.Bytes(), nil
+}
+
+// entities returns the entities of the schema sorted by name, so that generated code is stable.
+func entities(schema *base.SchemaDefinition) []entity {
+	entities := make([]entity, 0, len(schema.GetEntityDefinitions()))
+	for name, definition := range schema.GetEntityDefinitions() {
+		entities = append(entities, entity{
+			Name:        name,
+			Relations:   sortedKeys(definition.GetRelations()),
+			Attributes:  sortedKeys(definition.GetAttributes()),
+			Permissions: sortedKeys(declaredPermissions(definition)),
+		})
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Name < entities[j].Name
+	})
+	return entities
+}
+
+// declaredPermissions returns the permissions of the entity declared by the schema, without the ones synthesized by
+// the compiler to walk relations.
+func declaredPermissions(definition *base.EntityDefinition) map[string]*base.PermissionDefinition {
+	permissions := make(map[string]*base.PermissionDefinition, len(definition.GetPermissions()))
+	for name, permission := range definition.GetPermissions() {
+		if !permission.GetSynthetic() {
+			permissions[name] = permission
+		}
+	}
+	return permissions
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// pascal converts a snake case name of the schema, e.g. organization_member, to OrganizationMember.
+func pascal(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+// camel converts a snake case name of the schema, e.g. organization_member, to organizationMember.
+func camel(name string) string {
+	p := pascal(name)
+	if p == "" {
+		return p
+	}
+	return strings.ToLower(p[:1]) + p[1:]
+}
+
+var funcs = template.FuncMap{
+	"pascal": pascal,
+	"camel":  camel,
+}
+
+var goTemplate = template.Must(template.New("go").Funcs(funcs).Parse(`// Code generated by permify generate. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+)
+
+// EntityType - Type of an entity of the schema
+type EntityType string
+
+const (
+{{- range .Entities }}
+	Entity{{ pascal .Name }} EntityType = "{{ .Name }}"
+{{- end }}
+)
+
+// Entity - Entity of the given type and id
+type Entity struct {
+	Type EntityType
+	ID   string
+}
+
+// Subject - Subject of a check, an entity or the members of a relation of an entity when Relation is set
+type Subject struct {
+	Type     EntityType
+	ID       string
+	Relation string
+}
+
+// Checker - Checks whether the subject has the permission on the entity, usually with the client of the
+// Permify API
+type Checker interface {
+	Check(ctx context.Context, entity Entity, permission string, subject Subject) (bool, error)
+}
+{{ range .Entities }}{{ $entity := pascal .Name }}
+// {{ $entity }}Entity - Returns the {{ .Name }} entity with the id
+func {{ $entity }}Entity(id string) Entity {
+	return Entity{Type: Entity{{ $entity }}, ID: id}
+}
+
+// {{ $entity }}Subject - Returns the {{ .Name }} with the id as a subject
+func {{ $entity }}Subject(id string) Subject {
+	return Subject{Type: Entity{{ $entity }}, ID: id}
+}
+{{- if .Relations }}
+
+// {{ $entity }}Relation - Relation of the {{ .Name }} entity
+type {{ $entity }}Relation string
+
+const (
+{{- range .Relations }}
+	{{ $entity }}Relation{{ pascal . }} {{ $entity }}Relation = "{{ . }}"
+{{- end }}
+)
+
+// {{ $entity }}SubjectSet - Returns the subjects related to the {{ .Name }} with the id through the relation
+func {{ $entity }}SubjectSet(id string, relation {{ $entity }}Relation) Subject {
+	return Subject{Type: Entity{{ $entity }}, ID: id, Relation: string(relation)}
+}
+{{- end }}
+{{- if .Attributes }}
+
+// {{ $entity }}Attribute - Attribute of the {{ .Name }} entity
+type {{ $entity }}Attribute string
+
+const (
+{{- range .Attributes }}
+	{{ $entity }}Attribute{{ pascal . }} {{ $entity }}Attribute = "{{ . }}"
+{{- end }}
+)
+{{- end }}
+{{- if .Permissions }}
+
+// {{ $entity }}Permission - Permission of the {{ .Name }} entity
+type {{ $entity }}Permission string
+
+const (
+{{- range .Permissions }}
+	{{ $entity }}Permission{{ pascal . }} {{ $entity }}Permission = "{{ . }}"
+{{- end }}
+)
+
+// Check{{ $entity }} - Checks whether the subject has the permission on the {{ .Name }} with the id
+func Check{{ $entity }}(ctx context.Context, checker Checker, id string, permission {{ $entity }}Permission, subject Subject) (bool, error) {
+	return checker.Check(ctx, {{ $entity }}Entity(id), string(permission), subject)
+}
+{{- end }}
+{{ end }}`))
+
+var tsTemplate = template.Must(template.New("ts").Funcs(funcs).Parse(`// Code generated by permify generate. DO NOT EDIT.
+
+export const EntityTypes = {
+{{- range .Entities }}
+  {{ pascal .Name }}: "{{ .Name }}",
+{{- end }}
+} as const;
+
+export type EntityType = (typeof EntityTypes)[keyof typeof EntityTypes];
+
+export interface Entity {
+  type: EntityType;
+  id: string;
+}
+
+export interface Subject {
+  type: EntityType;
+  id: string;
+  relation?: string;
+}
+
+/** Checks whether the subject has the permission on the entity, usually with the client of the Permify API. */
+export interface Checker {
+  check(entity: Entity, permission: string, subject: Subject): Promise<boolean>;
+}
+{{ range .Entities }}{{ $entity := pascal .Name }}
+export const {{ $entity }} = {
+  type: "{{ .Name }}",
+  relations: {
+{{- range .Relations }}
+    {{ camel . }}: "{{ . }}",
+{{- end }}
+  },
+  attributes: {
+{{- range .Attributes }}
+    {{ camel . }}: "{{ . }}",
+{{- end }}
+  },
+  permissions: {
+{{- range .Permissions }}
+    {{ camel . }}: "{{ . }}",
+{{- end }}
+  },
+} as const;
+
+export type {{ $entity }}Relation = (typeof {{ $entity }}.relations)[keyof typeof {{ $entity }}.relations];
+export type {{ $entity }}Attribute = (typeof {{ $entity }}.attributes)[keyof typeof {{ $entity }}.attributes];
+export type {{ $entity }}Permission = (typeof {{ $entity }}.permissions)[keyof typeof {{ $entity }}.permissions];
+
+/** Returns the {{ .Name }} entity with the id. */
+export function {{ camel .Name }}Entity(id: string): Entity {
+  return { type: "{{ .Name }}", id };
+}
+
+/** Returns the {{ .Name }} with the id as a subject, or the subjects related to it through the relation. */
+export function {{ camel .Name }}Subject(id: string, relation?: {{ $entity }}Relation): Subject {
+  return { type: "{{ .Name }}", id, relation };
+}
+
+/** Checks whether the subject has the permission on the {{ .Name }} with the id. */
+export function check{{ $entity }}(checker: Checker, id: string, permission: {{ $entity }}Permission, subject: Subject): Promise<boolean> {
+  return checker.check({{ camel .Name }}Entity(id), permission, subject);
+}
+{{ end }}`))
@@ -0,0 +1,65 @@
+package codegen
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/schema"
+)
+
+// TestCodegen -
+func TestCodegen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "codegen-suite")
+}
+
+var _ = Describe("codegen", func() {
+	sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity organization {
+	relation admin @user
+}
+
+entity repository {
+	relation parent @organization
+	relation owner @user
+	attribute is_public boolean
+
+	permission edit = owner or parent.admin
+	permission view = edit or is_public
+}`)
+
+	It("Case 1 - Go", func() {
+		Expect(err).ShouldNot(HaveOccurred())
+
+		code, err := Generate(GO, sch, Options{Package: "authz"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(code)).Should(ContainSubstring("package authz"))
+		Expect(string(code)).Should(ContainSubstring(`EntityRepository   EntityType = "repository"`))
+		Expect(string(code)).Should(ContainSubstring(`RepositoryRelationOwner  RepositoryRelation = "owner"`))
+		Expect(string(code)).Should(ContainSubstring(`RepositoryAttributeIsPublic RepositoryAttribute = "is_public"`))
+		Expect(string(code)).Should(ContainSubstring(`RepositoryPermissionView RepositoryPermission = "view"`))
+		Expect(string(code)).Should(ContainSubstring("func CheckRepository(ctx context.Context, checker Checker, id string, permission RepositoryPermission, subject Subject) (bool, error)"))
+		Expect(string(code)).ShouldNot(ContainSubstring("func CheckUser("))
+	})
+
+	It("Case 2 - TypeScript", func() {
+		Expect(err).ShouldNot(HaveOccurred())
+
+		code, err := Generate(TYPESCRIPT, sch, Options{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(code)).Should(ContainSubstring(`Repository: "repository",`))
+		Expect(string(code)).Should(ContainSubstring(`isPublic: "is_public",`))
+		Expect(string(code)).Should(ContainSubstring("export function checkRepository(checker: Checker, id: string, permission: RepositoryPermission, subject: Subject): Promise<boolean>"))
+	})
+
+	It("Case 3 - Unsupported language", func() {
+		_, err := Generate(Language("java"), sch, Options{})
+		Expect(err).Should(MatchError("unsupported language java, expected one of go, ts"))
+	})
+})
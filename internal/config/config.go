@@ -13,6 +13,8 @@ import (
 type (
 	// Config is the main configuration structure containing various sections for different aspects of the application.
 	Config struct {
+		Profile string `mapstructure:"profile"` // Profile the settings exposing the internals of the server default by: dev or prod
+
 		Server      `mapstructure:"server"`      // Server configuration for both HTTP and gRPC
 		Log         `mapstructure:"logger"`      // Logging configuration
 		Profiler    `mapstructure:"profiler"`    // Profiler configuration
@@ -22,28 +24,140 @@ type (
 		Service     `mapstructure:"service"`     // Service configuration
 		Database    `mapstructure:"database"`    // Database configuration
 		Distributed `mapstructure:"distributed"` // Distributed configuration
+		Chaos       `mapstructure:"chaos"`       // Fault injection configuration
+		Replication `mapstructure:"replication"` // Multi-region replication configuration
+		Reload      `mapstructure:"reload"`      // Configuration reloading
+		Diagnostics `mapstructure:"diagnostics"` // Startup diagnostics
+	}
+
+	// Diagnostics contains configuration for the checks of the database, the certificates and the OIDC issuer run on
+	// startup, which refuse to start the server if any of them fails.
+	Diagnostics struct {
+		Enabled                  bool          `mapstructure:"enabled"`                    // Whether the checks run on startup
+		Timeout                  time.Duration `mapstructure:"timeout"`                    // Time each check has to complete
+		MaxClockSkew             time.Duration `mapstructure:"max_clock_skew"`             // Skew allowed between the clocks of the server and the database, 0 to not check it
+		CertificateExpiryWarning time.Duration `mapstructure:"certificate_expiry_warning"` // Warn about certificates expiring within this duration
+	}
+
+	// Reload contains configuration for reloading the log level, the rate limits, the preshared keys and the CORS
+	// policies of the server while it runs, when the configuration file changes or the process receives SIGHUP.
+	Reload struct {
+		Enabled bool `mapstructure:"enabled"` // Whether the configuration is reloaded while the server runs
 	}
 
 	// Server contains the configurations for both HTTP and gRPC servers.
 	Server struct {
-		HTTP      `mapstructure:"http"` // HTTP server configuration
-		GRPC      `mapstructure:"grpc"` // gRPC server configuration
-		RateLimit int64                 `mapstructure:"rate_limit"` // Rate limit configuration
+		HTTP             `mapstructure:"http"`        // HTTP server configuration
+		GRPC             `mapstructure:"grpc"`        // gRPC server configuration
+		Gateway          Gateway                      `mapstructure:"gateway"`            // Gateway-only deployment configuration
+		RateLimit        int64                        `mapstructure:"rate_limit"`         // Tokens the rate limiter grants per second
+		RateLimitWeights []RateLimitWeight            `mapstructure:"rate_limit_weights"` // Tokens taken by requests by gRPC method, 1 if not set
+		RateLimitRedis   RateLimitRedis               `mapstructure:"rate_limit_redis"`   // Redis the rate limit is shared through
+		ErrorMessages    map[string]map[string]string `mapstructure:"error_messages"`     // Messages of the error codes by locale and error code
+		VerboseErrors    bool                         `mapstructure:"verbose_errors"`     // Whether the errors of internal failures carry their underlying details
+		Autocert         Autocert                     `mapstructure:"autocert"`           // Certificates of the HTTP and gRPC servers obtained automatically
+		ExtAuthz         ExtAuthz                     `mapstructure:"ext_authz"`          // Envoy external authorization service of the gRPC server
+	}
+
+	// ExtAuthz contains configuration for serving the external authorization API of Envoy on the gRPC server, so
+	// that API gateways enforce the checks the rules map the HTTP requests they receive to.
+	ExtAuthz struct {
+		Enabled        bool           `mapstructure:"enabled"`         // Whether the external authorization service is served
+		Tenant         string         `mapstructure:"tenant"`          // Tenant of the checks of the rules not setting one
+		Depth          int32          `mapstructure:"depth"`           // Depth of the checks
+		AllowUnmatched bool           `mapstructure:"allow_unmatched"` // Whether the requests no rule matches are allowed, instead of denied
+		Rules          []ExtAuthzRule `mapstructure:"rules"`           // Rules mapping the requests to checks, the first matching one applies
+	}
+
+	// ExtAuthzRule maps the HTTP requests of its methods and path to a check. The tenant, entity and subject are
+	// templates, where {name} is replaced by the named group of the path and {header:name} by the header.
+	ExtAuthzRule struct {
+		Methods    []string `mapstructure:"methods"`    // HTTP methods of the requests, all of them if empty
+		Path       string   `mapstructure:"path"`       // Regular expression the path of the requests must match, without their query
+		Tenant     string   `mapstructure:"tenant"`     // Tenant of the check, the tenant of ext_authz if empty
+		Entity     string   `mapstructure:"entity"`     // Entity of the check, e.g. document:{id}
+		Permission string   `mapstructure:"permission"` // Permission of the check
+		Subject    string   `mapstructure:"subject"`    // Subject of the check, e.g. user:{header:x-user-id}
+	}
+
+	// Autocert contains configuration for obtaining and renewing the certificates of the HTTP and gRPC servers from
+	// an ACME certificate authority, such as Let's Encrypt, in place of the certificate files of their TLS
+	// configuration.
+	Autocert struct {
+		Enabled          bool     `mapstructure:"enabled"`           // Whether the certificates are obtained automatically
+		Hostnames        []string `mapstructure:"hostnames"`         // Hostnames certificates are obtained for, the others are refused
+		Email            string   `mapstructure:"email"`             // Contact address of the ACME account, if any
+		DirectoryURL     string   `mapstructure:"directory_url"`     // Directory of the ACME certificate authority, Let's Encrypt if empty
+		Cache            string   `mapstructure:"cache"`             // Where the certificates are kept: dir or database
+		CacheDir         string   `mapstructure:"cache_dir"`         // Directory the certificates are kept in, with the dir cache
+		ChallengeAddress string   `mapstructure:"challenge_address"` // Address the HTTP-01 challenges are answered on, e.g. :80, none if empty
+	}
+
+	// RateLimitRedis contains configuration for sharing the rate limit of the replicas through Redis, instead of
+	// granting it to each of them.
+	RateLimitRedis struct {
+		Enabled  bool   `mapstructure:"enabled"`  // Whether the rate limit is shared through Redis
+		Address  string `mapstructure:"address"`  // Address of the Redis server, e.g. localhost:6379
+		Username string `mapstructure:"username"` // Username to authenticate with, if any
+		Password string `mapstructure:"password"` // Password to authenticate with, if any
+		DB       int    `mapstructure:"db"`       // Database the bucket is stored in
+		Key      string `mapstructure:"key"`      // Key the bucket is stored at, shared by the replicas
+		Replicas int64  `mapstructure:"replicas"` // Replicas sharing the bucket, each limited to an even share of the rate while Redis can't be reached
+	}
+
+	// RateLimitWeight contains the tokens the requests of a gRPC method take from the rate limiter.
+	RateLimitWeight struct {
+		Method string `mapstructure:"method"` // Full gRPC method, e.g. /base.v1.Permission/LookupEntity
+		Weight int64  `mapstructure:"weight"` // Tokens taken by each request
 	}
 
 	// HTTP contains configuration for the HTTP server.
 	HTTP struct {
-		Enabled            bool      `mapstructure:"enabled"`              // Whether the HTTP server is enabled
-		Port               string    `mapstructure:"port"`                 // Port for the HTTP server
-		TLSConfig          TLSConfig `mapstructure:"tls"`                  // TLS configuration for the HTTP server
-		CORSAllowedOrigins []string  `mapstructure:"cors_allowed_origins"` // List of allowed origins for CORS
-		CORSAllowedHeaders []string  `mapstructure:"cors_allowed_headers"` // List of allowed headers for CORS
+		Enabled                   bool          `mapstructure:"enabled"`                      // Whether the HTTP server is enabled
+		Address                   string        `mapstructure:"address"`                      // Address the HTTP server binds to, all the interfaces if not set
+		Port                      string        `mapstructure:"port"`                         // Port for the HTTP server
+		TLSConfig                 TLSConfig     `mapstructure:"tls"`                          // TLS configuration for the HTTP server
+		CORSAllowedOrigins        []string      `mapstructure:"cors_allowed_origins"`         // List of allowed origins for CORS
+		CORSAllowedHeaders        []string      `mapstructure:"cors_allowed_headers"`         // List of allowed headers for CORS
+		CORSAllowedOriginPatterns []string      `mapstructure:"cors_allowed_origin_patterns"` // Regular expressions of allowed origins for CORS
+		CORSMaxAge                int           `mapstructure:"cors_max_age"`                 // Seconds preflight responses may be cached for
+		CORSPolicies              []CORSPolicy  `mapstructure:"cors_policies"`                // CORS policies of path prefixes, overriding the ones above
+		OpenAPIEnabled            bool          `mapstructure:"openapi_enabled"`              // Whether to serve the OpenAPI specification and Swagger UI
+		HTTP3Enabled              bool          `mapstructure:"http3_enabled"`                // Whether to also serve HTTP/3 over QUIC on the UDP port of the same number, TLS being required
+		ReadTimeout               time.Duration `mapstructure:"read_timeout"`                 // Maximum duration for reading an entire request
+		ReadHeaderTimeout         time.Duration `mapstructure:"read_header_timeout"`          // Maximum duration for reading the headers of a request
+		WriteTimeout              time.Duration `mapstructure:"write_timeout"`                // Maximum duration before timing out the writes of a response
+		IdleTimeout               time.Duration `mapstructure:"idle_timeout"`                 // Maximum duration to wait for the next request on a keep-alive connection
+		MaxHeaderBytes            int           `mapstructure:"max_header_bytes"`             // Maximum size of the headers of a request
+	}
+
+	// CORSPolicy contains the CORS configuration of the routes under a path prefix.
+	CORSPolicy struct {
+		PathPrefix            string   `mapstructure:"path_prefix"`             // Path prefix of the routes, e.g. /v1/schemas
+		AllowedOrigins        []string `mapstructure:"allowed_origins"`         // List of allowed origins
+		AllowedOriginPatterns []string `mapstructure:"allowed_origin_patterns"` // Regular expressions of allowed origins
+		AllowedHeaders        []string `mapstructure:"allowed_headers"`         // List of allowed headers
+		AllowedMethods        []string `mapstructure:"allowed_methods"`         // List of allowed methods, all API methods if empty
+		AllowCredentials      *bool    `mapstructure:"allow_credentials"`       // Whether credentials are allowed, true if unset
+		MaxAge                int      `mapstructure:"max_age"`                 // Seconds preflight responses may be cached for
 	}
 
 	// GRPC contains configuration for the gRPC server.
 	GRPC struct {
-		Port      string    `mapstructure:"port"` // Port for the gRPC server
-		TLSConfig TLSConfig `mapstructure:"tls"`  // TLS configuration for the gRPC server
+		Port             string    `mapstructure:"port"`              // Port for the gRPC server
+		Addresses        []string  `mapstructure:"addresses"`         // Addresses to listen on as host:port, all the interfaces on the port if empty
+		ReusePort        bool      `mapstructure:"reuse_port"`        // Whether the listeners are opened with SO_REUSEPORT
+		SocketActivation bool      `mapstructure:"socket_activation"` // Whether to serve on the sockets passed by systemd socket activation
+		TLSConfig        TLSConfig `mapstructure:"tls"`               // TLS configuration for the gRPC server
+		Reflection       bool      `mapstructure:"reflection"`        // Whether the gRPC reflection service is served
+	}
+
+	// Gateway contains configuration for running the HTTP server alone, in front of remote gRPC servers.
+	Gateway struct {
+		Enabled       bool      `mapstructure:"enabled"`        // Whether to run only the HTTP gateway
+		Targets       []string  `mapstructure:"targets"`        // gRPC servers the gateway forwards requests to
+		LoadBalancing string    `mapstructure:"load_balancing"` // Load balancing policy across the targets
+		TLSConfig     TLSConfig `mapstructure:"tls"`            // TLS configuration for the connections to the targets
 	}
 
 	// TLSConfig contains configuration for TLS.
@@ -55,10 +169,12 @@ type (
 
 	// Authn contains configuration for authentication.
 	Authn struct {
-		Enabled   bool      `mapstructure:"enabled"`   // Whether authentication is enabled
-		Method    string    `mapstructure:"method"`    // The authentication method to be used
-		Preshared Preshared `mapstructure:"preshared"` // Configuration for preshared key authentication
-		Oidc      Oidc      `mapstructure:"oidc"`      // Configuration for OIDC authentication
+		Enabled   bool                   `mapstructure:"enabled"`   // Whether authentication is enabled
+		Method    string                 `mapstructure:"method"`    // The authentication method to be used
+		Preshared Preshared              `mapstructure:"preshared"` // Configuration for preshared key authentication
+		Oidc      Oidc                   `mapstructure:"oidc"`      // Configuration for OIDC authentication
+		Spiffe    Spiffe                 `mapstructure:"spiffe"`    // Configuration for SPIFFE workload identity authentication
+		Providers map[string]interface{} `mapstructure:",remain"`   // Sections of custom authentication methods, keyed by method
 	}
 
 	// Preshared contains configuration for preshared key authentication.
@@ -72,15 +188,54 @@ type (
 		ClientID string `mapstructure:"client_id"` // OIDC client ID
 	}
 
+	// Spiffe contains configuration for SPIFFE workload identity authentication.
+	Spiffe struct {
+		TrustDomain string   `mapstructure:"trust_domain"` // Trust domain the callers must belong to
+		BundlePath  string   `mapstructure:"bundle_path"`  // Path to the SPIFFE bundle (JWKS) of the trust domain
+		AllowedIDs  []string `mapstructure:"allowed_ids"`  // Patterns of the SPIFFE IDs allowed to call the API
+		Audiences   []string `mapstructure:"audiences"`    // Audiences accepted in JWT-SVIDs
+	}
+
 	// Profiler contains configuration for the profiler.
 	Profiler struct {
-		Enabled bool   `mapstructure:"enabled"` // Whether the profiler is enabled
-		Port    string `mapstructure:"port"`    // Port for the profiler
+		Enabled bool         `mapstructure:"enabled"` // Whether the profiler is enabled
+		Address string       `mapstructure:"address"` // Address the profiler binds to, all the interfaces if not set
+		Port    string       `mapstructure:"port"`    // Port for the profiler
+		Auth    ProfilerAuth `mapstructure:"auth"`    // Authentication of the requests to the profiler
+		Push    ProfilerPush `mapstructure:"push"`    // Continuous profiles pushed to a profiling server
+	}
+
+	// ProfilerAuth contains configuration for authenticating the requests to the profiler, with the basic
+	// authentication or the preshared keys as bearer tokens. The profiler is not authenticated if the method is empty.
+	ProfilerAuth struct {
+		Method            string   `mapstructure:"method"`              // Authentication method: basic or preshared
+		BasicAuthUser     string   `mapstructure:"basic_auth_user"`     // User of the basic authentication
+		BasicAuthPassword string   `mapstructure:"basic_auth_password"` // Password of the basic authentication
+		Keys              []string `mapstructure:"keys"`                // Keys accepted as bearer tokens
+	}
+
+	// ProfilerPush contains configuration for pushing the profiles of the node to a Pyroscope server continuously,
+	// labelled with the RPC method and the tenant of the requests they were taken in.
+	ProfilerPush struct {
+		Enabled           bool   `mapstructure:"enabled"`             // Whether the profiles are pushed
+		Endpoint          string `mapstructure:"endpoint"`            // Address of the Pyroscope server
+		ApplicationName   string `mapstructure:"application_name"`    // Name of the application the profiles are pushed as
+		BasicAuthUser     string `mapstructure:"basic_auth_user"`     // User to authenticate to the server with, if any
+		BasicAuthPassword string `mapstructure:"basic_auth_password"` // Password to authenticate to the server with
 	}
 
 	// Log contains configuration for logging.
 	Log struct {
-		Level string `mapstructure:"level"` // Logging level
+		Level     string    `mapstructure:"level"`     // Logging level
+		Redaction Redaction `mapstructure:"redaction"` // Redaction of identifiers from logs, traces and error messages
+	}
+
+	// Redaction contains configuration for redacting the identifiers of entities and subjects from logs, traces
+	// and error messages, for deployments treating user identifiers as personal data.
+	Redaction struct {
+		Mode   string `mapstructure:"mode"`   // Redaction mode: none, hash or truncate
+		Salt   string `mapstructure:"salt"`   // Salt identifiers are hashed with
+		Length int    `mapstructure:"length"` // Number of characters truncated identifiers keep
 	}
 
 	// Tracer contains configuration for distributed tracing.
@@ -89,6 +244,8 @@ type (
 		Exporter string `mapstructure:"exporter"` // Exporter for tracing data
 		Endpoint string `mapstructure:"endpoint"` // Endpoint for the tracing exporter
 		Insecure bool   `mapstructure:"insecure"` // Connect to the collector using the HTTP scheme, instead of HTTPS.
+		// Ratio of the traces sampled, between 0 and 1. It can be changed at runtime through the Admin service.
+		SamplingRatio float64 `mapstructure:"sampling_ratio"`
 	}
 
 	// Meter contains configuration for metrics collection and reporting.
@@ -96,36 +253,213 @@ type (
 		Enabled  bool   `mapstructure:"enabled"`  // Whether metrics collection is enabled
 		Exporter string `mapstructure:"exporter"` // Exporter for metrics data
 		Endpoint string `mapstructure:"endpoint"` // Endpoint for the metrics exporter
+		SLO      SLO    `mapstructure:"slo"`      // Service level objective metrics configuration
+	}
+
+	// SLO contains configuration for the metrics of service level objectives.
+	SLO struct {
+		Enabled    bool        `mapstructure:"enabled"`    // Whether to export the availability and latency of requests against the objectives
+		Objectives []Objective `mapstructure:"objectives"` // Objectives by gRPC method
+	}
+
+	// Objective contains the service level objective of a gRPC method.
+	Objective struct {
+		Method        string        `mapstructure:"method"`         // Full gRPC method, e.g. /base.v1.Permission/Check, or * for the methods without an objective
+		Availability  float64       `mapstructure:"availability"`   // Share of requests that must not fail
+		Latency       time.Duration `mapstructure:"latency"`        // Threshold requests must be faster than
+		LatencyTarget float64       `mapstructure:"latency_target"` // Share of requests that must be faster than the threshold
 	}
 
 	// Service contains configuration for various service-level features.
 	Service struct {
-		CircuitBreaker bool       `mapstructure:"circuit_breaker"` // Whether to enable the circuit breaker pattern
-		Watch          Watch      `mapstructure:"watch"`           // Watch service configuration
-		Schema         Schema     `mapstructure:"schema"`          // Schema service configuration
-		Permission     Permission `mapstructure:"permission"`      // Permission service configuration
-		Data           Data       `mapstructure:"data"`            // Data service configuration
+		CircuitBreaker bool        `mapstructure:"circuit_breaker"` // Whether to enable the circuit breaker pattern
+		Watch          Watch       `mapstructure:"watch"`           // Watch service configuration
+		Schema         Schema      `mapstructure:"schema"`          // Schema service configuration
+		Permission     Permission  `mapstructure:"permission"`      // Permission service configuration
+		Data           Data        `mapstructure:"data"`            // Data service configuration
+		Idempotency    Idempotency `mapstructure:"idempotency"`     // Idempotency keys configuration of the write services
+		Capture        Capture     `mapstructure:"capture"`         // Request capture configuration
+		DecisionLog    DecisionLog `mapstructure:"decision_log"`    // Decision log configuration
+		Anomaly        Anomaly     `mapstructure:"anomaly"`         // Anomaly detection configuration
+		Limits         Limits      `mapstructure:"limits"`          // Size limits of the requests
+	}
+
+	// Limits contains configuration for rejecting the requests larger than a single client should submit, before
+	// they are evaluated. Zero limits are not enforced.
+	Limits struct {
+		MaxContextualTuples int `mapstructure:"max_contextual_tuples"` // Contextual tuples and attributes of a request
+		MaxBatchSize        int `mapstructure:"max_batch_size"`        // Tuples and attributes written by a request, across its operations
+		MaxFilterComplexity int `mapstructure:"max_filter_complexity"` // Entity and subject identifiers and attribute names of the filters of a request
 	}
 
 	// Watch contains configuration for the watch service.
 	Watch struct {
-		Enabled bool `mapstructure:"enabled"`
+		Enabled            bool          `mapstructure:"enabled"`
+		KeepaliveInterval  time.Duration `mapstructure:"keepalive_interval"`   // Interval of the keepalive messages sent while there are no changes, none if zero
+		BufferSize         int           `mapstructure:"buffer_size"`          // Number of changes buffered by each stream for the client
+		SlowConsumerPolicy string        `mapstructure:"slow_consumer_policy"` // What happens once the buffer of a stream is full, disconnect or drop
+		Hub                WatchHub      `mapstructure:"hub"`                  // Fan-out of the changes of each tenant to the streams of the node
+	}
+
+	// WatchHub contains configuration for reading the changes of each tenant once for all the watch streams of the
+	// node, instead of once for each of them.
+	WatchHub struct {
+		Enabled     bool `mapstructure:"enabled"`      // Whether the streams of the node share the changes read
+		HistorySize int  `mapstructure:"history_size"` // Number of the last changes of each tenant kept for the streams starting from them
+	}
+
+	// Capture contains configuration for recording a sample of the check and lookup requests, to be replayed with
+	// permify replay.
+	Capture struct {
+		Enabled    bool    `mapstructure:"enabled"`     // Whether requests are captured
+		Path       string  `mapstructure:"path"`        // File the captured requests are appended to
+		SampleRate float64 `mapstructure:"sample_rate"` // Share of the requests that are captured, between 0 and 1
+	}
+
+	// DecisionLog contains configuration for logging the decisions of permission checks to an external store in
+	// batches, for analytics such as the permissions denied the most.
+	DecisionLog struct {
+		Enabled       bool          `mapstructure:"enabled"`        // Whether decisions are logged
+		SampleRate    float64       `mapstructure:"sample_rate"`    // Share of the checks logged, between 0 and 1, 1 to log all of them
+		Sink          string        `mapstructure:"sink"`           // Store decisions are written to: object, clickhouse or bigquery
+		Location      string        `mapstructure:"location"`       // Directory, s3:// or gs:// prefix the object sink writes batches to
+		BatchSize     int           `mapstructure:"batch_size"`     // Maximum number of decisions written at once
+		FlushInterval time.Duration `mapstructure:"flush_interval"` // Maximum duration decisions wait before they are written
+		QueueSize     int           `mapstructure:"queue_size"`     // Number of decisions waiting to be written, more are dropped
+		ClickHouse    ClickHouse    `mapstructure:"clickhouse"`     // ClickHouse sink configuration
+		BigQuery      BigQuery      `mapstructure:"bigquery"`       // BigQuery sink configuration
+	}
+
+	// ClickHouse contains configuration for writing decisions to a ClickHouse table through its HTTP interface.
+	ClickHouse struct {
+		URL      string `mapstructure:"url"`      // URL of the HTTP interface, e.g. http://localhost:8123
+		Table    string `mapstructure:"table"`    // Table decisions are inserted into
+		Username string `mapstructure:"username"` // Username to authenticate with, if any
+		Password string `mapstructure:"password"` // Password to authenticate with, if any
+	}
+
+	// BigQuery contains configuration for streaming decisions to a BigQuery table. Credentials are read from the
+	// environment.
+	BigQuery struct {
+		Project string `mapstructure:"project"` // Project of the dataset
+		Dataset string `mapstructure:"dataset"` // Dataset of the table
+		Table   string `mapstructure:"table"`   // Table decisions are inserted into
+	}
+
+	// Anomaly contains configuration for flagging unusual patterns in the decisions of the checks and the changes
+	// written, such as sudden grant spikes or privilege escalation paths, and alerting through a webhook.
+	Anomaly struct {
+		Enabled     bool       `mapstructure:"enabled"`      // Whether decisions and changes are analyzed
+		QueueSize   int        `mapstructure:"queue_size"`   // Number of decisions and changes waiting to be analyzed, more are dropped
+		Webhook     Webhook    `mapstructure:"webhook"`      // Webhook alerts are posted to, alerts are only logged without one
+		GrantSpike  Spike      `mapstructure:"grant_spike"`  // Spikes of the relationships written by tenant
+		AccessSpike Spike      `mapstructure:"access_spike"` // Spikes of the checks allowed by subject
+		Escalation  Escalation `mapstructure:"escalation"`   // Grants of privileged relations
+	}
+
+	// Webhook contains configuration for posting alerts to a URL.
+	Webhook struct {
+		URL     string        `mapstructure:"url"`     // URL alerts are posted to as JSON
+		Secret  string        `mapstructure:"secret"`  // Secret the bodies are signed with in the X-Permify-Signature header, if any
+		Timeout time.Duration `mapstructure:"timeout"` // Maximum duration of a request
+	}
+
+	// Spike contains configuration for flagging counts much higher than the moving average of the previous windows.
+	Spike struct {
+		Enabled bool          `mapstructure:"enabled"` // Whether spikes are flagged
+		Window  time.Duration `mapstructure:"window"`  // Duration of the windows counts are compared in
+		Min     int           `mapstructure:"min"`     // Minimum count of a spike
+		Factor  float64       `mapstructure:"factor"`  // Minimum ratio of the count of a spike to the moving average
+	}
+
+	// Escalation contains configuration for flagging the grants of privileged relations.
+	Escalation struct {
+		Enabled   bool     `mapstructure:"enabled"`   // Whether grants of privileged relations are flagged
+		Relations []string `mapstructure:"relations"` // Privileged relations, as entity type#relation, e.g. organization#admin
 	}
 
 	// Schema contains configuration for the schema service.
 	Schema struct {
-		Cache Cache `mapstructure:"cache"` // Cache configuration for the schema service
+		Cache       Cache  `mapstructure:"cache"`       // Cache configuration for the schema service
+		Deduplicate bool   `mapstructure:"deduplicate"` // Whether writes of the schema the latest version holds return that version instead of writing a new one
+		Base        string `mapstructure:"base"`        // Tenant holding the base schema the schemas of the other tenants extend, none if empty
+		Bundle      Bundle `mapstructure:"bundle"`      // Bundle configuration for the schema service
+		Git         Git    `mapstructure:"git"`         // Git sync configuration for the schema service
+	}
+
+	// Bundle contains configuration for the bundles applied with the schema service.
+	Bundle struct {
+		PublicKeys []string `mapstructure:"public_keys"` // Base64 encoded Ed25519 public keys bundles must be signed with, any bundle is accepted if empty
+	}
+
+	// Git contains configuration for syncing the schemas of tenants from a Git repository.
+	Git struct {
+		Enabled  bool          `mapstructure:"enabled"`  // Whether to sync schemas from the repository
+		URL      string        `mapstructure:"url"`      // URL of the repository
+		Branch   string        `mapstructure:"branch"`   // Branch the schema is read from
+		Path     string        `mapstructure:"path"`     // Schema file, or directory of .perm files, in the repository
+		Interval time.Duration `mapstructure:"interval"` // Interval between pulls of the repository
+		Tenants  []string      `mapstructure:"tenants"`  // Tenants the schema is applied to
+		Username string        `mapstructure:"username"` // Username of HTTP basic authentication, if any
+		Password string        `mapstructure:"password"` // Password or access token of HTTP basic authentication, if any
 	}
 
 	// Permission contains configuration for the permission service.
 	Permission struct {
-		BulkLimit        int   `mapstructure:"bulk_limit"`        // Limit for bulk operations
-		ConcurrencyLimit int   `mapstructure:"concurrency_limit"` // Limit for concurrent operations
-		Cache            Cache `mapstructure:"cache"`             // Cache configuration for the permission service
+		BulkLimit        int           `mapstructure:"bulk_limit"`        // Limit for bulk operations
+		ConcurrencyLimit int           `mapstructure:"concurrency_limit"` // Limit for concurrent operations
+		ExpandMaxNodes   int           `mapstructure:"expand_max_nodes"`  // Limit for the nodes of expansion trees, beyond which they are pruned (0 for no limit)
+		CheckTimeout     time.Duration `mapstructure:"check_timeout"`     // Maximum duration of the evaluation of a check, whatever the deadline of the client (0 for no limit)
+		Cache            Cache         `mapstructure:"cache"`             // Cache configuration for the permission service
+		Shadow           Shadow        `mapstructure:"shadow"`            // Shadow evaluation configuration for the permission service
+		Decision         Decision      `mapstructure:"decision"`          // OPA-style decision configuration for the permission service
+	}
+
+	// Decision contains configuration for the OPA-style decisions of the permission service, checking the permission
+	// the translation table maps the action of a decision to.
+	Decision struct {
+		Tenant  string           `mapstructure:"tenant"`  // Tenant of the decisions not setting one
+		Depth   int32            `mapstructure:"depth"`   // Depth of the checks of the decisions
+		Actions []DecisionAction `mapstructure:"actions"` // Translation table of the actions, which are checked as the permission of the same name if not listed
+	}
+
+	// DecisionAction maps an action on the resources of a type, or of all the types, to a permission of the schema.
+	DecisionAction struct {
+		Action       string `mapstructure:"action"`        // Action of the decisions, e.g. read
+		ResourceType string `mapstructure:"resource_type"` // Entity type of the resources, all of them if empty
+		Permission   string `mapstructure:"permission"`    // Permission checked, e.g. view
+	}
+
+	// Shadow contains configuration for evaluating the checks of tenants against a candidate schema version as well,
+	// serving the answers of the active one and reporting where they diverge.
+	Shadow struct {
+		Enabled          bool              `mapstructure:"enabled"`           // Whether checks are evaluated against the candidate schema versions
+		Candidates       map[string]string `mapstructure:"candidates"`        // Candidate schema versions by tenant
+		ConcurrencyLimit int               `mapstructure:"concurrency_limit"` // Limit for shadow checks running at once
+	}
+
+	// Data contains configuration for the data service.
+	Data struct {
+		StrictValidation StrictValidation `mapstructure:"strict_validation"` // Strict validation configuration for the writes of the data service
 	}
 
-	// Data is a placeholder struct for the data service configuration.
-	Data struct{}
+	// StrictValidation contains configuration for validating the relationships and attributes written to tenants
+	// against their head schema version, on top of the schema version of the request.
+	StrictValidation struct {
+		Enabled bool     `mapstructure:"enabled"` // Whether writes are validated against the head schema version of the tenants
+		Tenants []string `mapstructure:"tenants"` // Tenants validated strictly, all tenants if empty
+		Exempt  []string `mapstructure:"exempt"`  // Tenants not validated strictly, for backward compatibility
+	}
+
+	// Idempotency contains configuration for remembering the responses of the writes carrying idempotency keys, so
+	// that their retries are not applied again.
+	Idempotency struct {
+		Enabled bool          `mapstructure:"enabled"`  // Whether the idempotency keys of the requests are honored
+		TTL     time.Duration `mapstructure:"ttl"`      // How long the response of a request is kept for its retries
+		MaxKeys int           `mapstructure:"max_keys"` // Number of keys kept at most, the oldest being forgotten first
+		Redis   bool          `mapstructure:"redis"`    // Whether the responses are kept in the Redis of server.rate_limit_redis, for all the replicas
+		Key     string        `mapstructure:"key"`      // Prefix of the Redis keys the responses are kept at
+	}
 
 	// Cache contains configuration for caching.
 	Cache struct {
@@ -135,14 +469,67 @@ type (
 
 	// Database contains configuration for the database.
 	Database struct {
-		Engine                string            `mapstructure:"engine"`                  // Database engine type (e.g., "postgres" or "memory")
+		Engine                string            `mapstructure:"engine"`                  // Database engine type (e.g., "postgres", "dynamodb", "mongodb", "spanner", "memory" or a registered custom engine)
 		URI                   string            `mapstructure:"uri"`                     // Database connection URI
 		AutoMigrate           bool              `mapstructure:"auto_migrate"`            // Whether to enable automatic migration
+		MigrationLockTimeout  time.Duration     `mapstructure:"migration_lock_timeout"`  // Maximum duration to wait for the migrations of other replicas to finish, 0 for no limit
+		Preflight             bool              `mapstructure:"preflight"`               // Whether to refuse starting against databases migrated to versions incompatible with this one
 		MaxOpenConnections    int               `mapstructure:"max_open_connections"`    // Maximum number of open connections to the database
 		MaxIdleConnections    int               `mapstructure:"max_idle_connections"`    // Maximum number of idle connections to the database
 		MaxConnectionLifetime time.Duration     `mapstructure:"max_connection_lifetime"` // Maximum duration a connection can be reused
 		MaxConnectionIdleTime time.Duration     `mapstructure:"max_connection_idle_time"`
+		QueryExecMode         string            `mapstructure:"query_exec_mode"`      // Mode postgres queries are executed with, cache_statement preparing their statements once per connection
+		StatementCacheSize    int               `mapstructure:"statement_cache_size"` // Maximum number of statements cached on each postgres connection
+		BatchReads            BatchReads        `mapstructure:"batch_reads"`          // Batching of the reads of the relationships of entities fanned out to by checks
+		ExistenceFilter       ExistenceFilter   `mapstructure:"existence_filter"`     // Bloom filters of the relations of entities answering the reads of the absent ones
 		GarbageCollection     GarbageCollection `mapstructure:"garbage_collection"`
+		Regions               []DatabaseRegion  `mapstructure:"regions"`               // Databases of the regions tenants are kept in, in place of this one
+		RegionCheckInterval   time.Duration     `mapstructure:"region_check_interval"` // Interval between the health checks of the regions and the refreshes of the tenants kept in them
+		Encryption            Encryption        `mapstructure:"encryption"`            // Encryption of the identifiers of entities and subjects at rest
+	}
+
+	// Encryption contains configuration for the deterministic encryption of the identifiers of entities and subjects
+	// before they are stored, so the database doesn't reveal who relates to what.
+	Encryption struct {
+		Enabled bool   `mapstructure:"enabled"` // Whether identifiers are encrypted
+		Key     string `mapstructure:"key"`     // Base64 key of 32 bytes identifiers are encrypted with, or the key wrapped by the KMS
+		KMS     KMS    `mapstructure:"kms"`     // Key management service unwrapping the key
+	}
+
+	// KMS contains configuration for the key management service unwrapping encryption keys.
+	KMS struct {
+		Provider string `mapstructure:"provider"` // Key management service, "vault" for the transit engine of HashiCorp Vault, none if empty
+		Address  string `mapstructure:"address"`  // Address of the key management service
+		Token    string `mapstructure:"token"`    // Token to authenticate to the key management service with
+		Mount    string `mapstructure:"mount"`    // Mount path of the transit engine, transit if empty
+		KeyName  string `mapstructure:"key_name"` // Name of the key wrapping the encryption key
+	}
+
+	// BatchReads contains configuration for reading the relationships of entities of the same type and relation
+	// requested while a read of them is in flight with a single query.
+	BatchReads struct {
+		Enabled bool `mapstructure:"enabled"`  // Whether the reads are batched
+		MaxSize int  `mapstructure:"max_size"` // Maximum number of entities read by a single query
+	}
+
+	// ExistenceFilter contains configuration for the bloom filters of the relations of the entities of each tenant,
+	// kept by each node to answer the reads of the relationships of entities that definitely have none.
+	ExistenceFilter struct {
+		Enabled           bool    `mapstructure:"enabled"`             // Whether the reads of the absent relations are answered by the filters
+		Capacity          int     `mapstructure:"capacity"`            // Number of relations of entities the filter of a tenant is sized for at least
+		FalsePositiveRate float64 `mapstructure:"false_positive_rate"` // Rate of the reads of absent relations still read from the database
+	}
+
+	// DatabaseRegion contains the database of a region, which keeps the data and schemas of its tenants. It runs the
+	// engine of the default database, and its connection settings unless they are set.
+	DatabaseRegion struct {
+		Name                  string        `mapstructure:"name"`                    // Name of the region, e.g. eu
+		URI                   string        `mapstructure:"uri"`                     // Database connection URI of the region
+		Tenants               []string      `mapstructure:"tenants"`                 // Tenants pinned to the region, other tenants are assigned to it when they are created
+		MaxOpenConnections    int           `mapstructure:"max_open_connections"`    // Maximum number of open connections to the database of the region
+		MaxIdleConnections    int           `mapstructure:"max_idle_connections"`    // Maximum number of idle connections to the database of the region
+		MaxConnectionLifetime time.Duration `mapstructure:"max_connection_lifetime"` // Maximum duration a connection to the database of the region can be reused
+		MaxConnectionIdleTime time.Duration `mapstructure:"max_connection_idle_time"`
 	}
 
 	GarbageCollection struct {
@@ -153,12 +540,126 @@ type (
 	}
 
 	Distributed struct {
-		Enabled bool   `mapstructure:"enabled"`
-		Address string `mapstructure:"address"`
-		Port    string `mapstructure:"port"`
+		Enabled      bool              `mapstructure:"enabled"`
+		Address      string            `mapstructure:"address"`
+		Port         string            `mapstructure:"port"`
+		TLS          DistributedTLS    `mapstructure:"tls"`           // Mutual TLS of the dispatches between the nodes
+		SharedSecret string            `mapstructure:"shared_secret"` // Secret the nodes authenticate their dispatches with
+		Invoke       DistributedInvoke `mapstructure:"invoke"`        // Options of the invoke server the peers dispatch to
+		Ring         DistributedRing   `mapstructure:"ring"`          // Consistent hash ring the checks are dispatched with
+		Peers        DistributedPeers  `mapstructure:"peers"`         // Connections to the peers the checks are dispatched to
+	}
+
+	// DistributedPeers contains the configuration of the connections to the peers, kept open and warm between the
+	// dispatches.
+	DistributedPeers struct {
+		HealthCheck      bool                        `mapstructure:"health_check"`      // Whether the peers are health checked, the unhealthy ones leaving the ring
+		KeepaliveTime    time.Duration               `mapstructure:"keepalive_time"`    // Interval of the pings keeping the idle connections warm, 0 to disable them
+		KeepaliveTimeout time.Duration               `mapstructure:"keepalive_timeout"` // Time a ping waits to be acknowledged before its connection is closed
+		OutlierDetection DistributedOutlierDetection `mapstructure:"outlier_detection"` // Ejection of the peers failing consecutive dispatches
+	}
+
+	// DistributedOutlierDetection contains the configuration of the ejection of the peers failing consecutive
+	// dispatches, whose keys are dispatched to the next peers of the ring while they are ejected.
+	DistributedOutlierDetection struct {
+		Enabled             bool          `mapstructure:"enabled"`
+		ConsecutiveFailures int           `mapstructure:"consecutive_failures"` // Number of consecutive failed dispatches ejecting a peer
+		EjectionTime        time.Duration `mapstructure:"ejection_time"`        // How long a peer stays ejected
+	}
+
+	// DistributedRing contains the configuration of the consistent hash ring placing the checks on the nodes.
+	DistributedRing struct {
+		VirtualNodes int    `mapstructure:"virtual_nodes"` // Number of virtual nodes of each node, spreading the keys more evenly
+		Hash         string `mapstructure:"hash"`          // Hash function of the ring: md5, sha256 or xxhash
+	}
+
+	// DistributedInvoke contains the options of the invoke server, independent of the ones of the client traffic.
+	DistributedInvoke struct {
+		Address        string `mapstructure:"address"`          // Address the invoke server binds to, all the interfaces if not set
+		Validation     bool   `mapstructure:"validation"`       // Whether the dispatched requests are validated again
+		MaxMessageSize int    `mapstructure:"max_message_size"` // Maximum size in bytes of the messages of the dispatches
+		Deduplication  bool   `mapstructure:"deduplication"`    // Whether the same checks dispatched by several peers at once are evaluated once
+	}
+
+	// DistributedTLS contains the mutual TLS configuration of the dispatches between the nodes of a cluster. It is
+	// separate from the TLS configuration of the client traffic, which the invoke server uses if it is not enabled.
+	DistributedTLS struct {
+		Enabled    bool   `mapstructure:"enabled"`
+		CertPath   string `mapstructure:"cert"`        // Certificate the node presents to its peers, as a server and as a client
+		KeyPath    string `mapstructure:"key"`         // Private key of the certificate
+		CAPath     string `mapstructure:"ca"`          // CA the certificates of the peers are verified against
+		ServerName string `mapstructure:"server_name"` // Name the certificates of the peers are verified for, the host of the address if not set
+	}
+
+	// Chaos contains configuration for injecting faults into storage calls and peer dispatches. It is meant for
+	// validating timeouts and retries in test environments, and must never be enabled in production.
+	Chaos struct {
+		Enabled  bool  `mapstructure:"enabled"`  // Whether faults are injected
+		Storage  Fault `mapstructure:"storage"`  // Faults injected into storage calls
+		Dispatch Fault `mapstructure:"dispatch"` // Faults injected into checks dispatched to peers
+	}
+
+	// Replication contains configuration for the regions of a multi-region deployment. Writes are accepted in the
+	// write region, and the other regions apply its changes to their databases asynchronously.
+	Replication struct {
+		Enabled               bool          `mapstructure:"enabled"`                  // Whether the deployment is a region of a replicated deployment
+		Region                string        `mapstructure:"region"`                   // Label of the region of the deployment, e.g. eu-west-1
+		WriteRegion           string        `mapstructure:"write_region"`             // Region accepting writes, which the other regions replicate
+		Source                string        `mapstructure:"source"`                   // gRPC address of the write region the other regions read its changes from
+		Key                   string        `mapstructure:"key"`                      // Preshared key to authenticate to the write region with, if any
+		TLSConfig             TLSConfig     `mapstructure:"tls"`                      // TLS configuration for the connection to the write region
+		Tenants               []string      `mapstructure:"tenants"`                  // Tenants replicated
+		StatePath             string        `mapstructure:"state_path"`               // File recording the last change of the write region applied for each tenant
+		HistorySize           int           `mapstructure:"history_size"`             // Number of changes applied kept by tenant to map the tokens of engines without ordered tokens
+		ReadYourWritesTimeout time.Duration `mapstructure:"read_your_writes_timeout"` // Maximum duration to wait for the snapshot of a request to be replicated
+	}
+
+	// Fault contains the latency and errors injected into a percentage of calls.
+	Fault struct {
+		Latency           time.Duration `mapstructure:"latency"`            // Latency added to the delayed calls
+		LatencyPercentage float64       `mapstructure:"latency_percentage"` // Percentage of calls that are delayed
+		ErrorPercentage   float64       `mapstructure:"error_percentage"`   // Percentage of calls that fail
 	}
 )
 
+const (
+	// ProfileDev is the profile of development deployments, exposing the internals of the server
+	ProfileDev = "dev"
+	// ProfileProd is the profile of production deployments, exposing as little of the server as possible
+	ProfileProd = "prod"
+)
+
+// profiles are the defaults of the settings exposing the internals of the server by profile. Settings configured in
+// the file, the flags or the environment override them.
+var profiles = map[string]map[string]interface{}{
+	ProfileDev: {
+		"server.grpc.reflection": true,
+		"server.verbose_errors":  true,
+		"profiler.enabled":       true,
+	},
+	ProfileProd: {
+		"server.grpc.reflection": false,
+		"server.verbose_errors":  false,
+		"profiler.enabled":       false,
+	},
+}
+
+// applyProfile sets the defaults of the profile of the configuration read, if it has one.
+func applyProfile() error {
+	profile := viper.GetString("profile")
+	if profile == "" {
+		return nil
+	}
+	defaults, ok := profiles[profile]
+	if !ok {
+		return fmt.Errorf("unknown profile %s, expected %s or %s", profile, ProfileDev, ProfileProd)
+	}
+	for key, value := range defaults {
+		viper.SetDefault(key, value)
+	}
+	return nil
+}
+
 // NewConfig initializes and returns a new Config object by reading and unmarshalling
 // the configuration file from the given path. It falls back to the DefaultConfig if the
 // file is not found. If there's an error during the process, it returns the error.
@@ -185,6 +686,11 @@ func NewConfig() (*Config, error) {
 		// If it's a "file not found" error, the code will continue and use the default config
 	}
 
+	// Apply the defaults of the profile, overridden by the settings configured explicitly
+	if err = applyProfile(); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal the configuration data into the Config struct
 	if err = viper.Unmarshal(cfg); err != nil {
 		// If there's an error during unmarshalling, return the error with a message
@@ -225,6 +731,11 @@ func NewConfigWithFile(dir string) (*Config, error) {
 		// If it's a "file not found" error, the code will continue and use the default config
 	}
 
+	// Apply the defaults of the profile, overridden by the settings configured explicitly
+	if err = applyProfile(); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal the configuration data into the Config struct
 	if err = viper.Unmarshal(cfg); err != nil {
 		// If there's an error during unmarshalling, return the error with a message
@@ -247,65 +758,263 @@ func DefaultConfig() *Config {
 				},
 				CORSAllowedOrigins: []string{"*"},
 				CORSAllowedHeaders: []string{"*"},
+				ReadHeaderTimeout:  5 * time.Second,
+				IdleTimeout:        2 * time.Minute,
+				MaxHeaderBytes:     1 << 20,
 			},
 			GRPC: GRPC{
 				Port: "3478",
 				TLSConfig: TLSConfig{
 					Enabled: false,
 				},
+				Reflection: true,
+			},
+			Gateway: Gateway{
+				Enabled:       false,
+				LoadBalancing: "round_robin",
+				TLSConfig: TLSConfig{
+					Enabled: false,
+				},
+			},
+			RateLimit:     100,
+			VerboseErrors: true,
+			Autocert: Autocert{
+				Enabled:   false,
+				Hostnames: []string{},
+				Cache:     "dir",
+				CacheDir:  "certs",
+			},
+			ExtAuthz: ExtAuthz{
+				Enabled:        false,
+				Tenant:         "t1",
+				Depth:          20,
+				AllowUnmatched: false,
+				Rules:          []ExtAuthzRule{},
+			},
+			RateLimitWeights: []RateLimitWeight{
+				{Method: "/base.v1.Permission/Expand", Weight: 5},
+				{Method: "/base.v1.Permission/LookupEntity", Weight: 10},
+				{Method: "/base.v1.Permission/LookupEntityStream", Weight: 10},
+				{Method: "/base.v1.Permission/LookupEntityWithPermissions", Weight: 20},
+				{Method: "/base.v1.Permission/LookupSubject", Weight: 10},
+				{Method: "/base.v1.Permission/SubjectPermission", Weight: 5},
+				{Method: "/base.v1.Permission/Paths", Weight: 10},
+			},
+			RateLimitRedis: RateLimitRedis{
+				Enabled:  false,
+				Address:  "localhost:6379",
+				Key:      "permify:rate_limit",
+				Replicas: 1,
 			},
-			RateLimit: 100,
 		},
 		Profiler: Profiler{
 			Enabled: false,
+			Push: ProfilerPush{
+				Enabled:         false,
+				ApplicationName: "permify",
+			},
 		},
 		Log: Log{
 			Level: "info",
+			Redaction: Redaction{
+				Mode:   "none",
+				Length: 3,
+			},
 		},
 		Tracer: Tracer{
-			Enabled: false,
+			Enabled:       false,
+			SamplingRatio: 1,
 		},
 		Meter: Meter{
 			Enabled:  true,
 			Exporter: "otlp",
 			Endpoint: "telemetry.permify.co",
+			SLO: SLO{
+				Enabled: false,
+				Objectives: []Objective{
+					{
+						Method:        "*",
+						Availability:  0.999,
+						Latency:       100 * time.Millisecond,
+						LatencyTarget: 0.99,
+					},
+				},
+			},
 		},
 		Service: Service{
 			CircuitBreaker: false,
 			Watch: Watch{
-				Enabled: false,
+				Enabled:            false,
+				KeepaliveInterval:  30 * time.Second,
+				BufferSize:         100,
+				SlowConsumerPolicy: "disconnect",
+				Hub: WatchHub{
+					Enabled:     true,
+					HistorySize: 1000,
+				},
+			},
+			Capture: Capture{
+				Enabled:    false,
+				Path:       "capture.jsonl",
+				SampleRate: 0.01,
+			},
+			DecisionLog: DecisionLog{
+				Enabled:       false,
+				SampleRate:    1,
+				Sink:          "object",
+				Location:      "decisions",
+				BatchSize:     1000,
+				FlushInterval: 10 * time.Second,
+				QueueSize:     10000,
+				ClickHouse: ClickHouse{
+					Table: "permify_decisions",
+				},
+			},
+			Anomaly: Anomaly{
+				Enabled:   false,
+				QueueSize: 10000,
+				Webhook: Webhook{
+					Timeout: 5 * time.Second,
+				},
+				GrantSpike: Spike{
+					Enabled: true,
+					Window:  time.Minute,
+					Min:     100,
+					Factor:  10,
+				},
+				AccessSpike: Spike{
+					Enabled: true,
+					Window:  time.Minute,
+					Min:     1000,
+					Factor:  10,
+				},
+				Escalation: Escalation{
+					Enabled:   false,
+					Relations: []string{},
+				},
+			},
+			Limits: Limits{
+				MaxContextualTuples: 0,
+				MaxBatchSize:        0,
+				MaxFilterComplexity: 0,
 			},
 			Schema: Schema{
 				Cache: Cache{
 					NumberOfCounters: 1_000,
 					MaxCost:          "10MiB",
 				},
+				Deduplicate: true,
+				Git: Git{
+					Enabled:  false,
+					Branch:   "main",
+					Path:     "schema.perm",
+					Interval: time.Minute,
+					Tenants:  []string{"t1"},
+				},
 			},
 			Permission: Permission{
 				BulkLimit:        100,
 				ConcurrencyLimit: 100,
+				ExpandMaxNodes:   0,
+				CheckTimeout:     0,
 				Cache: Cache{
 					NumberOfCounters: 10_000,
 					MaxCost:          "10MiB",
 				},
+				Shadow: Shadow{
+					Enabled:          false,
+					Candidates:       map[string]string{},
+					ConcurrencyLimit: 10,
+				},
+				Decision: Decision{
+					Tenant:  "t1",
+					Depth:   20,
+					Actions: []DecisionAction{},
+				},
+			},
+			Data: Data{
+				StrictValidation: StrictValidation{
+					Enabled: false,
+					Tenants: []string{},
+					Exempt:  []string{},
+				},
+			},
+			Idempotency: Idempotency{
+				Enabled: true,
+				TTL:     time.Hour,
+				MaxKeys: 10000,
+				Redis:   false,
+				Key:     "permify:idempotency",
 			},
-			Data: Data{},
 		},
 		Authn: Authn{
 			Enabled:   false,
 			Preshared: Preshared{},
 			Oidc:      Oidc{},
+			Spiffe:    Spiffe{},
 		},
 		Database: Database{
-			Engine:      "memory",
-			AutoMigrate: true,
+			Engine:               "memory",
+			AutoMigrate:          true,
+			MigrationLockTimeout: 5 * time.Minute,
+			Preflight:            true,
+			QueryExecMode:        "cache_statement",
+			StatementCacheSize:   512,
+			BatchReads: BatchReads{
+				Enabled: true,
+				MaxSize: 100,
+			},
+			ExistenceFilter: ExistenceFilter{
+				Enabled:           false,
+				Capacity:          100000,
+				FalsePositiveRate: 0.01,
+			},
 			GarbageCollection: GarbageCollection{
 				Enabled: false,
 			},
+			RegionCheckInterval: 30 * time.Second,
 		},
 		Distributed: Distributed{
 			Enabled: false,
 			Port:    "5000",
+			Invoke: DistributedInvoke{
+				Validation:     false,
+				MaxMessageSize: 16 << 20,
+				Deduplication:  true,
+			},
+			Ring: DistributedRing{
+				VirtualNodes: 100,
+				Hash:         "md5",
+			},
+			Peers: DistributedPeers{
+				HealthCheck:      true,
+				KeepaliveTime:    30 * time.Second,
+				KeepaliveTimeout: 10 * time.Second,
+				OutlierDetection: DistributedOutlierDetection{
+					Enabled:             true,
+					ConsecutiveFailures: 5,
+					EjectionTime:        30 * time.Second,
+				},
+			},
+		},
+		Chaos: Chaos{
+			Enabled: false,
+		},
+		Reload: Reload{
+			Enabled: false,
+		},
+		Diagnostics: Diagnostics{
+			Enabled:                  true,
+			Timeout:                  10 * time.Second,
+			MaxClockSkew:             10 * time.Second,
+			CertificateExpiryWarning: 30 * 24 * time.Hour,
+		},
+		Replication: Replication{
+			Enabled:               false,
+			Tenants:               []string{"t1"},
+			StatePath:             "replication.json",
+			HistorySize:           1000,
+			ReadYourWritesTimeout: 5 * time.Second,
 		},
 	}
 }
@@ -202,6 +202,71 @@ logger:
 	assert.Equal(t, "debug", cfg.Log.Level)
 }
 
+func TestNewConfigWithFile_CustomAuthnMethod(t *testing.T) {
+	configContent := []byte(`
+authn:
+  enabled: true
+  method: corporate-sso
+  corporate-sso:
+    header: X-Forwarded-User
+`)
+
+	// Create a temporary directory
+	tmpDir, err := os.MkdirTemp("", "new-config-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir) // Clean up after the test
+
+	// Create a temporary config file
+	tmpFile := filepath.Join(tmpDir, "config.yaml")
+	err = os.WriteFile(tmpFile, configContent, 0o666)
+	assert.NoError(t, err)
+
+	cfg, err := NewConfigWithFile(tmpFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "corporate-sso", cfg.Authn.Method)
+	settings, ok := cfg.Authn.Providers["corporate-sso"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "X-Forwarded-User", settings["header"])
+}
+
+func TestNewConfigWithFile_CORSPolicies(t *testing.T) {
+	configContent := []byte(`
+server:
+  http:
+    cors_max_age: 300
+    cors_policies:
+      - path_prefix: /v1/schemas
+        allowed_origins: ["https://admin.example.com"]
+        allowed_origin_patterns: ["^https://.*\\.example\\.com$"]
+        allow_credentials: false
+        max_age: 600
+`)
+
+	// Create a temporary directory
+	tmpDir, err := os.MkdirTemp("", "new-config-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir) // Clean up after the test
+
+	// Create a temporary config file
+	tmpFile := filepath.Join(tmpDir, "config.yaml")
+	err = os.WriteFile(tmpFile, configContent, 0o666)
+	assert.NoError(t, err)
+
+	cfg, err := NewConfigWithFile(tmpFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 300, cfg.Server.HTTP.CORSMaxAge)
+	assert.Len(t, cfg.Server.HTTP.CORSPolicies, 1)
+	policy := cfg.Server.HTTP.CORSPolicies[0]
+	assert.Equal(t, "/v1/schemas", policy.PathPrefix)
+	assert.Equal(t, []string{"https://admin.example.com"}, policy.AllowedOrigins)
+	assert.Equal(t, []string{`^https://.*\.example\.com$`}, policy.AllowedOriginPatterns)
+	assert.NotNil(t, policy.AllowCredentials)
+	assert.False(t, *policy.AllowCredentials)
+	assert.Equal(t, 600, policy.MaxAge)
+}
+
 func TestNewConfigWithFile_InvalidConfig(t *testing.T) {
 	configContent := []byte(`
 invalid config
@@ -221,3 +286,38 @@ invalid config
 	assert.Nil(t, cfg)
 	assert.Error(t, err)
 }
+
+func TestNewConfigWithFile_Profile(t *testing.T) {
+	configContent := []byte(`
+profile: prod
+profiler:
+  enabled: true
+`)
+
+	// Create a temporary directory
+	tmpDir, err := os.MkdirTemp("", "new-config-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tmpDir) // Clean up after the test
+	// The defaults of the profile are kept by viper
+	t.Cleanup(viper.Reset)
+
+	// Create a temporary config file
+	tmpFile := filepath.Join(tmpDir, "config.yaml")
+	err = os.WriteFile(tmpFile, configContent, 0o666)
+	assert.NoError(t, err)
+
+	cfg, err := NewConfigWithFile(tmpFile)
+	assert.NoError(t, err)
+
+	// The settings of the profile, overridden by the ones of the file
+	assert.Equal(t, ProfileProd, cfg.Profile)
+	assert.False(t, cfg.Server.GRPC.Reflection)
+	assert.False(t, cfg.Server.VerboseErrors)
+	assert.True(t, cfg.Profiler.Enabled)
+
+	err = os.WriteFile(tmpFile, []byte("profile: staging\n"), 0o666)
+	assert.NoError(t, err)
+
+	_, err = NewConfigWithFile(tmpFile)
+	assert.Error(t, err)
+}
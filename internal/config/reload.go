@@ -0,0 +1,144 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadDebounce is how long the reloader waits for the writes of the configuration file to settle, since editors
+// and config maps change it with several of them.
+const reloadDebounce = 200 * time.Millisecond
+
+// ReloadFunc - Validates the settings of the next configuration it reloads, compared to the previous one, and
+// returns the function applying them. It returns an error, without applying anything, if they are not valid.
+type ReloadFunc func(previous, next *Config) (apply func(), err error)
+
+// Reloader - Reloads a subset of the configuration while the server runs, when the configuration file changes or
+// the process receives SIGHUP. The settings are reloaded by the reload functions, and a configuration that fails to
+// load or that any of them rejects is not applied at all, keeping the previous one active.
+type Reloader struct {
+	file  string
+	load  func() (*Config, error)
+	funcs []ReloadFunc
+
+	mu      sync.Mutex
+	current *Config
+}
+
+// NewReloader - Creates a new reloader of the configuration loaded by load from the file, starting from the
+// current configuration. The file is not watched if it is empty.
+func NewReloader(current *Config, file string, load func() (*Config, error), funcs ...ReloadFunc) *Reloader {
+	return &Reloader{
+		file:    file,
+		load:    load,
+		funcs:   funcs,
+		current: current,
+	}
+}
+
+// Reload - Loads the configuration and applies it with the reload functions, unless it fails to load or any of
+// them rejects it.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	applies := make([]func(), 0, len(r.funcs))
+	for _, fn := range r.funcs {
+		apply, err := fn(r.current, next)
+		if err != nil {
+			return err
+		}
+		applies = append(applies, apply)
+	}
+	for _, apply := range applies {
+		apply()
+	}
+	r.current = next
+	return nil
+}
+
+// Run - Reloads the configuration when the file changes or the process receives SIGHUP, until the context is done.
+func (r *Reloader) Run(ctx context.Context) error {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	defer signal.Stop(hangups)
+
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	if r.file != "" {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+
+		// The directory is watched rather than the file, so that the file is still watched after being replaced,
+		// as editors and the config maps of Kubernetes do.
+		if err = watcher.Add(filepath.Dir(r.file)); err != nil {
+			return err
+		}
+		events, errs = watcher.Events, watcher.Errors
+	}
+
+	debounce := time.NewTimer(0)
+	if !debounce.Stop() {
+		<-debounce.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hangups:
+			r.reload("signal")
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if r.changes(event) {
+				debounce.Reset(reloadDebounce)
+			}
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			slog.Warn("failed to watch the configuration file", slog.Any("error", err))
+		case <-debounce.C:
+			r.reload("file change")
+		}
+	}
+}
+
+// changes returns whether the event of the directory of the file may have changed the file.
+func (r *Reloader) changes(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	// Kubernetes replaces the files of config maps by swapping the ..data symbolic link of their directory
+	name := filepath.Base(event.Name)
+	return filepath.Clean(event.Name) == filepath.Clean(r.file) || name == "..data"
+}
+
+// reload reloads the configuration, logging whether it was applied.
+func (r *Reloader) reload(trigger string) {
+	if err := r.Reload(); err != nil {
+		slog.Error("🔁 rejected the reloaded configuration, keeping the previous one", slog.String("trigger", trigger), slog.Any("error", err))
+		return
+	}
+	slog.Info("🔁 reloaded the configuration", slog.String("trigger", trigger))
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader_Reload(t *testing.T) {
+	var loaded []*Config
+	load := func() (*Config, error) {
+		cfg := DefaultConfig()
+		cfg.Server.RateLimit = int64(100 * (len(loaded) + 2))
+		loaded = append(loaded, cfg)
+		return cfg, nil
+	}
+
+	var applied []int64
+	reload := func(previous, next *Config) (func(), error) {
+		if next.Server.RateLimit == 200 {
+			return nil, errors.New("invalid rate limit")
+		}
+		return func() {
+			applied = append(applied, previous.Server.RateLimit, next.Server.RateLimit)
+		}, nil
+	}
+	var calls int
+	other := func(_, _ *Config) (func(), error) {
+		return func() { calls++ }, nil
+	}
+
+	current := DefaultConfig()
+	reloader := NewReloader(current, "", load, other, reload)
+
+	// The configuration rejected by a reload function is not applied by any of them
+	assert.Error(t, reloader.Reload())
+	assert.Zero(t, calls)
+	assert.Empty(t, applied)
+
+	// The next configuration is compared to the last one applied
+	require.NoError(t, reloader.Reload())
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []int64{current.Server.RateLimit, 300}, applied)
+
+	failing := NewReloader(current, "", func() (*Config, error) {
+		return nil, errors.New("failed to load")
+	}, other)
+	assert.Error(t, failing.Reload())
+	assert.Equal(t, 1, calls)
+}
+
+func TestReloader_Run(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("logger:\n  level: info\n"), 0o600))
+
+	var reloads atomic.Int32
+	reloader := NewReloader(DefaultConfig(), file, func() (*Config, error) {
+		return DefaultConfig(), nil
+	}, func(_, _ *Config) (func(), error) {
+		return func() { reloads.Add(1) }, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- reloader.Run(ctx)
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// The writes of the file settling are reloaded once, and the other files of the directory are not watched
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("a: b\n"), 0o600))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, os.WriteFile(file, []byte("logger:\n  level: debug\n"), 0o600))
+	}
+	assert.Eventually(t, func() bool { return reloads.Load() == 1 }, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(2 * reloadDebounce)
+	assert.Equal(t, int32(1), reloads.Load())
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,47 @@
+package decisionlog
+
+import (
+	"time"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Decision - Logged permission check, with its inputs and its outcome. Its fields are flat so that it maps to the
+// columns of a table, and the sample rate it was logged at lets counts be weighted back to all the checks.
+type Decision struct {
+	Time            time.Time `json:"time"`
+	TenantID        string    `json:"tenant_id"`
+	EntityType      string    `json:"entity_type"`
+	EntityID        string    `json:"entity_id"`
+	Permission      string    `json:"permission"`
+	SubjectType     string    `json:"subject_type"`
+	SubjectID       string    `json:"subject_id"`
+	SubjectRelation string    `json:"subject_relation"`
+	// Allowed is the outcome of the check
+	Allowed       bool    `json:"allowed"`
+	SnapToken     string  `json:"snap_token"`
+	SchemaVersion string  `json:"schema_version"`
+	CheckCount    int32   `json:"check_count"`
+	DurationMs    float64 `json:"duration_ms"`
+	SampleRate    float64 `json:"sample_rate"`
+}
+
+// NewDecision - Creates the decision of the check request and its response
+func NewDecision(start time.Time, duration time.Duration, request *base.PermissionCheckRequest, response *base.PermissionCheckResponse, rate float64) Decision {
+	return Decision{
+		Time:            start.UTC(),
+		TenantID:        request.GetTenantId(),
+		EntityType:      request.GetEntity().GetType(),
+		EntityID:        request.GetEntity().GetId(),
+		Permission:      request.GetPermission(),
+		SubjectType:     request.GetSubject().GetType(),
+		SubjectID:       request.GetSubject().GetId(),
+		SubjectRelation: request.GetSubject().GetRelation(),
+		Allowed:         response.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED,
+		SnapToken:       request.GetMetadata().GetSnapToken(),
+		SchemaVersion:   request.GetMetadata().GetSchemaVersion(),
+		CheckCount:      response.GetMetadata().GetCheckCount(),
+		DurationMs:      float64(duration.Microseconds()) / 1000,
+		SampleRate:      rate,
+	}
+}
@@ -0,0 +1,209 @@
+package decisionlog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TestDecisionLog -
+func TestDecisionLog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "decision-log-suite")
+}
+
+// memorySink - Sink keeping the batches written to it
+type memorySink struct {
+	mu      sync.Mutex
+	batches [][]Decision
+	err     error
+	closed  bool
+}
+
+func (s *memorySink) Write(_ context.Context, decisions []Decision) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, append([]Decision(nil), decisions...))
+	return s.err
+}
+
+func (s *memorySink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *memorySink) written() (batches [][]Decision) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(batches, s.batches...)
+}
+
+var _ = Describe("decision log", func() {
+	conf := config.DecisionLog{SampleRate: 1, BatchSize: 2, FlushInterval: time.Hour, QueueSize: 10}
+
+	check := &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "snap", Depth: 20},
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: "edit",
+		Subject:    &base.Subject{Type: "user", Id: "alice"},
+	}
+
+	intercept := func(l *Logger, method string, req interface{}, resp interface{}, err error) {
+		_, _ = l.UnaryServerInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+			return resp, err
+		})
+	}
+
+	Context("Logger", func() {
+		It("Case 1 - Logs the decisions of the sampled checks in batches", func() {
+			sink := &memorySink{}
+			l, err := NewLogger(sink, conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rolls := []float64{0.1, 0.9, 0.2}
+			l.rate = 0.5
+			l.roll = func() float64 {
+				r := rolls[0]
+				rolls = rolls[1:]
+				return r
+			}
+
+			allowed := &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED, Metadata: &base.PermissionCheckResponseMetadata{CheckCount: 3}}
+			intercept(l, base.Permission_Check_FullMethodName, check, allowed, nil)
+			intercept(l, base.Permission_Check_FullMethodName, check, allowed, nil)
+			intercept(l, base.Permission_Check_FullMethodName, check, &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_DENIED}, nil)
+			intercept(l, base.Permission_LookupEntity_FullMethodName, &base.PermissionLookupEntityRequest{}, &base.PermissionLookupEntityResponse{}, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() { done <- l.Start(ctx) }()
+
+			Eventually(sink.written).Should(HaveLen(1))
+			batch := sink.written()[0]
+			Expect(batch).Should(HaveLen(2))
+			Expect(batch[0].TenantID).Should(Equal("t1"))
+			Expect(batch[0].EntityType).Should(Equal("doc"))
+			Expect(batch[0].EntityID).Should(Equal("1"))
+			Expect(batch[0].SubjectID).Should(Equal("alice"))
+			Expect(batch[0].SnapToken).Should(Equal("snap"))
+			Expect(batch[0].Allowed).Should(BeTrue())
+			Expect(batch[0].CheckCount).Should(Equal(int32(3)))
+			Expect(batch[0].SampleRate).Should(Equal(0.5))
+			Expect(batch[1].Allowed).Should(BeFalse())
+
+			cancel()
+			Expect(<-done).ShouldNot(HaveOccurred())
+			Expect(sink.closed).Should(BeTrue())
+		})
+
+		It("Case 2 - Writes the queued decisions when it stops and drops those that don't fit the queue", func() {
+			sink := &memorySink{err: errors.New("unavailable")}
+			l, err := NewLogger(sink, config.DecisionLog{SampleRate: 1, BatchSize: 100, FlushInterval: time.Hour, QueueSize: 3})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := 0; i < 5; i++ {
+				l.Log(Decision{TenantID: "t1"})
+			}
+			Expect(l.dropped.Load()).Should(Equal(int64(2)))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			Expect(l.Start(ctx)).ShouldNot(HaveOccurred())
+			Expect(sink.written()).Should(HaveLen(1))
+			Expect(sink.written()[0]).Should(HaveLen(3))
+			Expect(l.dropped.Load()).Should(BeZero())
+		})
+
+		It("Case 3 - Rejects invalid configurations", func() {
+			_, err := NewLogger(&memorySink{}, config.DecisionLog{SampleRate: 0, BatchSize: 1, FlushInterval: time.Second, QueueSize: 1})
+			Expect(err).Should(HaveOccurred())
+			_, err = NewLogger(&memorySink{}, config.DecisionLog{SampleRate: 1, BatchSize: 0, FlushInterval: time.Second, QueueSize: 1})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Sinks", func() {
+		decisions := []Decision{{TenantID: "t1", Permission: "edit", Allowed: true}, {TenantID: "t1", Permission: "view"}}
+
+		It("Case 1 - Object sink writes batches as JSON lines partitioned by day", func() {
+			dir := GinkgoT().TempDir()
+			sink := NewObjectSink(dir)
+			Expect(sink.Write(context.Background(), decisions)).Should(Succeed())
+
+			files, err := filepath.Glob(filepath.Join(dir, "dt=*", "decisions-*.jsonl"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(files).Should(HaveLen(1))
+
+			f, err := os.Open(files[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			defer f.Close()
+			var lines []Decision
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var d Decision
+				Expect(json.Unmarshal(scanner.Bytes(), &d)).Should(Succeed())
+				lines = append(lines, d)
+			}
+			Expect(lines).Should(Equal(decisions))
+		})
+
+		It("Case 2 - ClickHouse sink inserts batches as JSONEachRow", func() {
+			var query, user string
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query, user = r.URL.Query().Get("query"), r.Header.Get("X-ClickHouse-User")
+				body, _ = io.ReadAll(r.Body)
+			}))
+			defer server.Close()
+
+			sink := NewClickHouseSink(config.ClickHouse{URL: server.URL, Table: "decisions", Username: "permify"})
+			Expect(sink.Write(context.Background(), decisions)).Should(Succeed())
+			Expect(query).Should(Equal("INSERT INTO decisions FORMAT JSONEachRow"))
+			Expect(user).Should(Equal("permify"))
+			Expect(body).Should(ContainSubstring(`"permission":"edit"`))
+
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "Code: 60. DB::Exception: Table doesn't exist", http.StatusNotFound)
+			})
+			Expect(sink.Write(context.Background(), decisions)).Should(MatchError(ContainSubstring("Table doesn't exist")))
+		})
+
+		It("Case 3 - BigQuery sink streams batches with insertAll", func() {
+			var path string
+			var request struct {
+				Rows []insertAllRow `json:"rows"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				_ = json.NewDecoder(r.Body).Decode(&request)
+				_, _ = w.Write([]byte(`{"insertErrors":[{"index":1,"errors":[{"reason":"invalid","message":"no such field"}]}]}`))
+			}))
+			defer server.Close()
+
+			sink := &BigQuerySink{conf: config.BigQuery{Project: "p", Dataset: "d", Table: "t"}, client: server.Client(), endpoint: server.URL}
+			err := sink.Write(context.Background(), decisions)
+			Expect(path).Should(Equal("/projects/p/datasets/d/tables/t/insertAll"))
+			Expect(request.Rows).Should(HaveLen(2))
+			Expect(err).Should(MatchError(ContainSubstring("1 of 2 decisions were not inserted, row 1: invalid: no such field")))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package decisionlog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Logger - Logs a sample of the decisions of the permission checks of the server to a sink. Decisions are queued
+// and written in batches in the background, so logging never slows checks down: decisions are dropped while the
+// queue is full, and batches the sink fails to write are dropped as well.
+type Logger struct {
+	sink Sink
+	rate float64
+	// roll returns a number in [0, 1)
+	roll func() float64
+
+	batchSize     int
+	flushInterval time.Duration
+	queue         chan Decision
+	// dropped is the number of decisions dropped since it was last reported
+	dropped atomic.Int64
+}
+
+// NewLogger - Creates new Logger writing the decisions to the sink with the batching and sampling of the
+// configuration
+func NewLogger(sink Sink, conf config.DecisionLog) (*Logger, error) {
+	if conf.SampleRate <= 0 || conf.SampleRate > 1 {
+		return nil, fmt.Errorf("decision log sample rate must be greater than 0 and at most 1")
+	}
+	if conf.BatchSize <= 0 || conf.QueueSize <= 0 || conf.FlushInterval <= 0 {
+		return nil, fmt.Errorf("decision log batch size, queue size and flush interval must be positive")
+	}
+	return &Logger{
+		sink:          sink,
+		rate:          conf.SampleRate,
+		roll:          rand.Float64,
+		batchSize:     conf.BatchSize,
+		flushInterval: conf.FlushInterval,
+		queue:         make(chan Decision, conf.QueueSize),
+	}, nil
+}
+
+// Log queues the decision to be written, dropping it if the queue is full
+func (l *Logger) Log(decision Decision) {
+	select {
+	case l.queue <- decision:
+	default:
+		l.dropped.Add(1)
+	}
+}
+
+// UnaryServerInterceptor - Returns an interceptor logging the sampled checks of the server
+func (l *Logger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		request, ok := req.(*base.PermissionCheckRequest)
+		if !ok || info.FullMethod != base.Permission_Check_FullMethodName || l.roll() >= l.rate {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if response, ok := resp.(*base.PermissionCheckResponse); ok && err == nil {
+			l.Log(NewDecision(start, time.Since(start), request, response, l.rate))
+		}
+		return resp, err
+	}
+}
+
+// Start writes the queued decisions in batches, once a batch is full or the flush interval elapses, until the
+// context is done. The decisions queued by then are written before it returns, and the sink is closed.
+func (l *Logger) Start(ctx context.Context) error {
+	ticker := time.NewTicker(l.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Decision, 0, l.batchSize)
+	for {
+		select {
+		case decision := <-l.queue:
+			batch = append(batch, decision)
+			if len(batch) < l.batchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-ctx.Done():
+			for {
+				select {
+				case decision := <-l.queue:
+					batch = append(batch, decision)
+					if len(batch) == l.batchSize {
+						batch = l.flush(context.Background(), batch)
+					}
+				default:
+					l.flush(context.Background(), batch)
+					return l.sink.Close()
+				}
+			}
+		}
+		batch = l.flush(ctx, batch)
+	}
+}
+
+// flush writes the batch, returning the emptied batch to fill again.
+func (l *Logger) flush(ctx context.Context, batch []Decision) []Decision {
+	if dropped := l.dropped.Swap(0); dropped > 0 {
+		slog.Warn("decision log queue is full, decisions were dropped", slog.Int64("dropped", dropped))
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+	if err := l.sink.Write(ctx, batch); err != nil {
+		slog.Error("failed to write decisions", slog.Int("decisions", len(batch)), slog.Any("error", err))
+	}
+	return batch[:0]
+}
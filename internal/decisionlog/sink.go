@@ -0,0 +1,233 @@
+package decisionlog
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/objectstorage"
+)
+
+// Sink - Store batches of decisions are written to
+type Sink interface {
+	// Write writes the batch of decisions
+	Write(ctx context.Context, decisions []Decision) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// NewSink - Creates the sink of the configuration
+func NewSink(ctx context.Context, conf config.DecisionLog) (Sink, error) {
+	switch conf.Sink {
+	case "object":
+		return NewObjectSink(conf.Location), nil
+	case "clickhouse":
+		return NewClickHouseSink(conf.ClickHouse), nil
+	case "bigquery":
+		return NewBigQuerySink(ctx, conf.BigQuery)
+	default:
+		return nil, fmt.Errorf("unknown decision log sink: %s", conf.Sink)
+	}
+}
+
+// ObjectSink - Writes each batch as an object of JSON lines, in a directory or under an s3:// or gs:// prefix.
+// Objects are partitioned by day, e.g. dt=2024-01-31/decisions-20240131T120000Z-1a2b3c4d.jsonl, so that query
+// engines such as Athena or BigQuery external tables prune them by date.
+type ObjectSink struct {
+	location string
+}
+
+// NewObjectSink - Creates new ObjectSink writing batches to the location
+func NewObjectSink(location string) *ObjectSink {
+	return &ObjectSink{location: strings.TrimSuffix(location, "/")}
+}
+
+// Write writes the batch as a new object
+func (s *ObjectSink) Write(ctx context.Context, decisions []Decision) error {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	key := fmt.Sprintf("dt=%s/decisions-%s-%s.jsonl", now.Format("2006-01-02"), now.Format("20060102T150405Z"), hex.EncodeToString(suffix))
+
+	location := s.location + "/" + key
+	if _, _, _, ok := objectstorage.Parse(location); !ok {
+		location = filepath.Join(s.location, filepath.FromSlash(key))
+		if err := os.MkdirAll(filepath.Dir(location), 0o755); err != nil {
+			return err
+		}
+	}
+
+	w, err := objectstorage.NewWriter(ctx, location)
+	if err != nil {
+		return err
+	}
+	if err = encode(w, decisions); err != nil {
+		w.Abort(err)
+		return err
+	}
+	return w.Close()
+}
+
+// Close does nothing, objects are closed as they are written
+func (s *ObjectSink) Close() error {
+	return nil
+}
+
+// ClickHouseSink - Inserts batches into a ClickHouse table through its HTTP interface, as JSONEachRow
+type ClickHouseSink struct {
+	conf   config.ClickHouse
+	client *http.Client
+}
+
+// NewClickHouseSink - Creates new ClickHouseSink inserting into the table of the configuration
+func NewClickHouseSink(conf config.ClickHouse) *ClickHouseSink {
+	return &ClickHouseSink{conf: conf, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Write inserts the batch into the table
+func (s *ClickHouseSink) Write(ctx context.Context, decisions []Decision) error {
+	var body bytes.Buffer
+	if err := encode(&body, decisions); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", s.conf.Table))
+	// Times are encoded as RFC 3339, which DateTime64 columns only parse with best effort parsing.
+	query.Set("date_time_input_format", "best_effort")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(s.conf.URL, "/")+"/?"+query.Encode(), &body)
+	if err != nil {
+		return err
+	}
+	if s.conf.Username != "" {
+		req.Header.Set("X-ClickHouse-User", s.conf.Username)
+		req.Header.Set("X-ClickHouse-Key", s.conf.Password)
+	}
+
+	return do(s.client, req, nil)
+}
+
+// Close closes the idle connections of the sink
+func (s *ClickHouseSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// BigQuerySink - Streams batches into a BigQuery table with the insertAll API
+type BigQuerySink struct {
+	conf     config.BigQuery
+	client   *http.Client
+	endpoint string
+}
+
+// NewBigQuerySink - Creates new BigQuerySink inserting into the table of the configuration, with the credentials
+// of the environment
+func NewBigQuerySink(ctx context.Context, conf config.BigQuery) (*BigQuerySink, error) {
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/bigquery.insertdata")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bigquery client: %w", err)
+	}
+	return &BigQuerySink{conf: conf, client: client, endpoint: "https://bigquery.googleapis.com/bigquery/v2"}, nil
+}
+
+// insertAllRow - Row of an insertAll request
+type insertAllRow struct {
+	JSON Decision `json:"json"`
+}
+
+// insertAllResponse - Response of an insertAll request, listing the rows that weren't inserted
+type insertAllResponse struct {
+	InsertErrors []struct {
+		Index  int `json:"index"`
+		Errors []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"insertErrors"`
+}
+
+// Write inserts the batch into the table
+func (s *BigQuerySink) Write(ctx context.Context, decisions []Decision) error {
+	rows := make([]insertAllRow, len(decisions))
+	for i, decision := range decisions {
+		rows[i] = insertAllRow{JSON: decision}
+	}
+	body, err := json.Marshal(map[string]interface{}{"rows": rows})
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s/insertAll", s.endpoint, url.PathEscape(s.conf.Project), url.PathEscape(s.conf.Dataset), url.PathEscape(s.conf.Table))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var response insertAllResponse
+	if err = do(s.client, req, &response); err != nil {
+		return err
+	}
+	if len(response.InsertErrors) > 0 {
+		e := response.InsertErrors[0]
+		reason := ""
+		if len(e.Errors) > 0 {
+			reason = e.Errors[0].Reason + ": " + e.Errors[0].Message
+		}
+		return fmt.Errorf("%d of %d decisions were not inserted, row %d: %s", len(response.InsertErrors), len(decisions), e.Index, reason)
+	}
+	return nil
+}
+
+// Close closes the idle connections of the sink
+func (s *BigQuerySink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// encode writes the decisions as JSON lines.
+func encode(w io.Writer, decisions []Decision) error {
+	encoder := json.NewEncoder(w)
+	for _, decision := range decisions {
+		if err := encoder.Encode(decision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do sends the request, decoding the JSON body of the response into out if it isn't nil. Responses other than
+// 2xx are returned as errors with the start of their body.
+func do(client *http.Client, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
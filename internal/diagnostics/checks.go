@@ -0,0 +1,135 @@
+package diagnostics
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/zitadel/oidc/pkg/client"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/storage"
+)
+
+// Database - Checks that the database is reachable, and that its clock is within maxSkew of the clock of the server,
+// since the snapshots and the garbage collection of the storage are timed by both. The skew isn't checked if maxSkew
+// is 0.
+func Database(name string, conf config.Database, maxSkew time.Duration) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (string, error) {
+			start := time.Now()
+			version, now, err := storage.ServerInfo(ctx, conf)
+			if err != nil {
+				return "", fmt.Errorf("failed to connect to the %s database, check database.uri and that the database accepts connections from this host: %w", conf.Engine, err)
+			}
+			if version == "" {
+				return fmt.Sprintf("engine %s", conf.Engine), nil
+			}
+
+			// The clock of the database is compared to the one of the server halfway through the query
+			local := start.Add(time.Since(start) / 2)
+			skew := now.Sub(local)
+			if skew < 0 {
+				skew = -skew
+			}
+			details := fmt.Sprintf("engine %s, version %s, clock skew %s", conf.Engine, version, skew.Round(time.Millisecond))
+			if maxSkew > 0 && skew > maxSkew {
+				return details, fmt.Errorf("the clock of the database is %s apart from the clock of this host, more than %s: synchronize them with NTP", skew.Round(time.Millisecond), maxSkew)
+			}
+			return details, nil
+		},
+	}
+}
+
+// Migrations - Checks that the database is migrated for this version, warning about the migrations it knows that
+// aren't applied yet. The database is checked to run against this version if preflight is enabled.
+func Migrations(name string, conf config.Database) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (string, error) {
+			current, latest, err := storage.MigrationVersion(ctx, conf.Engine, conf.URI)
+			if err != nil {
+				return "", fmt.Errorf("failed to read the migration version of the database: %w", err)
+			}
+			if latest == 0 {
+				return "no versioned migrations", nil
+			}
+			details := fmt.Sprintf("at migration %d of %d", current, latest)
+
+			if conf.Preflight {
+				if err = storage.Preflight(ctx, conf); err != nil {
+					return details, err
+				}
+			}
+			if current < latest {
+				return details, Warn(fmt.Errorf("migrations up to %d are not applied: migrate the database with `permify migrate up` or database.auto_migrate", latest))
+			}
+			return details, nil
+		},
+	}
+}
+
+// Certificate - Checks that the PEM certificate of the file is valid, warning if it expires within warnWithin
+func Certificate(name, path string, warnWithin time.Duration) Check {
+	return Check{
+		Name: name,
+		Run: func(context.Context) (string, error) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return "", fmt.Errorf("failed to read the certificate: %w", err)
+			}
+			block, _ := pem.Decode(data)
+			if block == nil || block.Type != "CERTIFICATE" {
+				return "", fmt.Errorf("%s does not contain a PEM certificate", path)
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse the certificate of %s: %w", path, err)
+			}
+
+			now := time.Now()
+			details := fmt.Sprintf("%s, valid until %s", cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339))
+			switch {
+			case now.Before(cert.NotBefore):
+				return details, fmt.Errorf("the certificate of %s is not valid before %s", path, cert.NotBefore.UTC().Format(time.RFC3339))
+			case now.After(cert.NotAfter):
+				return details, fmt.Errorf("the certificate of %s expired on %s: renew it", path, cert.NotAfter.UTC().Format(time.RFC3339))
+			case cert.NotAfter.Sub(now) < warnWithin:
+				return details, Warn(fmt.Errorf("the certificate of %s expires in %s: renew it", path, cert.NotAfter.Sub(now).Round(time.Hour)))
+			}
+			return details, nil
+		},
+	}
+}
+
+// OIDC - Checks that the discovery document of the OIDC issuer is reachable, as the authentication needs it
+func OIDC(name, issuer string) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (string, error) {
+			dis, err := client.Discover(issuer, &http.Client{Transport: contextTransport{ctx: ctx}})
+			if err != nil {
+				return "", fmt.Errorf("failed to discover the OIDC issuer, check authn.oidc.issuer and that it is reachable from this host: %w", err)
+			}
+			if dis.JwksURI == "" {
+				return "", errors.New("the discovery document of the OIDC issuer has no jwks_uri")
+			}
+			return fmt.Sprintf("issuer %s, keys %s", dis.Issuer, dis.JwksURI), nil
+		},
+	}
+}
+
+// contextTransport - Transport sending the requests within the context of the check, as the discovery doesn't take one
+type contextTransport struct {
+	ctx context.Context
+}
+
+func (t contextTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return http.DefaultTransport.RoundTrip(r.WithContext(t.ctx))
+}
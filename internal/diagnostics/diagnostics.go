@@ -0,0 +1,129 @@
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ErrFailed - Error of the reports with failed checks, which the errors of the checks are joined to
+var ErrFailed = errors.New("startup diagnostics failed")
+
+// Check - Check of a dependency of the server, run on startup before it serves anything
+type Check struct {
+	// Name is the name of the check, reported along with its result
+	Name string
+	// Run checks the dependency, returning what it found, and an error saying how to fix it if it is not usable.
+	// Errors wrapped by Warn are reported without failing the startup.
+	Run func(ctx context.Context) (details string, err error)
+}
+
+// warning - Error of a check that doesn't fail the startup
+type warning struct {
+	err error
+}
+
+func (w warning) Error() string { return w.err.Error() }
+
+func (w warning) Unwrap() error { return w.err }
+
+// Warn - Wraps the error of a check so that it is reported as a warning, without failing the startup
+func Warn(err error) error {
+	return warning{err: err}
+}
+
+// Result - Result of a check
+type Result struct {
+	Name     string
+	Details  string
+	Err      error
+	Warning  bool
+	Duration time.Duration
+}
+
+// Report - Results of the checks, in the order of the checks
+type Report struct {
+	Results []Result
+}
+
+// Run - Runs the checks concurrently, each within the timeout, and reports their results
+func Run(ctx context.Context, timeout time.Duration, checks ...Check) Report {
+	results := make([]Result, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = run(ctx, timeout, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	return Report{Results: results}
+}
+
+// run runs the check within the timeout, abandoning it if it doesn't return in time.
+func run(ctx context.Context, timeout time.Duration, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		details string
+		err     error
+	}
+	done := make(chan outcome, 1)
+	start := time.Now()
+	go func() {
+		details, err := check.Run(ctx)
+		done <- outcome{details: details, err: err}
+	}()
+
+	result := Result{Name: check.Name}
+	select {
+	case o := <-done:
+		result.Details, result.Err = o.details, o.err
+	case <-ctx.Done():
+		result.Err = fmt.Errorf("did not complete within %s: %w", timeout, ctx.Err())
+	}
+	result.Duration = time.Since(start)
+
+	var w warning
+	result.Warning = errors.As(result.Err, &w)
+	return result
+}
+
+// Log - Logs the result of each check
+func (r Report) Log() {
+	for _, result := range r.Results {
+		attrs := []any{slog.String("check", result.Name), slog.Duration("duration", result.Duration)}
+		if result.Details != "" {
+			attrs = append(attrs, slog.String("details", result.Details))
+		}
+		switch {
+		case result.Err == nil:
+			slog.Info("🩺 startup check passed", attrs...)
+		case result.Warning:
+			slog.Warn("🩺 startup check warned", append(attrs, slog.Any("warning", result.Err))...)
+		default:
+			slog.Error("🩺 startup check failed", append(attrs, slog.Any("error", result.Err))...)
+		}
+	}
+}
+
+// Err - Returns the errors of the failed checks, joined, or nil if none of them failed
+func (r Report) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil && !result.Warning {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrFailed, errors.Join(errs...))
+}
@@ -0,0 +1,133 @@
+package diagnostics
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestRun(t *testing.T) {
+	report := Run(context.Background(), 100*time.Millisecond,
+		Check{Name: "passing", Run: func(context.Context) (string, error) {
+			return "fine", nil
+		}},
+		Check{Name: "warning", Run: func(context.Context) (string, error) {
+			return "", Warn(errors.New("almost expired"))
+		}},
+		Check{Name: "failing", Run: func(context.Context) (string, error) {
+			return "", errors.New("unreachable")
+		}},
+		Check{Name: "hanging", Run: func(context.Context) (string, error) {
+			select {}
+		}},
+	)
+
+	require.Len(t, report.Results, 4)
+	assert.Equal(t, "passing", report.Results[0].Name)
+	assert.Equal(t, "fine", report.Results[0].Details)
+	assert.NoError(t, report.Results[0].Err)
+	assert.True(t, report.Results[1].Warning)
+	assert.False(t, report.Results[2].Warning)
+	assert.ErrorIs(t, report.Results[3].Err, context.DeadlineExceeded)
+
+	// The errors of all the failed checks are reported, but not the warnings
+	err := report.Err()
+	require.ErrorIs(t, err, ErrFailed)
+	assert.Contains(t, err.Error(), "failing: unreachable")
+	assert.Contains(t, err.Error(), "hanging: did not complete within 100ms")
+	assert.NotContains(t, err.Error(), "almost expired")
+
+	warnings := Run(context.Background(), time.Second, Check{Name: "warning", Run: func(context.Context) (string, error) {
+		return "", Warn(errors.New("almost expired"))
+	}})
+	assert.NoError(t, warnings.Err())
+}
+
+func TestDatabase(t *testing.T) {
+	details, err := Database("database", config.Database{Engine: "memory"}, time.Second).Run(context.Background())
+	assert.NoError(t, err)
+	assert.Contains(t, details, "version memory")
+
+	_, err = Migrations("migrations", config.Database{Engine: "memory"}).Run(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestCertificate(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, notBefore, notAfter time.Time) string {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name+".pem")
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+		return path
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name    string
+		path    string
+		err     bool
+		warning bool
+	}{
+		{name: "valid", path: write("valid", now.Add(-time.Hour), now.Add(365*24*time.Hour))},
+		{name: "expiring", path: write("expiring", now.Add(-time.Hour), now.Add(24*time.Hour)), err: true, warning: true},
+		{name: "expired", path: write("expired", now.Add(-48*time.Hour), now.Add(-24*time.Hour)), err: true},
+		{name: "not yet valid", path: write("future", now.Add(24*time.Hour), now.Add(48*time.Hour)), err: true},
+		{name: "missing", path: filepath.Join(dir, "missing.pem"), err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Run(context.Background(), time.Second, Certificate(tt.name, tt.path, 7*24*time.Hour)).Results[0]
+			assert.Equal(t, tt.err, result.Err != nil, result.Err)
+			assert.Equal(t, tt.warning, result.Warning)
+		})
+	}
+}
+
+func TestOIDC(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   server.URL,
+			"jwks_uri": server.URL + "/keys",
+		})
+	}))
+	defer server.Close()
+
+	details, err := OIDC("oidc", server.URL).Run(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, details, server.URL+"/keys")
+
+	_, err = OIDC("oidc", server.URL+"/missing").Run(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size of the keys identifiers are encrypted with.
+const KeySize = 32
+
+// wildcard is the subject id standing for every subject of its type, which isn't encrypted so it keeps matching
+// them. Encrypted identifiers never equal it, as it isn't in their alphabet.
+const wildcard = "*"
+
+// ErrInvalidCiphertext is returned for ciphertexts that are malformed or weren't encrypted with the key.
+var ErrInvalidCiphertext = errors.New("identifier isn't encrypted with the key")
+
+// Cipher - Encrypts the identifiers of entities and subjects deterministically, so equal identifiers of a tenant
+// have equal ciphertexts and equality lookups keep working, while the database only holds ciphertexts.
+//
+// Identifiers are encrypted with AES-GCM under a synthetic nonce, the HMAC of the tenant and the identifier, and
+// authenticated along with the tenant. Ciphertexts only reveal which identifiers of a tenant are equal.
+type Cipher struct {
+	aead   cipher.AEAD
+	macKey []byte
+}
+
+// NewCipher - Creates new Cipher of the key, from which the encryption and nonce keys are derived
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, not %d", KeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(derive(key, "permify identifier encryption"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{
+		aead:   aead,
+		macKey: derive(key, "permify identifier nonce"),
+	}, nil
+}
+
+// derive derives the key of the purpose from the key.
+func derive(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// Encrypt encrypts the identifier of the tenant. Empty identifiers and wildcards are kept as they are.
+func (c *Cipher) Encrypt(tenantID, id string) string {
+	if id == "" || id == wildcard {
+		return id
+	}
+
+	mac := hmac.New(sha256.New, c.macKey)
+	mac.Write([]byte(tenantID))
+	mac.Write([]byte{0})
+	mac.Write([]byte(id))
+	nonce := mac.Sum(nil)[:c.aead.NonceSize()]
+
+	sealed := make([]byte, len(nonce), len(nonce)+len(id)+c.aead.Overhead())
+	copy(sealed, nonce)
+	sealed = c.aead.Seal(sealed, nonce, []byte(id), []byte(tenantID))
+	return base64.RawURLEncoding.EncodeToString(sealed)
+}
+
+// Decrypt decrypts the identifier of the tenant encrypted with Encrypt.
+func (c *Cipher) Decrypt(tenantID, encrypted string) (string, error) {
+	if encrypted == "" || encrypted == wildcard {
+		return encrypted, nil
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(encrypted)
+	if err != nil || len(sealed) < c.aead.NonceSize()+c.aead.Overhead() {
+		return "", ErrInvalidCiphertext
+	}
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+
+	id, err := c.aead.Open(nil, nonce, ciphertext, []byte(tenantID))
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	return string(id), nil
+}
+
+// EncryptAll encrypts the identifiers of the tenant.
+func (c *Cipher) EncryptAll(tenantID string, ids []string) []string {
+	if len(ids) == 0 {
+		return ids
+	}
+	encrypted := make([]string, len(ids))
+	for i, id := range ids {
+		encrypted[i] = c.Encrypt(tenantID, id)
+	}
+	return encrypted
+}
+
+// DecryptAll decrypts the identifiers of the tenant.
+func (c *Cipher) DecryptAll(tenantID string, encrypted []string) ([]string, error) {
+	if len(encrypted) == 0 {
+		return encrypted, nil
+	}
+	ids := make([]string, len(encrypted))
+	for i, e := range encrypted {
+		id, err := c.Decrypt(tenantID, e)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
@@ -0,0 +1,81 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestCipher(t *testing.T) {
+	c, err := NewCipher(bytes.Repeat([]byte{1}, KeySize))
+	require.NoError(t, err)
+
+	encrypted := c.Encrypt("t1", "alice")
+	assert.NotEqual(t, "alice", encrypted)
+	assert.NotContains(t, encrypted, "alice")
+
+	// Encryption is deterministic within a tenant, so equality lookups keep working.
+	assert.Equal(t, encrypted, c.Encrypt("t1", "alice"))
+	assert.NotEqual(t, encrypted, c.Encrypt("t1", "bob"))
+	assert.NotEqual(t, encrypted, c.Encrypt("t2", "alice"))
+
+	id, err := c.Decrypt("t1", encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", id)
+
+	// Ciphertexts are bound to their tenant and key.
+	_, err = c.Decrypt("t2", encrypted)
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+	other, err := NewCipher(bytes.Repeat([]byte{2}, KeySize))
+	require.NoError(t, err)
+	_, err = other.Decrypt("t1", encrypted)
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+	_, err = c.Decrypt("t1", "alice")
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	// Wildcards and empty identifiers are kept as they are.
+	assert.Equal(t, "*", c.Encrypt("t1", "*"))
+	assert.Equal(t, "", c.Encrypt("t1", ""))
+
+	_, err = NewCipher([]byte("short"))
+	assert.Error(t, err)
+}
+
+func TestLoadKey(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, KeySize)
+
+	loaded, err := LoadKey(context.Background(), config.Encryption{Key: base64.StdEncoding.EncodeToString(key)})
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/transit/decrypt/permify", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get("X-Vault-Token"))
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body["ciphertext"] != "vault:v1:wrapped" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]string{"plaintext": base64.StdEncoding.EncodeToString(key)}})
+	}))
+	defer server.Close()
+
+	kms := config.KMS{Provider: "vault", Address: server.URL, Token: "token", KeyName: "permify"}
+	loaded, err = LoadKey(context.Background(), config.Encryption{Key: "vault:v1:wrapped", KMS: kms})
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	_, err = LoadKey(context.Background(), config.Encryption{Key: "vault:v1:other", KMS: kms})
+	assert.Error(t, err)
+}
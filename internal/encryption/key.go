@@ -0,0 +1,103 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// KMS - Key management service unwrapping the key identifiers are encrypted with, so the key is only stored
+// wrapped by a key that never leaves the service
+type KMS interface {
+	// Decrypt unwraps the wrapped key
+	Decrypt(ctx context.Context, wrapped string) ([]byte, error)
+}
+
+// LoadKey returns the key identifiers are encrypted with. It is the base64 key of the configuration, or the key
+// the KMS unwraps from it.
+func LoadKey(ctx context.Context, conf config.Encryption) ([]byte, error) {
+	if conf.Key == "" {
+		return nil, fmt.Errorf("encryption key is required")
+	}
+
+	switch conf.KMS.Provider {
+	case "":
+		key, err := base64.StdEncoding.DecodeString(conf.Key)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key must be base64 encoded: %w", err)
+		}
+		return key, nil
+	case "vault":
+		return NewVaultTransit(conf.KMS).Decrypt(ctx, conf.Key)
+	default:
+		return nil, fmt.Errorf("unknown kms provider: %s", conf.KMS.Provider)
+	}
+}
+
+// VaultTransit - Unwraps keys with the transit secrets engine of HashiCorp Vault, which can front the key management
+// services of cloud providers
+type VaultTransit struct {
+	address string
+	token   string
+	mount   string
+	keyName string
+	client  *http.Client
+}
+
+// NewVaultTransit - Creates new VaultTransit unwrapping keys with the key of the transit engine of the configuration
+func NewVaultTransit(conf config.KMS) *VaultTransit {
+	mount := conf.Mount
+	if mount == "" {
+		mount = "transit"
+	}
+	return &VaultTransit{
+		address: strings.TrimSuffix(conf.Address, "/"),
+		token:   conf.Token,
+		mount:   mount,
+		keyName: conf.KeyName,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Decrypt unwraps the key wrapped by the transit engine, e.g. vault:v1:...
+func (v *VaultTransit) Decrypt(ctx context.Context, wrapped string) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"ciphertext": wrapped})
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/decrypt/%s", v.address, v.mount, v.keyName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", v.token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap the encryption key: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to unwrap the encryption key: vault responded %s", resp.Status)
+	}
+
+	var decrypted struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&decrypted); err != nil {
+		return nil, fmt.Errorf("failed to unwrap the encryption key: %w", err)
+	}
+	return base64.StdEncoding.DecodeString(decrypted.Data.Plaintext)
+}
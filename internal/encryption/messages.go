@@ -0,0 +1,78 @@
+package encryption
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// EncryptTuple returns the tuple of the tenant with its entity and subject identifiers encrypted.
+func (c *Cipher) EncryptTuple(tenantID string, tuple *base.Tuple) *base.Tuple {
+	encrypted := proto.Clone(tuple).(*base.Tuple)
+	if encrypted.GetEntity() != nil {
+		encrypted.Entity.Id = c.Encrypt(tenantID, encrypted.GetEntity().GetId())
+	}
+	if encrypted.GetSubject() != nil {
+		encrypted.Subject.Id = c.Encrypt(tenantID, encrypted.GetSubject().GetId())
+	}
+	return encrypted
+}
+
+// DecryptTuple decrypts the entity and subject identifiers of the tuple of the tenant in place.
+func (c *Cipher) DecryptTuple(tenantID string, tuple *base.Tuple) (err error) {
+	if tuple.GetEntity() != nil {
+		if tuple.Entity.Id, err = c.Decrypt(tenantID, tuple.GetEntity().GetId()); err != nil {
+			return err
+		}
+	}
+	if tuple.GetSubject() != nil {
+		if tuple.Subject.Id, err = c.Decrypt(tenantID, tuple.GetSubject().GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncryptAttribute returns the attribute of the tenant with its entity identifier encrypted.
+func (c *Cipher) EncryptAttribute(tenantID string, attribute *base.Attribute) *base.Attribute {
+	encrypted := proto.Clone(attribute).(*base.Attribute)
+	if encrypted.GetEntity() != nil {
+		encrypted.Entity.Id = c.Encrypt(tenantID, encrypted.GetEntity().GetId())
+	}
+	return encrypted
+}
+
+// DecryptAttribute decrypts the entity identifier of the attribute of the tenant in place.
+func (c *Cipher) DecryptAttribute(tenantID string, attribute *base.Attribute) (err error) {
+	if attribute.GetEntity() != nil {
+		attribute.Entity.Id, err = c.Decrypt(tenantID, attribute.GetEntity().GetId())
+	}
+	return err
+}
+
+// EncryptTupleFilter returns the tuple filter of the tenant with its entity and subject identifiers encrypted.
+func (c *Cipher) EncryptTupleFilter(tenantID string, filter *base.TupleFilter) *base.TupleFilter {
+	if filter == nil {
+		return nil
+	}
+	encrypted := proto.Clone(filter).(*base.TupleFilter)
+	if encrypted.GetEntity() != nil {
+		encrypted.Entity.Ids = c.EncryptAll(tenantID, encrypted.GetEntity().GetIds())
+	}
+	if encrypted.GetSubject() != nil {
+		encrypted.Subject.Ids = c.EncryptAll(tenantID, encrypted.GetSubject().GetIds())
+	}
+	return encrypted
+}
+
+// EncryptAttributeFilter returns the attribute filter of the tenant with its entity identifiers encrypted.
+func (c *Cipher) EncryptAttributeFilter(tenantID string, filter *base.AttributeFilter) *base.AttributeFilter {
+	if filter == nil {
+		return nil
+	}
+	encrypted := proto.Clone(filter).(*base.AttributeFilter)
+	if encrypted.GetEntity() != nil {
+		encrypted.Entity.Ids = c.EncryptAll(tenantID, encrypted.GetEntity().GetIds())
+	}
+	return encrypted
+}
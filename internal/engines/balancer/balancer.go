@@ -2,16 +2,23 @@ package balancer
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"os"
 	"time"
 
 	"github.com/cespare/xxhash/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // Registers the health checking of the peers
+	"google.golang.org/grpc/keepalive"
 
+	"github.com/Permify/permify/internal/authn/peer"
 	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/invoke"
@@ -21,9 +28,26 @@ import (
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
-var grpcServicePolicy = fmt.Sprintf(`{
-		"loadBalancingPolicy": "%s"
-	}`, balancer.Policy)
+// serviceConfig returns the service config of the connection to the peers, balancing the checks with the consistent
+// hash ring of the configuration, and health checking the peers if it is enabled.
+func serviceConfig(dst *config.Distributed) (string, error) {
+	policy := balancer.Config{VirtualNodes: dst.Ring.VirtualNodes, Hash: dst.Ring.Hash}
+	if dst.Peers.OutlierDetection.Enabled {
+		policy.OutlierDetection = &balancer.OutlierDetection{
+			ConsecutiveFailures: dst.Peers.OutlierDetection.ConsecutiveFailures,
+			EjectionTime:        dst.Peers.OutlierDetection.EjectionTime.String(),
+		}
+	}
+	sc := map[string]interface{}{
+		"loadBalancingConfig": []map[string]balancer.Config{{balancer.Policy: policy}},
+	}
+	if dst.Peers.HealthCheck {
+		// The empty service name is the health of the whole invoke server
+		sc["healthCheckConfig"] = map[string]string{"serviceName": ""}
+	}
+	js, err := json.Marshal(sc)
+	return string(js), err
+}
 
 // Balancer is a wrapper around the balancer hash implementation that
 type Balancer struct {
@@ -34,13 +58,14 @@ type Balancer struct {
 }
 
 // NewCheckEngineWithBalancer
-// struct with the provided cache.Cache instance.
+// struct with the provided cache.Cache instance. The dial options are added to those of the connection to the peers.
 func NewCheckEngineWithBalancer(
 	checker invoke.Check,
 	schemaReader storage.SchemaReader,
 	dst *config.Distributed,
 	srv *config.GRPC,
 	authn *config.Authn,
+	opts ...grpc.DialOption,
 ) (invoke.Check, error) {
 	var err error
 
@@ -48,7 +73,12 @@ func NewCheckEngineWithBalancer(
 
 	var creds credentials.TransportCredentials
 
-	if srv.TLSConfig.CertPath != "" && srv.TLSConfig.KeyPath != "" {
+	if dst.TLS.Enabled {
+		creds, err = peerCredentials(dst)
+		if err != nil {
+			return nil, fmt.Errorf("could not load peer TLS certificate: %s", err)
+		}
+	} else if srv.TLSConfig.CertPath != "" && srv.TLSConfig.KeyPath != "" {
 		creds, err = credentials.NewClientTLSFromFile(srv.TLSConfig.CertPath, srv.TLSConfig.KeyPath)
 		if err != nil {
 			return nil, fmt.Errorf("could not load TLS certificate: %s", err)
@@ -57,16 +87,36 @@ func NewCheckEngineWithBalancer(
 		creds = insecure.NewCredentials()
 	}
 
-	// TODO: Add client-side authentication using a key from KeyAuthn.
-	// 1. Initialize the KeyAuthn structure using the provided configuration.
-	// 2. Convert the KeyAuthn instance into PerRPCCredentials.
-	// 3. Append grpc.WithPerRPCCredentials() to the options slice.
+	// The dispatches carry the shared secret of the cluster, which the invoke servers of the peers check
+	if dst.SharedSecret != "" {
+		options = append(options, grpc.WithPerRPCCredentials(peer.NewCredentials(dst.SharedSecret, dst.TLS.Enabled)))
+	}
+
+	policy, err := serviceConfig(dst)
+	if err != nil {
+		return nil, err
+	}
 
 	options = append(
 		options,
-		grpc.WithDefaultServiceConfig(grpcServicePolicy),
+		grpc.WithDefaultServiceConfig(policy),
 		grpc.WithTransportCredentials(creds),
 	)
+	// The connections to the peers are kept open between the dispatches, pinged while idle to keep them warm
+	if dst.Peers.KeepaliveTime > 0 {
+		options = append(options, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                dst.Peers.KeepaliveTime,
+			Timeout:             dst.Peers.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	if dst.Invoke.MaxMessageSize > 0 {
+		options = append(options, grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(dst.Invoke.MaxMessageSize),
+			grpc.MaxCallSendMsgSize(dst.Invoke.MaxMessageSize),
+		))
+	}
+	options = append(options, opts...)
 
 	conn, err := grpc.Dial(dst.Address, options...)
 	if err != nil {
@@ -80,6 +130,29 @@ func NewCheckEngineWithBalancer(
 	}, nil
 }
 
+// peerCredentials returns the mutual TLS credentials of the connection to the peers, presenting the certificate of
+// the node and verifying theirs against the CA of the cluster.
+func peerCredentials(dst *config.Distributed) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(dst.TLS.CertPath, dst.TLS.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := os.ReadFile(dst.TLS.CAPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", dst.TLS.CAPath)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   dst.TLS.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
 // Check performs a permission check using the schema reader to obtain
 // entity definitions, then distributes the request based on a generated key.
 func (c *Balancer) Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
@@ -122,6 +195,8 @@ func (c *Balancer) Check(ctx context.Context, request *base.PermissionCheckReque
 	// Logging the intention to forward the request to the underlying client.
 	slog.Debug("Forwarding request with key to the underlying client", slog.String("key", k))
 
+	engines.CheckStatsFromContext(ctx).Dispatch()
+
 	// Perform the actual permission check by making a call to the underlying client.
 	response, err := c.client.Check(withTimeout, request)
 	if err != nil {
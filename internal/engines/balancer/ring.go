@@ -0,0 +1,163 @@
+package balancer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+
+	"github.com/Permify/permify/pkg/balancer"
+)
+
+// latencyWeight is the weight of the latest dispatch in the moving average of the latency of a peer.
+const latencyWeight = 0.1
+
+// RingMonitor keeps the last state of the hash ring the checks are dispatched to the peers with, and records the
+// keys moved between the peers by the changes of its nodes, along with the latency of the dispatches to each peer.
+type RingMonitor struct {
+	mu        sync.RWMutex
+	state     balancer.RingState
+	movement  balancer.Movement
+	changedAt time.Time
+	built     bool
+	peers     map[string]*balancer.PeerStats
+
+	changes   api.Int64Counter
+	moved     api.Float64Histogram
+	minimum   api.Float64Histogram
+	latency   api.Int64Histogram
+	failures  api.Int64Counter
+	ejections api.Int64Counter
+}
+
+// NewRingMonitor creates a new RingMonitor recording its metrics with the meter.
+func NewRingMonitor(meter api.Meter) *RingMonitor {
+	changes, err := meter.Int64Counter("hash_ring_changes", api.WithDescription("Number of changes of the nodes of the hash ring"))
+	if err != nil {
+		panic(err)
+	}
+
+	moved, err := meter.Float64Histogram("hash_ring_moved_keys", api.WithDescription("Share of the keys moved between the nodes by a change of the hash ring"))
+	if err != nil {
+		panic(err)
+	}
+
+	minimum, err := meter.Float64Histogram("hash_ring_minimum_moved_keys", api.WithDescription("Share of the keys a change of the hash ring had to move for the nodes to own their new shares"))
+	if err != nil {
+		panic(err)
+	}
+
+	latency, err := meter.Int64Histogram("peer_dispatch_duration", api.WithDescription("Duration of the checks dispatched to a peer"), api.WithUnit("ms"))
+	if err != nil {
+		panic(err)
+	}
+
+	failures, err := meter.Int64Counter("peer_dispatch_failures", api.WithDescription("Number of the checks dispatched to a peer that it failed"))
+	if err != nil {
+		panic(err)
+	}
+
+	ejections, err := meter.Int64Counter("peer_ejections", api.WithDescription("Number of the ejections of a peer from the hash ring for failing consecutive dispatches"))
+	if err != nil {
+		panic(err)
+	}
+
+	return &RingMonitor{
+		peers:     map[string]*balancer.PeerStats{},
+		changes:   changes,
+		moved:     moved,
+		minimum:   minimum,
+		latency:   latency,
+		failures:  failures,
+		ejections: ejections,
+	}
+}
+
+// RingChanged records the new state of the ring, and the keys the change moved unless it is the first ring.
+func (m *RingMonitor) RingChanged(state balancer.RingState, movement balancer.Movement) {
+	m.mu.Lock()
+	first := !m.built
+	m.state, m.built = state, true
+	if !first {
+		m.movement, m.changedAt = movement, time.Now()
+	}
+	// The stats of the peers that left the ring are dropped
+	for _, address := range movement.Removed {
+		delete(m.peers, address)
+	}
+	m.mu.Unlock()
+
+	if first {
+		return
+	}
+	m.changes.Add(context.Background(), 1)
+	m.moved.Record(context.Background(), movement.Moved)
+	m.minimum.Record(context.Background(), movement.Minimum)
+}
+
+// Ring returns the state of the ring, along with the keys its last change moved and when it changed, which is zero
+// before it first changes. It returns false before the ring is built.
+func (m *RingMonitor) Ring() (balancer.RingState, balancer.Movement, time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state, m.movement, m.changedAt, m.built
+}
+
+// Dispatched records the latency of a dispatch to the peer, and whether the peer failed it.
+func (m *RingMonitor) Dispatched(address string, latency time.Duration, failed bool) {
+	m.mu.Lock()
+	stats := m.peer(address)
+	stats.Dispatches++
+	if failed {
+		stats.Failures++
+	}
+	if stats.Dispatches == 1 {
+		stats.Latency = latency
+	} else {
+		stats.Latency += time.Duration(latencyWeight * float64(latency-stats.Latency))
+	}
+	m.mu.Unlock()
+
+	attrs := api.WithAttributes(attribute.String("peer", address))
+	m.latency.Record(context.Background(), latency.Milliseconds(), attrs)
+	if failed {
+		m.failures.Add(context.Background(), 1, attrs)
+	}
+}
+
+// Ejected records the ejection of the peer from the ring until the given time.
+func (m *RingMonitor) Ejected(address string, until time.Time) {
+	m.mu.Lock()
+	m.peer(address).EjectedUntil = until
+	m.mu.Unlock()
+
+	m.ejections.Add(context.Background(), 1, api.WithAttributes(attribute.String("peer", address)))
+}
+
+// Peers returns the stats of the dispatches to the peers, by address. The ejections that have passed are cleared.
+func (m *RingMonitor) Peers() map[string]balancer.PeerStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	peers := make(map[string]balancer.PeerStats, len(m.peers))
+	for address, stats := range m.peers {
+		s := *stats
+		if !s.EjectedUntil.After(now) {
+			s.EjectedUntil = time.Time{}
+		}
+		peers[address] = s
+	}
+	return peers
+}
+
+// peer returns the stats of the peer, creating them on its first dispatch. The lock must be held.
+func (m *RingMonitor) peer(address string) *balancer.PeerStats {
+	stats, ok := m.peers[address]
+	if !ok {
+		stats = &balancer.PeerStats{}
+		m.peers[address] = stats
+	}
+	return stats
+}
@@ -3,8 +3,10 @@ package cache
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 
 	"github.com/cespare/xxhash/v2"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/invoke"
@@ -14,11 +16,16 @@ import (
 )
 
 // CheckEngineWithCache is a struct that holds an instance of a cache.Cache for managing engine cache.
+// The sub-checks of a check are dispatched through it as well, so the result of every subproblem, e.g. the
+// membership of a group, is cached, and the checks of the same subproblem evaluated concurrently share a single
+// evaluation.
 type CheckEngineWithCache struct {
 	// schemaReader is responsible for reading schema information
 	schemaReader storage.SchemaReader
 	checker      invoke.Check
 	cache        cache.Cache
+	// inflight are the evaluations of the subproblems not cached yet
+	inflight singleflight.Group
 }
 
 // NewCheckEngineWithCache creates a new instance of EngineKeyManager by initializing an EngineKeys
@@ -49,6 +56,7 @@ func (c *CheckEngineWithCache) Check(ctx context.Context, request *base.Permissi
 
 	// Try to get the cached result for the given request.
 	res, found := c.getCheckKey(request, isRelational)
+	engines.CheckStatsFromContext(ctx).CacheLookup(found)
 
 	// If a cached result is found, handle exclusion and return the result.
 	if found {
@@ -59,8 +67,8 @@ func (c *CheckEngineWithCache) Check(ctx context.Context, request *base.Permissi
 		}, nil
 	}
 
-	// Perform the actual permission check using the provided request.
-	res, err = c.checker.Check(ctx, request)
+	// Perform the actual permission check using the provided request, unless it is already being evaluated.
+	res, err = c.evaluate(ctx, request, isRelational)
 
 	// Check if there's an error or the response is nil, and return the result.
 	if err != nil {
@@ -72,15 +80,60 @@ func (c *CheckEngineWithCache) Check(ctx context.Context, request *base.Permissi
 		}, err
 	}
 
-	c.setCheckKey(request, &base.PermissionCheckResponse{
-		Can:      res.GetCan(),
-		Metadata: &base.PermissionCheckResponseMetadata{},
-	}, isRelational)
-
 	// Return the result of the permission check.
 	return res, err
 }
 
+// evaluate performs the permission check and caches its result, sharing the evaluation with the checks of the same
+// subproblem that start before it ends. The evaluations are keyed by depth as well, so that a subproblem depending
+// on itself through a cycle of relationships is evaluated again rather than waiting for itself.
+func (c *CheckEngineWithCache) evaluate(ctx context.Context, request *base.PermissionCheckRequest, isRelational bool) (*base.PermissionCheckResponse, error) {
+	key := fmt.Sprintf("%s|%d", engines.GenerateKey(request, isRelational), request.GetMetadata().GetDepth())
+	// The shared result of singleflight is true for the check that ran the evaluation too, so it is told apart from
+	// the checks that waited for it by running the function.
+	leader := false
+	v, err, _ := c.inflight.Do(key, func() (interface{}, error) {
+		leader = true
+		res, err := c.checker.Check(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		// A result depending on the request holds for this request only.
+		if res.GetMetadata().GetRequestDependent() {
+			return res, nil
+		}
+		c.setCheckKey(request, &base.PermissionCheckResponse{
+			Can:      res.GetCan(),
+			Metadata: &base.PermissionCheckResponseMetadata{},
+		}, isRelational)
+		return res, nil
+	})
+	if leader {
+		if err != nil {
+			return nil, err
+		}
+		return v.(*base.PermissionCheckResponse), nil
+	}
+
+	// The check that started the evaluation may have failed only because it was cancelled, e.g. when a sibling of
+	// a union is allowed, so this check evaluates the subproblem itself unless it was cancelled too.
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return c.checker.Check(ctx, request)
+	}
+
+	// The result of the evaluation depends on its own request.
+	if v.(*base.PermissionCheckResponse).GetMetadata().GetRequestDependent() {
+		return c.checker.Check(ctx, request)
+	}
+	return &base.PermissionCheckResponse{
+		Can:      v.(*base.PermissionCheckResponse).GetCan(),
+		Metadata: &base.PermissionCheckResponseMetadata{},
+	}, nil
+}
+
 // GetCheckKey retrieves the value for the given key from the EngineKeys cache.
 // It returns the PermissionCheckResponse if the key is found, and a boolean value
 // indicating whether the key was found or not.
@@ -1,13 +1,18 @@
 package cache
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/cache/ristretto"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -18,7 +23,7 @@ func TestEngineKeys_SetCheckKey(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Initialize a new EngineKeys struct with a new cache.Cache instance
-	engineKeys := CheckEngineWithCache{nil, nil, cache}
+	engineKeys := CheckEngineWithCache{cache: cache}
 
 	// Create a new PermissionCheckRequest and PermissionCheckResponse
 	checkReq := &base.PermissionCheckRequest{
@@ -68,7 +73,7 @@ func TestEngineKeys_SetCheckKey_WithHashError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Initialize a new EngineKeys struct with a new cache.Cache instance
-	engineKeys := CheckEngineWithCache{nil, nil, cache}
+	engineKeys := CheckEngineWithCache{cache: cache}
 
 	// Create a new PermissionCheckRequest and PermissionCheckResponse
 	checkReq := &base.PermissionCheckRequest{
@@ -118,7 +123,7 @@ func TestEngineKeys_GetCheckKey_KeyNotFound(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Initialize a new EngineKeys struct with a new cache.Cache instance
-	engineKeys := CheckEngineWithCache{nil, nil, cache}
+	engineKeys := CheckEngineWithCache{cache: cache}
 
 	// Create a new PermissionCheckRequest
 	checkReq := &base.PermissionCheckRequest{
@@ -153,7 +158,7 @@ func TestEngineKeys_SetAndGetMultipleKeys(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Initialize a new EngineKeys struct with a new cache.Cache instance
-	engineKeys := CheckEngineWithCache{nil, nil, cache}
+	engineKeys := CheckEngineWithCache{cache: cache}
 
 	// Create some new PermissionCheckRequests and PermissionCheckResponses
 	checkReq1 := &base.PermissionCheckRequest{
@@ -262,7 +267,7 @@ func TestEngineKeys_SetCheckKeyWithArguments(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Initialize a new EngineKeys struct with a new cache.Cache instance
-	engineKeys := CheckEngineWithCache{nil, nil, cache}
+	engineKeys := CheckEngineWithCache{cache: cache}
 
 	// Create a new PermissionCheckRequest and PermissionCheckResponse
 	checkReq := &base.PermissionCheckRequest{
@@ -397,3 +402,153 @@ func TestEngineKeys_SetCheckKeyWithContext(t *testing.T) {
 
 	assert.Equal(t, "check|t1|test_version|test_snap_token|entity_type:entity_id#relation@subject_type:subject_id,entity_type:entity_id$is_public|boolean:true,day_of_a_week:saturday,day_of_a_year:356|test-entity:e1$test-rule(test_argument_1,test_argument_2)", engines.GenerateKey(checkReq, false))
 }
+
+// entityDefinitions - Schema reader of a single entity definition with a relation
+type entityDefinitions struct {
+	storage.NoopSchemaReader
+}
+
+func (*entityDefinitions) ReadEntityDefinition(_ context.Context, _, _, _ string) (*base.EntityDefinition, string, error) {
+	return &base.EntityDefinition{
+		Name:       "group",
+		Relations:  map[string]*base.RelationDefinition{"member": {Name: "member"}},
+		References: map[string]base.EntityDefinition_Reference{"member": base.EntityDefinition_REFERENCE_RELATION},
+	}, "v1", nil
+}
+
+// blockingChecker - Checker counting its checks, which wait until it is released and then fail with err if set
+type blockingChecker struct {
+	checks  atomic.Int32
+	started chan struct{}
+	release chan struct{}
+	err     error
+}
+
+func (c *blockingChecker) Check(ctx context.Context, _ *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	c.checks.Add(1)
+	c.started <- struct{}{}
+	select {
+	case <-c.release:
+		if c.err != nil {
+			return nil, c.err
+		}
+		return &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED, Metadata: &base.PermissionCheckResponseMetadata{CheckCount: 3}}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestCheckEngineWithCache_SharesInflightEvaluations(t *testing.T) {
+	request := func() *base.PermissionCheckRequest {
+		return &base.PermissionCheckRequest{
+			TenantId:   "t1",
+			Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1", SnapToken: "s1", Depth: 19},
+			Entity:     &base.Entity{Type: "group", Id: "1"},
+			Permission: "member",
+			Subject:    &base.Subject{Type: "user", Id: "1"},
+		}
+	}
+
+	t.Run("concurrent checks of the same subproblem are evaluated once", func(t *testing.T) {
+		c, err := ristretto.New()
+		assert.Nil(t, err)
+		checker := &blockingChecker{started: make(chan struct{}, 4), release: make(chan struct{})}
+		engine := NewCheckEngineWithCache(checker, &entityDefinitions{}, c)
+
+		results := make(chan base.CheckResult, 4)
+		for i := 0; i < 4; i++ {
+			go func() {
+				res, err := engine.Check(context.Background(), request())
+				assert.Nil(t, err)
+				results <- res.GetCan()
+			}()
+		}
+		<-checker.started
+		// Gives the other checks the time to wait for the evaluation
+		time.Sleep(50 * time.Millisecond)
+		close(checker.release)
+
+		for i := 0; i < 4; i++ {
+			assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, <-results)
+		}
+		assert.Equal(t, int32(1), checker.checks.Load())
+	})
+
+	t.Run("a check evaluates the subproblem itself when the evaluation it waits for is cancelled", func(t *testing.T) {
+		c, err := ristretto.New()
+		assert.Nil(t, err)
+		checker := &blockingChecker{started: make(chan struct{}, 2), release: make(chan struct{})}
+		engine := NewCheckEngineWithCache(checker, &entityDefinitions{}, c)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error)
+		go func() {
+			_, err := engine.Check(ctx, request())
+			cancelled <- err
+		}()
+		<-checker.started
+
+		result := make(chan base.CheckResult)
+		go func() {
+			res, err := engine.Check(context.Background(), request())
+			assert.Nil(t, err)
+			result <- res.GetCan()
+		}()
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+		assert.NotNil(t, <-cancelled)
+
+		<-checker.started
+		close(checker.release)
+		assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, <-result)
+		assert.Equal(t, int32(2), checker.checks.Load())
+	})
+
+	t.Run("the check running the evaluation keeps its metadata and isn't run again", func(t *testing.T) {
+		c, err := ristretto.New()
+		assert.Nil(t, err)
+		checker := &blockingChecker{started: make(chan struct{}, 3), release: make(chan struct{})}
+		engine := NewCheckEngineWithCache(checker, &entityDefinitions{}, c)
+
+		counts := make(chan int32, 3)
+		for i := 0; i < 3; i++ {
+			go func() {
+				res, err := engine.Check(context.Background(), request())
+				assert.Nil(t, err)
+				counts <- res.GetMetadata().GetCheckCount()
+			}()
+		}
+		<-checker.started
+		time.Sleep(50 * time.Millisecond)
+		close(checker.release)
+
+		// Only the checks waiting for the evaluation get its result without its metadata
+		var read []int32
+		for i := 0; i < 3; i++ {
+			read = append(read, <-counts)
+		}
+		assert.ElementsMatch(t, []int32{3, 0, 0}, read)
+		assert.Equal(t, int32(1), checker.checks.Load())
+
+		// A failed evaluation is run again by the checks waiting for it only
+		c, err = ristretto.New()
+		assert.Nil(t, err)
+		checker = &blockingChecker{started: make(chan struct{}, 3), release: make(chan struct{}), err: errors.New("failed")}
+		engine = NewCheckEngineWithCache(checker, &entityDefinitions{}, c)
+
+		errs := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				_, err := engine.Check(context.Background(), request())
+				errs <- err
+			}()
+		}
+		<-checker.started
+		time.Sleep(50 * time.Millisecond)
+		close(checker.release)
+
+		assert.NotNil(t, <-errs)
+		assert.NotNil(t, <-errs)
+		assert.Equal(t, int32(2), checker.checks.Load())
+	})
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	api "go.opentelemetry.io/otel/metric"
+
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// CheckEngineWithInflightRegistry shares the evaluations of the checks dispatched to the node that owns them on the
+// hash ring. The nodes of a cluster dispatch the checks of a subproblem to its owner, so the same hot sub-check
+// arriving at several nodes at once is evaluated once by the owner and its result shared with all of them, whatever
+// the depth each node dispatched it at.
+//
+// A check only waits for an evaluation started with as much depth left as it has, or less: the sub-checks of an
+// evaluation always have less left, so an evaluation never waits for itself through a cycle of relationships. The
+// registry is best effort, and a check whose evaluation fails, e.g. running out of depth, evaluates the subproblem
+// itself.
+type CheckEngineWithInflightRegistry struct {
+	schemaReader storage.SchemaReader
+	checker      invoke.Check
+
+	mu    sync.Mutex
+	calls map[string]*inflightCheck
+
+	shared api.Int64Counter
+}
+
+// inflightCheck - Evaluation of a check registered in the registry
+type inflightCheck struct {
+	// depth is the remaining depth of the check that started the evaluation
+	depth    int32
+	done     chan struct{}
+	response *base.PermissionCheckResponse
+	err      error
+}
+
+// NewCheckEngineWithInflightRegistry creates the registry of the checks dispatched to the node, evaluating them with
+// the checker.
+func NewCheckEngineWithInflightRegistry(checker invoke.Check, schemaReader storage.SchemaReader, meter api.Meter) invoke.Check {
+	shared, err := meter.Int64Counter("dispatch_shared_checks", api.WithDescription("Number of the dispatched checks answered by the evaluation of a check of the same subproblem dispatched by another check"))
+	if err != nil {
+		panic(err)
+	}
+
+	return &CheckEngineWithInflightRegistry{
+		schemaReader: schemaReader,
+		checker:      checker,
+		calls:        map[string]*inflightCheck{},
+		shared:       shared,
+	}
+}
+
+// Check evaluates the check, or waits for the evaluation of the same subproblem in flight.
+func (c *CheckEngineWithInflightRegistry) Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	en, _, err := c.schemaReader.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return &base.PermissionCheckResponse{
+			Can: base.CheckResult_CHECK_RESULT_DENIED,
+			Metadata: &base.PermissionCheckResponseMetadata{
+				CheckCount: 0,
+			},
+		}, err
+	}
+
+	key := engines.GenerateKey(request, engines.IsRelational(en, request.GetPermission()))
+	depth := request.GetMetadata().GetDepth()
+
+	c.mu.Lock()
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		// A check with less depth left may be a sub-check of the evaluation, so it is evaluated separately
+		if depth < call.depth {
+			return c.checker.Check(ctx, request)
+		}
+		return c.wait(ctx, request, call)
+	}
+	call := &inflightCheck{depth: depth, done: make(chan struct{})}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	call.response, call.err = c.checker.Check(ctx, request)
+
+	c.mu.Lock()
+	delete(c.calls, key)
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.response, call.err
+}
+
+// wait returns the result of the evaluation in flight, evaluating the check itself if it fails.
+func (c *CheckEngineWithInflightRegistry) wait(ctx context.Context, request *base.PermissionCheckRequest, call *inflightCheck) (*base.PermissionCheckResponse, error) {
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return &base.PermissionCheckResponse{
+			Can: base.CheckResult_CHECK_RESULT_DENIED,
+			Metadata: &base.PermissionCheckResponseMetadata{
+				CheckCount: 0,
+			},
+		}, errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+	}
+
+	// The check that started the evaluation may have failed only because it was cancelled, e.g. when a sibling of
+	// a union is allowed on the node that dispatched it, and its result may depend on its own request.
+	if call.err != nil || call.response.GetMetadata().GetRequestDependent() {
+		return c.checker.Check(ctx, request)
+	}
+
+	c.shared.Add(ctx, 1)
+	return &base.PermissionCheckResponse{
+		Can:      call.response.GetCan(),
+		Metadata: &base.PermissionCheckResponseMetadata{},
+	}, nil
+}
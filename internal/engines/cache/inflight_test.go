@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+func TestCheckEngineWithInflightRegistry(t *testing.T) {
+	request := func(depth int32) *base.PermissionCheckRequest {
+		return &base.PermissionCheckRequest{
+			TenantId:   "t1",
+			Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1", SnapToken: "s1", Depth: depth},
+			Entity:     &base.Entity{Type: "group", Id: "1"},
+			Permission: "member",
+			Subject:    &base.Subject{Type: "user", Id: "1"},
+		}
+	}
+
+	t.Run("checks dispatched with as much depth left or more share the evaluation", func(t *testing.T) {
+		checker := &blockingChecker{started: make(chan struct{}, 4), release: make(chan struct{})}
+		engine := NewCheckEngineWithInflightRegistry(checker, &entityDefinitions{}, telemetry.NewNoopMeter())
+
+		results := make(chan base.CheckResult, 3)
+		go func() {
+			res, err := engine.Check(context.Background(), request(10))
+			assert.Nil(t, err)
+			results <- res.GetCan()
+		}()
+		<-checker.started
+
+		// The peers dispatched the same subproblem at other depths
+		for _, depth := range []int32{10, 18} {
+			depth := depth
+			go func() {
+				res, err := engine.Check(context.Background(), request(depth))
+				assert.Nil(t, err)
+				results <- res.GetCan()
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(1), checker.checks.Load())
+
+		// A check with less depth left may be a sub-check of the evaluation, and is evaluated separately
+		go func() {
+			_, _ = engine.Check(context.Background(), request(9))
+		}()
+		<-checker.started
+		assert.Equal(t, int32(2), checker.checks.Load())
+
+		close(checker.release)
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, <-results)
+		}
+	})
+
+	t.Run("a check evaluates the subproblem itself when the evaluation it waits for fails", func(t *testing.T) {
+		checker := &blockingChecker{started: make(chan struct{}, 2), release: make(chan struct{})}
+		engine := NewCheckEngineWithInflightRegistry(checker, &entityDefinitions{}, telemetry.NewNoopMeter())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error)
+		go func() {
+			_, err := engine.Check(ctx, request(10))
+			cancelled <- err
+		}()
+		<-checker.started
+
+		result := make(chan base.CheckResult)
+		go func() {
+			res, err := engine.Check(context.Background(), request(12))
+			assert.Nil(t, err)
+			result <- res.GetCan()
+		}()
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+		assert.NotNil(t, <-cancelled)
+
+		<-checker.started
+		close(checker.release)
+		assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, <-result)
+		assert.Equal(t, int32(2), checker.checks.Load())
+	})
+}
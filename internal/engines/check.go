@@ -4,14 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"sync"
 
 	"github.com/google/cel-go/cel"
+	otelAttribute "go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	storageContext "github.com/Permify/permify/internal/storage/context"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/dsl/utils"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -30,6 +38,13 @@ type CheckEngine struct {
 	dataReader storage.DataReader
 	// concurrencyLimit is the maximum number of concurrent permission checks allowed
 	concurrencyLimit int
+	// mismatchCounter counts the relationships and attributes read that the schema version does not allow, if set
+	mismatchCounter api.Int64Counter
+	// shadowCheckCounter, shadowDivergenceCounter and shadowErrorCounter count the evaluations of shadow permissions,
+	// those whose result differs from the one of their legacy permission and those that fail, if set
+	shadowCheckCounter      api.Int64Counter
+	shadowDivergenceCounter api.Int64Counter
+	shadowErrorCounter      api.Int64Counter
 }
 
 // NewCheckEngine creates a new CheckEngine instance for performing permission checks.
@@ -76,6 +91,10 @@ func (engine *CheckEngine) Check(ctx context.Context, request *base.PermissionCh
 	var res *base.PermissionCheckResponse
 	res, err = engine.check(ctx, request, en)(ctx)
 	if err != nil {
+		// Record where the evaluation was when it was interrupted, such as by running out of time
+		if ctx.Err() != nil {
+			CheckStatsFromContext(ctx).Unfinished(request.GetMetadata().GetPath())
+		}
 		return emptyResp, err
 	}
 
@@ -134,22 +153,24 @@ func (engine *CheckEngine) check(
 			// If an error is encountered while getting the permission, a CheckFunction is returned that always fails with this error.
 			return checkFail(err)
 		}
-		// Get the child of the permission.
-		child := permission.GetChild()
+		// Check the child of the permission.
+		fn = engine.checkChild(ctx, request, permission.GetChild())
 
-		// If the child has a rewrite, check the rewrite.
-		// If not, check the leaf.
-		if child.GetRewrite() != nil {
-			fn = engine.checkRewrite(ctx, request, child.GetRewrite())
-		} else {
-			fn = engine.checkLeaf(request, child.GetLeaf())
+		// The child of a shadow permission refers to its legacy permission, whose result is returned, while the new
+		// logic of the permission is evaluated along with it.
+		if shadow := permission.GetShadow(); shadow != nil {
+			fn = engine.checkShadow(request, fn, engine.checkChild(ctx, request, shadow))
 		}
 	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
 		// If the reference is an attribute, check the direct attribute.
 		fn = engine.checkDirectAttribute(request)
 	case base.EntityDefinition_REFERENCE_RELATION:
-		// If the reference is a relation, check the direct relation.
-		fn = engine.checkDirectRelation(request)
+		// If the reference is a relation, check the direct relation, combined with the rewrite of the relation if it
+		// has one.
+		fn = engine.checkDirectRelation(request, en)
+		if rewrite := en.GetRelations()[request.GetPermission()].GetRewrite(); rewrite != nil {
+			fn = engine.checkRelationRewrite(ctx, request, fn, rewrite)
+		}
 	default:
 		fn = engine.checkDirectCall(request)
 	}
@@ -165,6 +186,83 @@ func (engine *CheckEngine) check(
 	}
 }
 
+// checkChild prepares a CheckFunction checking the rewrite of the child if it has one, its leaf otherwise.
+func (engine *CheckEngine) checkChild(ctx context.Context, request *base.PermissionCheckRequest, child *base.Child) CheckFunction {
+	if child.GetRewrite() != nil {
+		return engine.checkRewrite(ctx, request, child.GetRewrite())
+	}
+	return engine.checkLeaf(request, child.GetLeaf())
+}
+
+// checkShadow prepares a CheckFunction returning the result of the legacy permission of a shadow permission, while
+// evaluating the new logic of the permission at the same time and reporting whether it differs from it. The failures
+// of the new logic are reported as well, but do not fail the check.
+func (engine *CheckEngine) checkShadow(request *base.PermissionCheckRequest, legacy, shadow CheckFunction) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		var (
+			wg             sync.WaitGroup
+			shadowResponse *base.PermissionCheckResponse
+			shadowErr      error
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shadowResponse, shadowErr = shadow(ctx)
+		}()
+
+		response, err := legacy(ctx)
+		wg.Wait()
+		if err != nil {
+			return response, err
+		}
+
+		engine.compareShadow(ctx, request, response.GetCan(), shadowResponse, shadowErr)
+		return response, nil
+	}
+}
+
+// compareShadow counts the evaluation of the shadow permission of the request, and counts and logs it if it failed or
+// its result differs from the one of the legacy permission.
+func (engine *CheckEngine) compareShadow(ctx context.Context, request *base.PermissionCheckRequest, legacy base.CheckResult, response *base.PermissionCheckResponse, err error) {
+	attrs := api.WithAttributes(
+		otelAttribute.String("entity_type", request.GetEntity().GetType()),
+		otelAttribute.String("permission", request.GetPermission()),
+	)
+	if engine.shadowCheckCounter != nil {
+		engine.shadowCheckCounter.Add(ctx, 1, attrs)
+	}
+
+	if err != nil {
+		if engine.shadowErrorCounter != nil {
+			engine.shadowErrorCounter.Add(ctx, 1, attrs)
+		}
+		slog.WarnContext(ctx, "shadow permission check failed",
+			slog.String("tenant_id", request.GetTenantId()),
+			slog.String("entity", tuple.EntityToString(request.GetEntity())),
+			slog.String("permission", request.GetPermission()),
+			slog.String("subject", tuple.SubjectToString(request.GetSubject())),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if response.GetCan() != legacy {
+		if engine.shadowDivergenceCounter != nil {
+			engine.shadowDivergenceCounter.Add(ctx, 1, attrs)
+		}
+		slog.WarnContext(ctx, "shadow permission check diverged from its legacy permission",
+			slog.String("tenant_id", request.GetTenantId()),
+			slog.String("entity", tuple.EntityToString(request.GetEntity())),
+			slog.String("permission", request.GetPermission()),
+			slog.String("subject", tuple.SubjectToString(request.GetSubject())),
+			slog.String("snap_token", request.GetMetadata().GetSnapToken()),
+			slog.String("schema_version", request.GetMetadata().GetSchemaVersion()),
+			slog.String("result", legacy.String()),
+			slog.String("shadow_result", response.GetCan().String()),
+		)
+	}
+}
+
 // checkRewrite prepares a CheckFunction according to the provided Rewrite operation.
 // It uses a Rewrite object that describes how to combine the results of multiple CheckFunctions.
 func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.PermissionCheckRequest, rewrite *base.Rewrite) CheckFunction {
@@ -188,6 +286,26 @@ func (engine *CheckEngine) checkRewrite(ctx context.Context, request *base.Permi
 	}
 }
 
+// checkRelationRewrite prepares a CheckFunction combining the direct relationships of a relation with the children of
+// its rewrite, by the operation of the rewrite: the subject must hold both for an intersection, and the relationships
+// but not the children for an exclusion.
+func (engine *CheckEngine) checkRelationRewrite(ctx context.Context, request *base.PermissionCheckRequest, direct CheckFunction, rewrite *base.Rewrite) CheckFunction {
+	var combiner CheckCombiner
+	switch rewrite.GetRewriteOperation() {
+	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
+		combiner = checkIntersection
+	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
+		combiner = checkExclusion
+	default:
+		return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
+	}
+
+	children := engine.setChild(ctx, request, rewrite.GetChildren(), checkUnion)
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		return combiner(ctx, []CheckFunction{direct, children}, engine.concurrencyLimit)
+	}
+}
+
 // checkLeaf prepares a CheckFunction according to the provided Leaf operation.
 // It uses a Leaf object that describes how to check a permission request.
 func (engine *CheckEngine) checkLeaf(request *base.PermissionCheckRequest, leaf *base.Leaf) CheckFunction {
@@ -250,8 +368,9 @@ func (engine *CheckEngine) setChild(
 }
 
 // checkDirectRelation is a method of CheckEngine struct that returns a CheckFunction.
-// It's responsible for directly checking the permissions on an entity
-func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequest) CheckFunction {
+// It's responsible for directly checking the permissions on an entity. The relationships the entity definition
+// does not allow are still evaluated, and recorded as schema mismatches.
+func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequest, en *base.EntityDefinition) CheckFunction {
 	// The returned CheckFunction is a closure over the provided context and request
 	return func(ctx context.Context) (result *base.PermissionCheckResponse, err error) {
 		// Define a TupleFilter. This specifies which tuples we're interested in.
@@ -288,8 +407,9 @@ func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequ
 		// NewUniqueTupleIterator() ensures that the iterator only returns unique tuples.
 		it := database.NewUniqueTupleIterator(rit, cti)
 
-		// Define a slice of CheckFunctions to hold the check functions for each subject.
-		var checkFunctions []CheckFunction
+		// Hold the check functions for each subject, released once the check ends.
+		fan := acquireFanOut()
+		defer fan.release()
 		// Iterate over all tuples returned by the iterator.
 		for it.HasNext() {
 			// Get the next tuple's subject.
@@ -297,31 +417,23 @@ func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequ
 			if !ok {
 				break
 			}
+			engine.validate(ctx, en, next)
 			subject := next.GetSubject()
 
-			// If the subject of the tuple is the same as the subject in the request, permission is allowed.
-			if tuple.AreSubjectsEqual(subject, request.GetSubject()) {
+			// If the subject of the tuple is the same as the subject in the request, or the wildcard of its type,
+			// permission is allowed.
+			if tuple.IsSubjectMatch(subject, request.GetSubject()) {
 				return allowed(&base.PermissionCheckResponseMetadata{}), nil
 			}
 			// If the subject is not a user and the relation is not ELLIPSIS, append a check function to the list.
 			if !tuple.IsDirectSubject(subject) && subject.GetRelation() != tuple.ELLIPSIS {
-				checkFunctions = append(checkFunctions, engine.invoke(&base.PermissionCheckRequest{
-					TenantId: request.GetTenantId(),
-					Entity: &base.Entity{
-						Type: subject.GetType(),
-						Id:   subject.GetId(),
-					},
-					Permission: subject.GetRelation(),
-					Subject:    request.GetSubject(),
-					Metadata:   request.GetMetadata(),
-					Context:    request.GetContext(),
-				}))
+				fan.add(engine.invoke(fan.request(request, subject.GetType(), subject.GetId(), subject.GetRelation())))
 			}
 		}
 
 		// If there's any CheckFunction in the list, return the union of all CheckFunctions
-		if len(checkFunctions) > 0 {
-			return checkUnion(ctx, checkFunctions, engine.concurrencyLimit)
+		if len(fan.functions) > 0 {
+			return checkUnion(ctx, fan.functions, engine.concurrencyLimit)
 		}
 
 		// If there's no CheckFunction, return a denied permission response.
@@ -331,6 +443,7 @@ func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequ
 
 // checkTupleToUserSet is a method of CheckEngine that checks permissions using the
 // TupleToUserSet data structure. It returns a CheckFunction closure that does the check.
+// The relationships the entity definition does not allow are still evaluated, and recorded as schema mismatches.
 func (engine *CheckEngine) checkTupleToUserSet(
 	request *base.PermissionCheckRequest,
 	ttu *base.TupleToUserSet,
@@ -368,8 +481,11 @@ func (engine *CheckEngine) checkTupleToUserSet(
 		// NewUniqueTupleIterator() ensures that the iterator only returns unique tuples.
 		it := database.NewUniqueTupleIterator(rit, cti)
 
-		// Define a slice of CheckFunctions to hold the check functions for each subject.
-		var checkFunctions []CheckFunction
+		// Hold the check functions for each subject, released once the check ends.
+		fan := acquireFanOut()
+		defer fan.release()
+		// The entity definition the relationships are validated against, read with the first one.
+		var en *base.EntityDefinition
 		// Iterate over all tuples returned by the iterator.
 		for it.HasNext() {
 			// Get the next tuple's subject.
@@ -377,25 +493,22 @@ func (engine *CheckEngine) checkTupleToUserSet(
 			if !ok {
 				break
 			}
+			if en == nil {
+				en, _, err = engine.schemaReader.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion())
+				if err != nil {
+					return denied(&base.PermissionCheckResponseMetadata{}), err
+				}
+			}
+			engine.validate(ctx, en, next)
 			subject := next.GetSubject()
 
 			// For each subject, generate a check function for its computed user set and append it to the list.
-			checkFunctions = append(checkFunctions, engine.checkComputedUserSet(&base.PermissionCheckRequest{
-				TenantId: request.GetTenantId(),
-				Entity: &base.Entity{
-					Type: subject.GetType(),
-					Id:   subject.GetId(),
-				},
-				Permission: subject.GetRelation(),
-				Subject:    request.GetSubject(),
-				Metadata:   request.GetMetadata(),
-				Context:    request.GetContext(),
-			}, ttu.GetComputed()))
+			fan.add(engine.invoke(fan.request(request, subject.GetType(), subject.GetId(), ttu.GetComputed().GetRelation())))
 		}
 
 		// Return the union of all CheckFunctions
 		// If any one of the check functions allows the action, the permission is granted.
-		return checkUnion(ctx, checkFunctions, engine.concurrencyLimit)
+		return checkUnion(ctx, fan.functions, engine.concurrencyLimit)
 	}
 }
 
@@ -481,16 +594,27 @@ func (engine *CheckEngine) checkDirectAttribute(
 			}
 		}
 
-		// No attribute was found matching the provided filter. In this case, we return a denied response with empty metadata
-		// and no error.
+		// No attribute was found matching the provided filter, so the default value of the attribute is evaluated. If
+		// there is no default, we return a denied response with empty metadata and no error.
+		value := val.GetValue()
 		if val == nil {
-			return denied(&base.PermissionCheckResponseMetadata{}), nil
+			value, err = engine.missingAttribute(ctx, request, request.GetPermission())
+			if err != nil {
+				return denied(&base.PermissionCheckResponseMetadata{}), err
+			}
+			if value == nil {
+				return denied(&base.PermissionCheckResponseMetadata{}), nil
+			}
 		}
 
 		// Unmarshal the attribute value into a BoolValue message.
 		var msg base.BooleanValue
-		if err := val.GetValue().UnmarshalTo(&msg); err != nil {
-			// If there was an error unmarshaling, return a denied response and the error.
+		if err := value.UnmarshalTo(&msg); err != nil {
+			// If there was an error unmarshaling, the stored value is not of the type of the attribute in the schema
+			// version, so it is recorded as a mismatch before a denied response and the error are returned.
+			if val != nil {
+				engine.mismatch(ctx, attribute.ToString(val), base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH)
+			}
 			return denied(&base.PermissionCheckResponseMetadata{}), err
 		}
 
@@ -574,6 +698,9 @@ func (engine *CheckEngine) checkDirectCall(
 			}
 		}
 
+		// Computed attributes found in the data source or the context.
+		found := make(map[string]bool, len(attributes))
+
 		// If there are computed attributes, fetch them from the data source.
 		if len(attributes) > 0 {
 			filter := &base.AttributeFilter{
@@ -602,9 +729,27 @@ func (engine *CheckEngine) checkDirectCall(
 					break
 				}
 				arguments[next.GetAttribute()] = utils.ConvertProtoAnyToInterface(next.GetValue())
+				found[next.GetAttribute()] = true
+			}
+		}
+
+		// Computed attributes the entity has no value for are evaluated as their default value, if any.
+		for _, attrName := range attributes {
+			if found[attrName] {
+				continue
+			}
+			value, err := engine.missingAttribute(ctx, request, attrName)
+			if err != nil {
+				return denied(&base.PermissionCheckResponseMetadata{}), err
+			}
+			if value != nil {
+				arguments[attrName] = utils.ConvertProtoAnyToInterface(value)
 			}
 		}
 
+		// The request variable describes the request the check is evaluated for.
+		arguments[utils.RequestVariable] = ruleRequest(request)
+
 		// Prepare the CEL environment with the argument values.
 		env, err := utils.ArgumentsAsCelEnv(ru.Arguments)
 		if err != nil {
@@ -630,15 +775,72 @@ func (engine *CheckEngine) checkDirectCall(
 			return denied(&base.PermissionCheckResponseMetadata{}), fmt.Errorf("expected boolean result, but got %T", out.Value())
 		}
 
+		// The result of a rule reading the request holds for this request only.
+		meta := &base.PermissionCheckResponseMetadata{RequestDependent: utils.ReadsRequest(ru.GetExpression())}
+
 		// If the result of the CEL evaluation is true, return an "allowed" response, otherwise return a "denied" response
 		if result {
-			return allowed(&base.PermissionCheckResponseMetadata{}), nil
+			return allowed(meta), nil
 		}
 
-		return denied(&base.PermissionCheckResponseMetadata{}), err
+		return denied(meta), err
 	}
 }
 
+// ruleRequest returns the request the rules of the check are evaluated for. Checks evaluated without the invoker,
+// which sets the request, are evaluated at the current time.
+func ruleRequest(request *base.PermissionCheckRequest) *base.RequestInfo {
+	info := &base.RequestInfo{
+		Time:    timestamppb.Now(),
+		Context: request.GetContext().GetData(),
+	}
+	if r := request.GetMetadata().GetRequest(); r != nil {
+		info.Time = r.GetTime()
+		info.Ip = r.GetIp()
+		info.Actor = r.GetActor()
+	}
+	return info
+}
+
+// missingAttribute returns the value to evaluate an attribute the entity of the request has no value for as, which
+// is the default value of the attribute, or nil if the attribute has no default and is evaluated as its empty value.
+// It returns an error if the attribute is required.
+func (engine *CheckEngine) missingAttribute(ctx context.Context, request *base.PermissionCheckRequest, name string) (*anypb.Any, error) {
+	definition, _, err := engine.schemaReader.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	attr, err := schema.GetAttributeByNameInEntityDefinition(definition, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if attr.GetRequired() {
+		return nil, fmt.Errorf("%s: %s is required but has no value",
+			base.ErrorCode_ERROR_CODE_MISSING_REQUIRED_ATTRIBUTE.String(), attribute.EntityAndCallOrAttributeToString(request.GetEntity(), name))
+	}
+
+	return attr.GetDefaultValue(), nil
+}
+
+// validate records the relationship as a schema mismatch if the entity definition does not allow it, such as when
+// the relation no longer allows its subject type.
+func (engine *CheckEngine) validate(ctx context.Context, en *base.EntityDefinition, t *base.Tuple) {
+	if err := validation.ValidateTuple(en, t); err != nil {
+		engine.mismatch(ctx, tuple.ToString(t), base.ErrorCode(base.ErrorCode_value[err.Error()]))
+	}
+}
+
+// mismatch counts the relationship or attribute the schema version does not allow, and records it in the stats of
+// the check when it is debugged.
+func (engine *CheckEngine) mismatch(ctx context.Context, item string, code base.ErrorCode) {
+	if engine.mismatchCounter != nil {
+		engine.mismatchCounter.Add(ctx, 1, api.WithAttributes(otelAttribute.String("code", code.String())))
+	}
+	CheckStatsFromContext(ctx).Mismatch(item, code)
+}
+
 // checkUnion checks if the subject has permission by running multiple CheckFunctions concurrently,
 // the permission check is successful if any one of the CheckFunctions succeeds (union).
 func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
@@ -668,6 +870,9 @@ func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*bas
 		close(decisionChan)
 	}()
 
+	// A cycle grants nothing, so the other CheckFunctions may still allow the permission
+	var cycleErr error
+
 	// Iterate over the results of the CheckFunctions
 	for i := 0; i < len(functions); i++ {
 		select {
@@ -675,6 +880,11 @@ func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*bas
 		case d := <-decisionChan:
 			// Merge the response metadata with the received metadata
 			responseMetadata = joinResponseMetas(responseMetadata, d.resp.Metadata)
+			// If there was a cycle, keep its error in case no other CheckFunction allows the permission
+			if d.err != nil && isCycle(d.err) {
+				cycleErr = d.err
+				continue
+			}
 			// If there was an error, deny the permission and return the error
 			if d.err != nil {
 				return denied(responseMetadata), d.err
@@ -689,8 +899,14 @@ func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*bas
 		}
 	}
 
-	// If all CheckFunctions are done and none have allowed the permission, deny the permission and return
-	return denied(responseMetadata), nil
+	// If all CheckFunctions are done and none have allowed the permission, deny the permission and return the
+	// cycle reached, if any
+	return denied(responseMetadata), cycleErr
+}
+
+// isCycle returns whether the error reports a cycle, which the checks of other nodes return within the status.
+func isCycle(err error) bool {
+	return strings.Contains(err.Error(), base.ErrorCode_ERROR_CODE_CYCLE_DETECTED.String())
 }
 
 // checkIntersection checks if the subject has permission by running multiple CheckFunctions concurrently,
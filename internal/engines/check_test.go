@@ -12,6 +12,7 @@ import (
 	"github.com/Permify/permify/internal/factories"
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/authn"
 	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/telemetry"
@@ -20,6 +21,53 @@ import (
 )
 
 var _ = Describe("check-engine", func() {
+	// PUBLIC WILDCARD SAMPLE
+	publicWildcardSchema := `
+entity user {}
+
+entity organization {
+	relation member @user
+}
+
+entity doc {
+	relation viewer @user @organization#member
+	relation banned @user
+
+	permission view = viewer not banned
+}
+`
+
+	// ATTRIBUTE DEFAULTS SAMPLE
+	attributeDefaultsSchema := `
+entity user {}
+
+entity organization {
+	relation member @user
+
+	attribute balance integer = 5000
+	attribute region string (required)
+
+	permission withdraw = member and check_balance(balance)
+	permission view = member and in_region(region)
+}
+
+entity repository {
+	relation owner @user
+
+	attribute public boolean = true
+
+	permission view = public or owner
+}
+
+rule check_balance(balance integer) {
+	balance >= 1000
+}
+
+rule in_region(region string) {
+	region == 'eu'
+}
+`
+
 	// DRIVE SAMPLE
 	driveSchema := `
 		entity user {}
@@ -110,6 +158,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -214,6 +263,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -320,6 +370,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -446,6 +497,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -572,6 +624,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -676,6 +729,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -811,6 +865,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -924,6 +979,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1041,6 +1097,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1156,6 +1213,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1261,6 +1319,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1366,6 +1425,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1510,6 +1570,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1648,6 +1709,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1779,6 +1841,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1904,6 +1967,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2038,6 +2102,7 @@ var _ = Describe("check-engine", func() {
 				nil,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2109,4 +2174,1001 @@ var _ = Describe("check-engine", func() {
 			}
 		})
 	})
+
+	Context("Public Wildcard Sample: Check", func() {
+		It("Public Wildcard Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(publicWildcardSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type check struct {
+				entity     string
+				subject    string
+				assertions map[string]base.CheckResult
+			}
+
+			tests := struct {
+				relationships []string
+				checks        []check
+			}{
+				relationships: []string{
+					"doc:1#viewer@user:*",
+					"doc:1#banned@user:3",
+					"doc:2#viewer@user:1",
+					"doc:3#viewer@organization:1#member",
+					"organization:1#member@user:*",
+				},
+				checks: []check{
+					{
+						entity:  "doc:1",
+						subject: "user:2",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "doc:1",
+						subject: "user:3",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+					{
+						entity:  "doc:1",
+						subject: "user:*",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "doc:2",
+						subject: "user:2",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+					{
+						entity:  "doc:2",
+						subject: "user:*",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_DENIED,
+						},
+					},
+					{
+						entity:  "doc:3",
+						subject: "user:5",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+					{
+						entity:  "doc:3",
+						subject: "user:*",
+						assertions: map[string]base.CheckResult{
+							"view": base.CheckResult_CHECK_RESULT_ALLOWED,
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range tests.checks {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				subject := &base.Subject{
+					Type:     ear.GetEntity().GetType(),
+					Id:       ear.GetEntity().GetId(),
+					Relation: ear.GetRelation(),
+				}
+
+				for permission, res := range check.assertions {
+					response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+						TenantId:   "t1",
+						Entity:     entity,
+						Subject:    subject,
+						Permission: permission,
+						Metadata: &base.PermissionCheckRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+							Depth:         20,
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).Should(Equal(response.GetCan()))
+				}
+			}
+		})
+	})
+	Context("Attribute Defaults Sample: Check", func() {
+		It("Attribute Defaults Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(attributeDefaultsSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type check struct {
+				entity     string
+				subject    string
+				permission string
+				result     base.CheckResult
+				err        string
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				checks        []check
+			}{
+				relationships: []string{
+					"organization:1#member@user:1",
+					"organization:2#member@user:1",
+				},
+				attributes: []string{
+					"organization:1$region|string:eu",
+					"organization:2$balance|integer:10",
+					"repository:1$public|boolean:false",
+				},
+				checks: []check{
+					{entity: "organization:1", subject: "user:1", permission: "withdraw", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+					{entity: "organization:1", subject: "user:1", permission: "view", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+					{entity: "organization:2", subject: "user:1", permission: "withdraw", result: base.CheckResult_CHECK_RESULT_DENIED},
+					{
+						entity: "organization:2", subject: "user:1", permission: "view",
+						err: base.ErrorCode_ERROR_CODE_MISSING_REQUIRED_ATTRIBUTE.String() + ": organization:2$region is required but has no value",
+					},
+					{entity: "repository:1", subject: "user:2", permission: "view", result: base.CheckResult_CHECK_RESULT_DENIED},
+					{entity: "repository:2", subject: "user:2", permission: "view", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			var attributes []*base.Attribute
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			for _, attr := range tests.attributes {
+				t, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range tests.checks {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     entity,
+					Subject:    &base.Subject{Type: ear.GetEntity().GetType(), Id: ear.GetEntity().GetId()},
+					Permission: check.permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+
+				if check.err != "" {
+					Expect(err).Should(MatchError(check.err))
+					continue
+				}
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result))
+			}
+		})
+	})
+
+	// SCHEMA MISMATCH SAMPLE
+	schemaMismatchSchema := `
+entity user {}
+
+entity team {
+	relation member @user
+}
+
+entity organization {
+	relation member @user
+}
+
+entity doc {
+	relation parent @team
+	relation viewer @user
+
+	permission view = viewer or parent.member
+}`
+
+	Context("Schema Mismatch Sample: Check", func() {
+		It("Schema Mismatch Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(schemaMismatchSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader, CheckMeter(telemetry.NewNoopMeter()))
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			// The relationships written under an older version of the schema, which allowed teams as viewers and
+			// organizations as parents
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"doc:1#viewer@team:1#member",
+				"doc:1#parent@organization:1",
+				"doc:1#parent@team:1",
+				"team:1#member@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ctx, stats := ContextWithCheckStats(context.Background())
+			response, err := invoker.Check(ctx, &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Subject:    &base.Subject{Type: "user", Id: "3"},
+				Permission: "view",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         20,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetCan()).Should(Equal(base.CheckResult_CHECK_RESULT_DENIED))
+
+			// The relationships the schema does not allow are reported, the valid ones are not
+			Expect(stats.Debug().GetSchemaMismatches()).Should(ConsistOf(
+				&base.SchemaMismatch{Item: "doc:1#viewer@team:1#member", Code: base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND},
+				&base.SchemaMismatch{Item: "doc:1#parent@organization:1", Code: base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND},
+			))
+		})
+	})
+
+	// RELATION REWRITE SAMPLE
+	relationRewriteSchema := `
+entity user {}
+
+entity team {
+	relation member @user
+	relation banned @user
+	relation active @user @team#member not banned
+}
+
+entity doc {
+	relation reviewer @user
+	relation approver @user @team#active and reviewer
+
+	permission approve = approver
+}`
+
+	Context("Relation Rewrite Sample: Check", func() {
+		It("Relation Rewrite Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(relationRewriteSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"team:1#member@user:3",
+				"team:1#member@user:4",
+				"team:1#banned@user:4",
+				"team:1#active@team:1#member",
+				"doc:1#approver@user:1",
+				"doc:1#approver@user:2",
+				"doc:1#approver@team:1#active",
+				"doc:1#reviewer@user:1",
+				"doc:1#reviewer@user:3",
+				"doc:1#reviewer@user:4",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range []struct {
+				entity     string
+				permission string
+				subject    string
+				result     base.CheckResult
+			}{
+				{"team:1", "active", "user:3", base.CheckResult_CHECK_RESULT_ALLOWED},
+				{"team:1", "active", "user:4", base.CheckResult_CHECK_RESULT_DENIED},
+				{"doc:1", "approve", "user:1", base.CheckResult_CHECK_RESULT_ALLOWED},
+				// Approvers must be reviewers too
+				{"doc:1", "approve", "user:2", base.CheckResult_CHECK_RESULT_DENIED},
+				{"doc:1", "approve", "user:3", base.CheckResult_CHECK_RESULT_ALLOWED},
+				// Banned members of the team are not active, so not approvers
+				{"doc:1", "approver", "user:4", base.CheckResult_CHECK_RESULT_DENIED},
+			} {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     entity,
+					Subject:    &base.Subject{Type: ear.GetEntity().GetType(), Id: ear.GetEntity().GetId()},
+					Permission: check.permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result), check.entity+"#"+check.permission+"@"+check.subject)
+			}
+		})
+	})
+
+	requestRuleSchema := `
+entity user {}
+
+entity document {
+	relation owner @user
+
+	attribute region string
+	attribute author string
+
+	permission view = owner and from_region(region)
+	permission edit = owner and written_by(author)
+}
+
+rule from_region(region string) {
+	request.context.region == region && request.time > timestamp('2020-01-01T00:00:00Z')
+}
+
+rule written_by(author string) {
+	request.actor == author
+}`
+
+	Context("Request Rule Sample: Check", func() {
+		It("Request Rule Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(requestRuleSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			t, err := tuple.Tuple("document:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var attributes []*base.Attribute
+			for _, attr := range []string{
+				"document:1$region|string:eu",
+				"document:1$author|string:alice",
+			} {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(t), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range []struct {
+				permission string
+				actor      string
+				region     string
+				result     base.CheckResult
+			}{
+				{"view", "", "eu", base.CheckResult_CHECK_RESULT_ALLOWED},
+				{"view", "", "us", base.CheckResult_CHECK_RESULT_DENIED},
+				{"edit", "alice", "eu", base.CheckResult_CHECK_RESULT_ALLOWED},
+				{"edit", "bob", "eu", base.CheckResult_CHECK_RESULT_DENIED},
+			} {
+				data, err := structpb.NewStruct(map[string]interface{}{"region": check.region})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ctx := authn.ContextWithActor(context.Background(), check.actor)
+				response, err := invoker.Check(ctx, &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: "1"},
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Permission: check.permission,
+					Context:    &base.Context{Data: data},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result), check.permission+" "+check.actor+" "+check.region)
+
+				// The results of rules reading the request are not cached
+				Expect(response.GetMetadata().GetRequestDependent()).Should(BeTrue())
+			}
+		})
+	})
+
+	relationWalkSchema := `
+entity user {}
+
+entity organization {
+	relation admin @user
+}
+
+entity folder {
+	relation parent @folder
+	relation org @organization
+}
+
+entity document {
+	relation folder @folder
+
+	permission view = folder.parent.org.admin
+}`
+
+	Context("Relation Walk Sample: Check", func() {
+		It("Relation Walk Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(relationWalkSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"organization:1#admin@user:1",
+				"folder:1#org@organization:1",
+				"folder:2#parent@folder:1",
+				"document:1#folder@folder:2",
+				"document:2#folder@folder:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The permissions the walk synthesizes on the folders are read with the folders
+			for entity, result := range map[string]base.CheckResult{
+				"1": base.CheckResult_CHECK_RESULT_ALLOWED,
+				"2": base.CheckResult_CHECK_RESULT_DENIED,
+			} {
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: entity},
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Permission: "view",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(result), "document:"+entity)
+			}
+		})
+	})
+
+	inheritedAttributeSchema := `
+entity user {}
+
+entity folder {
+	relation parent @folder
+
+	attribute classification string
+}
+
+entity document {
+	relation folder @folder
+	relation owner @user
+
+	permission view = owner or is_public(folder.classification)
+	permission edit = owner and in_region(folder.parent.classification, request.region)
+}
+
+rule is_public(classification string) {
+	classification == 'public'
+}
+
+rule in_region(classification string, region string) {
+	classification == region
+}`
+
+	Context("Inherited Attribute Sample: Check", func() {
+		It("Inherited Attribute Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(inheritedAttributeSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"folder:2#parent@folder:1",
+				"document:1#folder@folder:1",
+				"document:2#folder@folder:2",
+				"document:2#folder@folder:3",
+				"document:2#owner@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+			for _, attr := range []string{
+				"folder:1$classification|string:eu",
+				"folder:3$classification|string:public",
+			} {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range []struct {
+				entity     string
+				permission string
+				region     string
+				result     base.CheckResult
+			}{
+				{"document:1", "view", "", base.CheckResult_CHECK_RESULT_DENIED},
+				// One of the folders of the document is public
+				{"document:2", "view", "", base.CheckResult_CHECK_RESULT_ALLOWED},
+				// The folder of the folder of the document is in the region
+				{"document:2", "edit", "eu", base.CheckResult_CHECK_RESULT_ALLOWED},
+				{"document:2", "edit", "us", base.CheckResult_CHECK_RESULT_DENIED},
+			} {
+				data, err := structpb.NewStruct(map[string]interface{}{"region": check.region})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: check.entity[len("document:"):]},
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Permission: check.permission,
+					Context:    &base.Context{Data: data},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result), check.entity+"#"+check.permission+" "+check.region)
+			}
+		})
+	})
+
+	numericRuleSchema := `
+entity user {}
+
+entity account {
+	relation owner @user
+
+	attribute balance double
+	attribute overdraft integer
+
+	permission withdraw = owner and can_withdraw(balance, overdraft, request.amount)
+}
+
+rule can_withdraw(balance double, overdraft integer, amount double) {
+	between(amount, 1, 5000) && amount <= balance + overdraft
+}`
+
+	Context("Numeric Rule Sample: Check", func() {
+		It("Numeric Rule Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(numericRuleSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"account:1#owner@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+			for _, attr := range []string{
+				"account:1$balance|double:250.5",
+				"account:1$overdraft|integer:100",
+			} {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range []struct {
+				amount float64
+				result base.CheckResult
+			}{
+				{0.5, base.CheckResult_CHECK_RESULT_DENIED},
+				{1, base.CheckResult_CHECK_RESULT_ALLOWED},
+				// The overdraft is added to the balance
+				{350.5, base.CheckResult_CHECK_RESULT_ALLOWED},
+				{350.75, base.CheckResult_CHECK_RESULT_DENIED},
+			} {
+				data, err := structpb.NewStruct(map[string]interface{}{"amount": check.amount})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "account", Id: "1"},
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Permission: "withdraw",
+					Context:    &base.Context{Data: data},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result), fmt.Sprint(check.amount))
+			}
+		})
+	})
+
+	shadowPermissionSchema := `
+entity user {}
+
+entity document {
+	relation owner @user
+	relation editor @user
+
+	attribute status string (required)
+
+	permission edit = owner
+
+	@shadow(edit)
+	permission edit_next = owner or editor
+
+	@shadow(edit)
+	permission edit_open = owner and is_open(status)
+}
+
+rule is_open(status string) {
+	status == 'open'
+}`
+
+	Context("Shadow Permission Sample: Check", func() {
+		It("Shadow Permission Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(shadowPermissionSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader, CheckMeter(telemetry.NewNoopMeter()))
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"document:1#owner@user:1",
+				"document:1#editor@user:2",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, check := range []struct {
+				permission string
+				subject    string
+				result     base.CheckResult
+			}{
+				{"edit_next", "1", base.CheckResult_CHECK_RESULT_ALLOWED},
+				// The new logic allows the editor, but the legacy permission is the one returned
+				{"edit_next", "2", base.CheckResult_CHECK_RESULT_DENIED},
+				// The new logic fails as the status is required, but the legacy permission is the one returned
+				{"edit_open", "1", base.CheckResult_CHECK_RESULT_ALLOWED},
+				{"edit_open", "2", base.CheckResult_CHECK_RESULT_DENIED},
+			} {
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: "1"},
+					Subject:    &base.Subject{Type: "user", Id: check.subject},
+					Permission: check.permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result), check.permission+"@user:"+check.subject)
+			}
+		})
+	})
 })
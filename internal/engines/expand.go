@@ -22,16 +22,25 @@ type ExpandEngine struct {
 	schemaReader storage.SchemaReader
 	// relationshipReader is responsible for reading relationship information
 	dataReader storage.DataReader
+	// maxNodes is the maximum number of nodes of the trees returned, no limit if zero
+	maxNodes int
 }
 
 // NewExpandEngine - This function creates a new instance of ExpandEngine by taking a SchemaReader and a RelationshipReader as
 // parameters and returning a pointer to the created instance. The SchemaReader is used to read schema definitions, while the
 // RelationshipReader is used to read relationship definitions.
-func NewExpandEngine(sr storage.SchemaReader, rr storage.DataReader) *ExpandEngine {
-	return &ExpandEngine{
+func NewExpandEngine(sr storage.SchemaReader, rr storage.DataReader, opts ...ExpandOption) *ExpandEngine {
+	engine := &ExpandEngine{
 		schemaReader: sr,
 		dataReader:   rr,
 	}
+
+	// options
+	for _, opt := range opts {
+		opt(engine)
+	}
+
+	return engine
 }
 
 // Expand - This is the Run function of the ExpandEngine type, which takes a context, a PermissionExpandRequest,
@@ -43,12 +52,47 @@ func NewExpandEngine(sr storage.SchemaReader, rr storage.DataReader) *ExpandEngi
 // Finally, the function calls the expand function of the ExpandEngine type with the context, PermissionExpandRequest,
 // and false value, and returns the resulting PermissionExpandResponse and error. If there is an error, the span records
 // the error and sets the status to indicate an error.
+//
+// Trees with more nodes than the limit of the engine or the request are pruned, and the subtree of a pruned node is
+// returned for requests with its continuous token, expanded at the snapshot and schema version of the token.
 func (engine *ExpandEngine) Expand(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, err error) {
+	token := expandToken{
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+	}
+	if request.GetContinuousToken() != "" {
+		token, err = decodeExpandToken(request.GetContinuousToken())
+		if err != nil {
+			return nil, err
+		}
+		request.Metadata = &base.PermissionExpandRequestMetadata{
+			SnapToken:     token.SnapToken,
+			SchemaVersion: token.SchemaVersion,
+		}
+	}
+
 	resp := engine.expand(ctx, request)
 	if resp.Err != nil {
 		return nil, resp.Err
 	}
-	return resp.Response, resp.Err
+
+	tree, err := expandSubtree(resp.Response.GetTree(), token.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The lower of the limits applies, zero being no limit.
+	maxNodes := engine.maxNodes
+	if m := int(request.GetMaxNodes()); m > 0 && (maxNodes == 0 || m < maxNodes) {
+		maxNodes = m
+	}
+	if maxNodes > 0 {
+		if err = pruneExpandTree(tree, maxNodes, token); err != nil {
+			return nil, err
+		}
+	}
+
+	return &base.PermissionExpandResponse{Tree: tree}, nil
 }
 
 // ExpandResponse is a struct that contains the response and error returned from
@@ -106,8 +150,12 @@ func (engine *ExpandEngine) expand(ctx context.Context, request *base.Permission
 		// If the reference is an attribute, use the 'expandDirectAttribute' method.
 		fn = engine.expandDirectAttribute(request)
 	case base.EntityDefinition_REFERENCE_RELATION:
-		// If the reference is a relation, use the 'expandDirectRelation' method.
+		// If the reference is a relation, use the 'expandDirectRelation' method, combined with the rewrite of the
+		// relation if it has one.
 		fn = engine.expandDirectRelation(request)
+		if rewrite := en.GetRelations()[request.GetPermission()].GetRewrite(); rewrite != nil {
+			fn = engine.expandRelationRewrite(ctx, request, fn, rewrite)
+		}
 	default:
 		// If the reference is neither permission, attribute, nor relation, use the 'expandCall' method.
 		fn = engine.expandDirectCall(request)
@@ -146,6 +194,25 @@ func (engine *ExpandEngine) expandRewrite(ctx context.Context, request *base.Per
 	}
 }
 
+// expandRelationRewrite returns an ExpandFunction combining the expansion of the direct relationships of a relation
+// with the expansion of the children of its rewrite, by the operation of the rewrite.
+func (engine *ExpandEngine) expandRelationRewrite(ctx context.Context, request *base.PermissionExpandRequest, direct ExpandFunction, rewrite *base.Rewrite) ExpandFunction {
+	var combiner ExpandCombiner
+	switch rewrite.GetRewriteOperation() {
+	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
+		combiner = expandIntersection
+	case *base.Rewrite_OPERATION_EXCLUSION.Enum():
+		combiner = expandExclusion
+	default:
+		return expandFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
+	}
+
+	children := engine.setChild(ctx, request, rewrite.GetChildren(), expandUnion)
+	return func(ctx context.Context, resultChan chan<- ExpandResponse) {
+		resultChan <- combiner(ctx, request.GetEntity(), request.GetPermission(), request.GetArguments(), []ExpandFunction{direct, children})
+	}
+}
+
 // 'expandLeaf' is a method of ExpandEngine which takes a PermissionExpandRequest and a leaf object.
 // It returns an ExpandFunction, a function which performs the action associated with a given leaf type.
 func (engine *ExpandEngine) expandLeaf(
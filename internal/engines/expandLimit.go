@@ -0,0 +1,92 @@
+package engines
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// expandToken - Continuous token of a pruned node of an expansion tree. It locates the node by the indexes of the
+// children leading to it from the root, and pins the snapshot and schema version the tree was expanded at.
+type expandToken struct {
+	SnapToken     string `json:"s"`
+	SchemaVersion string `json:"v"`
+	Path          []int  `json:"p"`
+}
+
+// encode encodes the token to a string
+func (t expandToken) encode() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// decodeExpandToken decodes the continuous token of an expansion request
+func decodeExpandToken(value string) (expandToken, error) {
+	var t expandToken
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return t, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if err = json.Unmarshal(b, &t); err != nil {
+		return t, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return t, nil
+}
+
+// expandSubtree returns the node of the tree at the path.
+func expandSubtree(tree *base.Expand, path []int) (*base.Expand, error) {
+	node := tree
+	for _, i := range path {
+		children := node.GetExpand().GetChildren()
+		if i < 0 || i >= len(children) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		node = children[i]
+	}
+	return node, nil
+}
+
+// pruneExpandTree keeps the first maxNodes nodes of the tree, breadth first, and replaces the children beyond them
+// with pruned nodes. The token of each pruned node locates it from the root of the whole tree, the tree being the
+// node at the path of the token.
+func pruneExpandTree(tree *base.Expand, maxNodes int, token expandToken) error {
+	type entry struct {
+		node *base.Expand
+		path []int
+	}
+
+	kept := 1
+	queue := []entry{{node: tree, path: token.Path}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		children := current.node.GetExpand().GetChildren()
+		for i, child := range children {
+			path := append(append(make([]int, 0, len(current.path)+1), current.path...), i)
+			if kept < maxNodes {
+				kept++
+				queue = append(queue, entry{node: child, path: path})
+				continue
+			}
+
+			ct, err := expandToken{SnapToken: token.SnapToken, SchemaVersion: token.SchemaVersion, Path: path}.encode()
+			if err != nil {
+				return err
+			}
+			children[i] = &base.Expand{
+				Entity:          child.GetEntity(),
+				Permission:      child.GetPermission(),
+				Arguments:       child.GetArguments(),
+				Pruned:          true,
+				ContinuousToken: ct,
+			}
+		}
+	}
+	return nil
+}
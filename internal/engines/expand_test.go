@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -956,6 +957,7 @@ var _ = Describe("expand-engine", func() {
 				expandEngine,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1228,6 +1230,7 @@ var _ = Describe("expand-engine", func() {
 				expandEngine,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1459,6 +1462,7 @@ var _ = Describe("expand-engine", func() {
 				expandEngine,
 				nil,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1518,4 +1522,119 @@ var _ = Describe("expand-engine", func() {
 			}
 		})
 	})
+
+	Context("Drive Sample: Expand Limits", func() {
+		It("Drive Sample: Prunes trees and fetches the subtrees of pruned nodes", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(driveSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"doc:1#owner@user:2",
+				"doc:1#owner@folder:2#creator",
+				"doc:1#parent@folder:1#...",
+				"doc:1#org@organization:1#...",
+				"folder:1#collaborator@user:1",
+				"folder:2#creator@user:89",
+				"organization:1#admin@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expandEngine := NewExpandEngine(schemaReader, dataReader, ExpandMaxNodes(100))
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				nil,
+				expandEngine,
+				nil,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			expand := func(maxNodes uint32, ct string) *base.Expand {
+				response, err := invoker.Expand(context.Background(), &base.PermissionExpandRequest{
+					TenantId:        "t1",
+					Entity:          &base.Entity{Type: "doc", Id: "1"},
+					Permission:      "read",
+					Metadata:        &base.PermissionExpandRequestMetadata{},
+					MaxNodes:        maxNodes,
+					ContinuousToken: ct,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetTree()
+			}
+
+			// count returns the number of nodes of the tree that aren't pruned, and the pruned ones.
+			var count func(tree *base.Expand) (int, []*base.Expand)
+			count = func(tree *base.Expand) (int, []*base.Expand) {
+				if tree.GetPruned() {
+					return 0, []*base.Expand{tree}
+				}
+				nodes, pruned := 1, []*base.Expand(nil)
+				for _, child := range tree.GetExpand().GetChildren() {
+					n, p := count(child)
+					nodes += n
+					pruned = append(pruned, p...)
+				}
+				return nodes, pruned
+			}
+
+			full := expand(0, "")
+			nodes, pruned := count(full)
+			Expect(pruned).Should(BeEmpty())
+			Expect(nodes).Should(BeNumerically(">", 4))
+
+			limited := expand(4, "")
+			nodes, pruned = count(limited)
+			Expect(nodes).Should(Equal(4))
+			Expect(pruned).ShouldNot(BeEmpty())
+
+			// The subtrees of the pruned nodes are the ones of the full tree.
+			for _, p := range pruned {
+				Expect(p.GetNode()).Should(BeNil())
+				Expect(p.GetContinuousToken()).ShouldNot(BeEmpty())
+
+				token, err := decodeExpandToken(p.GetContinuousToken())
+				Expect(err).ShouldNot(HaveOccurred())
+				subtree, err := expandSubtree(full, token.Path)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(proto.Equal(expand(0, p.GetContinuousToken()), subtree)).Should(BeTrue())
+				Expect(proto.Equal(p.GetEntity(), subtree.GetEntity())).Should(BeTrue())
+				Expect(p.GetPermission()).Should(Equal(subtree.GetPermission()))
+			}
+
+			_, err = invoker.Expand(context.Background(), &base.PermissionExpandRequest{
+				TenantId:        "t1",
+				Entity:          &base.Entity{Type: "doc", Id: "1"},
+				Permission:      "read",
+				Metadata:        &base.PermissionExpandRequestMetadata{},
+				ContinuousToken: "invalid",
+			})
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String()))
+		})
+	})
 })
@@ -3,12 +3,16 @@ package engines
 import (
 	"context"
 	"errors"
+	"sort"
 	"sync"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 type LookupEngine struct {
@@ -49,6 +53,17 @@ func NewLookupEngine(
 // LookupEntity performs a permission check on a set of entities and returns a response
 // containing the IDs of the entities that have the requested permission.
 func (engine *LookupEngine) LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error) {
+	// The data reader evaluates the lookup itself when it can
+	ids, ok, err := engine.lookupEntities(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return &base.PermissionLookupEntityResponse{
+			EntityIds: ids,
+		}, nil
+	}
+
 	// A mutex and slice are declared to safely store entity IDs from concurrent callbacks
 	var mu sync.Mutex
 	var entityIDs []string
@@ -70,51 +85,12 @@ func (engine *LookupEngine) LookupEntity(ctx context.Context, request *base.Perm
 	// Create and start BulkPublisher. It receives entities and passes them to BulkChecker.
 	publisher := NewBulkEntityPublisher(ctx, request, checker)
 
-	// Retrieve the schema of the entity based on the tenantId and schema version
-	var sc *base.SchemaDefinition
-	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	// Publish the entities that may have the permission to the BulkChecker
+	err = engine.filterEntities(ctx, request, publisher)
 	if err != nil {
 		return nil, err
 	}
 
-	// Perform a walk of the entity schema for the permission check
-	err = schema.NewWalker(sc).Walk(request.GetEntityType(), request.GetPermission())
-	if err != nil {
-		// If the error is unimplemented, handle it with a MassEntityFilter
-		if errors.Is(err, schema.ErrUnimplemented) {
-			err = NewMassEntityFilter(engine.dataReader).EntityFilter(ctx, request, publisher)
-			if err != nil {
-				return nil, err
-			}
-		} else { // For other errors, simply return the error
-			return nil, err
-		}
-	} else {
-
-		// Create a map to keep track of visited entities
-		visits := &ERMap{}
-
-		// Perform an entity filter operation based on the permission request
-		err = NewSchemaBasedEntityFilter(engine.dataReader, sc).EntityFilter(ctx, &base.PermissionEntityFilterRequest{
-			TenantId: request.GetTenantId(),
-			Metadata: &base.PermissionEntityFilterRequestMetadata{
-				SnapToken:     request.GetMetadata().GetSnapToken(),
-				SchemaVersion: request.GetMetadata().GetSchemaVersion(),
-				Depth:         request.GetMetadata().GetDepth(),
-			},
-			EntityReference: &base.RelationReference{
-				Type:     request.GetEntityType(),
-				Relation: request.GetPermission(),
-			},
-			Subject: request.GetSubject(),
-			Context: request.GetContext(),
-		}, visits, publisher)
-
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	// Stop the BulkChecker and wait for it to finish processing entities
 	checker.Stop()
 	err = checker.Wait()
@@ -131,6 +107,22 @@ func (engine *LookupEngine) LookupEntity(ctx context.Context, request *base.Perm
 // LookupEntityStream performs a permission check on a set of entities and streams the results
 // containing the IDs of the entities that have the requested permission.
 func (engine *LookupEngine) LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error) {
+	// The data reader evaluates the lookup itself when it can
+	ids, ok, err := engine.lookupEntities(ctx, request)
+	if err != nil {
+		return err
+	}
+	if ok {
+		for _, id := range ids {
+			if err = server.Send(&base.PermissionLookupEntityStreamResponse{
+				EntityId: id,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	// Define a callback function that will be called for each entity that passes the permission check.
 	// If the check result is allowed, it sends the entity ID to the server stream.
 	callback := func(entityID string, result base.CheckResult) {
@@ -152,59 +144,114 @@ func (engine *LookupEngine) LookupEntityStream(ctx context.Context, request *bas
 	// Create and start BulkPublisher. It receives entities and passes them to BulkChecker.
 	publisher := NewBulkEntityPublisher(ctx, request, checker)
 
-	// Retrieve the entity definition schema based on the tenantId and schema version
-	var sc *base.SchemaDefinition
-	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	// Publish the entities that may have the permission to the BulkChecker
+	err = engine.filterEntities(ctx, request, publisher)
 	if err != nil {
 		return err
 	}
 
-	// Perform a permission check walk through the entity schema
-	err = schema.NewWalker(sc).Walk(request.GetEntityType(), request.GetPermission())
-
-	// If error exists in permission check walk
+	// Stop the BulkChecker and wait for it to finish processing entities
+	checker.Stop()
+	err = checker.Wait()
 	if err != nil {
-		// If the error is unimplemented, handle it with a MassEntityFilter
-		if errors.Is(err, schema.ErrUnimplemented) {
-			err = NewMassEntityFilter(engine.dataReader).EntityFilter(ctx, request, publisher)
+		return
+	}
+
+	return err
+}
+
+// LookupEntityWithPermissions performs the permission checks of a lookup for each of the requested permissions at once,
+// and returns the entities on which the subject has any of them, along with the ones it has.
+func (engine *LookupEngine) LookupEntityWithPermissions(ctx context.Context, request *base.PermissionLookupEntityWithPermissionsRequest) (response *base.PermissionLookupEntityWithPermissionsResponse, err error) {
+	// A mutex and map are declared to safely store the permissions of entities from concurrent callbacks
+	var mu sync.Mutex
+	permissions := make(map[string]map[string]struct{})
+
+	// The lookups of the permissions don't cancel each other, as the publishers of the BulkCheckers don't stop on
+	// cancellation.
+	g := &errgroup.Group{}
+	for _, permission := range request.GetPermissions() {
+		permission := permission
+
+		// Each permission is looked up like a single one, sharing the snapshot and schema version of the request.
+		g.Go(func() error {
+			lookup := &base.PermissionLookupEntityRequest{
+				TenantId:   request.GetTenantId(),
+				Metadata:   request.GetMetadata(),
+				EntityType: request.GetEntityType(),
+				Permission: permission,
+				Subject:    request.GetSubject(),
+				Context:    request.GetContext(),
+			}
+
+			// Record the permission for the entities the data reader evaluates when it can.
+			ids, ok, err := engine.lookupEntities(ctx, lookup)
 			if err != nil {
 				return err
 			}
-		} else { // For other types of errors, simply return the error
-			return err
-		}
-	} else { // If there was no error in permission check walk
-		visits := &ERMap{}
-
-		// Perform an entity filter operation based on the permission request
-		err = NewSchemaBasedEntityFilter(engine.dataReader, sc).EntityFilter(ctx, &base.PermissionEntityFilterRequest{
-			TenantId: request.GetTenantId(),
-			Metadata: &base.PermissionEntityFilterRequestMetadata{
-				SnapToken:     request.GetMetadata().GetSnapToken(),
-				SchemaVersion: request.GetMetadata().GetSchemaVersion(),
-				Depth:         request.GetMetadata().GetDepth(),
-			},
-			EntityReference: &base.RelationReference{
-				Type:     request.GetEntityType(),
-				Relation: request.GetPermission(),
-			},
-			Subject: request.GetSubject(),
-			Context: request.GetContext(),
-		}, visits, publisher)
-
-		if err != nil {
+			if ok {
+				mu.Lock()
+				defer mu.Unlock()
+				for _, id := range ids {
+					if _, ok := permissions[id]; !ok {
+						permissions[id] = make(map[string]struct{})
+					}
+					permissions[id][permission] = struct{}{}
+				}
+				return nil
+			}
+
+			// Callback function which records the permission for the entities that pass the permission check.
+			callback := func(entityID string, result base.CheckResult) {
+				if result == base.CheckResult_CHECK_RESULT_ALLOWED {
+					mu.Lock()
+					defer mu.Unlock()
+					if _, ok := permissions[entityID]; !ok {
+						permissions[entityID] = make(map[string]struct{})
+					}
+					permissions[entityID][permission] = struct{}{}
+				}
+			}
+
+			checker := NewBulkChecker(ctx, engine.checkEngine, callback, engine.concurrencyLimit)
+			checker.Start(BULK_ENTITY)
+
+			err = engine.filterEntities(ctx, lookup, NewBulkEntityPublisher(ctx, lookup, checker))
+
+			// Stop the BulkChecker and wait for it to finish processing entities
+			checker.Stop()
+			if werr := checker.Wait(); err == nil {
+				err = werr
+			}
 			return err
-		}
+		})
 	}
 
-	// Stop the BulkChecker and wait for it to finish processing entities
-	checker.Stop()
-	err = checker.Wait()
-	if err != nil {
-		return
+	if err = g.Wait(); err != nil {
+		return nil, err
 	}
 
-	return err
+	// Sort the entities by their identifiers, listing their permissions in the order of the request.
+	ids := make([]string, 0, len(permissions))
+	for id := range permissions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	entities := make([]*base.EntityPermissions, 0, len(ids))
+	for _, id := range ids {
+		entity := &base.EntityPermissions{EntityId: id}
+		for _, permission := range request.GetPermissions() {
+			if _, ok := permissions[id][permission]; ok {
+				entity.Permissions = append(entity.Permissions, permission)
+			}
+		}
+		entities = append(entities, entity)
+	}
+
+	return &base.PermissionLookupEntityWithPermissionsResponse{
+		Entities: entities,
+	}, nil
 }
 
 // LookupSubject checks if a subject has a particular permission based on the schema and version.
@@ -280,6 +327,80 @@ func (engine *LookupEngine) LookupSubject(ctx context.Context, request *base.Per
 	}, nil
 }
 
+// filterEntities publishes the entities of the request that may have the permission to the publisher, walking the
+// schema for the permission, or going through all the entities of the type if the schema can't be walked.
+func (engine *LookupEngine) filterEntities(ctx context.Context, request *base.PermissionLookupEntityRequest, publisher *BulkEntityPublisher) (err error) {
+	// Retrieve the schema of the entity based on the tenantId and schema version
+	var sc *base.SchemaDefinition
+	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return err
+	}
+
+	// Perform a walk of the entity schema for the permission check
+	err = schema.NewWalker(sc).Walk(request.GetEntityType(), request.GetPermission())
+	if err != nil {
+		// If the error is unimplemented, handle it with a MassEntityFilter
+		if errors.Is(err, schema.ErrUnimplemented) {
+			return NewMassEntityFilter(engine.dataReader).EntityFilter(ctx, request, publisher)
+		}
+		// For other errors, simply return the error
+		return err
+	}
+
+	// Create a map to keep track of visited entities
+	visits := &ERMap{}
+
+	// Perform an entity filter operation based on the permission request
+	return NewSchemaBasedEntityFilter(engine.dataReader, sc).EntityFilter(ctx, &base.PermissionEntityFilterRequest{
+		TenantId: request.GetTenantId(),
+		Metadata: &base.PermissionEntityFilterRequestMetadata{
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			Depth:         request.GetMetadata().GetDepth(),
+		},
+		EntityReference: &base.RelationReference{
+			Type:     request.GetEntityType(),
+			Relation: request.GetPermission(),
+		},
+		Subject: request.GetSubject(),
+		Context: request.GetContext(),
+	}, visits, publisher)
+}
+
+// lookupEntities returns the entities of the request the subject has the permission on, evaluated by the data
+// reader with the lookup plan of the permission. It returns false when the data reader doesn't evaluate lookups, or
+// can't evaluate this one: when the permission can't be planned, the subject is a subject set, the request has
+// contextual relationships, or its depth isn't enough for the checks of the plan.
+func (engine *LookupEngine) lookupEntities(ctx context.Context, request *base.PermissionLookupEntityRequest) (ids []string, ok bool, err error) {
+	lookup, ok := engine.dataReader.(storage.EntityLookup)
+	if !ok || tuple.NormalizeRelation(request.GetSubject().GetRelation()) != "" || len(request.GetContext().GetTuples()) > 0 {
+		return nil, false, nil
+	}
+
+	var sc *base.SchemaDefinition
+	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, false, err
+	}
+
+	planned, ok := newLookupPlanner(sc).plan(request.GetEntityType(), request.GetPermission())
+	if !ok || planned.depth >= request.GetMetadata().GetDepth() {
+		return nil, false, nil
+	}
+
+	ids, err = lookup.LookupEntities(ctx, request.GetTenantId(), planned.plan, request.GetSubject(), request.GetMetadata().GetSnapToken())
+	if err != nil {
+		if errors.Is(err, storage.ErrLookupUnsupported) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	sort.Strings(ids)
+	return ids, true, nil
+}
+
 // readSchema retrieves a SchemaDefinition for a given tenantID and schemaVersion.
 // It first checks a cache (schemaMap) for the schema, and if not found, reads it using the schemaReader.
 func (engine *LookupEngine) readSchema(ctx context.Context, tenantID, schemaVersion string) (*base.SchemaDefinition, error) {
@@ -0,0 +1,164 @@
+package engines
+
+import (
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// lookupPlanner builds the lookup plans of the permissions and relations of a schema, which the data readers
+// implementing storage.EntityLookup evaluate in the storage. A plan follows the checks of the check engine: the
+// relationships of a relation match the subject directly or through the subjects of their subject relation, the
+// ones of a tuple to user set through the computed relation of their subjects, and the rewrites are set operations.
+type lookupPlanner struct {
+	schema *base.SchemaDefinition
+	// plans are the plans built so far, nil for the permissions and relations that can't be planned
+	plans map[string]*plannedLookup
+	// visiting are the permissions and relations being planned, which can't be planned again while they are
+	visiting map[string]struct{}
+}
+
+// plannedLookup - Lookup plan of a permission or relation
+type plannedLookup struct {
+	plan *storage.LookupPlan
+	// depth is the number of checks the check engine dispatches one after the other, at most, evaluating it
+	depth int32
+}
+
+// newLookupPlanner creates a planner of the lookups of the schema.
+func newLookupPlanner(sc *base.SchemaDefinition) *lookupPlanner {
+	return &lookupPlanner{
+		schema:   sc,
+		plans:    map[string]*plannedLookup{},
+		visiting: map[string]struct{}{},
+	}
+}
+
+// plan returns the lookup plan of the permission or relation of the entity type, or false when it can't be planned:
+// when it depends on attributes, rules or, through the relationships, on itself.
+func (p *lookupPlanner) plan(entityType, name string) (*plannedLookup, bool) {
+	key := entityType + "#" + name
+	if planned, ok := p.plans[key]; ok {
+		return planned, planned != nil
+	}
+	if _, ok := p.visiting[key]; ok {
+		return nil, false
+	}
+	p.visiting[key] = struct{}{}
+	defer delete(p.visiting, key)
+
+	var planned *plannedLookup
+	var ok bool
+	if en, found := p.schema.GetEntityDefinitions()[entityType]; found {
+		tor, _ := schema.GetTypeOfReferenceByNameInEntityDefinition(en, name)
+		switch tor {
+		case base.EntityDefinition_REFERENCE_PERMISSION:
+			planned, ok = p.child(en, en.GetPermissions()[name].GetChild())
+		case base.EntityDefinition_REFERENCE_RELATION:
+			planned, ok = p.relation(en, en.GetRelations()[name])
+		}
+	}
+
+	// The permissions and relations that can't be planned can't be planned from anywhere else either
+	if !ok {
+		planned = nil
+	}
+	p.plans[key] = planned
+	return planned, ok
+}
+
+// relation plans the relationships of the relation, with a branch per subject type the relation references. Relations
+// combined with other relations and permissions can't be planned.
+func (p *lookupPlanner) relation(en *base.EntityDefinition, relation *base.RelationDefinition) (*plannedLookup, bool) {
+	if relation.GetRewrite() != nil {
+		return nil, false
+	}
+	planned := &plannedLookup{plan: &storage.LookupPlan{
+		Operation:  storage.LookupRelationships,
+		EntityType: en.GetName(),
+		Relation:   relation.GetName(),
+	}}
+	for _, reference := range relation.GetRelationReferences() {
+		branch := storage.LookupBranch{
+			SubjectType:     reference.GetType(),
+			SubjectRelation: tuple.NormalizeRelation(reference.GetRelation()),
+		}
+		if branch.SubjectRelation != "" {
+			subjects, ok := p.plan(reference.GetType(), branch.SubjectRelation)
+			if !ok {
+				return nil, false
+			}
+			branch.Subjects = subjects.plan
+			planned.depth = max(planned.depth, subjects.depth+1)
+		}
+		planned.plan.Branches = append(planned.plan.Branches, branch)
+	}
+	return planned, true
+}
+
+// child plans the rewrite or leaf of a permission of the entity.
+func (p *lookupPlanner) child(en *base.EntityDefinition, child *base.Child) (*plannedLookup, bool) {
+	if rewrite := child.GetRewrite(); rewrite != nil {
+		planned := &plannedLookup{plan: &storage.LookupPlan{}}
+		switch rewrite.GetRewriteOperation() {
+		case base.Rewrite_OPERATION_UNION:
+			planned.plan.Operation = storage.LookupUnion
+		case base.Rewrite_OPERATION_INTERSECTION:
+			planned.plan.Operation = storage.LookupIntersection
+		case base.Rewrite_OPERATION_EXCLUSION:
+			planned.plan.Operation = storage.LookupExclusion
+		default:
+			return nil, false
+		}
+		for _, c := range rewrite.GetChildren() {
+			operand, ok := p.child(en, c)
+			if !ok {
+				return nil, false
+			}
+			planned.plan.Children = append(planned.plan.Children, operand.plan)
+			planned.depth = max(planned.depth, operand.depth)
+		}
+		return planned, len(planned.plan.Children) > 0
+	}
+
+	switch leaf := child.GetLeaf().GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		computed, ok := p.plan(en.GetName(), leaf.ComputedUserSet.GetRelation())
+		if !ok {
+			return nil, false
+		}
+		return &plannedLookup{plan: computed.plan, depth: computed.depth + 1}, true
+	case *base.Leaf_TupleToUserSet:
+		relation, ok := en.GetRelations()[leaf.TupleToUserSet.GetTupleSet().GetRelation()]
+		if !ok {
+			return nil, false
+		}
+		planned := &plannedLookup{plan: &storage.LookupPlan{
+			Operation:  storage.LookupRelationships,
+			EntityType: en.GetName(),
+			Relation:   relation.GetName(),
+		}}
+		types := map[string]struct{}{}
+		for _, reference := range relation.GetRelationReferences() {
+			if _, ok := types[reference.GetType()]; ok {
+				continue
+			}
+			types[reference.GetType()] = struct{}{}
+
+			subjects, ok := p.plan(reference.GetType(), leaf.TupleToUserSet.GetComputed().GetRelation())
+			if !ok {
+				return nil, false
+			}
+			planned.plan.Branches = append(planned.plan.Branches, storage.LookupBranch{
+				SubjectType: reference.GetType(),
+				AnyRelation: true,
+				Subjects:    subjects.plan,
+			})
+			planned.depth = max(planned.depth, subjects.depth+1)
+		}
+		return planned, true
+	default:
+		return nil, false
+	}
+}
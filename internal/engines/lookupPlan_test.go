@@ -0,0 +1,186 @@
+package engines
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// lookupDataReader - Data reader evaluating the lookups of entities with the given result
+type lookupDataReader struct {
+	storage.DataReader
+	ids   []string
+	err   error
+	plans []*storage.LookupPlan
+}
+
+func (r *lookupDataReader) LookupEntities(_ context.Context, _ string, plan *storage.LookupPlan, _ *base.Subject, _ string) ([]string, error) {
+	r.plans = append(r.plans, plan)
+	return r.ids, r.err
+}
+
+var _ = Describe("lookup-plan", func() {
+	driveSchemaLookupPlan := `
+entity user {}
+
+entity organization {
+	relation admin @user
+	relation member @user @organization#member
+}
+
+entity folder {
+	relation org @organization
+	relation collaborator @user
+
+	permission update = collaborator
+}
+
+entity doc {
+	relation org @organization
+	relation parent @folder
+	relation owner @user
+	relation reviewer @user @organization#admin
+
+	attribute public boolean
+
+	permission read = (owner or parent.collaborator) or org.admin
+	permission update = owner and org.admin
+	permission share = update and (owner or parent.update)
+	permission review = reviewer not owner
+	permission view = public or owner
+	permission member = org.member
+}
+`
+
+	Context("Planner", func() {
+		It("should plan the rewrites as set operations over the relationships", func() {
+			sc, err := schema.NewSchemaFromStringDefinitions(true, driveSchemaLookupPlan)
+			Expect(err).ShouldNot(HaveOccurred())
+			planner := newLookupPlanner(sc)
+
+			planned, ok := planner.plan("doc", "review")
+			Expect(ok).Should(BeTrue())
+			Expect(planned.plan.Operation).Should(Equal(storage.LookupExclusion))
+			Expect(planned.plan.Children).Should(HaveLen(2))
+
+			reviewer := planned.plan.Children[0]
+			Expect(reviewer.Operation).Should(Equal(storage.LookupRelationships))
+			Expect(reviewer.Relation).Should(Equal("reviewer"))
+			Expect(reviewer.Branches).Should(HaveLen(2))
+			Expect(reviewer.Branches[0].Subjects).Should(BeNil())
+			Expect(reviewer.Branches[1].SubjectType).Should(Equal("organization"))
+			Expect(reviewer.Branches[1].SubjectRelation).Should(Equal("admin"))
+			Expect(reviewer.Branches[1].Subjects.Relation).Should(Equal("admin"))
+			// The reviewers are checked, then the admins of their organizations
+			Expect(planned.depth).Should(Equal(int32(2)))
+
+			planned, ok = planner.plan("doc", "read")
+			Expect(ok).Should(BeTrue())
+			Expect(planned.plan.Operation).Should(Equal(storage.LookupUnion))
+			org := planned.plan.Children[1]
+			Expect(org.Relation).Should(Equal("org"))
+			Expect(org.Branches).Should(HaveLen(1))
+			Expect(org.Branches[0].AnyRelation).Should(BeTrue())
+			Expect(org.Branches[0].Subjects.EntityType).Should(Equal("organization"))
+			Expect(planned.depth).Should(Equal(int32(1)))
+		})
+
+		It("should share the plans of the permissions and relations used several times", func() {
+			sc, err := schema.NewSchemaFromStringDefinitions(true, driveSchemaLookupPlan)
+			Expect(err).ShouldNot(HaveOccurred())
+			planner := newLookupPlanner(sc)
+
+			update, ok := planner.plan("doc", "update")
+			Expect(ok).Should(BeTrue())
+			share, ok := planner.plan("doc", "share")
+			Expect(ok).Should(BeTrue())
+
+			Expect(share.plan.Children[0]).Should(BeIdenticalTo(update.plan))
+			Expect(share.plan.Children[1].Children[0]).Should(BeIdenticalTo(update.plan.Children[0]))
+		})
+
+		It("should not plan the permissions depending on attributes or on themselves", func() {
+			sc, err := schema.NewSchemaFromStringDefinitions(true, driveSchemaLookupPlan)
+			Expect(err).ShouldNot(HaveOccurred())
+			planner := newLookupPlanner(sc)
+
+			_, ok := planner.plan("doc", "view")
+			Expect(ok).Should(BeFalse())
+			_, ok = planner.plan("doc", "member")
+			Expect(ok).Should(BeFalse())
+			_, ok = planner.plan("doc", "unknown")
+			Expect(ok).Should(BeFalse())
+		})
+	})
+
+	Context("Lookup Entity", func() {
+		It("should use the lookups of the data reader, and check the entities when it can't evaluate them", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(driveSchemaLookupPlan)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := &lookupDataReader{DataReader: factories.DataReaderFactory(db), ids: []string{"3", "1"}}
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			lookupEngine := NewLookupEngine(checkEngine, schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, lookupEngine, nil, nil, telemetry.NewNoopMeter())
+			checkEngine.SetInvoker(invoker)
+
+			t, err := tuple.Tuple("doc:2#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(t), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			lookup := func(subject *base.Subject, depth int32) []string {
+				response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					EntityType: "doc",
+					Permission: "review",
+					Subject:    subject,
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken: token.NewNoopToken().Encode().String(),
+						Depth:     depth,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetEntityIds()
+			}
+
+			Expect(lookup(&base.Subject{Type: "user", Id: "1"}, 100)).Should(Equal([]string{"1", "3"}))
+			Expect(dataReader.plans).Should(HaveLen(1))
+
+			// The subject sets, and the lookups without the depth for the checks of the plan, are checked
+			Expect(lookup(&base.Subject{Type: "organization", Id: "1", Relation: "admin"}, 100)).Should(BeEmpty())
+			Expect(lookup(&base.Subject{Type: "user", Id: "1"}, 2)).Should(BeEmpty())
+			Expect(dataReader.plans).Should(HaveLen(1))
+
+			dataReader.err = storage.ErrLookupUnsupported
+			Expect(lookup(&base.Subject{Type: "user", Id: "2"}, 100)).Should(BeEmpty())
+			Expect(dataReader.plans).Should(HaveLen(2))
+		})
+	})
+})
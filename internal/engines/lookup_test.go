@@ -19,6 +19,22 @@ import (
 )
 
 var _ = Describe("lookup-entity-engine", func() {
+	// PUBLIC WILDCARD SAMPLE
+	publicWildcardSchemaEntityFilter := `
+entity user {}
+
+entity organization {
+	relation member @user
+}
+
+entity doc {
+	relation viewer @user @organization#member
+	relation banned @user
+
+	permission view = viewer not banned
+}
+`
+
 	// DRIVE SAMPLE
 
 	driveSchemaEntityFilter := `
@@ -119,6 +135,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -252,6 +269,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -370,6 +388,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -488,6 +507,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -609,6 +629,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -739,6 +760,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -931,6 +953,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1218,6 +1241,7 @@ entity event {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1434,6 +1458,7 @@ entity event {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1630,6 +1655,7 @@ entity organization {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1812,6 +1838,7 @@ entity organization {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -1926,6 +1953,7 @@ entity organization {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2084,6 +2112,7 @@ entity organization {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2234,6 +2263,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2336,6 +2366,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2445,6 +2476,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2544,6 +2576,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2645,6 +2678,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2746,6 +2780,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -2852,6 +2887,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -3008,6 +3044,7 @@ entity doc {
 				nil,
 				lookupEngine,
 				nil,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -3055,4 +3092,209 @@ entity doc {
 			}
 		})
 	})
+
+	Context("Public Wildcard Sample: Entity Filter", func() {
+		It("Public Wildcard Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(publicWildcardSchemaEntityFilter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type filter struct {
+				entityType string
+				subject    string
+				assertions map[string][]string
+			}
+
+			tests := struct {
+				relationships []string
+				filters       []filter
+			}{
+				relationships: []string{
+					"doc:1#viewer@user:*",
+					"doc:1#banned@user:3",
+					"doc:2#viewer@user:1",
+					"doc:3#viewer@organization:1#member",
+					"organization:1#member@user:*",
+				},
+				filters: []filter{
+					{
+						entityType: "doc",
+						subject:    "user:1",
+						assertions: map[string][]string{
+							"view": {"1", "2", "3"},
+						},
+					},
+					{
+						entityType: "doc",
+						subject:    "user:3",
+						assertions: map[string][]string{
+							"view": {"3"},
+						},
+					},
+					{
+						entityType: "doc",
+						subject:    "user:*",
+						assertions: map[string][]string{
+							"view": {"1", "3"},
+						},
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			lookupEngine := NewLookupEngine(
+				checkEngine,
+				schemaReader,
+				dataReader,
+			)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				lookupEngine,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, filter := range tests.filters {
+				ear, err := tuple.EAR(filter.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				subject := &base.Subject{
+					Type:     ear.GetEntity().GetType(),
+					Id:       ear.GetEntity().GetId(),
+					Relation: ear.GetRelation(),
+				}
+
+				for permission, res := range filter.assertions {
+					response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+						TenantId:   "t1",
+						EntityType: filter.entityType,
+						Subject:    subject,
+						Permission: permission,
+						Metadata: &base.PermissionLookupEntityRequestMetadata{
+							SnapToken:     token.NewNoopToken().Encode().String(),
+							SchemaVersion: "",
+							Depth:         100,
+						},
+					})
+
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(isSameArray(response.GetEntityIds(), res)).Should(Equal(true))
+				}
+			}
+		})
+	})
+
+	Context("Drive Sample: Entity Filter With Permissions", func() {
+		It("Drive Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(driveSchemaEntityFilter)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			lookupEngine := NewLookupEngine(
+				checkEngine,
+				schemaReader,
+				dataReader,
+			)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				lookupEngine,
+				nil,
+				nil,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"doc:1#owner@user:1",
+				"doc:1#org@organization:1#...",
+				"doc:2#parent@folder:1#...",
+				"doc:3#owner@user:2",
+				"folder:1#collaborator@user:1",
+				"organization:1#admin@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			response, err := invoker.LookupEntityWithPermissions(context.Background(), &base.PermissionLookupEntityWithPermissionsRequest{
+				TenantId:    "t1",
+				EntityType:  "doc",
+				Permissions: []string{"read", "update", "delete"},
+				Subject:     &base.Subject{Type: "user", Id: "1"},
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         100,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(response.GetEntities()).Should(HaveLen(2))
+			Expect(response.GetEntities()[0].GetEntityId()).Should(Equal("1"))
+			Expect(response.GetEntities()[0].GetPermissions()).Should(Equal([]string{"read", "update", "delete"}))
+			Expect(response.GetEntities()[1].GetEntityId()).Should(Equal("2"))
+			Expect(response.GetEntities()[1].GetPermissions()).Should(Equal([]string{"read"}))
+		})
+	})
 })
@@ -0,0 +1,358 @@
+package engines
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	storageContext "github.com/Permify/permify/internal/storage/context"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// defaultMaxPaths is the number of paths returned for requests without a maximum
+const defaultMaxPaths = 10
+
+// PathEngine - Finds the relationship paths connecting a subject to a permission of an entity, to explain why the
+// subject has the permission. Paths are followed through the unions of the schema. Intersections and exclusions
+// follow one of their operands, once the check engine confirms the others hold, or don't for excluded ones, and
+// attributes and rules end the paths they hold in.
+type PathEngine struct {
+	// checker evaluates the operands of intersections and exclusions, attributes and rules
+	checker *CheckEngine
+	// schemaReader is responsible for reading schema information
+	schemaReader storage.SchemaReader
+	// dataReader is responsible for reading relationship information
+	dataReader storage.DataReader
+}
+
+// NewPathEngine - Creates new PathEngine evaluating the operands of permissions with the check engine
+func NewPathEngine(checker *CheckEngine, sr storage.SchemaReader, dr storage.DataReader) *PathEngine {
+	return &PathEngine{
+		checker:      checker,
+		schemaReader: sr,
+		dataReader:   dr,
+	}
+}
+
+// pathSearch - Search of the paths of a request
+type pathSearch struct {
+	request *base.PermissionPathsRequest
+	// max is the maximum number of paths returned by each step of the search
+	max int
+	// truncated is whether paths were left out for the maximum or the depth
+	truncated bool
+}
+
+// path is the steps of a path
+type path []*base.PermissionPathStep
+
+// Paths - Returns up to the maximum number of paths of the request connecting its subject to the permission of its
+// entity, shortest first
+func (engine *PathEngine) Paths(ctx context.Context, request *base.PermissionPathsRequest) (*base.PermissionPathsResponse, error) {
+	search := &pathSearch{request: request, max: int(request.GetMaxPaths())}
+	if search.max == 0 {
+		search.max = defaultMaxPaths
+	}
+
+	paths, err := engine.find(ctx, search, request.GetEntity(), request.GetPermission(), request.GetMetadata().GetDepth())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[i]) < len(paths[j])
+	})
+
+	response := &base.PermissionPathsResponse{Truncated: search.truncated}
+	for _, p := range paths {
+		response.Paths = append(response.Paths, &base.PermissionPath{Steps: p})
+	}
+	return response, nil
+}
+
+// find returns the paths connecting the subject of the search to the permission, relation, attribute or rule of the
+// entity, of at most depth steps. The depth decreases with each step added.
+func (engine *PathEngine) find(ctx context.Context, search *pathSearch, entity *base.Entity, relation string, depth int32) ([]path, error) {
+	// The subject set itself is reached, e.g. team:1#member when looking for the paths to team:1#member
+	if tuple.AreQueryAndSubjectEqual(entity, relation, search.request.GetSubject()) {
+		return []path{{}}, nil
+	}
+	if depth <= 0 {
+		search.truncated = true
+		return nil, nil
+	}
+
+	en, _, err := engine.schemaReader.ReadEntityDefinition(ctx, search.request.GetTenantId(), entity.GetType(), search.request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	tor, _ := schema.GetTypeOfReferenceByNameInEntityDefinition(en, relation)
+	switch tor {
+	case base.EntityDefinition_REFERENCE_RELATION:
+		// Relations combined with other relations and permissions end the path if they hold, as their
+		// relationships alone don't grant them
+		if en.GetRelations()[relation].GetRewrite() != nil {
+			return engine.end(ctx, search, entity, relation, depth, en)
+		}
+		return engine.findRelation(ctx, search, entity, relation, depth)
+	case base.EntityDefinition_REFERENCE_PERMISSION:
+		permission, err := schema.GetPermissionByNameInEntityDefinition(en, relation)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := engine.findChild(ctx, search, entity, permission.GetChild(), depth-1)
+		if err != nil {
+			return nil, err
+		}
+		return prepend(&base.PermissionPathStep{Entity: entity, Relation: relation}, paths), nil
+	default:
+		// Attributes and rules end the path if they hold
+		return engine.end(ctx, search, entity, relation, depth, en)
+	}
+}
+
+// end returns the path ending at the relation of the entity if the check engine evaluates that it holds.
+func (engine *PathEngine) end(ctx context.Context, search *pathSearch, entity *base.Entity, relation string, depth int32, en *base.EntityDefinition) ([]path, error) {
+	res, err := search.evaluate(ctx, engine.checker.check(ctx, search.checkRequest(entity, relation, depth), en))
+	if err != nil {
+		return nil, err
+	}
+	return search.holds(entity, relation, res), nil
+}
+
+// findRelation returns the paths through the relationships of the relation of the entity.
+func (engine *PathEngine) findRelation(ctx context.Context, search *pathSearch, entity *base.Entity, relation string, depth int32) (paths []path, err error) {
+	tuples, err := engine.relationships(ctx, search, entity, relation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tuples {
+		step := &base.PermissionPathStep{Entity: entity, Relation: relation, Tuple: t}
+		subject := t.GetSubject()
+
+		// The relationship reaches the subject, or the wildcard of its type
+		if tuple.IsSubjectMatch(subject, search.request.GetSubject()) {
+			paths = search.add(paths, path{step})
+			continue
+		}
+		if tuple.IsDirectSubject(subject) || subject.GetRelation() == tuple.ELLIPSIS {
+			continue
+		}
+
+		// The relationship reaches a subject set, e.g. team:1#member, whose paths continue it
+		sub, err := engine.find(ctx, search, &base.Entity{Type: subject.GetType(), Id: subject.GetId()}, subject.GetRelation(), depth-1)
+		if err != nil {
+			return nil, err
+		}
+		paths = search.add(paths, prepend(step, sub)...)
+	}
+	return paths, nil
+}
+
+// findChild returns the paths through the rewrite or the leaf of the child of a permission of the entity.
+func (engine *PathEngine) findChild(ctx context.Context, search *pathSearch, entity *base.Entity, child *base.Child, depth int32) ([]path, error) {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		return engine.findRewrite(ctx, search, entity, child.GetRewrite(), depth)
+	case *base.Child_Leaf:
+		return engine.findLeaf(ctx, search, entity, child.GetLeaf(), depth)
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+	}
+}
+
+// findRewrite returns the paths through the operands of the rewrite. The paths of a union are those of all its
+// operands. An intersection holds if all its operands have paths, and follows the first one through relationships.
+// An exclusion follows its first operand, if the check engine confirms none of the others hold.
+func (engine *PathEngine) findRewrite(ctx context.Context, search *pathSearch, entity *base.Entity, rewrite *base.Rewrite, depth int32) (paths []path, err error) {
+	children := rewrite.GetChildren()
+
+	switch rewrite.GetRewriteOperation() {
+	case base.Rewrite_OPERATION_UNION:
+		for _, child := range children {
+			sub, err := engine.findChild(ctx, search, entity, child, depth)
+			if err != nil {
+				return nil, err
+			}
+			paths = search.add(paths, sub...)
+		}
+		return paths, nil
+	case base.Rewrite_OPERATION_INTERSECTION:
+		for _, child := range children {
+			sub, err := engine.findChild(ctx, search, entity, child, depth)
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) == 0 {
+				return nil, nil
+			}
+			if paths == nil || (!throughRelationships(paths) && throughRelationships(sub)) {
+				paths = sub
+			}
+		}
+		return paths, nil
+	case base.Rewrite_OPERATION_EXCLUSION:
+		if len(children) == 0 {
+			return nil, nil
+		}
+		for _, child := range children[1:] {
+			var fn CheckFunction
+			if child.GetRewrite() != nil {
+				fn = engine.checker.checkRewrite(ctx, search.checkRequest(entity, "", depth), child.GetRewrite())
+			} else {
+				fn = engine.checker.checkLeaf(search.checkRequest(entity, "", depth), child.GetLeaf())
+			}
+			res, err := search.evaluate(ctx, fn)
+			if err != nil {
+				return nil, err
+			}
+			if res.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED {
+				return nil, nil
+			}
+		}
+		return engine.findChild(ctx, search, entity, children[0], depth)
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+	}
+}
+
+// findLeaf returns the paths through the leaf of a permission of the entity.
+func (engine *PathEngine) findLeaf(ctx context.Context, search *pathSearch, entity *base.Entity, leaf *base.Leaf, depth int32) (paths []path, err error) {
+	switch op := leaf.GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		return engine.find(ctx, search, entity, op.ComputedUserSet.GetRelation(), depth)
+	case *base.Leaf_TupleToUserSet:
+		relation := op.TupleToUserSet.GetTupleSet().GetRelation()
+		tuples, err := engine.relationships(ctx, search, entity, relation)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tuples {
+			subject := t.GetSubject()
+			sub, err := engine.find(ctx, search, &base.Entity{Type: subject.GetType(), Id: subject.GetId()}, op.TupleToUserSet.GetComputed().GetRelation(), depth-1)
+			if err != nil {
+				return nil, err
+			}
+			paths = search.add(paths, prepend(&base.PermissionPathStep{Entity: entity, Relation: relation, Tuple: t}, sub)...)
+		}
+		return paths, nil
+	case *base.Leaf_ComputedAttribute:
+		res, err := search.evaluate(ctx, engine.checker.checkLeaf(search.checkRequest(entity, "", depth), leaf))
+		if err != nil {
+			return nil, err
+		}
+		return search.holds(entity, op.ComputedAttribute.GetName(), res), nil
+	case *base.Leaf_Call:
+		res, err := search.evaluate(ctx, engine.checker.checkLeaf(search.checkRequest(entity, "", depth), leaf))
+		if err != nil {
+			return nil, err
+		}
+		return search.holds(entity, op.Call.GetRuleName(), res), nil
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+	}
+}
+
+// relationships returns the relationships of the relation of the entity, or of the wildcard of its type, along
+// with the contextual ones of the request.
+func (engine *PathEngine) relationships(ctx context.Context, search *pathSearch, entity *base.Entity, relation string) ([]*base.Tuple, error) {
+	filter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entity.GetType(),
+			Ids:  []string{entity.GetId(), WILDCARD_TOKEN},
+		},
+		Relation: relation,
+	}
+
+	cti, err := storageContext.NewContextualTuples(search.request.GetContext().GetTuples()...).QueryRelationships(filter)
+	if err != nil {
+		return nil, err
+	}
+	rit, err := engine.dataReader.QueryRelationships(ctx, search.request.GetTenantId(), filter, search.request.GetMetadata().GetSnapToken())
+	if err != nil {
+		return nil, err
+	}
+
+	var tuples []*base.Tuple
+	it := database.NewUniqueTupleIterator(rit, cti)
+	for it.HasNext() {
+		next, ok := it.GetNext()
+		if !ok {
+			break
+		}
+		tuples = append(tuples, next)
+	}
+	return tuples, nil
+}
+
+// checkRequest returns the request checking the permission of the entity for the subject of the search.
+func (search *pathSearch) checkRequest(entity *base.Entity, permission string, depth int32) *base.PermissionCheckRequest {
+	return &base.PermissionCheckRequest{
+		TenantId:   search.request.GetTenantId(),
+		Entity:     entity,
+		Permission: permission,
+		Subject:    search.request.GetSubject(),
+		Metadata: &base.PermissionCheckRequestMetadata{
+			SchemaVersion: search.request.GetMetadata().GetSchemaVersion(),
+			SnapToken:     search.request.GetMetadata().GetSnapToken(),
+			Depth:         depth,
+		},
+		Context: search.request.GetContext(),
+	}
+}
+
+// evaluate runs the check function. Checks that run out of depth are denied, leaving the paths they would hold
+// in out.
+func (search *pathSearch) evaluate(ctx context.Context, fn CheckFunction) (*base.PermissionCheckResponse, error) {
+	res, err := fn(ctx)
+	if err != nil && err.Error() == base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String() {
+		search.truncated = true
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+	return res, err
+}
+
+// holds returns the path ending with the attribute or rule of the entity if the check of it is allowed.
+func (search *pathSearch) holds(entity *base.Entity, name string, res *base.PermissionCheckResponse) []path {
+	if res.GetCan() != base.CheckResult_CHECK_RESULT_ALLOWED {
+		return nil
+	}
+	return []path{{{Entity: entity, Relation: name}}}
+}
+
+// add appends the paths to the found ones, up to the maximum of the search.
+func (search *pathSearch) add(paths []path, more ...path) []path {
+	for _, p := range more {
+		if len(paths) == search.max {
+			search.truncated = true
+			break
+		}
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+// prepend returns the paths starting with the step.
+func prepend(step *base.PermissionPathStep, paths []path) []path {
+	prepended := make([]path, 0, len(paths))
+	for _, p := range paths {
+		prepended = append(prepended, append(path{step}, p...))
+	}
+	return prepended
+}
+
+// throughRelationships returns whether the first path follows a relationship.
+func throughRelationships(paths []path) bool {
+	for _, step := range paths[0] {
+		if step.GetTuple() != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,263 @@
+package engines
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// pathToString formats the path as its steps, e.g. doc:1#read -> doc:1#owner@user:1
+func pathToString(path *base.PermissionPath) string {
+	steps := make([]string, 0, len(path.GetSteps()))
+	for _, step := range path.GetSteps() {
+		s := tuple.EntityAndRelationToString(step.GetEntity(), step.GetRelation())
+		if step.GetTuple() != nil {
+			s += "@" + tuple.SubjectToString(step.GetTuple().GetSubject())
+		}
+		steps = append(steps, s)
+	}
+	return strings.Join(steps, " -> ")
+}
+
+var _ = Describe("path-engine", func() {
+	driveSchema := `
+		entity user {}
+
+		entity team {
+			relation member @user @team#member
+		}
+
+		entity organization {
+			relation admin @user @team#member
+		}
+
+		entity folder {
+			relation collaborator @user
+
+			permission read = collaborator
+		}
+
+		entity doc {
+			relation org @organization
+			relation parent @folder
+			relation owner @user
+			relation banned @user
+
+			attribute is_public boolean
+
+			permission read = (owner or parent.read or org.admin or is_public) not banned
+			permission update = owner and org.admin
+		}
+`
+
+	Context("Drive Sample: Paths", func() {
+		It("Drive Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(driveSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			type assertion struct {
+				entity     string
+				permission string
+				subject    string
+				maxPaths   int32
+				depth      int32
+				paths      []string
+				truncated  bool
+			}
+
+			tests := struct {
+				relationships []string
+				attributes    []string
+				assertions    []assertion
+			}{
+				relationships: []string{
+					"doc:1#owner@user:1",
+					"doc:1#parent@folder:1",
+					"folder:1#collaborator@user:1",
+					"doc:1#org@organization:1",
+					"organization:1#admin@team:1#member",
+					"team:1#member@team:2#member",
+					"team:2#member@user:1",
+					"doc:1#owner@user:3",
+					"doc:1#banned@user:3",
+					"doc:3#owner@user:5",
+				},
+				attributes: []string{
+					"doc:2$is_public|boolean:true",
+				},
+				assertions: []assertion{
+					{
+						entity:     "doc:1",
+						permission: "read",
+						subject:    "user:1",
+						paths: []string{
+							"doc:1#read -> doc:1#owner@user:1",
+							"doc:1#read -> doc:1#parent@folder:1 -> folder:1#read -> folder:1#collaborator@user:1",
+							"doc:1#read -> doc:1#org@organization:1 -> organization:1#admin@team:1#member -> team:1#member@team:2#member -> team:2#member@user:1",
+						},
+					},
+					{
+						entity:     "doc:1",
+						permission: "read",
+						subject:    "user:3",
+						paths:      nil,
+					},
+					{
+						entity:     "doc:2",
+						permission: "read",
+						subject:    "user:4",
+						paths: []string{
+							"doc:2#read -> doc:2#is_public",
+						},
+					},
+					{
+						entity:     "doc:1",
+						permission: "update",
+						subject:    "user:1",
+						paths: []string{
+							"doc:1#update -> doc:1#owner@user:1",
+						},
+					},
+					{
+						entity:     "doc:3",
+						permission: "update",
+						subject:    "user:5",
+						paths:      nil,
+					},
+					{
+						entity:     "organization:1",
+						permission: "admin",
+						subject:    "team:2#member",
+						paths: []string{
+							"organization:1#admin@team:1#member -> team:1#member@team:2#member",
+						},
+					},
+					{
+						entity:     "doc:1",
+						permission: "read",
+						subject:    "user:1",
+						maxPaths:   1,
+						paths: []string{
+							"doc:1#read -> doc:1#owner@user:1",
+						},
+						truncated: true,
+					},
+					{
+						entity:     "doc:1",
+						permission: "read",
+						subject:    "user:1",
+						depth:      4,
+						paths: []string{
+							"doc:1#read -> doc:1#owner@user:1",
+							"doc:1#read -> doc:1#parent@folder:1 -> folder:1#read -> folder:1#collaborator@user:1",
+						},
+						truncated: true,
+					},
+				},
+			}
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+
+			pathEngine := NewPathEngine(checkEngine, schemaReader, dataReader)
+
+			invoker := invoke.NewDirectInvoker(
+				schemaReader,
+				dataReader,
+				checkEngine,
+				nil,
+				nil,
+				nil,
+				pathEngine,
+				telemetry.NewNoopMeter(),
+			)
+
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+
+			for _, relationship := range tests.relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+
+			for _, attr := range tests.attributes {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, assertion := range tests.assertions {
+				entity, err := tuple.E(assertion.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(assertion.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				depth := assertion.depth
+				if depth == 0 {
+					depth = 20
+				}
+
+				response, err := invoker.Paths(context.Background(), &base.PermissionPathsRequest{
+					TenantId:   "t1",
+					Entity:     entity,
+					Permission: assertion.permission,
+					Subject: &base.Subject{
+						Type:     ear.GetEntity().GetType(),
+						Id:       ear.GetEntity().GetId(),
+						Relation: ear.GetRelation(),
+					},
+					MaxPaths: assertion.maxPaths,
+					Metadata: &base.PermissionPathsRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         depth,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				var paths []string
+				for _, path := range response.GetPaths() {
+					paths = append(paths, pathToString(path))
+				}
+				Expect(paths).Should(Equal(assertion.paths))
+				Expect(response.GetTruncated()).Should(Equal(assertion.truncated))
+			}
+		})
+	})
+})
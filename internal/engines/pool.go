@@ -0,0 +1,79 @@
+package engines
+
+import (
+	"sync"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// fanOutPool pools the sub-checks of the checks fanning out to the subjects of their relationships, which allocate
+// a request and a check function per subject at each level of the evaluation.
+var fanOutPool = sync.Pool{
+	New: func() interface{} {
+		return &fanOut{}
+	},
+}
+
+// subCheckRequestPool pools the requests of the sub-checks, along with their entities.
+var subCheckRequestPool = sync.Pool{
+	New: func() interface{} {
+		return &subCheckRequest{}
+	},
+}
+
+// fanOut - Sub-checks of a check, one per subject of its relationships. They are only used while the check runs,
+// since the combiners wait for all the check functions they start before returning, so they are released to the
+// pools once it ends.
+type fanOut struct {
+	functions []CheckFunction
+	requests  []*subCheckRequest
+}
+
+// subCheckRequest - Request of a sub-check, allocated along with its entity
+type subCheckRequest struct {
+	request base.PermissionCheckRequest
+	entity  base.Entity
+}
+
+// acquireFanOut returns an empty fan out from the pool.
+func acquireFanOut() *fanOut {
+	return fanOutPool.Get().(*fanOut)
+}
+
+// request returns a request from the pool checking the permission of the entity for the subject of the request,
+// with its metadata and context.
+func (f *fanOut) request(request *base.PermissionCheckRequest, entityType, entityID, permission string) *base.PermissionCheckRequest {
+	r := subCheckRequestPool.Get().(*subCheckRequest)
+	f.requests = append(f.requests, r)
+
+	r.entity.Type = entityType
+	r.entity.Id = entityID
+	r.request.TenantId = request.GetTenantId()
+	r.request.Entity = &r.entity
+	r.request.Permission = permission
+	r.request.Subject = request.GetSubject()
+	r.request.Metadata = request.GetMetadata()
+	r.request.Context = request.GetContext()
+	return &r.request
+}
+
+// add adds the check function to the sub-checks.
+func (f *fanOut) add(fn CheckFunction) {
+	f.functions = append(f.functions, fn)
+}
+
+// release returns the requests and the fan out to the pools. None of them may be used after.
+func (f *fanOut) release() {
+	for i, r := range f.requests {
+		r.request.Reset()
+		r.entity.Reset()
+		subCheckRequestPool.Put(r)
+		f.requests[i] = nil
+	}
+	for i := range f.functions {
+		f.functions[i] = nil
+	}
+	f.requests = f.requests[:0]
+	f.functions = f.functions[:0]
+	fanOutPool.Put(f)
+}
@@ -0,0 +1,103 @@
+package engines
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage/decorators"
+	"github.com/Permify/permify/pkg/cache/ristretto"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// BenchmarkCheckFanOut measures the allocations of denied checks fanning out to the groups and the parents of a
+// document, the sub-check requests of which are pooled.
+func BenchmarkCheckFanOut(b *testing.B) {
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	conf, err := newSchema(`
+entity user {}
+
+entity group {
+	relation member @user
+}
+
+entity folder {
+	relation viewer @user @group#member
+}
+
+entity doc {
+	relation parent @folder
+	relation viewer @user @group#member
+
+	permission view = viewer or parent.viewer
+}
+`)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err = factories.SchemaWriterFactory(db).WriteSchema(context.Background(), conf); err != nil {
+		b.Fatal(err)
+	}
+
+	// The schema is read from the cache, as it is when served
+	schemaCache, err := ristretto.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	schemaReader := decorators.NewSchemaReaderWithCache(factories.SchemaReaderFactory(db), schemaCache)
+	dataReader := factories.DataReaderFactory(db)
+
+	checkEngine := NewCheckEngine(schemaReader, dataReader)
+	invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, nil, nil, nil, telemetry.NewNoopMeter())
+	checkEngine.SetInvoker(invoker)
+
+	var tuples []*base.Tuple
+	for i := 0; i < 32; i++ {
+		for _, value := range []string{
+			fmt.Sprintf("doc:1#viewer@group:%d#member", i),
+			fmt.Sprintf("doc:1#parent@folder:%d", i),
+			fmt.Sprintf("folder:%d#viewer@group:%d#member", i, i),
+		} {
+			t, err := tuple.Tuple(value)
+			if err != nil {
+				b.Fatal(err)
+			}
+			tuples = append(tuples, t)
+		}
+	}
+	if _, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection()); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+			TenantId:   "t1",
+			Entity:     &base.Entity{Type: "doc", Id: "1"},
+			Permission: "view",
+			Subject:    &base.Subject{Type: "user", Id: "1"},
+			Metadata: &base.PermissionCheckRequestMetadata{
+				SnapToken: token.NewNoopToken().Encode().String(),
+				Depth:     20,
+			},
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if response.GetCan() != base.CheckResult_CHECK_RESULT_DENIED {
+			b.Fatal("the check is denied")
+		}
+	}
+}
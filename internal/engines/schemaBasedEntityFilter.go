@@ -115,6 +115,13 @@ func (engine *SchemaBasedEntityFilter) relationEntrance(
 	g *errgroup.Group, // An errgroup used for executing goroutines.
 	publisher *BulkEntityPublisher, // A custom publisher that publishes results in bulk.
 ) error { // Returns an error if one occurs during execution.
+	// Tuples granting the relation to every subject of the type, e.g. with the subject user:*, also grant it to
+	// the subject of the request.
+	ids := []string{request.GetSubject().GetId()}
+	if tuple.IsDirectSubject(request.GetSubject()) && !tuple.IsWildcardSubject(request.GetSubject()) {
+		ids = append(ids, tuple.WILDCARD)
+	}
+
 	// Define a TupleFilter. This specifies which tuples we're interested in.
 	// We want tuples that match the entity type and ID from the request, and have a specific relation.
 	filter := &base.TupleFilter{
@@ -125,7 +132,7 @@ func (engine *SchemaBasedEntityFilter) relationEntrance(
 		Relation: entrance.TargetEntrance.GetRelation(),
 		Subject: &base.SubjectFilter{
 			Type:     request.GetSubject().GetType(),
-			Ids:      []string{request.GetSubject().GetId()},
+			Ids:      ids,
 			Relation: request.GetSubject().GetRelation(),
 		},
 	}
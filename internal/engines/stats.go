@@ -0,0 +1,136 @@
+package engines
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// statsKey is the context key under which the stats of a check are stored.
+type statsKey struct{}
+
+// CheckStats - Counters of the work done to evaluate a check and its sub-checks, collected when the check is
+// debugged. It is safe for concurrent use, and its methods do nothing on a nil receiver.
+type CheckStats struct {
+	lookups    atomic.Int32
+	cacheHit   atomic.Bool
+	hits       atomic.Int32
+	misses     atomic.Int32
+	dispatches atomic.Int32
+
+	mu         sync.Mutex
+	mismatches []*base.SchemaMismatch
+	seen       map[string]struct{}
+	unfinished map[string]struct{}
+}
+
+// ContextWithCheckStats returns a copy of ctx collecting the stats of the checks evaluated with it, along with
+// the stats.
+func ContextWithCheckStats(ctx context.Context) (context.Context, *CheckStats) {
+	stats := &CheckStats{}
+	return context.WithValue(ctx, statsKey{}, stats), stats
+}
+
+// CheckStatsFromContext returns the stats collected by ctx, or nil when the check is not debugged.
+func CheckStatsFromContext(ctx context.Context) *CheckStats {
+	if stats, ok := ctx.Value(statsKey{}).(*CheckStats); ok {
+		return stats
+	}
+	return nil
+}
+
+// CacheLookup records a lookup of the check cache. The first lookup is the one of the check itself, the
+// following ones are those of its sub-checks.
+func (s *CheckStats) CacheLookup(hit bool) {
+	if s == nil {
+		return
+	}
+	if s.lookups.Add(1) == 1 {
+		s.cacheHit.Store(hit)
+	}
+	if hit {
+		s.hits.Add(1)
+	} else {
+		s.misses.Add(1)
+	}
+}
+
+// Dispatch records a check dispatched to another node of the cluster.
+func (s *CheckStats) Dispatch() {
+	if s == nil {
+		return
+	}
+	s.dispatches.Add(1)
+}
+
+// Mismatch records a relationship or an attribute the schema version does not allow, once however many times it is
+// read.
+func (s *CheckStats) Mismatch(item string, code base.ErrorCode) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seen == nil {
+		s.seen = map[string]struct{}{}
+	}
+	if _, ok := s.seen[item]; ok {
+		return
+	}
+	s.seen[item] = struct{}{}
+	s.mismatches = append(s.mismatches, &base.SchemaMismatch{Item: item, Code: code})
+}
+
+// Unfinished records the chain of checks, from the check down, of a sub-check whose evaluation was interrupted.
+func (s *CheckStats) Unfinished(path []string) {
+	if s == nil || len(path) == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.unfinished == nil {
+		s.unfinished = map[string]struct{}{}
+	}
+	s.unfinished[strings.Join(path, " -> ")] = struct{}{}
+}
+
+// Debug returns the stats as the debug information of a check response.
+func (s *CheckStats) Debug() *base.PermissionCheckDebug {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &base.PermissionCheckDebug{
+		CacheHit:         s.cacheHit.Load(),
+		CacheHits:        s.hits.Load(),
+		CacheMisses:      s.misses.Load(),
+		DispatchCount:    s.dispatches.Load(),
+		SchemaMismatches: s.mismatches,
+		UnfinishedChecks: s.unfinishedChecks(),
+	}
+}
+
+// unfinishedChecks returns the chains of the interrupted checks that none of the other ones extends, which are those
+// of the sub-checks the evaluation was waiting for, in order.
+func (s *CheckStats) unfinishedChecks() []string {
+	var chains []string
+	for chain := range s.unfinished {
+		extended := false
+		for other := range s.unfinished {
+			if strings.HasPrefix(other, chain+" -> ") {
+				extended = true
+				break
+			}
+		}
+		if !extended {
+			chains = append(chains, chain)
+		}
+	}
+	sort.Strings(chains)
+	return chains
+}
@@ -0,0 +1,73 @@
+package engines
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("stats", func() {
+	Context("CheckStats", func() {
+		It("CheckStats: Case 1", func() {
+			ctx, stats := ContextWithCheckStats(context.Background())
+			Expect(CheckStatsFromContext(ctx)).Should(BeIdenticalTo(stats))
+
+			CheckStatsFromContext(ctx).CacheLookup(false)
+			CheckStatsFromContext(ctx).CacheLookup(true)
+			CheckStatsFromContext(ctx).CacheLookup(true)
+			CheckStatsFromContext(ctx).Dispatch()
+
+			debug := stats.Debug()
+			Expect(debug.GetCacheHit()).Should(BeFalse())
+			Expect(debug.GetCacheHits()).Should(Equal(int32(2)))
+			Expect(debug.GetCacheMisses()).Should(Equal(int32(1)))
+			Expect(debug.GetDispatchCount()).Should(Equal(int32(1)))
+		})
+
+		It("CheckStats: Case 2", func() {
+			ctx, stats := ContextWithCheckStats(context.Background())
+			CheckStatsFromContext(ctx).CacheLookup(true)
+			Expect(stats.Debug().GetCacheHit()).Should(BeTrue())
+
+			// Checks that are not debugged collect nothing
+			stats = CheckStatsFromContext(context.Background())
+			Expect(stats).Should(BeNil())
+			stats.CacheLookup(true)
+			stats.Dispatch()
+			Expect(stats.Debug()).Should(BeNil())
+		})
+
+		It("CheckStats: Case 3", func() {
+			ctx, stats := ContextWithCheckStats(context.Background())
+
+			// Mismatches are recorded once however many times they are read
+			CheckStatsFromContext(ctx).Mismatch("doc:1#viewer@team:1#member", base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND)
+			CheckStatsFromContext(ctx).Mismatch("doc:1#viewer@team:1#member", base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND)
+			CheckStatsFromContext(ctx).Mismatch("doc:1$public|string:yes", base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH)
+
+			Expect(stats.Debug().GetSchemaMismatches()).Should(Equal([]*base.SchemaMismatch{
+				{Item: "doc:1#viewer@team:1#member", Code: base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND},
+				{Item: "doc:1$public|string:yes", Code: base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH},
+			}))
+		})
+
+		It("CheckStats: Case 4", func() {
+			ctx, stats := ContextWithCheckStats(context.Background())
+
+			// The chains other interrupted chains extend are those of the checks waiting for them
+			CheckStatsFromContext(ctx).Unfinished([]string{"doc:1#view"})
+			CheckStatsFromContext(ctx).Unfinished([]string{"doc:1#view", "folder:1#view"})
+			CheckStatsFromContext(ctx).Unfinished([]string{"doc:1#view", "folder:1#view", "folder:2#view"})
+			CheckStatsFromContext(ctx).Unfinished([]string{"doc:1#view", "doc:1#owner"})
+			CheckStatsFromContext(ctx).Unfinished(nil)
+
+			Expect(stats.Debug().GetUnfinishedChecks()).Should(Equal([]string{
+				"doc:1#view -> doc:1#owner",
+				"doc:1#view -> folder:1#view -> folder:2#view",
+			}))
+		})
+	})
+})
@@ -131,6 +131,7 @@ var _ = Describe("subject-permission-engine", func() {
 				nil,
 				nil,
 				subjectPermissionEngine,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -257,6 +258,7 @@ var _ = Describe("subject-permission-engine", func() {
 				nil,
 				nil,
 				subjectPermissionEngine,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -386,6 +388,7 @@ var _ = Describe("subject-permission-engine", func() {
 				nil,
 				nil,
 				subjectPermissionEngine,
+				nil,
 				telemetry.NewNoopMeter(),
 			)
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	api "go.opentelemetry.io/otel/metric"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/internal/schema"
@@ -30,6 +31,33 @@ func CheckConcurrencyLimit(limit int) CheckOption {
 	}
 }
 
+// CheckMeter - a functional option that counts the relationships and attributes the CheckEngine reads that the schema
+// version does not allow in the meter, along with the evaluations of shadow permissions and their divergences.
+func CheckMeter(meter api.Meter) CheckOption {
+	return func(c *CheckEngine) {
+		counter, err := meter.Int64Counter("schema_mismatch_count", api.WithDescription("Number of relationships and attributes read during checks that the schema version does not allow"))
+		if err != nil {
+			panic(err)
+		}
+		c.mismatchCounter = counter
+
+		c.shadowCheckCounter, err = meter.Int64Counter("shadow_permission_check_count", api.WithDescription("Number of checks of shadow permissions evaluated along with their legacy permission"))
+		if err != nil {
+			panic(err)
+		}
+
+		c.shadowDivergenceCounter, err = meter.Int64Counter("shadow_permission_divergence_count", api.WithDescription("Number of checks of shadow permissions whose result differs from the one of their legacy permission"))
+		if err != nil {
+			panic(err)
+		}
+
+		c.shadowErrorCounter, err = meter.Int64Counter("shadow_permission_error_count", api.WithDescription("Number of checks of shadow permissions that failed"))
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
 type LookupOption func(engine *LookupEngine)
 
 func LookupConcurrencyLimit(limit int) LookupOption {
@@ -38,6 +66,16 @@ func LookupConcurrencyLimit(limit int) LookupOption {
 	}
 }
 
+// ExpandOption - a functional option type for configuring the ExpandEngine.
+type ExpandOption func(engine *ExpandEngine)
+
+// ExpandMaxNodes - a functional option that sets the maximum number of nodes of the trees of the ExpandEngine, no limit if zero.
+func ExpandMaxNodes(limit int) ExpandOption {
+	return func(c *ExpandEngine) {
+		c.maxNodes = limit
+	}
+}
+
 // SchemaBaseSubjectFilterOption - a functional option type for configuring the LookupSubjectEngine.
 type SchemaBaseSubjectFilterOption func(engine *SchemaBasedSubjectFilter)
 
@@ -63,6 +101,7 @@ func joinResponseMetas(meta ...*base.PermissionCheckResponseMetadata) *base.Perm
 	response := &base.PermissionCheckResponseMetadata{}
 	for _, m := range meta {
 		response.CheckCount += m.CheckCount
+		response.RequestDependent = response.RequestDependent || m.GetRequestDependent()
 	}
 	return response
 }
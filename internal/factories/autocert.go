@@ -0,0 +1,38 @@
+package factories
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/Permify/permify/internal/config"
+	PQRepository "github.com/Permify/permify/internal/storage/postgres"
+	"github.com/Permify/permify/pkg/database"
+	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+)
+
+const (
+	// AutocertCacheDir keeps the certificates obtained automatically in a directory, e.g. a mounted volume
+	AutocertCacheDir = "dir"
+	// AutocertCacheDatabase keeps the certificates obtained automatically in the database, shared by the replicas
+	AutocertCacheDatabase = "database"
+)
+
+// AutocertCacheFactory creates and returns the cache of the certificates obtained automatically of the autocert
+// configuration. The database cache is only available with the Postgres engine.
+func AutocertCacheFactory(conf config.Autocert, db database.Database) (autocert.Cache, error) {
+	switch conf.Cache {
+	case AutocertCacheDir:
+		if conf.CacheDir == "" {
+			return nil, fmt.Errorf("the %s autocert cache needs a cache_dir", AutocertCacheDir)
+		}
+		return autocert.DirCache(conf.CacheDir), nil
+	case AutocertCacheDatabase:
+		if db.GetEngineType() != database.POSTGRES.String() {
+			return nil, fmt.Errorf("the %s autocert cache is not available with the %s engine, use the %s cache", AutocertCacheDatabase, db.GetEngineType(), AutocertCacheDir)
+		}
+		return PQRepository.NewAutocertCache(db.(*PQDatabase.Postgres)), nil
+	default:
+		return nil, fmt.Errorf("unknown autocert cache %q, expected %s or %s", conf.Cache, AutocertCacheDir, AutocertCacheDatabase)
+	}
+}
@@ -0,0 +1,40 @@
+package factories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestAutocertCacheFactory(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+	defer db.Close()
+
+	// The dir cache keeps the certificates in the directory
+	cache, err := AutocertCacheFactory(config.Autocert{Cache: AutocertCacheDir, CacheDir: t.TempDir()}, db)
+	require.NoError(t, err)
+	_, err = cache.Get(ctx, "permify.example.com")
+	assert.ErrorIs(t, err, autocert.ErrCacheMiss)
+	require.NoError(t, cache.Put(ctx, "permify.example.com", []byte("certificate")))
+	data, err := cache.Get(ctx, "permify.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("certificate"), data)
+
+	_, err = AutocertCacheFactory(config.Autocert{Cache: AutocertCacheDir}, db)
+	assert.Error(t, err)
+
+	// The database cache needs the postgres engine
+	_, err = AutocertCacheFactory(config.Autocert{Cache: AutocertCacheDatabase}, db)
+	assert.ErrorContains(t, err, "not available with the memory engine")
+
+	_, err = AutocertCacheFactory(config.Autocert{Cache: "s3"}, db)
+	assert.Error(t, err)
+}
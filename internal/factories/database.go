@@ -6,22 +6,30 @@ import (
 	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/storage/memory/migrations"
 	"github.com/Permify/permify/pkg/database"
+	DDDatabase "github.com/Permify/permify/pkg/database/dynamodb"
 	IMDatabase "github.com/Permify/permify/pkg/database/memory"
+	MGDatabase "github.com/Permify/permify/pkg/database/mongodb"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	SPDatabase "github.com/Permify/permify/pkg/database/spanner"
+	api "github.com/Permify/permify/pkg/storage"
 )
 
 // DatabaseFactory is a factory function that creates a database instance according to the given configuration.
-// It supports different types of databases, such as PostgreSQL and in-memory databases.
+// It supports different types of databases, such as PostgreSQL, DynamoDB, MongoDB, Cloud Spanner and in-memory databases.
 //
 // conf: the configuration object containing the necessary information to create a database connection.
 //
 //	It should have the following properties:
-//	- Engine: the type of the database, e.g., POSTGRES or MEMORY
-//	- URI: the connection string for the database (only required for some database engines, e.g., POSTGRES)
+//	- Engine: the type of the database, e.g., POSTGRES, DYNAMODB, MONGODB, SPANNER or MEMORY
+//	- URI: the connection string for the database (only required for some database engines, e.g., POSTGRES, DYNAMODB, MONGODB or SPANNER)
 //	- MaxOpenConnections: the maximum number of open connections to the database
 //	- MaxIdleConnections: the maximum number of idle connections in the connection pool
 //	- MaxConnectionIdleTime: the maximum amount of time a connection can be idle before being closed
 //	- MaxConnectionLifetime: the maximum amount of time a connection can be reused before being closed
+//	- QueryExecMode: the mode queries are executed with (only for POSTGRES)
+//	- StatementCacheSize: the maximum number of statements cached on each connection (only for POSTGRES)
+//
+// Engines registered through the public storage registry are opened by their own implementation.
 //
 // Returns a database.Database instance if the database connection is successfully created, or an error if the
 // creation fails or the specified database engine is unsupported.
@@ -33,6 +41,8 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 			PQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
 			PQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
 			PQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+			PQDatabase.QueryExecMode(conf.QueryExecMode),
+			PQDatabase.StatementCacheSize(conf.StatementCacheSize),
 		)
 		if err != nil {
 			return nil, err
@@ -44,7 +54,42 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 			return nil, err
 		}
 		return
+	case database.DYNAMODB.String():
+		db, err = DDDatabase.New(conf.URI)
+		if err != nil {
+			return nil, err
+		}
+		return
+	case database.MONGODB.String():
+		db, err = MGDatabase.New(conf.URI,
+			MGDatabase.MaxOpenConnections(conf.MaxOpenConnections),
+			MGDatabase.MaxIdleConnections(conf.MaxIdleConnections),
+			MGDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return
+	case database.SPANNER.String():
+		db, err = SPDatabase.New(conf.URI,
+			SPDatabase.MaxOpenConnections(conf.MaxOpenConnections),
+			SPDatabase.MaxIdleConnections(conf.MaxIdleConnections),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return
 	default:
+		// If a custom engine is registered under the name, open the database with its implementation
+		if engine, ok := api.Lookup(conf.Engine); ok {
+			return engine.Open(api.Config{
+				URI:                   conf.URI,
+				MaxOpenConnections:    conf.MaxOpenConnections,
+				MaxIdleConnections:    conf.MaxIdleConnections,
+				MaxConnectionIdleTime: conf.MaxConnectionIdleTime,
+				MaxConnectionLifetime: conf.MaxConnectionLifetime,
+			})
+		}
 		return nil, fmt.Errorf("%s connection is unsupported", conf.Engine)
 	}
 }
@@ -0,0 +1,424 @@
+package factories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/decorators"
+	"github.com/Permify/permify/pkg/database"
+)
+
+// Residency - Databases of the regions tenants are kept in. The storage of the default database is routed to them
+// for their tenants, so the data and schemas of a tenant never reach another database.
+//
+// Tenants are pinned to regions by the configuration, or assigned to one when they are created. A tenant assigned
+// at creation is kept in the region whose database has it, which is found by listing the tenants of the databases
+// when the tenant isn't known yet, e.g. because it was created by another node, and at every check interval.
+type Residency struct {
+	// def is the default database, which keeps the list of every tenant
+	def database.Database
+	// databases are the databases of the regions, by region
+	databases map[string]database.Database
+	// pinned are the regions of the tenants pinned by the configuration, by tenant
+	pinned map[string]string
+	// interval is the interval between the health checks and the refreshes of the tenants of the regions
+	interval time.Duration
+
+	mu sync.RWMutex
+	// assigned are the regions of the tenants known, by tenant, empty for the default database
+	assigned map[string]string
+	// healthy are the regions whose database passed the last health check
+	healthy map[string]bool
+	// started and completed count the refreshes of the tenants started and the last one completed
+	started, completed uint64
+
+	// refreshMu serializes the refreshes of the tenants
+	refreshMu sync.Mutex
+}
+
+// RegionConfig returns the configuration of the database of the region, which runs the engine of the default
+// database, and its connection settings unless the region sets them.
+func RegionConfig(conf config.Database, region config.DatabaseRegion) config.Database {
+	conf.URI = region.URI
+	conf.Regions = nil
+	if region.MaxOpenConnections != 0 {
+		conf.MaxOpenConnections = region.MaxOpenConnections
+	}
+	if region.MaxIdleConnections != 0 {
+		conf.MaxIdleConnections = region.MaxIdleConnections
+	}
+	if region.MaxConnectionLifetime != 0 {
+		conf.MaxConnectionLifetime = region.MaxConnectionLifetime
+	}
+	if region.MaxConnectionIdleTime != 0 {
+		conf.MaxConnectionIdleTime = region.MaxConnectionIdleTime
+	}
+	return conf
+}
+
+// ResidencyFactory opens the databases of the regions of the configuration, each with a connection pool of its own.
+// Regions must have unique names, and tenants can be pinned to a single region.
+func ResidencyFactory(conf config.Database, def database.Database) (*Residency, error) {
+	if conf.RegionCheckInterval <= 0 {
+		conf.RegionCheckInterval = 30 * time.Second
+	}
+
+	r := &Residency{
+		def:       def,
+		databases: map[string]database.Database{},
+		pinned:    map[string]string{},
+		interval:  conf.RegionCheckInterval,
+		assigned:  map[string]string{},
+		healthy:   map[string]bool{},
+	}
+
+	for _, region := range conf.Regions {
+		if region.Name == "" {
+			return nil, r.closeWith(errors.New("database regions must have a name"))
+		}
+		if _, ok := r.databases[region.Name]; ok {
+			return nil, r.closeWith(fmt.Errorf("database region %s is configured twice", region.Name))
+		}
+		for _, tenantID := range region.Tenants {
+			if other, ok := r.pinned[tenantID]; ok {
+				return nil, r.closeWith(fmt.Errorf("tenant %s is pinned to regions %s and %s", tenantID, other, region.Name))
+			}
+			r.pinned[tenantID] = region.Name
+		}
+
+		db, err := DatabaseFactory(RegionConfig(conf, region))
+		if err != nil {
+			return nil, r.closeWith(fmt.Errorf("database region %s: %w", region.Name, err))
+		}
+		r.databases[region.Name] = db
+		r.healthy[region.Name] = true
+	}
+
+	return r, nil
+}
+
+// Databases returns the databases of the regions, by region.
+func (r *Residency) Databases() map[string]database.Database {
+	return r.databases
+}
+
+// Close closes the databases of the regions.
+func (r *Residency) Close() error {
+	var errs []error
+	for _, db := range r.databases {
+		errs = append(errs, db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// closeWith closes the databases opened so far, returning the error.
+func (r *Residency) closeWith(err error) error {
+	return errors.Join(err, r.Close())
+}
+
+// Start checks the health of the databases of the regions and refreshes the tenants kept in them at every check
+// interval, until the context is done.
+func (r *Residency) Start(ctx context.Context) error {
+	if len(r.databases) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.check(ctx)
+		if err := r.refresh(ctx); err != nil {
+			slog.Error("failed to refresh the tenants of the database regions", slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// check checks the health of the databases of the regions.
+func (r *Residency) check(ctx context.Context) {
+	for region, db := range r.databases {
+		ready, err := db.IsReady(ctx)
+		healthy := err == nil && ready
+
+		r.mu.Lock()
+		changed := r.healthy[region] != healthy
+		r.healthy[region] = healthy
+		r.mu.Unlock()
+
+		if changed && healthy {
+			slog.Info("database region is healthy", slog.String("region", region))
+		}
+		if changed && !healthy {
+			slog.Error("database region is unhealthy", slog.String("region", region), slog.Any("error", err))
+		}
+	}
+}
+
+// Health returns whether the databases of the regions passed their last health check, by region.
+func (r *Residency) Health() map[string]bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	health := make(map[string]bool, len(r.healthy))
+	for region, healthy := range r.healthy {
+		health[region] = healthy
+	}
+	return health
+}
+
+// TenantCounts returns the number of tenants known to be kept in each region.
+func (r *Residency) TenantCounts() map[string]int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int, len(r.databases))
+	for region := range r.databases {
+		counts[region] = 0
+	}
+	for tenantID, region := range r.assigned {
+		if _, ok := r.pinned[tenantID]; !ok && region != "" {
+			counts[region]++
+		}
+	}
+	for _, region := range r.pinned {
+		counts[region]++
+	}
+	return counts
+}
+
+// Regions returns the names of the regions, sorted.
+func (r *Residency) Regions() []string {
+	regions := make([]string, 0, len(r.databases))
+	for region := range r.databases {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// Route returns the region the tenant is kept in, empty for the default database. Tenants that aren't known are
+// looked up in the databases once a refresh started after the call completes.
+func (r *Residency) Route(ctx context.Context, tenantID string) (string, error) {
+	if len(r.databases) == 0 {
+		return "", nil
+	}
+
+	region, ok := r.lookup(tenantID)
+	if !ok {
+		r.mu.RLock()
+		started := r.started
+		r.mu.RUnlock()
+
+		if err := r.refreshAfter(ctx, started); err != nil {
+			return "", err
+		}
+		// Tenants that don't exist are routed to the default database, like they are without regions
+		region, _ = r.lookup(tenantID)
+	}
+
+	if region == "" {
+		return "", nil
+	}
+	r.mu.RLock()
+	healthy := r.healthy[region]
+	r.mu.RUnlock()
+	if !healthy {
+		return "", status.Errorf(codes.Unavailable, "database region %s is unavailable", region)
+	}
+	return region, nil
+}
+
+// lookup returns the region of the tenant, if it is known.
+func (r *Residency) lookup(tenantID string) (string, bool) {
+	if region, ok := r.pinned[tenantID]; ok {
+		return region, true
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	region, ok := r.assigned[tenantID]
+	return region, ok
+}
+
+// Assign returns the region a tenant created with the requested region is kept in. Pinned tenants are kept in
+// their region, and the region of the other tenants must exist and be healthy.
+func (r *Residency) Assign(tenantID, requested string) (string, error) {
+	if region, ok := r.pinned[tenantID]; ok {
+		if requested != "" && requested != region {
+			return "", status.Errorf(codes.InvalidArgument, "tenant %s is pinned to database region %s", tenantID, region)
+		}
+		requested = region
+	}
+	if requested == "" {
+		return "", nil
+	}
+
+	if _, ok := r.databases[requested]; !ok {
+		return "", status.Errorf(codes.InvalidArgument, "database region %s doesn't exist", requested)
+	}
+	r.mu.RLock()
+	healthy := r.healthy[requested]
+	r.mu.RUnlock()
+	if !healthy {
+		return "", status.Errorf(codes.Unavailable, "database region %s is unavailable", requested)
+	}
+	return requested, nil
+}
+
+// Assigned records that the tenant is created in the region.
+func (r *Residency) Assigned(tenantID, region string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.assigned[tenantID] = region
+}
+
+// Removed records that the tenant is deleted.
+func (r *Residency) Removed(tenantID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.assigned, tenantID)
+}
+
+// refreshAfter refreshes the tenants, unless a refresh started after the given number of refreshes completed
+// while waiting for the ongoing one.
+func (r *Residency) refreshAfter(ctx context.Context, started uint64) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+
+	r.mu.RLock()
+	completed := r.completed
+	r.mu.RUnlock()
+	if completed > started {
+		return nil
+	}
+	return r.refreshLocked(ctx)
+}
+
+// refresh refreshes the tenants.
+func (r *Residency) refresh(ctx context.Context) error {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	return r.refreshLocked(ctx)
+}
+
+// refreshLocked lists the tenants of the default database, then the ones of the regions. Tenants are created in
+// their region before the default database, so every tenant listed by the default database is found in its region.
+// It is called with the refresh lock held.
+func (r *Residency) refreshLocked(ctx context.Context) error {
+	r.mu.Lock()
+	r.started++
+	id := r.started
+	r.mu.Unlock()
+
+	tenants, err := listTenants(ctx, r.def)
+	if err != nil {
+		return err
+	}
+	assigned := make(map[string]string, len(tenants))
+	for _, tenantID := range tenants {
+		assigned[tenantID] = ""
+	}
+
+	for region, db := range r.databases {
+		r.mu.RLock()
+		healthy := r.healthy[region]
+		r.mu.RUnlock()
+		if !healthy {
+			return status.Errorf(codes.Unavailable, "database region %s is unavailable", region)
+		}
+
+		tenants, err = listTenants(ctx, db)
+		if err != nil {
+			return fmt.Errorf("database region %s: %w", region, err)
+		}
+		for _, tenantID := range tenants {
+			if _, ok := assigned[tenantID]; ok {
+				assigned[tenantID] = region
+			}
+		}
+	}
+
+	r.mu.Lock()
+	r.assigned = assigned
+	r.completed = id
+	r.mu.Unlock()
+	return nil
+}
+
+// listTenants lists the ids of the tenants of the database.
+func listTenants(ctx context.Context, db database.Database) ([]string, error) {
+	reader := TenantReaderFactory(db)
+
+	var ids []string
+	pageToken := ""
+	for {
+		tenants, ct, err := reader.ListTenants(ctx, database.NewPagination(database.Size(100), database.Token(pageToken)))
+		if err != nil {
+			return nil, err
+		}
+		for _, tenant := range tenants {
+			ids = append(ids, tenant.GetId())
+		}
+		pageToken = ct.String()
+		if pageToken == "" {
+			return ids, nil
+		}
+	}
+}
+
+// regions creates the storage of each region with the factory, by region.
+func regions[T any](r *Residency, factory func(db database.Database) T) map[string]T {
+	byRegion := make(map[string]T, len(r.databases))
+	for region, db := range r.databases {
+		byRegion[region] = factory(db)
+	}
+	return byRegion
+}
+
+// DataReader routes the reads of the tenants of the regions to their databases, and the others to the data reader.
+func (r *Residency) DataReader(dataReader storage.DataReader) storage.DataReader {
+	return decorators.NewDataReaderWithResidency(dataReader, r, regions(r, DataReaderFactory))
+}
+
+// DataWriter routes the writes of the tenants of the regions to their databases, and the others to the data writer.
+func (r *Residency) DataWriter(dataWriter storage.DataWriter) storage.DataWriter {
+	return decorators.NewDataWriterWithResidency(dataWriter, r, regions(r, DataWriterFactory))
+}
+
+// SchemaReader routes the schema reads of the tenants of the regions to their databases, and the others to the
+// schema reader.
+func (r *Residency) SchemaReader(schemaReader storage.SchemaReader) storage.SchemaReader {
+	return decorators.NewSchemaReaderWithResidency(schemaReader, r, regions(r, SchemaReaderFactory))
+}
+
+// SchemaWriter routes the schema writes of the tenants of the regions to their databases, and the others to the
+// schema writer.
+func (r *Residency) SchemaWriter(schemaWriter storage.SchemaWriter) storage.SchemaWriter {
+	return decorators.NewSchemaWriterWithResidency(schemaWriter, r, regions(r, SchemaWriterFactory))
+}
+
+// Watcher routes the watches of the tenants of the regions to their databases, and the others to the watcher.
+func (r *Residency) Watcher(watcher storage.Watcher) storage.Watcher {
+	return decorators.NewWatcherWithResidency(watcher, r, regions(r, WatcherFactory))
+}
+
+// TenantWriter creates and deletes the tenants of the regions in their databases as well as with the tenant writer,
+// which keeps the list of every tenant.
+func (r *Residency) TenantWriter(tenantWriter storage.TenantWriter) storage.TenantWriter {
+	return decorators.NewTenantWriterWithResidency(tenantWriter, r, regions(r, TenantWriterFactory))
+}
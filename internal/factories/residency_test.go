@@ -0,0 +1,119 @@
+package factories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/storage/decorators"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestResidency(t *testing.T) {
+	ctx := context.Background()
+
+	conf := config.Database{
+		Engine: "memory",
+		Regions: []config.DatabaseRegion{
+			{Name: "eu", Tenants: []string{"eu-tenant"}},
+			{Name: "byo"},
+		},
+	}
+
+	db, err := DatabaseFactory(conf)
+	require.NoError(t, err)
+	residency, err := ResidencyFactory(conf, db)
+	require.NoError(t, err)
+	defer residency.Close()
+
+	tenantWriter := residency.TenantWriter(TenantWriterFactory(db))
+	dataWriter := residency.DataWriter(DataWriterFactory(db))
+
+	tenants := map[string]string{
+		"eu-tenant":    "",
+		"byo-tenant":   "byo",
+		"other-tenant": "",
+	}
+	for tenantID, region := range tenants {
+		_, err = tenantWriter.CreateTenant(decorators.WithTenantDatabase(ctx, region), tenantID, tenantID)
+		require.NoError(t, err)
+
+		tup, err := tuple.Tuple("doc:1#viewer@user:" + tenantID)
+		require.NoError(t, err)
+		_, err = dataWriter.Write(ctx, tenantID, database.NewTupleCollection(tup), database.NewAttributeCollection())
+		require.NoError(t, err)
+	}
+
+	count := func(db database.Database, tenantID string) int {
+		reader := DataReaderFactory(db)
+		head, err := reader.HeadSnapshot(ctx, tenantID)
+		require.NoError(t, err)
+		it, err := reader.QueryRelationships(ctx, tenantID, &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}, head.Encode().String())
+		require.NoError(t, err)
+		n := 0
+		for it.HasNext() {
+			it.GetNext()
+			n++
+		}
+		return n
+	}
+
+	// The tuples of the tenants of the regions are kept in their database only.
+	eu, byo := residency.Databases()["eu"], residency.Databases()["byo"]
+	assert.Equal(t, 1, count(eu, "eu-tenant"))
+	assert.Equal(t, 0, count(db, "eu-tenant"))
+	assert.Equal(t, 1, count(byo, "byo-tenant"))
+	assert.Equal(t, 0, count(db, "byo-tenant"))
+	assert.Equal(t, 1, count(db, "other-tenant"))
+	assert.Equal(t, 0, count(eu, "other-tenant"))
+
+	// Every tenant is listed from the default database.
+	ids, err := listTenants(ctx, db)
+	require.NoError(t, err)
+	assert.Subset(t, ids, []string{"eu-tenant", "byo-tenant", "other-tenant"})
+
+	// Other nodes find the region of the tenants assigned at creation in the databases.
+	other, err := ResidencyFactory(conf, db)
+	require.NoError(t, err)
+	other.databases = residency.databases
+	region, err := other.Route(ctx, "byo-tenant")
+	require.NoError(t, err)
+	assert.Equal(t, "byo", region)
+	region, err = other.Route(ctx, "other-tenant")
+	require.NoError(t, err)
+	assert.Equal(t, "", region)
+	assert.Equal(t, map[string]int{"eu": 1, "byo": 1}, other.TenantCounts())
+
+	// Pinned tenants can't be assigned to other regions, and regions must exist.
+	_, err = residency.Assign("eu-tenant", "byo")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = residency.Assign("new-tenant", "unknown")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The tenants of unhealthy regions are unavailable.
+	residency.healthy["byo"] = false
+	_, err = dataWriter.Write(ctx, "byo-tenant", database.NewTupleCollection(), database.NewAttributeCollection())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = residency.Assign("new-tenant", "byo")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = dataWriter.Write(ctx, "other-tenant", database.NewTupleCollection(), database.NewAttributeCollection())
+	assert.NoError(t, err)
+}
+
+func TestResidencyFactory_TenantInTwoRegions(t *testing.T) {
+	_, err := ResidencyFactory(config.Database{
+		Engine: "memory",
+		Regions: []config.DatabaseRegion{
+			{Name: "eu", Tenants: []string{"t1"}},
+			{Name: "us", Tenants: []string{"t1"}},
+		},
+	}, nil)
+	assert.Error(t, err)
+}
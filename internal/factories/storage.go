@@ -2,11 +2,24 @@ package factories
 
 import (
 	"github.com/Permify/permify/internal/storage"
+	DDRepository "github.com/Permify/permify/internal/storage/dynamodb"
+	DDSnapshot "github.com/Permify/permify/internal/storage/dynamodb/snapshot"
 	MMRepository "github.com/Permify/permify/internal/storage/memory"
+	MMSnapshot "github.com/Permify/permify/internal/storage/memory/snapshot"
+	MGRepository "github.com/Permify/permify/internal/storage/mongodb"
+	MGSnapshot "github.com/Permify/permify/internal/storage/mongodb/snapshot"
 	PQRepository "github.com/Permify/permify/internal/storage/postgres"
+	PQSnapshot "github.com/Permify/permify/internal/storage/postgres/snapshot"
+	SPRepository "github.com/Permify/permify/internal/storage/spanner"
+	SPSnapshot "github.com/Permify/permify/internal/storage/spanner/snapshot"
 	"github.com/Permify/permify/pkg/database"
+	DDDatabase "github.com/Permify/permify/pkg/database/dynamodb"
 	MMDatabase "github.com/Permify/permify/pkg/database/memory"
+	MGDatabase "github.com/Permify/permify/pkg/database/mongodb"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	SPDatabase "github.com/Permify/permify/pkg/database/spanner"
+	api "github.com/Permify/permify/pkg/storage"
+	"github.com/Permify/permify/pkg/token"
 )
 
 // DataReaderFactory creates and returns a DataReader based on the database engine type.
@@ -15,10 +28,23 @@ func DataReaderFactory(db database.Database) (repo storage.DataReader) {
 	case "postgres":
 		// If the database engine is Postgres, create a new DataReader using the Postgres implementation
 		return PQRepository.NewDataReader(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new DataReader using the DynamoDB implementation
+		return DDRepository.NewDataReader(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new DataReader using the MongoDB implementation
+		return MGRepository.NewDataReader(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new DataReader using the Spanner implementation
+		return SPRepository.NewDataReader(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new DataReader using the in-memory implementation
 		return MMRepository.NewDataReader(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new DataReader using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewDataReader(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewDataReader(db.(*MMDatabase.Memory))
 	}
@@ -30,10 +56,23 @@ func DataWriterFactory(db database.Database) (repo storage.DataWriter) {
 	case "postgres":
 		// If the database engine is Postgres, create a new DataWriter using the Postgres implementation
 		return PQRepository.NewDataWriter(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new DataWriter using the DynamoDB implementation
+		return DDRepository.NewDataWriter(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new DataWriter using the MongoDB implementation
+		return MGRepository.NewDataWriter(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new DataWriter using the Spanner implementation
+		return SPRepository.NewDataWriter(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new DataWriter using the in-memory implementation
 		return MMRepository.NewDataWriter(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new DataWriter using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewDataWriter(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewDataWriter(db.(*MMDatabase.Memory))
 	}
@@ -45,10 +84,23 @@ func SchemaReaderFactory(db database.Database) (repo storage.SchemaReader) {
 	case "postgres":
 		// If the database engine is Postgres, create a new SchemaReader using the Postgres implementation
 		return PQRepository.NewSchemaReader(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new SchemaReader using the DynamoDB implementation
+		return DDRepository.NewSchemaReader(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new SchemaReader using the MongoDB implementation
+		return MGRepository.NewSchemaReader(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new SchemaReader using the Spanner implementation
+		return SPRepository.NewSchemaReader(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new SchemaReader using the in-memory implementation
 		return MMRepository.NewSchemaReader(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new SchemaReader using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewSchemaReader(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewSchemaReader(db.(*MMDatabase.Memory))
 	}
@@ -60,10 +112,26 @@ func WatcherFactory(db database.Database) (repo storage.Watcher) {
 	case "postgres":
 		// If the database engine is Postgres, create a new Watcher using the Postgres implementation
 		return PQRepository.NewWatcher(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new Watcher using the DynamoDB implementation
+		return DDRepository.NewWatcher(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new Watcher using the MongoDB implementation
+		return MGRepository.NewWatcher(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new Watcher using the Spanner implementation
+		return SPRepository.NewWatcher(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new Watcher using the in-memory implementation
 		return MMRepository.NewWatcher(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new Watcher using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			if engine.NewWatcher == nil {
+				return storage.NewNoopWatcher()
+			}
+			return engine.NewWatcher(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewWatcher(db.(*MMDatabase.Memory))
 	}
@@ -75,10 +143,23 @@ func SchemaWriterFactory(db database.Database) (repo storage.SchemaWriter) {
 	case "postgres":
 		// If the database engine is Postgres, create a new SchemaWriter using the Postgres implementation
 		return PQRepository.NewSchemaWriter(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new SchemaWriter using the DynamoDB implementation
+		return DDRepository.NewSchemaWriter(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new SchemaWriter using the MongoDB implementation
+		return MGRepository.NewSchemaWriter(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new SchemaWriter using the Spanner implementation
+		return SPRepository.NewSchemaWriter(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new SchemaWriter using the in-memory implementation
 		return MMRepository.NewSchemaWriter(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new SchemaWriter using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewSchemaWriter(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewSchemaWriter(db.(*MMDatabase.Memory))
 	}
@@ -90,10 +171,23 @@ func TenantReaderFactory(db database.Database) (repo storage.TenantReader) {
 	case "postgres":
 		// If the database engine is Postgres, create a new TenantReader using the Postgres implementation
 		return PQRepository.NewTenantReader(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new TenantReader using the DynamoDB implementation
+		return DDRepository.NewTenantReader(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new TenantReader using the MongoDB implementation
+		return MGRepository.NewTenantReader(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new TenantReader using the Spanner implementation
+		return SPRepository.NewTenantReader(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new TenantReader using the in-memory implementation
 		return MMRepository.NewTenantReader(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new TenantReader using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewTenantReader(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewTenantReader(db.(*MMDatabase.Memory))
 	}
@@ -105,11 +199,43 @@ func TenantWriterFactory(db database.Database) (repo storage.TenantWriter) {
 	case "postgres":
 		// If the database engine is Postgres, create a new TenantWriter using the Postgres implementation
 		return PQRepository.NewTenantWriter(db.(*PQDatabase.Postgres))
+	case "dynamodb":
+		// If the database engine is DynamoDB, create a new TenantWriter using the DynamoDB implementation
+		return DDRepository.NewTenantWriter(db.(*DDDatabase.DynamoDB))
+	case "mongodb":
+		// If the database engine is MongoDB, create a new TenantWriter using the MongoDB implementation
+		return MGRepository.NewTenantWriter(db.(*MGDatabase.MongoDB))
+	case "spanner":
+		// If the database engine is Spanner, create a new TenantWriter using the Spanner implementation
+		return SPRepository.NewTenantWriter(db.(*SPDatabase.Spanner))
 	case "memory":
 		// If the database engine is in-memory, create a new TenantWriter using the in-memory implementation
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory))
 	default:
+		// If a custom engine is registered for the type, create a new TenantWriter using its implementation
+		if engine, ok := api.Lookup(db.GetEngineType()); ok {
+			return engine.NewTenantWriter(db)
+		}
 		// For any other type, use the in-memory implementation as a default
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory))
 	}
 }
+
+// SnapTokenDecoderFactory creates and returns the function decoding the snapshot tokens of the database engine type.
+// It returns nil for custom engines, whose tokens are opaque.
+func SnapTokenDecoderFactory(db database.Database) func(value string) (token.SnapToken, error) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return func(value string) (token.SnapToken, error) { return PQSnapshot.EncodedToken{Value: value}.Decode() }
+	case "dynamodb":
+		return func(value string) (token.SnapToken, error) { return DDSnapshot.EncodedToken{Value: value}.Decode() }
+	case "mongodb":
+		return func(value string) (token.SnapToken, error) { return MGSnapshot.EncodedToken{Value: value}.Decode() }
+	case "spanner":
+		return func(value string) (token.SnapToken, error) { return SPSnapshot.EncodedToken{Value: value}.Decode() }
+	case "memory":
+		return func(value string) (token.SnapToken, error) { return MMSnapshot.EncodedToken{Value: value}.Decode() }
+	default:
+		return nil
+	}
+}
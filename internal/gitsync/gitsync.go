@@ -0,0 +1,285 @@
+package gitsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitConfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/rs/xid"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultBranch   = "main"
+	_defaultPath     = "schema.perm"
+	_defaultInterval = time.Minute
+)
+
+// Syncer - Keeps the schemas of tenants in sync with a schema kept in a Git repository. It pulls the branch of the
+// repository periodically and, when the schema changed, validates it and writes it as a new version of the tenants
+// whose schema differs, logging the definitions that were added, removed and changed.
+type Syncer struct {
+	sr storage.SchemaReader
+	sw storage.SchemaWriter
+
+	url      string
+	branch   string
+	path     string
+	interval time.Duration
+	tenants  []string
+	auth     transport.AuthMethod
+
+	// repository is the in-memory clone of the repository, nil until the first pull
+	repository *git.Repository
+	// commit is the last commit whose schema was synced
+	commit plumbing.Hash
+}
+
+// NewSyncer creates a new Syncer of the repository at the URL with the provided options.
+func NewSyncer(sr storage.SchemaReader, sw storage.SchemaWriter, url string, opts ...Option) *Syncer {
+	s := &Syncer{
+		sr:       sr,
+		sw:       sw,
+		url:      url,
+		branch:   _defaultBranch,
+		path:     _defaultPath,
+		interval: _defaultInterval,
+		tenants:  []string{"t1"},
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Start syncs the schema right away and then periodically, until the context is done.
+func (s *Syncer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop() // Ensure the ticker is stopped when the function exits.
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			slog.Error("failed to sync schema from git", slog.String("url", s.url), slog.String("branch", s.branch), slog.Any("error", err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err() // Return context error if cancellation is requested.
+		}
+	}
+}
+
+// Sync pulls the repository and, if the branch moved since the last sync, applies its schema to the tenants.
+// A schema that does not compile is not applied to any tenant, and is not retried until the branch moves again.
+func (s *Syncer) Sync(ctx context.Context) error {
+	commit, err := s.pull(ctx)
+	if err != nil {
+		return err
+	}
+	if commit.Hash == s.commit {
+		return nil
+	}
+
+	schema, err := s.read(commit)
+	if err != nil {
+		return err
+	}
+
+	sch, err := parser.NewParser(schema).Parse()
+	if err == nil {
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+	}
+	if err != nil {
+		s.commit = commit.Hash
+		return fmt.Errorf("invalid schema at commit %s: %w", commit.Hash, err)
+	}
+
+	for _, tenantID := range s.tenants {
+		if err = s.apply(ctx, tenantID, sch, commit.Hash); err != nil {
+			return fmt.Errorf("failed to apply schema of commit %s to tenant %s: %w", commit.Hash, tenantID, err)
+		}
+	}
+
+	s.commit = commit.Hash
+	return nil
+}
+
+// pull clones the branch of the repository on the first call and fetches it afterwards, returning its head commit.
+func (s *Syncer) pull(ctx context.Context) (*object.Commit, error) {
+	if s.repository == nil {
+		repository, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+			URL:           s.url,
+			Auth:          s.auth,
+			ReferenceName: plumbing.NewBranchReferenceName(s.branch),
+			SingleBranch:  true,
+			Depth:         1,
+			Tags:          git.NoTags,
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.repository = repository
+	} else {
+		err := s.repository.FetchContext(ctx, &git.FetchOptions{
+			Auth:  s.auth,
+			Depth: 1,
+			Force: true,
+			Tags:  git.NoTags,
+			RefSpecs: []gitConfig.RefSpec{
+				gitConfig.RefSpec(fmt.Sprintf("+refs/heads/%s:refs/remotes/%s/%s", s.branch, git.DefaultRemoteName, s.branch)),
+			},
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil, err
+		}
+	}
+
+	ref, err := s.repository.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, s.branch), true)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.CommitObject(ref.Hash())
+}
+
+// read returns the schema at the path of the commit. The .perm files of a directory are read in the order of their
+// paths and joined.
+func (s *Syncer) read(commit *object.Commit) (string, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", err
+	}
+
+	p := strings.Trim(path.Clean("/"+s.path), "/")
+	if p != "" {
+		if file, err := tree.File(p); err == nil {
+			return file.Contents()
+		}
+		if tree, err = tree.Tree(p); err != nil {
+			return "", fmt.Errorf("%s not found in the repository", s.path)
+		}
+	}
+
+	var names []string
+	contents := map[string]string{}
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if !strings.HasSuffix(file.Name, ".perm") {
+			return nil
+		}
+		content, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		names = append(names, file.Name)
+		contents[file.Name] = content
+		return nil
+	})
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("no schema files found in %s", s.path)
+	}
+
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, contents[name])
+	}
+	return strings.Join(parts, "\n"), nil
+}
+
+// apply writes the schema as a new version of the tenant, unless the head version of the tenant is the same schema.
+func (s *Syncer) apply(ctx context.Context, tenantID string, sch *ast.Schema, commit plumbing.Hash) error {
+	current := map[string]string{}
+	head, err := s.sr.HeadVersion(ctx, tenantID)
+	if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+		return err
+	}
+	if err == nil {
+		definitions, err := s.sr.ReadSchemaDefinitions(ctx, tenantID, head)
+		if err != nil {
+			return err
+		}
+		for _, definition := range definitions {
+			current[definition.Name] = string(definition.SerializedDefinition)
+		}
+	}
+
+	version := xid.New().String()
+
+	desired := map[string]string{}
+	definitions := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		desired[st.GetName()] = st.String()
+		definitions = append(definitions, storage.SchemaDefinition{
+			TenantID:             tenantID,
+			Version:              version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(st.String()),
+		})
+	}
+
+	added, removed, changed := diff(current, desired)
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		slog.Debug("schema is in sync with git", slog.String("tenant_id", tenantID), slog.String("commit", commit.String()))
+		return nil
+	}
+
+	if err = s.sw.WriteSchema(ctx, definitions); err != nil {
+		return err
+	}
+
+	slog.Info("schema synced from git",
+		slog.String("tenant_id", tenantID),
+		slog.String("commit", commit.String()),
+		slog.String("schema_version", version),
+		slog.Any("added", added),
+		slog.Any("removed", removed),
+		slog.Any("changed", changed),
+	)
+	return nil
+}
+
+// diff returns the sorted names of the definitions added, removed and changed from the current to the desired
+// definitions, both keyed by name.
+func diff(current, desired map[string]string) (added, removed, changed []string) {
+	for name, definition := range desired {
+		c, ok := current[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case c != definition:
+			changed = append(changed, name)
+		}
+	}
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed
+}
@@ -0,0 +1,154 @@
+package gitsync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+)
+
+// TestGitSync -
+func TestGitSync(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gitsync-suite")
+}
+
+const (
+	schemaV1 = `entity user {}
+
+entity doc {
+	relation owner @user
+	permission edit = owner
+}`
+
+	schemaV2 = `entity user {}
+
+entity doc {
+	relation owner @user
+	relation viewer @user
+	permission edit = owner
+	permission view = viewer or owner
+}`
+)
+
+var _ = Describe("gitsync", func() {
+	var dir string
+	var repository *git.Repository
+	var sr storage.SchemaReader
+	var sw storage.SchemaWriter
+
+	commit := func(files map[string]string) {
+		worktree, err := repository.Worktree()
+		Expect(err).ShouldNot(HaveOccurred())
+		for name, content := range files {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755)).Should(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)).Should(Succeed())
+			_, err = worktree.Add(name)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		_, err = worktree.Commit("update schema", &git.CommitOptions{
+			Author: &object.Signature{Name: "permify", Email: "permify@example.com", When: time.Now()},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	head := func(tenantID string) string {
+		version, err := sr.HeadVersion(context.Background(), tenantID)
+		Expect(err).ShouldNot(HaveOccurred())
+		return version
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		var err error
+		repository, err = git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+			InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+		sr = factories.SchemaReaderFactory(db)
+		sw = factories.SchemaWriterFactory(db)
+	})
+
+	It("Case 1 - Applies changes of the schema file to the tenants", func() {
+		commit(map[string]string{"schema.perm": schemaV1})
+
+		syncer := NewSyncer(sr, sw, dir, Tenants("t1", "t2"))
+		Expect(syncer.Sync(context.Background())).Should(Succeed())
+
+		sch, err := sr.ReadSchema(context.Background(), "t2", head("t2"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sch.GetEntityDefinitions()["doc"].GetPermissions()).Should(HaveKey("edit"))
+
+		// Nothing is written while the branch does not move
+		v1 := head("t1")
+		Expect(syncer.Sync(context.Background())).Should(Succeed())
+		Expect(head("t1")).Should(Equal(v1))
+
+		commit(map[string]string{"schema.perm": schemaV2})
+		Expect(syncer.Sync(context.Background())).Should(Succeed())
+		Expect(head("t1")).ShouldNot(Equal(v1))
+
+		sch, err = sr.ReadSchema(context.Background(), "t1", head("t1"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sch.GetEntityDefinitions()["doc"].GetPermissions()).Should(HaveKey("view"))
+	})
+
+	It("Case 2 - Does not apply a schema that does not compile", func() {
+		commit(map[string]string{"schema.perm": schemaV1})
+
+		syncer := NewSyncer(sr, sw, dir)
+		Expect(syncer.Sync(context.Background())).Should(Succeed())
+		v1 := head("t1")
+
+		commit(map[string]string{"schema.perm": "entity doc {\n\tpermission edit = owner\n}"})
+		Expect(syncer.Sync(context.Background())).Should(HaveOccurred())
+		Expect(head("t1")).Should(Equal(v1))
+
+		// The commit is not retried until the branch moves again
+		Expect(syncer.Sync(context.Background())).Should(Succeed())
+	})
+
+	It("Case 3 - Joins the schema files of a directory and skips tenants whose schema is the same", func() {
+		commit(map[string]string{
+			"permify/a_user.perm": "entity user {}",
+			"permify/b_doc.perm":  "entity doc {\n\trelation owner @user\n\tpermission edit = owner\n}",
+			"permify/README.md":   "schema of the documents",
+		})
+
+		Expect(NewSyncer(sr, sw, dir, Path("permify")).Sync(context.Background())).Should(Succeed())
+		v1 := head("t1")
+
+		sch, err := sr.ReadSchema(context.Background(), "t1", v1)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sch.GetEntityDefinitions()).Should(HaveLen(2))
+
+		// A new syncer of the same schema does not write a new version
+		Expect(NewSyncer(sr, sw, dir, Path("permify/")).Sync(context.Background())).Should(Succeed())
+		Expect(head("t1")).Should(Equal(v1))
+	})
+
+	It("Case 4 - Diff", func() {
+		added, removed, changed := diff(
+			map[string]string{"user": "entity user {}", "doc": "entity doc {}", "team": "entity team {}"},
+			map[string]string{"user": "entity user {}", "doc": "entity doc {relation owner @user}", "org": "entity org {}"},
+		)
+		Expect(added).Should(Equal([]string{"org"}))
+		Expect(removed).Should(Equal([]string{"team"}))
+		Expect(changed).Should(Equal([]string{"doc"}))
+	})
+})
@@ -0,0 +1,46 @@
+package gitsync
+
+import (
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// Option represents a function that configures a Syncer.
+type Option func(s *Syncer)
+
+// Branch is an option that sets the branch the schema is read from.
+func Branch(branch string) Option {
+	return func(s *Syncer) {
+		s.branch = branch
+	}
+}
+
+// Path is an option that sets the schema file, or the directory of .perm files, in the repository.
+func Path(path string) Option {
+	return func(s *Syncer) {
+		s.path = path
+	}
+}
+
+// Interval is an option that sets the interval between pulls of the repository.
+func Interval(n time.Duration) Option {
+	return func(s *Syncer) {
+		s.interval = n
+	}
+}
+
+// Tenants is an option that sets the tenants the schema is applied to.
+func Tenants(tenants ...string) Option {
+	return func(s *Syncer) {
+		s.tenants = tenants
+	}
+}
+
+// BasicAuth is an option that authenticates to the repository with HTTP basic authentication, e.g. with a
+// username and an access token.
+func BasicAuth(username, password string) Option {
+	return func(s *Syncer) {
+		s.auth = &http.BasicAuth{Username: username, Password: password}
+	}
+}
@@ -24,6 +24,7 @@ type Invoker interface {
 	Expand
 	Lookup
 	SubjectPermission
+	Paths
 }
 
 // Check is an interface that defines a method for checking permissions.
@@ -43,6 +44,7 @@ type Expand interface {
 type Lookup interface {
 	LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error)
 	LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error)
+	LookupEntityWithPermissions(ctx context.Context, request *base.PermissionLookupEntityWithPermissionsRequest) (response *base.PermissionLookupEntityWithPermissionsResponse, err error)
 	LookupSubject(ctx context.Context, request *base.PermissionLookupSubjectRequest) (response *base.PermissionLookupSubjectResponse, err error)
 }
 
@@ -51,6 +53,11 @@ type SubjectPermission interface {
 	SubjectPermission(ctx context.Context, request *base.PermissionSubjectPermissionRequest) (response *base.PermissionSubjectPermissionResponse, err error)
 }
 
+// Paths is an interface that defines a method for finding the relationship paths connecting a subject to a permission.
+type Paths interface {
+	Paths(ctx context.Context, request *base.PermissionPathsRequest) (response *base.PermissionPathsResponse, err error)
+}
+
 // DirectInvoker is a struct that implements the Invoker interface.
 // It holds references to various engines needed for permission-related operations.
 type DirectInvoker struct {
@@ -66,6 +73,8 @@ type DirectInvoker struct {
 	lo Lookup
 	// LookupSubject
 	sp SubjectPermission
+	// Paths engine for finding the paths connecting subjects to permissions
+	pa Paths
 
 	// Metrics
 	checkCounter             api.Int64Counter
@@ -75,7 +84,7 @@ type DirectInvoker struct {
 }
 
 // NewDirectInvoker is a constructor for DirectInvoker.
-// It takes pointers to CheckEngine, ExpandEngine, LookupSchemaEngine, and LookupEntityEngine as arguments
+// It takes pointers to CheckEngine, ExpandEngine, LookupSchemaEngine, LookupEntityEngine, and PathEngine as arguments
 // and returns an Invoker instance.
 func NewDirectInvoker(
 	schemaReader storage.SchemaReader,
@@ -84,6 +93,7 @@ func NewDirectInvoker(
 	ec Expand,
 	lo Lookup,
 	sp SubjectPermission,
+	pa Paths,
 	meter api.Meter,
 ) *DirectInvoker {
 	// Check Counter
@@ -117,6 +127,7 @@ func NewDirectInvoker(
 		ec:                       ec,
 		lo:                       lo,
 		sp:                       sp,
+		pa:                       pa,
 		checkCounter:             checkCounter,
 		lookupEntityCounter:      lookupEntityCounter,
 		lookupSubjectCounter:     lookupSubjectCounter,
@@ -136,8 +147,11 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 	))
 	defer span.End()
 
-	// Validate the depth of the request.
+	// Validate the depth of the request, and that its check is not reached again through a cycle.
 	err = checkDepth(request)
+	if err == nil {
+		err = checkCycle(request)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -150,10 +164,15 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 		}, err
 	}
 
-	// Set the SnapToken if it's not provided in the request.
+	// Set the SnapToken if it's not provided in the request. A requested point in time is resolved
+	// to the snapshot that was current at that time, otherwise the latest snapshot is used.
 	if request.GetMetadata().GetSnapToken() == "" {
 		var st token.SnapToken
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		if request.GetMetadata().GetSnapshotTime() != nil {
+			st, err = invoker.dataReader.SnapshotAt(ctx, request.GetTenantId(), request.GetMetadata().GetSnapshotTime().AsTime())
+		} else {
+			st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		}
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -184,8 +203,13 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 		}
 	}
 
-	// Decrease the depth of the request metadata.
-	request.Metadata = decreaseDepth(request.GetMetadata())
+	// Set the request the check is evaluated for, if the check is the one the server received.
+	if request.GetMetadata().GetRequest() == nil {
+		request.Metadata.Request = requestInfo(ctx)
+	}
+
+	// Decrease the depth of the request metadata, and add its check to the path.
+	request.Metadata = decreaseDepth(request)
 
 	// Perform the actual permission check using the provided request.
 	response, err = invoker.cc.Check(ctx, request)
@@ -325,6 +349,46 @@ func (invoker *DirectInvoker) LookupEntityStream(ctx context.Context, request *b
 	return invoker.lo.LookupEntityStream(ctx, request, server)
 }
 
+// LookupEntityWithPermissions is a method that implements the LookupEntityWithPermissions interface.
+// It calls the LookupEntityWithPermissions method of the LookupEntityEngine with the provided context and
+// PermissionLookupEntityWithPermissionsRequest, and returns a PermissionLookupEntityWithPermissionsResponse and an error if any.
+func (invoker *DirectInvoker) LookupEntityWithPermissions(ctx context.Context, request *base.PermissionLookupEntityWithPermissionsRequest) (response *base.PermissionLookupEntityWithPermissionsResponse, err error) {
+	ctx, span := tracer.Start(ctx, "lookup-entity-with-permissions", trace.WithAttributes(
+		attribute.KeyValue{Key: "tenant_id", Value: attribute.StringValue(request.GetTenantId())},
+		attribute.KeyValue{Key: "entity_type", Value: attribute.StringValue(request.GetEntityType())},
+		attribute.KeyValue{Key: "permissions", Value: attribute.StringSliceValue(request.GetPermissions())},
+		attribute.KeyValue{Key: "subject", Value: attribute.StringValue(tuple.SubjectToString(request.GetSubject()))},
+	))
+	defer span.End()
+
+	// Set SnapToken if not provided
+	if request.GetMetadata().GetSnapToken() == "" { // Check if the request has a SnapToken.
+		var st token.SnapToken
+		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId()) // Retrieve the head snapshot from the relationship reader.
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return response, err
+		}
+		request.Metadata.SnapToken = st.Encode().String() // Set the SnapToken in the request metadata.
+	}
+
+	// Set SchemaVersion if not provided
+	if request.GetMetadata().GetSchemaVersion() == "" { // Check if the request has a SchemaVersion.
+		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId()) // Retrieve the head schema version from the schema reader.
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return response, err
+		}
+	}
+
+	// Increase the lookup entity count in the metrics.
+	invoker.lookupEntityCounter.Add(ctx, 1)
+
+	return invoker.lo.LookupEntityWithPermissions(ctx, request)
+}
+
 // LookupSubject is a method of the DirectInvoker structure. It handles the task of looking up subjects
 // and returning the results in a response.
 func (invoker *DirectInvoker) LookupSubject(ctx context.Context, request *base.PermissionLookupSubjectRequest) (response *base.PermissionLookupSubjectResponse, err error) {
@@ -417,3 +481,45 @@ func (invoker *DirectInvoker) SubjectPermission(ctx context.Context, request *ba
 	// and return its response and error
 	return invoker.sp.SubjectPermission(ctx, request)
 }
+
+// Paths is a method of the DirectInvoker structure. It finds the relationship paths connecting the subject of the
+// request to the permission of its entity, at the head snapshot and schema version unless the request sets them.
+func (invoker *DirectInvoker) Paths(ctx context.Context, request *base.PermissionPathsRequest) (response *base.PermissionPathsResponse, err error) {
+	ctx, span := tracer.Start(ctx, "paths", trace.WithAttributes(
+		attribute.KeyValue{Key: "tenant_id", Value: attribute.StringValue(request.GetTenantId())},
+		attribute.KeyValue{Key: "entity", Value: attribute.StringValue(tuple.EntityToString(request.GetEntity()))},
+		attribute.KeyValue{Key: "permission", Value: attribute.StringValue(request.GetPermission())},
+		attribute.KeyValue{Key: "subject", Value: attribute.StringValue(tuple.SubjectToString(request.GetSubject()))},
+	))
+	defer span.End()
+
+	if request.GetMetadata().GetSnapToken() == "" {
+		var st token.SnapToken
+		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return response, err
+		}
+		request.Metadata.SnapToken = st.Encode().String()
+	}
+
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return response, err
+		}
+	}
+
+	response, err = invoker.pa.Paths(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return response, err
+	}
+
+	span.SetAttributes(attribute.KeyValue{Key: "paths", Value: attribute.IntValue(len(response.GetPaths()))})
+	return response, nil
+}
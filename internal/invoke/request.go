@@ -0,0 +1,102 @@
+package invoke
+
+import (
+	"context"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// _defaultCheckDepth is the depth of checks that do not set one, matching the default of the API.
+const _defaultCheckDepth = 20
+
+// CheckRequest - Permission check written with plain Go values, for in-process callers and tests that would
+// rather not build protobuf requests. Entities are written as "type:id", e.g. "repository:1", and subjects as
+// "type:id" or "type:id#relation", e.g. "organization:1#member".
+type CheckRequest struct {
+	Tenant     string
+	Entity     string
+	Permission string
+	Subject    string
+
+	// Contextual relation tuples, e.g. "repository:1#owner@user:2", considered along with the stored ones.
+	ContextualTuples []string
+
+	// Optional snapshot and schema version to check against, the latest ones if empty.
+	SnapToken     string
+	SchemaVersion string
+	// Optional depth of the check, 20 if zero.
+	Depth int32
+}
+
+// CheckResult - Outcome of a permission check
+type CheckResult struct {
+	// Allowed reports whether the subject has the permission on the entity.
+	Allowed bool
+	// CheckCount is the number of checks performed to reach the outcome.
+	CheckCount int32
+}
+
+// ToProto - Builds the protobuf request of the check
+func (r CheckRequest) ToProto() (*base.PermissionCheckRequest, error) {
+	entity, err := tuple.E(r.Entity)
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := tuple.EAR(r.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	tuples := make([]*base.Tuple, 0, len(r.ContextualTuples))
+	for _, t := range r.ContextualTuples {
+		tup, err := tuple.Tuple(t)
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, tup)
+	}
+
+	depth := r.Depth
+	if depth == 0 {
+		depth = _defaultCheckDepth
+	}
+
+	return &base.PermissionCheckRequest{
+		TenantId: r.Tenant,
+		Metadata: &base.PermissionCheckRequestMetadata{
+			SchemaVersion: r.SchemaVersion,
+			SnapToken:     r.SnapToken,
+			Depth:         depth,
+		},
+		Entity:     entity,
+		Permission: r.Permission,
+		Subject: &base.Subject{
+			Type:     subject.GetEntity().GetType(),
+			Id:       subject.GetEntity().GetId(),
+			Relation: subject.GetRelation(),
+		},
+		Context: &base.Context{
+			Tuples: tuples,
+		},
+	}, nil
+}
+
+// CheckPermission - Runs the check with the checker and reports whether the permission is allowed
+func CheckPermission(ctx context.Context, checker Check, request CheckRequest) (CheckResult, error) {
+	req, err := request.ToProto()
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	response, err := checker.Check(ctx, req)
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	return CheckResult{
+		Allowed:    response.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED,
+		CheckCount: response.GetMetadata().GetCheckCount(),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package invoke
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+type fakeChecker struct {
+	request  *base.PermissionCheckRequest
+	response *base.PermissionCheckResponse
+}
+
+func (c *fakeChecker) Check(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	c.request = request
+	return c.response, nil
+}
+
+func TestCheckPermission(t *testing.T) {
+	checker := &fakeChecker{response: &base.PermissionCheckResponse{
+		Can:      base.CheckResult_CHECK_RESULT_ALLOWED,
+		Metadata: &base.PermissionCheckResponseMetadata{CheckCount: 3},
+	}}
+
+	result, err := CheckPermission(context.Background(), checker, CheckRequest{
+		Tenant:           "t1",
+		Entity:           "repository:1",
+		Permission:       "edit",
+		Subject:          "organization:1#member",
+		ContextualTuples: []string{"repository:1#owner@user:2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, CheckResult{Allowed: true, CheckCount: 3}, result)
+
+	assert.Equal(t, "t1", checker.request.GetTenantId())
+	assert.Equal(t, int32(20), checker.request.GetMetadata().GetDepth())
+	assert.Equal(t, "repository", checker.request.GetEntity().GetType())
+	assert.Equal(t, "1", checker.request.GetEntity().GetId())
+	assert.Equal(t, "organization", checker.request.GetSubject().GetType())
+	assert.Equal(t, "member", checker.request.GetSubject().GetRelation())
+	assert.Len(t, checker.request.GetContext().GetTuples(), 1)
+}
+
+func TestCheckPermissionInvalidRequest(t *testing.T) {
+	checker := &fakeChecker{}
+
+	_, err := CheckPermission(context.Background(), checker, CheckRequest{
+		Tenant:     "t1",
+		Entity:     "repository",
+		Permission: "edit",
+		Subject:    "user:1",
+	})
+	assert.Error(t, err)
+	assert.Nil(t, checker.request)
+}
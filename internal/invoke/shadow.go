@@ -0,0 +1,148 @@
+package invoke
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// shadowTimeout is the time a shadow check may take.
+const shadowTimeout = 10 * time.Second
+
+// ShadowInvoker - Invoker that evaluates the checks of tenants against a candidate schema version as well as the
+// version they are served from, to roll out schema changes safely. Answers are always those of the served version.
+// Shadow checks run in the background at the same snapshot as the served ones, and their divergences are logged
+// and counted. Shadow checks are dropped rather than queued once the concurrency limit is reached.
+type ShadowInvoker struct {
+	Invoker
+
+	// candidates are the candidate schema versions by tenant
+	candidates map[string]string
+	// slots limits the number of shadow checks running at once
+	slots chan struct{}
+
+	// Metrics
+	checkCounter      api.Int64Counter
+	divergenceCounter api.Int64Counter
+	errorCounter      api.Int64Counter
+	droppedCounter    api.Int64Counter
+}
+
+// NewShadowInvoker - Creates a new shadow invoker evaluating the checks of the tenants of candidates, keyed by tenant,
+// against their candidate schema version, running at most concurrencyLimit shadow checks at once.
+func NewShadowInvoker(delegate Invoker, candidates map[string]string, concurrencyLimit int, meter api.Meter) *ShadowInvoker {
+	checkCounter, err := meter.Int64Counter("shadow_check_count", api.WithDescription("Number of checks evaluated against a candidate schema version"))
+	if err != nil {
+		panic(err)
+	}
+
+	divergenceCounter, err := meter.Int64Counter("shadow_check_divergence_count", api.WithDescription("Number of checks whose result differs on the candidate schema version"))
+	if err != nil {
+		panic(err)
+	}
+
+	errorCounter, err := meter.Int64Counter("shadow_check_error_count", api.WithDescription("Number of checks that failed on the candidate schema version"))
+	if err != nil {
+		panic(err)
+	}
+
+	droppedCounter, err := meter.Int64Counter("shadow_check_dropped_count", api.WithDescription("Number of checks not evaluated against the candidate schema version because of the concurrency limit"))
+	if err != nil {
+		panic(err)
+	}
+
+	if concurrencyLimit < 1 {
+		concurrencyLimit = 1
+	}
+
+	return &ShadowInvoker{
+		Invoker:           delegate,
+		candidates:        candidates,
+		slots:             make(chan struct{}, concurrencyLimit),
+		checkCounter:      checkCounter,
+		divergenceCounter: divergenceCounter,
+		errorCounter:      errorCounter,
+		droppedCounter:    droppedCounter,
+	}
+}
+
+// Check - Evaluates the check with the delegate, and in the background against the candidate schema version of the
+// tenant, if it has one.
+func (invoker *ShadowInvoker) Check(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, err error) {
+	candidate, ok := invoker.candidates[request.GetTenantId()]
+	if !ok {
+		return invoker.Invoker.Check(ctx, request)
+	}
+
+	// The delegate resolves the snapshot and the schema version into the metadata of the request, and decreases
+	// its depth, so the shadow request is copied first.
+	shadow := proto.Clone(request).(*base.PermissionCheckRequest)
+
+	response, err = invoker.Invoker.Check(ctx, request)
+	if err != nil || request.GetMetadata().GetSchemaVersion() == candidate {
+		return response, err
+	}
+
+	if shadow.Metadata == nil {
+		shadow.Metadata = &base.PermissionCheckRequestMetadata{}
+	}
+	shadow.Metadata.SnapToken = request.GetMetadata().GetSnapToken()
+	shadow.Metadata.SchemaVersion = candidate
+	shadow.Metadata.Debug = false
+
+	select {
+	case invoker.slots <- struct{}{}:
+		go func() {
+			defer func() { <-invoker.slots }()
+			invoker.compare(shadow, request.GetMetadata().GetSchemaVersion(), response.GetCan())
+		}()
+	default:
+		invoker.droppedCounter.Add(ctx, 1, api.WithAttributes(attribute.String("tenant_id", request.GetTenantId())))
+	}
+
+	return response, err
+}
+
+// compare evaluates the shadow request and records whether its result diverges from the served one.
+func (invoker *ShadowInvoker) compare(request *base.PermissionCheckRequest, active string, can base.CheckResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), shadowTimeout)
+	defer cancel()
+
+	attrs := api.WithAttributes(attribute.String("tenant_id", request.GetTenantId()))
+	invoker.checkCounter.Add(ctx, 1, attrs)
+
+	candidate := request.GetMetadata().GetSchemaVersion()
+	response, err := invoker.Invoker.Check(ctx, request)
+	if err != nil {
+		invoker.errorCounter.Add(ctx, 1, attrs)
+		slog.Warn("shadow check failed on the candidate schema version",
+			slog.String("tenant_id", request.GetTenantId()),
+			slog.String("schema_version", active),
+			slog.String("candidate_schema_version", candidate),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if response.GetCan() != can {
+		invoker.divergenceCounter.Add(ctx, 1, attrs)
+		slog.Warn("shadow check diverged on the candidate schema version",
+			slog.String("tenant_id", request.GetTenantId()),
+			slog.String("entity", tuple.EntityToString(request.GetEntity())),
+			slog.String("permission", request.GetPermission()),
+			slog.String("subject", tuple.SubjectToString(request.GetSubject())),
+			slog.String("snap_token", request.GetMetadata().GetSnapToken()),
+			slog.String("schema_version", active),
+			slog.String("result", can.String()),
+			slog.String("candidate_schema_version", candidate),
+			slog.String("candidate_result", response.GetCan().String()),
+		)
+	}
+}
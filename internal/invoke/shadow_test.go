@@ -0,0 +1,92 @@
+package invoke
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+// versionedInvoker answers checks by the schema version of the request, resolving the head version and snapshot
+// like the direct invoker does.
+type versionedInvoker struct {
+	Invoker
+
+	mu       sync.Mutex
+	requests []*base.PermissionCheckRequest
+	results  map[string]base.CheckResult
+	done     chan struct{}
+}
+
+func (invoker *versionedInvoker) Check(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if request.GetMetadata().GetSnapToken() == "" {
+		request.Metadata.SnapToken = "snap"
+	}
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		request.Metadata.SchemaVersion = "v1"
+	}
+
+	invoker.mu.Lock()
+	invoker.requests = append(invoker.requests, request)
+	invoker.mu.Unlock()
+
+	if request.GetMetadata().GetSchemaVersion() != "v1" && invoker.done != nil {
+		defer close(invoker.done)
+	}
+
+	return &base.PermissionCheckResponse{Can: invoker.results[request.GetMetadata().GetSchemaVersion()]}, nil
+}
+
+func TestShadowInvokerCheck(t *testing.T) {
+	delegate := &versionedInvoker{
+		results: map[string]base.CheckResult{
+			"v1": base.CheckResult_CHECK_RESULT_ALLOWED,
+			"v2": base.CheckResult_CHECK_RESULT_DENIED,
+		},
+		done: make(chan struct{}),
+	}
+	invoker := NewShadowInvoker(delegate, map[string]string{"t1": "v2"}, 1, telemetry.NewNoopMeter())
+
+	response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+		Entity:     &base.Entity{Type: "repository", Id: "1"},
+		Permission: "edit",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, response.GetCan())
+
+	select {
+	case <-delegate.done:
+	case <-time.After(time.Second):
+		t.Fatal("shadow check was not evaluated")
+	}
+
+	delegate.mu.Lock()
+	defer delegate.mu.Unlock()
+	assert.Len(t, delegate.requests, 2)
+	assert.Equal(t, "v2", delegate.requests[1].GetMetadata().GetSchemaVersion())
+	assert.Equal(t, "snap", delegate.requests[1].GetMetadata().GetSnapToken())
+	assert.Equal(t, int32(20), delegate.requests[1].GetMetadata().GetDepth())
+}
+
+func TestShadowInvokerCheckWithoutCandidate(t *testing.T) {
+	delegate := &versionedInvoker{
+		results: map[string]base.CheckResult{"v1": base.CheckResult_CHECK_RESULT_ALLOWED},
+	}
+	invoker := NewShadowInvoker(delegate, map[string]string{"t2": "v2"}, 1, telemetry.NewNoopMeter())
+
+	response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+		TenantId: "t1",
+		Metadata: &base.PermissionCheckRequestMetadata{Depth: 20},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, response.GetCan())
+	assert.Len(t, delegate.requests, 1)
+}
@@ -1,9 +1,19 @@
 package invoke
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"strings"
 
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/pkg/authn"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 // checkDepth - a helper function that returns an error if the depth in a PermissionCheckRequest is zero.
@@ -14,18 +24,78 @@ func checkDepth(request *base.PermissionCheckRequest) error {
 	return nil
 }
 
-// increaseCheckCount - a helper function that increments the check count in a PermissionCheckResponseMetadata struct.
+// increaseCheckCount - a helper function that increments the check count in a PermissionCheckResponseMetadata struct,
+// keeping whether the result depends on the request.
 func increaseCheckCount(metadata *base.PermissionCheckResponseMetadata) *base.PermissionCheckResponseMetadata {
 	return &base.PermissionCheckResponseMetadata{
-		CheckCount: metadata.CheckCount + 1,
+		CheckCount:       metadata.CheckCount + 1,
+		RequestDependent: metadata.GetRequestDependent(),
+	}
+}
+
+// checkCycle - a helper function that returns an error naming the cycle if the check of a PermissionCheckRequest was
+// already reached on the path leading to it, which relationships forming a loop, such as two folders being the parent
+// of each other, make a check walk forever.
+func checkCycle(request *base.PermissionCheckRequest) error {
+	step := pathStep(request)
+	for i, s := range request.GetMetadata().GetPath() {
+		if s == step {
+			cycle := append(append([]string{}, request.GetMetadata().GetPath()[i:]...), step)
+			return fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_CYCLE_DETECTED.String(), strings.Join(cycle, " -> "))
+		}
 	}
+	return nil
 }
 
-// increaseCheckCount - a helper function that increments the check count in a PermissionCheckResponseMetadata struct.
-func decreaseDepth(metadata *base.PermissionCheckRequestMetadata) *base.PermissionCheckRequestMetadata {
+// decreaseDepth - a helper function that decreases the depth in the metadata of a PermissionCheckRequest, and adds
+// its check to the path of the metadata.
+func decreaseDepth(request *base.PermissionCheckRequest) *base.PermissionCheckRequestMetadata {
+	metadata := request.GetMetadata()
+	path := make([]string, len(metadata.GetPath()), len(metadata.GetPath())+1)
+	copy(path, metadata.GetPath())
 	return &base.PermissionCheckRequestMetadata{
 		SchemaVersion: metadata.GetSchemaVersion(),
 		SnapToken:     metadata.GetSnapToken(),
 		Depth:         metadata.Depth - 1,
+		Path:          append(path, pathStep(request)),
+		Request:       metadata.GetRequest(),
+	}
+}
+
+// requestInfo - a helper function that returns the request a check received by the server is evaluated for, without
+// its context, which is read from the check.
+func requestInfo(ctx context.Context) *base.RequestInfo {
+	return &base.RequestInfo{
+		Time:  timestamppb.Now(),
+		Ip:    callerIP(ctx),
+		Actor: authn.ActorFromContext(ctx),
 	}
 }
+
+// callerIP - a helper function that returns the IP address of the caller of the request. The requests the HTTP
+// gateway serves in process reach the server through memory, so the address of their caller is the last one the
+// gateway forwarded.
+func callerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() != "tcp" {
+		forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for")
+		if len(forwarded) == 0 {
+			return ""
+		}
+		addresses := strings.Split(forwarded[len(forwarded)-1], ",")
+		return strings.TrimSpace(addresses[len(addresses)-1])
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// pathStep - a helper function that returns the check of a PermissionCheckRequest as a step of a path.
+func pathStep(request *base.PermissionCheckRequest) string {
+	return tuple.EntityToString(request.GetEntity()) + "#" + request.GetPermission()
+}
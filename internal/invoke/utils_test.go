@@ -0,0 +1,72 @@
+package invoke
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/Permify/permify/pkg/authn"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestCheckCycle(t *testing.T) {
+	request := func(id string, metadata *base.PermissionCheckRequestMetadata) *base.PermissionCheckRequest {
+		return &base.PermissionCheckRequest{
+			Entity:     &base.Entity{Type: "folder", Id: id},
+			Permission: "view",
+			Metadata:   metadata,
+		}
+	}
+
+	// Two folders being the parent of each other
+	first := request("1", &base.PermissionCheckRequestMetadata{Depth: 20})
+	require.NoError(t, checkCycle(first))
+	second := request("2", decreaseDepth(first))
+	require.NoError(t, checkCycle(second))
+	assert.Equal(t, int32(19), second.GetMetadata().GetDepth())
+
+	third := request("1", decreaseDepth(second))
+	err := checkCycle(third)
+	require.Error(t, err)
+	assert.Equal(t, "ERROR_CODE_CYCLE_DETECTED: folder:1#view -> folder:2#view -> folder:1#view", err.Error())
+
+	// The path of the request the sub-checks are reached from is left as is
+	assert.Equal(t, []string{"folder:1#view"}, second.GetMetadata().GetPath())
+}
+
+// memoryAddr is the address of a connection through memory, such as the one of the in-process HTTP gateway.
+type memoryAddr struct{}
+
+func (memoryAddr) Network() string { return "bufconn" }
+func (memoryAddr) String() string  { return "bufconn" }
+
+func TestRequestInfo(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 51234}})
+	info := requestInfo(authn.ContextWithActor(ctx, "alice"))
+	assert.Equal(t, "10.0.0.7", info.GetIp())
+	assert.Equal(t, "alice", info.GetActor())
+	assert.NotNil(t, info.GetTime())
+
+	// The requests of the in-process gateway are from the last address it forwarded
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: memoryAddr{}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.1, 198.51.100.2"))
+	assert.Equal(t, "198.51.100.2", requestInfo(ctx).GetIp())
+
+	// Other callers can't forward an address
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 51234}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.1"))
+	assert.Equal(t, "10.0.0.7", requestInfo(ctx).GetIp())
+
+	// The request is passed on to the sub-checks
+	request := &base.PermissionCheckRequest{
+		Entity:     &base.Entity{Type: "folder", Id: "1"},
+		Permission: "view",
+		Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20, Request: info},
+	}
+	assert.Equal(t, info, decreaseDepth(request).GetRequest())
+}
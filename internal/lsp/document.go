@@ -0,0 +1,407 @@
+package lsp
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl"
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// keywords - Keywords of the language offered by completion
+var keywords = []string{"entity", "relation", "attribute", "permission", "rule", "and", "or", "not"}
+
+// document - Schema file opened by the client, along with the result of its last analysis
+type document struct {
+	uri     string
+	version int
+	lines   []string
+	// schema is the syntax tree of the last version that parsed. It is kept while a version that does not parse
+	// is being edited, so that navigation and completion keep working.
+	schema      *ast.Schema
+	diagnostics []Diagnostic
+}
+
+// newDocument - Analyzes a version of the document, falling back to the syntax tree of the previous version if
+// the text does not parse
+func newDocument(uri string, version int, text string, previous *ast.Schema) *document {
+	doc := &document{
+		uri:         uri,
+		version:     version,
+		lines:       strings.Split(text, "\n"),
+		schema:      previous,
+		diagnostics: []Diagnostic{},
+	}
+
+	sch, _, _, err := dsl.Compile(text)
+	if sch != nil {
+		doc.schema = sch
+	}
+	if err != nil {
+		doc.diagnostics = append(doc.diagnostics, doc.diagnostic(err))
+	}
+
+	return doc
+}
+
+// diagnostic converts an error of the compiler to a diagnostic spanning the word at its position.
+func (d *document) diagnostic(err error) Diagnostic {
+	diagnostic := Diagnostic{
+		Severity: SeverityError,
+		Source:   "permify",
+		Message:  err.Error(),
+	}
+
+	var e *dsl.Error
+	if errors.As(err, &e) {
+		start := toPosition(e.Position)
+		end := Position{Line: start.Line, Character: start.Character + 1}
+		if _, s, w := d.wordAt(start); w != "" && s == start.Character {
+			end.Character = s + len(w)
+		}
+		diagnostic.Range = Range{Start: start, End: end}
+		diagnostic.Message = e.Message
+	}
+
+	return diagnostic
+}
+
+// hover returns the declaration of the symbol at the position.
+func (d *document) hover(pos Position) *Hover {
+	name, node := d.resolve(pos)
+	if node == nil {
+		return nil
+	}
+
+	text := strings.TrimSpace(node.String())
+	if entity, ok := node.(*ast.EntityStatement); ok {
+		text = "entity " + entity.Name.Literal
+	}
+
+	r := tokenRange(name)
+	return &Hover{
+		Contents: MarkupContent{Kind: "markdown", Value: "```perm\n" + text + "\n```"},
+		Range:    &r,
+	}
+}
+
+// definition returns the location of the declaration of the symbol at the position.
+func (d *document) definition(pos Position) *Location {
+	name, node := d.resolve(pos)
+	if node == nil {
+		return nil
+	}
+	return &Location{URI: d.uri, Range: tokenRange(name)}
+}
+
+// completion returns the suggestions for the word being written at the position.
+func (d *document) completion(pos Position) []CompletionItem {
+	items := []CompletionItem{}
+	if d.schema == nil {
+		for _, k := range keywords {
+			items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+		}
+		return items
+	}
+
+	line := d.line(pos.Line)
+	start := pos.Character
+	if start > len(line) {
+		start = len(line)
+	}
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+
+	var separator byte
+	if start > 0 {
+		separator = line[start-1]
+	}
+
+	switch separator {
+	case '@':
+		// Entity types of a relation.
+		for _, entity := range d.entities() {
+			items = append(items, entityItem(entity))
+		}
+	case '#':
+		// Relations of the entity type before the hash.
+		if entity := d.entity(precedingWord(line, start-1)); entity != nil {
+			for _, s := range entity.RelationStatements {
+				items = append(items, memberItem(s))
+			}
+		}
+	case '.':
+		// Members of the entity types the relations before the dot reach.
+		for _, entity := range d.walkedChain(d.enclosingEntity(pos.Line), precedingChain(line, start-1)) {
+			items = append(items, memberItems(entity)...)
+		}
+	default:
+		for _, k := range keywords {
+			items = append(items, CompletionItem{Label: k, Kind: CompletionKindKeyword})
+		}
+		for _, entity := range d.entities() {
+			items = append(items, entityItem(entity))
+		}
+		if entity := d.enclosingEntity(pos.Line); entity != nil {
+			items = append(items, memberItems(entity)...)
+		}
+		for _, s := range d.schema.Statements {
+			if rule, ok := s.(*ast.RuleStatement); ok {
+				items = append(items, CompletionItem{Label: rule.Name.Literal, Kind: CompletionKindFunction, Detail: "rule"})
+			}
+		}
+	}
+
+	return items
+}
+
+// resolve returns the name and the declaration of the symbol at the position, if any.
+func (d *document) resolve(pos Position) (token.Token, ast.Statement) {
+	if d.schema == nil {
+		return token.Token{}, nil
+	}
+
+	line, start, word := d.wordAt(pos)
+	if word == "" {
+		return token.Token{}, nil
+	}
+
+	var separator byte
+	if start > 0 {
+		separator = line[start-1]
+	}
+
+	switch separator {
+	case '@':
+		if entity := d.entity(word); entity != nil {
+			return entity.Name, entity
+		}
+	case '#':
+		if name, s := member(d.entity(precedingWord(line, start-1)), word); s != nil {
+			return name, s
+		}
+	case '.':
+		for _, entity := range d.walkedChain(d.enclosingEntity(pos.Line), precedingChain(line, start-1)) {
+			if name, s := member(entity, word); s != nil {
+				return name, s
+			}
+		}
+	}
+
+	if name, s := member(d.enclosingEntity(pos.Line), word); s != nil {
+		return name, s
+	}
+	if entity := d.entity(word); entity != nil {
+		return entity.Name, entity
+	}
+	for _, s := range d.schema.Statements {
+		if rule, ok := s.(*ast.RuleStatement); ok && rule.Name.Literal == word {
+			return rule.Name, rule
+		}
+	}
+
+	return token.Token{}, nil
+}
+
+// line returns the line of the document, empty if it is out of range.
+func (d *document) line(n int) string {
+	if n < 0 || n >= len(d.lines) {
+		return ""
+	}
+	return strings.TrimSuffix(d.lines[n], "\r")
+}
+
+// wordAt returns the line of the position along with the start and the text of the identifier at the position.
+func (d *document) wordAt(pos Position) (string, int, string) {
+	line := d.line(pos.Line)
+	if pos.Character < 0 || pos.Character > len(line) {
+		return line, 0, ""
+	}
+
+	start, end := pos.Character, pos.Character
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+	for end < len(line) && isIdentChar(line[end]) {
+		end++
+	}
+	return line, start, line[start:end]
+}
+
+// entities returns the entities of the schema.
+func (d *document) entities() []*ast.EntityStatement {
+	var entities []*ast.EntityStatement
+	for _, s := range d.schema.Statements {
+		if entity, ok := s.(*ast.EntityStatement); ok {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// entity returns the entity with the name, nil if there is none.
+func (d *document) entity(name string) *ast.EntityStatement {
+	for _, entity := range d.entities() {
+		if entity.Name.Literal == name {
+			return entity
+		}
+	}
+	return nil
+}
+
+// enclosingEntity returns the entity whose declaration contains the zero-based line, nil if the line is outside
+// of entities. Declarations are assumed to run until the next one.
+func (d *document) enclosingEntity(line int) *ast.EntityStatement {
+	var enclosing *ast.EntityStatement
+	for _, s := range d.schema.Statements {
+		if toPosition(ast.Pos(s)).Line > line {
+			break
+		}
+		enclosing, _ = s.(*ast.EntityStatement)
+	}
+	return enclosing
+}
+
+// walked returns the entity types of the relation of the entity, the entities a relation walk such as
+// parent.admin reaches.
+func (d *document) walked(entity *ast.EntityStatement, relation string) []*ast.EntityStatement {
+	_, s := member(entity, relation)
+	rs, ok := s.(*ast.RelationStatement)
+	if !ok {
+		return nil
+	}
+
+	var entities []*ast.EntityStatement
+	for _, typ := range rs.RelationTypes {
+		if e := d.entity(typ.Type.Literal); e != nil {
+			entities = append(entities, e)
+		}
+	}
+	return entities
+}
+
+// walkedChain returns the entity types a chain of relations of the entity reaches, the entities a relation walk such
+// as folder.parent.org reaches through folder.parent.
+func (d *document) walkedChain(entity *ast.EntityStatement, chain []string) []*ast.EntityStatement {
+	entities := []*ast.EntityStatement{entity}
+	for _, relation := range chain {
+		var next []*ast.EntityStatement
+		seen := map[*ast.EntityStatement]bool{}
+		for _, e := range entities {
+			for _, w := range d.walked(e, relation) {
+				if !seen[w] {
+					seen[w] = true
+					next = append(next, w)
+				}
+			}
+		}
+		entities = next
+	}
+	return entities
+}
+
+// member returns the name and the declaration of the relation, attribute or permission of the entity.
+func member(entity *ast.EntityStatement, name string) (token.Token, ast.Statement) {
+	if entity == nil {
+		return token.Token{}, nil
+	}
+	for _, statements := range [][]ast.Statement{entity.RelationStatements, entity.AttributeStatements, entity.PermissionStatements} {
+		for _, s := range statements {
+			if n := memberName(s); n.Literal == name {
+				return n, s
+			}
+		}
+	}
+	return token.Token{}, nil
+}
+
+// memberName returns the name of a relation, attribute or permission.
+func memberName(s ast.Statement) token.Token {
+	switch n := s.(type) {
+	case *ast.RelationStatement:
+		return n.Name
+	case *ast.AttributeStatement:
+		return n.Name
+	case *ast.PermissionStatement:
+		return n.Name
+	}
+	return token.Token{}
+}
+
+// memberItems returns the completion items of the relations, attributes and permissions of the entity.
+func memberItems(entity *ast.EntityStatement) []CompletionItem {
+	var items []CompletionItem
+	for _, statements := range [][]ast.Statement{entity.RelationStatements, entity.AttributeStatements, entity.PermissionStatements} {
+		for _, s := range statements {
+			items = append(items, memberItem(s))
+		}
+	}
+	return items
+}
+
+// memberItem returns the completion item of a relation, attribute or permission.
+func memberItem(s ast.Statement) CompletionItem {
+	item := CompletionItem{Label: memberName(s).Literal, Detail: strings.TrimSpace(s.String())}
+	switch s.(type) {
+	case *ast.RelationStatement:
+		item.Kind = CompletionKindField
+	case *ast.AttributeStatement:
+		item.Kind = CompletionKindProperty
+	case *ast.PermissionStatement:
+		item.Kind = CompletionKindMethod
+	}
+	return item
+}
+
+// entityItem returns the completion item of an entity.
+func entityItem(entity *ast.EntityStatement) CompletionItem {
+	return CompletionItem{Label: entity.Name.Literal, Kind: CompletionKindClass, Detail: "entity"}
+}
+
+// precedingWord returns the identifier ending right before the index of the line.
+func precedingWord(line string, end int) string {
+	start := end
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+	return line[start:end]
+}
+
+// precedingChain returns the words joined by dots which end at the index of the line, in order, such as folder and
+// parent before .org.
+func precedingChain(line string, end int) []string {
+	var chain []string
+	for {
+		word := precedingWord(line, end)
+		chain = append([]string{word}, chain...)
+		end -= len(word)
+		if word == "" || end == 0 || line[end-1] != '.' {
+			return chain
+		}
+		end--
+	}
+}
+
+// isIdentChar reports whether the character can be part of an identifier.
+func isIdentChar(ch byte) bool {
+	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || '0' <= ch && ch <= '9' || ch == '_'
+}
+
+// toPosition converts a position of the lexer to a zero-based position of the protocol.
+func toPosition(p token.PositionInfo) Position {
+	pos := Position{Line: p.Line() - 1, Character: p.Column() - 1}
+	if pos.Line < 0 {
+		pos.Line = 0
+	}
+	if pos.Character < 0 {
+		pos.Character = 0
+	}
+	return pos
+}
+
+// tokenRange returns the range of the literal of the token.
+func tokenRange(t token.Token) Range {
+	return Range{Start: toPosition(t.PositionInfo), End: toPosition(t.End())}
+}
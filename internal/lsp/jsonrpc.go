@@ -0,0 +1,111 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+)
+
+// Error codes of JSON-RPC used by the server.
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// message - JSON-RPC 2.0 request, notification or response. Requests carry an id and a method, notifications
+// only a method and responses only an id.
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError - Error of a failed request
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// conn - Reads and writes messages framed with Content-Length headers, as the Language Server Protocol specifies
+type conn struct {
+	reader *textproto.Reader
+
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// newConn - Creates a connection reading messages from r and writing them to w
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{
+		reader: textproto.NewReader(bufio.NewReader(r)),
+		writer: w,
+	}
+}
+
+// read reads the next message.
+func (c *conn) read() (*message, error) {
+	header, err := c.reader.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid content length: %w", err)
+	}
+
+	body := make([]byte, length)
+	if _, err = io.ReadFull(c.reader.R, body); err != nil {
+		return nil, err
+	}
+
+	msg := &message{}
+	if err = json.Unmarshal(body, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// write writes the message. It is safe for concurrent use.
+func (c *conn) write(msg *message) error {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err = fmt.Fprintf(c.writer, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+	_, err = c.writer.Write(body)
+	return err
+}
+
+// reply writes the response to the request with the given id.
+func (c *conn) reply(id *json.RawMessage, result interface{}, rerr *responseError) error {
+	if rerr == nil && result == nil {
+		// A successful response must carry a result, which is null for requests without one.
+		result = json.RawMessage("null")
+	}
+	return c.write(&message{ID: id, Result: result, Error: rerr})
+}
+
+// notify writes a notification.
+func (c *conn) notify(method string, params interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&message{Method: method, Params: body})
+}
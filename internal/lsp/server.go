@@ -0,0 +1,162 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/pkg/dsl/ast"
+)
+
+// Server - Language server for schema files, speaking the Language Server Protocol over a reader and a writer.
+// It publishes the diagnostics of the compiler and answers hover, definition and completion requests.
+type Server struct {
+	conn      *conn
+	documents map[string]*document
+}
+
+// NewServer - Creates a new language server reading requests from r and writing responses to w
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		conn:      newConn(r, w),
+		documents: map[string]*document{},
+	}
+}
+
+// Run serves the messages of the client one at a time until it exits, the input ends or the context is done.
+func (s *Server) Run(ctx context.Context) error {
+	for ctx.Err() == nil {
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				if err = s.conn.reply(nil, nil, &responseError{Code: codeParseError, Message: err.Error()}); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		result, rerr := s.handle(msg)
+		if msg.ID == nil {
+			if rerr != nil {
+				slog.Error("failed to handle notification: ", slog.String("method", msg.Method), slog.String("error", rerr.Message))
+			}
+			continue
+		}
+		if err = s.conn.reply(msg.ID, result, rerr); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// handle dispatches the message to its method, returning the result of requests.
+func (s *Server) handle(msg *message) (interface{}, *responseError) {
+	switch msg.Method {
+	case "initialize":
+		return &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:   1,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				CompletionProvider: &CompletionOptions{TriggerCharacters: []string{"@", "#", "."}},
+			},
+			ServerInfo: ServerInfo{Name: "permify", Version: internal.Version},
+		}, nil
+	case "initialized", "shutdown":
+		return nil, nil
+	case "textDocument/didOpen":
+		params := &DidOpenTextDocumentParams{}
+		if rerr := unmarshalParams(msg, params); rerr != nil {
+			return nil, rerr
+		}
+		s.update(params.TextDocument.URI, params.TextDocument.Version, params.TextDocument.Text)
+		return nil, nil
+	case "textDocument/didChange":
+		params := &DidChangeTextDocumentParams{}
+		if rerr := unmarshalParams(msg, params); rerr != nil {
+			return nil, rerr
+		}
+		if len(params.ContentChanges) > 0 {
+			// Documents are synchronized in full, so the last change holds the whole text.
+			s.update(params.TextDocument.URI, params.TextDocument.Version, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		params := &DidCloseTextDocumentParams{}
+		if rerr := unmarshalParams(msg, params); rerr != nil {
+			return nil, rerr
+		}
+		delete(s.documents, params.TextDocument.URI)
+		s.publish(&PublishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+		return nil, nil
+	case "textDocument/hover", "textDocument/definition", "textDocument/completion":
+		params := &TextDocumentPositionParams{}
+		if rerr := unmarshalParams(msg, params); rerr != nil {
+			return nil, rerr
+		}
+		doc, ok := s.documents[params.TextDocument.URI]
+		if !ok {
+			return nil, &responseError{Code: codeInvalidParams, Message: "document is not open: " + params.TextDocument.URI}
+		}
+		switch msg.Method {
+		case "textDocument/hover":
+			if hover := doc.hover(params.Position); hover != nil {
+				return hover, nil
+			}
+		case "textDocument/definition":
+			if location := doc.definition(params.Position); location != nil {
+				return location, nil
+			}
+		default:
+			return doc.completion(params.Position), nil
+		}
+		return nil, nil
+	}
+
+	if msg.ID == nil {
+		// Notifications the server does not support, such as $/cancelRequest, are ignored.
+		return nil, nil
+	}
+	return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+}
+
+// update analyzes the new version of the document and publishes its diagnostics.
+func (s *Server) update(uri string, version int, text string) {
+	var previous *ast.Schema
+	if doc, ok := s.documents[uri]; ok {
+		previous = doc.schema
+	}
+
+	doc := newDocument(uri, version, text, previous)
+	s.documents[uri] = doc
+
+	s.publish(&PublishDiagnosticsParams{URI: uri, Version: version, Diagnostics: doc.diagnostics})
+}
+
+// publish sends the diagnostics of a document to the client.
+func (s *Server) publish(params *PublishDiagnosticsParams) {
+	if err := s.conn.notify("textDocument/publishDiagnostics", params); err != nil {
+		slog.Error("failed to publish diagnostics: ", slog.Any("error", err))
+	}
+}
+
+// unmarshalParams decodes the parameters of the message.
+func unmarshalParams(msg *message, params interface{}) *responseError {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestLSP -
+func TestLSP(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "lsp-suite")
+}
+
+const schema = `entity user {}
+
+entity organization {
+	relation admin @user
+	relation member @user
+}
+
+entity repository {
+	relation parent @organization
+	relation owner @user @organization#member
+	attribute public boolean
+
+	permission edit = owner or parent.admin
+}`
+
+// client - Drives a server over pipes, the way an editor does
+type client struct {
+	conn   *conn
+	done   chan error
+	nextID int
+}
+
+func newClient() *client {
+	serverIn, clientOut := io.Pipe()
+	clientIn, serverOut := io.Pipe()
+
+	c := &client{conn: newConn(clientIn, clientOut), done: make(chan error, 1)}
+	go func() {
+		c.done <- NewServer(serverIn, serverOut).Run(context.Background())
+		serverOut.Close()
+	}()
+	return c
+}
+
+// request sends a request and decodes the result of its response into result.
+func (c *client) request(method string, params, result interface{}) {
+	c.nextID++
+	id := json.RawMessage(fmtID(c.nextID))
+	body, err := json.Marshal(params)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(c.conn.write(&message{ID: &id, Method: method, Params: body})).Should(Succeed())
+
+	raw := c.response()
+	Expect(string(*raw.ID)).Should(Equal(string(id)))
+	Expect(raw.Error).Should(BeNil())
+	if result != nil {
+		encoded, err := json.Marshal(raw.Result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(json.Unmarshal(encoded, result)).Should(Succeed())
+	}
+}
+
+// notify sends a notification.
+func (c *client) notify(method string, params interface{}) {
+	body, err := json.Marshal(params)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(c.conn.write(&message{Method: method, Params: body})).Should(Succeed())
+}
+
+// response reads messages until a response, skipping notifications.
+func (c *client) response() *message {
+	for {
+		msg, err := c.conn.read()
+		Expect(err).ShouldNot(HaveOccurred())
+		if msg.ID != nil {
+			return msg
+		}
+	}
+}
+
+// diagnostics reads the next published diagnostics.
+func (c *client) diagnostics() PublishDiagnosticsParams {
+	msg, err := c.conn.read()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(msg.Method).Should(Equal("textDocument/publishDiagnostics"))
+
+	params := PublishDiagnosticsParams{}
+	Expect(json.Unmarshal(msg.Params, &params)).Should(Succeed())
+	return params
+}
+
+func fmtID(id int) string {
+	b, _ := json.Marshal(id)
+	return string(b)
+}
+
+func at(line, character int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: "file:///schema.perm"},
+		Position:     Position{Line: line, Character: character},
+	}
+}
+
+var _ = Describe("lsp", func() {
+	var c *client
+
+	BeforeEach(func() {
+		c = newClient()
+
+		result := InitializeResult{}
+		c.request("initialize", map[string]interface{}{}, &result)
+		Expect(result.Capabilities.HoverProvider).Should(BeTrue())
+		c.notify("initialized", map[string]interface{}{})
+
+		c.notify("textDocument/didOpen", DidOpenTextDocumentParams{TextDocument: TextDocumentItem{
+			URI: "file:///schema.perm", LanguageID: "perm", Version: 1, Text: schema,
+		}})
+		Expect(c.diagnostics().Diagnostics).Should(BeEmpty())
+	})
+
+	AfterEach(func() {
+		c.request("shutdown", nil, nil)
+		c.notify("exit", nil)
+		Eventually(c.done).Should(Receive(BeNil()))
+	})
+
+	It("Case 1 - Diagnostics of a change", func() {
+		c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+			TextDocument:   VersionedTextDocumentIdentifier{URI: "file:///schema.perm", Version: 2},
+			ContentChanges: []TextDocumentContentChangeEvent{{Text: "entity user {}\n\nentity repository {\n\tpermission edit = owner\n}"}},
+		})
+
+		params := c.diagnostics()
+		Expect(params.Version).Should(Equal(2))
+		Expect(params.Diagnostics).Should(Equal([]Diagnostic{{
+			Range:    Range{Start: Position{Line: 3, Character: 19}, End: Position{Line: 3, Character: 24}},
+			Severity: SeverityError,
+			Source:   "permify",
+			Message:  "undefined relation reference",
+		}}))
+	})
+
+	It("Case 2 - Hover", func() {
+		hover := &Hover{}
+		// owner in the permission of repository
+		c.request("textDocument/hover", at(12, 20), hover)
+		Expect(hover.Contents.Value).Should(Equal("```perm\nrelation owner @user @organization#member\n```"))
+		Expect(*hover.Range).Should(Equal(Range{Start: Position{Line: 9, Character: 10}, End: Position{Line: 9, Character: 15}}))
+	})
+
+	It("Case 3 - Definition across a relation walk", func() {
+		location := &Location{}
+		// admin in parent.admin
+		c.request("textDocument/definition", at(12, 36), location)
+		Expect(location.Range.Start).Should(Equal(Position{Line: 3, Character: 10}))
+
+		// member in @organization#member
+		c.request("textDocument/definition", at(9, 38), location)
+		Expect(location.Range.Start).Should(Equal(Position{Line: 4, Character: 10}))
+
+		// organization in @organization
+		c.request("textDocument/definition", at(8, 20), location)
+		Expect(location.Range.Start).Should(Equal(Position{Line: 2, Character: 7}))
+	})
+
+	It("Case 4 - Completion", func() {
+		labels := func(items []CompletionItem) []string {
+			var l []string
+			for _, item := range items {
+				l = append(l, item.Label)
+			}
+			return l
+		}
+
+		var items []CompletionItem
+		c.notify("textDocument/didChange", DidChangeTextDocumentParams{
+			TextDocument:   VersionedTextDocumentIdentifier{URI: "file:///schema.perm", Version: 2},
+			ContentChanges: []TextDocumentContentChangeEvent{{Text: schema + "\n// parent."}},
+		})
+		c.diagnostics()
+
+		c.request("textDocument/completion", at(14, 10), &items)
+		Expect(labels(items)).Should(Equal([]string{"admin", "member"}))
+
+		c.request("textDocument/completion", at(9, 36), &items)
+		Expect(labels(items)).Should(Equal([]string{"admin", "member"}))
+
+		c.request("textDocument/completion", at(12, 19), &items)
+		Expect(labels(items)).Should(ContainElements("and", "user", "parent", "public", "edit"))
+	})
+})
@@ -1,8 +1,6 @@
 package middleware
 
 import (
-	"context"
-
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 
 	"github.com/Permify/permify/internal/authn/preshared"
@@ -10,11 +8,5 @@ import (
 
 // KeyAuthFunc - Middleware that responsible for key authentication
 func KeyAuthFunc(authenticator preshared.KeyAuthenticator) grpcAuth.AuthFunc {
-	return func(ctx context.Context) (context.Context, error) {
-		err := authenticator.Authenticate(ctx)
-		if err != nil {
-			return nil, err
-		}
-		return ctx, nil
-	}
+	return preshared.NewProvider(authenticator).Authenticate
 }
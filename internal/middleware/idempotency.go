@@ -0,0 +1,202 @@
+package middleware
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/pkg/clock"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// IdempotencyReplayedHeader - Header set on the responses returned for the retries of a request, instead of applying
+// it again
+const IdempotencyReplayedHeader = "idempotency-replayed"
+
+// IdempotentRequest - Request that may carry an idempotency key
+type IdempotentRequest interface {
+	proto.Message
+	GetTenantId() string
+	GetIdempotencyKey() string
+}
+
+// idempotencyKey - Idempotency key of a request, scoped to its method and its tenant
+type idempotencyKey struct {
+	method string
+	tenant string
+	key    string
+}
+
+// idempotencyEntry - First attempt of a request carrying an idempotency key
+type idempotencyEntry struct {
+	key         idempotencyKey
+	fingerprint [sha256.Size]byte
+	created     time.Time
+	// done is closed once the first attempt completes, with its response or its error
+	done     chan struct{}
+	response interface{}
+	err      error
+}
+
+// Idempotency - Remembers the responses of the requests carrying an idempotency key for a while, so that the retries
+// of a request, common with clients delivering at least once, return the response of its first attempt instead of
+// applying it again. Retries arriving while the first attempt is in progress wait for it. Failed attempts are not
+// remembered, so that they can be retried. Keys are scoped to the method and the tenant of the request, and can't
+// be reused for a different request until they expire.
+type Idempotency struct {
+	maxKeys int
+	clock   clock.Clock
+
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[idempotencyKey]*list.Element
+	// order holds the entries from the oldest to the newest, the order they expire in
+	order *list.List
+}
+
+// NewIdempotency - Creates a new Idempotency remembering the responses for ttl, at most maxKeys of them, the oldest
+// being forgotten first
+func NewIdempotency(ttl time.Duration, maxKeys int) *Idempotency {
+	return &Idempotency{
+		ttl:     ttl,
+		maxKeys: maxKeys,
+		clock:   clock.New(),
+		entries: map[idempotencyKey]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// UnaryServerInterceptor - Returns an interceptor applying the requests that carry an idempotency key once
+func (i *Idempotency) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return idempotencyInterceptor(i.Do)
+}
+
+// idempotencyInterceptor - Returns an interceptor applying the requests that carry an idempotency key with do
+func idempotencyInterceptor(do func(ctx context.Context, method string, request IdempotentRequest, fn func(ctx context.Context) (interface{}, error)) (interface{}, bool, error)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		r, ok := req.(IdempotentRequest)
+		if !ok || r.GetIdempotencyKey() == "" {
+			return handler(ctx, req)
+		}
+
+		resp, replayed, err := do(ctx, info.FullMethod, r, func(ctx context.Context) (interface{}, error) {
+			return handler(ctx, req)
+		})
+		if replayed {
+			// The header can't be set on the transports without headers, such as those of tests
+			_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotencyReplayedHeader, "true"))
+		}
+		return resp, err
+	}
+}
+
+// Do - Applies the request of the method with fn, unless a request with the same idempotency key was applied
+// already, in which case its response is returned, along with whether it was
+func (i *Idempotency) Do(ctx context.Context, method string, request IdempotentRequest, fn func(ctx context.Context) (interface{}, error)) (response interface{}, replayed bool, err error) {
+	key := idempotencyKey{method: method, tenant: request.GetTenantId(), key: request.GetIdempotencyKey()}
+	digest, err := fingerprint(request)
+	if err != nil {
+		return nil, false, err
+	}
+
+	i.mu.Lock()
+	i.expire()
+	if element, ok := i.entries[key]; ok {
+		entry := element.Value.(*idempotencyEntry)
+		i.mu.Unlock()
+
+		if entry.fingerprint != digest {
+			return nil, false, status.Error(codes.InvalidArgument, base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String())
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, false, status.Error(codes.Canceled, base.ErrorCode_ERROR_CODE_CANCELLED.String())
+		}
+		if entry.err != nil {
+			return nil, false, entry.err
+		}
+		return proto.Clone(entry.response.(proto.Message)), true, nil
+	}
+
+	entry := &idempotencyEntry{
+		key:         key,
+		fingerprint: digest,
+		created:     i.clock.Now(),
+		done:        make(chan struct{}),
+	}
+	i.entries[key] = i.order.PushBack(entry)
+	for i.order.Len() > i.maxKeys {
+		i.remove(i.order.Front())
+	}
+	i.mu.Unlock()
+
+	entry.response, entry.err = fn(ctx)
+
+	i.mu.Lock()
+	if entry.err != nil {
+		if element, ok := i.entries[key]; ok && element.Value == entry {
+			i.remove(element)
+		}
+	}
+	close(entry.done)
+	i.mu.Unlock()
+
+	return entry.response, false, entry.err
+}
+
+// TTL - Returns how long the responses are remembered
+func (i *Idempotency) TTL() time.Duration {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.ttl
+}
+
+// SetTTL - Changes how long the responses are remembered, including the ones remembered already
+func (i *Idempotency) SetTTL(ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("idempotency ttl must be positive, got %s", ttl)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.ttl = ttl
+	return nil
+}
+
+// expire forgets the responses kept for longer than the ttl. It must be called with the lock held.
+func (i *Idempotency) expire() {
+	now := i.clock.Now()
+	for i.order.Len() > 0 {
+		front := i.order.Front()
+		if now.Sub(front.Value.(*idempotencyEntry).created) < i.ttl {
+			return
+		}
+		i.remove(front)
+	}
+}
+
+// remove forgets the entry of the element. Requests waiting for it still get its response. It must be called with
+// the lock held.
+func (i *Idempotency) remove(element *list.Element) {
+	delete(i.entries, element.Value.(*idempotencyEntry).key)
+	i.order.Remove(element)
+}
+
+// fingerprint returns the digest of the request, to tell its retries from other requests with the same key.
+func fingerprint(request proto.Message) ([sha256.Size]byte, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// idempotencyLease - How long the first attempt of a request holds its key before completing. A replica stopping
+	// while applying a request releases its key after the lease, so that the retries of the request can be applied.
+	idempotencyLease = time.Minute
+	// idempotencyPollInterval - How often the retries of a request check whether its first attempt completed
+	idempotencyPollInterval = 50 * time.Millisecond
+)
+
+// redisIdempotencyRecord - First attempt of a request carrying an idempotency key, as kept in Redis. The response is
+// empty while the attempt is in progress.
+type redisIdempotencyRecord struct {
+	Fingerprint []byte `json:"fingerprint"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+// RedisIdempotency - Remembers the responses of the requests carrying an idempotency key in Redis, like Idempotency
+// does in memory, so that the retries of a request are recognized by all the replicas sharing the key prefix and not
+// only by the replica of its first attempt. Retries arriving while the first attempt is in progress on any replica
+// wait for it. Requests fail with codes.Unavailable while Redis can't be reached, instead of risking being applied
+// twice.
+type RedisIdempotency struct {
+	client redis.UniversalClient
+	prefix string
+
+	mu  sync.RWMutex
+	ttl time.Duration
+}
+
+// NewRedisIdempotency - Creates a new RedisIdempotency remembering the responses for ttl, at the keys of Redis
+// starting with the prefix
+func NewRedisIdempotency(client redis.UniversalClient, prefix string, ttl time.Duration) *RedisIdempotency {
+	return &RedisIdempotency{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+// UnaryServerInterceptor - Returns an interceptor applying the requests that carry an idempotency key once
+func (i *RedisIdempotency) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return idempotencyInterceptor(i.Do)
+}
+
+// Do - Applies the request of the method with fn, unless a request with the same idempotency key was applied
+// already by any of the replicas, in which case its response is returned, along with whether it was
+func (i *RedisIdempotency) Do(ctx context.Context, method string, request IdempotentRequest, fn func(ctx context.Context) (interface{}, error)) (response interface{}, replayed bool, err error) {
+	digest, err := fingerprint(request)
+	if err != nil {
+		return nil, false, err
+	}
+	key := i.key(method, request)
+	ttl := i.TTL()
+
+	pending, err := json.Marshal(redisIdempotencyRecord{Fingerprint: digest[:]})
+	if err != nil {
+		return nil, false, err
+	}
+
+	for {
+		first, err := i.client.SetNX(ctx, key, pending, min(ttl, idempotencyLease)).Result()
+		if err != nil {
+			return nil, false, unavailable(err)
+		}
+		if first {
+			return i.apply(ctx, key, digest[:], ttl, fn)
+		}
+
+		value, err := i.client.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			// The first attempt failed, or its lease expired, since the key was taken
+			continue
+		}
+		if err != nil {
+			return nil, false, unavailable(err)
+		}
+
+		var record redisIdempotencyRecord
+		if err = json.Unmarshal(value, &record); err != nil {
+			return nil, false, err
+		}
+		if !bytes.Equal(record.Fingerprint, digest[:]) {
+			return nil, false, status.Error(codes.InvalidArgument, base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String())
+		}
+
+		if len(record.Response) > 0 {
+			a := &anypb.Any{}
+			if err = proto.Unmarshal(record.Response, a); err != nil {
+				return nil, false, err
+			}
+			response, err := a.UnmarshalNew()
+			if err != nil {
+				return nil, false, err
+			}
+			return response, true, nil
+		}
+
+		select {
+		case <-time.After(idempotencyPollInterval):
+		case <-ctx.Done():
+			return nil, false, status.Error(codes.Canceled, base.ErrorCode_ERROR_CODE_CANCELLED.String())
+		}
+	}
+}
+
+// TTL - Returns how long the responses are remembered
+func (i *RedisIdempotency) TTL() time.Duration {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.ttl
+}
+
+// SetTTL - Changes how long the responses applied from now on are remembered. Like the rate limit, it must be
+// changed on all the replicas.
+func (i *RedisIdempotency) SetTTL(ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("idempotency ttl must be positive, got %s", ttl)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.ttl = ttl
+	return nil
+}
+
+// apply applies the first attempt of the request holding the key, keeping its response for ttl once it succeeds.
+// Failed attempts release the key, so that they can be retried.
+func (i *RedisIdempotency) apply(ctx context.Context, key string, digest []byte, ttl time.Duration, fn func(ctx context.Context) (interface{}, error)) (interface{}, bool, error) {
+	response, err := fn(ctx)
+
+	// The key is written even if the request was cancelled meanwhile, its retries still need it
+	wctx := context.WithoutCancel(ctx)
+	if err != nil {
+		if derr := i.client.Del(wctx, key).Err(); derr != nil {
+			slog.Warn("failed to release the idempotency key of a failed request", slog.Any("error", derr))
+		}
+		return nil, false, err
+	}
+
+	a, err := anypb.New(response.(proto.Message))
+	if err != nil {
+		return nil, false, err
+	}
+	b, err := proto.Marshal(a)
+	if err != nil {
+		return nil, false, err
+	}
+	value, err := json.Marshal(redisIdempotencyRecord{Fingerprint: digest, Response: b})
+	if err != nil {
+		return nil, false, err
+	}
+	// The request is applied already, so failing to remember its response doesn't fail it
+	if err = i.client.Set(wctx, key, value, ttl).Err(); err != nil {
+		slog.Warn("failed to remember the response of an idempotent request", slog.Any("error", err))
+	}
+	return response, false, nil
+}
+
+// key returns the key of Redis the request of the method is kept at. The method, the tenant and the idempotency key
+// are hashed, so that none of them can be mistaken for the others.
+func (i *RedisIdempotency) key(method string, request IdempotentRequest) string {
+	h := sha256.New()
+	for _, part := range []string{method, request.GetTenantId(), request.GetIdempotencyKey()} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return i.prefix + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// unavailable returns the error of the requests of which the idempotency key can't be checked.
+func unavailable(err error) error {
+	return status.Error(codes.Unavailable, fmt.Sprintf("failed to check the idempotency key: %s", err.Error()))
+}
@@ -0,0 +1,237 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/pkg/clock"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("idempotency", func() {
+	const write = "/base.v1.Data/Write"
+
+	var now *clock.Mock
+	var idempotency *Idempotency
+	var interceptor grpc.UnaryServerInterceptor
+	var applied int
+
+	// handler applies the writes, returning the number of writes applied so far as the snap token
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		applied++
+		return &base.DataWriteResponse{SnapToken: strconv.Itoa(applied)}, nil
+	}
+	request := func(tenantID, key, id string) *base.DataWriteRequest {
+		return &base.DataWriteRequest{
+			TenantId:       tenantID,
+			IdempotencyKey: key,
+			Metadata:       &base.DataWriteRequestMetadata{},
+			Tuples: []*base.Tuple{{
+				Entity:   &base.Entity{Type: "doc", Id: id},
+				Relation: "viewer",
+				Subject:  &base.Subject{Type: "user", Id: "1"},
+			}},
+		}
+	}
+	send := func(req *base.DataWriteRequest) (string, error) {
+		resp, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: write}, handler)
+		if err != nil {
+			return "", err
+		}
+		return resp.(*base.DataWriteResponse).GetSnapToken(), nil
+	}
+
+	BeforeEach(func() {
+		applied = 0
+		idempotency = NewIdempotency(time.Hour, 2)
+		now = clock.NewMock(time.Unix(1_700_000_000, 0))
+		idempotency.clock = now
+		interceptor = idempotency.UnaryServerInterceptor()
+	})
+
+	It("Case 1 - Retries return the response of the first attempt", func() {
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+
+		// Requests without a key, and the keys of other tenants, are applied
+		Expect(send(request("t1", "", "1"))).Should(Equal("2"))
+		Expect(send(request("t2", "k1", "1"))).Should(Equal("3"))
+		Expect(applied).Should(Equal(3))
+	})
+
+	It("Case 2 - Keys can't be reused for a different request", func() {
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+
+		_, err := send(request("t1", "k1", "2"))
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		Expect(status.Convert(err).Message()).Should(Equal(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String()))
+		Expect(applied).Should(Equal(1))
+	})
+
+	It("Case 3 - Keys are forgotten once expired, and the oldest ones beyond the maximum", func() {
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+
+		now.Add(time.Hour)
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("2"))
+
+		Expect(send(request("t1", "k2", "1"))).Should(Equal("3"))
+		Expect(send(request("t1", "k3", "1"))).Should(Equal("4"))
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("5"))
+		Expect(send(request("t1", "k3", "1"))).Should(Equal("4"))
+	})
+
+	It("Case 4 - Failed attempts are not remembered", func() {
+		failing := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_INTERNAL.String())
+		}
+		_, err := interceptor(context.Background(), request("t1", "k1", "1"), &grpc.UnaryServerInfo{FullMethod: write}, failing)
+		Expect(err).Should(HaveOccurred())
+
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+	})
+
+	It("Case 5 - Retries wait for the first attempt in progress", func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		slow := func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return handler(ctx, req)
+		}
+
+		first := make(chan string)
+		go func() {
+			defer GinkgoRecover()
+			resp, err := interceptor(context.Background(), request("t1", "k1", "1"), &grpc.UnaryServerInfo{FullMethod: write}, slow)
+			Expect(err).ShouldNot(HaveOccurred())
+			first <- resp.(*base.DataWriteResponse).GetSnapToken()
+		}()
+		<-started
+
+		retry := make(chan string)
+		go func() {
+			defer GinkgoRecover()
+			token, err := send(request("t1", "k1", "1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			retry <- token
+		}()
+		Consistently(retry, 50*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+		Expect(<-first).Should(Equal("1"))
+		Expect(<-retry).Should(Equal("1"))
+		Expect(applied).Should(Equal(1))
+	})
+
+	It("Case 6 - Changing the ttl applies to the responses remembered already", func() {
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("1"))
+
+		now.Add(30 * time.Minute)
+		Expect(idempotency.SetTTL(30 * time.Minute)).Should(Succeed())
+		Expect(send(request("t1", "k1", "1"))).Should(Equal("2"))
+
+		Expect(idempotency.SetTTL(0)).ShouldNot(Succeed())
+		Expect(idempotency.TTL()).Should(Equal(30 * time.Minute))
+	})
+
+	Describe("shared through redis", func() {
+		var server *miniredis.Miniredis
+		var client *redis.Client
+		var replicas []grpc.UnaryServerInterceptor
+
+		// sendTo sends the request to the replica
+		sendTo := func(replica int, req *base.DataWriteRequest) (string, error) {
+			resp, err := replicas[replica](context.Background(), req, &grpc.UnaryServerInfo{FullMethod: write}, handler)
+			if err != nil {
+				return "", err
+			}
+			return resp.(*base.DataWriteResponse).GetSnapToken(), nil
+		}
+
+		BeforeEach(func() {
+			server = miniredis.NewMiniRedis()
+			Expect(server.Start()).Should(Succeed())
+			client = redis.NewClient(&redis.Options{Addr: server.Addr()})
+			replicas = []grpc.UnaryServerInterceptor{
+				NewRedisIdempotency(client, "permify:idempotency", time.Hour).UnaryServerInterceptor(),
+				NewRedisIdempotency(client, "permify:idempotency", time.Hour).UnaryServerInterceptor(),
+			}
+		})
+
+		AfterEach(func() {
+			Expect(client.Close()).Should(Succeed())
+			server.Close()
+		})
+
+		It("Case 1 - Retries reaching another replica return the response of the first attempt", func() {
+			Expect(sendTo(0, request("t1", "k1", "1"))).Should(Equal("1"))
+			Expect(sendTo(1, request("t1", "k1", "1"))).Should(Equal("1"))
+			Expect(sendTo(1, request("t2", "k1", "1"))).Should(Equal("2"))
+			Expect(applied).Should(Equal(2))
+
+			_, err := sendTo(1, request("t1", "k1", "2"))
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+			// The responses expire with the ttl
+			server.FastForward(time.Hour)
+			Expect(sendTo(1, request("t1", "k1", "1"))).Should(Equal("3"))
+		})
+
+		It("Case 2 - Failed attempts are not remembered", func() {
+			failing := func(context.Context, interface{}) (interface{}, error) {
+				return nil, errors.New("failed")
+			}
+			_, err := replicas[0](context.Background(), request("t1", "k1", "1"), &grpc.UnaryServerInfo{FullMethod: write}, failing)
+			Expect(err).Should(HaveOccurred())
+
+			Expect(sendTo(1, request("t1", "k1", "1"))).Should(Equal("1"))
+		})
+
+		It("Case 3 - Retries wait for the first attempt in progress on another replica", func() {
+			started, release := make(chan struct{}), make(chan struct{})
+			slow := func(ctx context.Context, req interface{}) (interface{}, error) {
+				close(started)
+				<-release
+				return handler(ctx, req)
+			}
+
+			go func() {
+				defer GinkgoRecover()
+				_, err := replicas[0](context.Background(), request("t1", "k1", "1"), &grpc.UnaryServerInfo{FullMethod: write}, slow)
+				Expect(err).ShouldNot(HaveOccurred())
+			}()
+			<-started
+
+			retry := make(chan string)
+			go func() {
+				defer GinkgoRecover()
+				token, err := sendTo(1, request("t1", "k1", "1"))
+				Expect(err).ShouldNot(HaveOccurred())
+				retry <- token
+			}()
+			Consistently(retry, 100*time.Millisecond).ShouldNot(Receive())
+
+			close(release)
+			Eventually(retry).Should(Receive(Equal("1")))
+			Expect(applied).Should(Equal(1))
+		})
+
+		It("Case 4 - Requests fail while redis can't be reached", func() {
+			server.Close()
+
+			_, err := sendTo(0, request("t1", "k1", "1"))
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+			Expect(applied).Should(Equal(0))
+		})
+	})
+})
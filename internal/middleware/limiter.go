@@ -2,44 +2,118 @@ package middleware
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 
 	"github.com/juju/ratelimit"
 )
 
-// RateLimiter struct is a wrapper around the juju Bucket struct
+// RateLimiter struct is a wrapper around the juju Bucket struct. Requests take as many tokens from the bucket as
+// the weight of their method, so expensive methods use up more of the rate limit than cheap ones.
 type RateLimiter struct {
-	bucket *ratelimit.Bucket // bucket is the token bucket that forms the core of the rate limiter
+	mu        sync.Mutex        // mu makes checking and taking the tokens of a request atomic
+	reqPerSec int64             // reqPerSec is the rate and the capacity of the bucket
+	bucket    *ratelimit.Bucket // bucket is the token bucket that forms the core of the rate limiter
+	weights   methodWeights     // weights are the tokens taken by requests by full gRPC method
+	raw       map[string]int64  // raw are the weights as configured, before being capped by the rate
 }
 
 // NewRateLimiter is a constructor function for RateLimiter.
-// It creates a new RateLimiter that allows reqPerSec requests per second.
-func NewRateLimiter(reqPerSec int64) *RateLimiter {
+// It creates a new RateLimiter that allows reqPerSec tokens per second, taken by requests by the weights of their
+// full gRPC method, e.g. /base.v1.Permission/LookupEntity. Weights are capped at reqPerSec so every method can
+// pass when the bucket is full.
+func NewRateLimiter(reqPerSec int64, weights map[string]int64) *RateLimiter {
+	l := &RateLimiter{raw: weights}
+	l.reset(reqPerSec)
+	return l
+}
+
+// Rate returns the tokens per second the rate limiter allows.
+func (l *RateLimiter) Rate() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.reqPerSec
+}
+
+// SetRate changes the tokens per second the rate limiter allows, starting with a full bucket of the new rate.
+func (l *RateLimiter) SetRate(reqPerSec int64) error {
+	if reqPerSec <= 0 {
+		return fmt.Errorf("rate limit must be positive, got %d", reqPerSec)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reset(reqPerSec)
+	return nil
+}
+
+// SetWeights replaces the tokens taken by requests by full gRPC method, capped at the rate.
+func (l *RateLimiter) SetWeights(weights map[string]int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.raw = weights
+	l.weights = newMethodWeights(weights, l.reqPerSec)
+}
+
+// reset replaces the bucket with a full one of the rate, capping the weights at it.
+func (l *RateLimiter) reset(reqPerSec int64) {
 	// fillInterval is the amount of time between adding new tokens to the bucket.
 	// We want to add a new token reqPerSec times per second, so fillInterval is the inverse of reqPerSec.
 	fillInterval := time.Second / time.Duration(reqPerSec)
 
 	// Create a new token bucket with a rate of reqPerSec tokens per second and a capacity of reqPerSec.
-	bucket := ratelimit.NewBucket(fillInterval, reqPerSec)
-
-	return &RateLimiter{
-		bucket: bucket,
-	}
+	l.bucket = ratelimit.NewBucket(fillInterval, reqPerSec)
+	l.reqPerSec = reqPerSec
+	l.weights = newMethodWeights(l.raw, reqPerSec)
 }
 
 // Limit checks if a request should be allowed based on the current state of the bucket.
-// If no tokens are available (i.e., if TakeAvailable(1) returns 0), it means the rate limit has been hit,
-// so it returns true. If a token is available, it returns false, meaning the request can proceed.
-func (l *RateLimiter) Limit(_ context.Context) error {
-	tokenRes := l.bucket.TakeAvailable(1)
+// The request takes the weight of its method from the bucket. If fewer tokens are available, the rate limit has
+// been hit and it returns an error, without taking any. Otherwise, it returns nil, meaning the request can proceed.
+func (l *RateLimiter) Limit(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	weight := l.weights.of(ctx)
 
 	// When rate limit reached, return specific error for the clients.
-	if tokenRes == 0 {
+	if l.bucket.Available() < weight {
 		return fmt.Errorf("reached Rate-Limiting %d", l.bucket.Available())
 	}
 
+	l.bucket.TakeAvailable(weight)
+
 	// Rate limit isn't reached.
 	return nil
 }
+
+// methodWeights are the tokens taken by requests by full gRPC method, 1 if not set.
+type methodWeights map[string]int64
+
+// newMethodWeights creates the method weights, capped between 1 and the capacity of the bucket.
+func newMethodWeights(weights map[string]int64, capacity int64) methodWeights {
+	w := make(methodWeights, len(weights))
+	for method, weight := range weights {
+		switch {
+		case weight < 1:
+			w[method] = 1
+		case weight > capacity:
+			w[method] = capacity
+		default:
+			w[method] = weight
+		}
+	}
+	return w
+}
+
+// of returns the weight of the method of the request in the context.
+func (w methodWeights) of(ctx context.Context) int64 {
+	if method, ok := grpc.Method(ctx); ok {
+		if weight, ok := w[method]; ok {
+			return weight
+		}
+	}
+	return 1
+}
@@ -0,0 +1,156 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/net/context"
+)
+
+// takeScript takes the weight of a request from the token bucket stored at the key, refilling it by the time
+// passed since the last request, and returns whether the request is allowed along with the tokens left. Redis
+// runs scripts atomically, so the bucket is consistent across the replicas sharing it.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local weight = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = rate
+	ts = now
+end
+
+tokens = math.min(rate, tokens + (now - ts) * rate / 1000000)
+
+local allowed = 0
+if tokens >= weight then
+	tokens = tokens - weight
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], 2000)
+
+return {allowed, math.floor(tokens)}
+`)
+
+// warnInterval is the least time between the warnings logged while Redis can't be reached.
+const warnInterval = 10 * time.Second
+
+// RedisRateLimiter is a rate limiter of which the token bucket is stored in Redis, so the rate limit is shared by
+// all the replicas using the same key instead of being granted to each of them. Requests take as many tokens as
+// the weight of their method, like with RateLimiter. When Redis can't be reached, requests are limited by a local
+// bucket of an even share of the rate among the replicas instead of failing.
+type RedisRateLimiter struct {
+	client    redis.UniversalClient // client is the client of the Redis server the bucket is stored in
+	key       string                // key is the key the bucket is stored at
+	mu        sync.RWMutex          // mu guards the rate and the weights, which can be changed at runtime
+	reqPerSec int64                 // reqPerSec is the rate and the capacity of the bucket
+	weights   methodWeights         // weights are the tokens taken by requests by full gRPC method
+	raw       map[string]int64      // raw are the weights as configured, before being capped by the rate
+	replicas  int64                 // replicas is the number of replicas sharing the key
+	fallback  *RateLimiter          // fallback limits requests to the share of the replica while Redis can't be reached
+	warned    atomic.Int64          // warned is when Redis was last warned about being unreachable, in Unix nanoseconds
+	limited   atomic.Int64          // limited is the number of requests limited locally since the last warning
+}
+
+// NewRedisRateLimiter is a constructor function for RedisRateLimiter.
+// It creates a new RedisRateLimiter that allows reqPerSec tokens per second across the replicas sharing the key,
+// taken by requests by the weights of their full gRPC method.
+func NewRedisRateLimiter(client redis.UniversalClient, key string, reqPerSec, replicas int64, weights map[string]int64) *RedisRateLimiter {
+	if replicas < 1 {
+		replicas = 1
+	}
+	return &RedisRateLimiter{
+		client:    client,
+		key:       key,
+		reqPerSec: reqPerSec,
+		weights:   newMethodWeights(weights, reqPerSec),
+		raw:       weights,
+		replicas:  replicas,
+		fallback:  NewRateLimiter(share(reqPerSec, replicas), weights),
+	}
+}
+
+// share returns the tokens per second each of the replicas is allowed when they limit their requests alone, at least
+// one.
+func share(reqPerSec, replicas int64) int64 {
+	return max(reqPerSec/replicas, 1)
+}
+
+// Rate returns the tokens per second the replicas sharing the key are allowed.
+func (l *RedisRateLimiter) Rate() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.reqPerSec
+}
+
+// SetRate changes the tokens per second the replicas sharing the key are allowed. The replicas take the tokens of
+// the shared bucket at the rate they are each given, so it must be changed on all of them.
+func (l *RedisRateLimiter) SetRate(reqPerSec int64) error {
+	if reqPerSec <= 0 {
+		return fmt.Errorf("rate limit must be positive, got %d", reqPerSec)
+	}
+	if err := l.fallback.SetRate(share(reqPerSec, l.replicas)); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reqPerSec = reqPerSec
+	l.weights = newMethodWeights(l.raw, reqPerSec)
+	return nil
+}
+
+// SetWeights replaces the tokens taken by requests by full gRPC method, capped at the rate.
+func (l *RedisRateLimiter) SetWeights(weights map[string]int64) {
+	l.fallback.SetWeights(weights)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.raw = weights
+	l.weights = newMethodWeights(weights, l.reqPerSec)
+}
+
+// Limit checks if a request should be allowed based on the current state of the shared bucket, taking the weight
+// of its method from it if so.
+func (l *RedisRateLimiter) Limit(ctx context.Context) error {
+	l.mu.RLock()
+	reqPerSec, weight := l.reqPerSec, l.weights.of(ctx)
+	l.mu.RUnlock()
+
+	res, err := takeScript.Run(ctx, l.client, []string{l.key}, reqPerSec, weight).Int64Slice()
+	if err != nil || len(res) != 2 {
+		l.warn(err)
+		return l.fallback.Limit(ctx)
+	}
+
+	// When rate limit reached, return specific error for the clients.
+	if res[0] == 0 {
+		return fmt.Errorf("reached Rate-Limiting %d", res[1])
+	}
+
+	// Rate limit isn't reached.
+	return nil
+}
+
+// warn logs that requests are limited locally since Redis can't be reached, at most once every warnInterval so that
+// an outage doesn't log every request.
+func (l *RedisRateLimiter) warn(err error) {
+	l.limited.Add(1)
+
+	now := time.Now().UnixNano()
+	last := l.warned.Load()
+	if now-last < int64(warnInterval) || !l.warned.CompareAndSwap(last, now) {
+		return
+	}
+
+	slog.Warn("failed to take tokens from the shared rate limit, limiting locally", slog.Any("error", err), slog.Int64("requests", l.limited.Swap(0)), slog.Int64("rate", l.fallback.Rate()))
+}
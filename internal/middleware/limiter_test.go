@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+)
+
+// methodStream is a server transport stream of which only the method is used.
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s methodStream) Method() string {
+	return s.method
+}
+
+var _ = Describe("limiter", func() {
+	const (
+		check  = "/base.v1.Permission/Check"
+		lookup = "/base.v1.Permission/LookupEntity"
+	)
+
+	withMethod := func(method string) context.Context {
+		return grpc.NewContextWithServerTransportStream(context.Background(), methodStream{method: method})
+	}
+
+	It("takes the weight of the method from the bucket", func() {
+		limiter := NewRateLimiter(10, map[string]int64{lookup: 4})
+
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(lookup))).ShouldNot(Succeed())
+
+		Expect(limiter.Limit(withMethod(check))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(check))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(check))).ShouldNot(Succeed())
+	})
+
+	It("caps weights at the capacity of the bucket", func() {
+		limiter := NewRateLimiter(10, map[string]int64{lookup: 100})
+
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(check))).ShouldNot(Succeed())
+	})
+
+	It("takes a token for requests without a method", func() {
+		limiter := NewRateLimiter(1, nil)
+
+		Expect(limiter.Limit(context.Background())).Should(Succeed())
+		Expect(limiter.Limit(context.Background())).ShouldNot(Succeed())
+	})
+
+	It("changes the rate at runtime, capping the weights at the new one", func() {
+		limiter := NewRateLimiter(2, map[string]int64{lookup: 4})
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(lookup))).ShouldNot(Succeed())
+
+		Expect(limiter.SetRate(8)).Should(Succeed())
+		Expect(limiter.Rate()).Should(Equal(int64(8)))
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(lookup))).ShouldNot(Succeed())
+
+		Expect(limiter.SetRate(0)).ShouldNot(Succeed())
+	})
+
+	It("changes the weights at runtime", func() {
+		limiter := NewRateLimiter(4, nil)
+		limiter.SetWeights(map[string]int64{lookup: 4})
+
+		Expect(limiter.Limit(withMethod(lookup))).Should(Succeed())
+		Expect(limiter.Limit(withMethod(check))).ShouldNot(Succeed())
+	})
+})
+
+var _ = Describe("redis limiter", func() {
+	const lookup = "/base.v1.Permission/LookupEntity"
+
+	var server *miniredis.Miniredis
+	var client *redis.Client
+
+	BeforeEach(func() {
+		server = miniredis.NewMiniRedis()
+		Expect(server.Start()).Should(Succeed())
+		client = redis.NewClient(&redis.Options{Addr: server.Addr()})
+	})
+
+	AfterEach(func() {
+		Expect(client.Close()).Should(Succeed())
+		server.Close()
+	})
+
+	It("shares the bucket across limiters of the same key", func() {
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), methodStream{method: lookup})
+
+		first := NewRedisRateLimiter(client, "permify:rate_limit", 10, 1, map[string]int64{lookup: 4})
+		second := NewRedisRateLimiter(client, "permify:rate_limit", 10, 1, map[string]int64{lookup: 4})
+
+		Expect(first.Limit(ctx)).Should(Succeed())
+		Expect(second.Limit(ctx)).Should(Succeed())
+		Expect(first.Limit(ctx)).ShouldNot(Succeed())
+		Expect(second.Limit(context.Background())).Should(Succeed())
+
+		other := NewRedisRateLimiter(client, "permify:other", 10, 1, nil)
+		Expect(other.Limit(ctx)).Should(Succeed())
+	})
+
+	It("limits locally to the share of the replica when redis can't be reached", func() {
+		var logs bytes.Buffer
+		DeferCleanup(slog.SetDefault, slog.Default())
+		slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+
+		limiter := NewRedisRateLimiter(client, "permify:rate_limit", 4, 2, nil)
+		server.Close()
+
+		Expect(limiter.Limit(context.Background())).Should(Succeed())
+		Expect(limiter.Limit(context.Background())).Should(Succeed())
+		Expect(limiter.Limit(context.Background())).ShouldNot(Succeed())
+
+		// The outage is only warned about once
+		Expect(strings.Count(logs.String(), "limiting locally")).Should(Equal(1))
+
+		// The share follows the rate
+		Expect(limiter.SetRate(1)).Should(Succeed())
+		Expect(limiter.Limit(context.Background())).Should(Succeed())
+		Expect(limiter.Limit(context.Background())).ShouldNot(Succeed())
+	})
+})
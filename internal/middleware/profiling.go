@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"runtime/pprof"
+
+	"google.golang.org/grpc"
+)
+
+// ProfileLabels - Labels the goroutines serving the requests with their RPC method and their tenant, so that the
+// samples of the profiles of the node, pulled from the pprof endpoints or pushed continuously, can be attributed to
+// the workloads they were taken in. The goroutines the engines start for a request inherit its labels.
+type ProfileLabels struct{}
+
+// NewProfileLabels - Creates the interceptors labelling the goroutines of the requests
+func NewProfileLabels() *ProfileLabels {
+	return &ProfileLabels{}
+}
+
+// UnaryServerInterceptor - Returns an interceptor labelling the goroutine of the unary requests
+func (p *ProfileLabels) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		pprof.Do(ctx, profileLabels(info.FullMethod, req), func(ctx context.Context) {
+			resp, err = handler(ctx, req)
+		})
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor - Returns an interceptor labelling the goroutine of the streams, with the tenant of their
+// first message
+func (p *ProfileLabels) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		pprof.Do(stream.Context(), pprof.Labels("method", info.FullMethod), func(ctx context.Context) {
+			err = handler(srv, &profiledStream{ServerStream: stream, ctx: ctx, method: info.FullMethod})
+		})
+		return err
+	}
+}
+
+// profiledStream - Stream labelling its goroutine with the tenant of its first message
+type profiledStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	method   string
+	labelled bool
+}
+
+// Context returns the context of the stream, carrying the labels of its goroutine.
+func (s *profiledStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives the message, labelling the goroutine with its tenant if it is the first.
+func (s *profiledStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.labelled {
+		s.labelled = true
+		s.ctx = pprof.WithLabels(s.ctx, profileLabels(s.method, m))
+		pprof.SetGoroutineLabels(s.ctx)
+	}
+	return nil
+}
+
+// profileLabels returns the labels of the request of the method, with its tenant if it has one.
+func profileLabels(method string, req interface{}) pprof.LabelSet {
+	if r, ok := req.(interface{ GetTenantId() string }); ok && r.GetTenantId() != "" {
+		return pprof.Labels("method", method, "tenant_id", r.GetTenantId())
+	}
+	return pprof.Labels("method", method)
+}
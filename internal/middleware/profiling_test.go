@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"runtime/pprof"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// recvStream - Server stream receiving a single request
+type recvStream struct {
+	grpc.ServerStream
+	request *base.PermissionLookupEntityRequest
+}
+
+func (s *recvStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	m.(*base.PermissionLookupEntityRequest).TenantId = s.request.GetTenantId()
+	return nil
+}
+
+var _ = Describe("profile labels", func() {
+	labels := NewProfileLabels()
+
+	label := func(ctx context.Context, key string) string {
+		value, _ := pprof.Label(ctx, key)
+		return value
+	}
+
+	It("should label the unary requests with their method and tenant", func() {
+		info := &grpc.UnaryServerInfo{FullMethod: "/base.v1.Permission/Check"}
+		_, err := labels.UnaryServerInterceptor()(context.Background(), &base.PermissionCheckRequest{TenantId: "t1"}, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			Expect(label(ctx, "method")).To(Equal("/base.v1.Permission/Check"))
+			Expect(label(ctx, "tenant_id")).To(Equal("t1"))
+			return nil, nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should label the streams with the tenant of their first message", func() {
+		info := &grpc.StreamServerInfo{FullMethod: "/base.v1.Permission/LookupEntityStream"}
+		stream := &recvStream{request: &base.PermissionLookupEntityRequest{TenantId: "t1"}}
+		err := labels.StreamServerInterceptor()(nil, stream, info, func(_ interface{}, stream grpc.ServerStream) error {
+			Expect(label(stream.Context(), "method")).To(Equal("/base.v1.Permission/LookupEntityStream"))
+			Expect(label(stream.Context(), "tenant_id")).To(BeEmpty())
+
+			Expect(stream.RecvMsg(&base.PermissionLookupEntityRequest{})).To(Succeed())
+			Expect(label(stream.Context(), "tenant_id")).To(Equal("t1"))
+			return nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,258 @@
+package middleware
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/pkg/clock"
+)
+
+// AnyMethod - Method of an objective that applies to the methods without an objective of their own
+const AnyMethod = "*"
+
+// Objective - Service level objective of a gRPC method
+type Objective struct {
+	// Method is the full gRPC method, e.g. /base.v1.Permission/Check, or AnyMethod.
+	Method string
+	// Availability is the share of requests that must not fail, e.g. 0.999.
+	Availability float64
+	// Latency is the threshold requests must be faster than.
+	Latency time.Duration
+	// LatencyTarget is the share of requests that must be faster than the threshold, e.g. 0.99.
+	LatencyTarget float64
+}
+
+// sloWindows are the windows burn rates are computed over, those of the usual multi-window alerts.
+var sloWindows = []struct {
+	name    string
+	minutes int64
+}{
+	{"5m", 5},
+	{"30m", 30},
+	{"1h", 60},
+	{"6h", 360},
+}
+
+// sloBuckets is the number of one minute buckets kept by a series, enough for the longest window.
+const sloBuckets = 360
+
+// sloBucket - Counts of requests of a minute
+type sloBucket struct {
+	minute int64
+	total  int64
+	failed int64
+	slow   int64
+}
+
+// sloSeries - Requests of a method and a tenant, by minute
+type sloSeries struct {
+	objective Objective
+	buckets   [sloBuckets]sloBucket
+	last      int64
+}
+
+// sloKey - Method and tenant of a series
+type sloKey struct {
+	method string
+	tenant string
+}
+
+// SLO - Measures the availability and the latency of requests against their objectives by method and tenant. Along
+// with counters of the requests and the bad ones, it exports precomputed burn rates over the windows of multi-window
+// alerts, the rate the error budget of an objective is spent at in the window. A burn rate of 1 spends the budget
+// exactly over the period of the objective.
+type SLO struct {
+	objectives map[string]Objective
+	clock      clock.Clock
+
+	mu     sync.Mutex
+	series map[sloKey]*sloSeries
+
+	requests api.Int64Counter
+	bad      api.Int64Counter
+}
+
+// NewSLO - Creates a new SLO measuring requests against the objectives and exporting its metrics with the meter
+func NewSLO(meter api.Meter, objectives ...Objective) (*SLO, error) {
+	s := &SLO{
+		objectives: map[string]Objective{},
+		clock:      clock.New(),
+		series:     map[sloKey]*sloSeries{},
+	}
+
+	for _, objective := range objectives {
+		if objective.Availability <= 0 || objective.Availability >= 1 || objective.LatencyTarget <= 0 || objective.LatencyTarget >= 1 {
+			return nil, fmt.Errorf("objectives of %s must be between 0 and 1", objective.Method)
+		}
+		if objective.Latency <= 0 {
+			return nil, fmt.Errorf("latency threshold of %s must be positive", objective.Method)
+		}
+		s.objectives[objective.Method] = objective
+	}
+
+	var err error
+	s.requests, err = meter.Int64Counter("slo_requests", api.WithDescription("Number of requests measured against service level objectives"))
+	if err != nil {
+		return nil, err
+	}
+	s.bad, err = meter.Int64Counter("slo_bad_requests", api.WithDescription("Number of requests that failed or were slower than the latency objective, by sli"))
+	if err != nil {
+		return nil, err
+	}
+	_, err = meter.Float64ObservableGauge("slo_burn_rate",
+		api.WithDescription("Rate the error budget of the objective is spent at over the window, by sli"),
+		api.WithFloat64Callback(func(_ context.Context, o api.Float64Observer) error {
+			for _, rate := range s.BurnRates() {
+				o.Observe(rate.Value, api.WithAttributes(
+					attribute.String("method", rate.Method),
+					attribute.String("tenant_id", rate.Tenant),
+					attribute.String("sli", rate.SLI),
+					attribute.String("window", rate.Window),
+				))
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// UnaryServerInterceptor - Returns an interceptor measuring the unary requests of the server
+func (s *SLO) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := s.clock.Now()
+		resp, err := handler(ctx, req)
+
+		var tenantID string
+		if r, ok := req.(interface{ GetTenantId() string }); ok {
+			tenantID = r.GetTenantId()
+		}
+		s.Record(ctx, info.FullMethod, tenantID, s.clock.Now().Sub(start), err)
+
+		return resp, err
+	}
+}
+
+// Record - Records a request of the method for the tenant that took the duration and failed with err, if not nil.
+// Requests of methods without an objective are ignored.
+func (s *SLO) Record(ctx context.Context, method, tenantID string, duration time.Duration, err error) {
+	objective, ok := s.objectives[method]
+	if !ok {
+		if objective, ok = s.objectives[AnyMethod]; !ok {
+			return
+		}
+	}
+
+	failed := isServerError(err)
+	slow := duration > objective.Latency
+
+	attrs := []attribute.KeyValue{attribute.String("method", method), attribute.String("tenant_id", tenantID)}
+	s.requests.Add(ctx, 1, api.WithAttributes(attrs...))
+	if failed {
+		s.bad.Add(ctx, 1, api.WithAttributes(append(attrs, attribute.String("sli", "availability"))...))
+	}
+	if slow {
+		s.bad.Add(ctx, 1, api.WithAttributes(append(attrs, attribute.String("sli", "latency"))...))
+	}
+
+	minute := s.clock.Now().Unix() / 60
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := sloKey{method: method, tenant: tenantID}
+	series, ok := s.series[key]
+	if !ok {
+		series = &sloSeries{objective: objective}
+		s.series[key] = series
+	}
+
+	b := &series.buckets[minute%sloBuckets]
+	if b.minute != minute {
+		*b = sloBucket{minute: minute}
+	}
+	b.total++
+	if failed {
+		b.failed++
+	}
+	if slow {
+		b.slow++
+	}
+	series.last = minute
+}
+
+// BurnRate - Burn rate of the objective of a method and a tenant over a window
+type BurnRate struct {
+	Method string
+	Tenant string
+	// SLI is either availability or latency.
+	SLI    string
+	Window string
+	Value  float64
+}
+
+// BurnRates - Returns the burn rates of the objectives of the methods and tenants with requests in the longest
+// window. Series without requests in it are dropped.
+func (s *SLO) BurnRates() []BurnRate {
+	minute := s.clock.Now().Unix() / 60
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rates []BurnRate
+	for key, series := range s.series {
+		if minute-series.last >= sloBuckets {
+			delete(s.series, key)
+			continue
+		}
+
+		for _, window := range sloWindows {
+			var total, failed, slow int64
+			for i := int64(0); i < window.minutes; i++ {
+				b := series.buckets[(minute-i)%sloBuckets]
+				if b.minute != minute-i {
+					continue
+				}
+				total += b.total
+				failed += b.failed
+				slow += b.slow
+			}
+
+			var availability, latency float64
+			if total > 0 {
+				availability = float64(failed) / float64(total) / (1 - series.objective.Availability)
+				latency = float64(slow) / float64(total) / (1 - series.objective.LatencyTarget)
+			}
+			rates = append(rates,
+				BurnRate{Method: key.method, Tenant: key.tenant, SLI: "availability", Window: window.name, Value: availability},
+				BurnRate{Method: key.method, Tenant: key.tenant, SLI: "latency", Window: window.name, Value: latency},
+			)
+		}
+	}
+	return rates
+}
+
+// isServerError reports whether the error counts against availability. Errors caused by the request, such as
+// invalid arguments, missing permissions or rate limiting, do not.
+func isServerError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/pkg/clock"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+// TestMiddleware -
+func TestMiddleware(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "middleware-suite")
+}
+
+var _ = Describe("slo", func() {
+	const check = "/base.v1.Permission/Check"
+
+	var now *clock.Mock
+	var slo *SLO
+
+	burnRate := func(tenantID, sli, window string) float64 {
+		for _, rate := range slo.BurnRates() {
+			if rate.Method == check && rate.Tenant == tenantID && rate.SLI == sli && rate.Window == window {
+				return rate.Value
+			}
+		}
+		Fail("no burn rate of " + tenantID + " " + sli + " " + window)
+		return 0
+	}
+
+	BeforeEach(func() {
+		var err error
+		slo, err = NewSLO(telemetry.NewNoopMeter(), Objective{
+			Method:        AnyMethod,
+			Availability:  0.99,
+			Latency:       100 * time.Millisecond,
+			LatencyTarget: 0.9,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		now = clock.NewMock(time.Unix(1_700_000_000, 0))
+		slo.clock = now
+	})
+
+	It("Case 1 - Burn rates by window", func() {
+		ctx := context.Background()
+
+		// 100 requests of t1 ten minutes ago, one of which failed
+		now.Add(-10 * time.Minute)
+		for i := 0; i < 99; i++ {
+			slo.Record(ctx, check, "t1", 10*time.Millisecond, nil)
+		}
+		slo.Record(ctx, check, "t1", 10*time.Millisecond, status.Error(codes.Internal, "internal"))
+
+		// 10 slow requests of t1 now, and an invalid one
+		now.Add(10 * time.Minute)
+		for i := 0; i < 10; i++ {
+			slo.Record(ctx, check, "t1", time.Second, nil)
+		}
+		slo.Record(ctx, check, "t1", 10*time.Millisecond, status.Error(codes.InvalidArgument, "invalid"))
+
+		Expect(burnRate("t1", "availability", "5m")).Should(BeZero())
+		Expect(burnRate("t1", "latency", "5m")).Should(BeNumerically("~", 10.0/11/0.1, 1e-9))
+		Expect(burnRate("t1", "availability", "30m")).Should(BeNumerically("~", 1.0/111/0.01, 1e-9))
+		Expect(burnRate("t1", "latency", "1h")).Should(BeNumerically("~", 10.0/111/0.1, 1e-9))
+	})
+
+	It("Case 2 - Series are kept by tenant and dropped once out of the longest window", func() {
+		ctx := context.Background()
+
+		slo.Record(ctx, check, "t1", 10*time.Millisecond, errors.New("unknown"))
+		slo.Record(ctx, check, "t2", 10*time.Millisecond, nil)
+
+		Expect(burnRate("t1", "availability", "6h")).Should(BeNumerically("~", 100, 1e-9))
+		Expect(burnRate("t2", "availability", "6h")).Should(BeZero())
+
+		now.Add(6 * time.Hour)
+		Expect(slo.BurnRates()).Should(BeEmpty())
+	})
+
+	It("Case 3 - Objectives", func() {
+		s, err := NewSLO(telemetry.NewNoopMeter(), Objective{Method: check, Availability: 0.999, Latency: time.Second, LatencyTarget: 0.99})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Methods without an objective are not measured
+		s.Record(context.Background(), "/base.v1.Schema/Write", "t1", time.Minute, nil)
+		Expect(s.BurnRates()).Should(BeEmpty())
+
+		_, err = NewSLO(telemetry.NewNoopMeter(), Objective{Method: check, Availability: 1, Latency: time.Second, LatencyTarget: 0.99})
+		Expect(err).Should(HaveOccurred())
+		_, err = NewSLO(telemetry.NewNoopMeter(), Objective{Method: check, Availability: 0.99, LatencyTarget: 0.99})
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,242 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Scheme is the scheme of an object storage URL.
+type Scheme string
+
+const (
+	S3  Scheme = "s3"
+	GCS Scheme = "gs"
+)
+
+// errAborted is the error aborted uploads are stopped with when the writer is aborted without a cause.
+var errAborted = errors.New("object storage write aborted")
+
+// Writer writes an object. The object is only complete once the writer is closed, writers that fail partway must
+// be aborted instead, so that no partial object is left at the location.
+type Writer interface {
+	io.WriteCloser
+	// Abort discards what was written, stopping the upload with err without completing it.
+	Abort(err error)
+}
+
+// NewWriter opens location for writing. The location is either a file path, "-" for the standard output,
+// or an s3://bucket/key or gs://bucket/key URL. Objects are uploaded as they are written, without staging
+// them on local disk, and are only complete once the writer is closed. Credentials are read from the environment.
+func NewWriter(ctx context.Context, location string) (Writer, error) {
+	if location == "-" {
+		return nopWriteCloser{os.Stdout}, nil
+	}
+
+	scheme, bucket, key, ok := Parse(location)
+	if !ok {
+		f, err := os.Create(location)
+		if err != nil {
+			return nil, err
+		}
+		return fileWriter{f}, nil
+	}
+
+	switch scheme {
+	case S3:
+		client, err := s3Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return newS3Writer(ctx, client, bucket, key), nil
+	case GCS:
+		client, err := gcs.NewClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create gcs client: %w", err)
+		}
+		// Cancelling the context of the object writer before it is closed stops the upload without completing it.
+		ctx, cancel := context.WithCancel(ctx)
+		return &gcsWriter{Writer: client.Bucket(bucket).Object(key).NewWriter(ctx), client: client, cancel: cancel}, nil
+	default:
+		return nil, fmt.Errorf("unsupported object storage scheme %s", scheme)
+	}
+}
+
+// NewReader opens location for reading. It accepts the same locations as NewWriter,
+// with "-" meaning the standard input. Objects are streamed as they are read.
+func NewReader(ctx context.Context, location string) (io.ReadCloser, error) {
+	if location == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	scheme, bucket, key, ok := Parse(location)
+	if !ok {
+		return os.Open(location)
+	}
+
+	switch scheme {
+	case S3:
+		client, err := s3Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out, err := client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)})
+		if err != nil {
+			return nil, err
+		}
+		return out.Body, nil
+	case GCS:
+		client, err := gcs.NewClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create gcs client: %w", err)
+		}
+		r, err := client.Bucket(bucket).Object(key).NewReader(ctx)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		return &gcsReader{Reader: r, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unsupported object storage scheme %s", scheme)
+	}
+}
+
+// Parse splits an object storage URL into its scheme, bucket and key.
+// It reports false for locations that are not object storage URLs, such as file paths.
+func Parse(location string) (scheme Scheme, bucket, key string, ok bool) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", "", "", false
+	}
+	switch Scheme(u.Scheme) {
+	case S3, GCS:
+	default:
+		return "", "", "", false
+	}
+	key = strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || key == "" {
+		return "", "", "", false
+	}
+	return Scheme(u.Scheme), u.Host, key, true
+}
+
+// s3Client creates an S3 client from the default AWS configuration chain.
+func s3Client(ctx context.Context) (*s3.Client, error) {
+	cfg, err := awsConfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+	return s3.NewFromConfig(cfg), nil
+}
+
+// s3Writer streams everything written to it into an S3 object through a multipart upload.
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func newS3Writer(ctx context.Context, client *s3.Client, bucket, key string) *s3Writer {
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	go func() {
+		_, err := manager.NewUploader(client).Upload(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Body:   pr,
+		})
+		// Unblock any pending write if the upload stopped early.
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w
+}
+
+// Write writes p to the upload.
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close finishes the upload and waits for it to complete.
+func (w *s3Writer) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
+
+// Abort stops the upload with err. The uploader aborts the upload when reading its body fails, instead of
+// completing the object.
+func (w *s3Writer) Abort(err error) {
+	if err == nil {
+		err = errAborted
+	}
+	w.pw.CloseWithError(err)
+	<-w.done
+}
+
+// gcsWriter closes the client along with the object writer.
+type gcsWriter struct {
+	*gcs.Writer
+	client *gcs.Client
+	cancel context.CancelFunc
+}
+
+// Close finishes the upload and closes the client.
+func (w *gcsWriter) Close() error {
+	defer w.client.Close()
+	defer w.cancel()
+	return w.Writer.Close()
+}
+
+// Abort cancels the upload before closing the object writer, so that the object is not completed.
+func (w *gcsWriter) Abort(error) {
+	w.cancel()
+	w.Writer.Close()
+	w.client.Close()
+}
+
+// fileWriter removes the file when aborted.
+type fileWriter struct {
+	*os.File
+}
+
+// Abort closes and removes the file.
+func (w fileWriter) Abort(error) {
+	w.File.Close()
+	os.Remove(w.File.Name())
+}
+
+// gcsReader closes the client along with the object reader.
+type gcsReader struct {
+	*gcs.Reader
+	client *gcs.Client
+}
+
+// Close closes the object reader and the client.
+func (r *gcsReader) Close() error {
+	defer r.client.Close()
+	return r.Reader.Close()
+}
+
+// nopWriteCloser wraps a writer that must not be closed, such as the standard output.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close does nothing.
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// Abort does nothing, what was written is out already.
+func (nopWriteCloser) Abort(error) {}
@@ -0,0 +1,143 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestObjectStorage is the entry point for the test suite of the objectstorage package.
+func TestObjectStorage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "objectstorage-suite")
+}
+
+var _ = Describe("ObjectStorage", func() {
+	Context("Parse", func() {
+		It("should parse object storage URLs", func() {
+			tests := []struct {
+				location string
+				scheme   Scheme
+				bucket   string
+				key      string
+			}{
+				{location: "s3://backups/permify/2023-09-01.ndjson", scheme: S3, bucket: "backups", key: "permify/2023-09-01.ndjson"},
+				{location: "gs://backups/permify.ndjson", scheme: GCS, bucket: "backups", key: "permify.ndjson"},
+			}
+
+			for _, tt := range tests {
+				scheme, bucket, key, ok := Parse(tt.location)
+				Expect(ok).Should(BeTrue())
+				Expect(scheme).Should(Equal(tt.scheme))
+				Expect(bucket).Should(Equal(tt.bucket))
+				Expect(key).Should(Equal(tt.key))
+			}
+		})
+
+		It("should not parse other locations", func() {
+			for _, location := range []string{"/var/backups/permify.ndjson", "permify.ndjson", "s3://backups", "gs:///permify.ndjson", "https://example.com/permify.ndjson"} {
+				_, _, _, ok := Parse(location)
+				Expect(ok).Should(BeFalse(), location)
+			}
+		})
+	})
+
+	Context("Files", func() {
+		It("should write and read back a file", func() {
+			ctx := context.Background()
+			location := filepath.Join(GinkgoT().TempDir(), "permify.ndjson")
+
+			w, err := NewWriter(ctx, location)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = w.Write([]byte("{}\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.Close()).Should(Succeed())
+
+			r, err := NewReader(ctx, location)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer r.Close()
+
+			b, err := io.ReadAll(r)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(b)).Should(Equal("{}\n"))
+		})
+
+		It("should remove the file of an aborted write", func() {
+			location := filepath.Join(GinkgoT().TempDir(), "permify.ndjson")
+
+			w, err := NewWriter(context.Background(), location)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = w.Write([]byte("{}\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			w.Abort(errors.New("backup failed"))
+
+			_, err = os.Stat(location)
+			Expect(os.IsNotExist(err)).Should(BeTrue())
+		})
+	})
+
+	Context("S3", func() {
+		var (
+			server *httptest.Server
+			client *s3.Client
+
+			mu      sync.Mutex
+			objects map[string]string
+		)
+
+		BeforeEach(func() {
+			objects = map[string]string{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				if err != nil || r.Method != http.MethodPut {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				mu.Lock()
+				objects[r.URL.Path] = string(b)
+				mu.Unlock()
+				w.Header().Set("ETag", `"etag"`)
+			}))
+			client = s3.New(s3.Options{
+				Region:       "us-east-1",
+				BaseEndpoint: aws.String(server.URL),
+				UsePathStyle: true,
+				Credentials:  aws.AnonymousCredentials{},
+			})
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should upload the object once the writer is closed", func() {
+			w := newS3Writer(context.Background(), client, "backups", "permify.ndjson")
+			_, err := w.Write([]byte("{}\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.Close()).Should(Succeed())
+
+			Expect(objects).Should(Equal(map[string]string{"/backups/permify.ndjson": "{}\n"}))
+		})
+
+		It("should not upload the object of a write failing partway", func() {
+			w := newS3Writer(context.Background(), client, "backups", "permify.ndjson")
+			_, err := w.Write([]byte("{}\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			w.Abort(errors.New("backup failed"))
+
+			Expect(objects).Should(BeEmpty())
+		})
+	})
+})
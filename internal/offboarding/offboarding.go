@@ -0,0 +1,118 @@
+package offboarding
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// pageSize is the number of relationships and tenants read at once
+const pageSize = 100
+
+// Record - Audit record of the relationships of a subject deleted from a tenant
+type Record struct {
+	Time     time.Time `json:"time"`
+	TenantID string    `json:"tenant_id"`
+	Subject  string    `json:"subject"`
+	Reason   string    `json:"reason,omitempty"`
+	// SnapToken is the snap token of the deletion, the relationships can be read at the snapshots before it
+	SnapToken string `json:"snap_token,omitempty"`
+	// Relationships are the relationships found before the deletion
+	Relationships []string `json:"relationships"`
+}
+
+// Tenants returns the ids of all the tenants.
+func Tenants(ctx context.Context, tenancy base.TenancyClient) ([]string, error) {
+	var ids []string
+	token := ""
+	for {
+		response, err := tenancy.List(ctx, &base.TenantListRequest{PageSize: pageSize, ContinuousToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, tenant := range response.GetTenants() {
+			ids = append(ids, tenant.GetId())
+		}
+
+		token = response.GetContinuousToken()
+		if token == "" {
+			return ids, nil
+		}
+	}
+}
+
+// Find returns the relationships of the tenant in which the entity appears, as the subject, in any subject
+// relation, or as the entity.
+func Find(ctx context.Context, data base.DataClient, tenantID string, entity *base.Entity) ([]*base.Tuple, error) {
+	var tuples []*base.Tuple
+	seen := map[string]struct{}{}
+	for _, filter := range filters(entity) {
+		token := ""
+		for {
+			response, err := data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+				TenantId:        tenantID,
+				Metadata:        &base.RelationshipReadRequestMetadata{},
+				Filter:          filter,
+				PageSize:        pageSize,
+				ContinuousToken: token,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range response.GetTuples() {
+				// The relationships of an entity to itself match both filters
+				key := tuple.ToString(t)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				tuples = append(tuples, t)
+			}
+
+			token = response.GetContinuousToken()
+			if token == "" {
+				break
+			}
+		}
+	}
+	return tuples, nil
+}
+
+// Delete deletes the relationships of the tenant in which the entity appears, returning the snap token of the
+// last deletion. The relationships written since they were found are deleted too.
+func Delete(ctx context.Context, data base.DataClient, tenantID string, entity *base.Entity) (string, error) {
+	var token string
+	for _, filter := range filters(entity) {
+		response, err := data.DeleteRelationships(ctx, &base.RelationshipDeleteRequest{
+			TenantId: tenantID,
+			Filter:   filter,
+		})
+		if err != nil {
+			return "", err
+		}
+		token = response.GetSnapToken()
+	}
+	return token, nil
+}
+
+// WriteRecord appends the record to the audit log as a line of JSON.
+func WriteRecord(w io.Writer, record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
+
+// filters returns the filters of the relationships of the entity as the subject and as the entity.
+func filters(entity *base.Entity) []*base.TupleFilter {
+	return []*base.TupleFilter{
+		{Subject: &base.SubjectFilter{Type: entity.GetType(), Ids: []string{entity.GetId()}}},
+		{Entity: &base.EntityFilter{Type: entity.GetType(), Ids: []string{entity.GetId()}}},
+	}
+}
@@ -0,0 +1,164 @@
+package offboarding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestOffboarding -
+func TestOffboarding(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "offboarding-suite")
+}
+
+// matches returns whether the tuple matches the entity or the subject of the filter.
+func matches(t *base.Tuple, filter *base.TupleFilter) bool {
+	if filter.GetSubject() != nil {
+		return t.GetSubject().GetType() == filter.GetSubject().GetType() && t.GetSubject().GetId() == filter.GetSubject().GetIds()[0]
+	}
+	return t.GetEntity().GetType() == filter.GetEntity().GetType() && t.GetEntity().GetId() == filter.GetEntity().GetIds()[0]
+}
+
+// data - Data client reading and deleting the relationships of tenants, one at a time
+type data struct {
+	base.DataClient
+
+	tuples  map[string][]*base.Tuple
+	deletes int
+}
+
+func (d *data) ReadRelationships(_ context.Context, in *base.RelationshipReadRequest, _ ...grpc.CallOption) (*base.RelationshipReadResponse, error) {
+	start := 0
+	if in.GetContinuousToken() != "" {
+		start, _ = strconv.Atoi(in.GetContinuousToken())
+	}
+
+	response := &base.RelationshipReadResponse{}
+	tuples := d.tuples[in.GetTenantId()]
+	for i := start; i < len(tuples); i++ {
+		if matches(tuples[i], in.GetFilter()) {
+			response.Tuples = append(response.Tuples, tuples[i])
+			response.ContinuousToken = strconv.Itoa(i + 1)
+			break
+		}
+	}
+	return response, nil
+}
+
+func (d *data) DeleteRelationships(_ context.Context, in *base.RelationshipDeleteRequest, _ ...grpc.CallOption) (*base.RelationshipDeleteResponse, error) {
+	var kept []*base.Tuple
+	for _, t := range d.tuples[in.GetTenantId()] {
+		if !matches(t, in.GetFilter()) {
+			kept = append(kept, t)
+		}
+	}
+	d.tuples[in.GetTenantId()] = kept
+	d.deletes++
+	return &base.RelationshipDeleteResponse{SnapToken: "snap-" + strconv.Itoa(d.deletes)}, nil
+}
+
+// tenancy - Tenancy client listing fixed tenants, one at a time
+type tenancy struct {
+	base.TenancyClient
+
+	ids []string
+}
+
+func (t *tenancy) List(_ context.Context, in *base.TenantListRequest, _ ...grpc.CallOption) (*base.TenantListResponse, error) {
+	start := 0
+	if in.GetContinuousToken() != "" {
+		start, _ = strconv.Atoi(in.GetContinuousToken())
+	}
+	response := &base.TenantListResponse{Tenants: []*base.Tenant{{Id: t.ids[start]}}}
+	if start+1 < len(t.ids) {
+		response.ContinuousToken = strconv.Itoa(start + 1)
+	}
+	return response, nil
+}
+
+var _ = Describe("offboarding", func() {
+	tuples := func(strs ...string) []*base.Tuple {
+		var tuples []*base.Tuple
+		for _, str := range strs {
+			t, err := tuple.Tuple(str)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		return tuples
+	}
+	strs := func(tuples []*base.Tuple) []string {
+		var strs []string
+		for _, t := range tuples {
+			strs = append(strs, tuple.ToString(t))
+		}
+		return strs
+	}
+	user := &base.Entity{Type: "user", Id: "1"}
+
+	It("Lists all the tenants", func() {
+		ids, err := Tenants(context.Background(), &tenancy{ids: []string{"t1", "t2", "t3"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids).Should(Equal([]string{"t1", "t2", "t3"}))
+	})
+
+	It("Finds and deletes the relationships the subject appears in", func() {
+		d := &data{tuples: map[string][]*base.Tuple{
+			"t1": tuples(
+				"organization:1#admin@user:1",
+				"doc:1#viewer@user:2",
+				"doc:2#viewer@team:1#member",
+				"team:1#member@user:1",
+				"user:1#manager@user:2",
+				"user:1#manager@user:1",
+			),
+			"t2": tuples("doc:1#owner@user:1"),
+		}}
+
+		found, err := Find(context.Background(), d, "t1", user)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(strs(found)).Should(Equal([]string{
+			"organization:1#admin@user:1",
+			"team:1#member@user:1",
+			"user:1#manager@user:1",
+			"user:1#manager@user:2",
+		}))
+
+		token, err := Delete(context.Background(), d, "t1", user)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(token).Should(Equal("snap-2"))
+		Expect(strs(d.tuples["t1"])).Should(Equal([]string{"doc:1#viewer@user:2", "doc:2#viewer@team:1#member"}))
+		// The relationships of the other tenants are left alone
+		Expect(d.tuples["t2"]).Should(HaveLen(1))
+	})
+
+	It("Appends audit records as JSON lines", func() {
+		var buf bytes.Buffer
+		record := Record{
+			Time:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			TenantID:      "t1",
+			Subject:       "user:1",
+			Reason:        "left the company",
+			SnapToken:     "snap",
+			Relationships: []string{"team:1#member@user:1"},
+		}
+		Expect(WriteRecord(&buf, record)).Should(Succeed())
+		Expect(WriteRecord(&buf, record)).Should(Succeed())
+
+		lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+		Expect(lines).Should(HaveLen(2))
+		decoded := Record{}
+		Expect(json.Unmarshal(lines[1], &decoded)).Should(Succeed())
+		Expect(decoded).Should(Equal(record))
+	})
+})
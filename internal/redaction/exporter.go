@@ -0,0 +1,84 @@
+package redaction
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+)
+
+// SpanExporter - Span exporter redacting the identifiers of the spans before the exporter it wraps exports them
+type SpanExporter struct {
+	exporter trace.SpanExporter
+	redactor *Redactor
+}
+
+// NewSpanExporter - Creates new SpanExporter redacting the spans of the exporter
+func NewSpanExporter(exporter trace.SpanExporter, redactor *Redactor) *SpanExporter {
+	return &SpanExporter{exporter: exporter, redactor: redactor}
+}
+
+// ExportSpans exports the redacted spans
+func (e *SpanExporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan) error {
+	redacted := make([]trace.ReadOnlySpan, len(spans))
+	for i, span := range spans {
+		redacted[i] = &redactedSpan{ReadOnlySpan: span, redactor: e.redactor}
+	}
+	return e.exporter.ExportSpans(ctx, redacted)
+}
+
+// Shutdown shuts the exporter down
+func (e *SpanExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// redactedSpan - Span whose attributes, events and status are redacted
+type redactedSpan struct {
+	trace.ReadOnlySpan
+	redactor *Redactor
+}
+
+// Attributes returns the redacted attributes of the span
+func (s *redactedSpan) Attributes() []attribute.KeyValue {
+	return s.attributes(s.ReadOnlySpan.Attributes())
+}
+
+// Events returns the events of the span with redacted attributes, such as the messages of recorded errors
+func (s *redactedSpan) Events() []trace.Event {
+	events := s.ReadOnlySpan.Events()
+	redacted := make([]trace.Event, len(events))
+	for i, event := range events {
+		event.Attributes = s.attributes(event.Attributes)
+		redacted[i] = event
+	}
+	return redacted
+}
+
+// Status returns the status of the span with a redacted description
+func (s *redactedSpan) Status() trace.Status {
+	status := s.ReadOnlySpan.Status()
+	status.Description = s.redactor.Text(status.Description)
+	return status
+}
+
+// attributes redacts the attributes holding identifiers, references and error messages.
+func (s *redactedSpan) attributes(attrs []attribute.KeyValue) []attribute.KeyValue {
+	redacted := make([]attribute.KeyValue, len(attrs))
+	for i, attr := range attrs {
+		key := string(attr.Key)
+		_, isID := idKeys[key]
+		_, isReference := referenceKeys[key]
+
+		switch {
+		case isID && attr.Value.Type() == attribute.STRING:
+			attr.Value = attribute.StringValue(s.redactor.ID(attr.Value.AsString()))
+		case isID && attr.Value.Type() == attribute.STRINGSLICE:
+			attr.Value = attribute.StringSliceValue(s.redactor.IDs(attr.Value.AsStringSlice()))
+		case (isReference || attr.Key == semconv.ExceptionMessageKey) && attr.Value.Type() == attribute.STRING:
+			attr.Value = attribute.StringValue(s.redactor.Text(attr.Value.AsString()))
+		}
+		redacted[i] = attr
+	}
+	return redacted
+}
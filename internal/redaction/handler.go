@@ -0,0 +1,105 @@
+package redaction
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// idKeys are the keys of log attributes and span attributes holding identifiers
+var idKeys = map[string]struct{}{
+	"entity_id":  {},
+	"subject_id": {},
+	"ids":        {},
+}
+
+// referenceKeys are the keys of log attributes and span attributes holding references, tuples or errors, whose
+// identifiers are redacted from their text
+var referenceKeys = map[string]struct{}{
+	"entity":    {},
+	"subject":   {},
+	"tuple":     {},
+	"attribute": {},
+	"error":     {},
+}
+
+// Handler - Log handler redacting the identifiers of the records before the handler it wraps handles them
+type Handler struct {
+	handler  slog.Handler
+	redactor *Redactor
+}
+
+// NewHandler - Creates new Handler redacting the records of the handler
+func NewHandler(handler slog.Handler, redactor *Redactor) *Handler {
+	return &Handler{handler: handler, redactor: redactor}
+}
+
+// Enabled reports whether the handler handles records at the level
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle redacts the message and the attributes of the record, then handles it
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.Text(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.attr(attr))
+		return true
+	})
+	return h.handler.Handle(ctx, redacted)
+}
+
+// WithAttrs returns a handler with the redacted attributes
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.attr(attr)
+	}
+	return &Handler{handler: h.handler.WithAttrs(redacted), redactor: h.redactor}
+}
+
+// WithGroup returns a handler with the group
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{handler: h.handler.WithGroup(name), redactor: h.redactor}
+}
+
+// attr redacts the attribute. Tuples, attributes, entities and subjects are redacted whatever their key, along
+// with the values of the keys holding identifiers.
+func (h *Handler) attr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+
+	switch value.Kind() {
+	case slog.KindGroup:
+		attrs := value.Group()
+		redacted := make([]any, len(attrs))
+		for i, a := range attrs {
+			redacted[i] = h.attr(a)
+		}
+		return slog.Group(attr.Key, redacted...)
+	case slog.KindString:
+		if _, ok := idKeys[attr.Key]; ok {
+			return slog.String(attr.Key, h.redactor.ID(value.String()))
+		}
+		if _, ok := referenceKeys[attr.Key]; ok {
+			return slog.String(attr.Key, h.redactor.Text(value.String()))
+		}
+		return slog.Attr{Key: attr.Key, Value: value}
+	case slog.KindAny:
+		switch v := value.Any().(type) {
+		case []string:
+			if _, ok := idKeys[attr.Key]; ok {
+				return slog.Any(attr.Key, h.redactor.IDs(v))
+			}
+		case error:
+			return slog.String(attr.Key, h.redactor.Text(v.Error()))
+		case proto.Message:
+			if m := h.redactor.Message(v); m != nil {
+				return slog.Any(attr.Key, m)
+			}
+		}
+		return slog.Attr{Key: attr.Key, Value: value}
+	default:
+		return slog.Attr{Key: attr.Key, Value: value}
+	}
+}
@@ -0,0 +1,37 @@
+package redaction
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor - Returns an interceptor redacting the identifiers of the error messages of the responses
+func (r *Redactor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, r.Error(err)
+	}
+}
+
+// StreamServerInterceptor - Returns an interceptor redacting the identifiers of the error messages of the streams
+func (r *Redactor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return r.Error(handler(srv, stream))
+	}
+}
+
+// Error returns the error with a redacted message, keeping its gRPC status code. Details of the status are kept.
+func (r *Redactor) Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return status.Error(st.Code(), r.Text(err.Error()))
+	}
+	p := st.Proto()
+	p.Message = r.Text(p.GetMessage())
+	return status.ErrorProto(p)
+}
@@ -0,0 +1,122 @@
+package redaction
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestRedactor(t *testing.T) {
+	hash, err := NewRedactor(config.Redaction{Mode: ModeHash, Salt: "salt"})
+	require.NoError(t, err)
+	assert.True(t, hash.Enabled())
+	assert.Regexp(t, `^\[[0-9a-f]{12}\]$`, hash.ID("alice"))
+	assert.Equal(t, hash.ID("alice"), hash.ID("alice"))
+	assert.NotEqual(t, hash.ID("alice"), hash.ID("bob"))
+	assert.Equal(t, "*", hash.ID("*"))
+
+	salted, err := NewRedactor(config.Redaction{Mode: ModeHash, Salt: "other"})
+	require.NoError(t, err)
+	assert.NotEqual(t, hash.ID("alice"), salted.ID("alice"))
+
+	truncate, err := NewRedactor(config.Redaction{Mode: ModeTruncate, Length: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "ali***", truncate.ID("alice"))
+	assert.Equal(t, "***", truncate.ID("bob"))
+
+	text := truncate.Text("doc:readme#viewer@user:alice@example.com is denied, see https://permify.co and localhost:3476")
+	assert.Equal(t, "doc:rea***#viewer@user:ali*** is denied, see https://permify.co and localhost:347***", text)
+
+	none, err := NewRedactor(config.Redaction{})
+	require.NoError(t, err)
+	assert.False(t, none.Enabled())
+	assert.Equal(t, "user:alice", none.Text("user:alice"))
+
+	_, err = NewRedactor(config.Redaction{Mode: "mask"})
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	redactor, err := NewRedactor(config.Redaction{Mode: ModeTruncate, Length: 2})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(slog.NewTextHandler(&buf, nil), redactor)).With(slog.String("entity_id", "readme"))
+
+	logger.Info("checking user:alice",
+		slog.String("subject", "user:alice"),
+		slog.Any("ids", []string{"alice", "bob"}),
+		slog.Any("error", errors.New("entity doc:readme not found")),
+		slog.Any("tuple", &base.Tuple{
+			Entity:   &base.Entity{Type: "doc", Id: "readme"},
+			Relation: "viewer",
+			Subject:  &base.Subject{Type: "user", Id: "alice"},
+		}),
+		slog.String("address", "localhost:3476"),
+	)
+
+	out := buf.String()
+	assert.NotContains(t, out, "alice")
+	assert.NotContains(t, out, "readme")
+	assert.NotContains(t, out, "bob")
+	assert.Contains(t, out, "entity_id=re***")
+	assert.Contains(t, out, `msg="checking user:al***"`)
+	assert.Contains(t, out, "address=localhost:3476")
+}
+
+func TestSpanExporter(t *testing.T) {
+	redactor, err := NewRedactor(config.Redaction{Mode: ModeHash})
+	require.NoError(t, err)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewSpanExporter(exporter, redactor)))
+
+	_, span := provider.Tracer("test").Start(context.Background(), "check")
+	span.SetAttributes(
+		attribute.String("entity", "doc:readme"),
+		attribute.String("subject", "user:alice"),
+		attribute.String("tenant_id", "t1"),
+	)
+	span.RecordError(errors.New("user:alice can't be found"))
+	span.SetStatus(codes.Error, "user:alice can't be found")
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	for _, attr := range spans[0].Attributes {
+		assert.NotContains(t, attr.Value.Emit(), "alice")
+		assert.NotContains(t, attr.Value.Emit(), "readme")
+	}
+	assert.Contains(t, spans[0].Attributes, attribute.String("tenant_id", "t1"))
+	assert.Contains(t, spans[0].Attributes, attribute.String("subject", "user:"+redactor.ID("alice")))
+	require.Len(t, spans[0].Events, 1)
+	for _, attr := range spans[0].Events[0].Attributes {
+		assert.NotContains(t, attr.Value.Emit(), "alice")
+	}
+	assert.Equal(t, "user:"+redactor.ID("alice")+" can't be found", spans[0].Status.Description)
+}
+
+func TestError(t *testing.T) {
+	redactor, err := NewRedactor(config.Redaction{Mode: ModeTruncate, Length: 1})
+	require.NoError(t, err)
+
+	err = redactor.Error(status.Error(grpcCodes.NotFound, "subject user:alice not found"))
+	assert.Equal(t, grpcCodes.NotFound, status.Code(err))
+	assert.Equal(t, "subject user:a*** not found", status.Convert(err).Message())
+
+	assert.NoError(t, redactor.Error(nil))
+}
@@ -0,0 +1,137 @@
+package redaction
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// ModeNone keeps identifiers as they are
+	ModeNone = "none"
+	// ModeHash replaces identifiers with a salted hash, so equal identifiers can still be correlated
+	ModeHash = "hash"
+	// ModeTruncate keeps the first characters of identifiers only
+	ModeTruncate = "truncate"
+
+	// wildcard is the identifier of every subject of a type, which isn't personal data
+	wildcard = "*"
+	// hashSize is the number of bytes of the hash identifiers are replaced with
+	hashSize = 6
+)
+
+// reference matches the type:id references of entities and subjects in text, such as those of error messages.
+// Identifiers starting with a slash aren't matched, so URLs are left alone.
+var reference = regexp.MustCompile(`\b([a-z][a-z0-9_]{1,62}[a-z0-9]):([a-zA-Z0-9_\-@.+|][a-zA-Z0-9_\-@.:+|]*|\*)`)
+
+// Redactor - Redacts the identifiers of entities and subjects from logs, traces and error messages, since many
+// deployments treat user identifiers as personal data
+type Redactor struct {
+	mode   string
+	salt   []byte
+	length int
+}
+
+// NewRedactor - Creates new Redactor with the mode of the configuration
+func NewRedactor(conf config.Redaction) (*Redactor, error) {
+	r := &Redactor{mode: conf.Mode, salt: []byte(conf.Salt), length: conf.Length}
+	switch conf.Mode {
+	case "", ModeNone:
+		r.mode = ModeNone
+	case ModeHash:
+	case ModeTruncate:
+		if conf.Length < 0 {
+			return nil, fmt.Errorf("redaction length must not be negative")
+		}
+	default:
+		return nil, fmt.Errorf("unknown redaction mode: %s", conf.Mode)
+	}
+	return r, nil
+}
+
+// Enabled returns whether identifiers are redacted
+func (r *Redactor) Enabled() bool {
+	return r.mode != ModeNone
+}
+
+// ID redacts the identifier. Hashed identifiers are bracketed and truncated ones are followed by asterisks,
+// neither of which identifiers can contain, so redacted identifiers can't be mistaken for real ones.
+func (r *Redactor) ID(id string) string {
+	if id == "" || id == wildcard {
+		return id
+	}
+	switch r.mode {
+	case ModeHash:
+		mac := hmac.New(sha256.New, r.salt)
+		mac.Write([]byte(id))
+		return "[" + hex.EncodeToString(mac.Sum(nil)[:hashSize]) + "]"
+	case ModeTruncate:
+		// Identifiers no longer than the kept characters are hidden entirely.
+		if len(id) <= r.length {
+			return "***"
+		}
+		return id[:r.length] + "***"
+	default:
+		return id
+	}
+}
+
+// IDs redacts the identifiers
+func (r *Redactor) IDs(ids []string) []string {
+	redacted := make([]string, len(ids))
+	for i, id := range ids {
+		redacted[i] = r.ID(id)
+	}
+	return redacted
+}
+
+// Text redacts the identifiers of the type:id references in the text, e.g. user:1 or doc:1#viewer@user:1
+func (r *Redactor) Text(text string) string {
+	if !r.Enabled() || !strings.Contains(text, ":") {
+		return text
+	}
+	return reference.ReplaceAllStringFunc(text, func(match string) string {
+		typ, id, _ := strings.Cut(match, ":")
+		return typ + ":" + r.ID(id)
+	})
+}
+
+// Message returns a copy of the tuple, attribute, entity or subject with its identifiers redacted, or nil for
+// other messages.
+func (r *Redactor) Message(m proto.Message) proto.Message {
+	switch v := m.(type) {
+	case *base.Tuple:
+		t := proto.Clone(v).(*base.Tuple)
+		if t.GetEntity() != nil {
+			t.Entity.Id = r.ID(t.GetEntity().GetId())
+		}
+		if t.GetSubject() != nil {
+			t.Subject.Id = r.ID(t.GetSubject().GetId())
+		}
+		return t
+	case *base.Attribute:
+		a := proto.Clone(v).(*base.Attribute)
+		if a.GetEntity() != nil {
+			a.Entity.Id = r.ID(a.GetEntity().GetId())
+		}
+		return a
+	case *base.Entity:
+		e := proto.Clone(v).(*base.Entity)
+		e.Id = r.ID(e.GetId())
+		return e
+	case *base.Subject:
+		s := proto.Clone(v).(*base.Subject)
+		s.Id = r.ID(s.GetId())
+		return s
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,309 @@
+package relationmigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// maxTransactionItems is the maximum number of operations, and of relationships written, of a transaction
+const maxTransactionItems = 100
+
+// Migration - Rename of a relation of an entity type, rewriting the relationships of the relation and the ones whose
+// subjects are sets of the relation, e.g. team:1#member
+type Migration struct {
+	TenantID   string
+	EntityType string
+	From       string
+	To         string
+	// BatchSize is the number of relationships read at once
+	BatchSize int
+	// DeleteSource is whether the relationships of the old relation are deleted as they are rewritten. Otherwise
+	// they are copied, so that both relations hold the relationships while the schema and the clients move to the
+	// new relation.
+	DeleteSource bool
+}
+
+// Progress - State of a migration, saved after every batch so that an interrupted migration resumes where it stopped
+type Progress struct {
+	TenantID     string `json:"tenant_id"`
+	EntityType   string `json:"entity_type"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+	DeleteSource bool   `json:"delete_source"`
+	// Types are the entity types of the schema, whose relationships may have subjects that are sets of the relation
+	Types []string `json:"types"`
+	// Filter is the index of the filter being migrated, the relationships of the relation first, followed by the
+	// relationships of each of the types whose subjects are sets of the relation
+	Filter int `json:"filter"`
+	// ContinuousToken is the token of the next batch of the filter being copied
+	ContinuousToken string `json:"continuous_token,omitempty"`
+	// Migrated is the number of relationships rewritten so far
+	Migrated int  `json:"migrated"`
+	Done     bool `json:"done"`
+}
+
+// NewProgress - Creates the progress of a migration that has not started
+func NewProgress(m Migration) *Progress {
+	return &Progress{
+		TenantID:     m.TenantID,
+		EntityType:   m.EntityType,
+		From:         m.From,
+		To:           m.To,
+		DeleteSource: m.DeleteSource,
+	}
+}
+
+// Filters - Returns the number of filters of the migration, known once it has started
+func (p *Progress) Filters() int {
+	return len(p.Types) + 1
+}
+
+// Run - Runs the migration from its progress, reporting the progress after every batch. A migration stops at the
+// first error, including the ones report returns, and resumes from the last progress reported.
+func Run(ctx context.Context, schema base.SchemaClient, data base.DataClient, m Migration, progress *Progress, report func(*Progress) error) error {
+	if m.From == m.To {
+		return errors.New("the relation is renamed to itself")
+	}
+	if m.BatchSize < 1 || m.BatchSize > maxTransactionItems {
+		return fmt.Errorf("the batch size must be between 1 and %d", maxTransactionItems)
+	}
+	if progress.TenantID != m.TenantID || progress.EntityType != m.EntityType || progress.From != m.From || progress.To != m.To || progress.DeleteSource != m.DeleteSource {
+		return errors.New("the progress belongs to another migration")
+	}
+	if progress.Done {
+		return nil
+	}
+
+	response, err := schema.Read(ctx, &base.SchemaReadRequest{
+		TenantId: m.TenantID,
+		Metadata: &base.SchemaReadRequestMetadata{},
+	})
+	if err != nil {
+		return err
+	}
+	definition, ok := response.GetSchema().GetEntityDefinitions()[m.EntityType]
+	if !ok {
+		return fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), m.EntityType)
+	}
+	if _, ok := definition.GetRelations()[m.To]; !ok {
+		return fmt.Errorf("%s: %s#%s", base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(), m.EntityType, m.To)
+	}
+
+	// The entity types are fixed when the migration starts, so that the filters don't change when it resumes
+	if progress.Types == nil {
+		progress.Types = []string{}
+		for name := range response.GetSchema().GetEntityDefinitions() {
+			progress.Types = append(progress.Types, name)
+		}
+		sort.Strings(progress.Types)
+	}
+
+	for ; progress.Filter < progress.Filters(); progress.Filter++ {
+		filter := m.filter(progress)
+		for {
+			var more bool
+			if m.DeleteSource {
+				more, err = m.move(ctx, data, filter, progress)
+			} else {
+				more, err = m.copy(ctx, data, filter, progress)
+			}
+			if err != nil {
+				return err
+			}
+			if !more {
+				break
+			}
+			if err = report(progress); err != nil {
+				return err
+			}
+		}
+		progress.ContinuousToken = ""
+	}
+
+	progress.Done = true
+	return report(progress)
+}
+
+// filter returns the filter of the relationships the progress is migrating.
+func (m Migration) filter(progress *Progress) *base.TupleFilter {
+	if progress.Filter == 0 {
+		return &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: m.EntityType},
+			Relation: m.From,
+		}
+	}
+	return &base.TupleFilter{
+		Entity:  &base.EntityFilter{Type: progress.Types[progress.Filter-1]},
+		Subject: &base.SubjectFilter{Type: m.EntityType, Relation: m.From},
+	}
+}
+
+// rename returns the relationship with the relation renamed, on its entity and its subject.
+func (m Migration) rename(t *base.Tuple) *base.Tuple {
+	renamed := proto.Clone(t).(*base.Tuple)
+	if renamed.GetEntity().GetType() == m.EntityType && renamed.GetRelation() == m.From {
+		renamed.Relation = m.To
+	}
+	if renamed.GetSubject().GetType() == m.EntityType && renamed.GetSubject().GetRelation() == m.From {
+		renamed.Subject.Relation = m.To
+	}
+	return renamed
+}
+
+// copy writes the renamed copies of the next batch of relationships matching the filter, returning whether there
+// was one.
+func (m Migration) copy(ctx context.Context, data base.DataClient, filter *base.TupleFilter, progress *Progress) (bool, error) {
+	response, err := data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+		TenantId:        m.TenantID,
+		Metadata:        &base.RelationshipReadRequestMetadata{},
+		Filter:          filter,
+		PageSize:        uint32(m.BatchSize),
+		ContinuousToken: progress.ContinuousToken,
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(response.GetTuples()) == 0 {
+		return false, nil
+	}
+
+	request := &base.RelationshipWriteRequest{
+		TenantId: m.TenantID,
+		Metadata: &base.RelationshipWriteRequestMetadata{},
+	}
+	for _, t := range response.GetTuples() {
+		request.Tuples = append(request.Tuples, m.rename(t))
+	}
+	if _, err = data.WriteRelationships(ctx, request); err != nil {
+		return false, err
+	}
+
+	progress.Migrated += len(response.GetTuples())
+	progress.ContinuousToken = response.GetContinuousToken()
+	if progress.ContinuousToken == "" {
+		// The last batch of the filter, reported as the start of the next filter
+		return false, nil
+	}
+	return true, nil
+}
+
+// move replaces the next batch of relationships matching the filter with their renamed copies in a transaction,
+// returning whether there was one. Since the moved relationships no longer match the filter, batches are always read
+// from the start.
+func (m Migration) move(ctx context.Context, data base.DataClient, filter *base.TupleFilter, progress *Progress) (bool, error) {
+	response, err := data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+		TenantId: m.TenantID,
+		Metadata: &base.RelationshipReadRequestMetadata{},
+		Filter:   filter,
+		PageSize: uint32(m.BatchSize),
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(response.GetTuples()) == 0 {
+		return false, nil
+	}
+
+	var deletions []*base.DataOperation
+	write := &base.DataOperationWrite{}
+	written := map[string]struct{}{}
+	for _, t := range response.GetTuples() {
+		// The relationships moved along with another one of the batch are skipped
+		if _, ok := written[tuple.ToString(m.rename(t))]; ok {
+			continue
+		}
+		deletion := exact(t)
+
+		// A relationship without a subject relation cannot be deleted alone, its filter matching the relationships
+		// of every subject relation, which are all moved along with it
+		group := []*base.Tuple{t}
+		if t.GetSubject().GetRelation() == "" || t.GetSubject().GetRelation() == tuple.ELLIPSIS {
+			group, err = read(ctx, data, m.TenantID, deletion)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		var tuples []*base.Tuple
+		for _, g := range group {
+			renamed := m.rename(g)
+			if _, ok := written[tuple.ToString(renamed)]; ok {
+				continue
+			}
+			tuples = append(tuples, renamed)
+		}
+		if len(deletions) > 0 && (len(deletions)+2 > maxTransactionItems || len(write.Tuples)+len(tuples) > maxTransactionItems) {
+			break
+		}
+
+		deletions = append(deletions, &base.DataOperation{Type: &base.DataOperation_Delete{Delete: &base.DataOperationDelete{
+			TupleFilter:     deletion,
+			AttributeFilter: &base.AttributeFilter{},
+		}}})
+		for _, renamed := range tuples {
+			written[tuple.ToString(renamed)] = struct{}{}
+			write.Tuples = append(write.Tuples, renamed)
+		}
+	}
+
+	_, err = data.RunTransaction(ctx, &base.DataTransactionRequest{
+		TenantId:   m.TenantID,
+		Metadata:   &base.DataTransactionRequestMetadata{},
+		Operations: append(deletions, &base.DataOperation{Type: &base.DataOperation_Write{Write: write}}),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	progress.Migrated += len(write.GetTuples())
+	return true, nil
+}
+
+// exact returns the filter matching the relationship. A relationship without a subject relation cannot be matched
+// exactly, its filter matching the relationships of every subject relation.
+func exact(t *base.Tuple) *base.TupleFilter {
+	relation := t.GetSubject().GetRelation()
+	if relation == tuple.ELLIPSIS {
+		relation = ""
+	}
+	return &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: t.GetEntity().GetType(), Ids: []string{t.GetEntity().GetId()}},
+		Relation: t.GetRelation(),
+		Subject: &base.SubjectFilter{
+			Type:     t.GetSubject().GetType(),
+			Ids:      []string{t.GetSubject().GetId()},
+			Relation: relation,
+		},
+	}
+}
+
+// read reads all the relationships matching the filter.
+func read(ctx context.Context, data base.DataClient, tenantID string, filter *base.TupleFilter) ([]*base.Tuple, error) {
+	var tuples []*base.Tuple
+	token := ""
+	for {
+		response, err := data.ReadRelationships(ctx, &base.RelationshipReadRequest{
+			TenantId:        tenantID,
+			Metadata:        &base.RelationshipReadRequestMetadata{},
+			Filter:          filter,
+			PageSize:        maxTransactionItems,
+			ContinuousToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, response.GetTuples()...)
+
+		token = response.GetContinuousToken()
+		if token == "" {
+			return tuples, nil
+		}
+	}
+}
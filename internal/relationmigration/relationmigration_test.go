@@ -0,0 +1,219 @@
+package relationmigration
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	storageContext "github.com/Permify/permify/internal/storage/context"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/schema"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TestRelationMigration -
+func TestRelationMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "relation-migration-suite")
+}
+
+// schemas - Schema client reading a fixed schema
+type schemas struct {
+	base.SchemaClient
+
+	definition *base.SchemaDefinition
+}
+
+func (s *schemas) Read(_ context.Context, _ *base.SchemaReadRequest, _ ...grpc.CallOption) (*base.SchemaReadResponse, error) {
+	return &base.SchemaReadResponse{Schema: s.definition}, nil
+}
+
+// data - Data client storing the relationships of a tenant in a list, paginated by offset
+type data struct {
+	base.DataClient
+
+	tuples       []*base.Tuple
+	transactions int
+}
+
+func (d *data) query(filter *base.TupleFilter) []*base.Tuple {
+	it, err := storageContext.NewContextualTuples(d.tuples...).QueryRelationships(filter)
+	Expect(err).ShouldNot(HaveOccurred())
+	var tuples []*base.Tuple
+	for it.HasNext() {
+		tuples = append(tuples, it.GetNext())
+	}
+	return tuples
+}
+
+func (d *data) ReadRelationships(_ context.Context, in *base.RelationshipReadRequest, _ ...grpc.CallOption) (*base.RelationshipReadResponse, error) {
+	tuples := d.query(in.GetFilter())
+	offset := 0
+	if in.GetContinuousToken() != "" {
+		offset, _ = strconv.Atoi(in.GetContinuousToken())
+	}
+	end := offset + int(in.GetPageSize())
+	if end >= len(tuples) {
+		return &base.RelationshipReadResponse{Tuples: tuples[offset:]}, nil
+	}
+	return &base.RelationshipReadResponse{Tuples: tuples[offset:end], ContinuousToken: strconv.Itoa(end)}, nil
+}
+
+func (d *data) write(tuples []*base.Tuple) {
+	stored := map[string]struct{}{}
+	for _, t := range d.tuples {
+		stored[tuple.ToString(t)] = struct{}{}
+	}
+	for _, t := range tuples {
+		if _, ok := stored[tuple.ToString(t)]; !ok {
+			stored[tuple.ToString(t)] = struct{}{}
+			d.tuples = append(d.tuples, t)
+		}
+	}
+}
+
+func (d *data) WriteRelationships(_ context.Context, in *base.RelationshipWriteRequest, _ ...grpc.CallOption) (*base.RelationshipWriteResponse, error) {
+	d.write(in.GetTuples())
+	return &base.RelationshipWriteResponse{}, nil
+}
+
+func (d *data) RunTransaction(_ context.Context, in *base.DataTransactionRequest, _ ...grpc.CallOption) (*base.DataTransactionResponse, error) {
+	d.transactions++
+	for _, operation := range in.GetOperations() {
+		if deletion := operation.GetDelete(); deletion != nil {
+			deleted := map[string]struct{}{}
+			for _, t := range d.query(deletion.GetTupleFilter()) {
+				deleted[tuple.ToString(t)] = struct{}{}
+			}
+			var kept []*base.Tuple
+			for _, t := range d.tuples {
+				if _, ok := deleted[tuple.ToString(t)]; !ok {
+					kept = append(kept, t)
+				}
+			}
+			d.tuples = kept
+		}
+	}
+	for _, operation := range in.GetOperations() {
+		d.write(operation.GetWrite().GetTuples())
+	}
+	return &base.DataTransactionResponse{}, nil
+}
+
+var _ = Describe("relation migration", func() {
+	definition := schema.Schema(schema.Entities(
+		schema.Entity("user", nil, nil, nil),
+		schema.Entity("team", schema.Relations(
+			schema.Relation("member", schema.Reference("user")),
+			schema.Relation("participant", schema.Reference("user")),
+		), nil, nil),
+		schema.Entity("doc", schema.Relations(
+			schema.Relation("viewer", schema.Reference("user"), schema.Reference("team#member"), schema.Reference("team#participant")),
+		), nil, nil),
+	), nil)
+
+	tuples := func(strs ...string) []*base.Tuple {
+		var tuples []*base.Tuple
+		for _, str := range strs {
+			t, err := tuple.Tuple(str)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		return tuples
+	}
+	relationships := func(d *data) []string {
+		var strs []string
+		for _, t := range d.tuples {
+			strs = append(strs, tuple.ToString(t))
+		}
+		return strs
+	}
+
+	It("Copies the relationships in batches, resuming from the last progress reported", func() {
+		d := &data{tuples: tuples(
+			"team:1#member@user:1",
+			"team:1#member@user:2",
+			"team:2#member@user:1",
+			"doc:1#viewer@team:1#member",
+			"doc:1#viewer@user:3",
+		)}
+		m := Migration{TenantID: "t1", EntityType: "team", From: "member", To: "participant", BatchSize: 2}
+
+		// The migration is interrupted after its first batch
+		var saved Progress
+		interrupted := errors.New("interrupted")
+		err := Run(context.Background(), &schemas{definition: definition}, d, m, NewProgress(m), func(p *Progress) error {
+			saved = *p
+			return interrupted
+		})
+		Expect(err).Should(Equal(interrupted))
+		Expect(saved.Migrated).Should(Equal(2))
+
+		reports := 0
+		err = Run(context.Background(), &schemas{definition: definition}, d, m, &saved, func(p *Progress) error {
+			reports++
+			return nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(saved.Done).Should(BeTrue())
+		Expect(saved.Migrated).Should(Equal(4))
+		Expect(reports).Should(Equal(1))
+
+		// Both relations hold the relationships until the source is deleted
+		Expect(relationships(d)).Should(ConsistOf(
+			"team:1#member@user:1",
+			"team:1#member@user:2",
+			"team:2#member@user:1",
+			"doc:1#viewer@team:1#member",
+			"doc:1#viewer@user:3",
+			"team:1#participant@user:1",
+			"team:1#participant@user:2",
+			"team:2#participant@user:1",
+			"doc:1#viewer@team:1#participant",
+		))
+
+		// A finished migration is not run again
+		Expect(Run(context.Background(), &schemas{definition: definition}, d, m, &saved, nil)).Should(Succeed())
+	})
+
+	It("Moves the relationships in transactions, along with the ones their filters match", func() {
+		d := &data{tuples: tuples(
+			"doc:1#viewer@team:1",
+			"doc:1#viewer@user:1",
+			"doc:1#viewer@team:1#member",
+			"doc:2#viewer@user:1",
+			"team:1#member@user:1",
+		)}
+		m := Migration{TenantID: "t1", EntityType: "doc", From: "viewer", To: "reader", BatchSize: 1, DeleteSource: true}
+
+		// The new relation must be defined by the schema
+		Expect(Run(context.Background(), &schemas{definition: definition}, d, m, NewProgress(m), func(*Progress) error { return nil })).ShouldNot(Succeed())
+
+		transition := schema.Schema(schema.Entities(
+			schema.Entity("user", nil, nil, nil),
+			schema.Entity("team", schema.Relations(schema.Relation("member", schema.Reference("user"))), nil, nil),
+			schema.Entity("doc", schema.Relations(
+				schema.Relation("viewer", schema.Reference("user"), schema.Reference("team"), schema.Reference("team#member")),
+				schema.Relation("reader", schema.Reference("user"), schema.Reference("team"), schema.Reference("team#member")),
+			), nil, nil),
+		), nil)
+
+		progress := NewProgress(m)
+		Expect(Run(context.Background(), &schemas{definition: transition}, d, m, progress, func(*Progress) error { return nil })).Should(Succeed())
+		Expect(progress.Migrated).Should(Equal(4))
+		// The relationship with the team as a subject is moved along with the one with the members of the team
+		Expect(d.transactions).Should(Equal(3))
+		Expect(relationships(d)).Should(ConsistOf(
+			"doc:1#reader@team:1",
+			"doc:1#reader@user:1",
+			"doc:1#reader@team:1#member",
+			"doc:2#reader@user:1",
+			"team:1#member@user:1",
+		))
+	})
+})
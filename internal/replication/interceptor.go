@@ -0,0 +1,176 @@
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// snapTokenField is the name of the fields of the requests and responses holding snapshot tokens
+	snapTokenField = "snap_token"
+	// tenantIDField is the name of the field of the requests holding the tenant
+	tenantIDField = "tenant_id"
+)
+
+// writeMethods are the methods writing data, schemas or tenants, accepted only in the write region.
+var writeMethods = map[string]struct{}{
+	base.Data_Write_FullMethodName:               {},
+	base.Data_WriteRelationships_FullMethodName:  {},
+	base.Data_Delete_FullMethodName:              {},
+	base.Data_DeleteRelationships_FullMethodName: {},
+	base.Data_RunTransaction_FullMethodName:      {},
+	base.Schema_Write_FullMethodName:             {},
+	base.Schema_ApplyBundle_FullMethodName:       {},
+	base.Schema_Migrate_FullMethodName:           {},
+	base.Tenancy_Create_FullMethodName:           {},
+	base.Tenancy_Delete_FullMethodName:           {},
+}
+
+// Interceptor - Applies the replication mode of a region to its API. Writes are accepted in the write region only,
+// snapshot tokens of responses are labeled with the region, and labeled tokens of requests are read at the local
+// snapshot they are replicated to.
+type Interceptor struct {
+	region      string
+	writeRegion string
+	progress    *Progress
+	// timeout is the maximum duration to wait for the snapshots of the write region to be replicated
+	timeout time.Duration
+}
+
+// NewInterceptor - Creates new Interceptor of the region, reading the progress of the replication of the write
+// region in the other regions, for up to timeout.
+func NewInterceptor(region, writeRegion string, progress *Progress, timeout time.Duration) *Interceptor {
+	return &Interceptor{
+		region:      region,
+		writeRegion: writeRegion,
+		progress:    progress,
+		timeout:     timeout,
+	}
+}
+
+// Unary - Returns the unary server interceptor
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := writeMethods[info.FullMethod]; ok && i.region != i.writeRegion {
+			return nil, status.Errorf(codes.FailedPrecondition, "writes are accepted in region %s only, this is region %s", i.writeRegion, i.region)
+		}
+
+		if m, ok := req.(proto.Message); ok {
+			if err := i.unlabel(ctx, m.ProtoReflect(), tenantID(m.ProtoReflect())); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if m, ok := resp.(proto.Message); ok {
+			i.label(m.ProtoReflect())
+		}
+		return resp, nil
+	}
+}
+
+// unlabel replaces the labeled snapshot tokens of the request with the local tokens they are read at.
+func (i *Interceptor) unlabel(ctx context.Context, m protoreflect.Message, tenantID string) error {
+	for _, fd := range snapTokenFields(m) {
+		snap, err := i.local(ctx, tenantID, m.Get(fd).String())
+		if err != nil {
+			return err
+		}
+		m.Set(fd, protoreflect.ValueOfString(snap))
+	}
+	for _, child := range messages(m) {
+		if err := i.unlabel(ctx, child, tenantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// local returns the local snapshot token the snapshot token of the request is read at.
+func (i *Interceptor) local(ctx context.Context, tenantID, labeled string) (string, error) {
+	region, snap, ok := Unlabel(labeled)
+	switch {
+	case !ok, region == i.region:
+		// Tokens without a label are local, as they were before replication
+		return snap, nil
+	case region == i.writeRegion:
+		ctx, cancel := context.WithTimeout(ctx, i.timeout)
+		defer cancel()
+
+		local, err := i.progress.Local(ctx, tenantID, snap)
+		if errors.Is(err, ErrNotReplicated) {
+			return "", status.Errorf(codes.Unavailable, "snapshot of region %s isn't replicated to region %s yet", region, i.region)
+		}
+		if err != nil {
+			return "", status.Error(codes.InvalidArgument, fmt.Sprintf("invalid snapshot token: %s", err))
+		}
+		return local, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "snapshot token of region %s can't be read in region %s", region, i.region)
+	}
+}
+
+// label labels the snapshot tokens of the response with the region.
+func (i *Interceptor) label(m protoreflect.Message) {
+	for _, fd := range snapTokenFields(m) {
+		snap := m.Get(fd).String()
+		if _, _, ok := Unlabel(snap); !ok {
+			m.Set(fd, protoreflect.ValueOfString(Label(i.region, snap)))
+		}
+	}
+	for _, child := range messages(m) {
+		i.label(child)
+	}
+}
+
+// snapTokenFields returns the set snapshot token fields of the message.
+func snapTokenFields(m protoreflect.Message) (fields []protoreflect.FieldDescriptor) {
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.StringKind && !fd.IsList() && string(fd.Name()) == snapTokenField {
+			fields = append(fields, fd)
+		}
+		return true
+	})
+	return fields
+}
+
+// messages returns the set message fields of the message, along with the messages of its list fields.
+func messages(m protoreflect.Message) (children []protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			for j := 0; j < v.List().Len(); j++ {
+				children = append(children, v.List().Get(j).Message())
+			}
+			return true
+		}
+		children = append(children, v.Message())
+		return true
+	})
+	return children
+}
+
+// tenantID returns the tenant of the request, if it has one.
+func tenantID(m protoreflect.Message) string {
+	fd := m.Descriptor().Fields().ByName(tenantIDField)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return m.Get(fd).String()
+}
@@ -0,0 +1,81 @@
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestInterceptor_Unary(t *testing.T) {
+	progress := NewProgress(10, nil)
+	progress.Applied("t1", "w-10", "r-7")
+
+	replica := NewInterceptor("eu", "us", progress, 10*time.Millisecond).Unary()
+
+	t.Run("writes are rejected outside the write region", func(t *testing.T) {
+		_, err := replica(context.Background(), &base.DataWriteRequest{TenantId: "t1"}, &grpc.UnaryServerInfo{FullMethod: base.Data_Write_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler called")
+			return nil, nil
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("tokens of the write region are read at their local snapshot", func(t *testing.T) {
+		req := &base.PermissionCheckRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionCheckRequestMetadata{SnapToken: Label("us", "w-10")},
+		}
+		resp, err := replica(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: base.Permission_Check_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			assert.Equal(t, "r-7", req.(*base.PermissionCheckRequest).GetMetadata().GetSnapToken())
+			return &base.DataWriteResponse{SnapToken: "r-8"}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, Label("eu", "r-8"), resp.(*base.DataWriteResponse).GetSnapToken())
+	})
+
+	t.Run("tokens of the write region not replicated yet are unavailable", func(t *testing.T) {
+		req := &base.PermissionCheckRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionCheckRequestMetadata{SnapToken: Label("us", "w-11")},
+		}
+		_, err := replica(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: base.Permission_Check_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler called")
+			return nil, nil
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("tokens of the region and unlabeled tokens are local", func(t *testing.T) {
+		for _, snap := range []string{Label("eu", "r-3"), "r-3"} {
+			req := &base.PermissionCheckRequest{
+				TenantId: "t1",
+				Metadata: &base.PermissionCheckRequestMetadata{SnapToken: snap},
+			}
+			_, err := replica(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: base.Permission_Check_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				assert.Equal(t, "r-3", req.(*base.PermissionCheckRequest).GetMetadata().GetSnapToken())
+				return &base.PermissionCheckResponse{}, nil
+			})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("tokens of other regions are invalid", func(t *testing.T) {
+		req := &base.PermissionCheckRequest{
+			TenantId: "t1",
+			Metadata: &base.PermissionCheckRequestMetadata{SnapToken: Label("ap", "a-1")},
+		}
+		_, err := replica(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: base.Permission_Check_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler called")
+			return nil, nil
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
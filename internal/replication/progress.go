@@ -0,0 +1,210 @@
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Permify/permify/pkg/token"
+)
+
+// ErrNotReplicated is the error waiting for a snapshot of the write region ends with when it isn't replicated in time.
+var ErrNotReplicated = errors.New("snapshot isn't replicated yet")
+
+// Progress - Changes of the write region applied to the local database of a region, by tenant. It maps the snapshot
+// tokens of the write region to the local ones, so requests with the tokens of their writes read them in every region.
+type Progress struct {
+	// decode decodes the snapshot tokens of the write region to order them, nil if they are opaque
+	decode func(value string) (token.SnapToken, error)
+	// historySize is the number of applied changes kept by tenant to map opaque tokens
+	historySize int
+
+	mu      sync.Mutex
+	tenants map[string]*tenantProgress
+}
+
+// tenantProgress - Changes of the write region applied for a tenant
+type tenantProgress struct {
+	// applied is the last changes applied, oldest first
+	applied []applied
+	// notify is closed once a change is applied
+	notify chan struct{}
+}
+
+// applied - Change of the write region applied to the local database
+type applied struct {
+	source string
+	local  string
+}
+
+// NewProgress - Creates new Progress keeping historySize changes by tenant. Tokens of the write region are ordered
+// with decode, or only mapped while their changes are kept if it is nil.
+func NewProgress(historySize int, decode func(value string) (token.SnapToken, error)) *Progress {
+	if historySize < 1 {
+		historySize = 1
+	}
+	return &Progress{
+		decode:      decode,
+		historySize: historySize,
+		tenants:     map[string]*tenantProgress{},
+	}
+}
+
+// tenant returns the progress of the tenant, called with the lock held.
+func (p *Progress) tenant(tenantID string) *tenantProgress {
+	t, ok := p.tenants[tenantID]
+	if !ok {
+		t = &tenantProgress{notify: make(chan struct{})}
+		p.tenants[tenantID] = t
+	}
+	return t
+}
+
+// Applied records that the change of the snapshot of the write region is applied to the local database at the
+// local snapshot. The local snapshot is empty if it isn't known, e.g. for progress loaded after a restart.
+func (p *Progress) Applied(tenantID, source, local string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t := p.tenant(tenantID)
+	t.applied = append(t.applied, applied{source: source, local: local})
+	if len(t.applied) > p.historySize {
+		t.applied = append([]applied(nil), t.applied[len(t.applied)-p.historySize:]...)
+	}
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+// Last returns the snapshot of the write region of the last change applied for the tenant, empty if none is.
+func (p *Progress) Last(tenantID string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t, ok := p.tenants[tenantID]
+	if !ok || len(t.applied) == 0 {
+		return ""
+	}
+	return t.applied[len(t.applied)-1].source
+}
+
+// Local returns the local snapshot the changes of the tenant up to the snapshot of the write region are read at,
+// waiting for them to be applied until the context is done. The snapshot is empty when the latest one reads them.
+func (p *Progress) Local(ctx context.Context, tenantID, source string) (string, error) {
+	for {
+		p.mu.Lock()
+		t := p.tenant(tenantID)
+		local, ok, err := p.find(t, source)
+		notify := t.notify
+		p.mu.Unlock()
+
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return local, nil
+		}
+
+		select {
+		case <-notify:
+		case <-ctx.Done():
+			return "", ErrNotReplicated
+		}
+	}
+}
+
+// isApplied returns whether the change of the snapshot of the write region is applied for the tenant.
+func (p *Progress) isApplied(tenantID, source string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok, err := p.find(p.tenant(tenantID), source)
+	return ok, err
+}
+
+// find returns the local snapshot the changes up to the snapshot of the write region are read at, if they are
+// applied. Changes applied before the last one are read at its snapshot as well. It is called with the lock held.
+func (p *Progress) find(t *tenantProgress, source string) (string, bool, error) {
+	if len(t.applied) == 0 {
+		return "", false, nil
+	}
+	last := t.applied[len(t.applied)-1]
+
+	if p.decode == nil {
+		for i := len(t.applied) - 1; i >= 0; i-- {
+			if t.applied[i].source == source {
+				return last.local, true, nil
+			}
+		}
+		return "", false, nil
+	}
+
+	requested, err := p.decode(source)
+	if err != nil {
+		return "", false, err
+	}
+	current, err := p.decode(last.source)
+	if err != nil {
+		return "", false, err
+	}
+	if requested.Gt(current) {
+		return "", false, nil
+	}
+	return last.local, true, nil
+}
+
+// Load loads the progress saved at the path, if any. The local snapshots of the loaded changes aren't known, so
+// they are read at the latest one.
+func (p *Progress) Load(path string) error {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var last map[string]string
+	if err = json.Unmarshal(b, &last); err != nil {
+		return err
+	}
+	for tenantID, source := range last {
+		p.Applied(tenantID, source, "")
+	}
+	return nil
+}
+
+// Save saves the snapshot of the write region of the last change applied for each tenant at the path, replacing
+// the file at once so it isn't left half written.
+func (p *Progress) Save(path string) error {
+	p.mu.Lock()
+	last := make(map[string]string, len(p.tenants))
+	for tenantID, t := range p.tenants {
+		if len(t.applied) > 0 {
+			last[tenantID] = t.applied[len(t.applied)-1].source
+		}
+	}
+	p.mu.Unlock()
+
+	b, err := json.Marshal(last)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,299 @@
+package replication
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Replicator - Applies the changes of tenants in the write region to the local database, reading them from the
+// watch service of the write region. The write region wins conflicts: changes that don't match the local data,
+// such as deletions of data missing locally, are applied as far as they can be and reported.
+type Replicator struct {
+	client base.WatchClient
+	dr     storage.DataReader
+	dw     storage.DataWriter
+
+	progress *Progress
+	tenants  []string
+
+	// statePath is the file the progress is saved to, none if empty
+	statePath string
+	// retryInterval is the interval between the watches of a tenant after one ends
+	retryInterval time.Duration
+}
+
+// ReplicatorOption - Option of the replicator
+type ReplicatorOption func(replicator *Replicator)
+
+// StatePath - Saves the progress to the file at the path after every change, and resumes from it
+func StatePath(path string) ReplicatorOption {
+	return func(r *Replicator) {
+		r.statePath = path
+	}
+}
+
+// RetryInterval - Interval between the watches of a tenant after one ends
+func RetryInterval(interval time.Duration) ReplicatorOption {
+	return func(r *Replicator) {
+		r.retryInterval = interval
+	}
+}
+
+// NewReplicator - Creates new Replicator of the tenants, recording the changes applied to the progress
+func NewReplicator(client base.WatchClient, dr storage.DataReader, dw storage.DataWriter, progress *Progress, tenants []string, opts ...ReplicatorOption) *Replicator {
+	r := &Replicator{
+		client:        client,
+		dr:            dr,
+		dw:            dw,
+		progress:      progress,
+		tenants:       tenants,
+		retryInterval: 5 * time.Second,
+	}
+
+	// options
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Start replicates the tenants until the context is done, watching them again after their watches end.
+func (r *Replicator) Start(ctx context.Context) error {
+	if r.statePath != "" {
+		if err := r.progress.Load(r.statePath); err != nil {
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, tenantID := range r.tenants {
+		wg.Add(1)
+		go func(tenantID string) {
+			defer wg.Done()
+			for {
+				err := r.replicate(ctx, tenantID)
+				if ctx.Err() != nil {
+					return
+				}
+				slog.Warn("replication of tenant ended, retrying", slog.String("tenant_id", tenantID), slog.Any("error", err))
+
+				select {
+				case <-time.After(r.retryInterval):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(tenantID)
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+// replicate applies the changes of the tenant after the last one applied until the watch ends.
+func (r *Replicator) replicate(ctx context.Context, tenantID string) error {
+	stream, err := r.client.Watch(ctx, &base.WatchRequest{
+		TenantId:  tenantID,
+		SnapToken: r.progress.Last(tenantID),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if response.GetKeepalive() {
+			continue
+		}
+		if response.GetDropped() > 0 {
+			// The write region dropped changes, watch again from the last change applied to read them.
+			return errors.New("changes were dropped by the write region")
+		}
+
+		if err = r.Apply(ctx, tenantID, response.GetChanges()); err != nil {
+			return err
+		}
+	}
+}
+
+// Apply applies the changes of the tenant made at a snapshot of the write region to the local database.
+func (r *Replicator) Apply(ctx context.Context, tenantID string, changes *base.DataChanges) error {
+	source := changes.GetSnapToken()
+
+	// Changes at or before the last snapshot applied were applied already, e.g. by a previous watch.
+	ok, err := r.progress.isApplied(tenantID, source)
+	if err != nil {
+		return err
+	}
+	if ok {
+		slog.Warn("replication conflict: change was applied already, skipping it", slog.String("tenant_id", tenantID), slog.String("snap_token", source))
+		return nil
+	}
+
+	local := ""
+	for _, change := range changes.GetDataChanges() {
+		snap, err := r.apply(ctx, tenantID, change)
+		if err != nil {
+			return err
+		}
+		if snap != "" {
+			local = snap
+		}
+	}
+
+	r.progress.Applied(tenantID, source, local)
+	if r.statePath != "" {
+		return r.progress.Save(r.statePath)
+	}
+	return nil
+}
+
+// apply applies the change to the local database, returning the local snapshot it is applied at, if it wrote.
+func (r *Replicator) apply(ctx context.Context, tenantID string, change *base.DataChange) (string, error) {
+	switch {
+	case change.GetTuple() != nil:
+		return r.applyTuple(ctx, tenantID, change.GetOperation(), change.GetTuple())
+	case change.GetAttribute() != nil:
+		return r.applyAttribute(ctx, tenantID, change.GetOperation(), change.GetAttribute())
+	default:
+		return "", nil
+	}
+}
+
+// applyTuple creates or deletes the tuple. Tuples created already aren't written again, and deleting tuples that
+// don't exist doesn't write.
+func (r *Replicator) applyTuple(ctx context.Context, tenantID string, operation base.DataChange_Operation, tuple *base.Tuple) (string, error) {
+	filter := &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: tuple.GetEntity().GetType(), Ids: []string{tuple.GetEntity().GetId()}},
+		Relation: tuple.GetRelation(),
+		Subject:  &base.SubjectFilter{Type: tuple.GetSubject().GetType(), Ids: []string{tuple.GetSubject().GetId()}, Relation: tuple.GetSubject().GetRelation()},
+	}
+
+	head, err := r.head(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	// Filters without a subject relation match the tuples of every subject relation.
+	it, err := r.dr.QueryRelationships(ctx, tenantID, filter, head)
+	if err != nil {
+		return "", err
+	}
+	var exists bool
+	var others []*base.Tuple
+	for it.HasNext() {
+		t := it.GetNext()
+		if t.GetSubject().GetRelation() == tuple.GetSubject().GetRelation() {
+			exists = true
+		} else {
+			others = append(others, t)
+		}
+	}
+
+	switch operation {
+	case base.DataChange_OPERATION_CREATE:
+		if exists {
+			slog.Warn("replication conflict: created tuple exists already", slog.String("tenant_id", tenantID), slog.Any("tuple", tuple))
+			return "", nil
+		}
+		snap, err := r.dw.Write(ctx, tenantID, database.NewTupleCollection(tuple), database.NewAttributeCollection())
+		if err != nil {
+			return "", err
+		}
+		return snap.String(), nil
+	case base.DataChange_OPERATION_DELETE:
+		if !exists {
+			slog.Warn("replication conflict: deleted tuple doesn't exist", slog.String("tenant_id", tenantID), slog.Any("tuple", tuple))
+			return "", nil
+		}
+		snap, err := r.dw.Delete(ctx, tenantID, filter, &base.AttributeFilter{})
+		if err != nil {
+			return "", err
+		}
+		// Write back the tuples of the other subject relations the filter deleted along with it.
+		if len(others) > 0 {
+			snap, err = r.dw.Write(ctx, tenantID, database.NewTupleCollection(others...), database.NewAttributeCollection())
+			if err != nil {
+				return "", err
+			}
+		}
+		return snap.String(), nil
+	default:
+		return "", nil
+	}
+}
+
+// applyAttribute creates or deletes the attribute. Creating an attribute replaces the value of the attribute of
+// the entity, and deleting attributes that don't exist doesn't write.
+func (r *Replicator) applyAttribute(ctx context.Context, tenantID string, operation base.DataChange_Operation, attribute *base.Attribute) (string, error) {
+	filter := &base.AttributeFilter{
+		Entity:     &base.EntityFilter{Type: attribute.GetEntity().GetType(), Ids: []string{attribute.GetEntity().GetId()}},
+		Attributes: []string{attribute.GetAttribute()},
+	}
+
+	head, err := r.head(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	current, err := r.dr.QuerySingleAttribute(ctx, tenantID, filter, head)
+	if err != nil {
+		return "", err
+	}
+
+	switch operation {
+	case base.DataChange_OPERATION_CREATE:
+		if current != nil {
+			if proto.Equal(current.GetValue(), attribute.GetValue()) {
+				slog.Warn("replication conflict: created attribute exists already", slog.String("tenant_id", tenantID), slog.Any("attribute", attribute))
+				return "", nil
+			}
+			if _, err = r.dw.Delete(ctx, tenantID, &base.TupleFilter{}, filter); err != nil {
+				return "", err
+			}
+		}
+		snap, err := r.dw.Write(ctx, tenantID, database.NewTupleCollection(), database.NewAttributeCollection(attribute))
+		if err != nil {
+			return "", err
+		}
+		return snap.String(), nil
+	case base.DataChange_OPERATION_DELETE:
+		if current == nil {
+			slog.Warn("replication conflict: deleted attribute doesn't exist", slog.String("tenant_id", tenantID), slog.Any("attribute", attribute))
+			return "", nil
+		}
+		snap, err := r.dw.Delete(ctx, tenantID, &base.TupleFilter{}, filter)
+		if err != nil {
+			return "", err
+		}
+		return snap.String(), nil
+	default:
+		return "", nil
+	}
+}
+
+// head returns the latest local snapshot of the tenant.
+func (r *Replicator) head(ctx context.Context, tenantID string) (string, error) {
+	st, err := r.dr.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return st.Encode().String(), nil
+}
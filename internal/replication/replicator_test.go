@@ -0,0 +1,116 @@
+package replication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage/memory/snapshot"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// sourceToken returns the memory snapshot token of the write region at the time.
+func sourceToken(n int64) string {
+	return snapshot.NewToken(time.Unix(0, n)).Encode().String()
+}
+
+func tupleChange(t *testing.T, operation base.DataChange_Operation, value string) *base.DataChange {
+	tup, err := tuple.Tuple(value)
+	require.NoError(t, err)
+	return &base.DataChange{Operation: operation, Type: &base.DataChange_Tuple{Tuple: tup}}
+}
+
+func TestReplicator_Apply(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+	dr := factories.DataReaderFactory(db)
+
+	progress := NewProgress(10, factories.SnapTokenDecoderFactory(db))
+	replicator := NewReplicator(nil, dr, factories.DataWriterFactory(db), progress, []string{"t1"})
+
+	relationships := func() []string {
+		head, err := dr.HeadSnapshot(ctx, "t1")
+		require.NoError(t, err)
+		it, err := dr.QueryRelationships(ctx, "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}, head.Encode().String())
+		require.NoError(t, err)
+		var values []string
+		for it.HasNext() {
+			values = append(values, tuple.ToString(it.GetNext()))
+		}
+		return values
+	}
+
+	err = replicator.Apply(ctx, "t1", &base.DataChanges{
+		SnapToken: sourceToken(10),
+		DataChanges: []*base.DataChange{
+			tupleChange(t, base.DataChange_OPERATION_CREATE, "doc:1#viewer@group:1"),
+			tupleChange(t, base.DataChange_OPERATION_CREATE, "doc:1#viewer@group:1#member"),
+		},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"doc:1#viewer@group:1", "doc:1#viewer@group:1#member"}, relationships())
+	assert.Equal(t, sourceToken(10), progress.Last("t1"))
+
+	// Changes applied already are skipped.
+	err = replicator.Apply(ctx, "t1", &base.DataChanges{
+		SnapToken:   sourceToken(5),
+		DataChanges: []*base.DataChange{tupleChange(t, base.DataChange_OPERATION_CREATE, "doc:2#viewer@group:1")},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"doc:1#viewer@group:1", "doc:1#viewer@group:1#member"}, relationships())
+
+	// Deleting a tuple without a subject relation keeps the tuples of the other subject relations, and deleting
+	// a tuple that doesn't exist is a conflict applied as a no-op.
+	err = replicator.Apply(ctx, "t1", &base.DataChanges{
+		SnapToken: sourceToken(20),
+		DataChanges: []*base.DataChange{
+			tupleChange(t, base.DataChange_OPERATION_DELETE, "doc:1#viewer@group:1"),
+			tupleChange(t, base.DataChange_OPERATION_DELETE, "doc:3#viewer@group:1"),
+		},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"doc:1#viewer@group:1#member"}, relationships())
+	assert.Equal(t, sourceToken(20), progress.Last("t1"))
+}
+
+func TestProgress_Local(t *testing.T) {
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+	progress := NewProgress(10, factories.SnapTokenDecoderFactory(db))
+
+	progress.Applied("t1", sourceToken(10), "local-10")
+
+	// Snapshots up to the last one applied are read at its local snapshot.
+	local, err := progress.Local(context.Background(), "t1", sourceToken(5))
+	require.NoError(t, err)
+	assert.Equal(t, "local-10", local)
+
+	// Later snapshots are waited for.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = progress.Local(ctx, "t1", sourceToken(20))
+	assert.ErrorIs(t, err, ErrNotReplicated)
+
+	go progress.Applied("t1", sourceToken(20), "local-20")
+	local, err = progress.Local(context.Background(), "t1", sourceToken(20))
+	require.NoError(t, err)
+	assert.Equal(t, "local-20", local)
+
+	// Progress is saved and loaded without the local snapshots, which are read at the latest one.
+	path := t.TempDir() + "/replication.json"
+	require.NoError(t, progress.Save(path))
+	loaded := NewProgress(10, nil)
+	require.NoError(t, loaded.Load(path))
+	assert.Equal(t, sourceToken(20), loaded.Last("t1"))
+	local, err = loaded.Local(context.Background(), "t1", sourceToken(20))
+	require.NoError(t, err)
+	assert.Equal(t, "", local)
+}
@@ -0,0 +1,52 @@
+package replication
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// keyCredentials - Authenticates the calls to the write region with a preshared key
+type keyCredentials struct {
+	key    string
+	secure bool
+}
+
+// GetRequestMetadata - Returns the authorization header of the calls
+func (c keyCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.key}, nil
+}
+
+// RequireTransportSecurity - Whether the key is only sent over TLS
+func (c keyCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// DialSource connects to the write region the region replicates.
+func DialSource(conf *config.Replication) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	switch {
+	case conf.TLSConfig.Enabled && conf.TLSConfig.CertPath != "":
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(conf.TLSConfig.CertPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not load TLS certificate: %s", err)
+		}
+	case conf.TLSConfig.Enabled:
+		// Verify the write region with the roots of the system
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+
+	options := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if conf.Key != "" {
+		options = append(options, grpc.WithPerRPCCredentials(keyCredentials{key: conf.Key, secure: conf.TLSConfig.Enabled}))
+	}
+
+	return grpc.Dial(conf.Source, options...)
+}
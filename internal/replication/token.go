@@ -0,0 +1,27 @@
+package replication
+
+import (
+	"strings"
+)
+
+// separator separates the region label of a snapshot token from the token. Encoded snapshot tokens don't contain
+// it, and region labels can't.
+const separator = ":"
+
+// Label labels the snapshot token with the region it was issued in.
+func Label(region, snap string) string {
+	if snap == "" {
+		return ""
+	}
+	return region + separator + snap
+}
+
+// Unlabel returns the region the snapshot token was issued in and the token of the region, or false for tokens
+// without a label.
+func Unlabel(labeled string) (region, snap string, ok bool) {
+	region, snap, ok = strings.Cut(labeled, separator)
+	if !ok {
+		return "", labeled, false
+	}
+	return region, snap, true
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/compiler"
@@ -180,3 +181,44 @@ func IsDirectlyRelated(target *base.RelationDefinition, source *base.RelationRef
 	// If no match is found, return false
 	return false
 }
+
+// GetReferencedDefinitionNames returns the names of the entities the relations of the entity definition reference, and
+// the names of the rules its permissions call, each sorted and without duplicates.
+func GetReferencedDefinitionNames(entityDefinition *base.EntityDefinition) (entities, rules []string) {
+	entitySet := map[string]struct{}{}
+	ruleSet := map[string]struct{}{}
+
+	// Collect the types of the subjects of the relations
+	for _, relation := range entityDefinition.GetRelations() {
+		for _, reference := range relation.GetRelationReferences() {
+			entitySet[reference.GetType()] = struct{}{}
+		}
+	}
+
+	// Collect the rules called in the permissions, walking their rewrites
+	var walk func(child *base.Child)
+	walk = func(child *base.Child) {
+		if rewrite := child.GetRewrite(); rewrite != nil {
+			for _, c := range rewrite.GetChildren() {
+				walk(c)
+			}
+			return
+		}
+		if call := child.GetLeaf().GetCall(); call != nil {
+			ruleSet[call.GetRuleName()] = struct{}{}
+		}
+	}
+	for _, permission := range entityDefinition.GetPermissions() {
+		walk(permission.GetChild())
+	}
+
+	for name := range entitySet {
+		entities = append(entities, name)
+	}
+	for name := range ruleSet {
+		rules = append(rules, name)
+	}
+	sort.Strings(entities)
+	sort.Strings(rules)
+	return entities, rules
+}
@@ -332,4 +332,44 @@ var _ = Describe("schema", func() {
 			}))
 		})
 	})
+
+	Context("GetReferencedDefinitionNames", func() {
+		It("Case 1", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+			entity user {}
+
+			entity organization {
+				relation member @user
+			}
+
+			entity repository {
+				relation parent @organization
+				relation owner @user @organization#member
+
+				attribute public boolean
+				attribute ip_range string[]
+
+				permission view = owner or (parent.member and is_public(public)) or in_range(ip_range)
+				permission edit = owner
+			}
+
+			rule is_public(public boolean) {
+				public == true
+			}
+
+			rule in_range(ip_range string[]) {
+				'127.0.0.1' in ip_range
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			entities, rules := GetReferencedDefinitionNames(sch.GetEntityDefinitions()["repository"])
+			Expect(entities).Should(Equal([]string{"organization", "user"}))
+			Expect(rules).Should(Equal([]string{"in_range", "is_public"}))
+
+			entities, rules = GetReferencedDefinitionNames(sch.GetEntityDefinitions()["user"])
+			Expect(entities).Should(BeEmpty())
+			Expect(rules).Should(BeEmpty())
+		})
+	})
 })
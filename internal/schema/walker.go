@@ -65,7 +65,20 @@ func (w *Walker) Walk(
 		// Otherwise, walk the leaf node
 		return w.WalkLeaf(entityType, child.GetLeaf())
 	case base.EntityDefinition_REFERENCE_RELATION:
-		// If the reference type is a relation, nothing to do, return nil
+		// If the relation is combined with other relations and permissions, its relationships are not enough to
+		// find its subjects, not implemented, return error
+		if def.Relations[permission].GetRewrite() != nil {
+			return ErrUnimplemented
+		}
+		// Otherwise, walk the relations of the subject sets it references, which may be combined with others
+		for _, reference := range def.Relations[permission].GetRelationReferences() {
+			if w.schema.GetEntityDefinitions()[reference.GetType()].GetReferences()[reference.GetRelation()] != base.EntityDefinition_REFERENCE_RELATION {
+				continue
+			}
+			if err := w.Walk(reference.GetType(), reference.GetRelation()); err != nil {
+				return err
+			}
+		}
 		return nil
 	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
 		// If the reference type is an attribute, not implemented, return error
@@ -0,0 +1,265 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	otelCodes "go.opentelemetry.io/otel/codes"
+	rpcCode "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/tunables"
+	"github.com/Permify/permify/pkg/authn"
+	"github.com/Permify/permify/pkg/balancer"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// DatabaseRegions - Database regions tenants are kept in
+type DatabaseRegions interface {
+	// Regions returns the names of the regions, sorted
+	Regions() []string
+	// Health returns whether the databases of the regions passed their last health check, by region
+	Health() map[string]bool
+	// TenantCounts returns the number of tenants known to be kept in each region
+	TenantCounts() map[string]int
+}
+
+// HashRing - Consistent hash ring the checks are dispatched to the nodes of the cluster with, and the dispatches to them
+type HashRing interface {
+	// Ring returns the state of the ring, along with the keys its last change moved and when it changed, which is
+	// zero before it first changes. It returns false before the ring is built.
+	Ring() (balancer.RingState, balancer.Movement, time.Time, bool)
+	// Peers returns the stats of the dispatches to the nodes, by address.
+	Peers() map[string]balancer.PeerStats
+}
+
+// Listeners - Servers listening on network addresses
+type Listeners interface {
+	// ListenAddresses returns the addresses the servers listen on, once started
+	ListenAddresses() []ListenAddress
+}
+
+// AdminServer - Structure for Admin Server
+type AdminServer struct {
+	v1.UnimplementedAdminServer
+
+	database  config.Database
+	regions   DatabaseRegions
+	errors    *ErrorCatalog
+	ring      HashRing
+	tunables  *tunables.Registry
+	listeners Listeners
+}
+
+// NewAdminServer - Creates new Admin Server, listing the database regions if there are any, the error codes of the
+// catalog, the hash ring of the checks dispatched to the cluster if there is one, the tunables of the registry
+// if there is one, and the addresses of the listeners if there are any
+func NewAdminServer(database config.Database, regions DatabaseRegions, errors *ErrorCatalog, ring HashRing, registry *tunables.Registry, listeners Listeners) *AdminServer {
+	return &AdminServer{
+		database:  database,
+		regions:   regions,
+		errors:    errors,
+		ring:      ring,
+		tunables:  registry,
+		listeners: listeners,
+	}
+}
+
+// MigrationStatus - Reports the migration version of the database
+func (r *AdminServer) MigrationStatus(ctx context.Context, _ *v1.AdminMigrationStatusRequest) (*v1.AdminMigrationStatusResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.migration-status")
+	defer span.End()
+
+	current, latest, err := storage.MigrationVersion(ctx, r.database.Engine, r.database.URI)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.AdminMigrationStatusResponse{
+		Engine:         r.database.Engine,
+		CurrentVersion: current,
+		LatestVersion:  latest,
+		Pending:        current < latest,
+	}, nil
+}
+
+// Databases - Lists the database regions along with their health
+func (r *AdminServer) Databases(ctx context.Context, _ *v1.AdminDatabasesRequest) (*v1.AdminDatabasesResponse, error) {
+	_, span := tracer.Start(ctx, "admin.databases")
+	defer span.End()
+
+	response := &v1.AdminDatabasesResponse{Databases: []*v1.AdminDatabase{}}
+	if r.regions == nil {
+		return response, nil
+	}
+
+	health := r.regions.Health()
+	counts := r.regions.TenantCounts()
+	for _, region := range r.regions.Regions() {
+		response.Databases = append(response.Databases, &v1.AdminDatabase{
+			Name:        region,
+			Healthy:     health[region],
+			TenantCount: int64(counts[region]),
+		})
+	}
+	return response, nil
+}
+
+// ErrorCodes - Lists the error codes of the catalog with their messages in the locale of the request
+func (r *AdminServer) ErrorCodes(ctx context.Context, request *v1.AdminErrorCodesRequest) (*v1.AdminErrorCodesResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.error-codes")
+	defer span.End()
+
+	locales := RequestLocales(ctx)
+	if request.GetLocale() != "" {
+		locales = []string{request.GetLocale()}
+	}
+	locale := r.errors.Locale(locales...)
+
+	response := &v1.AdminErrorCodesResponse{Locale: locale, ErrorCodes: []*v1.AdminErrorCode{}}
+	for _, code := range r.errors.Codes() {
+		message, _ := r.errors.Message(code, locale)
+		response.ErrorCodes = append(response.ErrorCodes, &v1.AdminErrorCode{
+			Code:    code,
+			Number:  int32(code),
+			Status:  rpcCode.Code(ErrorCodeStatus(code)).String(),
+			Message: message,
+		})
+	}
+	return response, nil
+}
+
+// Ring - Reports the hash ring the checks are dispatched to the nodes of the cluster with
+func (r *AdminServer) Ring(ctx context.Context, _ *v1.AdminRingRequest) (*v1.AdminRingResponse, error) {
+	_, span := tracer.Start(ctx, "admin.ring")
+	defer span.End()
+
+	response := &v1.AdminRingResponse{Nodes: []*v1.AdminRingNode{}}
+	if r.ring == nil {
+		return response, nil
+	}
+	response.Distributed = true
+
+	state, movement, changedAt, ok := r.ring.Ring()
+	if !ok {
+		return response, nil
+	}
+	response.Hash = state.Hash
+	response.VirtualNodes = int32(state.VirtualNodes)
+	peers := r.ring.Peers()
+	for _, node := range state.Nodes {
+		stats := peers[node.Address]
+		n := &v1.AdminRingNode{
+			Address:      node.Address,
+			VirtualNodes: int32(node.VirtualNodes),
+			Ownership:    node.Ownership,
+			Dispatches:   stats.Dispatches,
+			Failures:     stats.Failures,
+		}
+		if stats.Dispatches > 0 {
+			n.Latency = durationpb.New(stats.Latency)
+		}
+		if !stats.EjectedUntil.IsZero() {
+			n.EjectedUntil = timestamppb.New(stats.EjectedUntil)
+		}
+		response.Nodes = append(response.Nodes, n)
+	}
+	if !changedAt.IsZero() {
+		response.LastChange = &v1.AdminRingChange{
+			Time:    timestamppb.New(changedAt),
+			Added:   movement.Added,
+			Removed: movement.Removed,
+			Moved:   movement.Moved,
+			Minimum: movement.Minimum,
+		}
+	}
+	return response, nil
+}
+
+// Tunables - Lists the tunables of the server along with their recent changes
+func (r *AdminServer) Tunables(ctx context.Context, _ *v1.AdminTunablesRequest) (*v1.AdminTunablesResponse, error) {
+	_, span := tracer.Start(ctx, "admin.tunables")
+	defer span.End()
+
+	response := &v1.AdminTunablesResponse{Tunables: []*v1.AdminTunable{}, Changes: []*v1.AdminTunableChange{}}
+	if r.tunables == nil {
+		return response, nil
+	}
+	response.Tunables = tunablesResponse(r.tunables.Tunables())
+	response.Changes = changesResponse(r.tunables.Changes())
+	return response, nil
+}
+
+// UpdateTunables - Changes tunables of the server at runtime, on behalf of the actor of the request
+func (r *AdminServer) UpdateTunables(ctx context.Context, request *v1.AdminUpdateTunablesRequest) (*v1.AdminUpdateTunablesResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.update-tunables")
+	defer span.End()
+
+	registry := r.tunables
+	if registry == nil {
+		registry = tunables.NewRegistry()
+	}
+	changes, err := registry.Update(ctx, authn.ActorFromContext(ctx), request.GetValues())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.AdminUpdateTunablesResponse{
+		Tunables: tunablesResponse(registry.Tunables()),
+		Changes:  changesResponse(changes),
+	}, nil
+}
+
+// Listeners - Lists the addresses the servers listen on
+func (r *AdminServer) Listeners(ctx context.Context, _ *v1.AdminListenersRequest) (*v1.AdminListenersResponse, error) {
+	_, span := tracer.Start(ctx, "admin.listeners")
+	defer span.End()
+
+	response := &v1.AdminListenersResponse{Listeners: []*v1.AdminListener{}}
+	if r.listeners == nil {
+		return response, nil
+	}
+	for _, l := range r.listeners.ListenAddresses() {
+		response.Listeners = append(response.Listeners, &v1.AdminListener{Server: l.Server, Address: l.Address})
+	}
+	return response, nil
+}
+
+// tunablesResponse converts the tunables to their messages.
+func tunablesResponse(list []tunables.Tunable) []*v1.AdminTunable {
+	response := make([]*v1.AdminTunable, 0, len(list))
+	for _, t := range list {
+		response = append(response, &v1.AdminTunable{
+			Name:        t.Name,
+			Description: t.Description,
+			Value:       t.Get(),
+		})
+	}
+	return response
+}
+
+// changesResponse converts the changes of the tunables to their messages.
+func changesResponse(changes []tunables.Change) []*v1.AdminTunableChange {
+	response := make([]*v1.AdminTunableChange, 0, len(changes))
+	for _, c := range changes {
+		response = append(response, &v1.AdminTunableChange{
+			Time:     timestamppb.New(c.Time),
+			Actor:    c.Actor,
+			Name:     c.Name,
+			Previous: c.Previous,
+			Value:    c.Value,
+		})
+	}
+	return response
+}
@@ -0,0 +1,230 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines/balancer"
+	"github.com/Permify/permify/internal/middleware"
+	"github.com/Permify/permify/internal/tunables"
+	"github.com/Permify/permify/pkg/authn"
+	pkgbalancer "github.com/Permify/permify/pkg/balancer"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+func TestAdminServer_MigrationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	response, err := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, nil).MigrationStatus(ctx, &v1.AdminMigrationStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "memory", response.GetEngine())
+	assert.Zero(t, response.GetCurrentVersion())
+	assert.Zero(t, response.GetLatestVersion())
+	assert.False(t, response.GetPending())
+
+	_, err = NewAdminServer(config.Database{Engine: "unknown"}, nil, nil, nil, nil, nil).MigrationStatus(ctx, &v1.AdminMigrationStatusRequest{})
+	assert.Error(t, err)
+}
+
+type fakeRegions struct{}
+
+func (fakeRegions) Regions() []string { return []string{"byo", "eu"} }
+
+func (fakeRegions) Health() map[string]bool { return map[string]bool{"byo": false, "eu": true} }
+
+func (fakeRegions) TenantCounts() map[string]int { return map[string]int{"byo": 1, "eu": 3} }
+
+func TestAdminServer_Databases(t *testing.T) {
+	ctx := context.Background()
+
+	response, err := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, nil).Databases(ctx, &v1.AdminDatabasesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, response.GetDatabases())
+
+	response, err = NewAdminServer(config.Database{Engine: "memory"}, fakeRegions{}, nil, nil, nil, nil).Databases(ctx, &v1.AdminDatabasesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*v1.AdminDatabase{
+		{Name: "byo", Healthy: false, TenantCount: 1},
+		{Name: "eu", Healthy: true, TenantCount: 3},
+	}, response.GetDatabases())
+}
+
+func TestAdminServer_ErrorCodes(t *testing.T) {
+	catalog, err := NewErrorCatalog(map[string]map[string]string{
+		"de": {"error_code_tenant_not_found": "Der Mandant wurde nicht gefunden."},
+	})
+	require.NoError(t, err)
+	server := NewAdminServer(config.Database{Engine: "memory"}, nil, catalog, nil, nil, nil)
+
+	response, err := server.ErrorCodes(context.Background(), &v1.AdminErrorCodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "en", response.GetLocale())
+	assert.Len(t, response.GetErrorCodes(), len(v1.ErrorCode_name)-1)
+
+	var found bool
+	for _, code := range response.GetErrorCodes() {
+		assert.NotEmpty(t, code.GetMessage(), code.GetCode().String())
+		if code.GetCode() == v1.ErrorCode_ERROR_CODE_VALIDATION {
+			found = true
+			assert.Equal(t, int32(2000), code.GetNumber())
+			assert.Equal(t, "INVALID_ARGUMENT", code.GetStatus())
+		}
+	}
+	assert.True(t, found)
+
+	response, err = server.ErrorCodes(context.Background(), &v1.AdminErrorCodesRequest{Locale: "de-CH"})
+	require.NoError(t, err)
+	assert.Equal(t, "de", response.GetLocale())
+	for _, code := range response.GetErrorCodes() {
+		switch code.GetCode() {
+		case v1.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND:
+			assert.Equal(t, "Der Mandant wurde nicht gefunden.", code.GetMessage())
+		case v1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND:
+			assert.Equal(t, "The schema is not found.", code.GetMessage())
+		}
+	}
+}
+
+func TestAdminServer_Ring(t *testing.T) {
+	ctx := context.Background()
+
+	response, err := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, nil).Ring(ctx, &v1.AdminRingRequest{})
+	require.NoError(t, err)
+	assert.False(t, response.GetDistributed())
+	assert.Empty(t, response.GetNodes())
+
+	monitor := balancer.NewRingMonitor(telemetry.NewNoopMeter())
+	server := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, monitor, nil, nil)
+
+	// The ring is built with the first check dispatched
+	response, err = server.Ring(ctx, &v1.AdminRingRequest{})
+	require.NoError(t, err)
+	assert.True(t, response.GetDistributed())
+	assert.Empty(t, response.GetNodes())
+
+	monitor.RingChanged(pkgbalancer.RingState{Hash: "md5", VirtualNodes: 100, Nodes: []pkgbalancer.NodeState{
+		{Address: "10.0.0.1:5000", VirtualNodes: 100, Ownership: 1},
+	}}, pkgbalancer.Movement{})
+	response, err = server.Ring(ctx, &v1.AdminRingRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "md5", response.GetHash())
+	assert.Equal(t, int32(100), response.GetVirtualNodes())
+	assert.Len(t, response.GetNodes(), 1)
+	assert.Nil(t, response.GetLastChange())
+
+	monitor.RingChanged(pkgbalancer.RingState{Hash: "md5", VirtualNodes: 100, Nodes: []pkgbalancer.NodeState{
+		{Address: "10.0.0.1:5000", VirtualNodes: 100, Ownership: 0.49},
+		{Address: "10.0.0.2:5000", VirtualNodes: 100, Ownership: 0.51},
+	}}, pkgbalancer.Movement{Added: []string{"10.0.0.2:5000"}, Moved: 0.51, Minimum: 0.51})
+	response, err = server.Ring(ctx, &v1.AdminRingRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*v1.AdminRingNode{
+		{Address: "10.0.0.1:5000", VirtualNodes: 100, Ownership: 0.49},
+		{Address: "10.0.0.2:5000", VirtualNodes: 100, Ownership: 0.51},
+	}, response.GetNodes())
+	assert.Equal(t, []string{"10.0.0.2:5000"}, response.GetLastChange().GetAdded())
+	assert.Equal(t, 0.51, response.GetLastChange().GetMoved())
+	assert.NotNil(t, response.GetLastChange().GetTime())
+
+	// The dispatches to the nodes are reported along with them
+	monitor.Dispatched("10.0.0.1:5000", 10*time.Millisecond, false)
+	monitor.Dispatched("10.0.0.1:5000", 20*time.Millisecond, true)
+	monitor.Ejected("10.0.0.1:5000", time.Now().Add(time.Minute))
+	response, err = server.Ring(ctx, &v1.AdminRingRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), response.GetNodes()[0].GetDispatches())
+	assert.Equal(t, int64(1), response.GetNodes()[0].GetFailures())
+	assert.Equal(t, 11*time.Millisecond, response.GetNodes()[0].GetLatency().AsDuration())
+	assert.NotNil(t, response.GetNodes()[0].GetEjectedUntil())
+	assert.Nil(t, response.GetNodes()[1].GetLatency())
+	assert.Nil(t, response.GetNodes()[1].GetEjectedUntil())
+}
+
+func TestAdminServer_Tunables(t *testing.T) {
+	ctx := authn.ContextWithActor(context.Background(), "ops@example.com")
+
+	response, err := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, nil).Tunables(ctx, &v1.AdminTunablesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, response.GetTunables())
+
+	level := new(slog.LevelVar)
+	limiter := middleware.NewRateLimiter(100, nil)
+	idempotency := middleware.NewIdempotency(time.Hour, 10)
+	server := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, tunables.NewRegistry(
+		tunables.LogLevel(level),
+		tunables.RateLimit(limiter),
+		tunables.IdempotencyTTL(idempotency),
+	), nil)
+
+	updated, err := server.UpdateTunables(ctx, &v1.AdminUpdateTunablesRequest{Values: map[string]string{
+		"log.level":         "debug",
+		"server.rate_limit": "100",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelDebug, level.Level())
+	// Values equal to the current ones are not changes
+	require.Len(t, updated.GetChanges(), 1)
+	assert.Equal(t, "ops@example.com", updated.GetChanges()[0].GetActor())
+	assert.Equal(t, "info", updated.GetChanges()[0].GetPrevious())
+	assert.Equal(t, "debug", updated.GetChanges()[0].GetValue())
+
+	// A value that is not valid fails the whole request
+	_, err = server.UpdateTunables(ctx, &v1.AdminUpdateTunablesRequest{Values: map[string]string{
+		"server.rate_limit":       "500",
+		"service.idempotency.ttl": "-1m",
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, int64(100), limiter.Rate())
+
+	_, err = server.UpdateTunables(ctx, &v1.AdminUpdateTunablesRequest{Values: map[string]string{"unknown": "1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.UpdateTunables(ctx, &v1.AdminUpdateTunablesRequest{Values: map[string]string{"service.idempotency.ttl": "30m"}})
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, idempotency.TTL())
+
+	response, err = server.Tunables(ctx, &v1.AdminTunablesRequest{})
+	require.NoError(t, err)
+	var names, values []string
+	for _, tunable := range response.GetTunables() {
+		names = append(names, tunable.GetName())
+		values = append(values, tunable.GetValue())
+	}
+	assert.Equal(t, []string{"log.level", "server.rate_limit", "service.idempotency.ttl"}, names)
+	assert.Equal(t, []string{"debug", "100", "30m0s"}, values)
+	require.Len(t, response.GetChanges(), 2)
+	assert.Equal(t, "service.idempotency.ttl", response.GetChanges()[1].GetName())
+	assert.Equal(t, "1h0m0s", response.GetChanges()[1].GetPrevious())
+}
+
+// fakeListeners lists fixed addresses.
+type fakeListeners []ListenAddress
+
+func (l fakeListeners) ListenAddresses() []ListenAddress { return l }
+
+func TestAdminServer_Listeners(t *testing.T) {
+	ctx := context.Background()
+
+	response, err := NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, nil).Listeners(ctx, &v1.AdminListenersRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, response.GetListeners())
+
+	response, err = NewAdminServer(config.Database{Engine: "memory"}, nil, nil, nil, nil, fakeListeners{
+		{Server: "grpc server", Address: "10.0.0.1:3478"},
+		{Server: "http server", Address: "[::]:3476"},
+	}).Listeners(ctx, &v1.AdminListenersRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.GetListeners(), 2)
+	assert.Equal(t, "grpc server", response.GetListeners()[0].GetServer())
+	assert.Equal(t, "10.0.0.1:3478", response.GetListeners()[0].GetAddress())
+	assert.Equal(t, "[::]:3476", response.GetListeners()[1].GetAddress())
+}
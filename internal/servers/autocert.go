@@ -0,0 +1,80 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// NewAutocertManager - Creates the manager obtaining the certificates of the hostnames of the autocert configuration
+// from its ACME certificate authority, accepting its terms of service, and renewing them before they expire. The
+// certificates and the key of the account are kept in the cache.
+func NewAutocertManager(conf config.Autocert, cache autocert.Cache) (*autocert.Manager, error) {
+	if len(conf.Hostnames) == 0 {
+		return nil, errors.New("autocert needs the hostnames certificates are obtained for")
+	}
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      cache,
+		HostPolicy: autocert.HostWhitelist(conf.Hostnames...),
+		Email:      conf.Email,
+	}
+	if conf.DirectoryURL != "" {
+		manager.Client = &acme.Client{DirectoryURL: conf.DirectoryURL}
+	}
+	return manager, nil
+}
+
+// ChallengeServer - Component answering the HTTP-01 challenges of the ACME certificate authority, and redirecting
+// the other requests to HTTPS
+type ChallengeServer struct {
+	failure
+	listening
+
+	server *http.Server
+}
+
+// NewChallengeServer - Creates a new component answering the HTTP-01 challenges of the manager on the address
+func NewChallengeServer(address string, manager *autocert.Manager) *ChallengeServer {
+	return &ChallengeServer{
+		server: &http.Server{
+			Addr:              address,
+			Handler:           manager.HTTPHandler(nil),
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       15 * time.Second,
+		},
+	}
+}
+
+// Name returns the name of the challenge server.
+func (c *ChallengeServer) Name() string {
+	return "acme challenge server"
+}
+
+// Start listens on the address and answers the challenges in a separate goroutine.
+func (c *ChallengeServer) Start(_ context.Context) error {
+	lis, err := net.Listen("tcp", c.server.Addr)
+	if err != nil {
+		return err
+	}
+	c.listened(lis)
+	serveHTTP(c.server, lis, config.TLSConfig{}, c.fail)
+
+	slog.Info(fmt.Sprintf("🚀 acme challenge server successfully started: %s", lis.Addr()))
+
+	return nil
+}
+
+// Stop shuts the challenge server down gracefully.
+func (c *ChallengeServer) Stop(ctx context.Context) error {
+	return c.server.Shutdown(ctx)
+}
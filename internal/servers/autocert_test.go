@@ -0,0 +1,61 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestNewAutocertManager(t *testing.T) {
+	_, err := NewAutocertManager(config.Autocert{}, autocert.DirCache(t.TempDir()))
+	assert.Error(t, err)
+
+	manager, err := NewAutocertManager(config.Autocert{
+		Hostnames:    []string{"permify.example.com"},
+		DirectoryURL: "https://acme-staging-v02.api.letsencrypt.org/directory",
+	}, autocert.DirCache(t.TempDir()))
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme-staging-v02.api.letsencrypt.org/directory", manager.Client.DirectoryURL)
+
+	// Only the configured hostnames are served certificates
+	assert.NoError(t, manager.HostPolicy(context.Background(), "permify.example.com"))
+	assert.Error(t, manager.HostPolicy(context.Background(), "other.example.com"))
+
+	// The gRPC servers are served with TLS, with the certificates of the manager
+	creds, err := transportOptions(&config.Server{}, nil, manager)
+	require.NoError(t, err)
+	assert.Len(t, creds, 1)
+	creds, err = transportOptions(&config.Server{}, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, creds)
+}
+
+func TestChallengeServer(t *testing.T) {
+	manager, err := NewAutocertManager(config.Autocert{Hostnames: []string{"permify.example.com"}}, autocert.DirCache(t.TempDir()))
+	require.NoError(t, err)
+
+	server := NewChallengeServer("127.0.0.1:0", manager)
+	require.NoError(t, server.Start(context.Background()))
+	defer server.Stop(context.Background())
+
+	// Requests other than the challenges are redirected to HTTPS
+	client := &http.Client{
+		Timeout:       5 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	req, err := http.NewRequest(http.MethodGet, "http://"+server.ListenAddresses()[0]+"/v1/tenants", nil)
+	require.NoError(t, err)
+	req.Host = "permify.example.com"
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "https://permify.example.com/v1/tenants", resp.Header.Get("Location"))
+}
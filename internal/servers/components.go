@@ -0,0 +1,433 @@
+package servers
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/crypto/acme/autocert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	health "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/supervisor"
+)
+
+// GRPCServer - Component serving a gRPC server on a TCP port
+type GRPCServer struct {
+	failure
+	listening
+
+	name   string
+	conf   config.GRPC
+	server *grpc.Server
+}
+
+// NewGRPCServer - Creates a new component serving the gRPC server on the listeners of the configuration
+func NewGRPCServer(name string, conf config.GRPC, server *grpc.Server) *GRPCServer {
+	return &GRPCServer{
+		name:   name,
+		conf:   conf,
+		server: server,
+	}
+}
+
+// Name returns the name of the server.
+func (s *GRPCServer) Name() string {
+	return s.name
+}
+
+// Server returns the underlying gRPC server, on which more services can be registered before it starts.
+func (s *GRPCServer) Server() *grpc.Server {
+	return s.server
+}
+
+// Start opens the listeners and serves the gRPC server on each of them in a separate goroutine.
+func (s *GRPCServer) Start(ctx context.Context) error {
+	listeners, err := listen(ctx, s.conf)
+	if err != nil {
+		return err
+	}
+
+	s.listened(listeners...)
+	for _, lis := range listeners {
+		go func(lis net.Listener) {
+			if err := s.server.Serve(lis); err != nil {
+				s.fail(err)
+			}
+		}(lis)
+	}
+
+	slog.Info(fmt.Sprintf("🚀 %s successfully started: %s", s.name, strings.Join(s.ListenAddresses(), ", ")))
+
+	return nil
+}
+
+// Stop stops the gRPC server gracefully, or forcefully once the context is done.
+func (s *GRPCServer) Stop(ctx context.Context) error {
+	return gracefulStop(ctx, s.server)
+}
+
+// GatewayServer - Component serving the HTTP API, translating REST requests to gRPC calls
+type GatewayServer struct {
+	failure
+	listening
+
+	srv *config.Server
+	// backend is the in-memory gRPC server the requests are forwarded to, if the services run in process.
+	backend *grpc.Server
+	lis     *bufconn.Listener
+	// target and options dial the gRPC servers the requests are forwarded to.
+	target  string
+	options []grpc.DialOption
+
+	// certificates is the manager of the certificates obtained automatically the server is served with, if any.
+	certificates *autocert.Manager
+
+	conn       *grpc.ClientConn
+	httpServer *http.Server
+	// http3Server serves the HTTP server over QUIC on udp, if HTTP/3 is enabled.
+	http3Server *http3.Server
+	udp         net.PacketConn
+	// cors is the handler of the CORS policies of the HTTP server, once started.
+	cors atomic.Pointer[corsHandler]
+}
+
+// NewGatewayServer - Creates a new gateway forwarding requests to the gRPC server, which runs in process
+// with an in-memory listener, so there is no network hop between the HTTP handlers and the services
+func NewGatewayServer(srv *config.Server, backend *grpc.Server) *GatewayServer {
+	lis := bufconn.Listen(_gatewayBufferSize)
+	return &GatewayServer{
+		srv:     srv,
+		backend: backend,
+		lis:     lis,
+		target:  "bufnet",
+		options: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		},
+	}
+}
+
+// NewRemoteGatewayServer - Creates a new gateway forwarding requests to the remote gRPC servers of the
+// gateway configuration
+func NewRemoteGatewayServer(srv *config.Server, gateway *config.Gateway) (*GatewayServer, error) {
+	target, options, err := gatewayDialOptions(gateway)
+	if err != nil {
+		return nil, err
+	}
+	return &GatewayServer{
+		srv:     srv,
+		target:  target,
+		options: options,
+	}, nil
+}
+
+// Name returns the name of the gateway.
+func (g *GatewayServer) Name() string {
+	return "http server"
+}
+
+// Start connects to the gRPC servers and serves the HTTP server in a separate goroutine.
+func (g *GatewayServer) Start(ctx context.Context) error {
+	if g.backend != nil {
+		go func() {
+			if err := g.backend.Serve(g.lis); err != nil {
+				g.fail(fmt.Errorf("gateway grpc server: %w", err))
+			}
+		}()
+	}
+
+	// The connection is established lazily, so that a remote gateway can start before its targets.
+	conn, err := grpc.DialContext(ctx, g.target, g.options...)
+	if err != nil {
+		return err
+	}
+	g.conn = conn
+
+	var cors *corsHandler
+	g.httpServer, cors, err = newHTTPServer(ctx, g.srv, conn)
+	if err != nil {
+		return err
+	}
+	g.cors.Store(cors)
+
+	if g.srv.HTTP.HTTP3Enabled {
+		g.http3Server, err = newHTTP3Server(g.srv.HTTP, g.certificates, g.httpServer.Handler)
+		if err != nil {
+			return err
+		}
+		g.httpServer.Handler = altSvcHandler(g.http3Server, g.httpServer.Handler)
+	}
+
+	lis, err := net.Listen("tcp", g.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+	g.listened(lis)
+	tlsConfig := g.srv.HTTP.TLSConfig
+	if g.certificates != nil {
+		g.httpServer.TLSConfig = g.certificates.TLSConfig()
+		tlsConfig = config.TLSConfig{Enabled: true}
+	}
+	serveHTTP(g.httpServer, lis, tlsConfig, g.fail)
+
+	slog.Info(fmt.Sprintf("🚀 http server successfully started: %s", lis.Addr()))
+
+	// HTTP/3 is served on the UDP port of the same number, which the Alt-Svc header advertises.
+	if g.http3Server != nil {
+		g.udp, err = net.ListenPacket("udp", lis.Addr().String())
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := g.http3Server.Serve(g.udp); !errors.Is(err, http.ErrServerClosed) {
+				g.fail(fmt.Errorf("http3 server: %w", err))
+			}
+		}()
+
+		slog.Info(fmt.Sprintf("🚀 http3 server successfully started: %s", g.udp.LocalAddr()))
+	}
+
+	return nil
+}
+
+// ReloadCORS validates the CORS policies of the HTTP configuration, returning the function applying them to the
+// requests of the server. The policies are only validated if the server is not started.
+func (g *GatewayServer) ReloadCORS(conf config.HTTP) (func(), error) {
+	cors := g.cors.Load()
+	if cors == nil {
+		_, err := buildCORSHandler(conf, http.NotFoundHandler())
+		return func() {}, err
+	}
+	return cors.reload(conf)
+}
+
+// Stop shuts the HTTP server down gracefully and closes the HTTP/3 server, then closes the connection and the
+// in-memory gRPC server.
+func (g *GatewayServer) Stop(ctx context.Context) error {
+	var errs []error
+	if g.httpServer != nil {
+		if err := g.httpServer.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if g.http3Server != nil {
+		if err := g.http3Server.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if g.udp != nil {
+		if err := g.udp.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if g.conn != nil {
+		if err := g.conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if g.backend != nil {
+		if err := gracefulStop(ctx, g.backend); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ProfilerServer - Component serving the pprof profiler over HTTP
+type ProfilerServer struct {
+	failure
+	listening
+
+	server *http.Server
+}
+
+// NewProfilerServer - Creates a new component serving the pprof profiler on the address and the port, behind the
+// authentication of the configuration
+func NewProfilerServer(conf config.Profiler) (*ProfilerServer, error) {
+	// Create a new HTTP ServeMux to register pprof routes.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	handler, err := profilerAuth(conf.Auth, mux)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfilerServer{
+		// Define the HTTP server with timeouts and the mux handler for pprof routes.
+		server: &http.Server{
+			Addr:         net.JoinHostPort(conf.Address, conf.Port),
+			Handler:      handler,
+			ReadTimeout:  20 * time.Second,
+			WriteTimeout: 20 * time.Second,
+			IdleTimeout:  15 * time.Second,
+		},
+	}, nil
+}
+
+// profilerAuth wraps the handler of the profiler with the authentication of the configuration. The credentials are
+// compared in constant time.
+func profilerAuth(conf config.ProfilerAuth, next http.Handler) (http.Handler, error) {
+	unauthorized := func(w http.ResponseWriter) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	}
+
+	switch conf.Method {
+	case "":
+		return next, nil
+	case "basic":
+		if conf.BasicAuthUser == "" || conf.BasicAuthPassword == "" {
+			return nil, errors.New("the basic authentication of the profiler needs a user and a password")
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			if !ok || subtle.ConstantTimeCompare([]byte(user), []byte(conf.BasicAuthUser))&
+				subtle.ConstantTimeCompare([]byte(password), []byte(conf.BasicAuthPassword)) != 1 {
+				w.Header().Set("WWW-Authenticate", `Basic realm="profiler"`)
+				unauthorized(w)
+				return
+			}
+			next.ServeHTTP(w, r)
+		}), nil
+	case "preshared":
+		if len(conf.Keys) == 0 {
+			return nil, errors.New("the preshared authentication of the profiler needs at least one key")
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if ok {
+				for _, key := range conf.Keys {
+					if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+						next.ServeHTTP(w, r)
+						return
+					}
+				}
+			}
+			unauthorized(w)
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown profiler authentication method %q, expected basic or preshared", conf.Method)
+	}
+}
+
+// Name returns the name of the profiler.
+func (p *ProfilerServer) Name() string {
+	return "profiler server"
+}
+
+// Start listens on the port and serves the profiler in a separate goroutine.
+func (p *ProfilerServer) Start(_ context.Context) error {
+	lis, err := net.Listen("tcp", p.server.Addr)
+	if err != nil {
+		return err
+	}
+	p.listened(lis)
+	serveHTTP(p.server, lis, config.TLSConfig{}, p.fail)
+
+	slog.Info(fmt.Sprintf("🚀 profiler server successfully started: %s", lis.Addr()))
+
+	return nil
+}
+
+// Stop shuts the profiler down gracefully.
+func (p *ProfilerServer) Stop(ctx context.Context) error {
+	return p.server.Shutdown(ctx)
+}
+
+// Readiness - Component reporting the servers ready once the components before it started, and not ready once they
+// stop, by the health server and to the process supervisor, if any
+type Readiness struct {
+	health     *HealthServer
+	supervisor supervisor.Supervisor
+	cancel     context.CancelFunc
+}
+
+// NewReadiness - Creates a new component reporting the readiness of the servers by the health server, and to the
+// supervisor if it is not nil
+func NewReadiness(health *HealthServer, s supervisor.Supervisor) *Readiness {
+	return &Readiness{
+		health:     health,
+		supervisor: s,
+	}
+}
+
+// Name returns the name of the readiness.
+func (r *Readiness) Name() string {
+	return "readiness"
+}
+
+// Start reports the servers ready, and pings the watchdog of the supervisor in a separate goroutine while the
+// health server reports them serving.
+func (r *Readiness) Start(ctx context.Context) error {
+	r.health.SetServingStatus(health.HealthCheckResponse_SERVING)
+	if r.supervisor == nil {
+		return nil
+	}
+
+	if err := r.supervisor.Ready(); err != nil {
+		slog.Warn("failed to notify the supervisor of the readiness", slog.Any("error", err))
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go func() {
+		serving := func() bool {
+			return r.health.ServingStatus() == health.HealthCheckResponse_SERVING
+		}
+		if err := r.supervisor.Watchdog(ctx, serving); err != nil {
+			slog.Warn("failed to ping the watchdog of the supervisor", slog.Any("error", err))
+		}
+	}()
+	return nil
+}
+
+// Stop reports the servers not ready, before the components before it stop.
+func (r *Readiness) Stop(context.Context) error {
+	r.health.SetServingStatus(health.HealthCheckResponse_NOT_SERVING)
+	if r.supervisor == nil {
+		return nil
+	}
+
+	r.cancel()
+	if err := r.supervisor.Stopping(); err != nil {
+		slog.Warn("failed to notify the supervisor of the shutdown", slog.Any("error", err))
+	}
+	return nil
+}
+
+// gracefulStop stops the gRPC server gracefully, or forcefully once the context is done.
+func gracefulStop(ctx context.Context, server *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,94 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	health "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestProfilerAuth(t *testing.T) {
+	serve := func(handler http.Handler, set func(r *http.Request)) int {
+		r := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+		set(r)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+	none := func(*http.Request) {}
+
+	basic, err := profilerAuth(config.ProfilerAuth{Method: "basic", BasicAuthUser: "permify", BasicAuthPassword: "secret"}, http.NotFoundHandler())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, serve(basic, none))
+	assert.Equal(t, http.StatusUnauthorized, serve(basic, func(r *http.Request) { r.SetBasicAuth("permify", "wrong") }))
+	assert.Equal(t, http.StatusNotFound, serve(basic, func(r *http.Request) { r.SetBasicAuth("permify", "secret") }))
+
+	preshared, err := profilerAuth(config.ProfilerAuth{Method: "preshared", Keys: []string{"a", "b"}}, http.NotFoundHandler())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, serve(preshared, none))
+	assert.Equal(t, http.StatusUnauthorized, serve(preshared, func(r *http.Request) { r.Header.Set("Authorization", "Bearer c") }))
+	assert.Equal(t, http.StatusNotFound, serve(preshared, func(r *http.Request) { r.Header.Set("Authorization", "Bearer b") }))
+
+	open, err := profilerAuth(config.ProfilerAuth{}, http.NotFoundHandler())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, serve(open, none))
+
+	// Incomplete or unknown methods are rejected rather than leaving the profiler open
+	for _, conf := range []config.ProfilerAuth{
+		{Method: "basic", BasicAuthUser: "permify"},
+		{Method: "preshared"},
+		{Method: "oidc"},
+	} {
+		_, err = NewProfilerServer(config.Profiler{Port: "6060", Auth: conf})
+		assert.Error(t, err, conf.Method)
+	}
+}
+
+// fakeSupervisor records the notifications it receives.
+type fakeSupervisor struct {
+	notifications []string
+}
+
+func (s *fakeSupervisor) Ready() error {
+	s.notifications = append(s.notifications, "ready")
+	return nil
+}
+
+func (s *fakeSupervisor) Stopping() error {
+	s.notifications = append(s.notifications, "stopping")
+	return nil
+}
+
+func (s *fakeSupervisor) Watchdog(ctx context.Context, _ func() bool) error {
+	<-ctx.Done()
+	return nil
+}
+
+func TestReadiness(t *testing.T) {
+	server := NewHealthServer()
+	server.SetServingStatus(health.HealthCheckResponse_NOT_SERVING)
+	supervisor := &fakeSupervisor{}
+	readiness := NewReadiness(server, supervisor)
+
+	check := func() health.HealthCheckResponse_ServingStatus {
+		res, err := server.Check(context.Background(), &health.HealthCheckRequest{})
+		require.NoError(t, err)
+		return res.GetStatus()
+	}
+
+	require.NoError(t, readiness.Start(context.Background()))
+	assert.Equal(t, health.HealthCheckResponse_SERVING, check())
+	require.NoError(t, readiness.Stop(context.Background()))
+	assert.Equal(t, health.HealthCheckResponse_NOT_SERVING, check())
+	assert.Equal(t, []string{"ready", "stopping"}, supervisor.notifications)
+
+	// Without a supervisor, the readiness is only reported by the health server
+	require.NoError(t, NewReadiness(server, nil).Start(context.Background()))
+	assert.Equal(t, health.HealthCheckResponse_SERVING, check())
+}
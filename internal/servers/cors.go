@@ -0,0 +1,167 @@
+package servers
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/rs/cors"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// corsMethods are the methods allowed by a CORS policy that does not list its own.
+var corsMethods = []string{
+	http.MethodGet, http.MethodPost,
+	http.MethodHead, http.MethodPatch, http.MethodDelete, http.MethodPut,
+}
+
+// corsRoute is a CORS policy along with the path prefix of the routes it applies to.
+type corsRoute struct {
+	prefix string
+	cors   *cors.Cors
+}
+
+// corsHandler is a handler applying the CORS policies of the HTTP configuration, which can be replaced while the
+// server runs.
+type corsHandler struct {
+	next    http.Handler
+	handler atomic.Pointer[http.Handler]
+}
+
+// newCORSHandler wraps the handler with the CORS policies of the HTTP configuration.
+func newCORSHandler(conf config.HTTP, next http.Handler) (*corsHandler, error) {
+	h := &corsHandler{next: next}
+	apply, err := h.reload(conf)
+	if err != nil {
+		return nil, err
+	}
+	apply()
+	return h, nil
+}
+
+// reload validates the CORS policies of the HTTP configuration, returning the function replacing the policies of
+// the handler with them.
+func (h *corsHandler) reload(conf config.HTTP) (func(), error) {
+	handler, err := buildCORSHandler(conf, h.next)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		h.handler.Store(&handler)
+	}, nil
+}
+
+// ServeHTTP handles the request with the current CORS policies.
+func (h *corsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*h.handler.Load()).ServeHTTP(w, r)
+}
+
+// buildCORSHandler wraps the handler with the CORS policies of the HTTP configuration. A request is handled by
+// the policy with the longest path prefix matching its path, or by the policy of the top level options.
+func buildCORSHandler(conf config.HTTP, next http.Handler) (http.Handler, error) {
+	fallback, err := newCORS(config.CORSPolicy{
+		AllowedOrigins:        conf.CORSAllowedOrigins,
+		AllowedOriginPatterns: conf.CORSAllowedOriginPatterns,
+		AllowedHeaders:        conf.CORSAllowedHeaders,
+		MaxAge:                conf.CORSMaxAge,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.CORSPolicies) == 0 {
+		return fallback.Handler(next), nil
+	}
+
+	routes := make([]corsRoute, 0, len(conf.CORSPolicies))
+	for _, policy := range conf.CORSPolicies {
+		if policy.PathPrefix == "" {
+			return nil, fmt.Errorf("cors policy must have a path prefix")
+		}
+		c, err := newCORS(policy)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, corsRoute{prefix: policy.PathPrefix, cors: c})
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].prefix) > len(routes[j].prefix)
+	})
+
+	handlers := make([]http.Handler, len(routes))
+	for i, route := range routes {
+		handlers[i] = route.cors.Handler(next)
+	}
+	fallbackHandler := fallback.Handler(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i, route := range routes {
+			if strings.HasPrefix(r.URL.Path, route.prefix) {
+				handlers[i].ServeHTTP(w, r)
+				return
+			}
+		}
+		fallbackHandler.ServeHTTP(w, r)
+	}), nil
+}
+
+// newCORS creates the CORS handler of the policy.
+func newCORS(policy config.CORSPolicy) (*cors.Cors, error) {
+	options := cors.Options{
+		AllowCredentials: policy.AllowCredentials == nil || *policy.AllowCredentials,
+		AllowedOrigins:   policy.AllowedOrigins,
+		AllowedHeaders:   policy.AllowedHeaders,
+		AllowedMethods:   policy.AllowedMethods,
+		MaxAge:           policy.MaxAge,
+	}
+	if len(options.AllowedMethods) == 0 {
+		options.AllowedMethods = corsMethods
+	}
+
+	if len(policy.AllowedOriginPatterns) > 0 {
+		patterns := make([]*regexp.Regexp, 0, len(policy.AllowedOriginPatterns))
+		for _, p := range policy.AllowedOriginPatterns {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cors origin pattern %s: %w", p, err)
+			}
+			patterns = append(patterns, re)
+		}
+		// The allowed origins are ignored by the cors package once an origin function is set, so the
+		// function checks them as well.
+		origins := policy.AllowedOrigins
+		options.AllowOriginFunc = func(origin string) bool {
+			if matchOrigin(origins, origin) {
+				return true
+			}
+			for _, re := range patterns {
+				if re.MatchString(origin) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	return cors.New(options), nil
+}
+
+// matchOrigin reports whether the origin is one of the allowed origins, which may be * or contain a single
+// * wildcard, e.g. https://*.example.com.
+func matchOrigin(allowed []string, origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == "*" || a == origin {
+			return true
+		}
+		if prefix, suffix, ok := strings.Cut(a, "*"); ok &&
+			len(origin) >= len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package servers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestCORSHandler_Reload(t *testing.T) {
+	handler, err := newCORSHandler(config.HTTP{CORSAllowedOrigins: []string{"https://a.example.com"}}, http.NotFoundHandler())
+	require.NoError(t, err)
+
+	allowed := func(origin string) string {
+		r := httptest.NewRequest(http.MethodGet, "/v1/tenants", nil)
+		r.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Header().Get("Access-Control-Allow-Origin")
+	}
+	assert.Equal(t, "https://a.example.com", allowed("https://a.example.com"))
+	assert.Empty(t, allowed("https://b.example.com"))
+
+	// Invalid policies are rejected, keeping the current ones
+	_, err = handler.reload(config.HTTP{CORSAllowedOriginPatterns: []string{"("}})
+	assert.Error(t, err)
+
+	apply, err := handler.reload(config.HTTP{CORSAllowedOrigins: []string{"https://b.example.com"}})
+	require.NoError(t, err)
+	assert.Empty(t, allowed("https://b.example.com"))
+	apply()
+	assert.Equal(t, "https://b.example.com", allowed("https://b.example.com"))
+	assert.Empty(t, allowed("https://a.example.com"))
+}
@@ -2,16 +2,24 @@ package servers
 
 import (
 	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"google.golang.org/grpc/status"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/context"
 
+	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/storage"
+	storageContext "github.com/Permify/permify/internal/storage/context"
 	"github.com/Permify/permify/internal/validation"
 	"github.com/Permify/permify/pkg/database"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 // DataServer - Structure for Data Server
@@ -21,6 +29,12 @@ type DataServer struct {
 	sr storage.SchemaReader
 	dr storage.DataReader
 	dw storage.DataWriter
+
+	// strict is whether the writes of the tenants are validated against their head schema version as well
+	strict bool
+	// strictTenants are the tenants validated strictly, all tenants but the exempt ones if empty
+	strictTenants map[string]struct{}
+	exempt        map[string]struct{}
 }
 
 // NewDataServer - Creates new Data Server
@@ -28,12 +42,23 @@ func NewDataServer(
 	dr storage.DataReader,
 	dw storage.DataWriter,
 	sr storage.SchemaReader,
+	cfg config.Data,
 ) *DataServer {
-	return &DataServer{
-		dr: dr,
-		dw: dw,
-		sr: sr,
+	server := &DataServer{
+		dr:            dr,
+		dw:            dw,
+		sr:            sr,
+		strict:        cfg.StrictValidation.Enabled,
+		strictTenants: map[string]struct{}{},
+		exempt:        map[string]struct{}{},
+	}
+	for _, tenant := range cfg.StrictValidation.Tenants {
+		server.strictTenants[tenant] = struct{}{}
+	}
+	for _, tenant := range cfg.StrictValidation.Exempt {
+		server.exempt[tenant] = struct{}{}
 	}
+	return server
 }
 
 // ReadRelationships - Allows directly querying the stored engines data to display and filter stored relational tuples
@@ -48,9 +73,9 @@ func (r *DataServer) ReadRelationships(ctx context.Context, request *v1.Relation
 
 	snap := request.GetMetadata().GetSnapToken()
 	if snap == "" {
-		st, err := r.dr.HeadSnapshot(ctx, request.GetTenantId())
+		st, err := r.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapshotTime())
 		if err != nil {
-			return nil, err
+			return nil, status.Error(GetStatus(err), err.Error())
 		}
 		snap = st.Encode().String()
 	}
@@ -90,9 +115,9 @@ func (r *DataServer) ReadAttributes(ctx context.Context, request *v1.AttributeRe
 
 	snap := request.GetMetadata().GetSnapToken()
 	if snap == "" {
-		st, err := r.dr.HeadSnapshot(ctx, request.GetTenantId())
+		st, err := r.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetSnapshotTime())
 		if err != nil {
-			return nil, err
+			return nil, status.Error(GetStatus(err), err.Error())
 		}
 		snap = st.Encode().String()
 	}
@@ -120,6 +145,48 @@ func (r *DataServer) ReadAttributes(ctx context.Context, request *v1.AttributeRe
 	}, nil
 }
 
+// ReadHistory - Allows reading the timeline of creations and deletions of stored relational tuples
+func (r *DataServer) ReadHistory(ctx context.Context, request *v1.HistoryReadRequest) (*v1.HistoryReadResponse, error) {
+	ctx, span := tracer.Start(ctx, "data.read.history")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	var start, end time.Time
+	if request.GetStartTime() != nil {
+		start = request.GetStartTime().AsTime()
+	}
+	if request.GetEndTime() != nil {
+		end = request.GetEndTime().AsTime()
+	}
+
+	changes, ct, err := r.dr.ReadRelationshipHistory(
+		ctx,
+		request.GetTenantId(),
+		request.GetFilter(),
+		start,
+		end,
+		database.NewPagination(
+			database.Size(request.GetPageSize()),
+			database.Token(request.GetContinuousToken()),
+		),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.HistoryReadResponse{
+		Changes:         changes,
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
 // Write - Write relationships and attributes to writeDB
 func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
 	ctx, span := tracer.Start(ctx, "data.write")
@@ -141,47 +208,12 @@ func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*
 		version = v
 	}
 
-	relationships := make([]*v1.Tuple, 0, len(request.GetTuples()))
-
-	for _, tup := range request.GetTuples() {
-		definition, _, err := r.sr.ReadEntityDefinition(ctx, request.GetTenantId(), tup.GetEntity().GetType(), version)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return nil, err
-		}
-
-		err = validation.ValidateTuple(definition, tup)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return nil, err
-		}
-
-		relationships = append(relationships, tup)
-	}
-
-	attributes := make([]*v1.Attribute, 0, len(request.GetAttributes()))
-
-	for _, attribute := range request.GetAttributes() {
-		definition, _, err := r.sr.ReadEntityDefinition(ctx, request.GetTenantId(), attribute.GetEntity().GetType(), version)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return nil, err
-		}
-
-		err = validation.ValidateAttribute(definition, attribute)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return nil, err
-		}
-
-		attributes = append(attributes, attribute)
+	err := r.validateData(ctx, span, request.GetTenantId(), version, request.GetTuples(), request.GetAttributes(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection(attributes...))
+	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(request.GetTuples()...), database.NewAttributeCollection(request.GetAttributes()...))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -232,9 +264,30 @@ func (r *DataServer) WriteRelationships(ctx context.Context, request *v1.Relatio
 			return nil, err
 		}
 
+		err = r.validateIDs(ctx, request.GetTenantId(), version, definition, tup)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+
 		relationships = append(relationships, tup)
 	}
 
+	err := r.validateCardinality(ctx, request.GetTenantId(), version, relationships, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	err = r.validateStrictly(ctx, request.GetTenantId(), version, relationships, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
 	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection())
 	if err != nil {
 		span.RecordError(err)
@@ -303,3 +356,306 @@ func (r *DataServer) DeleteRelationships(ctx context.Context, request *v1.Relati
 		SnapToken: snap.String(),
 	}, nil
 }
+
+// RunTransaction - Writes and deletes relationships and attributes in a single transaction, so that they share one
+// snapshot
+func (r *DataServer) RunTransaction(ctx context.Context, request *v1.DataTransactionRequest) (*v1.DataTransactionResponse, error) {
+	ctx, span := tracer.Start(ctx, "data.run-transaction")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		v, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		version = v
+	}
+
+	transaction := database.NewTransaction()
+	for _, operation := range request.GetOperations() {
+		switch op := operation.GetType().(type) {
+		case *v1.DataOperation_Write:
+			transaction.Write(op.Write.GetTuples(), op.Write.GetAttributes())
+		case *v1.DataOperation_Delete:
+			err := validation.ValidateFilters(op.Delete.GetTupleFilter(), op.Delete.GetAttributeFilter())
+			if err != nil {
+				return nil, status.Error(GetStatus(err), err.Error())
+			}
+			transaction.Delete(op.Delete.GetTupleFilter(), op.Delete.GetAttributeFilter())
+		}
+	}
+
+	err := r.validateData(ctx, span, request.GetTenantId(), version, transaction.Tuples.GetTuples(), transaction.Attributes.GetAttributes(), transaction.TupleFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := r.dw.RunTransaction(ctx, request.GetTenantId(), transaction)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.DataTransactionResponse{
+		SnapToken: snap.String(),
+	}, nil
+}
+
+// validateData - Validates the relationships and attributes written to the tenant against the schema version, and
+// checks that the entities stay within the cardinality of their relations once the relationships matching the
+// deleted filters are deleted and the relationships are written
+func (r *DataServer) validateData(ctx context.Context, span trace.Span, tenantID, version string, relationships []*v1.Tuple, attributes []*v1.Attribute, deleted []*v1.TupleFilter) error {
+	for _, tup := range relationships {
+		definition, _, err := r.sr.ReadEntityDefinition(ctx, tenantID, tup.GetEntity().GetType(), version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+
+		err = validation.ValidateTuple(definition, tup)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+
+		err = r.validateIDs(ctx, tenantID, version, definition, tup)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return status.Error(GetStatus(err), err.Error())
+		}
+	}
+
+	err := r.validateCardinality(ctx, tenantID, version, relationships, deleted)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	for _, attribute := range attributes {
+		definition, _, err := r.sr.ReadEntityDefinition(ctx, tenantID, attribute.GetEntity().GetType(), version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+
+		err = validation.ValidateAttribute(definition, attribute)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+
+		err = validation.ValidateID(definition, attribute.GetEntity().GetId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return status.Error(GetStatus(err), err.Error())
+		}
+	}
+
+	err = r.validateStrictly(ctx, tenantID, version, relationships, attributes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	return nil
+}
+
+// validateStrictly - Validates the relationships and attributes written to the tenant against its head schema
+// version as well, when its writes are validated strictly and the request names another version, so that clients
+// pinned to an older version cannot write data the active schema does not allow
+func (r *DataServer) validateStrictly(ctx context.Context, tenantID, version string, tuples []*v1.Tuple, attributes []*v1.Attribute) error {
+	if !r.strict {
+		return nil
+	}
+	if _, ok := r.exempt[tenantID]; ok {
+		return nil
+	}
+	if _, ok := r.strictTenants[tenantID]; !ok && len(r.strictTenants) > 0 {
+		return nil
+	}
+
+	head, err := r.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if head == version {
+		return nil
+	}
+
+	for _, tup := range tuples {
+		definition, _, err := r.sr.ReadEntityDefinition(ctx, tenantID, tup.GetEntity().GetType(), head)
+		if err != nil {
+			return err
+		}
+		err = validation.ValidateTuple(definition, tup)
+		if err != nil {
+			return err
+		}
+		if !tuple.IsWildcardSubject(tup.GetSubject()) {
+			_, _, err = r.sr.ReadEntityDefinition(ctx, tenantID, tup.GetSubject().GetType(), head)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, attribute := range attributes {
+		definition, _, err := r.sr.ReadEntityDefinition(ctx, tenantID, attribute.GetEntity().GetType(), head)
+		if err != nil {
+			return err
+		}
+		err = validation.ValidateAttribute(definition, attribute)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateIDs - Checks the ids of the entity and, unless it is a wildcard, of the subject of the relationship
+// against the id formats of their entity types
+func (r *DataServer) validateIDs(ctx context.Context, tenantID, version string, definition *v1.EntityDefinition, tup *v1.Tuple) error {
+	err := validation.ValidateID(definition, tup.GetEntity().GetId())
+	if err != nil {
+		return err
+	}
+
+	if tuple.IsWildcardSubject(tup.GetSubject()) {
+		return nil
+	}
+
+	subject, _, err := r.sr.ReadEntityDefinition(ctx, tenantID, tup.GetSubject().GetType(), version)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateID(subject, tup.GetSubject().GetId())
+}
+
+// validateCardinality - Checks that the entities the relationships are written to stay within the cardinality of
+// their relations once the relationships are written, the stored relationships matching the deleted filters aside.
+// Deletions are not checked, so that a relationship of a relation of exactly one subject can be replaced by deleting
+// it and writing the new one.
+func (r *DataServer) validateCardinality(ctx context.Context, tenantID, version string, relationships []*v1.Tuple, deleted []*v1.TupleFilter) error {
+	entities := map[string]*v1.Entity{}
+	written := map[string][]*v1.Tuple{}
+	for _, tup := range relationships {
+		key := tuple.EntityToString(tup.GetEntity())
+		if _, ok := entities[key]; !ok {
+			entities[key] = tup.GetEntity()
+		}
+		written[key] = append(written[key], tup)
+	}
+
+	var snap string
+	definitions := map[string]*v1.EntityDefinition{}
+	for key, entity := range entities {
+		definition, ok := definitions[entity.GetType()]
+		if !ok {
+			var err error
+			definition, _, err = r.sr.ReadEntityDefinition(ctx, tenantID, entity.GetType(), version)
+			if err != nil {
+				return err
+			}
+			definitions[entity.GetType()] = definition
+		}
+		if !hasCardinality(definition) {
+			continue
+		}
+
+		if snap == "" {
+			st, err := r.dr.HeadSnapshot(ctx, tenantID)
+			if err != nil {
+				return err
+			}
+			snap = st.Encode().String()
+		}
+
+		it, err := r.dr.QueryRelationships(ctx, tenantID, &v1.TupleFilter{
+			Entity: &v1.EntityFilter{Type: entity.GetType(), Ids: []string{entity.GetId()}},
+		}, snap)
+		if err != nil {
+			return err
+		}
+
+		// Count the distinct relationships of the entity by relation, the stored ones that are not deleted along with
+		// the written ones
+		seen := map[string]struct{}{}
+		counts := map[string]int{}
+		count := func(tup *v1.Tuple) {
+			k := tuple.ToString(tup)
+			if _, ok := seen[k]; ok {
+				return
+			}
+			seen[k] = struct{}{}
+			counts[tup.GetRelation()]++
+		}
+		var stored []*v1.Tuple
+		for it.HasNext() {
+			stored = append(stored, it.GetNext())
+		}
+		removed := map[string]struct{}{}
+		for _, filter := range deleted {
+			if validation.IsTupleFilterEmpty(filter) {
+				continue
+			}
+			dit, err := storageContext.NewContextualTuples(stored...).QueryRelationships(filter)
+			if err != nil {
+				return err
+			}
+			for dit.HasNext() {
+				removed[tuple.ToString(dit.GetNext())] = struct{}{}
+			}
+		}
+		for _, tup := range stored {
+			if _, ok := removed[tuple.ToString(tup)]; !ok {
+				count(tup)
+			}
+		}
+		for _, tup := range written[key] {
+			count(tup)
+		}
+
+		err = validation.ValidateCardinality(definition, entity, counts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasCardinality - Reports whether any relation of the entity definition has a cardinality
+func hasCardinality(definition *v1.EntityDefinition) bool {
+	for _, relation := range definition.GetRelations() {
+		if relation.GetCardinality() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot - Resolves the snapshot to read at, which is the one current at the requested time or the latest one
+func (r *DataServer) snapshot(ctx context.Context, tenantID string, at *timestamppb.Timestamp) (token.SnapToken, error) {
+	if at != nil {
+		return r.dr.SnapshotAt(ctx, tenantID, at.AsTime())
+	}
+	return r.dr.HeadSnapshot(ctx, tenantID)
+}
@@ -0,0 +1,192 @@
+package servers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/pkg/attribute"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestDataServer_StrictValidation(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	schemas := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+
+	versions := map[string][2]string{}
+	for _, tenant := range []string{"t1", "t2", "t3"} {
+		// The older version allows the editors and the public attribute the head version has since removed
+		old, err := schemas.Write(ctx, &v1.SchemaWriteRequest{
+			TenantId: tenant,
+			Schema:   "entity user {}\nentity doc {\n\trelation viewer @user\n\trelation editor @user\n\tattribute public boolean\n}",
+		})
+		require.NoError(t, err)
+		head, err := schemas.Write(ctx, &v1.SchemaWriteRequest{
+			TenantId: tenant,
+			Schema:   "entity user {}\nentity doc {\n\trelation viewer @user\n}",
+		})
+		require.NoError(t, err)
+		versions[tenant] = [2]string{old.GetSchemaVersion(), head.GetSchemaVersion()}
+	}
+
+	server := NewDataServer(factories.DataReaderFactory(db), factories.DataWriterFactory(db), factories.SchemaReaderFactory(db), config.Data{
+		StrictValidation: config.StrictValidation{Enabled: true, Tenants: []string{"t1", "t2"}, Exempt: []string{"t2"}},
+	})
+
+	write := func(tenant, version, relationship string) error {
+		tup, err := tuple.Tuple(relationship)
+		require.NoError(t, err)
+		_, err = server.WriteRelationships(ctx, &v1.RelationshipWriteRequest{
+			TenantId: tenant,
+			Metadata: &v1.RelationshipWriteRequestMetadata{SchemaVersion: version},
+			Tuples:   []*v1.Tuple{tup},
+		})
+		return err
+	}
+
+	// The relationships the head version allows are written whatever the version of the request
+	assert.NoError(t, write("t1", versions["t1"][0], "doc:1#viewer@user:1"))
+	assert.NoError(t, write("t1", versions["t1"][1], "doc:1#viewer@user:2"))
+
+	// The relationships only the older version allows are rejected for the strict tenant
+	assert.Error(t, write("t1", versions["t1"][0], "doc:1#editor@user:1"))
+
+	// but not for the exempt tenant, nor the tenant not listed
+	assert.NoError(t, write("t2", versions["t2"][0], "doc:1#editor@user:1"))
+	assert.NoError(t, write("t3", versions["t3"][0], "doc:1#editor@user:1"))
+
+	a, err := attribute.Attribute("doc:1$public|boolean:true")
+	require.NoError(t, err)
+	_, err = server.Write(ctx, &v1.DataWriteRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.DataWriteRequestMetadata{SchemaVersion: versions["t1"][0]},
+		Attributes: []*v1.Attribute{a},
+	})
+	assert.Error(t, err)
+
+	_, err = server.Write(ctx, &v1.DataWriteRequest{
+		TenantId:   "t3",
+		Metadata:   &v1.DataWriteRequestMetadata{SchemaVersion: versions["t3"][0]},
+		Attributes: []*v1.Attribute{a},
+	})
+	assert.NoError(t, err)
+}
+
+func TestDataServer_RunTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	schemas := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+	_, err = schemas.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity user {}\nentity doc {\n\trelation owner @user (exactly 1)\n\trelation viewer @user\n\tattribute public boolean\n}",
+	})
+	require.NoError(t, err)
+
+	server := NewDataServer(factories.DataReaderFactory(db), factories.DataWriterFactory(db), factories.SchemaReaderFactory(db), config.Data{})
+
+	tuples := func(relationships ...string) []*v1.Tuple {
+		var tuples []*v1.Tuple
+		for _, relationship := range relationships {
+			tup, err := tuple.Tuple(relationship)
+			require.NoError(t, err)
+			tuples = append(tuples, tup)
+		}
+		return tuples
+	}
+	read := func() []string {
+		resp, err := server.ReadRelationships(ctx, &v1.RelationshipReadRequest{
+			TenantId: "t1",
+			Metadata: &v1.RelationshipReadRequestMetadata{},
+			Filter:   &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc"}},
+		})
+		require.NoError(t, err)
+		var relationships []string
+		for _, tup := range resp.GetTuples() {
+			relationships = append(relationships, tuple.ToString(tup))
+		}
+		return relationships
+	}
+	write := func(tuples []*v1.Tuple, attributes ...*v1.Attribute) *v1.DataOperation {
+		return &v1.DataOperation{Type: &v1.DataOperation_Write{Write: &v1.DataOperationWrite{Tuples: tuples, Attributes: attributes}}}
+	}
+	deletion := func(filter *v1.TupleFilter) *v1.DataOperation {
+		return &v1.DataOperation{Type: &v1.DataOperation_Delete{Delete: &v1.DataOperationDelete{TupleFilter: filter, AttributeFilter: &v1.AttributeFilter{}}}}
+	}
+	run := func(operations ...*v1.DataOperation) (*v1.DataTransactionResponse, error) {
+		return server.RunTransaction(ctx, &v1.DataTransactionRequest{
+			TenantId:   "t1",
+			Metadata:   &v1.DataTransactionRequestMetadata{},
+			Operations: operations,
+		})
+	}
+
+	public, err := attribute.Attribute("doc:1$public|boolean:true")
+	require.NoError(t, err)
+
+	resp, err := run(write(tuples("doc:1#owner@user:1", "doc:1#viewer@user:1"), public), write(tuples("doc:1#viewer@user:2")))
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.GetSnapToken())
+	assert.ElementsMatch(t, []string{"doc:1#owner@user:1", "doc:1#viewer@user:1", "doc:1#viewer@user:2"}, read())
+
+	// The owner is replaced, since the deletions are applied before the writes whatever the order of the operations
+	_, err = run(
+		write(tuples("doc:1#owner@user:2")),
+		deletion(&v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"1"}}, Relation: "owner"}),
+		deletion(&v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"1"}}, Relation: "viewer", Subject: &v1.SubjectFilter{Type: "user", Ids: []string{"1"}}}),
+	)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"doc:1#owner@user:2", "doc:1#viewer@user:2"}, read())
+
+	// Nothing is applied when an operation is invalid
+	_, err = run(
+		deletion(&v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"1"}}, Relation: "viewer"}),
+		write(tuples("doc:1#owner@user:3")),
+	)
+	assert.Error(t, err)
+	_, err = run(
+		deletion(&v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"1"}}, Relation: "viewer"}),
+		write(tuples("doc:1#editor@user:3")),
+	)
+	assert.Error(t, err)
+	_, err = run(deletion(&v1.TupleFilter{}))
+	assert.Error(t, err)
+	assert.ElementsMatch(t, []string{"doc:1#owner@user:2", "doc:1#viewer@user:2"}, read())
+
+	attributes, err := server.ReadAttributes(ctx, &v1.AttributeReadRequest{
+		TenantId: "t1",
+		Metadata: &v1.AttributeReadRequestMetadata{},
+		Filter:   &v1.AttributeFilter{Entity: &v1.EntityFilter{Type: "doc"}},
+	})
+	require.NoError(t, err)
+	assert.Len(t, attributes.GetAttributes(), 1)
+}
+
+func TestDataServer_SnapshotTimeWithoutHistory(t *testing.T) {
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+	server := NewDataServer(factories.DataReaderFactory(db), factories.DataWriterFactory(db), factories.SchemaReaderFactory(db), config.Data{})
+
+	// The memory engine keeps no history, so past states are refused rather than read from the current one
+	_, err = server.ReadRelationships(context.Background(), &v1.RelationshipReadRequest{
+		TenantId: "t1",
+		Metadata: &v1.RelationshipReadRequestMetadata{SnapshotTime: timestamppb.New(time.Now().Add(-time.Hour))},
+		Filter:   &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc"}},
+	})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -1,6 +1,8 @@
 package servers
 
 import (
+	"strings"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -16,11 +18,20 @@ func GetStatus(err error) codes.Code {
 	}
 
 	// If this wasn't a custom error, continue with your existing logic...
-	code, ok := base.ErrorCode_value[err.Error()]
+	// Errors may carry details after the error code, as in ERROR_CODE_RELATION_CARDINALITY: <details>
+	name, _, _ := strings.Cut(err.Error(), ":")
+	code, ok := base.ErrorCode_value[name]
 	if !ok {
 		return codes.Internal
 	}
+	return ErrorCodeStatus(base.ErrorCode(code))
+}
+
+// ErrorCodeStatus - Get the status code of the requests failing with the error code
+func ErrorCodeStatus(code base.ErrorCode) codes.Code {
 	switch {
+	case code == base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED:
+		return codes.Unimplemented
 	case code > 999 && code < 1999:
 		return codes.Unauthenticated
 	case code > 1999 && code < 2999:
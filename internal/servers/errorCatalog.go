@@ -0,0 +1,413 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// ErrorDomain is the domain of the ErrorInfo details of the errors of the server
+	ErrorDomain = "permify.co"
+	// DefaultLocale is the locale of the built-in messages of the error codes
+	DefaultLocale = "en"
+)
+
+// errorMessages are the built-in messages of the error codes
+var errorMessages = map[base.ErrorCode]string{
+	// authn
+	base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN:     "The request is missing a bearer token.",
+	base.ErrorCode_ERROR_CODE_UNAUTHENTICATED:          "The request could not be authenticated.",
+	base.ErrorCode_ERROR_CODE_MISSING_TENANT_ID:        "The request is missing a tenant.",
+	base.ErrorCode_ERROR_CODE_INVALID_BUNDLE_SIGNATURE: "The signature of the bundle is not valid.",
+
+	// validation
+	base.ErrorCode_ERROR_CODE_VALIDATION:                                        "The request is not valid.",
+	base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE:                              "The schema uses an undefined child type.",
+	base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND:                              "The schema uses an undefined child kind.",
+	base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE:                      "The schema references an undefined relation.",
+	base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK:                       "The relation can't be walked.",
+	base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL:                "The entity and the subject can't be equal.",
+	base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH:                                  "The depth of the request is not enough to evaluate it.",
+	base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES: "The relation reference is not found in the entity references.",
+	base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_MUST_HAVE_ONE_ENTITY_REFERENCE: "The relation reference must have exactly one entity reference.",
+	base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE:                       "The schema defines an entity more than once.",
+	base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE:                     "The schema defines a relation more than once.",
+	base.ErrorCode_ERROR_CODE_DUPLICATED_PERMISSION_REFERENCE:                   "The schema defines a permission more than once.",
+	base.ErrorCode_ERROR_CODE_SCHEMA_PARSE:                                      "The schema could not be parsed.",
+	base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE:                                    "The schema could not be compiled.",
+	base.ErrorCode_ERROR_CODE_SUBJECT_RELATION_MUST_BE_EMPTY:                    "The relation of the subject must be empty.",
+	base.ErrorCode_ERROR_CODE_SUBJECT_RELATION_CANNOT_BE_EMPTY:                  "The relation of the subject can't be empty.",
+	base.ErrorCode_ERROR_CODE_SCHEMA_MUST_HAVE_USER_ENTITY_DEFINITION:           "The schema must define the user entity.",
+	base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT:                                 "The data exists already.",
+	base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN:                          "The continuous token is not valid.",
+	base.ErrorCode_ERROR_CODE_INVALID_KEY:                                       "The key is not valid.",
+	base.ErrorCode_ERROR_CODE_ENTITY_TYPE_REQUIRED:                              "The entity type is required.",
+	base.ErrorCode_ERROR_CODE_NO_ENTITY_REFERENCES_FOUND_IN_SCHEMA:              "The schema doesn't define any entity.",
+	base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT:                                  "An argument of the request is not valid.",
+	base.ErrorCode_ERROR_CODE_INVALID_RULE_REFERENCE:                            "The schema references an undefined rule.",
+	base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_WALK:                                "The permission can't be walked.",
+	base.ErrorCode_ERROR_CODE_MISSING_ARGUMENT:                                  "An argument of the rule is missing.",
+	base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED:                                  "The snapshot has expired.",
+	base.ErrorCode_ERROR_CODE_RELATION_CARDINALITY:                              "The relation has more subjects than its cardinality allows.",
+	base.ErrorCode_ERROR_CODE_INVALID_ID_FORMAT:                                 "The identifier does not match the format of its type.",
+	base.ErrorCode_ERROR_CODE_MISSING_REQUIRED_ATTRIBUTE:                        "A required attribute of the entity is missing.",
+	base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED:                            "The idempotency key was used for a different request.",
+	base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT:                           "The latest schema version is not the one the request is based on.",
+	base.ErrorCode_ERROR_CODE_CYCLE_DETECTED:                                    "The permission refers back to itself, in the schema or through relationships.",
+	base.ErrorCode_ERROR_CODE_REQUEST_LIMIT_EXCEEDED:                            "The request is larger than the server allows.",
+
+	// not found
+	base.ErrorCode_ERROR_CODE_NOT_FOUND:                       "The requested resource is not found.",
+	base.ErrorCode_ERROR_CODE_ENTITY_TYPE_NOT_FOUND:           "The entity type is not found.",
+	base.ErrorCode_ERROR_CODE_PERMISSION_NOT_FOUND:            "The permission is not found.",
+	base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND:                "The schema is not found.",
+	base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND:          "The subject type is not found.",
+	base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND:     "The entity definition is not found.",
+	base.ErrorCode_ERROR_CODE_PERMISSION_DEFINITION_NOT_FOUND: "The permission definition is not found.",
+	base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND:   "The relation definition is not found.",
+	base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND:                "The record is not found.",
+	base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND:                "The tenant is not found.",
+	base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND:  "The attribute definition is not found.",
+	base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH:         "The value of the attribute doesn't match its type.",
+
+	// internal
+	base.ErrorCode_ERROR_CODE_INTERNAL:                                  "An internal error occurred.",
+	base.ErrorCode_ERROR_CODE_CANCELLED:                                 "The request was cancelled.",
+	base.ErrorCode_ERROR_CODE_SQL_BUILDER:                               "The database query could not be built.",
+	base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER:                           "The request was rejected by the circuit breaker.",
+	base.ErrorCode_ERROR_CODE_EXECUTION:                                 "The database query could not be executed.",
+	base.ErrorCode_ERROR_CODE_SCAN:                                      "The database results could not be read.",
+	base.ErrorCode_ERROR_CODE_MIGRATION:                                 "The database could not be migrated.",
+	base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION:                         "A value could not be converted.",
+	base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES:                         "The request failed after the maximum number of retries.",
+	base.ErrorCode_ERROR_CODE_ROLLBACK:                                  "The transaction could not be rolled back.",
+	base.ErrorCode_ERROR_CODE_EXCLUSION_REQUIRES_MORE_THAN_ONE_FUNCTION: "An exclusion requires more than one operand.",
+	base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED:                           "The operation is not implemented.",
+	base.ErrorCode_ERROR_CODE_CHECK_TIMEOUT:                             "The evaluation of the check took longer than the server allows.",
+}
+
+// statusErrorCodes are the error codes of the errors of the status codes, for errors without an error code
+var statusErrorCodes = map[codes.Code]base.ErrorCode{
+	codes.Unauthenticated: base.ErrorCode_ERROR_CODE_UNAUTHENTICATED,
+	codes.InvalidArgument: base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+	codes.NotFound:        base.ErrorCode_ERROR_CODE_NOT_FOUND,
+	codes.Canceled:        base.ErrorCode_ERROR_CODE_CANCELLED,
+	codes.Unimplemented:   base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED,
+	codes.Internal:        base.ErrorCode_ERROR_CODE_INTERNAL,
+	codes.Unknown:         base.ErrorCode_ERROR_CODE_INTERNAL,
+}
+
+// ErrorCatalog - Catalog of the error codes of the server, with their messages by locale. It attaches structured
+// details to the errors of the requests, so clients map failures to behavior by code instead of matching messages.
+type ErrorCatalog struct {
+	// messages are the messages of the error codes by lowercase locale, along with the built-in ones
+	messages map[string]map[base.ErrorCode]string
+	// verbose is whether the errors of internal failures keep their underlying details
+	verbose bool
+}
+
+// NewErrorCatalog - Creates new ErrorCatalog with the messages of the error codes by locale, such as
+// {"de": {"ERROR_CODE_TENANT_NOT_FOUND": "..."}}, along with the built-in messages. The messages of a locale
+// override the built-in ones of the error codes they are set for.
+func NewErrorCatalog(messages map[string]map[string]string) (*ErrorCatalog, error) {
+	c := &ErrorCatalog{messages: map[string]map[base.ErrorCode]string{DefaultLocale: {}}, verbose: true}
+	for code, message := range errorMessages {
+		c.messages[DefaultLocale][code] = message
+	}
+
+	for locale, localized := range messages {
+		locale = strings.ToLower(locale)
+		if _, ok := c.messages[locale]; !ok {
+			c.messages[locale] = map[base.ErrorCode]string{}
+		}
+		for name, message := range localized {
+			// Keys of configuration files are lowercased when they are read.
+			code, ok := base.ErrorCode_value[strings.ToUpper(name)]
+			if !ok || code == 0 {
+				return nil, fmt.Errorf("unknown error code %s of the messages of locale %s", name, locale)
+			}
+			c.messages[locale][base.ErrorCode(code)] = message
+		}
+	}
+
+	return c, nil
+}
+
+// SetVerbose sets whether the errors of internal failures keep their underlying details, such as the errors of the
+// database, in their messages and ErrorInfo details. Without them, they only carry their error codes and the messages
+// of the catalog. They are kept by default.
+func (c *ErrorCatalog) SetVerbose(verbose bool) {
+	c.verbose = verbose
+}
+
+// Codes returns the error codes of the catalog, ordered by number
+func (c *ErrorCatalog) Codes() []base.ErrorCode {
+	list := make([]base.ErrorCode, 0, len(base.ErrorCode_name))
+	for number := range base.ErrorCode_name {
+		if number != 0 {
+			list = append(list, base.ErrorCode(number))
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// Locale returns the first of the locales the catalog has messages in, matching them by language when it doesn't
+// have their region, e.g. de for de-CH. It is the default locale if it has none of them.
+func (c *ErrorCatalog) Locale(locales ...string) string {
+	for _, locale := range locales {
+		locale = strings.ToLower(locale)
+		if _, ok := c.messages[locale]; ok {
+			return locale
+		}
+		if language, _, ok := strings.Cut(locale, "-"); ok {
+			if _, ok := c.messages[language]; ok {
+				return language
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// Message returns the message of the error code in the locale, or its built-in message if the locale doesn't have
+// one, along with the locale of the message
+func (c *ErrorCatalog) Message(code base.ErrorCode, locale string) (string, string) {
+	if message, ok := c.messages[strings.ToLower(locale)][code]; ok {
+		return message, strings.ToLower(locale)
+	}
+	return c.messages[DefaultLocale][code], DefaultLocale
+}
+
+// UnaryServerInterceptor - Returns an interceptor attaching the details of the error codes to the errors of the
+// unary requests
+func (c *ErrorCatalog) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, c.Error(ctx, req, err)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor - Returns an interceptor attaching the details of the error codes to the errors of the
+// streams
+func (c *ErrorCatalog) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		recording := &recordingStream{ServerStream: stream}
+		if err := handler(srv, recording); err != nil {
+			return c.Error(stream.Context(), recording.req, err)
+		}
+		return nil
+	}
+}
+
+// Error returns the error of the request with the details of its error code: an ErrorInfo with the error code as
+// its reason, a LocalizedMessage in the locale of the request and, for invalid requests, a BadRequest with the
+// offending fields. Validation errors are returned as invalid arguments, and the status code and the message of
+// other errors are kept.
+func (c *ErrorCatalog) Error(ctx context.Context, req interface{}, err error) error {
+	st, ok := status.FromError(err)
+	violations := fieldViolations("", err)
+	switch {
+	case !ok && len(violations) > 0:
+		st = status.New(codes.InvalidArgument, err.Error())
+	case !ok:
+		return err
+	case st.Code() == codes.InvalidArgument && len(errorCode(st.Message())) == 0:
+		// Requests failing validation before reaching the services are validated again to find the fields.
+		violations = fieldViolations("", validate(req))
+	}
+
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.ErrorInfo); ok {
+			return err
+		}
+	}
+
+	code, detail := base.ErrorCode_ERROR_CODE_UNSPECIFIED, ""
+	if name := errorCode(st.Message()); name != "" {
+		code = base.ErrorCode(base.ErrorCode_value[name])
+		_, detail, _ = strings.Cut(st.Message(), ":")
+	} else if len(violations) > 0 {
+		code = base.ErrorCode_ERROR_CODE_VALIDATION
+	} else {
+		code = statusErrorCodes[st.Code()]
+	}
+	if code == base.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		return st.Err()
+	}
+	if !c.verbose && code >= base.ErrorCode_ERROR_CODE_INTERNAL {
+		terse := st.Proto()
+		terse.Message = code.String()
+		st, detail = status.FromProto(terse), ""
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason:   code.String(),
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"code": strconv.Itoa(int(code))},
+	}
+	if detail = strings.TrimSpace(detail); detail != "" {
+		info.Metadata["detail"] = detail
+	}
+	message, locale := c.Message(code, c.Locale(RequestLocales(ctx)...))
+	details := []protoiface.MessageV1{info, &errdetails.LocalizedMessage{Locale: locale, Message: message}}
+	if len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	withDetails, derr := st.WithDetails(details...)
+	if derr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// RequestLocales returns the locales of the Accept-Language header of the request, in order of preference
+func RequestLocales(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	// The gateway forwards the headers of HTTP requests with a prefix.
+	values := append(md.Get("accept-language"), md.Get("grpcgateway-accept-language")...)
+
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var tags []weighted
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			locale, params, _ := strings.Cut(strings.TrimSpace(tag), ";")
+			q := 1.0
+			if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+			if locale != "" && locale != "*" && q > 0 {
+				tags = append(tags, weighted{locale: locale, q: q})
+			}
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	locales := make([]string, len(tags))
+	for i, tag := range tags {
+		locales[i] = tag.locale
+	}
+	return locales
+}
+
+// errorCode returns the name of the error code the message starts with, as in ERROR_CODE_RELATION_CARDINALITY:
+// <details>, or an empty string if it doesn't start with one.
+func errorCode(message string) string {
+	name, _, _ := strings.Cut(message, ":")
+	if code, ok := base.ErrorCode_value[name]; ok && code != 0 {
+		return name
+	}
+	return ""
+}
+
+// validationError - Validation error of a field of a message
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// validate returns the validation errors of all the fields of the request, if it is valid.
+func validate(req interface{}) error {
+	switch v := req.(type) {
+	case interface{ ValidateAll() error }:
+		return v.ValidateAll()
+	case interface{ Validate() error }:
+		return v.Validate()
+	default:
+		return nil
+	}
+}
+
+// fieldViolations returns the offending fields of the validation error, as paths of the fields of the request
+// such as entity.id or tuples[0].subject.type.
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if err == nil {
+		return nil
+	}
+
+	var multi interface{ AllErrors() []error }
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, e)...)
+		}
+		return violations
+	}
+
+	var v validationError
+	if !errors.As(err, &v) {
+		return nil
+	}
+	field := fieldPath(v.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	// Errors of embedded messages are reported at the fields of the messages that failed.
+	if nested := fieldViolations(field, v.Cause()); len(nested) > 0 {
+		return nested
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: v.Reason()}}
+}
+
+// fieldPath converts the Go name of a field to the name of the field of the request, e.g. TenantId[0] to
+// tenant_id[0]. Keys and indexes in brackets are kept as they are.
+func fieldPath(name string) string {
+	var b strings.Builder
+	depth := 0
+	for i, r := range name {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0 && unicode.IsUpper(r):
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// recordingStream - Server stream recording the first message it receives, the request of server streams
+type recordingStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+// RecvMsg receives the message, recording it if it is the first one
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
@@ -0,0 +1,127 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// errorDetails returns the details of the status of the error, by type.
+func errorDetails(t *testing.T, err error) (*errdetails.ErrorInfo, *errdetails.LocalizedMessage, *errdetails.BadRequest) {
+	var info *errdetails.ErrorInfo
+	var message *errdetails.LocalizedMessage
+	var request *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.LocalizedMessage:
+			message = d
+		case *errdetails.BadRequest:
+			request = d
+		}
+	}
+	require.NotNil(t, info)
+	return info, message, request
+}
+
+func TestErrorCatalog_Error(t *testing.T) {
+	catalog, err := NewErrorCatalog(map[string]map[string]string{
+		"DE": {"ERROR_CODE_TENANT_NOT_FOUND": "Der Mandant wurde nicht gefunden."},
+	})
+	require.NoError(t, err)
+
+	// Errors of the services keep their status and message.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-accept-language", "fr-FR;q=0.5, de-CH"))
+	err = catalog.Error(ctx, nil, status.Error(codes.Internal, "ERROR_CODE_TENANT_NOT_FOUND"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "ERROR_CODE_TENANT_NOT_FOUND", status.Convert(err).Message())
+	info, message, _ := errorDetails(t, err)
+	assert.Equal(t, "ERROR_CODE_TENANT_NOT_FOUND", info.GetReason())
+	assert.Equal(t, ErrorDomain, info.GetDomain())
+	assert.Equal(t, "4009", info.GetMetadata()["code"])
+	assert.Equal(t, "de", message.GetLocale())
+	assert.Equal(t, "Der Mandant wurde nicht gefunden.", message.GetMessage())
+
+	err = catalog.Error(context.Background(), nil, status.Error(codes.InvalidArgument, "ERROR_CODE_RELATION_CARDINALITY: document:1#owner allows 1"))
+	info, message, _ = errorDetails(t, err)
+	assert.Equal(t, "ERROR_CODE_RELATION_CARDINALITY", info.GetReason())
+	assert.Equal(t, "document:1#owner allows 1", info.GetMetadata()["detail"])
+	assert.Equal(t, "en", message.GetLocale())
+
+	// Validation errors are invalid arguments, with the paths of the offending fields.
+	request := &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionCheckRequestMetadata{Depth: 20},
+		Entity:     &v1.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &v1.Subject{Type: "user", Id: "not valid!"},
+	}
+	err = catalog.Error(context.Background(), request, request.Validate())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	info, _, badRequest := errorDetails(t, err)
+	assert.Equal(t, "ERROR_CODE_VALIDATION", info.GetReason())
+	require.NotNil(t, badRequest)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "subject.id", badRequest.GetFieldViolations()[0].GetField())
+
+	// Requests rejected by the validation interceptor are validated again to find the fields.
+	request.TenantId = ""
+	err = catalog.Error(context.Background(), request, status.Error(codes.InvalidArgument, request.Validate().Error()))
+	_, _, badRequest = errorDetails(t, err)
+	var fields []string
+	for _, violation := range badRequest.GetFieldViolations() {
+		fields = append(fields, violation.GetField())
+	}
+	assert.ElementsMatch(t, []string{"tenant_id", "subject.id"}, fields)
+
+	// Errors without an error code or a status are kept as they are.
+	plain := errors.New("plain")
+	assert.Equal(t, plain, catalog.Error(context.Background(), nil, plain))
+	err = catalog.Error(context.Background(), nil, status.Error(codes.Unavailable, "unavailable"))
+	assert.Empty(t, status.Convert(err).Details())
+
+	_, err = NewErrorCatalog(map[string]map[string]string{"de": {"ERROR_CODE_UNKNOWN": "?"}})
+	assert.Error(t, err)
+}
+
+func TestErrorCatalog_Terse(t *testing.T) {
+	catalog, err := NewErrorCatalog(nil)
+	require.NoError(t, err)
+	catalog.SetVerbose(false)
+
+	// Internal failures only carry their error code.
+	err = catalog.Error(context.Background(), nil, status.Error(codes.Internal, "ERROR_CODE_EXECUTION: pq: relation \"relation_tuples\" does not exist"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "ERROR_CODE_EXECUTION", status.Convert(err).Message())
+	info, message, _ := errorDetails(t, err)
+	assert.Equal(t, "ERROR_CODE_EXECUTION", info.GetReason())
+	assert.NotContains(t, info.GetMetadata(), "detail")
+	assert.Equal(t, "The database query could not be executed.", message.GetMessage())
+
+	err = catalog.Error(context.Background(), nil, errors.New("plain"))
+	assert.Equal(t, "plain", err.Error())
+	err = catalog.Error(context.Background(), nil, status.Error(codes.Unknown, "connection reset"))
+	assert.Equal(t, "ERROR_CODE_INTERNAL", status.Convert(err).Message())
+
+	// Errors of invalid requests keep their details.
+	err = catalog.Error(context.Background(), nil, status.Error(codes.InvalidArgument, "ERROR_CODE_RELATION_CARDINALITY: document:1#owner allows 1"))
+	assert.Equal(t, "ERROR_CODE_RELATION_CARDINALITY: document:1#owner allows 1", status.Convert(err).Message())
+	info, _, _ = errorDetails(t, err)
+	assert.Equal(t, "document:1#owner allows 1", info.GetMetadata()["detail"])
+}
+
+func TestFieldPath(t *testing.T) {
+	assert.Equal(t, "tenant_id", fieldPath("TenantId"))
+	assert.Equal(t, "tuples[0]", fieldPath("Tuples[0]"))
+	assert.Equal(t, `arguments["UserId"]`, fieldPath(`Arguments["UserId"]`))
+}
@@ -0,0 +1,209 @@
+package servers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+
+	"github.com/Permify/permify/internal/config"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// placeholder matches the placeholders of the templates of the rules, {name} or {header:name}
+var placeholder = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// ExtAuthzRules - Compiled rules mapping the HTTP requests to checks
+type ExtAuthzRules struct {
+	conf  config.ExtAuthz
+	rules []extAuthzRule
+}
+
+// extAuthzRule - Rule of the configuration, with its path compiled
+type extAuthzRule struct {
+	config.ExtAuthzRule
+	path *regexp.Regexp
+}
+
+// NewExtAuthzRules - Compiles the rules of the ext_authz configuration
+func NewExtAuthzRules(conf config.ExtAuthz) (*ExtAuthzRules, error) {
+	rules := make([]extAuthzRule, 0, len(conf.Rules))
+	for i, rule := range conf.Rules {
+		// The path is anchored, so that the rules match whole paths and not the paths containing what they match
+		path, err := regexp.Compile(`^(?:` + rule.Path + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("ext_authz rule %d: invalid path: %w", i, err)
+		}
+		if rule.Entity == "" || rule.Permission == "" || rule.Subject == "" {
+			return nil, fmt.Errorf("ext_authz rule %d: entity, permission and subject are required", i)
+		}
+		for _, template := range []string{rule.Tenant, rule.Entity, rule.Subject} {
+			for _, m := range placeholder.FindAllStringSubmatch(template, -1) {
+				if !strings.HasPrefix(m[1], "header:") && path.SubexpIndex(m[1]) < 0 {
+					return nil, fmt.Errorf("ext_authz rule %d: the path has no %s group", i, m[1])
+				}
+			}
+		}
+		rules = append(rules, extAuthzRule{ExtAuthzRule: rule, path: path})
+	}
+	return &ExtAuthzRules{conf: conf, rules: rules}, nil
+}
+
+// Match - Returns the check of the first rule matching the request, false if no rule matches it. An error is returned
+// if the values the rule needs are missing from the request, e.g. the header of its subject.
+func (r *ExtAuthzRules) Match(method, path string, headers map[string]string) (*v1.PermissionCheckRequest, bool, error) {
+	path, _, _ = strings.Cut(path, "?")
+	for _, rule := range r.rules {
+		if len(rule.Methods) > 0 && !containsFold(rule.Methods, method) {
+			continue
+		}
+		groups := rule.path.FindStringSubmatch(path)
+		if groups == nil {
+			continue
+		}
+
+		expand := func(template string) (string, error) {
+			var missing string
+			value := placeholder.ReplaceAllStringFunc(template, func(p string) string {
+				name := p[1 : len(p)-1]
+				var v string
+				if header, ok := strings.CutPrefix(name, "header:"); ok {
+					v = headers[strings.ToLower(header)]
+				} else {
+					v = groups[rule.path.SubexpIndex(name)]
+				}
+				if v == "" && missing == "" {
+					missing = name
+				}
+				return v
+			})
+			if missing != "" {
+				return "", fmt.Errorf("the request has no %s", missing)
+			}
+			return value, nil
+		}
+
+		tenant := r.conf.Tenant
+		if rule.Tenant != "" {
+			t, err := expand(rule.Tenant)
+			if err != nil {
+				return nil, true, err
+			}
+			tenant = t
+		}
+		e, err := expand(rule.Entity)
+		if err != nil {
+			return nil, true, err
+		}
+		entity, err := tuple.E(e)
+		if err != nil {
+			return nil, true, err
+		}
+		s, err := expand(rule.Subject)
+		if err != nil {
+			return nil, true, err
+		}
+		subject, err := tuple.EAR(s)
+		if err != nil {
+			return nil, true, err
+		}
+
+		return &v1.PermissionCheckRequest{
+			TenantId:   tenant,
+			Metadata:   &v1.PermissionCheckRequestMetadata{Depth: r.conf.Depth},
+			Entity:     entity,
+			Permission: rule.Permission,
+			Subject: &v1.Subject{
+				Type:     subject.GetEntity().GetType(),
+				Id:       subject.GetEntity().GetId(),
+				Relation: subject.GetRelation(),
+			},
+		}, true, nil
+	}
+	return nil, false, nil
+}
+
+// containsFold returns whether the values contain the value, ignoring the case
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// checker - Evaluates the checks of the external authorization service
+type checker interface {
+	Check(ctx context.Context, request *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error)
+}
+
+// ExtAuthzServer - Structure for the Envoy external authorization server, allowing the HTTP requests whose check,
+// mapped by the rules, is allowed
+type ExtAuthzServer struct {
+	authv3.UnimplementedAuthorizationServer
+
+	checker checker
+	rules   *ExtAuthzRules
+}
+
+// NewExtAuthzServer - Creates new ExtAuthz Server
+func NewExtAuthzServer(checker checker, rules *ExtAuthzRules) *ExtAuthzServer {
+	return &ExtAuthzServer{
+		checker: checker,
+		rules:   rules,
+	}
+}
+
+// Check - Allows or denies the HTTP request of Envoy. Failed checks are returned as errors, for Envoy to apply its
+// failure mode.
+func (s *ExtAuthzServer) Check(ctx context.Context, request *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	ctx, span := tracer.Start(ctx, "ext-authz.check")
+	defer span.End()
+
+	attributes := request.GetAttributes().GetRequest().GetHttp()
+	check, matched, err := s.rules.Match(attributes.GetMethod(), attributes.GetPath(), attributes.GetHeaders())
+	if err != nil {
+		return extAuthzDenied(err.Error()), nil
+	}
+	if !matched {
+		if s.rules.conf.AllowUnmatched {
+			return extAuthzAllowed(), nil
+		}
+		return extAuthzDenied("no rule matches the request"), nil
+	}
+
+	response, err := s.checker.Check(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+	if response.GetCan() != v1.CheckResult_CHECK_RESULT_ALLOWED {
+		return extAuthzDenied(fmt.Sprintf("%s does not have the %s permission on %s", tuple.SubjectToString(check.GetSubject()),
+			check.GetPermission(), tuple.EntityToString(check.GetEntity()))), nil
+	}
+	return extAuthzAllowed(), nil
+}
+
+// extAuthzAllowed returns the response of ext_authz allowing the request
+func extAuthzAllowed() *authv3.CheckResponse {
+	return &authv3.CheckResponse{
+		Status:       &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{OkResponse: &authv3.OkHttpResponse{}},
+	}
+}
+
+// extAuthzDenied returns the response of ext_authz denying the request with the forbidden status
+func extAuthzDenied(reason string) *authv3.CheckResponse {
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(codes.PermissionDenied), Message: reason},
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{DeniedResponse: &authv3.DeniedHttpResponse{
+			Status: &typev3.HttpStatus{Code: typev3.StatusCode_Forbidden},
+		}},
+	}
+}
@@ -0,0 +1,149 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/Permify/permify/internal/config"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// testChecker - Allows the checks of user:1, failing the checks of user:error
+type testChecker struct {
+	checks []*v1.PermissionCheckRequest
+}
+
+func (c *testChecker) Check(_ context.Context, request *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error) {
+	c.checks = append(c.checks, request)
+	switch request.GetSubject().GetId() {
+	case "error":
+		return nil, errors.New("failed")
+	case "1":
+		return &v1.PermissionCheckResponse{Can: v1.CheckResult_CHECK_RESULT_ALLOWED}, nil
+	default:
+		return &v1.PermissionCheckResponse{Can: v1.CheckResult_CHECK_RESULT_DENIED}, nil
+	}
+}
+
+func TestExtAuthzServer_Check(t *testing.T) {
+	conf := config.ExtAuthz{
+		Enabled: true,
+		Tenant:  "t1",
+		Depth:   20,
+		Rules: []config.ExtAuthzRule{
+			{
+				Methods:    []string{"GET"},
+				Path:       `^/documents/(?P<id>[^/]+)$`,
+				Entity:     "document:{id}",
+				Permission: "view",
+				Subject:    "user:{header:X-User-Id}",
+			},
+			{
+				Methods:    []string{"put", "DELETE"},
+				Path:       `^/teams/(?P<team>[^/]+)/documents/(?P<id>[^/]+)$`,
+				Tenant:     "{header:x-tenant-id}",
+				Entity:     "document:{id}",
+				Permission: "edit",
+				Subject:    "team:{team}#member",
+			},
+		},
+	}
+	rules, err := NewExtAuthzRules(conf)
+	require.NoError(t, err)
+	checker := &testChecker{}
+	server := NewExtAuthzServer(checker, rules)
+
+	check := func(method, path string, headers map[string]string) (*authv3.CheckResponse, error) {
+		return server.Check(context.Background(), &authv3.CheckRequest{
+			Attributes: &authv3.AttributeContext{Request: &authv3.AttributeContext_Request{
+				Http: &authv3.AttributeContext_HttpRequest{Method: method, Path: path, Headers: headers},
+			}},
+		})
+	}
+
+	response, err := check("GET", "/documents/12?format=pdf", map[string]string{"x-user-id": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), response.GetStatus().GetCode())
+	assert.NotNil(t, response.GetOkResponse())
+	require.Len(t, checker.checks, 1)
+	assert.Equal(t, "t1", checker.checks[0].GetTenantId())
+	assert.Equal(t, "document", checker.checks[0].GetEntity().GetType())
+	assert.Equal(t, "12", checker.checks[0].GetEntity().GetId())
+	assert.Equal(t, "view", checker.checks[0].GetPermission())
+	assert.Equal(t, int32(20), checker.checks[0].GetMetadata().GetDepth())
+
+	// Denied checks deny the requests
+	response, err = check("GET", "/documents/12", map[string]string{"x-user-id": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.PermissionDenied), response.GetStatus().GetCode())
+	assert.Contains(t, response.GetStatus().GetMessage(), "user:2 does not have the view permission on document:12")
+	assert.Equal(t, 403, int(response.GetDeniedResponse().GetStatus().GetCode()))
+
+	// Rules may map the tenant and subject sets
+	_, err = check("PUT", "/teams/7/documents/12", map[string]string{"x-tenant-id": "t2"})
+	require.NoError(t, err)
+	require.Len(t, checker.checks, 3)
+	assert.Equal(t, "t2", checker.checks[2].GetTenantId())
+	assert.Equal(t, "team", checker.checks[2].GetSubject().GetType())
+	assert.Equal(t, "7", checker.checks[2].GetSubject().GetId())
+	assert.Equal(t, "member", checker.checks[2].GetSubject().GetRelation())
+
+	// Requests missing the values of their rule, or matching no rule, are denied without a check
+	response, err = check("GET", "/documents/12", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.PermissionDenied), response.GetStatus().GetCode())
+	assert.Contains(t, response.GetStatus().GetMessage(), "header:X-User-Id")
+	response, err = check("POST", "/documents/12", map[string]string{"x-user-id": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.PermissionDenied), response.GetStatus().GetCode())
+	assert.Len(t, checker.checks, 3)
+
+	// Unless they are allowed
+	conf.AllowUnmatched = true
+	rules, err = NewExtAuthzRules(conf)
+	require.NoError(t, err)
+	server = NewExtAuthzServer(checker, rules)
+	response, err = check("POST", "/documents/12", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), response.GetStatus().GetCode())
+
+	// Failed checks fail the request of Envoy
+	_, err = check("GET", "/documents/12", map[string]string{"x-user-id": "error"})
+	assert.Error(t, err)
+}
+
+func TestNewExtAuthzRules(t *testing.T) {
+	_, err := NewExtAuthzRules(config.ExtAuthz{Rules: []config.ExtAuthzRule{
+		{Path: `^/documents/(`, Entity: "document:1", Permission: "view", Subject: "user:1"},
+	}})
+	assert.ErrorContains(t, err, "invalid path")
+
+	_, err = NewExtAuthzRules(config.ExtAuthz{Rules: []config.ExtAuthzRule{
+		{Path: `^/documents/(?P<id>[^/]+)$`, Entity: "document:{document}", Permission: "view", Subject: "user:1"},
+	}})
+	assert.ErrorContains(t, err, "the path has no document group")
+
+	_, err = NewExtAuthzRules(config.ExtAuthz{Rules: []config.ExtAuthzRule{
+		{Path: `^/documents/(?P<id>[^/]+)$`, Entity: "document:{id}", Subject: "user:1"},
+	}})
+	assert.ErrorContains(t, err, "required")
+
+	// Paths match whole paths, even without anchors
+	rules, err := NewExtAuthzRules(config.ExtAuthz{Rules: []config.ExtAuthzRule{
+		{Path: `/documents/(?P<id>\w+)`, Entity: "document:{id}", Permission: "view", Subject: "user:1"},
+	}})
+	require.NoError(t, err)
+	_, ok, err := rules.Match("GET", "/admin/documents/x/delete", nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	check, ok, err := rules.Match("GET", "/documents/x", nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "x", check.GetEntity().GetId())
+}
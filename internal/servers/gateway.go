@@ -0,0 +1,79 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// gatewayScheme is the resolver scheme of the static target list of the gateway.
+const gatewayScheme = "permify-gateway"
+
+// RunGateway runs the HTTP server alone, forwarding requests to the remote gRPC servers of the gateway
+// configuration, so that the REST facade can be scaled independently of the engine nodes.
+func RunGateway(ctx context.Context, srv *config.Server, gateway *config.Gateway) error {
+	gatewayServer, err := NewRemoteGatewayServer(srv, gateway)
+	if err != nil {
+		return err
+	}
+
+	slog.Info(fmt.Sprintf("🚀 forwarding http requests to %v", gateway.Targets))
+
+	return NewLifecycle(gatewayServer).Run(ctx)
+}
+
+// gatewayDialOptions returns the target and the dial options of the connection to the gateway targets.
+// A single target is dialed as is, so that resolvers such as dns:/// or kubernetes:/// can discover the
+// servers behind it. Several targets are resolved statically. Requests are balanced with the configured
+// policy across the resolved addresses.
+func gatewayDialOptions(gateway *config.Gateway) (string, []grpc.DialOption, error) {
+	if len(gateway.Targets) == 0 {
+		return "", nil, errors.New("gateway must have at least one target")
+	}
+
+	policy := gateway.LoadBalancing
+	if policy == "" {
+		policy = "round_robin"
+	}
+	if policy != "round_robin" && policy != "pick_first" {
+		return "", nil, fmt.Errorf("unknown gateway load balancing policy: '%s'", policy)
+	}
+
+	options := []grpc.DialOption{
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig": [{"%s":{}}]}`, policy)),
+	}
+
+	if gateway.TLSConfig.Enabled {
+		c, err := credentials.NewClientTLSFromFile(gateway.TLSConfig.CertPath, "")
+		if err != nil {
+			return "", nil, err
+		}
+		options = append(options, grpc.WithTransportCredentials(c))
+	} else {
+		options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if len(gateway.Targets) == 1 {
+		return gateway.Targets[0], options, nil
+	}
+
+	addresses := make([]resolver.Address, 0, len(gateway.Targets))
+	for _, t := range gateway.Targets {
+		addresses = append(addresses, resolver.Address{Addr: t})
+	}
+	r := manual.NewBuilderWithScheme(gatewayScheme)
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	return r.Scheme() + ":///targets", append(options, grpc.WithResolvers(r)), nil
+}
@@ -2,8 +2,8 @@ package servers
 
 import (
 	"context"
+	"sync"
 
-	"google.golang.org/grpc/codes"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
@@ -11,20 +11,61 @@ import (
 // HealthServer - Structure for Health Server
 type HealthServer struct {
 	health.UnimplementedHealthServer
+
+	mu     sync.Mutex
+	status health.HealthCheckResponse_ServingStatus
+	// changed is closed when the status changes, and replaced
+	changed chan struct{}
 }
 
-// NewHealthServer - Creates new HealthServer Server
+// NewHealthServer - Creates new HealthServer Server, serving
 func NewHealthServer() *HealthServer {
-	return &HealthServer{}
+	return &HealthServer{
+		status:  health.HealthCheckResponse_SERVING,
+		changed: make(chan struct{}),
+	}
+}
+
+// ServingStatus - Returns the status of the server
+func (s *HealthServer) ServingStatus() health.HealthCheckResponse_ServingStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+// SetServingStatus - Changes the status of the server, sent to the streams watching it
+func (s *HealthServer) SetServingStatus(serving health.HealthCheckResponse_ServingStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.status == serving {
+		return
+	}
+	s.status = serving
+	close(s.changed)
+	s.changed = make(chan struct{})
 }
 
 // Check - Return health check status response
 func (s *HealthServer) Check(_ context.Context, _ *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
-	return &health.HealthCheckResponse{Status: health.HealthCheckResponse_SERVING}, nil
+	return &health.HealthCheckResponse{Status: s.ServingStatus()}, nil
 }
 
-// Watch - TO:DO
-func (s *HealthServer) Watch(_ *health.HealthCheckRequest, _ health.Health_WatchServer) error {
-	// Example of how to register both methods but only implement the Check method.
-	return status.Error(codes.Unimplemented, "unimplemented")
+// Watch - Streams the health check status and its changes until the stream ends. The peers dispatching checks
+// to the invoke server watch it to take the node out of their rings when the stream breaks.
+func (s *HealthServer) Watch(_ *health.HealthCheckRequest, server health.Health_WatchServer) error {
+	for {
+		s.mu.Lock()
+		current, changed := s.status, s.changed
+		s.mu.Unlock()
+
+		if err := server.Send(&health.HealthCheckResponse{Status: current}); err != nil {
+			return err
+		}
+
+		select {
+		case <-changed:
+		case <-server.Context().Done():
+			return status.FromContextError(server.Context().Err()).Err()
+		}
+	}
 }
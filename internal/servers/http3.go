@@ -0,0 +1,45 @@
+package servers
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+
+	"github.com/quic-go/quic-go/http3"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// newHTTP3Server creates the HTTP/3 server of the handler, with the certificates obtained automatically if any, and
+// the certificate of the HTTP configuration otherwise. HTTP/3 always runs over TLS, so one of them is required.
+func newHTTP3Server(conf config.HTTP, certificates *autocert.Manager, handler http.Handler) (*http3.Server, error) {
+	var tlsConfig *tls.Config
+	switch {
+	case certificates != nil:
+		tlsConfig = certificates.TLSConfig()
+	case conf.TLSConfig.Enabled:
+		cert, err := tls.LoadX509KeyPair(conf.TLSConfig.CertPath, conf.TLSConfig.KeyPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	default:
+		return nil, errors.New("http3 needs the tls of the http server, or autocert, to be enabled")
+	}
+	return &http3.Server{
+		Handler:        handler,
+		TLSConfig:      tlsConfig,
+		MaxHeaderBytes: conf.MaxHeaderBytes,
+	}, nil
+}
+
+// altSvcHandler advertises the HTTP/3 server in the Alt-Svc header of the responses of the handler, so that clients
+// switch to it for their next requests and fall back to HTTP/2 or HTTP/1.1 if UDP is blocked on their network.
+func altSvcHandler(h3 *http3.Server, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The header is not set until the HTTP/3 server listens.
+		_ = h3.SetQUICHeaders(w.Header())
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,107 @@
+package servers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+// writeTestCertificate writes a self-signed certificate of 127.0.0.1 and its key to the directory, returning their
+// paths and the pool trusting the certificate.
+func writeTestCertificate(t *testing.T, dir string) (string, string, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath, keyPath := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return certPath, keyPath, pool
+}
+
+func TestGatewayServer_HTTP3(t *testing.T) {
+	certPath, keyPath, pool := writeTestCertificate(t, t.TempDir())
+	srv := &config.Server{HTTP: config.HTTP{
+		Enabled:           true,
+		Address:           "127.0.0.1",
+		Port:              "0",
+		TLSConfig:         config.TLSConfig{Enabled: true, CertPath: certPath, KeyPath: keyPath},
+		ReadHeaderTimeout: 5 * time.Second,
+		MaxHeaderBytes:    1 << 20,
+		HTTP3Enabled:      true,
+	}}
+	backend := grpc.NewServer()
+	healthpb.RegisterHealthServer(backend, health.NewServer())
+
+	gateway := NewGatewayServer(srv, backend)
+	require.NoError(t, gateway.Start(context.Background()))
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		assert.NoError(t, gateway.Stop(ctx))
+	}()
+	address := gateway.ListenAddresses()[0]
+	_, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+
+	// The responses over TCP advertise the HTTP/3 server on the UDP port of the same number
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	response, err := client.Get("https://" + address + "/healthz")
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, response.Header.Get("Alt-Svc"), `h3=":`+port+`"`)
+
+	// Which serves the same API
+	roundTripper := &http3.RoundTripper{TLSClientConfig: &tls.Config{RootCAs: pool}}
+	defer roundTripper.Close()
+	response, err = (&http.Client{Transport: roundTripper}).Get("https://" + address + "/healthz")
+	require.NoError(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 3, response.ProtoMajor)
+}
+
+func TestGatewayServer_HTTP3WithoutTLS(t *testing.T) {
+	srv := &config.Server{HTTP: config.HTTP{Enabled: true, Address: "127.0.0.1", Port: "0", HTTP3Enabled: true}}
+	gateway := NewGatewayServer(srv, grpc.NewServer())
+	err := gateway.Start(context.Background())
+	assert.ErrorContains(t, err, "http3 needs the tls")
+	assert.NoError(t, gateway.Stop(context.Background()))
+}
@@ -0,0 +1,180 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// _defaultShutdownTimeout is how long components are given to stop gracefully.
+const _defaultShutdownTimeout = 5 * time.Second
+
+// Component - Part of the servers that is started and stopped on its own, such as the gRPC server or the
+// HTTP gateway
+type Component interface {
+	// Name returns the name of the component, used in logs.
+	Name() string
+	// Start starts the component. It returns once the component is serving, or with the error that kept it
+	// from serving, e.g. a port that is already in use.
+	Start(ctx context.Context) error
+	// Stop stops the component gracefully, giving up on pending work when the context is done.
+	Stop(ctx context.Context) error
+}
+
+// Failer - Component that can fail once started, e.g. when the listener of its server fails
+type Failer interface {
+	// Failed returns a channel receiving the error the component failed with once started.
+	Failed() <-chan error
+}
+
+// StartError - Error of a component that failed to start
+type StartError struct {
+	Component string
+	Err       error
+}
+
+func (e *StartError) Error() string { return fmt.Sprintf("failed to start %s: %v", e.Component, e.Err) }
+
+func (e *StartError) Unwrap() error { return e.Err }
+
+// ServeError - Error of a component that failed once started
+type ServeError struct {
+	Component string
+	Err       error
+}
+
+func (e *ServeError) Error() string { return fmt.Sprintf("failed to serve %s: %v", e.Component, e.Err) }
+
+func (e *ServeError) Unwrap() error { return e.Err }
+
+// StopError - Error of a component that failed to stop gracefully
+type StopError struct {
+	Component string
+	Err       error
+}
+
+func (e *StopError) Error() string { return fmt.Sprintf("failed to stop %s: %v", e.Component, e.Err) }
+
+func (e *StopError) Unwrap() error { return e.Err }
+
+// failure - Error a component fails with once started, implementing Failer for the components embedding it
+type failure struct {
+	once sync.Once
+	ch   chan error
+}
+
+// Failed returns a channel receiving the error the component failed with.
+func (f *failure) Failed() <-chan error {
+	return f.channel()
+}
+
+// fail reports the error the component failed with, keeping only the first one.
+func (f *failure) fail(err error) {
+	select {
+	case f.channel() <- err:
+	default:
+	}
+}
+
+func (f *failure) channel() chan error {
+	f.once.Do(func() {
+		f.ch = make(chan error, 1)
+	})
+	return f.ch
+}
+
+// Lifecycle - Starts components in order, and stops them in reverse order
+type Lifecycle struct {
+	components      []Component
+	shutdownTimeout time.Duration
+}
+
+// NewLifecycle - Creates a new lifecycle of the components
+func NewLifecycle(components ...Component) *Lifecycle {
+	return &Lifecycle{
+		components:      components,
+		shutdownTimeout: _defaultShutdownTimeout,
+	}
+}
+
+// Run starts the components and stops them once the context is done, or once one of them fails. If a component
+// fails to start, the components started before it are stopped. The errors are returned joined, as a StartError,
+// ServeErrors and StopErrors.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	for i, c := range l.components {
+		if err := c.Start(ctx); err != nil {
+			return errors.Join(&StartError{Component: c.Name(), Err: err}, l.stop(l.components[:i]))
+		}
+	}
+	logListeners(l.components)
+
+	failures := make(chan error, len(l.components))
+	done := make(chan struct{})
+	defer close(done)
+	for _, c := range l.components {
+		f, ok := c.(Failer)
+		if !ok {
+			continue
+		}
+		go func(name string, failed <-chan error) {
+			select {
+			case err := <-failed:
+				failures <- &ServeError{Component: name, Err: err}
+			case <-done:
+			}
+		}(c.Name(), f.Failed())
+	}
+
+	// Wait for the context to be canceled (e.g., due to a signal), or for a component to fail.
+	var errs []error
+	select {
+	case <-ctx.Done():
+		slog.Info("gracefully shutting down")
+	case err := <-failures:
+		slog.Error("shutting down after a failure", slog.Any("error", err))
+		errs = append(errs, err)
+	}
+
+	errs = append(errs, l.stop(l.components))
+
+	// Components failing while the others stop are reported as well
+	for {
+		select {
+		case err := <-failures:
+			errs = append(errs, err)
+		default:
+			return errors.Join(errs...)
+		}
+	}
+}
+
+// logListeners logs the addresses the components listen on, once all of them started.
+func logListeners(components []Component) {
+	addresses := listenAddresses(components)
+	if len(addresses) == 0 {
+		return
+	}
+	attrs := make([]any, 0, len(addresses))
+	for _, a := range addresses {
+		attrs = append(attrs, slog.String(a.Server, a.Address))
+	}
+	slog.Info("🚀 listening on", attrs...)
+}
+
+// stop stops the components in reverse order and returns the errors they stopped with.
+func (l *Lifecycle) stop(components []Component) error {
+	ctx, cancel := context.WithTimeout(context.Background(), l.shutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(components) - 1; i >= 0; i-- {
+		if err := components[i].Stop(ctx); err != nil {
+			slog.Error(fmt.Sprintf("failed to stop %s: ", components[i].Name()), slog.Any("error", err))
+			errs = append(errs, &StopError{Component: components[i].Name(), Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
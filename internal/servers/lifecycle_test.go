@@ -0,0 +1,81 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeComponent records the calls it receives into the shared log.
+type fakeComponent struct {
+	failure
+
+	name     string
+	log      *[]string
+	startErr error
+	stopErr  error
+}
+
+func (c *fakeComponent) Name() string { return c.name }
+
+func (c *fakeComponent) Start(context.Context) error {
+	*c.log = append(*c.log, "start "+c.name)
+	return c.startErr
+}
+
+func (c *fakeComponent) Stop(context.Context) error {
+	*c.log = append(*c.log, "stop "+c.name)
+	return c.stopErr
+}
+
+func TestLifecycle_Run(t *testing.T) {
+	var log []string
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := NewLifecycle(
+		&fakeComponent{name: "a", log: &log},
+		&fakeComponent{name: "b", log: &log},
+	).Run(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"start a", "start b", "stop b", "stop a"}, log)
+}
+
+func TestLifecycle_RunStartFailure(t *testing.T) {
+	var log []string
+
+	err := NewLifecycle(
+		&fakeComponent{name: "a", log: &log},
+		&fakeComponent{name: "b", log: &log, startErr: errors.New("address already in use")},
+		&fakeComponent{name: "c", log: &log},
+	).Run(context.Background())
+
+	var start *StartError
+	assert.ErrorAs(t, err, &start)
+	assert.ErrorContains(t, err, "failed to start b: address already in use")
+	assert.Equal(t, []string{"start a", "start b", "stop a"}, log)
+}
+
+func TestLifecycle_RunServeFailure(t *testing.T) {
+	var log []string
+	failing := &fakeComponent{name: "b", log: &log}
+	failing.fail(errors.New("listener closed"))
+
+	// The components are stopped once one of them fails, without the context being done
+	err := NewLifecycle(
+		&fakeComponent{name: "a", log: &log, stopErr: errors.New("timed out")},
+		failing,
+	).Run(context.Background())
+
+	var serve *ServeError
+	var stop *StopError
+	assert.ErrorAs(t, err, &serve)
+	assert.Equal(t, "b", serve.Component)
+	assert.ErrorAs(t, err, &stop)
+	assert.Equal(t, "a", stop.Component)
+	assert.ErrorContains(t, err, "failed to serve b: listener closed")
+	assert.Equal(t, []string{"start a", "start b", "stop b", "stop a"}, log)
+}
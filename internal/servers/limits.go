@@ -0,0 +1,136 @@
+package servers
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// RequestLimits - Rejects the requests larger than a single client should submit, before they are evaluated or
+// written, so that a malformed client can't submit pathological requests. Zero limits are not enforced.
+type RequestLimits struct {
+	limits config.Limits
+}
+
+// NewRequestLimits - Creates new RequestLimits enforcing the limits
+func NewRequestLimits(limits config.Limits) *RequestLimits {
+	return &RequestLimits{limits: limits}
+}
+
+// UnaryServerInterceptor - Returns an interceptor rejecting the requests exceeding the limits
+func (l *RequestLimits) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.Validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - Returns an interceptor rejecting the requests of the streams exceeding the limits
+func (l *RequestLimits) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitedStream{ServerStream: stream, limits: l})
+	}
+}
+
+// Validate - Returns an invalid argument error with the ERROR_CODE_REQUEST_LIMIT_EXCEEDED error code if the request
+// exceeds one of the limits, nil otherwise
+func (l *RequestLimits) Validate(req interface{}) error {
+	if n := contextualTuples(req); l.limits.MaxContextualTuples > 0 && n > l.limits.MaxContextualTuples {
+		return limitExceeded(n, l.limits.MaxContextualTuples, "contextual tuples and attributes")
+	}
+	if n := batchSize(req); l.limits.MaxBatchSize > 0 && n > l.limits.MaxBatchSize {
+		return limitExceeded(n, l.limits.MaxBatchSize, "tuples and attributes written")
+	}
+	if n := filterComplexity(req); l.limits.MaxFilterComplexity > 0 && n > l.limits.MaxFilterComplexity {
+		return limitExceeded(n, l.limits.MaxFilterComplexity, "identifiers and attribute names in filters")
+	}
+	return nil
+}
+
+// limitExceeded returns the error of a request with n of what the limit bounds
+func limitExceeded(n, limit int, what string) error {
+	return status.Errorf(codes.InvalidArgument, "%s: %d %s, more than the %d allowed",
+		base.ErrorCode_ERROR_CODE_REQUEST_LIMIT_EXCEEDED, n, what, limit)
+}
+
+// contextualTuples returns the number of contextual tuples and attributes of the request
+func contextualTuples(req interface{}) int {
+	if r, ok := req.(interface{ GetContext() *base.Context }); ok {
+		return len(r.GetContext().GetTuples()) + len(r.GetContext().GetAttributes())
+	}
+	return 0
+}
+
+// batchSize returns the number of tuples and attributes written by the request, across its bundle or its operations
+func batchSize(req interface{}) (n int) {
+	if r, ok := req.(interface{ GetTuples() []*base.Tuple }); ok {
+		n += len(r.GetTuples())
+	}
+	if r, ok := req.(interface{ GetAttributes() []*base.Attribute }); ok {
+		n += len(r.GetAttributes())
+	}
+	if r, ok := req.(interface{ GetBundle() *base.Bundle }); ok {
+		n += len(r.GetBundle().GetTuples()) + len(r.GetBundle().GetAttributes())
+	}
+	if r, ok := req.(interface{ GetOperations() []*base.DataOperation }); ok {
+		for _, operation := range r.GetOperations() {
+			n += len(operation.GetWrite().GetTuples()) + len(operation.GetWrite().GetAttributes())
+		}
+	}
+	return n
+}
+
+// filterComplexity returns the number of entity and subject identifiers and attribute names of the filters of the
+// request, across its operations
+func filterComplexity(req interface{}) (n int) {
+	switch r := req.(type) {
+	case interface{ GetFilter() *base.TupleFilter }:
+		n += tupleFilterComplexity(r.GetFilter())
+	case interface{ GetFilter() *base.AttributeFilter }:
+		n += attributeFilterComplexity(r.GetFilter())
+	}
+	if r, ok := req.(interface{ GetTupleFilter() *base.TupleFilter }); ok {
+		n += tupleFilterComplexity(r.GetTupleFilter())
+	}
+	if r, ok := req.(interface{ GetAttributeFilter() *base.AttributeFilter }); ok {
+		n += attributeFilterComplexity(r.GetAttributeFilter())
+	}
+	if r, ok := req.(interface{ GetOperations() []*base.DataOperation }); ok {
+		for _, operation := range r.GetOperations() {
+			n += tupleFilterComplexity(operation.GetDelete().GetTupleFilter())
+			n += attributeFilterComplexity(operation.GetDelete().GetAttributeFilter())
+		}
+	}
+	return n
+}
+
+// tupleFilterComplexity returns the number of entity and subject identifiers of the filter
+func tupleFilterComplexity(filter *base.TupleFilter) int {
+	return len(filter.GetEntity().GetIds()) + len(filter.GetSubject().GetIds())
+}
+
+// attributeFilterComplexity returns the number of entity identifiers and attribute names of the filter
+func attributeFilterComplexity(filter *base.AttributeFilter) int {
+	return len(filter.GetEntity().GetIds()) + len(filter.GetAttributes())
+}
+
+// limitedStream - Server stream rejecting the messages it receives exceeding the limits
+type limitedStream struct {
+	grpc.ServerStream
+	limits *RequestLimits
+}
+
+// RecvMsg receives the message, returning the error of the limits it exceeds
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.limits.Validate(m)
+}
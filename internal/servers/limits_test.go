@@ -0,0 +1,65 @@
+package servers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestRequestLimits_Validate(t *testing.T) {
+	limits := NewRequestLimits(config.Limits{MaxContextualTuples: 2, MaxBatchSize: 3, MaxFilterComplexity: 2})
+
+	tuple := &v1.Tuple{
+		Entity:   &v1.Entity{Type: "doc", Id: "1"},
+		Relation: "viewer",
+		Subject:  &v1.Subject{Type: "user", Id: "1"},
+	}
+	attribute := &v1.Attribute{Entity: &v1.Entity{Type: "doc", Id: "1"}, Attribute: "public"}
+
+	exceeded := func(err error) {
+		t.Helper()
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), v1.ErrorCode_ERROR_CODE_REQUEST_LIMIT_EXCEEDED.String())
+	}
+
+	// Contextual tuples and attributes
+	assert.NoError(t, limits.Validate(&v1.PermissionCheckRequest{
+		Context: &v1.Context{Tuples: []*v1.Tuple{tuple}, Attributes: []*v1.Attribute{attribute}},
+	}))
+	exceeded(limits.Validate(&v1.PermissionLookupEntityRequest{
+		Context: &v1.Context{Tuples: []*v1.Tuple{tuple, tuple}, Attributes: []*v1.Attribute{attribute}},
+	}))
+
+	// Tuples and attributes written, across the operations
+	assert.NoError(t, limits.Validate(&v1.DataWriteRequest{Tuples: []*v1.Tuple{tuple, tuple}, Attributes: []*v1.Attribute{attribute}}))
+	exceeded(limits.Validate(&v1.RelationshipWriteRequest{Tuples: []*v1.Tuple{tuple, tuple, tuple, tuple}}))
+	exceeded(limits.Validate(&v1.DataTransactionRequest{Operations: []*v1.DataOperation{
+		{Type: &v1.DataOperation_Write{Write: &v1.DataOperationWrite{Tuples: []*v1.Tuple{tuple, tuple}}}},
+		{Type: &v1.DataOperation_Write{Write: &v1.DataOperationWrite{Attributes: []*v1.Attribute{attribute, attribute}}}},
+	}}))
+	exceeded(limits.Validate(&v1.SchemaApplyBundleRequest{Bundle: &v1.Bundle{Tuples: []*v1.Tuple{tuple, tuple, tuple, tuple}}}))
+
+	// Identifiers and attribute names of the filters
+	assert.NoError(t, limits.Validate(&v1.RelationshipReadRequest{Filter: &v1.TupleFilter{
+		Entity:  &v1.EntityFilter{Type: "doc", Ids: []string{"1"}},
+		Subject: &v1.SubjectFilter{Type: "user", Ids: []string{"1"}},
+	}}))
+	exceeded(limits.Validate(&v1.AttributeReadRequest{Filter: &v1.AttributeFilter{
+		Entity:     &v1.EntityFilter{Type: "doc", Ids: []string{"1", "2"}},
+		Attributes: []string{"public"},
+	}}))
+	exceeded(limits.Validate(&v1.DataDeleteRequest{
+		TupleFilter:     &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"1", "2"}}},
+		AttributeFilter: &v1.AttributeFilter{Entity: &v1.EntityFilter{Type: "doc", Ids: []string{"3"}}},
+	}))
+
+	// Zero limits are not enforced
+	assert.NoError(t, NewRequestLimits(config.Limits{}).Validate(&v1.RelationshipWriteRequest{Tuples: []*v1.Tuple{tuple, tuple, tuple, tuple}}))
+}
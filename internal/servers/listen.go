@@ -0,0 +1,215 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+const (
+	// _listenFDsStart is the first file descriptor of the sockets passed by systemd socket activation.
+	_listenFDsStart = 3
+	// _listenFDName is the FileDescriptorName of the sockets passed by systemd the gRPC server is served on.
+	_listenFDName = "grpc"
+)
+
+// ListenAddress - Address a server listens on
+type ListenAddress struct {
+	// Server is the name of the server
+	Server string
+	// Address is the address the server listens on, as host:port
+	Address string
+}
+
+// Listener - Component listening on network addresses once started
+type Listener interface {
+	// ListenAddresses returns the addresses the component listens on, once started.
+	ListenAddresses() []string
+}
+
+// listening - Addresses a component listens on once started, implementing Listener for the components embedding it
+type listening struct {
+	mu        sync.Mutex
+	addresses []string
+}
+
+// ListenAddresses returns the addresses the component listens on.
+func (l *listening) ListenAddresses() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.addresses)
+}
+
+// listened records the addresses of the listeners the component listens on.
+func (l *listening) listened(listeners ...net.Listener) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, lis := range listeners {
+		l.addresses = append(l.addresses, lis.Addr().String())
+	}
+}
+
+// listenAddresses returns the addresses the components listen on, in the order of the components.
+func listenAddresses(components []Component) []ListenAddress {
+	var addresses []ListenAddress
+	for _, c := range components {
+		l, ok := c.(Listener)
+		if !ok {
+			continue
+		}
+		for _, address := range l.ListenAddresses() {
+			addresses = append(addresses, ListenAddress{Server: c.Name(), Address: address})
+		}
+	}
+	return addresses
+}
+
+// ValidateListeners - Checks that no two servers of the configuration listen on the same port of the same
+// interface, or of all of them, returning all the collisions. The gRPC server isn't checked if its sockets are
+// passed by systemd.
+func ValidateListeners(srv *config.Server, dst *config.Distributed, profiler *config.Profiler) error {
+	var addresses []ListenAddress
+	if !srv.GRPC.SocketActivation {
+		for _, address := range grpcAddresses(srv.GRPC) {
+			addresses = append(addresses, ListenAddress{Server: "grpc server", Address: address})
+		}
+	}
+	for _, address := range grpcAddresses(invokeListeners(dst)) {
+		addresses = append(addresses, ListenAddress{Server: "invoker grpc server", Address: address})
+	}
+	if srv.HTTP.Enabled {
+		addresses = append(addresses, ListenAddress{Server: "http server", Address: net.JoinHostPort(srv.HTTP.Address, srv.HTTP.Port)})
+	}
+	if profiler != nil && profiler.Enabled {
+		addresses = append(addresses, ListenAddress{Server: "profiler server", Address: net.JoinHostPort(profiler.Address, profiler.Port)})
+	}
+	if srv.Autocert.Enabled && srv.Autocert.ChallengeAddress != "" {
+		addresses = append(addresses, ListenAddress{Server: "acme challenge server", Address: srv.Autocert.ChallengeAddress})
+	}
+
+	var errs []error
+	for i, a := range addresses {
+		for _, b := range addresses[i+1:] {
+			if collide(a.Address, b.Address) {
+				errs = append(errs, fmt.Errorf("the %s listening on %s and the %s listening on %s share a port, bind them to other ports or interfaces", a.Server, a.Address, b.Server, b.Address))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// collide returns whether listening on both addresses conflicts, on the same port of the same interface or of all of
+// them. Ports chosen by the system never conflict.
+func collide(a, b string) bool {
+	hostA, portA, errA := net.SplitHostPort(a)
+	hostB, portB, errB := net.SplitHostPort(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	if portA != portB || portA == "" || portA == "0" {
+		return false
+	}
+	return hostA == hostB || unspecified(hostA) || unspecified(hostB)
+}
+
+// unspecified returns whether listening on the host listens on all the interfaces.
+func unspecified(host string) bool {
+	ip := net.ParseIP(host)
+	return host == "" || ip != nil && ip.IsUnspecified()
+}
+
+// invokeListeners returns the listeners configuration of the invoke server of the distributed configuration.
+func invokeListeners(dst *config.Distributed) config.GRPC {
+	conf := config.GRPC{Port: dst.Port}
+	if dst.Invoke.Address != "" {
+		conf.Addresses = []string{net.JoinHostPort(dst.Invoke.Address, dst.Port)}
+	}
+	return conf
+}
+
+// grpcAddresses returns the addresses of the gRPC configuration, all the interfaces on the port if there are none.
+func grpcAddresses(conf config.GRPC) []string {
+	if len(conf.Addresses) == 0 {
+		return []string{":" + conf.Port}
+	}
+	return conf.Addresses
+}
+
+// listen opens the listeners of the gRPC configuration: the sockets passed by systemd if socket activation is
+// enabled, otherwise one listener by address, or one on all the interfaces on the port if there are none. The
+// listeners opened are closed if any of them fails to open.
+func listen(ctx context.Context, conf config.GRPC) ([]net.Listener, error) {
+	if conf.SocketActivation {
+		return activatedListeners()
+	}
+
+	addresses := grpcAddresses(conf)
+
+	lc := net.ListenConfig{}
+	if conf.ReusePort {
+		lc.Control = reusePort
+	}
+
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		lis, err := lc.Listen(ctx, "tcp", address)
+		if err != nil {
+			closeListeners(listeners)
+			return nil, err
+		}
+		listeners = append(listeners, lis)
+	}
+	return listeners, nil
+}
+
+// activatedListeners returns the listeners of the sockets systemd passed to the process. If some of the sockets are
+// named grpc with their FileDescriptorName, only those are served, so that the sockets of other servers can be
+// passed along.
+func activatedListeners() ([]net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, errors.New("socket activation is enabled, but systemd passed no sockets to this process")
+	}
+	count, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || count < 1 {
+		return nil, errors.New("socket activation is enabled, but systemd passed no sockets to this process")
+	}
+
+	names := make([]string, count)
+	copy(names, strings.Split(os.Getenv("LISTEN_FDNAMES"), ":"))
+	named := false
+	for _, n := range names {
+		named = named || n == _listenFDName
+	}
+
+	var listeners []net.Listener
+	for i := 0; i < count; i++ {
+		if named && names[i] != _listenFDName {
+			continue
+		}
+		file := os.NewFile(uintptr(_listenFDsStart+i), names[i])
+		lis, err := net.FileListener(file)
+		_ = file.Close()
+		if err != nil {
+			closeListeners(listeners)
+			return nil, fmt.Errorf("failed to listen on the socket %d passed by systemd: %w", _listenFDsStart+i, err)
+		}
+		listeners = append(listeners, lis)
+	}
+	return listeners, nil
+}
+
+// closeListeners closes the listeners, which failed to be served.
+func closeListeners(listeners []net.Listener) {
+	for _, lis := range listeners {
+		_ = lis.Close()
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin && !freebsd
+
+package servers
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+)
+
+// reusePort fails, as SO_REUSEPORT is not supported on the platform.
+func reusePort(_, _ string, _ syscall.RawConn) error {
+	return fmt.Errorf("SO_REUSEPORT is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,21 @@
+//go:build linux || darwin || freebsd
+
+package servers
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// reusePort sets SO_REUSEPORT on the socket, so that the listeners of a new process can be opened on the addresses
+// before the previous process stops listening on them.
+func reusePort(_, _ string, conn syscall.RawConn) error {
+	var err error
+	if cerr := conn.Control(func(fd uintptr) {
+		err = unix.SetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+	}); cerr != nil {
+		return cerr
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+package servers
+
+import (
+	"context"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestListen(t *testing.T) {
+	listeners, err := listen(context.Background(), config.GRPC{Addresses: []string{"127.0.0.1:0", "127.0.0.1:0"}})
+	require.NoError(t, err)
+	require.Len(t, listeners, 2)
+	assert.NotEqual(t, listeners[0].Addr().String(), listeners[1].Addr().String())
+
+	// The listeners opened are closed if one of them fails to open
+	_, err = listen(context.Background(), config.GRPC{Addresses: []string{"127.0.0.1:0", listeners[0].Addr().String()}})
+	assert.Error(t, err)
+	closeListeners(listeners)
+
+	// Socket activation fails without sockets passed to this process
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	t.Setenv("LISTEN_FDS", "1")
+	_, err = listen(context.Background(), config.GRPC{SocketActivation: true})
+	assert.Error(t, err)
+}
+
+func TestValidateListeners(t *testing.T) {
+	srv := &config.Server{
+		GRPC: config.GRPC{Port: "3478"},
+		HTTP: config.HTTP{Enabled: true, Port: "3476"},
+	}
+	dst := &config.Distributed{Port: "5000"}
+	profiler := &config.Profiler{Enabled: true, Port: "6060"}
+	require.NoError(t, ValidateListeners(srv, dst, profiler))
+
+	// Every collision is reported
+	profiler.Port = "3478"
+	dst.Port = "3476"
+	err := ValidateListeners(srv, dst, profiler)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "grpc server listening on :3478 and the profiler server")
+	assert.Contains(t, err.Error(), "invoker grpc server listening on :3476 and the http server")
+
+	// Servers share a port on distinct interfaces
+	srv.HTTP.Address = "127.0.0.1"
+	dst.Invoke.Address = "10.0.0.1"
+	profiler.Address = "127.0.0.1"
+	srv.GRPC.Addresses = []string{"10.0.0.1:3478"}
+	require.NoError(t, ValidateListeners(srv, dst, profiler))
+
+	// All the interfaces include the other ones
+	dst.Invoke.Address = "0.0.0.0"
+	assert.Error(t, ValidateListeners(srv, dst, profiler))
+	dst.Invoke.Address = "10.0.0.1"
+
+	// The ports chosen by the system and the disabled servers never collide
+	srv.GRPC.Addresses = []string{"127.0.0.1:0"}
+	srv.HTTP.Port = "0"
+	profiler.Enabled = false
+	require.NoError(t, ValidateListeners(srv, dst, profiler))
+}
+
+func TestListen_ReusePort(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" && runtime.GOOS != "freebsd" {
+		t.Skip("SO_REUSEPORT is not supported on " + runtime.GOOS)
+	}
+
+	first, err := listen(context.Background(), config.GRPC{Addresses: []string{"127.0.0.1:0"}, ReusePort: true})
+	require.NoError(t, err)
+	defer closeListeners(first)
+
+	// A second process listens on the address of the first one before it stops
+	address := first[0].Addr().String()
+	second, err := listen(context.Background(), config.GRPC{Addresses: []string{address}, ReusePort: true})
+	require.NoError(t, err)
+	defer closeListeners(second)
+	assert.Equal(t, address, second[0].Addr().String())
+
+	_, err = net.Listen("tcp", address)
+	assert.Error(t, err)
+}
@@ -0,0 +1,96 @@
+package servers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	swaggerFiles "github.com/swaggo/files"
+
+	"github.com/Permify/permify/docs"
+	"github.com/Permify/permify/internal"
+)
+
+const (
+	// openAPIPath is the path the OpenAPI specification is served on.
+	openAPIPath = "/openapi.json"
+	// swaggerUIPath is the path prefix the Swagger UI is served on.
+	swaggerUIPath = "/swagger-ui/"
+)
+
+// swaggerUIIndex is the page of the Swagger UI, loading the specification served by the server. The assets
+// of the UI are embedded in the binary.
+const swaggerUIIndex = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>Permify API</title>
+  <link rel="stylesheet" type="text/css" href="./swagger-ui.css" />
+  <link rel="icon" type="image/png" href="./favicon-32x32.png" sizes="32x32" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="./swagger-ui-bundle.js" charset="UTF-8"></script>
+  <script src="./swagger-ui-standalone-preset.js" charset="UTF-8"></script>
+  <script>
+    window.onload = function() {
+      window.ui = SwaggerUIBundle({
+        url: "` + openAPIPath + `",
+        dom_id: "#swagger-ui",
+        deepLinking: true,
+        presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+        layout: "StandaloneLayout"
+      });
+    };
+  </script>
+</body>
+</html>
+`
+
+// newOpenAPIHandler serves the OpenAPI specification and the Swagger UI next to the handler of the API.
+// The specification is adjusted to the server: its version is the one of the build, and its scheme the one
+// the server is listening with, so that requests can be tried from the UI.
+func newOpenAPIHandler(next http.Handler, tlsEnabled bool) (http.Handler, error) {
+	spec, err := openAPISpec(tlsEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := http.StripPrefix(strings.TrimSuffix(swaggerUIPath, "/"), http.FileServer(swaggerFiles.HTTP))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(openAPIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+	mux.HandleFunc(swaggerUIPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == swaggerUIPath || r.URL.Path == swaggerUIPath+"index.html" {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(swaggerUIIndex))
+			return
+		}
+		assets.ServeHTTP(w, r)
+	})
+	mux.Handle("/", next)
+
+	return mux, nil
+}
+
+// openAPISpec returns the embedded specification with the version of the build and the scheme of the server.
+func openAPISpec(tlsEnabled bool) ([]byte, error) {
+	var spec map[string]interface{}
+	if err := json.Unmarshal(docs.OpenAPI, &spec); err != nil {
+		return nil, err
+	}
+
+	if info, ok := spec["info"].(map[string]interface{}); ok {
+		info["version"] = internal.Version
+	}
+	if tlsEnabled {
+		spec["schemes"] = []string{"https"}
+	} else {
+		spec["schemes"] = []string{"http"}
+	}
+
+	return json.Marshal(spec)
+}
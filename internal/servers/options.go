@@ -0,0 +1,194 @@
+package servers
+
+import (
+	"crypto/ed25519"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/ratelimit"
+	api "go.opentelemetry.io/otel/metric"
+	"golang.org/x/crypto/acme/autocert"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/supervisor"
+	"github.com/Permify/permify/internal/tunables"
+)
+
+// InterceptorStage - Position of custom interceptors in the interceptor chain of the gRPC servers.
+// The chain runs validation and panic recovery first, then rate limiting, then authentication.
+type InterceptorStage int
+
+const (
+	// BeforeRateLimit - Runs after validation and panic recovery, before requests are rate limited
+	BeforeRateLimit InterceptorStage = iota
+	// BeforeAuthn - Runs after rate limiting, before requests are authenticated
+	BeforeAuthn
+	// AfterAuthn - Runs last, after requests are authenticated, with the actor of the request in the context
+	AfterAuthn
+)
+
+// interceptors - Custom interceptors of the gRPC servers, by stage
+type interceptors struct {
+	unary  map[InterceptorStage][]grpc.UnaryServerInterceptor
+	stream map[InterceptorStage][]grpc.StreamServerInterceptor
+}
+
+// ContainerOption - Option of the container
+type ContainerOption func(container *Container)
+
+// WithUnaryInterceptors - Adds unary interceptors to the chain of the gRPC servers at the stage. Interceptors of
+// the same stage run in the order they are added.
+func WithUnaryInterceptors(stage InterceptorStage, interceptors ...grpc.UnaryServerInterceptor) ContainerOption {
+	return func(c *Container) {
+		if c.interceptors.unary == nil {
+			c.interceptors.unary = map[InterceptorStage][]grpc.UnaryServerInterceptor{}
+		}
+		c.interceptors.unary[stage] = append(c.interceptors.unary[stage], interceptors...)
+	}
+}
+
+// WithStreamInterceptors - Adds stream interceptors to the chain of the gRPC servers at the stage. Interceptors
+// of the same stage run in the order they are added.
+func WithStreamInterceptors(stage InterceptorStage, interceptors ...grpc.StreamServerInterceptor) ContainerOption {
+	return func(c *Container) {
+		if c.interceptors.stream == nil {
+			c.interceptors.stream = map[InterceptorStage][]grpc.StreamServerInterceptor{}
+		}
+		c.interceptors.stream[stage] = append(c.interceptors.stream[stage], interceptors...)
+	}
+}
+
+// WithBundleKeys - Requires the bundles applied with the ApplyBundle API to be signed with one of the public keys
+func WithBundleKeys(keys ...ed25519.PublicKey) ContainerOption {
+	return func(c *Container) {
+		c.bundleKeys = append(c.bundleKeys, keys...)
+	}
+}
+
+// WithSchemaDeduplication - Returns the latest schema version of the tenant for the writes of the schema it already
+// holds, instead of writing a new version
+func WithSchemaDeduplication(enabled bool) ContainerOption {
+	return func(c *Container) {
+		c.deduplicateSchemas = enabled
+	}
+}
+
+// WithMeter - Counts the checks of deprecated relations and permissions in the meter
+func WithMeter(meter api.Meter) ContainerOption {
+	return func(c *Container) {
+		c.meter = meter
+	}
+}
+
+// WithCheckTimeout - Fails the checks whose evaluation takes longer than the timeout, whatever the deadline of their
+// client, no limit if zero
+func WithCheckTimeout(timeout time.Duration) ContainerOption {
+	return func(c *Container) {
+		c.checkTimeout = timeout
+	}
+}
+
+// WithSchemaBase - Compiles the schemas of the tenants with the base schema held by the tenant, and writes them
+// again on the new versions of the base schema
+func WithSchemaBase(tenantID string) ContainerOption {
+	return func(c *Container) {
+		c.schemaBase = tenantID
+	}
+}
+
+// WithDatabase - Serves the migration status of the database through the Admin service
+func WithDatabase(conf config.Database) ContainerOption {
+	return func(c *Container) {
+		c.database = &conf
+	}
+}
+
+// WithDatabaseRegions - Lists the database regions tenants are kept in through the Admin service
+func WithDatabaseRegions(regions DatabaseRegions) ContainerOption {
+	return func(c *Container) {
+		c.regions = regions
+	}
+}
+
+// WithHashRing - Reports the hash ring the checks are dispatched to the nodes of the cluster with through the Admin
+// service
+func WithHashRing(ring HashRing) ContainerOption {
+	return func(c *Container) {
+		c.ring = ring
+	}
+}
+
+// WithTunables - Lists the tunables of the registry and changes them at runtime through the Admin service
+func WithTunables(registry *tunables.Registry) ContainerOption {
+	return func(c *Container) {
+		c.tunables = registry
+	}
+}
+
+// WithErrorCatalog - Attaches the details of the error codes of the catalog to the errors of the requests, with
+// its messages
+func WithErrorCatalog(catalog *ErrorCatalog) ContainerOption {
+	return func(c *Container) {
+		c.errorCatalog = catalog
+	}
+}
+
+// WithRateLimiter - Limits the requests of the gRPC servers with the limiter instead of a token bucket of the
+// configured rate limit local to the server, e.g. to share the rate limit across replicas
+func WithRateLimiter(limiter ratelimit.Limiter) ContainerOption {
+	return func(c *Container) {
+		c.limiter = limiter
+	}
+}
+
+// WithData - Configures the validation of the writes of the data service
+func WithData(cfg config.Data) ContainerOption {
+	return func(c *Container) {
+		c.data = cfg
+	}
+}
+
+// WithSupervisor - Notifies the process supervisor, such as systemd, once the servers are ready and when they stop,
+// and pings its watchdog while the health server reports them serving
+func WithSupervisor(s supervisor.Supervisor) ContainerOption {
+	return func(c *Container) {
+		c.supervisor = s
+	}
+}
+
+// WithLimits - Rejects the requests exceeding the size limits before they reach the services
+func WithLimits(limits config.Limits) ContainerOption {
+	return func(c *Container) {
+		c.limits = limits
+	}
+}
+
+// WithAutocert - Serves the HTTP and gRPC servers with TLS, with the certificates obtained and renewed by the manager
+// in place of the certificate files of their TLS configuration
+func WithAutocert(manager *autocert.Manager) ContainerOption {
+	return func(c *Container) {
+		c.certificates = manager
+	}
+}
+
+// WithDecision - Configures the tenant, the depth and the translation table of the actions of the OPA-style decisions
+func WithDecision(decision config.Decision) ContainerOption {
+	return func(c *Container) {
+		c.decision = decision
+	}
+}
+
+// WithExtAuthz - Serves the external authorization API of Envoy on the gRPC server, allowing the requests whose
+// check, mapped by the rules, is allowed
+func WithExtAuthz(rules *ExtAuthzRules) ContainerOption {
+	return func(c *Container) {
+		c.extAuthz = rules
+	}
+}
+
+// WithWatch - Configures the keepalive messages and the buffering of the streams of the watch service
+func WithWatch(cfg config.Watch) ContainerOption {
+	return func(c *Container) {
+		c.watch = cfg
+	}
+}
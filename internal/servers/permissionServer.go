@@ -1,28 +1,77 @@
 package servers
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"sync"
+	"time"
 
+	otelAttribute "go.opentelemetry.io/otel/attribute"
 	otelCodes "go.opentelemetry.io/otel/codes"
-	"golang.org/x/net/context"
+	api "go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/validation"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
+// DeprecationHeader - Header set on the responses of the checks of deprecated relations and permissions, holding the
+// deprecated name along with the reason of its deprecation
+const DeprecationHeader = "permify-deprecated"
+
+// errCheckTimeout is the cause of the cancellation of the checks whose evaluation took longer than the server allows.
+var errCheckTimeout = errors.New(v1.ErrorCode_ERROR_CODE_CHECK_TIMEOUT.String())
+
+// _deprecationLogInterval is the minimum interval between the warnings logged for the checks of the same deprecated
+// relation or permission of a tenant.
+const _deprecationLogInterval = time.Minute
+
 // PermissionServer - Structure for Permission Server
 type PermissionServer struct {
 	v1.UnimplementedPermissionServer
 
 	invoker invoke.Invoker
+	// sr reads the id formats of the entity types the ids of checks are validated against, and the deprecations of
+	// the relations and permissions checked. It is nil for the checks other nodes send, which the node that
+	// received them validated.
+	sr storage.SchemaReader
+	// deprecations counts the checks of deprecated relations and permissions, if set
+	deprecations api.Int64Counter
+	// warned holds when a warning was last logged for the checks of a deprecated relation or permission, by tenant
+	warned sync.Map
+	// checkTimeout is the maximum duration of the evaluation of a check, whatever the deadline of its client, no
+	// limit if zero
+	checkTimeout time.Duration
+	// decision holds the tenant, the depth and the translation table of the actions of the decisions
+	decision config.Decision
 }
 
-// NewPermissionServer - Creates new Permission Server
-func NewPermissionServer(i invoke.Invoker) *PermissionServer {
-	return &PermissionServer{
+// NewPermissionServer - Creates new Permission Server, counting the checks of deprecated relations and permissions
+// in the meter if there is one
+func NewPermissionServer(i invoke.Invoker, sr storage.SchemaReader, meter api.Meter) *PermissionServer {
+	server := &PermissionServer{
 		invoker: i,
+		sr:      sr,
+	}
+	if meter != nil {
+		counter, err := meter.Int64Counter("deprecated_check_count", api.WithDescription("Number of checks of deprecated relations and permissions"))
+		if err != nil {
+			panic(err)
+		}
+		server.deprecations = counter
 	}
+	return server
 }
 
 // Check - Performs Authorization Check
@@ -35,17 +84,77 @@ func (r *PermissionServer) Check(ctx context.Context, request *v1.PermissionChec
 		return nil, v
 	}
 
+	err := r.validateCheck(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	// The stats are collected for the checks that can time out as well, to report how far their evaluation went
+	var stats *engines.CheckStats
+	if request.GetMetadata().GetDebug() || r.checkTimeout > 0 {
+		ctx, stats = engines.ContextWithCheckStats(ctx)
+	}
+	if r.checkTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, r.checkTimeout, errCheckTimeout)
+		defer cancel()
+	}
+	start := time.Now()
+
 	response, err := r.invoker.Check(ctx, request)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(context.Cause(ctx), errCheckTimeout) {
+			return nil, r.checkTimeoutError(ctx, request, checkDebug(request, stats, start))
+		}
 		slog.Error(err.Error())
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	if request.GetMetadata().GetDebug() {
+		response.Metadata = &v1.PermissionCheckResponseMetadata{
+			CheckCount: response.GetMetadata().GetCheckCount(),
+			Debug:      checkDebug(request, stats, start),
+		}
+	}
+
 	return response, nil
 }
 
+// checkDebug returns the debug information of the check of the request from the stats collected while evaluating it
+// since start.
+func checkDebug(request *v1.PermissionCheckRequest, stats *engines.CheckStats, start time.Time) *v1.PermissionCheckDebug {
+	debug := stats.Debug()
+	debug.Duration = durationpb.New(time.Since(start))
+	// The invoker resolves the snapshot and the schema version of the request when they are not given
+	debug.SnapToken = request.GetMetadata().GetSnapToken()
+	debug.SchemaVersion = request.GetMetadata().GetSchemaVersion()
+	return debug
+}
+
+// checkTimeoutError returns the error of a check whose evaluation took longer than the server allows, carrying the
+// debug information collected until then, with the sub-checks that were still being evaluated, and logs it.
+func (r *PermissionServer) checkTimeoutError(ctx context.Context, request *v1.PermissionCheckRequest, debug *v1.PermissionCheckDebug) error {
+	slog.WarnContext(ctx, "check timed out",
+		slog.String("tenant_id", request.GetTenantId()),
+		slog.String("entity", tuple.EntityToString(request.GetEntity())),
+		slog.String("permission", request.GetPermission()),
+		slog.String("subject", tuple.SubjectToString(request.GetSubject())),
+		slog.Duration("timeout", r.checkTimeout),
+		slog.Any("unfinished_checks", debug.GetUnfinishedChecks()),
+	)
+
+	st := status.New(codes.DeadlineExceeded, fmt.Sprintf("%s: the evaluation of the check took longer than %s", errCheckTimeout, r.checkTimeout))
+	withDebug, err := st.WithDetails(debug)
+	if err != nil {
+		return st.Err()
+	}
+	return withDebug.Err()
+}
+
 // Expand - Get schema actions in a tree structure
 func (r *PermissionServer) Expand(ctx context.Context, request *v1.PermissionExpandRequest) (*v1.PermissionExpandResponse, error) {
 	ctx, span := tracer.Start(ctx, "permissions.expand")
@@ -109,6 +218,27 @@ func (r *PermissionServer) LookupEntityStream(request *v1.PermissionLookupEntity
 	return nil
 }
 
+// LookupEntityWithPermissions -
+func (r *PermissionServer) LookupEntityWithPermissions(ctx context.Context, request *v1.PermissionLookupEntityWithPermissionsRequest) (*v1.PermissionLookupEntityWithPermissionsResponse, error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-entity-with-permissions")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	response, err := r.invoker.LookupEntityWithPermissions(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return response, nil
+}
+
 // LookupSubject -
 func (r *PermissionServer) LookupSubject(ctx context.Context, request *v1.PermissionLookupSubjectRequest) (*v1.PermissionLookupSubjectResponse, error) {
 	ctx, span := tracer.Start(ctx, "permissions.lookup-subject")
@@ -150,3 +280,168 @@ func (r *PermissionServer) SubjectPermission(ctx context.Context, request *v1.Pe
 
 	return response, nil
 }
+
+// Paths - Finds the relationship paths connecting the subject to the permission of the entity
+func (r *PermissionServer) Paths(ctx context.Context, request *v1.PermissionPathsRequest) (*v1.PermissionPathsResponse, error) {
+	ctx, span := tracer.Start(ctx, "permissions.paths")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	response, err := r.invoker.Paths(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return response, nil
+}
+
+// Decision - Decides whether the subject can perform the action on the resource, by checking the permission the
+// translation table maps the action to
+func (r *PermissionServer) Decision(ctx context.Context, request *v1.PermissionDecisionRequest) (*v1.PermissionDecisionResponse, error) {
+	ctx, span := tracer.Start(ctx, "permissions.decision")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	entity, err := tuple.E(request.GetResource())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: invalid resource %q", v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, request.GetResource())
+	}
+	subject, err := tuple.EAR(request.GetSubject())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: invalid subject %q", v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, request.GetSubject())
+	}
+
+	tenantID := request.GetTenantId()
+	if tenantID == "" {
+		tenantID = r.decision.Tenant
+	}
+
+	check := &v1.PermissionCheckRequest{
+		TenantId:   tenantID,
+		Metadata:   &v1.PermissionCheckRequestMetadata{Depth: r.decision.Depth},
+		Entity:     entity,
+		Permission: decisionPermission(r.decision.Actions, request.GetAction(), entity.GetType()),
+		Subject: &v1.Subject{
+			Type:     subject.GetEntity().GetType(),
+			Id:       subject.GetEntity().GetId(),
+			Relation: subject.GetRelation(),
+		},
+	}
+	if request.GetContext() != nil {
+		check.Context = &v1.Context{Data: request.GetContext()}
+	}
+
+	response, err := r.Check(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.PermissionDecisionResponse{
+		Result: response.GetCan() == v1.CheckResult_CHECK_RESULT_ALLOWED,
+	}, nil
+}
+
+// decisionPermission returns the permission the actions map the action on the resources of the entity type to,
+// preferring the entries of the entity type to the ones of all the types, and the action itself if none maps it
+func decisionPermission(actions []config.DecisionAction, action, entityType string) string {
+	permission := action
+	for _, a := range actions {
+		if a.Action != action {
+			continue
+		}
+		if a.ResourceType == entityType {
+			return a.Permission
+		}
+		if a.ResourceType == "" {
+			permission = a.Permission
+		}
+	}
+	return permission
+}
+
+// validateCheck - Checks the ids of the entity and, unless it is a wildcard, of the subject of the check against the
+// id formats of their entity types, and reports the check of a deprecated relation or permission
+func (r *PermissionServer) validateCheck(ctx context.Context, request *v1.PermissionCheckRequest) (err error) {
+	if r.sr == nil {
+		return nil
+	}
+
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		version, err = r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return err
+		}
+		// The invoker reads the schema at the same version as the ids are validated against
+		request.Metadata.SchemaVersion = version
+	}
+
+	definition, _, err := r.sr.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetEntity().GetType(), version)
+	if err != nil {
+		return err
+	}
+	err = validation.ValidateID(definition, request.GetEntity().GetId())
+	if err != nil {
+		return err
+	}
+	r.deprecated(ctx, request.GetTenantId(), definition, request.GetPermission())
+
+	if tuple.IsWildcardSubject(request.GetSubject()) {
+		return nil
+	}
+
+	definition, _, err = r.sr.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetSubject().GetType(), version)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateID(definition, request.GetSubject().GetId())
+}
+
+// deprecated reports the check of the relation or permission of the entity definition if it is deprecated: the check
+// is counted, a warning is logged at most once a minute by tenant and name, and the deprecated name along with the
+// reason of its deprecation is set in the header of the response, so that the clients using it can be found.
+func (r *PermissionServer) deprecated(ctx context.Context, tenantID string, definition *v1.EntityDefinition, name string) {
+	deprecation := definition.GetRelations()[name].GetDeprecation()
+	if deprecation == nil {
+		deprecation = definition.GetPermissions()[name].GetDeprecation()
+	}
+	if deprecation == nil {
+		return
+	}
+
+	if r.deprecations != nil {
+		r.deprecations.Add(ctx, 1, api.WithAttributes(
+			otelAttribute.String("entity_type", definition.GetName()),
+			otelAttribute.String("permission", name),
+		))
+	}
+
+	key := tenantID + "/" + definition.GetName() + "#" + name
+	now := time.Now()
+	if last, ok := r.warned.Load(key); !ok || now.Sub(last.(time.Time)) >= _deprecationLogInterval {
+		r.warned.Store(key, now)
+		slog.WarnContext(ctx, "deprecated relation or permission checked",
+			slog.String("tenant_id", tenantID),
+			slog.String("entity_type", definition.GetName()),
+			slog.String("permission", name),
+			slog.String("reason", deprecation.GetReason()),
+		)
+	}
+
+	value := definition.GetName() + "#" + name
+	if deprecation.GetReason() != "" {
+		value += ": " + deprecation.GetReason()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(DeprecationHeader, value))
+}
@@ -0,0 +1,181 @@
+package servers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/invoke"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// headerStream is a server transport stream recording the headers set on it.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestPermissionServer_Deprecated(t *testing.T) {
+	server := NewPermissionServer(nil, nil, nil)
+	definition := &v1.EntityDefinition{
+		Name: "document",
+		Relations: map[string]*v1.RelationDefinition{
+			"owner":  {Name: "owner"},
+			"author": {Name: "author", Deprecation: &v1.Deprecation{Reason: "use owner"}},
+		},
+		Permissions: map[string]*v1.PermissionDefinition{
+			"edit":   {Name: "edit"},
+			"modify": {Name: "modify", Deprecation: &v1.Deprecation{}, AliasOf: "edit"},
+		},
+	}
+
+	check := func(name string) []string {
+		stream := &headerStream{}
+		server.deprecated(grpc.NewContextWithServerTransportStream(context.Background(), stream), "t1", definition, name)
+		return stream.header.Get(DeprecationHeader)
+	}
+
+	assert.Equal(t, []string{"document#author: use owner"}, check("author"))
+	assert.Equal(t, []string{"document#modify"}, check("modify"))
+	assert.Empty(t, check("owner"))
+	assert.Empty(t, check("edit"))
+
+	// The warnings are logged once a minute for the same tenant and name
+	_, warned := server.warned.Load("t1/document#author")
+	assert.True(t, warned)
+	_, warned = server.warned.Load("t1/document#owner")
+	assert.False(t, warned)
+}
+
+// blockingInvoker is an invoker whose checks are evaluated until their context is done, having reached a sub-check.
+type blockingInvoker struct {
+	invoke.Invoker
+}
+
+func (blockingInvoker) Check(ctx context.Context, request *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error) {
+	<-ctx.Done()
+	engines.CheckStatsFromContext(ctx).Unfinished([]string{"document:1#view", "folder:1#view"})
+	return nil, ctx.Err()
+}
+
+func TestPermissionServer_CheckTimeout(t *testing.T) {
+	server := NewPermissionServer(blockingInvoker{}, nil, nil)
+	server.checkTimeout = 10 * time.Millisecond
+
+	request := &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionCheckRequestMetadata{Depth: 20},
+		Entity:     &v1.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &v1.Subject{Type: "user", Id: "1"},
+	}
+
+	// The check times out even though its client sets no deadline, with how far its evaluation went
+	_, err := server.Check(context.Background(), request)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.True(t, strings.HasPrefix(st.Message(), "ERROR_CODE_CHECK_TIMEOUT: "))
+	assert.Len(t, st.Details(), 1)
+	debug, ok := st.Details()[0].(*v1.PermissionCheckDebug)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"document:1#view -> folder:1#view"}, debug.GetUnfinishedChecks())
+
+	// The error catalog keeps the debug information along with the details of the error code
+	catalog, err := NewErrorCatalog(nil)
+	assert.NoError(t, err)
+	st, _ = status.FromError(catalog.Error(context.Background(), request, st.Err()))
+	assert.Equal(t, codes.DeadlineExceeded, st.Code())
+	assert.Len(t, st.Details(), 3)
+	assert.IsType(t, &v1.PermissionCheckDebug{}, st.Details()[0])
+
+	// The deadline of the client is not reported as a timeout of the server
+	server.checkTimeout = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = server.Check(ctx, request)
+	st, _ = status.FromError(err)
+	assert.Empty(t, st.Details())
+	assert.False(t, strings.HasPrefix(st.Message(), "ERROR_CODE_CHECK_TIMEOUT"))
+}
+
+// recordingInvoker is an invoker recording its checks, allowing the ones of the view permission.
+type recordingInvoker struct {
+	invoke.Invoker
+	checks []*v1.PermissionCheckRequest
+}
+
+func (i *recordingInvoker) Check(_ context.Context, request *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error) {
+	i.checks = append(i.checks, request)
+	if request.GetPermission() == "view" {
+		return &v1.PermissionCheckResponse{Can: v1.CheckResult_CHECK_RESULT_ALLOWED}, nil
+	}
+	return &v1.PermissionCheckResponse{Can: v1.CheckResult_CHECK_RESULT_DENIED}, nil
+}
+
+func TestPermissionServer_Decision(t *testing.T) {
+	invoker := &recordingInvoker{}
+	server := NewPermissionServer(invoker, nil, nil)
+	server.decision = config.Decision{
+		Tenant: "t1",
+		Depth:  20,
+		Actions: []config.DecisionAction{
+			{Action: "read", Permission: "view"},
+			{Action: "read", ResourceType: "repository", Permission: "pull"},
+		},
+	}
+
+	data, err := structpb.NewStruct(map[string]interface{}{"ip_address": "10.0.0.1"})
+	require.NoError(t, err)
+	response, err := server.Decision(context.Background(), &v1.PermissionDecisionRequest{
+		Subject:  "organization:1#member",
+		Action:   "read",
+		Resource: "document:1",
+		Context:  data,
+	})
+	require.NoError(t, err)
+	assert.True(t, response.GetResult())
+	require.Len(t, invoker.checks, 1)
+	assert.Equal(t, "t1", invoker.checks[0].GetTenantId())
+	assert.Equal(t, int32(20), invoker.checks[0].GetMetadata().GetDepth())
+	assert.Equal(t, "view", invoker.checks[0].GetPermission())
+	assert.Equal(t, "member", invoker.checks[0].GetSubject().GetRelation())
+	assert.Equal(t, "10.0.0.1", invoker.checks[0].GetContext().GetData().GetFields()["ip_address"].GetStringValue())
+
+	// Entries of the resource type take precedence, and actions without an entry are checked as is
+	response, err = server.Decision(context.Background(), &v1.PermissionDecisionRequest{
+		Subject: "user:1", Action: "read", Resource: "repository:1", TenantId: "t2",
+	})
+	require.NoError(t, err)
+	assert.False(t, response.GetResult())
+	_, err = server.Decision(context.Background(), &v1.PermissionDecisionRequest{
+		Subject: "user:1", Action: "push", Resource: "repository:1",
+	})
+	require.NoError(t, err)
+	require.Len(t, invoker.checks, 3)
+	assert.Equal(t, "t2", invoker.checks[1].GetTenantId())
+	assert.Equal(t, "pull", invoker.checks[1].GetPermission())
+	assert.Equal(t, "push", invoker.checks[2].GetPermission())
+
+	_, err = server.Decision(context.Background(), &v1.PermissionDecisionRequest{
+		Subject: "user:1", Action: "read", Resource: "repository",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+}
@@ -0,0 +1,287 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/rs/xid"
+	"golang.org/x/net/context"
+
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// _rebasePageSize is the number of tenants listed at once while rebasing them on a new version of the base schema.
+const _rebasePageSize = 100
+
+// compose compiles the schema of the tenant together with the latest version of the base schema, returning the
+// syntax tree of the composed schema along with its entity definitions. The base schema itself, and the schemas
+// of the tenants while there is no base schema, are compiled alone.
+func (r *SchemaServer) compose(ctx context.Context, tenantID, schema string) (*ast.Schema, map[string]*v1.EntityDefinition, error) {
+	if r.base == "" || tenantID == r.base {
+		return compile(schema)
+	}
+
+	base, err := r.headSchema(ctx, r.base)
+	if err != nil {
+		return nil, nil, err
+	}
+	if base == nil {
+		return compile(schema)
+	}
+
+	extension, err := parser.NewParser(schema).Parse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	composed, err := extend(base, extension)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return compile(composed.String())
+}
+
+// headSchema returns the syntax tree of the latest schema version of the tenant, nil if the tenant has no schema.
+func (r *SchemaServer) headSchema(ctx context.Context, tenantID string) (*ast.Schema, error) {
+	version, err := r.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		if err.Error() == v1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	definitions, err := r.sr.ReadSchemaDefinitions(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		serialized = append(serialized, string(definition.SerializedDefinition))
+	}
+
+	return parser.NewParser(strings.Join(serialized, "\n")).Parse()
+}
+
+// rebase writes the schemas of the tenants again on the next version of the base schema, keeping the definitions
+// they extended the previous version with. It returns the tenants whose schemas couldn't be compiled with the next
+// version, which keep their latest version.
+func (r *SchemaServer) rebase(ctx context.Context, previous, next *ast.Schema) ([]string, error) {
+	if r.tr == nil {
+		return nil, nil
+	}
+
+	var unrebased []string
+	ct := ""
+	for {
+		page, token, err := r.tr.ListTenants(ctx, database.NewPagination(database.Size(_rebasePageSize), database.Token(ct)))
+		if err != nil {
+			return unrebased, err
+		}
+
+		for _, tenant := range page {
+			if tenant.GetId() == r.base {
+				continue
+			}
+			if err := r.rebaseTenant(ctx, tenant.GetId(), previous, next); err != nil {
+				slog.WarnContext(ctx, "failed to rebase the schema on the base schema", slog.String("tenant_id", tenant.GetId()), slog.Any("error", err))
+				unrebased = append(unrebased, tenant.GetId())
+			}
+		}
+
+		ct = token.String()
+		if ct == "" {
+			return unrebased, nil
+		}
+	}
+}
+
+// rebaseTenant writes the schema of the tenant again on the next version of the base schema, unless the tenant has
+// no schema or the schema doesn't change.
+func (r *SchemaServer) rebaseTenant(ctx context.Context, tenantID string, previous, next *ast.Schema) error {
+	head, err := r.headSchema(ctx, tenantID)
+	if err != nil || head == nil {
+		return err
+	}
+
+	composed, err := extend(next, without(head, previous))
+	if err != nil {
+		return err
+	}
+
+	sch, _, err := compile(composed.String())
+	if err != nil {
+		return err
+	}
+
+	cnf := definitions(tenantID, xid.New().String(), sch)
+	if schemaHash(cnf) == schemaHash(definitions(tenantID, "", head)) {
+		return nil
+	}
+
+	return r.sw.WriteSchema(ctx, cnf)
+}
+
+// extend returns the base schema extended with the statements of the extension. Entities of the extension the base
+// schema defines extend them with relations, attributes and permissions of their own, and the other entities and
+// rules are added. Definitions identical to the ones of the base schema are inherited, so that a tenant holding a
+// copy of the base schema can be moved onto it.
+func extend(base, extension *ast.Schema) (*ast.Schema, error) {
+	composed := ast.NewSchema()
+	statements := make(map[string]ast.Statement, len(base.Statements))
+	for _, st := range base.Statements {
+		if entity, ok := st.(*ast.EntityStatement); ok {
+			clone := *entity
+			clone.RelationStatements = slices.Clone(entity.RelationStatements)
+			clone.AttributeStatements = slices.Clone(entity.AttributeStatements)
+			clone.PermissionStatements = slices.Clone(entity.PermissionStatements)
+			st = &clone
+		}
+		statements[st.GetName()] = st
+		composed.Statements = append(composed.Statements, st)
+	}
+
+	var errs []error
+	for _, st := range extension.Statements {
+		existing, ok := statements[st.GetName()]
+		if !ok {
+			statements[st.GetName()] = st
+			composed.Statements = append(composed.Statements, st)
+			continue
+		}
+		if existing.String() == st.String() {
+			continue
+		}
+
+		baseEntity, ok := existing.(*ast.EntityStatement)
+		entity, isEntity := st.(*ast.EntityStatement)
+		if !ok || !isEntity {
+			errs = append(errs, fmt.Errorf("%s: %s is defined by the base schema", v1.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String(), st.GetName()))
+			continue
+		}
+		if err := extendEntity(baseEntity, entity); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return composed, errors.Join(errs...)
+}
+
+// extendEntity adds the relations, attributes and permissions of the extension to the entity of the base schema.
+// Members are named uniquely across the entity, so a member of the extension can't redefine a member of the base
+// entity of any kind, nor can its id format replace the one of the base entity.
+func extendEntity(base, extension *ast.EntityStatement) error {
+	if extension.IDFormat != nil {
+		if base.IDFormat != nil && base.IDFormat.String() != extension.IDFormat.String() {
+			return fmt.Errorf("%s: the id format of %s is defined by the base schema", v1.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String(), base.GetName())
+		}
+		base.IDFormat = extension.IDFormat
+	}
+
+	members := memberDefinitions(base)
+	add := func(list *[]ast.Statement, statements []ast.Statement, code v1.ErrorCode) error {
+		for _, member := range statements {
+			existing, ok := members[memberName(member)]
+			if !ok {
+				members[memberName(member)] = member.String()
+				*list = append(*list, member)
+				continue
+			}
+			if existing != member.String() {
+				return fmt.Errorf("%s: %s#%s is defined by the base schema", code.String(), base.GetName(), memberName(member))
+			}
+		}
+		return nil
+	}
+
+	return errors.Join(
+		add(&base.RelationStatements, extension.RelationStatements, v1.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE),
+		add(&base.AttributeStatements, extension.AttributeStatements, v1.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE),
+		add(&base.PermissionStatements, extension.PermissionStatements, v1.ErrorCode_ERROR_CODE_DUPLICATED_PERMISSION_REFERENCE),
+	)
+}
+
+// without returns the statements of the composed schema the base schema doesn't define identically, the extension
+// the composed schema was compiled from. Without a base schema, the whole composed schema is returned.
+func without(composed, base *ast.Schema) *ast.Schema {
+	if base == nil {
+		return composed
+	}
+
+	statements := make(map[string]ast.Statement, len(base.Statements))
+	for _, st := range base.Statements {
+		statements[st.GetName()] = st
+	}
+
+	extension := ast.NewSchema()
+	for _, st := range composed.Statements {
+		existing, ok := statements[st.GetName()]
+		if !ok {
+			extension.Statements = append(extension.Statements, st)
+			continue
+		}
+		if existing.String() == st.String() {
+			continue
+		}
+
+		baseEntity, ok := existing.(*ast.EntityStatement)
+		entity, isEntity := st.(*ast.EntityStatement)
+		if !ok || !isEntity {
+			extension.Statements = append(extension.Statements, st)
+			continue
+		}
+
+		members := memberDefinitions(baseEntity)
+		kept := func(statements []ast.Statement) []ast.Statement {
+			var kept []ast.Statement
+			for _, member := range statements {
+				if existing, ok := members[memberName(member)]; !ok || existing != member.String() {
+					kept = append(kept, member)
+				}
+			}
+			return kept
+		}
+
+		own := &ast.EntityStatement{
+			Entity:               entity.Entity,
+			Name:                 entity.Name,
+			RelationStatements:   kept(entity.RelationStatements),
+			AttributeStatements:  kept(entity.AttributeStatements),
+			PermissionStatements: kept(entity.PermissionStatements),
+		}
+		if entity.IDFormat != nil && (baseEntity.IDFormat == nil || baseEntity.IDFormat.String() != entity.IDFormat.String()) {
+			own.IDFormat = entity.IDFormat
+		}
+		extension.Statements = append(extension.Statements, own)
+	}
+
+	return extension
+}
+
+// memberDefinitions returns the definitions of the relations, attributes and permissions of the entity by name.
+func memberDefinitions(entity *ast.EntityStatement) map[string]string {
+	members := map[string]string{}
+	for _, list := range [][]ast.Statement{entity.RelationStatements, entity.AttributeStatements, entity.PermissionStatements} {
+		for _, member := range list {
+			members[memberName(member)] = member.String()
+		}
+	}
+	return members
+}
+
+// memberName returns the name of the relation, attribute or permission statement. Permission statements don't
+// return theirs with GetName.
+func memberName(member ast.Statement) string {
+	if permission, ok := member.(*ast.PermissionStatement); ok {
+		return permission.Name.Literal
+	}
+	return member.GetName()
+}
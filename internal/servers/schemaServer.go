@@ -1,49 +1,82 @@
 package servers
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log/slog"
+	"sort"
 
 	"github.com/rs/xid"
 	"google.golang.org/grpc/status"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/bundle"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/ast"
 	"github.com/Permify/permify/pkg/dsl/compiler"
 	"github.com/Permify/permify/pkg/dsl/parser"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
+// _maxMigratedData is the maximum number of relationships and attributes a migration writes.
+const _maxMigratedData = 1000
+
+// _maxSchemaWriteAttempts is the number of times writing a schema unless it is the latest one is attempted, when other
+// versions are written meanwhile.
+const _maxSchemaWriteAttempts = 10
+
 // SchemaServer - Structure for Schema Server
 type SchemaServer struct {
 	v1.UnimplementedSchemaServer
 
 	sw storage.SchemaWriter
 	sr storage.SchemaReader
+	dr storage.DataReader
+	dw storage.DataWriter
+	// keys are the public keys bundles must be signed with. Unsigned bundles are accepted when there are none.
+	keys []ed25519.PublicKey
+	// deduplicate is whether writing the schema the latest version already holds returns that version instead of
+	// writing a new one
+	deduplicate bool
+	// tr lists the tenants rebased on the new versions of the base schema
+	tr storage.TenantReader
+	// base is the tenant holding the base schema the schemas of the other tenants are compiled with, none if empty
+	base string
 }
 
-// NewSchemaServer - Creates new Schema Server
-func NewSchemaServer(sw storage.SchemaWriter, sr storage.SchemaReader) *SchemaServer {
+// NewSchemaServer - Creates new Schema Server, compiling the schemas of the tenants with the schema of the base
+// tenant if there is one
+func NewSchemaServer(sw storage.SchemaWriter, sr storage.SchemaReader, dr storage.DataReader, dw storage.DataWriter, keys []ed25519.PublicKey, deduplicate bool, tr storage.TenantReader, base string) *SchemaServer {
 	return &SchemaServer{
-		sw: sw,
-		sr: sr,
+		sw:          sw,
+		sr:          sr,
+		dr:          dr,
+		dw:          dw,
+		keys:        keys,
+		deduplicate: deduplicate,
+		tr:          tr,
+		base:        base,
 	}
 }
 
-// Write - Configure new Permify Schema to Permify
+// Write - Configure new Permify Schema to Permify. Writing the base schema writes the schemas of the tenants
+// extending it again, on its new version.
 func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest) (*v1.SchemaWriteResponse, error) {
 	ctx, span := tracer.Start(ctx, "schemas.write")
 	defer span.End()
 
-	sch, err := parser.NewParser(request.GetSchema()).Parse()
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(otelCodes.Error, err.Error())
-		return nil, err
-	}
-
-	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	sch, _, err := r.compose(ctx, request.GetTenantId(), request.GetSchema())
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -51,30 +84,131 @@ func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest
 	}
 
 	version := xid.New().String()
+	cnf := definitions(request.GetTenantId(), version, sch)
+	hash := schemaHash(cnf)
 
-	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
-	for _, st := range sch.Statements {
-		cnf = append(cnf, storage.SchemaDefinition{
-			TenantID:             request.GetTenantId(),
-			Version:              version,
-			Name:                 st.GetName(),
-			SerializedDefinition: []byte(st.String()),
-		})
+	// The previous version of the base schema tells the definitions the tenants extended it with apart.
+	var previous *ast.Schema
+	if r.base != "" && request.GetTenantId() == r.base {
+		previous, err = r.headSchema(ctx, r.base)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
 	}
 
-	err = r.sw.WriteSchema(ctx, cnf)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(otelCodes.Error, err.Error())
-		slog.Error(err.Error())
-		return nil, status.Error(GetStatus(err), err.Error())
+	if request.GetIfChanged() || r.deduplicate {
+		head, changed, _, err := r.writeIfChanged(ctx, request.GetTenantId(), cnf, database.NewTransaction())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		if !changed {
+			return &v1.SchemaWriteResponse{
+				SchemaVersion: head,
+				Changed:       false,
+				SchemaHash:    hash,
+			}, nil
+		}
+	} else {
+		err = r.sw.WriteSchema(ctx, cnf)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+	}
+
+	var unrebased []string
+	if r.base != "" && request.GetTenantId() == r.base {
+		unrebased, err = r.rebase(ctx, previous, sch)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
 	}
 
 	return &v1.SchemaWriteResponse{
-		SchemaVersion: version,
+		SchemaVersion:    version,
+		Changed:          true,
+		SchemaHash:       hash,
+		UnrebasedTenants: unrebased,
 	}, nil
 }
 
+// writeIfChanged writes the definitions of the schema along with the transaction, unless the latest version of the
+// tenant holds the same schema already, in which case only the data of the transaction is written, and that version
+// is returned along with false. The snap token of the data written is returned either way, empty if none was. The latest version is compared again in the transaction writing the schema, so that
+// concurrent writes of the same schema write a single version, and compared anew when another version was written
+// in between.
+func (r *SchemaServer) writeIfChanged(ctx context.Context, tenantID string, cnf []storage.SchemaDefinition, transaction *database.Transaction) (string, bool, string, error) {
+	hash := schemaHash(cnf)
+	for i := 0; i < _maxSchemaWriteAttempts; i++ {
+		head, headHash, err := r.headSchemaHash(ctx, tenantID)
+		if err != nil {
+			return "", false, "", err
+		}
+
+		if headHash == hash {
+			var snap string
+			if len(transaction.Tuples.GetTuples()) > 0 || len(transaction.Attributes.GetAttributes()) > 0 {
+				token, err := r.dw.RunTransaction(ctx, tenantID, transaction)
+				if err != nil {
+					return "", false, "", err
+				}
+				snap = token.String()
+			}
+			return head, false, snap, nil
+		}
+
+		transaction.ExpectSchemaVersion(head)
+		token, err := r.dw.MigrateSchema(ctx, tenantID, cnf, transaction)
+		if err != nil {
+			if err.Error() == v1.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String() {
+				continue
+			}
+			return "", false, "", err
+		}
+		return "", true, token.String(), nil
+	}
+	return "", false, "", errors.New(v1.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// headSchemaHash returns the latest schema version of the tenant along with the hash of its definitions, both
+// empty if the tenant has no schema.
+func (r *SchemaServer) headSchemaHash(ctx context.Context, tenantID string) (version, hash string, err error) {
+	version, err = r.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		if err.Error() == v1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return "", "", nil
+		}
+		return "", "", err
+	}
+
+	hash, err = r.versionHash(ctx, tenantID, version)
+	if err != nil {
+		return "", "", err
+	}
+
+	return version, hash, nil
+}
+
+// versionHash returns the hash of the definitions of the schema version of the tenant.
+func (r *SchemaServer) versionHash(ctx context.Context, tenantID, version string) (string, error) {
+	current, err := r.sr.ReadSchemaDefinitions(ctx, tenantID, version)
+	if err != nil {
+		return "", err
+	}
+	return schemaHash(current), nil
+}
+
 // Read - Read created Schema
 func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest) (*v1.SchemaReadResponse, error) {
 	ctx, span := tracer.Start(ctx, "schemas.read")
@@ -97,7 +231,576 @@ func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest)
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	hash, err := r.versionHash(ctx, request.GetTenantId(), version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
 	return &v1.SchemaReadResponse{
-		Schema: response,
+		Schema:     response,
+		SchemaHash: hash,
+	}, nil
+}
+
+// ReadPartial - Read some of the entity and rule definitions of created Schema, along with the ones they reference
+// if requested
+func (r *SchemaServer) ReadPartial(ctx context.Context, request *v1.SchemaReadPartialRequest) (*v1.SchemaReadPartialResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.read-partial")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		ver, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		version = ver
+	}
+
+	entityNames := append([]string(nil), request.GetEntities()...)
+	ruleNames := append([]string(nil), request.GetRules()...)
+	visited := map[string]struct{}{}
+
+	var entities []*v1.EntityDefinition
+	var rules []*v1.RuleDefinition
+
+	// Read the definitions by name, adding the ones they reference to the names to read if requested.
+	for len(entityNames) > 0 || len(ruleNames) > 0 {
+		if len(entityNames) > 0 {
+			name := entityNames[0]
+			entityNames = entityNames[1:]
+			if _, ok := visited["entity:"+name]; ok {
+				continue
+			}
+			visited["entity:"+name] = struct{}{}
+
+			definition, _, err := r.sr.ReadEntityDefinition(ctx, request.GetTenantId(), name, version)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				slog.Error(err.Error())
+				return nil, status.Error(GetStatus(err), err.Error())
+			}
+			entities = append(entities, definition)
+
+			if request.GetIncludeReferences() {
+				referencedEntities, referencedRules := schema.GetReferencedDefinitionNames(definition)
+				entityNames = append(entityNames, referencedEntities...)
+				ruleNames = append(ruleNames, referencedRules...)
+			}
+			continue
+		}
+
+		name := ruleNames[0]
+		ruleNames = ruleNames[1:]
+		if _, ok := visited["rule:"+name]; ok {
+			continue
+		}
+		visited["rule:"+name] = struct{}{}
+
+		definition, _, err := r.sr.ReadRuleDefinition(ctx, request.GetTenantId(), name, version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		rules = append(rules, definition)
+	}
+
+	hash, err := r.versionHash(ctx, request.GetTenantId(), version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaReadPartialResponse{
+		Schema:        schema.NewSchemaFromEntityAndRuleDefinitions(entities, rules),
+		SchemaVersion: version,
+		SchemaHash:    hash,
+	}, nil
+}
+
+// ApplyBundle - Applies a bundle, writing its schema as a new version along with the relationships and attributes
+// it is seeded with. The whole bundle is validated before anything is written.
+func (r *SchemaServer) ApplyBundle(ctx context.Context, request *v1.SchemaApplyBundleRequest) (*v1.SchemaApplyBundleResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.apply-bundle")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	b := request.GetBundle()
+
+	if len(r.keys) > 0 {
+		err := bundle.Verify(b, request.GetSignature(), r.keys)
+		if err != nil {
+			err = fmt.Errorf("%s: %s", v1.ErrorCode_ERROR_CODE_INVALID_BUNDLE_SIGNATURE.String(), err.Error())
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+	}
+
+	sch, entities, err := r.compose(ctx, request.GetTenantId(), b.GetSchema())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	err = validateBundleData(entities, b)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	version := xid.New().String()
+	cnf := definitions(request.GetTenantId(), version, sch)
+	hash := schemaHash(cnf)
+
+	transaction := database.NewTransaction()
+	transaction.Write(b.GetTuples(), b.GetAttributes())
+
+	// The schema of the bundle isn't written again if the latest version already holds it, only its data is.
+	if r.deduplicate {
+		head, changed, snap, err := r.writeIfChanged(ctx, request.GetTenantId(), cnf, transaction)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		if !changed {
+			version = head
+		}
+
+		slog.Info("bundle applied", slog.String("tenant_id", request.GetTenantId()), slog.String("name", b.GetName()), slog.String("version", b.GetVersion()), slog.String("schema_version", version))
+
+		return &v1.SchemaApplyBundleResponse{
+			SchemaVersion: version,
+			SnapToken:     snap,
+			Changed:       changed,
+			SchemaHash:    hash,
+		}, nil
+	}
+
+	// The schema is written along with its data in a single transaction, so that neither is written without the
+	// other.
+	snap, err := r.dw.MigrateSchema(ctx, request.GetTenantId(), cnf, transaction)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	slog.Info("bundle applied", slog.String("tenant_id", request.GetTenantId()), slog.String("name", b.GetName()), slog.String("version", b.GetVersion()), slog.String("schema_version", version))
+
+	return &v1.SchemaApplyBundleResponse{
+		SchemaVersion: version,
+		SnapToken:     snap.String(),
+		Changed:       true,
+		SchemaHash:    hash,
 	}, nil
 }
+
+// Migrate - Writes a new version of the schema along with the changes it makes to the relationships and attributes
+// of the tenant in a single transaction, so that no snapshot holds the new version without the data matching it. The
+// migrated data is validated against the new version before anything is written.
+func (r *SchemaServer) Migrate(ctx context.Context, request *v1.SchemaMigrateRequest) (*v1.SchemaMigrateResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.migrate")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+
+	sch, entities, err := r.compose(ctx, request.GetTenantId(), request.GetSchema())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+
+	// The version the migration is based on is checked before the data is read, so that the migrations are applied
+	// to the data of that version.
+	if request.GetSchemaVersion() != "" {
+		head, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil && err.Error() != v1.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		if head != request.GetSchemaVersion() {
+			err = fmt.Errorf("%s: the latest version is %q", v1.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String(), head)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+	}
+
+	transaction, relationships, attributes, err := r.migration(ctx, request, entities)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	if len(transaction.Tuples.GetTuples())+len(transaction.Attributes.GetAttributes()) > _maxMigratedData {
+		err = fmt.Errorf("%s: the migration writes more than %d relationships and attributes", v1.ErrorCode_ERROR_CODE_VALIDATION.String(), _maxMigratedData)
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	err = validateSchemaData(entities, transaction.Tuples.GetTuples(), transaction.Attributes.GetAttributes())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	// The version is checked again as the migration is written, in case another version was written meanwhile.
+	if request.GetSchemaVersion() != "" {
+		transaction.ExpectSchemaVersion(request.GetSchemaVersion())
+	}
+
+	version := xid.New().String()
+	cnf := definitions(request.GetTenantId(), version, sch)
+
+	snap, err := r.dw.MigrateSchema(ctx, request.GetTenantId(), cnf, transaction)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	slog.Info("schema migrated", slog.String("tenant_id", request.GetTenantId()), slog.String("schema_version", version), slog.Int("relationships", relationships), slog.Int("attributes", attributes))
+
+	return &v1.SchemaMigrateResponse{
+		SchemaVersion: version,
+		SnapToken:     snap.String(),
+		SchemaHash:    schemaHash(cnf),
+		Relationships: int32(relationships),
+		Attributes:    int32(attributes),
+	}, nil
+}
+
+// migration reads the relationships and attributes of the tenant the migrations of the request change, and returns
+// the transaction replacing them with their migrated copies along with the operations of the request, and the number
+// of relationships and attributes migrated. The migrations are applied in order, so a relation renamed twice ends up
+// with the name of the last migration.
+func (r *SchemaServer) migration(ctx context.Context, request *v1.SchemaMigrateRequest, entities map[string]*v1.EntityDefinition) (*database.Transaction, int, int, error) {
+	tenantID := request.GetTenantId()
+	transaction := database.NewTransaction()
+
+	var tuples []*v1.Tuple
+	var attributes []*v1.Attribute
+	if len(request.GetMigrations()) > 0 {
+		st, err := r.dr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		snap := st.Encode().String()
+
+		// The relationships and attributes matching several migrations are read once
+		seenTuples := map[string]struct{}{}
+		seenAttributes := map[string]struct{}{}
+		readTuples := func(filter *v1.TupleFilter) error {
+			it, err := r.dr.QueryRelationships(ctx, tenantID, filter, snap)
+			if err != nil {
+				return err
+			}
+			for it.HasNext() {
+				tup := it.GetNext()
+				if _, ok := seenTuples[tuple.ToString(tup)]; ok {
+					continue
+				}
+				seenTuples[tuple.ToString(tup)] = struct{}{}
+				tuples = append(tuples, tup)
+			}
+			return nil
+		}
+
+		// The entity types of the schema are sorted, so that the relationships are migrated in a stable order
+		types := make([]string, 0, len(entities))
+		for name := range entities {
+			types = append(types, name)
+		}
+		sort.Strings(types)
+
+		for _, migration := range request.GetMigrations() {
+			switch m := migration.GetType().(type) {
+			case *v1.SchemaMigration_RenameRelation:
+				rename := m.RenameRelation
+				err = readTuples(&v1.TupleFilter{
+					Entity:   &v1.EntityFilter{Type: rename.GetEntityType()},
+					Relation: rename.GetFrom(),
+				})
+				if err != nil {
+					return nil, 0, 0, err
+				}
+				// The relationships whose subjects are sets of the relation, e.g. team:1#member, are stored with the
+				// entities referencing the relation
+				for _, typ := range types {
+					err = readTuples(&v1.TupleFilter{
+						Entity:  &v1.EntityFilter{Type: typ},
+						Subject: &v1.SubjectFilter{Type: rename.GetEntityType(), Relation: rename.GetFrom()},
+					})
+					if err != nil {
+						return nil, 0, 0, err
+					}
+				}
+			case *v1.SchemaMigration_RenameAttribute:
+				rename := m.RenameAttribute
+				it, err := r.dr.QueryAttributes(ctx, tenantID, &v1.AttributeFilter{
+					Entity:     &v1.EntityFilter{Type: rename.GetEntityType()},
+					Attributes: []string{rename.GetFrom()},
+				}, snap)
+				if err != nil {
+					return nil, 0, 0, err
+				}
+				for it.HasNext() {
+					a := it.GetNext()
+					key := attribute.EntityAndCallOrAttributeToString(a.GetEntity(), a.GetAttribute())
+					if _, ok := seenAttributes[key]; ok {
+						continue
+					}
+					seenAttributes[key] = struct{}{}
+					attributes = append(attributes, a)
+				}
+			}
+		}
+	}
+
+	for _, tup := range tuples {
+		transaction.Delete(&v1.TupleFilter{
+			Entity:   &v1.EntityFilter{Type: tup.GetEntity().GetType(), Ids: []string{tup.GetEntity().GetId()}},
+			Relation: tup.GetRelation(),
+			Subject: &v1.SubjectFilter{
+				Type:     tup.GetSubject().GetType(),
+				Ids:      []string{tup.GetSubject().GetId()},
+				Relation: tup.GetSubject().GetRelation(),
+			},
+		}, &v1.AttributeFilter{})
+
+		migrated := proto.Clone(tup).(*v1.Tuple)
+		for _, migration := range request.GetMigrations() {
+			rename := migration.GetRenameRelation()
+			if rename == nil {
+				continue
+			}
+			if migrated.GetEntity().GetType() == rename.GetEntityType() && migrated.GetRelation() == rename.GetFrom() {
+				migrated.Relation = rename.GetTo()
+			}
+			if migrated.GetSubject().GetType() == rename.GetEntityType() && migrated.GetSubject().GetRelation() == rename.GetFrom() {
+				migrated.Subject.Relation = rename.GetTo()
+			}
+		}
+		transaction.Write([]*v1.Tuple{migrated}, nil)
+	}
+
+	for _, a := range attributes {
+		transaction.Delete(&v1.TupleFilter{}, &v1.AttributeFilter{
+			Entity:     &v1.EntityFilter{Type: a.GetEntity().GetType(), Ids: []string{a.GetEntity().GetId()}},
+			Attributes: []string{a.GetAttribute()},
+		})
+
+		migrated := proto.Clone(a).(*v1.Attribute)
+		for _, migration := range request.GetMigrations() {
+			rename := migration.GetRenameAttribute()
+			if rename == nil {
+				continue
+			}
+			if migrated.GetEntity().GetType() == rename.GetEntityType() && migrated.GetAttribute() == rename.GetFrom() {
+				migrated.Attribute = rename.GetTo()
+			}
+		}
+		transaction.Write(nil, []*v1.Attribute{migrated})
+	}
+
+	for _, operation := range request.GetOperations() {
+		switch op := operation.GetType().(type) {
+		case *v1.DataOperation_Write:
+			transaction.Write(op.Write.GetTuples(), op.Write.GetAttributes())
+		case *v1.DataOperation_Delete:
+			err := validation.ValidateFilters(op.Delete.GetTupleFilter(), op.Delete.GetAttributeFilter())
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			transaction.Delete(op.Delete.GetTupleFilter(), op.Delete.GetAttributeFilter())
+		}
+	}
+
+	return transaction, len(tuples), len(attributes), nil
+}
+
+// compile parses and compiles the schema, returning its syntax tree along with its entity definitions.
+func compile(schema string) (*ast.Schema, map[string]*v1.EntityDefinition, error) {
+	sch, err := parser.NewParser(schema).Parse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entities, _, err := compiler.NewCompiler(true, sch).Compile()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	definitions := make(map[string]*v1.EntityDefinition, len(entities))
+	for _, entity := range entities {
+		definitions[entity.GetName()] = entity
+	}
+
+	return sch, definitions, nil
+}
+
+// definitions returns the statements of the schema as the definitions of the version to store.
+func definitions(tenantID, version string, sch *ast.Schema) []storage.SchemaDefinition {
+	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		cnf = append(cnf, storage.SchemaDefinition{
+			TenantID:             tenantID,
+			Version:              version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(st.String()),
+		})
+	}
+	return cnf
+}
+
+// schemaHash returns the hex encoded SHA-256 digest of the names and the serialized definitions of a schema version,
+// in the order of their names.
+func schemaHash(definitions []storage.SchemaDefinition) string {
+	sorted := make([]storage.SchemaDefinition, len(definitions))
+	copy(sorted, definitions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	h := sha256.New()
+	for _, definition := range sorted {
+		h.Write([]byte(definition.Name))
+		h.Write([]byte{0})
+		h.Write(definition.SerializedDefinition)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// validateBundleData validates the relationships and the attributes of the bundle against the entity definitions
+// of its schema. Cardinality is checked against the relationships of the bundle alone, as seed data is expected to
+// hold every relationship of the entities it seeds.
+func validateBundleData(entities map[string]*v1.EntityDefinition, b *v1.Bundle) error {
+	err := validateSchemaData(entities, b.GetTuples(), b.GetAttributes())
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]struct{}{}
+	counts := map[string]map[string]int{}
+	seeded := map[string]*v1.Entity{}
+
+	for _, tup := range b.GetTuples() {
+		if _, ok := seen[tuple.ToString(tup)]; ok {
+			continue
+		}
+		seen[tuple.ToString(tup)] = struct{}{}
+
+		key := tuple.EntityToString(tup.GetEntity())
+		if _, ok := counts[key]; !ok {
+			counts[key] = map[string]int{}
+			seeded[key] = tup.GetEntity()
+		}
+		counts[key][tup.GetRelation()]++
+	}
+
+	for key, entity := range seeded {
+		if !hasCardinality(entities[entity.GetType()]) {
+			continue
+		}
+		err := validation.ValidateCardinality(entities[entity.GetType()], entity, counts[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSchemaData validates the relationships and the attributes against the entity definitions of a schema.
+func validateSchemaData(entities map[string]*v1.EntityDefinition, tuples []*v1.Tuple, attributes []*v1.Attribute) error {
+	definition := func(typ string) (*v1.EntityDefinition, error) {
+		d, ok := entities[typ]
+		if !ok {
+			return nil, errors.New(v1.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+		}
+		return d, nil
+	}
+
+	for _, tup := range tuples {
+		d, err := definition(tup.GetEntity().GetType())
+		if err != nil {
+			return err
+		}
+
+		err = validation.ValidateTuple(d, tup)
+		if err != nil {
+			return err
+		}
+
+		err = validation.ValidateID(d, tup.GetEntity().GetId())
+		if err != nil {
+			return err
+		}
+
+		if !tuple.IsWildcardSubject(tup.GetSubject()) {
+			s, err := definition(tup.GetSubject().GetType())
+			if err != nil {
+				return err
+			}
+			err = validation.ValidateID(s, tup.GetSubject().GetId())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, a := range attributes {
+		d, err := definition(a.GetEntity().GetType())
+		if err != nil {
+			return err
+		}
+
+		err = validation.ValidateAttribute(d, a)
+		if err != nil {
+			return err
+		}
+
+		err = validation.ValidateID(d, a.GetEntity().GetId())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,471 @@
+package servers
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestSchemaServer_ReadPartial(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+
+	written, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema: `
+		entity user {}
+
+		entity organization {
+			relation member @user
+		}
+
+		entity repository {
+			relation parent @organization
+
+			attribute public boolean
+
+			permission view = parent.member or is_public(public)
+		}
+
+		entity team {
+			relation member @user
+		}
+
+		rule is_public(public boolean) {
+			public == true
+		}
+		`,
+	})
+	require.NoError(t, err)
+
+	names := func(schema *v1.SchemaDefinition) []string {
+		var names []string
+		for name := range schema.GetReferences() {
+			names = append(names, name)
+		}
+		return names
+	}
+
+	response, err := server.ReadPartial(ctx, &v1.SchemaReadPartialRequest{
+		TenantId: "t1",
+		Metadata: &v1.SchemaReadRequestMetadata{},
+		Entities: []string{"repository"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, written.GetSchemaVersion(), response.GetSchemaVersion())
+	assert.ElementsMatch(t, []string{"repository"}, names(response.GetSchema()))
+	assert.Contains(t, response.GetSchema().GetEntityDefinitions()["repository"].GetPermissions(), "view")
+
+	response, err = server.ReadPartial(ctx, &v1.SchemaReadPartialRequest{
+		TenantId:          "t1",
+		Metadata:          &v1.SchemaReadRequestMetadata{SchemaVersion: written.GetSchemaVersion()},
+		Entities:          []string{"repository"},
+		IncludeReferences: true,
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"repository", "organization", "user", "is_public"}, names(response.GetSchema()))
+
+	_, err = server.ReadPartial(ctx, &v1.SchemaReadPartialRequest{
+		TenantId: "t1",
+		Metadata: &v1.SchemaReadRequestMetadata{},
+		Rules:    []string{"missing"},
+	})
+	assert.Error(t, err)
+}
+
+func TestSchemaServer_Deduplicate(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, true, nil, "")
+
+	first, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity user {}\n\nentity organization {\n\trelation member @user\n}",
+	})
+	require.NoError(t, err)
+	assert.True(t, first.GetChanged())
+
+	// The same definitions, formatted differently, return the latest version.
+	second, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity user {}\nentity organization { relation member @user }",
+	})
+	require.NoError(t, err)
+	assert.False(t, second.GetChanged())
+	assert.Equal(t, first.GetSchemaVersion(), second.GetSchemaVersion())
+	assert.Equal(t, first.GetSchemaHash(), second.GetSchemaHash())
+
+	read, err := server.Read(ctx, &v1.SchemaReadRequest{
+		TenantId: "t1",
+		Metadata: &v1.SchemaReadRequestMetadata{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first.GetSchemaHash(), read.GetSchemaHash())
+
+	third, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity user {}",
+	})
+	require.NoError(t, err)
+	assert.True(t, third.GetChanged())
+	assert.NotEqual(t, first.GetSchemaVersion(), third.GetSchemaVersion())
+	assert.NotEqual(t, first.GetSchemaHash(), third.GetSchemaHash())
+}
+
+// racingDataWriter writes the schema of the first migration it runs as another version, without expecting any, before
+// running the migration, as a write of the same schema concurrent with it would.
+type racingDataWriter struct {
+	storage.DataWriter
+	version string
+}
+
+func (w *racingDataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	if w.version == "" {
+		w.version = xid.New().String()
+		concurrent := make([]storage.SchemaDefinition, 0, len(definitions))
+		for _, definition := range definitions {
+			definition.Version = w.version
+			concurrent = append(concurrent, definition)
+		}
+		if _, err := w.DataWriter.MigrateSchema(ctx, tenantID, concurrent, database.NewTransaction()); err != nil {
+			return nil, err
+		}
+	}
+	return w.DataWriter.MigrateSchema(ctx, tenantID, definitions, transaction)
+}
+
+func TestSchemaServer_IfChanged(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	// The head versions of the memory storage are shared by its databases, so each case writes to a tenant of its own
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+	schema := "entity user {}\nentity organization {\n\trelation member @user\n}"
+
+	versions := func(tenantID string) []string {
+		versions, err := factories.SchemaReaderFactory(db).ReadSchemaVersions(ctx, tenantID)
+		require.NoError(t, err)
+		return versions
+	}
+
+	t.Run("writes a schema once", func(t *testing.T) {
+		first, err := server.Write(ctx, &v1.SchemaWriteRequest{TenantId: "if-changed", Schema: schema, IfChanged: true})
+		require.NoError(t, err)
+		assert.True(t, first.GetChanged())
+
+		second, err := server.Write(ctx, &v1.SchemaWriteRequest{TenantId: "if-changed", Schema: schema, IfChanged: true})
+		require.NoError(t, err)
+		assert.False(t, second.GetChanged())
+		assert.Equal(t, first.GetSchemaVersion(), second.GetSchemaVersion())
+		assert.Equal(t, first.GetSchemaHash(), second.GetSchemaHash())
+
+		// Writes without it still write a new version, since the server doesn't deduplicate schemas
+		third, err := server.Write(ctx, &v1.SchemaWriteRequest{TenantId: "if-changed", Schema: schema})
+		require.NoError(t, err)
+		assert.True(t, third.GetChanged())
+		assert.Equal(t, []string{first.GetSchemaVersion(), third.GetSchemaVersion()}, versions("if-changed"))
+	})
+
+	t.Run("returns the version of a write of the same schema made since the latest version was compared", func(t *testing.T) {
+		racing := &racingDataWriter{DataWriter: factories.DataWriterFactory(db)}
+		server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), racing, nil, false, nil, "")
+
+		written, err := server.Write(ctx, &v1.SchemaWriteRequest{TenantId: "if-changed-race", Schema: schema, IfChanged: true})
+		require.NoError(t, err)
+		assert.False(t, written.GetChanged())
+		assert.Equal(t, racing.version, written.GetSchemaVersion())
+		assert.Equal(t, []string{racing.version}, versions("if-changed-race"))
+	})
+
+	t.Run("writes a single version for concurrent writes of the same schema", func(t *testing.T) {
+		var wg sync.WaitGroup
+		responses := make([]*v1.SchemaWriteResponse, 8)
+		errs := make([]error, len(responses))
+		for i := range responses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				responses[i], errs[i] = server.Write(ctx, &v1.SchemaWriteRequest{TenantId: "if-changed-concurrent", Schema: schema, IfChanged: true})
+			}(i)
+		}
+		wg.Wait()
+
+		changed := 0
+		for i, response := range responses {
+			require.NoError(t, errs[i])
+			if response.GetChanged() {
+				changed++
+			}
+			assert.Equal(t, responses[0].GetSchemaVersion(), response.GetSchemaVersion())
+		}
+		assert.Equal(t, 1, changed)
+		assert.Len(t, versions("if-changed-concurrent"), 1)
+	})
+
+	t.Run("applies the data of a bundle along with the schema it is compared with", func(t *testing.T) {
+		server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, true, nil, "")
+
+		tup, err := tuple.Tuple("organization:1#member@user:1")
+		require.NoError(t, err)
+		request := &v1.SchemaApplyBundleRequest{
+			TenantId: "if-changed-bundle",
+			Bundle:   &v1.Bundle{Name: "organizations", Version: "1.0.0", Schema: schema, Tuples: []*v1.Tuple{tup}},
+		}
+
+		first, err := server.ApplyBundle(ctx, request)
+		require.NoError(t, err)
+		assert.True(t, first.GetChanged())
+		assert.NotEmpty(t, first.GetSnapToken())
+
+		second, err := server.ApplyBundle(ctx, request)
+		require.NoError(t, err)
+		assert.False(t, second.GetChanged())
+		assert.NotEmpty(t, second.GetSnapToken())
+		assert.Equal(t, first.GetSchemaVersion(), second.GetSchemaVersion())
+		assert.Len(t, versions("if-changed-bundle"), 1)
+	})
+}
+
+func TestSchemaServer_Migrate(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+	data := NewDataServer(factories.DataReaderFactory(db), factories.DataWriterFactory(db), factories.SchemaReaderFactory(db), config.Data{})
+
+	written, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity user {}\nentity team {\n\trelation member @user\n}\nentity doc {\n\trelation reader @user @team#member\n\tattribute shared boolean\n}",
+	})
+	require.NoError(t, err)
+
+	var tuples []*v1.Tuple
+	for _, relationship := range []string{"team:1#member@user:1", "doc:1#reader@user:2", "doc:1#reader@team:1#member"} {
+		tup, err := tuple.Tuple(relationship)
+		require.NoError(t, err)
+		tuples = append(tuples, tup)
+	}
+	shared, err := attribute.Attribute("doc:1$shared|boolean:true")
+	require.NoError(t, err)
+	_, err = data.Write(ctx, &v1.DataWriteRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.DataWriteRequestMetadata{SchemaVersion: written.GetSchemaVersion()},
+		Tuples:     tuples,
+		Attributes: []*v1.Attribute{shared},
+	})
+	require.NoError(t, err)
+
+	schema := "entity user {}\nentity team {\n\trelation participant @user\n}\nentity doc {\n\trelation viewer @user @team#participant\n\tattribute public boolean\n}"
+	migrations := []*v1.SchemaMigration{
+		{Type: &v1.SchemaMigration_RenameRelation{RenameRelation: &v1.SchemaMigrationRename{EntityType: "team", From: "member", To: "participant"}}},
+		{Type: &v1.SchemaMigration_RenameRelation{RenameRelation: &v1.SchemaMigrationRename{EntityType: "doc", From: "reader", To: "viewer"}}},
+		{Type: &v1.SchemaMigration_RenameAttribute{RenameAttribute: &v1.SchemaMigrationRename{EntityType: "doc", From: "shared", To: "public"}}},
+	}
+
+	// Migrations based on another version are rejected
+	_, err = server.Migrate(ctx, &v1.SchemaMigrateRequest{TenantId: "t1", Schema: schema, SchemaVersion: "other", Migrations: migrations})
+	assert.Error(t, err)
+
+	// as are the ones leaving data the schema does not allow
+	_, err = server.Migrate(ctx, &v1.SchemaMigrateRequest{TenantId: "t1", Schema: schema, SchemaVersion: written.GetSchemaVersion(), Migrations: migrations[1:]})
+	assert.Error(t, err)
+
+	migrated, err := server.Migrate(ctx, &v1.SchemaMigrateRequest{TenantId: "t1", Schema: schema, SchemaVersion: written.GetSchemaVersion(), Migrations: migrations})
+	require.NoError(t, err)
+	assert.NotEmpty(t, migrated.GetSnapToken())
+	assert.Equal(t, int32(3), migrated.GetRelationships())
+	assert.Equal(t, int32(1), migrated.GetAttributes())
+
+	head, err := factories.SchemaReaderFactory(db).HeadVersion(ctx, "t1")
+	require.NoError(t, err)
+	assert.Equal(t, migrated.GetSchemaVersion(), head)
+
+	relationships, err := data.ReadRelationships(ctx, &v1.RelationshipReadRequest{
+		TenantId: "t1",
+		Metadata: &v1.RelationshipReadRequestMetadata{SnapToken: migrated.GetSnapToken()},
+		Filter:   &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc"}},
+	})
+	require.NoError(t, err)
+	var read []string
+	for _, tup := range relationships.GetTuples() {
+		read = append(read, tuple.ToString(tup))
+	}
+	assert.ElementsMatch(t, []string{"doc:1#viewer@user:2", "doc:1#viewer@team:1#participant"}, read)
+
+	attributes, err := data.ReadAttributes(ctx, &v1.AttributeReadRequest{
+		TenantId: "t1",
+		Metadata: &v1.AttributeReadRequestMetadata{SnapToken: migrated.GetSnapToken()},
+		Filter:   &v1.AttributeFilter{Entity: &v1.EntityFilter{Type: "doc"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, attributes.GetAttributes(), 1)
+	assert.Equal(t, "public", attributes.GetAttributes()[0].GetAttribute())
+}
+
+// brokenSchemaDataWriter fails the schema writes of its migrations in the storage, writing a definition without a
+// name along with the definitions of the version.
+type brokenSchemaDataWriter struct {
+	storage.DataWriter
+}
+
+func (w brokenSchemaDataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	return w.DataWriter.MigrateSchema(ctx, tenantID, append(definitions, storage.SchemaDefinition{TenantID: tenantID, Version: definitions[0].Version}), transaction)
+}
+
+func TestSchemaServer_ApplyBundle(t *testing.T) {
+	ctx := context.Background()
+
+	tup, err := tuple.Tuple("doc:1#owner@user:1")
+	require.NoError(t, err)
+	// The head versions of the memory storage are shared by its databases, so the bundles are applied to a tenant of
+	// their own
+	request := &v1.SchemaApplyBundleRequest{
+		TenantId: "bundles",
+		Bundle: &v1.Bundle{
+			Name:    "docs",
+			Version: "1.0.0",
+			Schema:  "entity user {}\nentity doc {\n\trelation owner @user\n}",
+			Tuples:  []*v1.Tuple{tup},
+		},
+	}
+
+	relationships := func(db database.Database) []*v1.Tuple {
+		st, err := factories.DataReaderFactory(db).HeadSnapshot(ctx, "bundles")
+		require.NoError(t, err)
+		it, err := factories.DataReaderFactory(db).QueryRelationships(ctx, "bundles", &v1.TupleFilter{Entity: &v1.EntityFilter{Type: "doc"}}, st.Encode().String())
+		require.NoError(t, err)
+		var tuples []*v1.Tuple
+		for it.HasNext() {
+			tuples = append(tuples, it.GetNext())
+		}
+		return tuples
+	}
+
+	// The data of the bundle isn't written when its schema fails to be
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), brokenSchemaDataWriter{factories.DataWriterFactory(db)}, nil, false, nil, "")
+	_, err = server.ApplyBundle(ctx, request)
+	require.Error(t, err)
+
+	_, err = factories.SchemaReaderFactory(db).HeadVersion(ctx, "bundles")
+	assert.Error(t, err)
+	assert.Empty(t, relationships(db))
+
+	// and is written along with it otherwise
+	db, err = factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	server = NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, nil, "")
+	applied, err := server.ApplyBundle(ctx, request)
+	require.NoError(t, err)
+	assert.True(t, applied.GetChanged())
+	assert.NotEmpty(t, applied.GetSnapToken())
+
+	head, err := factories.SchemaReaderFactory(db).HeadVersion(ctx, "bundles")
+	require.NoError(t, err)
+	assert.Equal(t, applied.GetSchemaVersion(), head)
+	assert.Len(t, relationships(db), 1)
+}
+
+func TestSchemaServer_Base(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+	require.NoError(t, err)
+
+	tenants := factories.TenantWriterFactory(db)
+	for _, id := range []string{"base", "t1", "t2"} {
+		_, err = tenants.CreateTenant(ctx, id, id)
+		require.NoError(t, err)
+	}
+
+	server := NewSchemaServer(factories.SchemaWriterFactory(db), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), nil, false, factories.TenantReaderFactory(db), "base")
+
+	_, err = server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "base",
+		Schema:   "entity user {}\n\nentity document {\n\trelation owner @user\n\tpermission edit = owner\n}",
+	})
+	require.NoError(t, err)
+
+	// The tenant extends the entity of the base schema and adds an entity of its own
+	_, err = server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema:   "entity document {\n\trelation reviewer @user\n\tpermission review = reviewer or owner\n}\n\nentity team {\n\trelation member @user\n}",
+	})
+	require.NoError(t, err)
+
+	read := func(tenantID string) *v1.SchemaDefinition {
+		response, err := server.Read(ctx, &v1.SchemaReadRequest{TenantId: tenantID, Metadata: &v1.SchemaReadRequestMetadata{}})
+		require.NoError(t, err)
+		return response.GetSchema()
+	}
+	document := read("t1").GetEntityDefinitions()["document"]
+	assert.Contains(t, document.GetRelations(), "owner")
+	assert.Contains(t, document.GetRelations(), "reviewer")
+	assert.Contains(t, document.GetPermissions(), "edit")
+	assert.Contains(t, read("t1").GetEntityDefinitions(), "team")
+
+	// The tenant holding a copy of the base schema inherits it
+	_, err = server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t2",
+		Schema:   "entity user {}\n\nentity document {\n\trelation owner @user\n\tpermission edit = owner\n}",
+	})
+	require.NoError(t, err)
+
+	// Members of the base schema can't be redefined
+	_, err = server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "t2",
+		Schema:   "entity document {\n\trelation owner @team\n}\n\nentity team {}",
+	})
+	assert.Error(t, err)
+
+	// The tenants are rebased on the new version of the base schema, keeping their own definitions
+	written, err := server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "base",
+		Schema:   "entity user {}\n\nentity document {\n\trelation owner @user\n\trelation viewer @user\n\tpermission edit = owner\n\tpermission view = viewer or edit\n}",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, written.GetUnrebasedTenants())
+
+	document = read("t1").GetEntityDefinitions()["document"]
+	assert.Contains(t, document.GetPermissions(), "view")
+	assert.Contains(t, document.GetPermissions(), "review")
+	assert.Contains(t, read("t1").GetEntityDefinitions(), "team")
+	assert.Contains(t, read("t2").GetEntityDefinitions()["document"].GetPermissions(), "view")
+
+	// Tenants whose definitions no longer compile with the base schema keep their version
+	written, err = server.Write(ctx, &v1.SchemaWriteRequest{
+		TenantId: "base",
+		Schema:   "entity user {}\n\nentity document {\n\trelation viewer @user\n\tpermission view = viewer\n}",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1"}, written.GetUnrebasedTenants())
+	assert.Contains(t, read("t1").GetEntityDefinitions()["document"].GetRelations(), "owner")
+	assert.NotContains(t, read("t2").GetEntityDefinitions()["document"].GetRelations(), "owner")
+}
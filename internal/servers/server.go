@@ -2,43 +2,54 @@ package servers
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
-	"net/http/pprof"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/ratelimit"
 
-	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
-
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	grpcRecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcValidator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/rs/cors"
-	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
+	api "go.opentelemetry.io/otel/metric"
+	"golang.org/x/crypto/acme/autocert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	health "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/Permify/permify/internal/authn/oidc"
+	"github.com/Permify/permify/internal/authn/peer"
 	"github.com/Permify/permify/internal/authn/preshared"
+	"github.com/Permify/permify/internal/authn/spiffe"
 	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/middleware"
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/supervisor"
+	"github.com/Permify/permify/internal/tunables"
+	"github.com/Permify/permify/pkg/authn"
 	grpcV1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
 var tracer = otel.Tracer("servers")
 
+// _gatewayBufferSize is the size of the in-memory connection between the HTTP handlers and the gRPC server.
+const _gatewayBufferSize = 1024 * 1024
+
 // Container is a struct that holds the invoker and various storage storage
 // for permission-related operations. It serves as a central point of access
 // for interacting with the underlying data and services.
@@ -59,11 +70,62 @@ type Container struct {
 	TW storage.TenantWriter
 
 	W storage.Watcher
+
+	// Custom interceptors of the gRPC servers
+	interceptors interceptors
+	// Public keys bundles must be signed with
+	bundleKeys []ed25519.PublicKey
+	// Whether writing the schema the latest version already holds returns that version
+	deduplicateSchemas bool
+	// Tenant holding the base schema the schemas of the other tenants are compiled with, none if empty
+	schemaBase string
+	// Meter the checks of deprecated relations and permissions are counted in, if any
+	meter api.Meter
+	// Maximum duration of the evaluation of a check, no limit if zero
+	checkTimeout time.Duration
+	// Rate limiter of the gRPC servers, a local one of the configured rate limit if nil
+	limiter ratelimit.Limiter
+	// Watch service configuration
+	watch config.Watch
+	// Data service configuration
+	data config.Data
+	// Size limits of the requests, beyond which they are rejected
+	limits config.Limits
+	// Manager of the certificates of the HTTP and gRPC servers obtained automatically, if any
+	certificates *autocert.Manager
+	// Tenant, depth and translation table of the actions of the OPA-style decisions
+	decision config.Decision
+	// Rules of the Envoy external authorization service, not served if nil
+	extAuthz *ExtAuthzRules
+	// Database configuration, the Admin service is served only with it
+	database *config.Database
+	// Database regions listed by the Admin service, if any
+	regions DatabaseRegions
+	// Hash ring of the checks dispatched to the cluster reported by the Admin service, if any
+	ring HashRing
+	// Tunables listed and changed at runtime through the Admin service, if any
+	tunables *tunables.Registry
+	// Catalog of the error codes whose details are attached to the errors of the requests
+	errorCatalog *ErrorCatalog
+	// Health server of the API, serving once the components started
+	health *HealthServer
+	// Process supervisor notified of the readiness of the servers, if any
+	supervisor supervisor.Supervisor
+
+	// mu guards the components whose settings are reloaded with the configuration, built along with the servers
+	mu sync.Mutex
+	// Authenticators of the preshared keys of the servers
+	keyAuthns []*preshared.KeyAuthn
+	// HTTP server applying the CORS policies, if any
+	gateway *GatewayServer
+	// Components built along with the servers, whose addresses are reported through the Admin service
+	components []Component
 }
 
 // NewContainer is a constructor for the Container struct.
 // It takes an Invoker, RelationshipReader, RelationshipWriter, SchemaReader, SchemaWriter,
 // TenantReader, and TenantWriter as arguments, and returns a pointer to a Container instance.
+// Options such as WithUnaryInterceptors add custom interceptors to the gRPC servers.
 func NewContainer(
 	invoker invoke.Invoker,
 	dr storage.DataReader,
@@ -73,8 +135,9 @@ func NewContainer(
 	tr storage.TenantReader,
 	tw storage.TenantWriter,
 	w storage.Watcher,
+	opts ...ContainerOption,
 ) *Container {
-	return &Container{
+	container := &Container{
 		Invoker: invoker,
 		DR:      dr,
 		DW:      dw,
@@ -84,11 +147,21 @@ func NewContainer(
 		TW:      tw,
 		W:       w,
 	}
+	container.errorCatalog, _ = NewErrorCatalog(nil)
+	container.health = NewHealthServer()
+	container.health.SetServingStatus(health.HealthCheckResponse_NOT_SERVING)
+
+	// options
+	for _, opt := range opts {
+		opt(container)
+	}
+
+	return container
 }
 
 // Run is a method that starts the Container and its services, including the gRPC server,
-// an optional HTTP server, and an optional profiler server. It also sets up authentication,
-// TLS configurations, and interceptors as needed.
+// an optional HTTP server, and an optional profiler server, and stops them once the context is done.
+// It also sets up authentication, TLS configurations, and interceptors as needed.
 func (s *Container) Run(
 	ctx context.Context,
 	srv *config.Server,
@@ -97,257 +170,489 @@ func (s *Container) Run(
 	profiler *config.Profiler,
 	localInvoker invoke.Invoker,
 ) error {
-	var err error
+	components, err := s.Components(ctx, srv, dst, authentication, profiler, localInvoker)
+	if err != nil {
+		return err
+	}
+	return NewLifecycle(components...).Run(ctx)
+}
 
-	limiter := middleware.NewRateLimiter(srv.RateLimit) // for example 1000 req/sec
+// Components creates the components started by Run: the gRPC server, the invoke server used by the
+// distributed check engine, and the optional HTTP server and profiler. Deployments embedding the servers can
+// pick the components they need and run them with a Lifecycle of their own.
+func (s *Container) Components(
+	ctx context.Context,
+	srv *config.Server,
+	dst *config.Distributed,
+	authentication *config.Authn,
+	profiler *config.Profiler,
+	localInvoker invoke.Invoker,
+) ([]Component, error) {
+	if err := ValidateListeners(srv, dst, profiler); err != nil {
+		return nil, err
+	}
+
+	opts, err := s.ServerOptions(ctx, srv, authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer, err := s.BuildGRPCServer(srv, authentication, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	invokeOpts, err := s.InvokeServerOptions(ctx, dst, authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	invokeServer, err := NewInvokeServer(srv, dst, authentication, localInvoker, invokeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	components := []Component{grpcServer, invokeServer}
+
+	// Answer the HTTP-01 challenges of the certificates obtained automatically, if enabled.
+	if s.certificates != nil && srv.Autocert.ChallengeAddress != "" {
+		components = append(components, NewChallengeServer(srv.Autocert.ChallengeAddress, s.certificates))
+	}
+
+	// Start the optional HTTP server with CORS and optional TLS configurations.
+	if srv.HTTP.Enabled {
+		components = append(components, s.BuildGatewayServer(srv, opts))
+	}
+
+	// If profiling is enabled, set up the profiler using the net/http package. It is served on a port of its own,
+	// so that it is never exposed along with the API.
+	if profiler != nil && profiler.Enabled {
+		profilerServer, err := NewProfilerServer(*profiler)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, profilerServer)
+	}
+
+	// The servers are reported ready once all of them started
+	components = append(components, NewReadiness(s.health, s.supervisor))
+
+	s.mu.Lock()
+	s.components = components
+	s.mu.Unlock()
+
+	return components, nil
+}
+
+// ListenAddresses returns the addresses the servers of the components built last listen on, once started.
+func (s *Container) ListenAddresses() []ListenAddress {
+	s.mu.Lock()
+	components := s.components
+	s.mu.Unlock()
+	return listenAddresses(components)
+}
+
+// RateLimitWeights returns the tokens taken from the rate limit by requests by full gRPC method.
+func RateLimitWeights(srv *config.Server) map[string]int64 {
+	weights := make(map[string]int64, len(srv.RateLimitWeights))
+	for _, w := range srv.RateLimitWeights {
+		weights[w.Method] = w.Weight
+	}
+	return weights
+}
+
+// ServerOptions creates the interceptor chain shared by the gRPC servers: error details, validation, request
+// limits, panic recovery, rate limiting and authentication with the provider of the configured method, along with
+// the custom interceptors of the container.
+func (s *Container) ServerOptions(ctx context.Context, srv *config.Server, authentication *config.Authn) ([]grpc.ServerOption, error) {
+	limiter := s.limiter
+	if limiter == nil {
+		limiter = middleware.NewRateLimiter(srv.RateLimit, RateLimitWeights(srv)) // for example 1000 tokens/sec
+	}
+	limits := NewRequestLimits(s.limits)
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		s.errorCatalog.UnaryServerInterceptor(),
 		grpcValidator.UnaryServerInterceptor(),
+		limits.UnaryServerInterceptor(),
 		grpcRecovery.UnaryServerInterceptor(),
-		ratelimit.UnaryServerInterceptor(limiter),
 	}
+	unaryInterceptors = append(unaryInterceptors, s.interceptors.unary[BeforeRateLimit]...)
+	unaryInterceptors = append(unaryInterceptors, ratelimit.UnaryServerInterceptor(limiter))
+	unaryInterceptors = append(unaryInterceptors, s.interceptors.unary[BeforeAuthn]...)
 
 	streamingInterceptors := []grpc.StreamServerInterceptor{
+		s.errorCatalog.StreamServerInterceptor(),
 		grpcValidator.StreamServerInterceptor(),
+		limits.StreamServerInterceptor(),
 		grpcRecovery.StreamServerInterceptor(),
-		ratelimit.StreamServerInterceptor(limiter),
 	}
+	streamingInterceptors = append(streamingInterceptors, s.interceptors.stream[BeforeRateLimit]...)
+	streamingInterceptors = append(streamingInterceptors, ratelimit.StreamServerInterceptor(limiter))
+	streamingInterceptors = append(streamingInterceptors, s.interceptors.stream[BeforeAuthn]...)
 
-	// Configure authentication based on the provided method ("preshared" or "oidc").
-	// Add the appropriate interceptors to the unary and streaming interceptors.
+	// Configure authentication with the provider of the configured method, built-in or registered.
+	// Add the interceptors of the provider to the unary and streaming interceptors.
 	if authentication != nil && authentication.Enabled {
-		switch authentication.Method {
-		case "preshared":
-			var authenticator *preshared.KeyAuthn
-			authenticator, err = preshared.NewKeyAuthn(ctx, authentication.Preshared)
-			if err != nil {
-				return err
-			}
-			unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.KeyAuthFunc(authenticator)))
-			streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.KeyAuthFunc(authenticator)))
-		case "oidc":
-			var authenticator *oidc.Authn
-			authenticator, err = oidc.NewOidcAuthn(ctx, authentication.Oidc)
-			if err != nil {
-				return err
-			}
-			unaryInterceptors = append(unaryInterceptors, oidc.UnaryServerInterceptor(authenticator))
-			streamingInterceptors = append(streamingInterceptors, oidc.StreamServerInterceptor(authenticator))
-		default:
-			return fmt.Errorf("unknown authentication method: '%s'", authentication.Method)
+		provider, err := s.newAuthnProvider(ctx, authentication)
+		if err != nil {
+			return nil, err
 		}
+		unaryInterceptors = append(unaryInterceptors, authn.UnaryServerInterceptor(provider))
+		streamingInterceptors = append(streamingInterceptors, authn.StreamServerInterceptor(provider))
 	}
 
-	opts := []grpc.ServerOption{
+	unaryInterceptors = append(unaryInterceptors, s.interceptors.unary[AfterAuthn]...)
+	streamingInterceptors = append(streamingInterceptors, s.interceptors.stream[AfterAuthn]...)
+
+	return []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(streamingInterceptors...),
+	}, nil
+}
+
+// InvokeServerOptions creates the interceptor chain of the invoke server, separate from the one of the client
+// traffic: the dispatches belong to client requests that were already rate limited and validated, so they are not
+// rate limited, and are validated again only if enabled. The peers are authenticated with the shared secret of the
+// cluster if it has one, and with the provider of the configured method otherwise. The custom interceptors of the
+// container run at their stages, and the message size limit of the dispatches applies.
+func (s *Container) InvokeServerOptions(ctx context.Context, dst *config.Distributed, authentication *config.Authn) ([]grpc.ServerOption, error) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{s.errorCatalog.UnaryServerInterceptor()}
+	streamingInterceptors := []grpc.StreamServerInterceptor{s.errorCatalog.StreamServerInterceptor()}
+	if dst.Invoke.Validation {
+		unaryInterceptors = append(unaryInterceptors, grpcValidator.UnaryServerInterceptor())
+		streamingInterceptors = append(streamingInterceptors, grpcValidator.StreamServerInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, grpcRecovery.UnaryServerInterceptor())
+	streamingInterceptors = append(streamingInterceptors, grpcRecovery.StreamServerInterceptor())
+	for _, stage := range []InterceptorStage{BeforeRateLimit, BeforeAuthn} {
+		unaryInterceptors = append(unaryInterceptors, s.interceptors.unary[stage]...)
+		streamingInterceptors = append(streamingInterceptors, s.interceptors.stream[stage]...)
 	}
 
-	if srv.GRPC.TLSConfig.Enabled {
-		var c credentials.TransportCredentials
-		c, err = credentials.NewServerTLSFromFile(srv.GRPC.TLSConfig.CertPath, srv.GRPC.TLSConfig.KeyPath)
+	var provider authn.Provider
+	if dst.SharedSecret != "" {
+		provider = peer.NewProvider(dst.SharedSecret)
+	} else if authentication != nil && authentication.Enabled {
+		var err error
+		provider, err = s.newAuthnProvider(ctx, authentication)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		opts = append(opts, grpc.Creds(c))
+	}
+	if provider != nil {
+		unaryInterceptors = append(unaryInterceptors, authn.UnaryServerInterceptor(provider))
+		streamingInterceptors = append(streamingInterceptors, authn.StreamServerInterceptor(provider))
 	}
 
-	// Create a new gRPC server instance with the provided options.
-	grpcServer := grpc.NewServer(opts...)
+	unaryInterceptors = append(unaryInterceptors, s.interceptors.unary[AfterAuthn]...)
+	streamingInterceptors = append(streamingInterceptors, s.interceptors.stream[AfterAuthn]...)
 
-	// Register various gRPC services to the server.
-	grpcV1.RegisterPermissionServer(grpcServer, NewPermissionServer(s.Invoker))
-	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SW, s.SR))
-	grpcV1.RegisterDataServer(grpcServer, NewDataServer(s.DR, s.DW, s.SR))
-	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TR, s.TW))
-	grpcV1.RegisterWatchServer(grpcServer, NewWatchServer(s.W, s.DR))
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamingInterceptors...),
+	}
+	if dst.Invoke.MaxMessageSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(dst.Invoke.MaxMessageSize), grpc.MaxSendMsgSize(dst.Invoke.MaxMessageSize))
+	}
+	// The peers ping their idle connections to keep them warm, which the server would otherwise take for abuse
+	if dst.Peers.KeepaliveTime > 0 {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             dst.Peers.KeepaliveTime,
+			PermitWithoutStream: true,
+		}))
+	}
+	return opts, nil
+}
 
-	// Register health check and reflection services for gRPC.
-	health.RegisterHealthServer(grpcServer, NewHealthServer())
-	reflection.Register(grpcServer)
+// BuildGRPCServer creates the public gRPC server of the services, with the server options and the TLS
+// configuration of the server.
+func (s *Container) BuildGRPCServer(srv *config.Server, authentication *config.Authn, opts []grpc.ServerOption) (*GRPCServer, error) {
+	creds, err := transportOptions(srv, authentication, s.certificates)
+	if err != nil {
+		return nil, err
+	}
 
-	// Create another gRPC server, presumably for invoking permissions.
-	invokeServer := grpc.NewServer(opts...)
-	grpcV1.RegisterPermissionServer(invokeServer, NewPermissionServer(localInvoker))
+	// Create a new gRPC server instance with the provided options.
+	grpcServer := grpc.NewServer(append(creds, opts...)...)
 
-	// Register health check and reflection services for the invokeServer.
-	health.RegisterHealthServer(invokeServer, NewHealthServer())
-	reflection.Register(invokeServer)
-
-	// If profiling is enabled, set up the profiler using the net/http package.
-	if profiler.Enabled {
-		// Create a new HTTP ServeMux to register pprof routes.
-		mux := http.NewServeMux()
-		mux.HandleFunc("/debug/pprof/", pprof.Index)
-		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
-		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
-		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
-		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
-
-		// Run the profiler server in a separate goroutine.
-		go func() {
-			// Log a message indicating the profiler server's start status and port.
-			slog.Info(fmt.Sprintf("🚀 profiler server successfully started: %s", profiler.Port))
-
-			// Define the HTTP server with timeouts and the mux handler for pprof routes.
-			pprofserver := &http.Server{
-				Addr:         ":" + profiler.Port,
-				Handler:      mux,
-				ReadTimeout:  20 * time.Second,
-				WriteTimeout: 20 * time.Second,
-				IdleTimeout:  15 * time.Second,
-			}
+	// Register various gRPC services to the server.
+	s.registerServices(grpcServer)
 
-			// Start the profiler server.
-			if err := pprofserver.ListenAndServe(); err != nil {
-				// Check if the error was due to the server being closed, and log it.
-				if errors.Is(err, http.ErrServerClosed) {
-					slog.Error("failed to start profiler", err)
-				}
-			}
-		}()
+	// Register reflection service for gRPC, unless it is disabled.
+	if srv.GRPC.Reflection {
+		reflection.Register(grpcServer)
 	}
 
-	var lis net.Listener
-	lis, err = net.Listen("tcp", ":"+srv.GRPC.Port)
-	if err != nil {
-		return err
-	}
+	return NewGRPCServer("grpc server", srv.GRPC, grpcServer), nil
+}
 
-	var invokeLis net.Listener
-	invokeLis, err = net.Listen("tcp", ":"+dst.Port)
+// NewInvokeServer creates the gRPC server the distributed check engine sends the checks of the local node to. It
+// uses mutual TLS when the distributed TLS is enabled, and the TLS configuration of the public server otherwise.
+func NewInvokeServer(srv *config.Server, dst *config.Distributed, authentication *config.Authn, localInvoker invoke.Invoker, opts []grpc.ServerOption) (*GRPCServer, error) {
+	var creds []grpc.ServerOption
+	var err error
+	if dst.TLS.Enabled {
+		creds, err = peerTransportOptions(dst)
+	} else {
+		creds, err = transportOptions(srv, authentication, nil)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Start the gRPC server.
-	go func() {
-		if err := grpcServer.Serve(lis); err != nil {
-			slog.Error("failed to start grpc server", err)
-		}
-	}()
+	// Create another gRPC server for invoking permissions.
+	invokeServer := grpc.NewServer(append(creds, opts...)...)
+	grpcV1.RegisterPermissionServer(invokeServer, NewPermissionServer(localInvoker, nil, nil))
 
-	go func() {
-		if err := invokeServer.Serve(invokeLis); err != nil {
-			slog.Error("failed to start invoke grpc server", err)
-		}
-	}()
+	// Register health check and reflection services for the invokeServer, the latter unless it is disabled.
+	health.RegisterHealthServer(invokeServer, NewHealthServer())
+	if srv.GRPC.Reflection {
+		reflection.Register(invokeServer)
+	}
 
-	slog.Info(fmt.Sprintf("🚀 grpc server successfully started: %s", srv.GRPC.Port))
-	slog.Info(fmt.Sprintf("🚀 invoker grpc server successfully started: %s", dst.Port))
+	return NewGRPCServer("invoker grpc server", invokeListeners(dst), invokeServer), nil
+}
 
-	var httpServer *http.Server
+// BuildGatewayServer creates the HTTP server of the services. The HTTP handlers reach the services through a
+// gRPC server of their own, listening in memory. It runs the same interceptors as the public one but without
+// transport security, so there is no network hop.
+func (s *Container) BuildGatewayServer(srv *config.Server, opts []grpc.ServerOption) *GatewayServer {
+	backend := grpc.NewServer(opts...)
+	s.registerServices(backend)
+	gateway := NewGatewayServer(srv, backend)
+	gateway.certificates = s.certificates
+
+	s.mu.Lock()
+	s.gateway = gateway
+	s.mu.Unlock()
+	return gateway
+}
 
-	// Start the optional HTTP server with CORS and optional TLS configurations.
-	// Connect to the gRPC server and register the HTTP handlers for each service.
-	if srv.HTTP.Enabled {
-		options := []grpc.DialOption{
-			grpc.WithBlock(),
-			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
-		}
-		if srv.GRPC.TLSConfig.Enabled {
-			c, err := credentials.NewClientTLSFromFile(srv.GRPC.TLSConfig.CertPath, "")
-			if err != nil {
-				return err
-			}
-			options = append(options, grpc.WithTransportCredentials(c))
+// Reload reloads the preshared keys of the authentication and the CORS policies of the HTTP server with the next
+// configuration. It is the config.ReloadFunc of the servers. Changes of the authentication method, or enabling or
+// disabling it, apply after a restart only.
+func (s *Container) Reload(previous, next *config.Config) (func(), error) {
+	s.mu.Lock()
+	keyAuthns := append([]*preshared.KeyAuthn{}, s.keyAuthns...)
+	gateway := s.gateway
+	s.mu.Unlock()
+
+	var applies []func()
+	if len(keyAuthns) > 0 {
+		if next.Authn.Enabled != previous.Authn.Enabled || next.Authn.Method != previous.Authn.Method {
+			slog.Warn("changes of the authentication method apply after a restart")
 		} else {
-			options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if _, err := preshared.NewKeyAuthn(context.Background(), next.Authn.Preshared); err != nil {
+				return nil, fmt.Errorf("invalid authn.preshared.keys: %w", err)
+			}
+			applies = append(applies, func() {
+				for _, a := range keyAuthns {
+					_ = a.SetKeys(next.Authn.Preshared.Keys)
+				}
+			})
 		}
-
-		timeoutCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
-		defer cancel()
-
-		conn, err := grpc.DialContext(timeoutCtx, ":"+srv.GRPC.Port, options...)
+	}
+	if gateway != nil {
+		apply, err := gateway.ReloadCORS(next.Server.HTTP)
 		if err != nil {
-			return err
-		}
-		defer func() {
-			if err = conn.Close(); err != nil {
-				slog.Error("Failed to close gRPC connection: %v", err)
-			}
-		}()
-
-		healthClient := health.NewHealthClient(conn)
-		muxOpts := []runtime.ServeMuxOption{
-			runtime.WithHealthzEndpoint(healthClient),
-			runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
-				Marshaler: &runtime.JSONPb{
-					MarshalOptions: protojson.MarshalOptions{
-						UseProtoNames:   true,
-						EmitUnpopulated: true,
-					},
-					UnmarshalOptions: protojson.UnmarshalOptions{
-						DiscardUnknown: true,
-					},
-				},
-			}),
+			return nil, fmt.Errorf("invalid cors policies: %w", err)
 		}
+		applies = append(applies, apply)
+	}
 
-		mux := runtime.NewServeMux(muxOpts...)
-
-		if err = grpcV1.RegisterPermissionHandler(ctx, mux, conn); err != nil {
-			return err
-		}
-		if err = grpcV1.RegisterSchemaHandler(ctx, mux, conn); err != nil {
-			return err
-		}
-		if err = grpcV1.RegisterDataHandler(ctx, mux, conn); err != nil {
-			return err
-		}
-		if err = grpcV1.RegisterTenancyHandler(ctx, mux, conn); err != nil {
-			return err
+	return func() {
+		for _, apply := range applies {
+			apply()
 		}
+	}, nil
+}
 
-		httpServer = &http.Server{
-			Addr: ":" + srv.HTTP.Port,
-			Handler: cors.New(cors.Options{
-				AllowCredentials: true,
-				AllowedOrigins:   srv.HTTP.CORSAllowedOrigins,
-				AllowedHeaders:   srv.HTTP.CORSAllowedHeaders,
-				AllowedMethods: []string{
-					http.MethodGet, http.MethodPost,
-					http.MethodHead, http.MethodPatch, http.MethodDelete, http.MethodPut,
-				},
-			}).Handler(mux),
-			ReadHeaderTimeout: 5 * time.Second,
+// transportOptions returns the credentials of the gRPC servers if TLS is enabled, with the certificates of the
+// manager if not nil.
+func transportOptions(srv *config.Server, authentication *config.Authn, certificates *autocert.Manager) ([]grpc.ServerOption, error) {
+	var tlsConfig *tls.Config
+	switch {
+	case certificates != nil:
+		tlsConfig = certificates.TLSConfig()
+	case srv.GRPC.TLSConfig.Enabled:
+		cert, err := tls.LoadX509KeyPair(srv.GRPC.TLSConfig.CertPath, srv.GRPC.TLSConfig.KeyPath)
+		if err != nil {
+			return nil, err
 		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	default:
+		return nil, nil
+	}
+	// X.509-SVIDs are verified against the SPIFFE bundle by the provider, so client certificates are only
+	// requested here.
+	if authentication != nil && authentication.Enabled && authentication.Method == "spiffe" {
+		tlsConfig.ClientAuth = tls.RequestClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
 
-		// Start the HTTP server with TLS if enabled, otherwise without TLS.
-		go func() {
-			var err error
-			if srv.HTTP.TLSConfig.Enabled {
-				err = httpServer.ListenAndServeTLS(srv.HTTP.TLSConfig.CertPath, srv.HTTP.TLSConfig.KeyPath)
-			} else {
-				err = httpServer.ListenAndServe()
-			}
-			if !errors.Is(err, http.ErrServerClosed) {
-				slog.Error(err.Error())
-			}
-		}()
+// peerTransportOptions returns the mutual TLS credentials of the invoke server, requiring the peers to present a
+// certificate issued by the CA of the cluster.
+func peerTransportOptions(dst *config.Distributed) ([]grpc.ServerOption, error) {
+	cert, err := tls.LoadX509KeyPair(dst.TLS.CertPath, dst.TLS.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := certPool(dst.TLS.CAPath)
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}))}, nil
+}
+
+// certPool returns the pool of the certificates of the PEM file.
+func certPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+	return pool, nil
+}
+
+// registerServices registers the API services along with the health check service to the gRPC server.
+func (s *Container) registerServices(server *grpc.Server) {
+	permissionServer := NewPermissionServer(s.Invoker, s.SR, s.meter)
+	permissionServer.checkTimeout = s.checkTimeout
+	permissionServer.decision = s.decision
+	grpcV1.RegisterPermissionServer(server, permissionServer)
+	grpcV1.RegisterSchemaServer(server, NewSchemaServer(s.SW, s.SR, s.DR, s.DW, s.bundleKeys, s.deduplicateSchemas, s.TR, s.schemaBase))
+	grpcV1.RegisterDataServer(server, NewDataServer(s.DR, s.DW, s.SR, s.data))
+	grpcV1.RegisterTenancyServer(server, NewTenancyServer(s.TR, s.TW))
+	grpcV1.RegisterWatchServer(server, NewWatchServer(s.W, s.DR, s.watch))
+	if s.extAuthz != nil {
+		authv3.RegisterAuthorizationServer(server, NewExtAuthzServer(permissionServer, s.extAuthz))
+	}
+	if s.database != nil {
+		grpcV1.RegisterAdminServer(server, NewAdminServer(*s.database, s.regions, s.errorCatalog, s.ring, s.tunables, s))
+	}
+	health.RegisterHealthServer(server, s.health)
+}
 
-		slog.Info(fmt.Sprintf("🚀 http server successfully started: %s", srv.HTTP.Port))
+// newHTTPServer creates the HTTP server translating REST requests to calls of the gRPC services reachable
+// through the connection, along with the handler of its CORS policies.
+func newHTTPServer(ctx context.Context, srv *config.Server, conn *grpc.ClientConn) (*http.Server, *corsHandler, error) {
+	healthClient := health.NewHealthClient(conn)
+	muxOpts := []runtime.ServeMuxOption{
+		runtime.WithHealthzEndpoint(healthClient),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
 	}
 
-	// Wait for the context to be canceled (e.g., due to a signal).
-	<-ctx.Done()
+	mux := runtime.NewServeMux(muxOpts...)
 
-	// Shutdown the servers gracefully.
-	ctxShutdown, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+	if err := grpcV1.RegisterPermissionHandler(ctx, mux, conn); err != nil {
+		return nil, nil, err
+	}
+	if err := grpcV1.RegisterSchemaHandler(ctx, mux, conn); err != nil {
+		return nil, nil, err
+	}
+	if err := grpcV1.RegisterDataHandler(ctx, mux, conn); err != nil {
+		return nil, nil, err
+	}
+	if err := grpcV1.RegisterTenancyHandler(ctx, mux, conn); err != nil {
+		return nil, nil, err
+	}
+	if err := grpcV1.RegisterAdminHandler(ctx, mux, conn); err != nil {
+		return nil, nil, err
+	}
 
-	if httpServer != nil {
-		if err := httpServer.Shutdown(ctxShutdown); err != nil {
-			slog.Error(err.Error())
-			return err
+	var handler http.Handler = mux
+	var err error
+	if srv.HTTP.OpenAPIEnabled {
+		handler, err = newOpenAPIHandler(mux, srv.HTTP.TLSConfig.Enabled || srv.Autocert.Enabled)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 
-	// Gracefully stop the gRPC server.
-	grpcServer.GracefulStop()
-	invokeServer.GracefulStop()
+	cors, err := newCORSHandler(srv.HTTP, handler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &http.Server{
+		Addr:              net.JoinHostPort(srv.HTTP.Address, srv.HTTP.Port),
+		Handler:           cors,
+		ReadTimeout:       srv.HTTP.ReadTimeout,
+		ReadHeaderTimeout: srv.HTTP.ReadHeaderTimeout,
+		WriteTimeout:      srv.HTTP.WriteTimeout,
+		IdleTimeout:       srv.HTTP.IdleTimeout,
+		MaxHeaderBytes:    srv.HTTP.MaxHeaderBytes,
+	}, cors, nil
+}
 
-	slog.Info("gracefully shutting down")
+// serveHTTP serves the HTTP server on the listener in a separate goroutine, with TLS if enabled, otherwise
+// without TLS. The error the server fails with is reported to fail.
+func serveHTTP(httpServer *http.Server, lis net.Listener, tlsConfig config.TLSConfig, fail func(error)) {
+	go func() {
+		var err error
+		if tlsConfig.Enabled {
+			err = httpServer.ServeTLS(lis, tlsConfig.CertPath, tlsConfig.KeyPath)
+		} else {
+			err = httpServer.Serve(lis)
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			fail(err)
+		}
+	}()
+}
 
-	return nil
+// newAuthnProvider creates the authentication provider of the configured method. Methods other than the
+// built-in preshared, oidc and spiffe ones are looked up in the public authn registry. The authenticators of the
+// preshared keys are kept, for their keys to be reloaded with the configuration.
+func (s *Container) newAuthnProvider(ctx context.Context, authentication *config.Authn) (authn.Provider, error) {
+	switch authentication.Method {
+	case "preshared":
+		authenticator, err := preshared.NewKeyAuthn(ctx, authentication.Preshared)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		s.keyAuthns = append(s.keyAuthns, authenticator)
+		s.mu.Unlock()
+		return preshared.NewProvider(authenticator), nil
+	case "oidc":
+		authenticator, err := oidc.NewOidcAuthn(ctx, authentication.Oidc)
+		if err != nil {
+			return nil, err
+		}
+		return oidc.NewProvider(authenticator), nil
+	case "spiffe":
+		authenticator, err := spiffe.NewSpiffeAuthn(ctx, authentication.Spiffe)
+		if err != nil {
+			return nil, err
+		}
+		return spiffe.NewProvider(authenticator), nil
+	default:
+		factory, ok := authn.Lookup(authentication.Method)
+		if !ok {
+			return nil, fmt.Errorf("unknown authentication method: '%s'", authentication.Method)
+		}
+		settings, _ := authentication.Providers[authentication.Method].(map[string]interface{})
+		return factory(ctx, settings)
+	}
 }
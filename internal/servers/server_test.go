@@ -0,0 +1,138 @@
+package servers
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Permify/permify/internal/authn/peer"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/invoke"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// allowingInvoker is an invoker allowing every check.
+type allowingInvoker struct {
+	invoke.Invoker
+}
+
+func (allowingInvoker) Check(context.Context, *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error) {
+	return &v1.PermissionCheckResponse{Can: v1.CheckResult_CHECK_RESULT_ALLOWED, Metadata: &v1.PermissionCheckResponseMetadata{}}, nil
+}
+
+// rejectingLimiter is a rate limiter rejecting every request.
+type rejectingLimiter struct{}
+
+func (rejectingLimiter) Limit(context.Context) error {
+	return status.Error(codes.ResourceExhausted, "rate limited")
+}
+
+// newInvokeClient serves the permission service of the invoke server, built with the options of the container for
+// the distributed config, in memory and returns a client of it.
+func newInvokeClient(t *testing.T, container *Container, dst *config.Distributed, opts ...grpc.DialOption) v1.PermissionClient {
+	t.Helper()
+	serverOpts, err := container.InvokeServerOptions(context.Background(), dst, nil)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(serverOpts...)
+	v1.RegisterPermissionServer(srv, NewPermissionServer(allowingInvoker{}, nil, nil))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }
+	opts = append([]grpc.DialOption{grpc.WithContextDialer(dialer), grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.Dial("bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return v1.NewPermissionClient(conn)
+}
+
+func TestInvokeServerOptions(t *testing.T) {
+	ctx := context.Background()
+	request := func() *v1.PermissionCheckRequest {
+		return &v1.PermissionCheckRequest{
+			TenantId:   "t1",
+			Metadata:   &v1.PermissionCheckRequestMetadata{SchemaVersion: "v1", SnapToken: "s1", Depth: 20},
+			Entity:     &v1.Entity{Type: "document", Id: "1"},
+			Permission: "view",
+			Subject:    &v1.Subject{Type: "user", Id: "1"},
+		}
+	}
+
+	t.Run("dispatches are not rate limited", func(t *testing.T) {
+		client := newInvokeClient(t, NewContainer(nil, nil, nil, nil, nil, nil, nil, nil, WithRateLimiter(rejectingLimiter{})), &config.Distributed{})
+		for i := 0; i < 3; i++ {
+			response, err := client.Check(ctx, request())
+			require.NoError(t, err)
+			assert.Equal(t, v1.CheckResult_CHECK_RESULT_ALLOWED, response.GetCan())
+		}
+	})
+
+	t.Run("requests are validated only when enabled", func(t *testing.T) {
+		for _, validation := range []bool{false, true} {
+			// The interceptors after the authentication are reached only by the requests the validator let through
+			var reached atomic.Int32
+			container := NewContainer(nil, nil, nil, nil, nil, nil, nil, nil, WithUnaryInterceptors(AfterAuthn,
+				func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+					reached.Add(1)
+					return handler(ctx, req)
+				}))
+			client := newInvokeClient(t, container, &config.Distributed{Invoke: config.DistributedInvoke{Validation: validation}})
+
+			invalid := request()
+			invalid.Permission = "view!"
+			_, err := client.Check(ctx, invalid)
+			require.Error(t, err)
+			if validation {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Zero(t, reached.Load())
+			} else {
+				assert.Equal(t, int32(1), reached.Load())
+			}
+		}
+	})
+
+	t.Run("messages are limited to the max message size", func(t *testing.T) {
+		client := newInvokeClient(t, NewContainer(nil, nil, nil, nil, nil, nil, nil, nil), &config.Distributed{
+			Invoke: config.DistributedInvoke{MaxMessageSize: 1 << 10},
+		})
+
+		_, err := client.Check(ctx, request())
+		require.NoError(t, err)
+
+		large := request()
+		large.Context = &v1.Context{Tuples: []*v1.Tuple{{
+			Entity:   &v1.Entity{Type: "document", Id: strings.Repeat("1", 2<<10)},
+			Relation: "viewer",
+			Subject:  &v1.Subject{Type: "user", Id: "1"},
+		}}}
+		_, err = client.Check(ctx, large)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("dispatches are authenticated with the shared secret", func(t *testing.T) {
+		dst := &config.Distributed{SharedSecret: "secret"}
+		container := NewContainer(nil, nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := newInvokeClient(t, container, dst).Check(ctx, request())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = newInvokeClient(t, container, dst, grpc.WithPerRPCCredentials(peer.NewCredentials("other", false))).Check(ctx, request())
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		response, err := newInvokeClient(t, container, dst, grpc.WithPerRPCCredentials(peer.NewCredentials("secret", false))).Check(ctx, request())
+		require.NoError(t, err)
+		assert.Equal(t, v1.CheckResult_CHECK_RESULT_ALLOWED, response.GetCan())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/decorators"
 	"github.com/Permify/permify/pkg/database"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -33,6 +34,9 @@ func (t *TenancyServer) Create(ctx context.Context, request *v1.TenantCreateRequ
 	ctx, span := tracer.Start(ctx, "tenant.create")
 	defer span.End()
 
+	// Create the tenant in the database region requested, if any
+	ctx = decorators.WithTenantDatabase(ctx, request.GetDatabase())
+
 	tenant, err := t.tw.CreateTenant(ctx, request.GetId(), request.GetName())
 	if err != nil {
 		span.RecordError(err)
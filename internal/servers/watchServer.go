@@ -1,30 +1,65 @@
 package servers
 
 import (
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/storage"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
+const (
+	// SlowConsumerDisconnect - Ends the streams of which the send buffer is full
+	SlowConsumerDisconnect = "disconnect"
+	// SlowConsumerDrop - Drops the changes the send buffer of a stream has no room for, and tells the client how
+	// many were dropped along with the next message
+	SlowConsumerDrop = "drop"
+)
+
+// _defaultWatchBufferSize is the number of changes a stream buffers when the configuration doesn't set it.
+const _defaultWatchBufferSize = 100
+
 type WatchServer struct {
 	v1.UnimplementedWatchServer
 
 	dr storage.DataReader
 	w  storage.Watcher
+
+	// keepalive is the interval of the keepalive messages sent while there are no changes, none if zero
+	keepalive time.Duration
+	// bufferSize is the number of changes buffered by each stream before the slow consumer policy applies
+	bufferSize int
+	// dropSlowConsumers is whether changes are dropped rather than streams ended when their buffer is full
+	dropSlowConsumers bool
 }
 
 func NewWatchServer(
 	w storage.Watcher,
 	dr storage.DataReader,
+	cfg config.Watch,
 ) *WatchServer {
+	bufferSize := cfg.BufferSize
+	if bufferSize < 1 {
+		bufferSize = _defaultWatchBufferSize
+	}
+
 	return &WatchServer{
-		w:  w,
-		dr: dr,
+		w:                 w,
+		dr:                dr,
+		keepalive:         cfg.KeepaliveInterval,
+		bufferSize:        bufferSize,
+		dropSlowConsumers: cfg.SlowConsumerPolicy == SlowConsumerDrop,
 	}
 }
 
 // Watch function sets up a stream for the client to receive changes.
+// Changes are read from the storage as they come and buffered for the stream, so a client that doesn't keep up
+// neither blocks the reads nor holds more than the buffer. Once the buffer is full, the stream is ended or the
+// changes are dropped, by the slow consumer policy.
 func (r *WatchServer) Watch(request *v1.WatchRequest, server v1.Watch_WatchServer) error {
 	// Start a new context and span for tracing.
 	ctx, span := tracer.Start(server.Context(), "watch.watch")
@@ -51,26 +86,107 @@ func (r *WatchServer) Watch(request *v1.WatchRequest, server v1.Watch_WatchServe
 	// Call the Watch function on the watcher, which returns two channels.
 	changes, errs := r.w.Watch(ctx, request.GetTenantId(), snap)
 
-	// Create a separate goroutine to handle sending changes to the server.
+	// Create a separate goroutine to handle sending the buffered messages to the client, along with the
+	// keepalive messages.
+	buffer := make(chan *v1.WatchResponse, r.bufferSize)
+	sendErr := make(chan error, 1)
+	sent := make(chan struct{})
 	go func() {
-		for change := range changes {
-			// For each change, send it to the client.
-			if err := server.Send(&v1.WatchResponse{Changes: change}); err != nil {
-				// If an error occurs while sending, exit the goroutine.
-				// The error is not handled here because the context will be cancelled if an error is detected.
-				return
+		defer close(sent)
+		r.send(server, buffer, sendErr)
+	}()
+
+	// dropped is the number of changes dropped since the last message buffered
+	var dropped uint32
+
+	// Main loop for handling changes and errors.
+	for {
+		// Buffer a message telling the number of changes dropped as soon as there is room for it.
+		var marker chan<- *v1.WatchResponse
+		if dropped > 0 {
+			marker = buffer
+		}
+
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				// The changes channel is closed along with the errs channel, which ends the loop.
+				changes = nil
+				continue
+			}
+			select {
+			case buffer <- &v1.WatchResponse{Changes: change, Dropped: dropped}:
+				dropped = 0
+			default:
+				if !r.dropSlowConsumers {
+					slog.Warn("watch client doesn't keep up with the changes, ending the stream", slog.String("tenant_id", request.GetTenantId()))
+					return status.Error(codes.ResourceExhausted, "watch client doesn't keep up with the changes")
+				}
+				dropped++
+			}
+		case marker <- &v1.WatchResponse{Dropped: dropped}:
+			dropped = 0
+		case err := <-sendErr:
+			// The context of the stream is cancelled once sending fails, so the watcher stops as well.
+			return err
+		case err, ok := <-errs:
+			if ok {
+				// If an error occurs, convert it to a status error and return it.
+				return status.Errorf(GetStatus(err), err.Error())
+			}
+			// At this point, the errs channel has been closed, indicating that no more changes will be coming in.
+			// Therefore, the buffered messages are sent along with the number of changes dropped last, and it's
+			// safe to return nil indicating that the operation was successful.
+			if dropped > 0 {
+				select {
+				case buffer <- &v1.WatchResponse{Dropped: dropped}:
+				case err := <-sendErr:
+					return err
+				}
+			}
+			close(buffer)
+			<-sent
+			select {
+			case err := <-sendErr:
+				return err
+			default:
+				return nil
 			}
 		}
-	}()
+	}
+}
 
-	// Main loop for handling errors.
-	for err := range errs {
-		// If an error occurs, convert it to a status error and return it.
-		// This ends the Watch function, which in turn closes the changes channel and ends the above goroutine.
-		return status.Errorf(GetStatus(err), err.Error())
+// send sends the messages of the buffer to the client until it's closed or sending fails, and a keepalive
+// message when nothing was sent for the keepalive interval.
+func (r *WatchServer) send(server v1.Watch_WatchServer, buffer <-chan *v1.WatchResponse, errs chan<- error) {
+	var ticker *time.Ticker
+	var keepalive <-chan time.Time
+	if r.keepalive > 0 {
+		ticker = time.NewTicker(r.keepalive)
+		defer ticker.Stop()
+		keepalive = ticker.C
 	}
 
-	// At this point, the errs channel has been closed, indicating that no more errors will be coming in.
-	// Therefore, it's safe to return nil indicating that the operation was successful.
-	return nil
+	for {
+		var res *v1.WatchResponse
+		select {
+		case m, ok := <-buffer:
+			if !ok {
+				return
+			}
+			res = m
+		case <-keepalive:
+			res = &v1.WatchResponse{Keepalive: true}
+		case <-server.Context().Done():
+			return
+		}
+
+		if err := server.Send(res); err != nil {
+			errs <- err
+			return
+		}
+		if ticker != nil {
+			ticker.Reset(r.keepalive)
+		}
+	}
 }
@@ -0,0 +1,149 @@
+package servers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/config"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// fakeWatcher returns the channels of the test as the changes of any tenant.
+type fakeWatcher struct {
+	changes chan *v1.DataChanges
+	errs    chan error
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{changes: make(chan *v1.DataChanges), errs: make(chan error)}
+}
+
+func (w *fakeWatcher) Watch(context.Context, string, string) (<-chan *v1.DataChanges, <-chan error) {
+	return w.changes, w.errs
+}
+
+// close ends the watch like the storage watchers do.
+func (w *fakeWatcher) close() {
+	close(w.changes)
+	close(w.errs)
+}
+
+// fakeWatchStream passes the messages sent to the test, once it lets them through.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sending chan struct{}
+	gate    chan struct{}
+	sent    chan *v1.WatchResponse
+}
+
+func newFakeWatchStream(ctx context.Context) *fakeWatchStream {
+	return &fakeWatchStream{
+		ctx:     ctx,
+		sending: make(chan struct{}, 100),
+		gate:    make(chan struct{}),
+		sent:    make(chan *v1.WatchResponse, 100),
+	}
+}
+
+func (s *fakeWatchStream) Context() context.Context { return s.ctx }
+
+func (s *fakeWatchStream) Send(res *v1.WatchResponse) error {
+	s.sending <- struct{}{}
+	select {
+	case <-s.gate:
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+	s.sent <- res
+	return nil
+}
+
+func watch(server *WatchServer, stream *fakeWatchStream) chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- server.Watch(&v1.WatchRequest{TenantId: "t1", SnapToken: "snap"}, stream)
+	}()
+	return done
+}
+
+func TestWatchServer_DisconnectsSlowConsumers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := newFakeWatcher()
+	stream := newFakeWatchStream(ctx)
+	done := watch(NewWatchServer(watcher, nil, config.Watch{BufferSize: 1, SlowConsumerPolicy: SlowConsumerDisconnect}), stream)
+
+	// At most the first change is held by the send and the next one buffered, so a later one has no room.
+	for {
+		select {
+		case watcher.changes <- &v1.DataChanges{}:
+			continue
+		case err := <-done:
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		}
+		break
+	}
+}
+
+func TestWatchServer_DropsForSlowConsumers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := newFakeWatcher()
+	stream := newFakeWatchStream(ctx)
+	done := watch(NewWatchServer(watcher, nil, config.Watch{BufferSize: 1, SlowConsumerPolicy: SlowConsumerDrop}), stream)
+
+	// The first change is held by the send, the second one buffered and the third one dropped. The next ones
+	// are dropped unless the client caught up by then.
+	watcher.changes <- &v1.DataChanges{SnapToken: "1"}
+	<-stream.sending
+	for _, token := range []string{"2", "3", "4"} {
+		watcher.changes <- &v1.DataChanges{SnapToken: token}
+	}
+	close(stream.gate)
+	watcher.changes <- &v1.DataChanges{SnapToken: "5"}
+	watcher.close()
+	assert.NoError(t, <-done)
+	close(stream.sent)
+
+	var tokens []string
+	var dropped uint32
+	for res := range stream.sent {
+		if res.GetChanges() != nil {
+			tokens = append(tokens, res.GetChanges().GetSnapToken())
+		}
+		dropped += res.GetDropped()
+	}
+	assert.Equal(t, []string{"1", "2"}, tokens[:2])
+	assert.GreaterOrEqual(t, dropped, uint32(1))
+	assert.Equal(t, 5, len(tokens)+int(dropped))
+}
+
+func TestWatchServer_SendsKeepalives(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := newFakeWatcher()
+	stream := newFakeWatchStream(ctx)
+	close(stream.gate)
+	done := watch(NewWatchServer(watcher, nil, config.Watch{KeepaliveInterval: 10 * time.Millisecond}), stream)
+
+	select {
+	case res := <-stream.sent:
+		assert.True(t, res.GetKeepalive())
+		assert.Nil(t, res.GetChanges())
+	case <-time.After(time.Second):
+		t.Fatal("no keepalive was sent")
+	}
+
+	watcher.close()
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/pkg/database"
+)
+
+const (
+	// postgresMinimumVersion is the oldest migration of the Postgres database the storage of this version runs
+	// against. It stays below the latest migration as long as the storage works without the migrations after it,
+	// so the version can be rolled out before the database is migrated.
+	postgresMinimumVersion int64 = 20261014120000
+	// postgresCompatibilityVersion is the migration creating the table of the oldest migration binaries must know
+	postgresCompatibilityVersion int64 = 20261015120000
+)
+
+// Preflight checks that the storage of this version runs against the database, refusing databases that aren't
+// migrated far enough for it, and databases migrated by newer versions in ways it doesn't support. Migrations only
+// expanding the schema keep the database compatible with the previous versions, so both versions run during
+// blue/green rollouts.
+func Preflight(ctx context.Context, conf config.Database) error {
+	if conf.Engine != database.POSTGRES.String() {
+		// Other engines create what they need if it is missing, and have no versioned migrations
+		return nil
+	}
+
+	return withPostgres(conf.URI, func(db *sql.DB) error {
+		current, err := goose.GetDBVersionContext(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		latest, err := latestPostgresMigration()
+		if err != nil {
+			return err
+		}
+
+		var minimum int64
+		if current >= postgresCompatibilityVersion {
+			err = db.QueryRowContext(ctx, "SELECT minimum_version FROM migration_compatibility").Scan(&minimum)
+			if err != nil {
+				return err
+			}
+		}
+
+		return checkCompatibility(current, minimum, postgresMinimumVersion, latest)
+	})
+}
+
+// checkCompatibility checks that a binary knowing the migrations up to latest, and needing at least the one of
+// required, runs against a database at the current migration, whose migrations need binaries knowing at least
+// the one of minimum.
+func checkCompatibility(current, minimum, required, latest int64) error {
+	if current < required {
+		return fmt.Errorf("database is at migration %d, this version of permify needs at least migration %d: migrate it with `permify migrate up` or database.auto_migrate", current, required)
+	}
+	if minimum > latest {
+		return fmt.Errorf("database is at migration %d, migrated by a newer version of permify that needs versions knowing at least migration %d, this version knows up to migration %d", current, minimum, latest)
+	}
+	return nil
+}
+
+// ServerInfo returns the version of the database server and the time of its clock, connecting to it. It returns an
+// empty version for the engines whose connectivity is only checked once the storage uses them.
+func ServerInfo(ctx context.Context, conf config.Database) (version string, now time.Time, err error) {
+	switch conf.Engine {
+	case database.POSTGRES.String():
+		err = withPostgres(conf.URI, func(db *sql.DB) error {
+			return db.QueryRowContext(ctx, "SELECT current_setting('server_version'), clock_timestamp()").Scan(&version, &now)
+		})
+		return version, now, err
+	case database.MEMORY.String():
+		return database.MEMORY.String(), time.Now(), nil
+	default:
+		return "", time.Time{}, nil
+	}
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCompatibility(t *testing.T) {
+	tests := []struct {
+		name                               string
+		current, minimum, required, latest int64
+		compatible                         bool
+	}{
+		{name: "same version", current: 3, minimum: 1, required: 3, latest: 3, compatible: true},
+		{name: "database behind the required migration", current: 2, minimum: 1, required: 3, latest: 3, compatible: false},
+		{name: "database behind within the window", current: 2, minimum: 1, required: 2, latest: 3, compatible: true},
+		{name: "database expanded by a newer version", current: 4, minimum: 1, required: 3, latest: 3, compatible: true},
+		{name: "database contracted by a newer version", current: 4, minimum: 4, required: 3, latest: 3, compatible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCompatibility(tt.current, tt.minimum, tt.required, tt.latest)
+			if tt.compatible {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package decorators
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReaderWithBatching - Batch the reads of the relationships of entities of the same type and relation. A check
+// fanning out to the subjects of a relation reads the relationships of each of them at once: the first read runs
+// alone, and the ones arriving while it runs are read together with a single query once it is done, so the fan out
+// takes two queries instead of one per subject, without delaying the reads that don't fan out.
+type DataReaderWithBatching struct {
+	delegate storage.DataReader
+	// maxBatchSize is the maximum number of entities read by a single query, the reads past it running alone
+	maxBatchSize int
+
+	mu sync.Mutex
+	// queues are the reads in flight, by the relationships they read
+	queues map[batchKey]*batchQueue
+}
+
+// batchKey - Relationships of entities read together
+type batchKey struct {
+	tenantID   string
+	entityType string
+	relation   string
+	snap       string
+}
+
+// batchQueue - Reads of the relationships waiting for the read in flight
+type batchQueue struct {
+	pending *relationshipBatch
+}
+
+// relationshipBatch - Reads of the relationships of entities run with a single query
+type relationshipBatch struct {
+	ctx  context.Context
+	ids  map[string]struct{}
+	done chan struct{}
+	// tuples are the relationships of the entities of the batch, by entity
+	tuples map[string][]*base.Tuple
+	err    error
+}
+
+// NewDataReaderWithBatching - Add batching of the reads of the relationships of entities to new data reader
+func NewDataReaderWithBatching(delegate storage.DataReader, maxBatchSize int) *DataReaderWithBatching {
+	return &DataReaderWithBatching{
+		delegate:     delegate,
+		maxBatchSize: maxBatchSize,
+		queues:       map[batchKey]*batchQueue{},
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository, with the reads of the relationships of entities in
+// flight for the same relation
+func (r *DataReaderWithBatching) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	// Only the reads of the relationships of entities, whatever their subjects, are batched
+	if filter.GetEntity().GetType() == "" || len(filter.GetEntity().GetIds()) == 0 || filter.GetRelation() == "" ||
+		filter.GetSubject().GetType() != "" || len(filter.GetSubject().GetIds()) > 0 || filter.GetSubject().GetRelation() != "" {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+	key := batchKey{tenantID: tenantID, entityType: filter.GetEntity().GetType(), relation: filter.GetRelation(), snap: snap}
+
+	r.mu.Lock()
+	queue, ok := r.queues[key]
+	if !ok {
+		r.queues[key] = &batchQueue{}
+		r.mu.Unlock()
+		it, err := r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+		r.next(key)
+		return it, err
+	}
+	batch := queue.pending
+	if batch == nil {
+		batch = &relationshipBatch{
+			ctx:  context.WithoutCancel(ctx),
+			ids:  map[string]struct{}{},
+			done: make(chan struct{}),
+		}
+		queue.pending = batch
+	}
+	if len(batch.ids)+len(filter.GetEntity().GetIds()) > r.maxBatchSize {
+		r.mu.Unlock()
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+	for _, id := range filter.GetEntity().GetIds() {
+		batch.ids[id] = struct{}{}
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	// The tuples are copied, as the decorators reading them may modify them
+	var tuples []*base.Tuple
+	for _, id := range filter.GetEntity().GetIds() {
+		for _, t := range batch.tuples[id] {
+			tuples = append(tuples, proto.Clone(t).(*base.Tuple))
+		}
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// next runs the reads waiting for the read of the relationships that just finished, all at once.
+func (r *DataReaderWithBatching) next(key batchKey) {
+	r.mu.Lock()
+	queue := r.queues[key]
+	batch := queue.pending
+	if batch == nil {
+		delete(r.queues, key)
+		r.mu.Unlock()
+		return
+	}
+	queue.pending = nil
+	r.mu.Unlock()
+
+	go func() {
+		ids := make([]string, 0, len(batch.ids))
+		for id := range batch.ids {
+			ids = append(ids, id)
+		}
+
+		var it *database.TupleIterator
+		it, batch.err = r.delegate.QueryRelationships(batch.ctx, key.tenantID, &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: key.entityType, Ids: ids},
+			Relation: key.relation,
+		}, key.snap)
+		if batch.err == nil {
+			batch.tuples = map[string][]*base.Tuple{}
+			for it.HasNext() {
+				t := it.GetNext()
+				batch.tuples[t.GetEntity().GetId()] = append(batch.tuples[t.GetEntity().GetId()], t)
+			}
+		}
+		close(batch.done)
+
+		r.next(key)
+	}()
+}
+
+// ReadRelationships - Reads relation tuples from the repository with pagination
+func (r *DataReaderWithBatching) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository
+func (r *DataReaderWithBatching) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	return r.delegate.QuerySingleAttribute(ctx, tenantID, filter, snap)
+}
+
+// QueryAttributes - Reads attributes from the repository
+func (r *DataReaderWithBatching) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*database.AttributeIterator, error) {
+	return r.delegate.QueryAttributes(ctx, tenantID, filter, snap)
+}
+
+// ReadAttributes - Reads attributes from the repository with pagination
+func (r *DataReaderWithBatching) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadAttributes(ctx, tenantID, filter, snap, pagination)
+}
+
+// QueryUniqueEntities - Reads unique entities from the repository
+func (r *DataReaderWithBatching) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	return r.delegate.QueryUniqueEntities(ctx, tenantID, name, snap, pagination)
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository
+func (r *DataReaderWithBatching) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	return r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, snap, pagination)
+}
+
+// ReadRelationshipHistory - Reads the changes of relation tuples from the repository
+func (r *DataReaderWithBatching) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, start, end, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository
+func (r *DataReaderWithBatching) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot current at the time from the repository
+func (r *DataReaderWithBatching) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithBatching) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	lookup, ok := r.delegate.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+	return lookup.LookupEntities(ctx, tenantID, plan, subject, snap)
+}
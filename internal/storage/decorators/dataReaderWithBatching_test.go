@@ -0,0 +1,106 @@
+package decorators
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// blockingDataReader - Data reader recording the relationships queried, holding the first query until released
+type blockingDataReader struct {
+	storage.DataReader
+	tuples  []*base.Tuple
+	release chan struct{}
+
+	mu      sync.Mutex
+	queries [][]string
+}
+
+func (r *blockingDataReader) QueryRelationships(_ context.Context, _ string, filter *base.TupleFilter, _ string) (*database.TupleIterator, error) {
+	r.mu.Lock()
+	r.queries = append(r.queries, filter.GetEntity().GetIds())
+	first := len(r.queries) == 1
+	r.mu.Unlock()
+	if first {
+		<-r.release
+	}
+
+	var tuples []*base.Tuple
+	for _, t := range r.tuples {
+		for _, id := range filter.GetEntity().GetIds() {
+			if t.GetEntity().GetType() == filter.GetEntity().GetType() && t.GetEntity().GetId() == id && t.GetRelation() == filter.GetRelation() {
+				tuples = append(tuples, t)
+			}
+		}
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+func (r *blockingDataReader) queried() [][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.queries
+}
+
+func TestDataReaderWithBatching(t *testing.T) {
+	ctx := context.Background()
+
+	delegate := &blockingDataReader{release: make(chan struct{})}
+	for _, value := range []string{"group:1#member@user:alice", "group:2#member@user:bob", "group:2#member@user:*", "group:3#member@group:1#member"} {
+		tup, err := tuple.Tuple(value)
+		require.NoError(t, err)
+		delegate.tuples = append(delegate.tuples, tup)
+	}
+	reader := NewDataReaderWithBatching(delegate, 2)
+
+	read := func(ids ...string) []string {
+		it, err := reader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "group", Ids: ids},
+			Relation: "member",
+		}, "s1")
+		require.NoError(t, err)
+		var values []string
+		for it.HasNext() {
+			values = append(values, tuple.ToString(it.GetNext()))
+		}
+		sort.Strings(values)
+		return values
+	}
+
+	first := make(chan []string)
+	go func() { first <- read("1") }()
+	assert.Eventually(t, func() bool { return len(delegate.queried()) == 1 }, time.Second, time.Millisecond)
+
+	// The reads arriving while the first one is in flight wait for it, and are read together
+	results := map[string]chan []string{"2": make(chan []string), "3": make(chan []string)}
+	for id, result := range results {
+		id, result := id, result
+		go func() { result <- read(id) }()
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	// The batch is full, so this read runs alone
+	assert.Equal(t, []string{"group:1#member@user:alice"}, read("1"))
+	assert.Len(t, delegate.queried(), 2)
+
+	close(delegate.release)
+	assert.Equal(t, []string{"group:1#member@user:alice"}, <-first)
+	assert.Equal(t, []string{"group:2#member@user:*", "group:2#member@user:bob"}, <-results["2"])
+	assert.Equal(t, []string{"group:3#member@group:1#member"}, <-results["3"])
+
+	queries := delegate.queried()
+	require.Len(t, queries, 3)
+	sort.Strings(queries[2])
+	assert.Equal(t, []string{"2", "3"}, queries[2])
+
+}
@@ -3,6 +3,7 @@ package decorators
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/afex/hystrix-go/hystrix"
 
@@ -225,3 +226,84 @@ func (r *DataReaderWithCircuitBreaker) HeadSnapshot(ctx context.Context, tenantI
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// ReadRelationshipHistory - Reads the creation and deletion history of relationships from the repository.
+func (r *DataReaderWithCircuitBreaker) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) (changes []*base.TupleChange, ct database.EncodedContinuousToken, err error) {
+	type circuitBreakerResponse struct {
+		Changes         []*base.TupleChange
+		ContinuousToken database.EncodedContinuousToken
+		Error           error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("dataReader.readRelationshipHistory", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("dataReader.readRelationshipHistory", func() error {
+		changes, ct, err := r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, start, end, pagination)
+		output <- circuitBreakerResponse{Changes: changes, ContinuousToken: ct, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Changes, out.ContinuousToken, out.Error
+	case <-bErrors:
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// SnapshotAt - Reads the version of the snapshot that was current at the given point in time from the repository.
+func (r *DataReaderWithCircuitBreaker) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	type circuitBreakerResponse struct {
+		Token token.SnapToken
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("dataReader.snapshotAt", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("dataReader.snapshotAt", func() error {
+		tok, err := r.delegate.SnapshotAt(ctx, tenantID, at)
+		output <- circuitBreakerResponse{Token: tok, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Token, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithCircuitBreaker) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	lookup, ok := r.delegate.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+
+	type circuitBreakerResponse struct {
+		IDs   []string
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("dataReader.lookupEntities", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("dataReader.lookupEntities", func() error {
+		ids, err := lookup.LookupEntities(ctx, tenantID, plan, subject, snap)
+		output <- circuitBreakerResponse{IDs: ids, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.IDs, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
@@ -0,0 +1,163 @@
+package decorators
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/encryption"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReaderWithEncryption - Add encryption of the identifiers of entities and subjects to data reader. Filters
+// are encrypted, so equality lookups match the stored ciphertexts, and the results are decrypted.
+type DataReaderWithEncryption struct {
+	delegate storage.DataReader
+	cipher   *encryption.Cipher
+}
+
+// NewDataReaderWithEncryption - Add encryption of the identifiers of entities and subjects to new data reader
+func NewDataReaderWithEncryption(delegate storage.DataReader, cipher *encryption.Cipher) *DataReaderWithEncryption {
+	return &DataReaderWithEncryption{delegate: delegate, cipher: cipher}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *DataReaderWithEncryption) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	it, err := r.delegate.QueryRelationships(ctx, tenantID, r.cipher.EncryptTupleFilter(tenantID, filter), snap)
+	if err != nil {
+		return nil, err
+	}
+	var tuples []*base.Tuple
+	for it.HasNext() {
+		tuple := it.GetNext()
+		if err = r.cipher.DecryptTuple(tenantID, tuple); err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, tuple)
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// ReadRelationships - Reads relation tuples from the repository with pagination
+func (r *DataReaderWithEncryption) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	collection, ct, err := r.delegate.ReadRelationships(ctx, tenantID, r.cipher.EncryptTupleFilter(tenantID, filter), snap, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tuple := range collection.GetTuples() {
+		if err = r.cipher.DecryptTuple(tenantID, tuple); err != nil {
+			return nil, nil, err
+		}
+	}
+	return collection, ct, nil
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository
+func (r *DataReaderWithEncryption) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	attribute, err := r.delegate.QuerySingleAttribute(ctx, tenantID, r.cipher.EncryptAttributeFilter(tenantID, filter), snap)
+	if err != nil || attribute == nil {
+		return attribute, err
+	}
+	if err = r.cipher.DecryptAttribute(tenantID, attribute); err != nil {
+		return nil, err
+	}
+	return attribute, nil
+}
+
+// QueryAttributes - Reads attributes from the repository
+func (r *DataReaderWithEncryption) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*database.AttributeIterator, error) {
+	it, err := r.delegate.QueryAttributes(ctx, tenantID, r.cipher.EncryptAttributeFilter(tenantID, filter), snap)
+	if err != nil {
+		return nil, err
+	}
+	var attributes []*base.Attribute
+	for it.HasNext() {
+		attribute := it.GetNext()
+		if err = r.cipher.DecryptAttribute(tenantID, attribute); err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attribute)
+	}
+	return database.NewAttributeIterator(attributes...), nil
+}
+
+// ReadAttributes - Reads attributes from the repository with pagination
+func (r *DataReaderWithEncryption) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	collection, ct, err := r.delegate.ReadAttributes(ctx, tenantID, r.cipher.EncryptAttributeFilter(tenantID, filter), snap, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, attribute := range collection.GetAttributes() {
+		if err = r.cipher.DecryptAttribute(tenantID, attribute); err != nil {
+			return nil, nil, err
+		}
+	}
+	return collection, ct, nil
+}
+
+// QueryUniqueEntities - Reads unique entities from the repository
+func (r *DataReaderWithEncryption) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	ids, ct, err := r.delegate.QueryUniqueEntities(ctx, tenantID, name, snap, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ids, err = r.cipher.DecryptAll(tenantID, ids); err != nil {
+		return nil, nil, err
+	}
+	return ids, ct, nil
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository
+func (r *DataReaderWithEncryption) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	ids, ct, err := r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, snap, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ids, err = r.cipher.DecryptAll(tenantID, ids); err != nil {
+		return nil, nil, err
+	}
+	return ids, ct, nil
+}
+
+// ReadRelationshipHistory - Reads the changes of relation tuples from the repository
+func (r *DataReaderWithEncryption) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	changes, ct, err := r.delegate.ReadRelationshipHistory(ctx, tenantID, r.cipher.EncryptTupleFilter(tenantID, filter), start, end, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, change := range changes {
+		if err = r.cipher.DecryptTuple(tenantID, change.GetTuple()); err != nil {
+			return nil, nil, err
+		}
+	}
+	return changes, ct, nil
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository
+func (r *DataReaderWithEncryption) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot current at the time from the repository
+func (r *DataReaderWithEncryption) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithEncryption) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	lookup, ok := r.delegate.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+	ids, err := lookup.LookupEntities(ctx, tenantID, plan, &base.Subject{
+		Type:     subject.GetType(),
+		Id:       r.cipher.Encrypt(tenantID, subject.GetId()),
+		Relation: subject.GetRelation(),
+	}, snap)
+	if err != nil {
+		return nil, err
+	}
+	return r.cipher.DecryptAll(tenantID, ids)
+}
@@ -0,0 +1,77 @@
+package decorators
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/encryption"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/database"
+	MMDatabase "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestDataReaderWithEncryption(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := MMDatabase.New(migrations.Schema)
+	require.NoError(t, err)
+	cipher, err := encryption.NewCipher(bytes.Repeat([]byte{1}, encryption.KeySize))
+	require.NoError(t, err)
+
+	reader := NewDataReaderWithEncryption(memory.NewDataReader(db), cipher)
+	writer := NewDataWriterWithEncryption(memory.NewDataWriter(db), cipher)
+
+	var tuples []*base.Tuple
+	for _, value := range []string{"doc:1#viewer@user:alice", "doc:1#viewer@user:*", "doc:2#viewer@group:eng#member"} {
+		tup, err := tuple.Tuple(value)
+		require.NoError(t, err)
+		tuples = append(tuples, tup)
+	}
+	_, err = writer.Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+	require.NoError(t, err)
+	assert.Equal(t, "alice", tuples[0].GetSubject().GetId(), "written tuples aren't changed")
+
+	head, err := reader.HeadSnapshot(ctx, "t1")
+	require.NoError(t, err)
+	snap := head.Encode().String()
+
+	// The database only holds ciphertexts.
+	it, err := memory.NewDataReader(db).QueryRelationships(ctx, "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}, snap)
+	require.NoError(t, err)
+	for it.HasNext() {
+		tup := it.GetNext()
+		assert.NotContains(t, []string{"1", "2"}, tup.GetEntity().GetId())
+		assert.NotContains(t, []string{"alice", "eng"}, tup.GetSubject().GetId())
+	}
+
+	// Equality lookups match, and results are decrypted.
+	it, err = reader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+		Entity:  &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+		Subject: &base.SubjectFilter{Type: "user", Ids: []string{"alice", "*"}},
+	}, snap)
+	require.NoError(t, err)
+	var values []string
+	for it.HasNext() {
+		values = append(values, tuple.ToString(it.GetNext()))
+	}
+	assert.ElementsMatch(t, []string{"doc:1#viewer@user:alice", "doc:1#viewer@user:*"}, values)
+
+	ids, _, err := reader.QueryUniqueEntities(ctx, "t1", "doc", snap, database.NewPagination(database.Size(10)))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"1", "2"}, ids)
+
+	_, err = writer.Delete(ctx, "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc", Ids: []string{"2"}}}, &base.AttributeFilter{})
+	require.NoError(t, err)
+	head, err = reader.HeadSnapshot(ctx, "t1")
+	require.NoError(t, err)
+	ids, _, err = reader.QueryUniqueEntities(ctx, "t1", "doc", head.Encode().String(), database.NewPagination(database.Size(10)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, ids)
+}
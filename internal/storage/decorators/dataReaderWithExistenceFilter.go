@@ -0,0 +1,299 @@
+package decorators
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	api "go.opentelemetry.io/otel/metric"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/bloom"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+const (
+	// existenceFilterSnapshots is the number of the last snapshots of a tenant the filter of the tenant answers the
+	// reads of, the reads of older snapshots reading from the delegate.
+	existenceFilterSnapshots = 1024
+
+	// existenceFilterPageSize is the number of relationships read at a time while the filter of a tenant is built.
+	existenceFilterPageSize = 1000
+
+	// existenceFilterRetryInterval is how long the filter of a tenant whose build or watch failed waits before it
+	// is built again.
+	existenceFilterRetryInterval = 10 * time.Second
+)
+
+// DataReaderWithExistenceFilter - Add a bloom filter of the relations of the entities of each tenant to data reader,
+// answering the reads of the relationships of entities that definitely have none without reading them. The filter
+// of a tenant is built from its relationships at its head snapshot the first time they are read, and follows its
+// changes from the watcher, so it answers the reads of that snapshot and of the ones of the changes it holds, while
+// the reads of the other snapshots, and the ones of the entities whose relations may exist, read from the delegate.
+type DataReaderWithExistenceFilter struct {
+	delegate storage.DataReader
+	watcher  storage.Watcher
+	// capacity is the number of relations of entities the filter of a tenant is sized for at least, and
+	// falsePositiveRate the rate of the reads of absent relations read from the delegate while it holds no more
+	capacity          int
+	falsePositiveRate float64
+
+	mu      sync.Mutex
+	filters map[string]*existenceFilter
+
+	skipped api.Int64Counter
+}
+
+// existenceFilter - Relations of the entities of a tenant, as of the snapshots it holds
+type existenceFilter struct {
+	cancel context.CancelFunc
+
+	mu sync.RWMutex
+	// keys are the relations of the entities, nil until the filter is built
+	keys *bloom.Filter
+	// snapshots are the snapshots whose relationships are all in the filter, and order the order they were added in
+	snapshots map[string]struct{}
+	order     []string
+	// failed is when the build or the watch of the filter failed, zero unless they did
+	failed time.Time
+}
+
+// NewDataReaderWithExistenceFilter - Add existence filters of the relations of the entities to new data reader
+func NewDataReaderWithExistenceFilter(delegate storage.DataReader, watcher storage.Watcher, capacity int, falsePositiveRate float64, meter api.Meter) *DataReaderWithExistenceFilter {
+	skipped, err := meter.Int64Counter("existence_filter_skipped_reads", api.WithDescription("Number of the reads of relationships answered by the existence filters without reading them"))
+	if err != nil {
+		panic(err)
+	}
+
+	return &DataReaderWithExistenceFilter{
+		delegate:          delegate,
+		watcher:           watcher,
+		capacity:          capacity,
+		falsePositiveRate: falsePositiveRate,
+		filters:           map[string]*existenceFilter{},
+		skipped:           skipped,
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository, unless the filter of the tenant tells none of the
+// entities of the filter has the relation
+func (r *DataReaderWithExistenceFilter) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	if filter.GetEntity().GetType() == "" || len(filter.GetEntity().GetIds()) == 0 || filter.GetRelation() == "" {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+
+	f := r.filter(tenantID)
+	f.mu.RLock()
+	_, ok := f.snapshots[snap]
+	if !ok || f.keys == nil {
+		f.mu.RUnlock()
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+	for _, id := range filter.GetEntity().GetIds() {
+		if f.keys.Test(existenceKey(filter.GetEntity().GetType(), id, filter.GetRelation())) {
+			f.mu.RUnlock()
+			return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+		}
+	}
+	f.mu.RUnlock()
+
+	r.skipped.Add(ctx, 1)
+	return database.NewTupleIterator(), nil
+}
+
+// filter returns the filter of the tenant, starting to build it if it isn't, or if its last build failed long enough
+// ago or it holds more relations than it is sized for.
+func (r *DataReaderWithExistenceFilter) filter(tenantID string) *existenceFilter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, ok := r.filters[tenantID]
+	if ok {
+		f.mu.RLock()
+		stale := (!f.failed.IsZero() && time.Since(f.failed) > existenceFilterRetryInterval) || (f.keys != nil && f.keys.Full())
+		capacity := r.capacity
+		if f.keys != nil {
+			capacity = max(capacity, 2*f.keys.Count())
+		}
+		f.mu.RUnlock()
+		if !stale {
+			return f
+		}
+		f.cancel()
+		f = r.build(tenantID, capacity)
+	} else {
+		f = r.build(tenantID, r.capacity)
+	}
+	r.filters[tenantID] = f
+	return f
+}
+
+// build builds the filter of the tenant from its relationships at its head snapshot, then adds the relations of
+// the changes of the tenant to it as they are written.
+func (r *DataReaderWithExistenceFilter) build(tenantID string, capacity int) *existenceFilter {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &existenceFilter{cancel: cancel, snapshots: map[string]struct{}{}}
+
+	go func() {
+		head, err := r.delegate.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			f.fail(tenantID, err)
+			return
+		}
+		snap := head.Encode().String()
+
+		// The relationships are read a page at a time and added as they are read, so that the build doesn't hold
+		// all of them. The relations several relationships share are only counted once, so that the filter isn't
+		// rebuilt larger than it needs to be.
+		filter := bloom.New(capacity, r.falsePositiveRate)
+		ct := ""
+		for {
+			collection, next, err := r.delegate.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, snap, database.NewPagination(database.Size(existenceFilterPageSize), database.Token(ct)))
+			if err != nil {
+				f.fail(tenantID, err)
+				return
+			}
+			for _, t := range collection.GetTuples() {
+				key := existenceKey(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation())
+				if !filter.Test(key) {
+					filter.Add(key)
+				}
+			}
+			ct = next.String()
+			if ct == "" {
+				break
+			}
+		}
+
+		// The changes after the snapshot are added to the filter as they are read
+		changes, errs := r.watcher.Watch(ctx, tenantID, snap)
+
+		f.mu.Lock()
+		f.keys = filter
+		f.add(snap)
+		f.mu.Unlock()
+
+		for {
+			select {
+			case change, ok := <-changes:
+				if !ok {
+					if ctx.Err() == nil {
+						f.fail(tenantID, nil)
+					}
+					return
+				}
+				f.mu.Lock()
+				for _, c := range change.GetDataChanges() {
+					if t := c.GetTuple(); t != nil {
+						f.keys.Add(existenceKey(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation()))
+					}
+				}
+				f.add(change.GetSnapToken())
+				f.mu.Unlock()
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if err != nil && ctx.Err() == nil {
+					f.fail(tenantID, err)
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return f
+}
+
+// add adds the snapshot to the ones whose relationships are all in the filter, forgetting the oldest past the
+// number of snapshots the filter answers the reads of.
+func (f *existenceFilter) add(snap string) {
+	if _, ok := f.snapshots[snap]; ok {
+		return
+	}
+	f.snapshots[snap] = struct{}{}
+	f.order = append(f.order, snap)
+	if len(f.order) > existenceFilterSnapshots {
+		delete(f.snapshots, f.order[0])
+		f.order = f.order[1:]
+	}
+}
+
+// fail stops the filter from answering reads until it is built again.
+func (f *existenceFilter) fail(tenantID string, err error) {
+	if err != nil {
+		slog.Error("existence filter stopped following the changes of the tenant", slog.String("tenant_id", tenantID), slog.Any("error", err))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = nil
+	f.snapshots = map[string]struct{}{}
+	f.order = nil
+	f.failed = time.Now()
+}
+
+// existenceKey returns the key of the relation of the entity in the filters.
+func existenceKey(entityType, entityID, relation string) string {
+	return entityType + ":" + entityID + "#" + relation
+}
+
+// ReadRelationships - Reads relation tuples from the repository with pagination
+func (r *DataReaderWithExistenceFilter) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository
+func (r *DataReaderWithExistenceFilter) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	return r.delegate.QuerySingleAttribute(ctx, tenantID, filter, snap)
+}
+
+// QueryAttributes - Reads attributes from the repository
+func (r *DataReaderWithExistenceFilter) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*database.AttributeIterator, error) {
+	return r.delegate.QueryAttributes(ctx, tenantID, filter, snap)
+}
+
+// ReadAttributes - Reads attributes from the repository with pagination
+func (r *DataReaderWithExistenceFilter) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadAttributes(ctx, tenantID, filter, snap, pagination)
+}
+
+// QueryUniqueEntities - Reads unique entities from the repository
+func (r *DataReaderWithExistenceFilter) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	return r.delegate.QueryUniqueEntities(ctx, tenantID, name, snap, pagination)
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository
+func (r *DataReaderWithExistenceFilter) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	return r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, snap, pagination)
+}
+
+// ReadRelationshipHistory - Reads the changes of relation tuples from the repository
+func (r *DataReaderWithExistenceFilter) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, start, end, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository
+func (r *DataReaderWithExistenceFilter) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot current at the time from the repository
+func (r *DataReaderWithExistenceFilter) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithExistenceFilter) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	lookup, ok := r.delegate.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+	return lookup.LookupEntities(ctx, tenantID, plan, subject, snap)
+}
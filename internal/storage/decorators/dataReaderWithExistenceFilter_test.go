@@ -0,0 +1,177 @@
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/database"
+	MMDatabase "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// countingDataReader - Data reader counting the reads of relationships and their pages, whose head snapshot doesn't
+// move
+type countingDataReader struct {
+	storage.DataReader
+	reads atomic.Int32
+	pages atomic.Int32
+}
+
+func (r *countingDataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	r.reads.Add(1)
+	return r.DataReader.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+func (r *countingDataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	r.pages.Add(1)
+	return r.DataReader.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+func (r *countingDataReader) HeadSnapshot(context.Context, string) (token.SnapToken, error) {
+	return token.NewNoopToken(), nil
+}
+
+// channelWatcher - Watcher streaming the changes sent to it
+type channelWatcher struct {
+	changes chan *base.DataChanges
+}
+
+func (w *channelWatcher) Watch(_ context.Context, _, _ string) (<-chan *base.DataChanges, <-chan error) {
+	return w.changes, make(chan error)
+}
+
+func TestDataReaderWithExistenceFilter(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := MMDatabase.New(migrations.Schema)
+	require.NoError(t, err)
+	writer := memory.NewDataWriter(db)
+
+	tuples := func(values ...string) *database.TupleCollection {
+		collection := database.NewTupleCollection()
+		for _, value := range values {
+			tup, err := tuple.Tuple(value)
+			require.NoError(t, err)
+			collection.Add(tup)
+		}
+		return collection
+	}
+	_, err = writer.Write(ctx, "t1", tuples("doc:1#viewer@user:alice", "doc:*#owner@user:bob"), database.NewAttributeCollection())
+	require.NoError(t, err)
+
+	watcher := &channelWatcher{changes: make(chan *base.DataChanges)}
+	delegate := &countingDataReader{DataReader: memory.NewDataReader(db)}
+	reader := NewDataReaderWithExistenceFilter(delegate, watcher, 10, 0.01, telemetry.NewNoopMeter())
+
+	head, err := reader.HeadSnapshot(ctx, "t1")
+	require.NoError(t, err)
+	snap := head.Encode().String()
+
+	count := func(snap string, relation string, ids ...string) int {
+		it, err := reader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: "doc", Ids: ids},
+			Relation: relation,
+		}, snap)
+		require.NoError(t, err)
+		n := 0
+		for it.HasNext() {
+			it.GetNext()
+			n++
+		}
+		return n
+	}
+
+	// The first read builds the filter
+	assert.Equal(t, 1, count(snap, "viewer", "1"))
+	assert.Eventually(t, func() bool {
+		f := reader.filter("t1")
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		return f.keys != nil
+	}, time.Second, time.Millisecond)
+
+	reads := delegate.reads.Load()
+	assert.Equal(t, 1, count(snap, "viewer", "1"))
+	assert.Equal(t, 1, count(snap, "owner", "2", "*"))
+	assert.Equal(t, reads+2, delegate.reads.Load())
+	assert.Equal(t, 0, count(snap, "viewer", "2"))
+	assert.Equal(t, 0, count(snap, "editor", "1", "*"))
+	assert.Equal(t, reads+2, delegate.reads.Load(), "the reads of absent relations are answered by the filter")
+
+	// The reads of the snapshots of changes the filter hasn't read yet read the relationships
+	written, err := writer.Write(ctx, "t1", tuples("doc:2#viewer@user:alice"), database.NewAttributeCollection())
+	require.NoError(t, err)
+	after := written.String()
+	assert.Equal(t, 1, count(after, "viewer", "2"))
+
+	watcher.changes <- &base.DataChanges{
+		SnapToken: after,
+		DataChanges: []*base.DataChange{{
+			Operation: base.DataChange_OPERATION_CREATE,
+			Type:      &base.DataChange_Tuple{Tuple: tuples("doc:2#viewer@user:alice").GetTuples()[0]},
+		}},
+	}
+	assert.Eventually(t, func() bool { return count(after, "viewer", "2") == 1 }, time.Second, time.Millisecond)
+
+	// The filter stops answering reads once the watch of the changes ends
+	close(watcher.changes)
+	assert.Eventually(t, func() bool {
+		f := reader.filter("t1")
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		return f.keys == nil && !f.failed.IsZero()
+	}, time.Second, time.Millisecond)
+	reads = delegate.reads.Load()
+	assert.Equal(t, 0, count(snap, "viewer", "3"))
+	assert.Equal(t, reads+1, delegate.reads.Load())
+}
+
+func TestDataReaderWithExistenceFilter_Pages(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := MMDatabase.New(migrations.Schema)
+	require.NoError(t, err)
+
+	// The relationships of the tenant fill more than a page, with two relationships for each relation
+	collection := database.NewTupleCollection()
+	for i := 0; i < existenceFilterPageSize; i++ {
+		for _, subject := range []string{"alice", "bob"} {
+			tup, err := tuple.Tuple(fmt.Sprintf("doc:%d#viewer@user:%s", i, subject))
+			require.NoError(t, err)
+			collection.Add(tup)
+		}
+	}
+	_, err = memory.NewDataWriter(db).Write(ctx, "t1", collection, database.NewAttributeCollection())
+	require.NoError(t, err)
+
+	delegate := &countingDataReader{DataReader: memory.NewDataReader(db)}
+	reader := NewDataReaderWithExistenceFilter(delegate, &channelWatcher{changes: make(chan *base.DataChanges)}, 4*existenceFilterPageSize, 0.01, telemetry.NewNoopMeter())
+
+	assert.Eventually(t, func() bool {
+		f := reader.filter("t1")
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		return f.keys != nil
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), delegate.pages.Load())
+
+	f := reader.filter("t1")
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for i := 0; i < existenceFilterPageSize; i++ {
+		assert.True(t, f.keys.Test(fmt.Sprintf("doc:%d#viewer", i)))
+	}
+	assert.LessOrEqual(t, f.keys.Count(), existenceFilterPageSize)
+}
@@ -0,0 +1,115 @@
+package decorators
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/chaos"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReaderWithFaults - Add fault injection to data reader
+type DataReaderWithFaults struct {
+	delegate storage.DataReader
+	injector *chaos.Injector
+}
+
+// NewDataReaderWithFaults - Add fault injection to new data reader
+func NewDataReaderWithFaults(delegate storage.DataReader, injector *chaos.Injector) *DataReaderWithFaults {
+	return &DataReaderWithFaults{delegate: delegate, injector: injector}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *DataReaderWithFaults) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	if err := r.injector.Inject(ctx, "dataReader.queryRelationships"); err != nil {
+		return nil, err
+	}
+	return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+// ReadRelationships - Reads relation tuples from the repository with pagination
+func (r *DataReaderWithFaults) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.readRelationships"); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository
+func (r *DataReaderWithFaults) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	if err := r.injector.Inject(ctx, "dataReader.querySingleAttribute"); err != nil {
+		return nil, err
+	}
+	return r.delegate.QuerySingleAttribute(ctx, tenantID, filter, snap)
+}
+
+// QueryAttributes - Reads attributes from the repository
+func (r *DataReaderWithFaults) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*database.AttributeIterator, error) {
+	if err := r.injector.Inject(ctx, "dataReader.queryAttributes"); err != nil {
+		return nil, err
+	}
+	return r.delegate.QueryAttributes(ctx, tenantID, filter, snap)
+}
+
+// ReadAttributes - Reads attributes from the repository with pagination
+func (r *DataReaderWithFaults) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.readAttributes"); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadAttributes(ctx, tenantID, filter, snap, pagination)
+}
+
+// QueryUniqueEntities - Reads unique entities from the repository with pagination
+func (r *DataReaderWithFaults) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.queryUniqueEntities"); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.QueryUniqueEntities(ctx, tenantID, name, snap, pagination)
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository with pagination
+func (r *DataReaderWithFaults) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.queryUniqueSubjectReferences"); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, snap, pagination)
+}
+
+// ReadRelationshipHistory - Reads the changes of relation tuples in a time range from the repository
+func (r *DataReaderWithFaults) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.readRelationshipHistory"); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, start, end, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository
+func (r *DataReaderWithFaults) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.headSnapshot"); err != nil {
+		return nil, err
+	}
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot that was current at the given point in time
+func (r *DataReaderWithFaults) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataReader.snapshotAt"); err != nil {
+		return nil, err
+	}
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithFaults) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	lookup, ok := r.delegate.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+	if err := r.injector.Inject(ctx, "dataReader.lookupEntities"); err != nil {
+		return nil, err
+	}
+	return lookup.LookupEntities(ctx, tenantID, plan, subject, snap)
+}
@@ -0,0 +1,139 @@
+package decorators
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReaderWithResidency - Route the reads of tenants kept in regions to the data readers of their regions
+type DataReaderWithResidency struct {
+	delegate storage.DataReader
+	router   TenantRouter
+	regions  map[string]storage.DataReader
+}
+
+// NewDataReaderWithResidency - Route the reads of tenants to the data readers of the regions the router resolves,
+// and the reads of the other tenants to the delegate
+func NewDataReaderWithResidency(delegate storage.DataReader, router TenantRouter, regions map[string]storage.DataReader) *DataReaderWithResidency {
+	return &DataReaderWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// route returns the data reader of the tenant
+func (r *DataReaderWithResidency) route(ctx context.Context, tenantID string) (storage.DataReader, error) {
+	region, err := r.router.Route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if reader, ok := r.regions[region]; ok {
+		return reader, nil
+	}
+	return r.delegate, nil
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *DataReaderWithResidency) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+// ReadRelationships - Reads relation tuples from the repository with pagination
+func (r *DataReaderWithResidency) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// QuerySingleAttribute - Reads a single attribute from the repository
+func (r *DataReaderWithResidency) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.QuerySingleAttribute(ctx, tenantID, filter, snap)
+}
+
+// QueryAttributes - Reads attributes from the repository
+func (r *DataReaderWithResidency) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*database.AttributeIterator, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.QueryAttributes(ctx, tenantID, filter, snap)
+}
+
+// ReadAttributes - Reads attributes from the repository with pagination
+func (r *DataReaderWithResidency) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader.ReadAttributes(ctx, tenantID, filter, snap, pagination)
+}
+
+// QueryUniqueEntities - Reads unique entities from the repository
+func (r *DataReaderWithResidency) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader.QueryUniqueEntities(ctx, tenantID, name, snap, pagination)
+}
+
+// QueryUniqueSubjectReferences - Reads unique subject references from the repository
+func (r *DataReaderWithResidency) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, snap, pagination)
+}
+
+// ReadRelationshipHistory - Reads the changes of relation tuples from the repository
+func (r *DataReaderWithResidency) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader.ReadRelationshipHistory(ctx, tenantID, filter, start, end, pagination)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository
+func (r *DataReaderWithResidency) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot current at the time from the repository
+func (r *DataReaderWithResidency) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.SnapshotAt(ctx, tenantID, at)
+}
+
+// LookupEntities - Reads the entities of the lookup plan the subject is in from the repository
+func (r *DataReaderWithResidency) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) ([]string, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	lookup, ok := reader.(storage.EntityLookup)
+	if !ok {
+		return nil, storage.ErrLookupUnsupported
+	}
+	return lookup.LookupEntities(ctx, tenantID, plan, subject, snap)
+}
@@ -73,3 +73,55 @@ func (r *DataWriterWithCircuitBreaker) Delete(ctx context.Context, tenantID stri
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// RunTransaction - Delete and write relation tuples and attributes in a single transaction
+func (r *DataWriterWithCircuitBreaker) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	type circuitBreakerResponse struct {
+		Token token.EncodedSnapToken
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+
+	hystrix.ConfigureCommand("dataWriter.runTransaction", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("dataWriter.runTransaction", func() error {
+		t, err := r.delegate.RunTransaction(ctx, tenantID, transaction)
+		output <- circuitBreakerResponse{Token: t, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Token, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// MigrateSchema - Write a schema version and migrate relation tuples and attributes to it in a single transaction
+func (r *DataWriterWithCircuitBreaker) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	type circuitBreakerResponse struct {
+		Token token.EncodedSnapToken
+		Error error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+
+	hystrix.ConfigureCommand("dataWriter.migrateSchema", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("dataWriter.migrateSchema", func() error {
+		t, err := r.delegate.MigrateSchema(ctx, tenantID, definitions, transaction)
+		output <- circuitBreakerResponse{Token: t, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Token, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
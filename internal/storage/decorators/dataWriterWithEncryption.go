@@ -0,0 +1,71 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/encryption"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataWriterWithEncryption - Add encryption of the identifiers of entities and subjects to data writer
+type DataWriterWithEncryption struct {
+	delegate storage.DataWriter
+	cipher   *encryption.Cipher
+}
+
+// NewDataWriterWithEncryption - Add encryption of the identifiers of entities and subjects to new data writer
+func NewDataWriterWithEncryption(delegate storage.DataWriter, cipher *encryption.Cipher) *DataWriterWithEncryption {
+	return &DataWriterWithEncryption{delegate: delegate, cipher: cipher}
+}
+
+// Write - Write relation tuples and attributes to the repository
+func (r *DataWriterWithEncryption) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
+	tuples := database.NewTupleCollection()
+	for _, tuple := range tupleCollection.GetTuples() {
+		tuples.Add(r.cipher.EncryptTuple(tenantID, tuple))
+	}
+	attributes := database.NewAttributeCollection()
+	for _, attribute := range attributeCollection.GetAttributes() {
+		attributes.Add(r.cipher.EncryptAttribute(tenantID, attribute))
+	}
+	return r.delegate.Write(ctx, tenantID, tuples, attributes)
+}
+
+// Delete - Delete relation tuples and attributes from the repository
+func (r *DataWriterWithEncryption) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attrFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+	return r.delegate.Delete(ctx, tenantID, r.cipher.EncryptTupleFilter(tenantID, tupleFilter), r.cipher.EncryptAttributeFilter(tenantID, attrFilter))
+}
+
+// RunTransaction - Delete and write relation tuples and attributes in a single transaction
+func (r *DataWriterWithEncryption) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	encrypted := database.NewTransaction()
+	for i := range transaction.TupleFilters {
+		encrypted.Delete(r.cipher.EncryptTupleFilter(tenantID, transaction.TupleFilters[i]), r.cipher.EncryptAttributeFilter(tenantID, transaction.AttributeFilters[i]))
+	}
+	for _, tuple := range transaction.Tuples.GetTuples() {
+		encrypted.Tuples.Add(r.cipher.EncryptTuple(tenantID, tuple))
+	}
+	for _, attribute := range transaction.Attributes.GetAttributes() {
+		encrypted.Attributes.Add(r.cipher.EncryptAttribute(tenantID, attribute))
+	}
+	return r.delegate.RunTransaction(ctx, tenantID, encrypted)
+}
+
+// MigrateSchema - Write a schema version and migrate relation tuples and attributes to it in a single transaction
+func (r *DataWriterWithEncryption) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	encrypted := database.NewTransaction()
+	for i := range transaction.TupleFilters {
+		encrypted.Delete(r.cipher.EncryptTupleFilter(tenantID, transaction.TupleFilters[i]), r.cipher.EncryptAttributeFilter(tenantID, transaction.AttributeFilters[i]))
+	}
+	for _, tuple := range transaction.Tuples.GetTuples() {
+		encrypted.Tuples.Add(r.cipher.EncryptTuple(tenantID, tuple))
+	}
+	for _, attribute := range transaction.Attributes.GetAttributes() {
+		encrypted.Attributes.Add(r.cipher.EncryptAttribute(tenantID, attribute))
+	}
+	encrypted.SchemaVersion = transaction.SchemaVersion
+	return r.delegate.MigrateSchema(ctx, tenantID, definitions, encrypted)
+}
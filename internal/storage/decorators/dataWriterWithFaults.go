@@ -0,0 +1,54 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/chaos"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataWriterWithFaults - Add fault injection to data writer
+type DataWriterWithFaults struct {
+	delegate storage.DataWriter
+	injector *chaos.Injector
+}
+
+// NewDataWriterWithFaults - Add fault injection to new data writer
+func NewDataWriterWithFaults(delegate storage.DataWriter, injector *chaos.Injector) *DataWriterWithFaults {
+	return &DataWriterWithFaults{delegate: delegate, injector: injector}
+}
+
+// Write - Write relation tuples and attributes to the repository
+func (r *DataWriterWithFaults) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataWriter.write"); err != nil {
+		return nil, err
+	}
+	return r.delegate.Write(ctx, tenantID, tupleCollection, attributeCollection)
+}
+
+// Delete - Delete relation tuples and attributes from the repository
+func (r *DataWriterWithFaults) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attrFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataWriter.delete"); err != nil {
+		return nil, err
+	}
+	return r.delegate.Delete(ctx, tenantID, tupleFilter, attrFilter)
+}
+
+// RunTransaction - Delete and write relation tuples and attributes in a single transaction
+func (r *DataWriterWithFaults) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataWriter.runTransaction"); err != nil {
+		return nil, err
+	}
+	return r.delegate.RunTransaction(ctx, tenantID, transaction)
+}
+
+// MigrateSchema - Write a schema version and migrate relation tuples and attributes to it in a single transaction
+func (r *DataWriterWithFaults) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	if err := r.injector.Inject(ctx, "dataWriter.migrateSchema"); err != nil {
+		return nil, err
+	}
+	return r.delegate.MigrateSchema(ctx, tenantID, definitions, transaction)
+}
@@ -0,0 +1,71 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataWriterWithResidency - Route the writes of tenants kept in regions to the data writers of their regions
+type DataWriterWithResidency struct {
+	delegate storage.DataWriter
+	router   TenantRouter
+	regions  map[string]storage.DataWriter
+}
+
+// NewDataWriterWithResidency - Route the writes of tenants to the data writers of the regions the router resolves,
+// and the writes of the other tenants to the delegate
+func NewDataWriterWithResidency(delegate storage.DataWriter, router TenantRouter, regions map[string]storage.DataWriter) *DataWriterWithResidency {
+	return &DataWriterWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// route returns the data writer of the tenant
+func (r *DataWriterWithResidency) route(ctx context.Context, tenantID string) (storage.DataWriter, error) {
+	region, err := r.router.Route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if writer, ok := r.regions[region]; ok {
+		return writer, nil
+	}
+	return r.delegate, nil
+}
+
+// Write - Write relation tuples and attributes to the repository
+func (r *DataWriterWithResidency) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
+	writer, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return writer.Write(ctx, tenantID, tupleCollection, attributeCollection)
+}
+
+// Delete - Delete relation tuples and attributes from the repository
+func (r *DataWriterWithResidency) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attrFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+	writer, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return writer.Delete(ctx, tenantID, tupleFilter, attrFilter)
+}
+
+// RunTransaction - Delete and write relation tuples and attributes in a single transaction
+func (r *DataWriterWithResidency) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	writer, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return writer.RunTransaction(ctx, tenantID, transaction)
+}
+
+// MigrateSchema - Write a schema version and migrate relation tuples and attributes to it in a single transaction
+func (r *DataWriterWithResidency) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	writer, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return writer.MigrateSchema(ctx, tenantID, definitions, transaction)
+}
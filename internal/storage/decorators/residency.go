@@ -0,0 +1,32 @@
+package decorators
+
+import (
+	"context"
+)
+
+// TenantRouter - Resolves the database regions tenants are kept in
+type TenantRouter interface {
+	// Route returns the region the tenant is kept in, empty for the default database. It fails for tenants of
+	// regions that can't be served, e.g. unhealthy ones.
+	Route(ctx context.Context, tenantID string) (region string, err error)
+	// Assign returns the region a tenant created with the requested region is kept in
+	Assign(tenantID, requested string) (region string, err error)
+	// Assigned records that the tenant is created in the region
+	Assigned(tenantID, region string)
+	// Removed records that the tenant is deleted
+	Removed(tenantID string)
+}
+
+// tenantDatabaseKey is the context key of the database region requested for the tenant created
+type tenantDatabaseKey struct{}
+
+// WithTenantDatabase returns the context creating tenants in the database region, the default database if empty
+func WithTenantDatabase(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, tenantDatabaseKey{}, region)
+}
+
+// tenantDatabase returns the database region requested for the tenant created with the context
+func tenantDatabase(ctx context.Context) string {
+	region, _ := ctx.Value(tenantDatabaseKey{}).(string)
+	return region
+}
@@ -80,3 +80,13 @@ func (r *SchemaReaderWithCache) ReadRuleDefinition(ctx context.Context, tenantID
 func (r *SchemaReaderWithCache) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	return r.delegate.HeadVersion(ctx, tenantID)
 }
+
+// ReadSchemaDefinitions - Read serialized schema definitions from the repository
+func (r *SchemaReaderWithCache) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	return r.delegate.ReadSchemaDefinitions(ctx, tenantID, version)
+}
+
+// ReadSchemaVersions - Read the versions of the schema from the repository
+func (r *SchemaReaderWithCache) ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error) {
+	return r.delegate.ReadSchemaVersions(ctx, tenantID)
+}
@@ -125,3 +125,55 @@ func (r *SchemaReaderWithCircuitBreaker) HeadVersion(ctx context.Context, tenant
 		return "", errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// ReadSchemaDefinitions - Read serialized schema definitions from repository
+func (r *SchemaReaderWithCircuitBreaker) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) ([]storage.SchemaDefinition, error) {
+	type circuitBreakerResponse struct {
+		Definitions []storage.SchemaDefinition
+		Error       error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+
+	hystrix.ConfigureCommand("schemaReader.readSchemaDefinitions", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("schemaReader.readSchemaDefinitions", func() error {
+		definitions, err := r.delegate.ReadSchemaDefinitions(ctx, tenantID, version)
+		output <- circuitBreakerResponse{Definitions: definitions, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Definitions, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// ReadSchemaVersions - Read the versions of the schema from repository
+func (r *SchemaReaderWithCircuitBreaker) ReadSchemaVersions(ctx context.Context, tenantID string) ([]string, error) {
+	type circuitBreakerResponse struct {
+		Versions []string
+		Error    error
+	}
+
+	output := make(chan circuitBreakerResponse, 1)
+
+	hystrix.ConfigureCommand("schemaReader.readSchemaVersions", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("schemaReader.readSchemaVersions", func() error {
+		versions, err := r.delegate.ReadSchemaVersions(ctx, tenantID)
+		output <- circuitBreakerResponse{Versions: versions, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+
+	select {
+	case out := <-output:
+		return out.Versions, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
@@ -0,0 +1,68 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/chaos"
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReaderWithFaults - Add fault injection to schema reader
+type SchemaReaderWithFaults struct {
+	delegate storage.SchemaReader
+	injector *chaos.Injector
+}
+
+// NewSchemaReaderWithFaults - Add fault injection to new schema reader
+func NewSchemaReaderWithFaults(delegate storage.SchemaReader, injector *chaos.Injector) *SchemaReaderWithFaults {
+	return &SchemaReaderWithFaults{delegate: delegate, injector: injector}
+}
+
+// ReadSchema - Read schema from the repository
+func (r *SchemaReaderWithFaults) ReadSchema(ctx context.Context, tenantID, version string) (*base.SchemaDefinition, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.readSchema"); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadSchema(ctx, tenantID, version)
+}
+
+// ReadEntityDefinition - Read entity definition from the repository
+func (r *SchemaReaderWithFaults) ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (*base.EntityDefinition, string, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.readEntityDefinition"); err != nil {
+		return nil, "", err
+	}
+	return r.delegate.ReadEntityDefinition(ctx, tenantID, entityName, version)
+}
+
+// ReadRuleDefinition - Read rule definition from the repository
+func (r *SchemaReaderWithFaults) ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (*base.RuleDefinition, string, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.readRuleDefinition"); err != nil {
+		return nil, "", err
+	}
+	return r.delegate.ReadRuleDefinition(ctx, tenantID, ruleName, version)
+}
+
+// HeadVersion - Finds the latest version of the schema
+func (r *SchemaReaderWithFaults) HeadVersion(ctx context.Context, tenantID string) (string, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.headVersion"); err != nil {
+		return "", err
+	}
+	return r.delegate.HeadVersion(ctx, tenantID)
+}
+
+// ReadSchemaDefinitions - Read the definitions of a schema version from the repository
+func (r *SchemaReaderWithFaults) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) ([]storage.SchemaDefinition, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.readSchemaDefinitions"); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadSchemaDefinitions(ctx, tenantID, version)
+}
+
+// ReadSchemaVersions - Read the versions of the schema from the repository
+func (r *SchemaReaderWithFaults) ReadSchemaVersions(ctx context.Context, tenantID string) ([]string, error) {
+	if err := r.injector.Inject(ctx, "schemaReader.readSchemaVersions"); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadSchemaVersions(ctx, tenantID)
+}
@@ -0,0 +1,87 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReaderWithResidency - Route the schema reads of tenants kept in regions to the schema readers of their regions
+type SchemaReaderWithResidency struct {
+	delegate storage.SchemaReader
+	router   TenantRouter
+	regions  map[string]storage.SchemaReader
+}
+
+// NewSchemaReaderWithResidency - Route the schema reads of tenants to the schema readers of the regions the router
+// resolves, and the schema reads of the other tenants to the delegate
+func NewSchemaReaderWithResidency(delegate storage.SchemaReader, router TenantRouter, regions map[string]storage.SchemaReader) *SchemaReaderWithResidency {
+	return &SchemaReaderWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// route returns the schema reader of the tenant
+func (r *SchemaReaderWithResidency) route(ctx context.Context, tenantID string) (storage.SchemaReader, error) {
+	region, err := r.router.Route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if reader, ok := r.regions[region]; ok {
+		return reader, nil
+	}
+	return r.delegate, nil
+}
+
+// ReadSchema - Read schema from the repository
+func (r *SchemaReaderWithResidency) ReadSchema(ctx context.Context, tenantID, version string) (*base.SchemaDefinition, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.ReadSchema(ctx, tenantID, version)
+}
+
+// ReadEntityDefinition - Read entity definition from the repository
+func (r *SchemaReaderWithResidency) ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (*base.EntityDefinition, string, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, "", err
+	}
+	return reader.ReadEntityDefinition(ctx, tenantID, entityName, version)
+}
+
+// ReadRuleDefinition - Read rule definition from the repository
+func (r *SchemaReaderWithResidency) ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (*base.RuleDefinition, string, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, "", err
+	}
+	return reader.ReadRuleDefinition(ctx, tenantID, ruleName, version)
+}
+
+// HeadVersion - Finds the latest version of the schema
+func (r *SchemaReaderWithResidency) HeadVersion(ctx context.Context, tenantID string) (string, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return reader.HeadVersion(ctx, tenantID)
+}
+
+// ReadSchemaDefinitions - Read the definitions of the schema from the repository
+func (r *SchemaReaderWithResidency) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) ([]storage.SchemaDefinition, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.ReadSchemaDefinitions(ctx, tenantID, version)
+}
+
+// ReadSchemaVersions - Read the versions of the schema from the repository
+func (r *SchemaReaderWithResidency) ReadSchemaVersions(ctx context.Context, tenantID string) ([]string, error) {
+	reader, err := r.route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return reader.ReadSchemaVersions(ctx, tenantID)
+}
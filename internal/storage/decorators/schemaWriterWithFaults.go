@@ -0,0 +1,27 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/chaos"
+	"github.com/Permify/permify/internal/storage"
+)
+
+// SchemaWriterWithFaults - Add fault injection to schema writer
+type SchemaWriterWithFaults struct {
+	delegate storage.SchemaWriter
+	injector *chaos.Injector
+}
+
+// NewSchemaWriterWithFaults - Add fault injection to new schema writer
+func NewSchemaWriterWithFaults(delegate storage.SchemaWriter, injector *chaos.Injector) *SchemaWriterWithFaults {
+	return &SchemaWriterWithFaults{delegate: delegate, injector: injector}
+}
+
+// WriteSchema - Write schema to repository
+func (r *SchemaWriterWithFaults) WriteSchema(ctx context.Context, definitions []storage.SchemaDefinition) error {
+	if err := r.injector.Inject(ctx, "schemaWriter.writeSchema"); err != nil {
+		return err
+	}
+	return r.delegate.WriteSchema(ctx, definitions)
+}
@@ -0,0 +1,36 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/storage"
+)
+
+// SchemaWriterWithResidency - Route the schema writes of tenants kept in regions to the schema writers of their regions
+type SchemaWriterWithResidency struct {
+	delegate storage.SchemaWriter
+	router   TenantRouter
+	regions  map[string]storage.SchemaWriter
+}
+
+// NewSchemaWriterWithResidency - Route the schema writes of tenants to the schema writers of the regions the router
+// resolves, and the schema writes of the other tenants to the delegate
+func NewSchemaWriterWithResidency(delegate storage.SchemaWriter, router TenantRouter, regions map[string]storage.SchemaWriter) *SchemaWriterWithResidency {
+	return &SchemaWriterWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// WriteSchema - Write schema to repository. The definitions of a schema belong to a single tenant.
+func (r *SchemaWriterWithResidency) WriteSchema(ctx context.Context, definitions []storage.SchemaDefinition) error {
+	if len(definitions) == 0 {
+		return r.delegate.WriteSchema(ctx, definitions)
+	}
+
+	region, err := r.router.Route(ctx, definitions[0].TenantID)
+	if err != nil {
+		return err
+	}
+	if writer, ok := r.regions[region]; ok {
+		return writer.WriteSchema(ctx, definitions)
+	}
+	return r.delegate.WriteSchema(ctx, definitions)
+}
@@ -0,0 +1,75 @@
+package decorators
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantWriterWithResidency - Create and delete tenants kept in regions in the tenant writers of their regions as
+// well. The delegate records every tenant, so tenants are listed from it.
+type TenantWriterWithResidency struct {
+	delegate storage.TenantWriter
+	router   TenantRouter
+	regions  map[string]storage.TenantWriter
+}
+
+// NewTenantWriterWithResidency - Create and delete tenants in the tenant writers of the regions the router assigns
+// them to as well as the delegate
+func NewTenantWriterWithResidency(delegate storage.TenantWriter, router TenantRouter, regions map[string]storage.TenantWriter) *TenantWriterWithResidency {
+	return &TenantWriterWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// CreateTenant - Creates the tenant in the region it is assigned to, then in the delegate, so tenants listed by the
+// delegate are found in their regions. The tenant is deleted from its region if it can't be created in the delegate.
+func (r *TenantWriterWithResidency) CreateTenant(ctx context.Context, id, name string) (*base.Tenant, error) {
+	region, err := r.router.Assign(id, tenantDatabase(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	writer, ok := r.regions[region]
+	if !ok {
+		tenant, err := r.delegate.CreateTenant(ctx, id, name)
+		if err != nil {
+			return nil, err
+		}
+		r.router.Assigned(id, region)
+		return tenant, nil
+	}
+	if _, err = writer.CreateTenant(ctx, id, name); err != nil {
+		return nil, err
+	}
+
+	tenant, err := r.delegate.CreateTenant(ctx, id, name)
+	if err != nil {
+		if _, derr := writer.DeleteTenant(ctx, id); derr != nil {
+			slog.Error("failed to delete tenant not created in the default database from its region", slog.String("tenant_id", id), slog.String("region", region), slog.Any("error", derr))
+		}
+		return nil, err
+	}
+	r.router.Assigned(id, region)
+	return tenant, nil
+}
+
+// DeleteTenant - Deletes the tenant and its data from its region, then from the delegate
+func (r *TenantWriterWithResidency) DeleteTenant(ctx context.Context, tenantID string) (*base.Tenant, error) {
+	region, err := r.router.Route(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if writer, ok := r.regions[region]; ok {
+		if _, err = writer.DeleteTenant(ctx, tenantID); err != nil {
+			return nil, err
+		}
+	}
+
+	tenant, err := r.delegate.DeleteTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	r.router.Removed(tenantID)
+	return tenant, nil
+}
@@ -0,0 +1,82 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/encryption"
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// WatcherWithEncryption - Add decryption of the identifiers of entities and subjects to the changes of watcher
+type WatcherWithEncryption struct {
+	delegate storage.Watcher
+	cipher   *encryption.Cipher
+}
+
+// NewWatcherWithEncryption - Add decryption of the identifiers of entities and subjects to the changes of new watcher
+func NewWatcherWithEncryption(delegate storage.Watcher, cipher *encryption.Cipher) *WatcherWithEncryption {
+	return &WatcherWithEncryption{delegate: delegate, cipher: cipher}
+}
+
+// Watch - Watches the changes of the tenant from the snapshot, decrypting them. The watch ends with the error of
+// changes that can't be decrypted.
+func (r *WatcherWithEncryption) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	ctx, cancel := context.WithCancel(ctx)
+	changes, errs := r.delegate.Watch(ctx, tenantID, snap)
+
+	decrypted := make(chan *base.DataChanges)
+	decryptErrs := make(chan error, 1)
+
+	go func() {
+		defer close(decrypted)
+		defer close(decryptErrs)
+		defer cancel()
+
+		for {
+			select {
+			case change, ok := <-changes:
+				if !ok {
+					// The changes channel is closed along with the errs channel, which ends the loop.
+					changes = nil
+					continue
+				}
+				if err := r.decrypt(tenantID, change); err != nil {
+					decryptErrs <- err
+					return
+				}
+				select {
+				case decrypted <- change:
+				case <-ctx.Done():
+					return
+				}
+			case err, ok := <-errs:
+				if ok {
+					decryptErrs <- err
+				}
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return decrypted, decryptErrs
+}
+
+// decrypt decrypts the changes in place.
+func (r *WatcherWithEncryption) decrypt(tenantID string, changes *base.DataChanges) error {
+	for _, change := range changes.GetDataChanges() {
+		if tuple := change.GetTuple(); tuple != nil {
+			if err := r.cipher.DecryptTuple(tenantID, tuple); err != nil {
+				return err
+			}
+		}
+		if attribute := change.GetAttribute(); attribute != nil {
+			if err := r.cipher.DecryptAttribute(tenantID, attribute); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
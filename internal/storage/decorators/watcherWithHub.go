@@ -0,0 +1,205 @@
+package decorators
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// ErrWatchSubscriberTooSlow is the error the watches of a hub end with when they don't keep up with the changes.
+var ErrWatchSubscriberTooSlow = status.Error(codes.ResourceExhausted, "watch doesn't keep up with the changes")
+
+// WatcherWithHub - Add fan-out behaviour to watcher, reading the changes of each tenant from the delegate once for
+// all the watches of the node. The hub of a tenant keeps the last changes it read, so watches starting from the
+// snapshot of one of them, or the one the hub started from, join it. Other watches, e.g. from older snapshots,
+// read from the delegate.
+type WatcherWithHub struct {
+	delegate    storage.Watcher
+	historySize int
+	bufferSize  int
+
+	mu   sync.Mutex
+	hubs map[string]*hub
+}
+
+// NewWatcherWithHub new instance of WatcherWithHub keeping the last historySize changes of each tenant, and
+// buffering bufferSize changes for each watch.
+func NewWatcherWithHub(delegate storage.Watcher, historySize, bufferSize int) *WatcherWithHub {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &WatcherWithHub{
+		delegate:    delegate,
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		hubs:        map[string]*hub{},
+	}
+}
+
+// hub - Changes of a tenant read from the delegate, fanned out to the subscribers
+type hub struct {
+	cancel context.CancelFunc
+
+	// base is the snapshot the history starts after
+	base string
+	// history is the last changes read, oldest first
+	history []*base.DataChanges
+	// subscribers are the watches of the hub
+	subscribers map[*subscriber]struct{}
+}
+
+// subscriber - Watch of a hub
+type subscriber struct {
+	changes chan *base.DataChanges
+	errs    chan error
+}
+
+// close ends the watch with the error, if any.
+func (s *subscriber) close(err error) {
+	if err != nil {
+		s.errs <- err
+	}
+	close(s.changes)
+	close(s.errs)
+}
+
+// Watch - Watches the changes of the tenant after the snapshot, through the hub of the tenant if it can serve them.
+func (r *WatcherWithHub) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.hubs[tenantID]
+	if !ok {
+		h = r.start(tenantID, snap)
+	}
+
+	// Changes after the snapshot of the watch, if the hub knows it
+	var replay []*base.DataChanges
+	switch {
+	case snap == h.base:
+		replay = h.history
+	default:
+		found := false
+		for i, c := range h.history {
+			if c.GetSnapToken() == snap {
+				replay, found = h.history[i+1:], true
+				break
+			}
+		}
+		if !found {
+			return r.delegate.Watch(ctx, tenantID, snap)
+		}
+	}
+
+	s := &subscriber{
+		changes: make(chan *base.DataChanges, len(replay)+r.bufferSize),
+		errs:    make(chan error, 1),
+	}
+	for _, c := range replay {
+		s.changes <- c
+	}
+	h.subscribers[s] = struct{}{}
+
+	// Leave the hub once the watch is done, stopping it if it was the last watch.
+	go func() {
+		<-ctx.Done()
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if _, ok := h.subscribers[s]; !ok {
+			return
+		}
+		delete(h.subscribers, s)
+		s.close(nil)
+
+		if len(h.subscribers) == 0 && r.hubs[tenantID] == h {
+			h.cancel()
+			delete(r.hubs, tenantID)
+		}
+	}()
+
+	return s.changes, s.errs
+}
+
+// start starts the hub of the tenant reading the changes after the snapshot from the delegate.
+// It is called with the lock held.
+func (r *WatcherWithHub) start(tenantID, snap string) *hub {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &hub{
+		cancel:      cancel,
+		base:        snap,
+		subscribers: map[*subscriber]struct{}{},
+	}
+	r.hubs[tenantID] = h
+
+	changes, errs := r.delegate.Watch(ctx, tenantID, snap)
+
+	go func() {
+		for {
+			select {
+			case c, ok := <-changes:
+				if !ok {
+					changes = nil
+					continue
+				}
+				r.publish(h, c)
+			case err, ok := <-errs:
+				// The delegate ended, so do the watches of the hub, once they got the changes left if it
+				// ended without error.
+				if !ok && changes != nil {
+					for c := range changes {
+						r.publish(h, c)
+					}
+				}
+				r.stop(tenantID, h, err)
+				return
+			}
+		}
+	}()
+
+	return h
+}
+
+// publish adds the change to the history of the hub and sends it to its subscribers, ending the watches that
+// don't keep up with the changes.
+func (r *WatcherWithHub) publish(h *hub, c *base.DataChanges) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h.history = append(h.history, c)
+	if len(h.history) > r.historySize {
+		trimmed := len(h.history) - r.historySize
+		h.base = h.history[trimmed-1].GetSnapToken()
+		h.history = append([]*base.DataChanges(nil), h.history[trimmed:]...)
+	}
+
+	for s := range h.subscribers {
+		select {
+		case s.changes <- c:
+		default:
+			delete(h.subscribers, s)
+			s.close(ErrWatchSubscriberTooSlow)
+		}
+	}
+}
+
+// stop ends the watches of the hub with the error, if any, and removes it.
+func (r *WatcherWithHub) stop(tenantID string, h *hub, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h.cancel()
+	for s := range h.subscribers {
+		delete(h.subscribers, s)
+		s.close(err)
+	}
+	if r.hubs[tenantID] == h {
+		delete(r.hubs, tenantID)
+	}
+}
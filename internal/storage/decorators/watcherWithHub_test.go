@@ -0,0 +1,139 @@
+package decorators
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// feedWatcher records the tenants and snapshots watched, and feeds the watches the changes of the test.
+type feedWatcher struct {
+	mu      sync.Mutex
+	watches []string
+	changes chan *base.DataChanges
+	errs    chan error
+}
+
+func newFeedWatcher() *feedWatcher {
+	return &feedWatcher{changes: make(chan *base.DataChanges), errs: make(chan error, 1)}
+}
+
+func (w *feedWatcher) Watch(_ context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.watches = append(w.watches, tenantID+"@"+snap)
+	return w.changes, w.errs
+}
+
+func (w *feedWatcher) watched() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.watches...)
+}
+
+func receive(t *testing.T, changes <-chan *base.DataChanges) string {
+	select {
+	case c := <-changes:
+		return c.GetSnapToken()
+	case <-time.After(time.Second):
+		t.Fatal("no change was received")
+		return ""
+	}
+}
+
+func TestWatcherWithHub_SharesTheChangesOfTenants(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	delegate := newFeedWatcher()
+	watcher := NewWatcherWithHub(delegate, 2, 10)
+
+	first, _ := watcher.Watch(ctx, "t1", "0")
+	second, _ := watcher.Watch(ctx, "t1", "0")
+
+	delegate.changes <- &base.DataChanges{SnapToken: "1"}
+	assert.Equal(t, "1", receive(t, first))
+	assert.Equal(t, "1", receive(t, second))
+
+	// Watches starting from a change the hub read join it.
+	third, _ := watcher.Watch(ctx, "t1", "1")
+	delegate.changes <- &base.DataChanges{SnapToken: "2"}
+	assert.Equal(t, "2", receive(t, first))
+	assert.Equal(t, "2", receive(t, third))
+	assert.Equal(t, []string{"t1@0"}, delegate.watched())
+
+	// Watches starting from a change the hub read replay the changes after it.
+	fourth, _ := watcher.Watch(ctx, "t1", "1")
+	assert.Equal(t, "2", receive(t, fourth))
+	assert.Equal(t, []string{"t1@0"}, delegate.watched())
+}
+
+func TestWatcherWithHub_WatchesUnknownSnapshotsFromTheDelegate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	delegate := newFeedWatcher()
+	watcher := NewWatcherWithHub(delegate, 1, 10)
+
+	first, _ := watcher.Watch(ctx, "t1", "0")
+	delegate.changes <- &base.DataChanges{SnapToken: "1"}
+	delegate.changes <- &base.DataChanges{SnapToken: "2"}
+	assert.Equal(t, "1", receive(t, first))
+	assert.Equal(t, "2", receive(t, first))
+
+	// The change of snapshot 1 left the history, so the hub only serves the watches from snapshot 1 on.
+	watcher.Watch(ctx, "t1", "0")
+	watcher.Watch(ctx, "t1", "1")
+	watcher.Watch(ctx, "t2", "0")
+	assert.Equal(t, []string{"t1@0", "t1@0", "t2@0"}, delegate.watched())
+}
+
+func TestWatcherWithHub_EndsSlowWatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	delegate := newFeedWatcher()
+	watcher := NewWatcherWithHub(delegate, 10, 1)
+
+	slow, errs := watcher.Watch(ctx, "t1", "0")
+	delegate.changes <- &base.DataChanges{SnapToken: "1"}
+	delegate.changes <- &base.DataChanges{SnapToken: "2"}
+
+	select {
+	case err := <-errs:
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("the slow watch wasn't ended")
+	}
+	assert.Equal(t, "1", receive(t, slow))
+}
+
+func TestWatcherWithHub_EndsWatchesWithTheDelegate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	delegate := newFeedWatcher()
+	watcher := NewWatcherWithHub(delegate, 10, 10)
+
+	_, first := watcher.Watch(ctx, "t1", "0")
+	_, second := watcher.Watch(ctx, "t1", "0")
+
+	delegate.errs <- status.Error(codes.Internal, "failed")
+	for _, errs := range []<-chan error{first, second} {
+		err, ok := <-errs
+		require.True(t, ok)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	}
+
+	// The hub is removed along with the delegate, so the next watch starts a new one.
+	watcher.Watch(ctx, "t1", "0")
+	assert.Equal(t, []string{"t1@0", "t1@0"}, delegate.watched())
+}
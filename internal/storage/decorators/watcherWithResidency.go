@@ -0,0 +1,38 @@
+package decorators
+
+import (
+	"context"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// WatcherWithResidency - Route the watches of tenants kept in regions to the watchers of their regions
+type WatcherWithResidency struct {
+	delegate storage.Watcher
+	router   TenantRouter
+	regions  map[string]storage.Watcher
+}
+
+// NewWatcherWithResidency - Route the watches of tenants to the watchers of the regions the router resolves, and the
+// watches of the other tenants to the delegate
+func NewWatcherWithResidency(delegate storage.Watcher, router TenantRouter, regions map[string]storage.Watcher) *WatcherWithResidency {
+	return &WatcherWithResidency{delegate: delegate, router: router, regions: regions}
+}
+
+// Watch - Watches the changes of the tenant from the snapshot
+func (r *WatcherWithResidency) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	region, err := r.router.Route(ctx, tenantID)
+	if err != nil {
+		changes := make(chan *base.DataChanges)
+		errs := make(chan error, 1)
+		errs <- err
+		close(changes)
+		close(errs)
+		return changes, errs
+	}
+	if watcher, ok := r.regions[region]; ok {
+		return watcher.Watch(ctx, tenantID, snap)
+	}
+	return r.delegate.Watch(ctx, tenantID, snap)
+}
@@ -0,0 +1,13 @@
+package dynamodb
+
+const (
+	// _defaultMaxDataPerWrite keeps the writes in a single transaction, each tuple or attribute taking up to two of
+	// its items, the live item and the history copy of the item it replaces, and the head snapshot another one.
+	_defaultMaxDataPerWrite = (_maxTransactItems - 1) / 2
+	_defaultMaxRetries      = 10
+	_defaultWatchBufferSize = 100
+	// _maxTransactItems is the number of items DynamoDB accepts in a single transaction.
+	_maxTransactItems = 100
+	// _maxBatchGetItems is the number of keys DynamoDB accepts in a single batch get.
+	_maxBatchGetItems = 100
+)
@@ -0,0 +1,447 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage/dynamodb/snapshot"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// live is the snapshot that only live items, those that have not expired yet, are part of.
+const live = math.MaxUint64
+
+// DataReader - Structure for Data Reader
+type DataReader struct {
+	database *db.DynamoDB
+}
+
+// NewDataReader - Creates a new DataReader
+func NewDataReader(database *db.DynamoDB) *DataReader {
+	return &DataReader{
+		database: database,
+	}
+}
+
+// QueryRelationships reads relation tuples from the storage based on the given filter.
+func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	tuples, _, err := readTuples(ctx, r.database, tenantID, filter, st, nil, 0)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query relationships: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	collection := database.NewTupleCollection()
+	for _, t := range tuples {
+		collection.Add(t.Tuple.ToTuple())
+	}
+
+	return collection.CreateTupleIterator(), nil
+}
+
+// ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
+func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	start, err := decodeStartKey(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	tuples, keys, err := readTuples(ctx, r.database, tenantID, filter, st, start, int(pagination.PageSize())+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read relationships: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	if len(tuples) > int(pagination.PageSize()) {
+		tuples = tuples[:pagination.PageSize()]
+		ct = utils.NewContinuousToken(keys[pagination.PageSize()-1]).Encode()
+	}
+
+	collection = database.NewTupleCollection()
+	for _, t := range tuples {
+		collection.Add(t.Tuple.ToTuple())
+	}
+
+	return collection, ct, nil
+}
+
+// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
+func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-single-attribute")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	attributes, _, err := readAttributes(ctx, r.database, tenantID, filter, st, nil, 1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query single attribute: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	return attributes[0].Attribute.ToAttribute(), nil
+}
+
+// QueryAttributes reads multiple attributes from the storage based on the given filter.
+func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (it *database.AttributeIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	attributes, _, err := readAttributes(ctx, r.database, tenantID, filter, st, nil, 0)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query attributes: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	collection := database.NewAttributeCollection()
+	for _, a := range attributes {
+		collection.Add(a.Attribute.ToAttribute())
+	}
+
+	return collection.CreateAttributeIterator(), nil
+}
+
+// ReadAttributes reads multiple attributes from the storage based on the given filter and pagination.
+func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	start, err := decodeStartKey(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	attributes, keys, err := readAttributes(ctx, r.database, tenantID, filter, st, start, int(pagination.PageSize())+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read attributes: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	if len(attributes) > int(pagination.PageSize()) {
+		attributes = attributes[:pagination.PageSize()]
+		ct = utils.NewContinuousToken(keys[pagination.PageSize()-1]).Encode()
+	}
+
+	collection = database.NewAttributeCollection()
+	for _, a := range attributes {
+		collection.Add(a.Attribute.ToAttribute())
+	}
+
+	return collection, ct, nil
+}
+
+// QueryUniqueEntities reads the distinct IDs of the entities of the given type that have relation tuples or
+// attributes in the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-entities")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	after, err := decodeAfter(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	size := int(pagination.PageSize()) + 1
+
+	// Both partitions are sorted by entity ID, so the smallest IDs of their union are among the smallest IDs of each.
+	tupleIDs, err := uniqueValues(ctx, r.database, access{partition: utils.TuplePartition(tenantID, name), after: after}, st, size, func(item map[string]types.AttributeValue) (string, bool, error) {
+		t, err := utils.ItemToTuple(item)
+		return t.Tuple.EntityID, utils.Visible(t.CreatedAt, t.ExpiredAt, st), err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	attributeIDs, err := uniqueValues(ctx, r.database, access{partition: utils.AttributePartition(tenantID, name), after: after}, st, size, func(item map[string]types.AttributeValue) (string, bool, error) {
+		a, err := utils.ItemToAttribute(item)
+		return a.Attribute.EntityID, utils.Visible(a.CreatedAt, a.ExpiredAt, st), err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return paginateValues(append(tupleIDs, attributeIDs...), pagination)
+}
+
+// QueryUniqueSubjectReferences reads the distinct IDs of the subjects of the given type and relation that appear
+// in relation tuples of the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-subject-reference")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	after, err := decodeAfter(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	subjectIDs, err := uniqueValues(ctx, r.database, access{index: db.SubjectIndex, partition: utils.SubjectPartition(tenantID, subjectReference.GetType()), after: after}, st, int(pagination.PageSize())+1, func(item map[string]types.AttributeValue) (string, bool, error) {
+		t, err := utils.ItemToTuple(item)
+		return t.Tuple.SubjectID, t.Tuple.SubjectRelation == subjectReference.GetRelation() && utils.Visible(t.CreatedAt, t.ExpiredAt, st), err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return paginateValues(subjectIDs, pagination)
+}
+
+// ReadRelationshipHistory reads the creations and deletions of relation tuples matching the given filter, oldest first.
+func (r *DataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _, _ time.Time, _ database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+}
+
+// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant.
+func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "data-reader.head-snapshot")
+	defer span.End()
+
+	head, err := headSnapshot(ctx, r.database, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read head snapshot: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.Token{Value: head}, nil
+}
+
+// SnapshotAt returns the snapshot token that was current for the tenant at the given point in time.
+// Snapshots are timestamps, so every point in time maps to a snapshot directly.
+func (r *DataReader) SnapshotAt(_ context.Context, _ string, at time.Time) (token.SnapToken, error) {
+	return snapshot.NewToken(at), nil
+}
+
+// readTuples reads the relation tuples matching the filter that are part of the snapshot, starting after the
+// given key. It stops after limit tuples unless limit is zero, and returns the tuples together with the
+// encoded keys that reading can be resumed after.
+func readTuples(ctx context.Context, database *db.DynamoDB, tenantID string, filter *base.TupleFilter, snap uint64, start map[string]types.AttributeValue, limit int) (tuples []utils.TupleItem, keys []string, err error) {
+	a := tupleAccess(tenantID, filter)
+	err = iterate(ctx, database, a, start, func(item map[string]types.AttributeValue) (bool, error) {
+		t, err := utils.ItemToTuple(item)
+		if err != nil {
+			return false, err
+		}
+		if t.Tuple.TenantID != tenantID || !utils.Visible(t.CreatedAt, t.ExpiredAt, snap) || !utils.MatchTuple(filter, t.Tuple) {
+			return true, nil
+		}
+		tuples = append(tuples, t)
+		keys = append(keys, encodeKey(item, a.keyNames()))
+		return limit == 0 || len(tuples) < limit, nil
+	})
+	return tuples, keys, err
+}
+
+// readAttributes reads the attributes matching the filter that are part of the snapshot, like readTuples.
+func readAttributes(ctx context.Context, database *db.DynamoDB, tenantID string, filter *base.AttributeFilter, snap uint64, start map[string]types.AttributeValue, limit int) (attributes []utils.AttributeItem, keys []string, err error) {
+	a := attributeAccess(tenantID, filter)
+	err = iterate(ctx, database, a, start, func(item map[string]types.AttributeValue) (bool, error) {
+		at, err := utils.ItemToAttribute(item)
+		if err != nil {
+			return false, err
+		}
+		if at.Attribute.TenantID != tenantID || !utils.Visible(at.CreatedAt, at.ExpiredAt, snap) || !utils.MatchAttribute(filter, at.Attribute) {
+			return true, nil
+		}
+		attributes = append(attributes, at)
+		keys = append(keys, encodeKey(item, a.keyNames()))
+		return limit == 0 || len(attributes) < limit, nil
+	})
+	return attributes, keys, err
+}
+
+// uniqueValues reads the distinct values extracted from the items of the access, in sort key order, until
+// limit values have been found. The extract function reports whether an item is to be counted.
+func uniqueValues(ctx context.Context, database *db.DynamoDB, a access, snap uint64, limit int, extract func(item map[string]types.AttributeValue) (string, bool, error)) (values []string, err error) {
+	err = iterate(ctx, database, a, nil, func(item map[string]types.AttributeValue) (bool, error) {
+		value, ok, err := extract(item)
+		if err != nil {
+			return false, err
+		}
+		if !ok || (len(values) > 0 && values[len(values)-1] == value) {
+			return true, nil
+		}
+		values = append(values, value)
+		return len(values) < limit, nil
+	})
+	return values, err
+}
+
+// paginateValues sorts and deduplicates the values and cuts them to the page size of the pagination.
+func paginateValues(values []string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	sort.Strings(values)
+
+	ids := make([]string, 0, len(values))
+	for _, v := range values {
+		if len(ids) == 0 || ids[len(ids)-1] != v {
+			ids = append(ids, v)
+		}
+	}
+
+	if len(ids) > int(pagination.PageSize()) {
+		ids = ids[:pagination.PageSize()]
+		return ids, utils.NewContinuousToken(ids[len(ids)-1]).Encode(), nil
+	}
+
+	return ids, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// headSnapshot reads the head snapshot of the tenant, zero if nothing has been written yet.
+func headSnapshot(ctx context.Context, database *db.DynamoDB, tenantID string) (uint64, error) {
+	out, err := database.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(database.Table),
+		Key: map[string]types.AttributeValue{
+			db.PartitionKey: utils.S(utils.HeadPartition(tenantID)),
+			db.SortKey:      utils.S(utils.HeadKey),
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return utils.GetN(out.Item, utils.AttrSnapshot)
+}
+
+// decodeSnapshot decodes an encoded snapshot token to its timestamp.
+func decodeSnapshot(snap string) (uint64, error) {
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return 0, err
+	}
+	return st.(snapshot.Token).Value, nil
+}
+
+// decodeStartKey decodes the continuous token of the pagination to the key that reading resumes after.
+func decodeStartKey(pagination database.Pagination) (map[string]types.AttributeValue, error) {
+	if pagination.Token() == "" {
+		return nil, nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+	if err != nil {
+		return nil, err
+	}
+	key, err := decodeKey(t.(utils.ContinuousToken).Value)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return key, nil
+}
+
+// decodeAfter decodes the continuous token of the pagination to the sort key that reading resumes after.
+func decodeAfter(pagination database.Pagination) (string, error) {
+	if pagination.Token() == "" {
+		return "", nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+	if err != nil {
+		return "", err
+	}
+	return utils.After(t.(utils.ContinuousToken).Value), nil
+}
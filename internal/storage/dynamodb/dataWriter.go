@@ -0,0 +1,536 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/snapshot"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// errTooManyItems is returned for the writes that don't fit in a single DynamoDB transaction.
+var errTooManyItems = fmt.Errorf("write touches more than the %d items of a dynamodb transaction, split it into smaller writes", _maxTransactItems)
+
+// DataWriter - Structure for Data Writer
+//
+// Every write is stamped with a timestamp that becomes the head snapshot of the tenant. Items replaced or
+// deleted by a write are kept as history copies expiring at that timestamp, so that reads at earlier
+// snapshots are not affected. Every write is stored in a single transaction along with the move of the head
+// snapshot, which is conditioned on the head the write started from, so that concurrent writers are serialized
+// and a write is either stored whole or not at all.
+type DataWriter struct {
+	database *db.DynamoDB
+	// options
+	maxDataPerWrite int
+	maxRetries      int
+}
+
+// NewDataWriter - Creates a new DataWriter
+func NewDataWriter(database *db.DynamoDB) *DataWriter {
+	return &DataWriter{
+		database:        database,
+		maxDataPerWrite: _defaultMaxDataPerWrite,
+		maxRetries:      _defaultMaxRetries,
+	}
+}
+
+// Write writes relation tuples and attributes to the storage.
+func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.write")
+	defer span.End()
+
+	if len(tupleCollection.GetTuples())+len(attributeCollection.GetAttributes()) > w.maxDataPerWrite {
+		return nil, fmt.Errorf("max data per write exceeded, dynamodb writes are limited to %d tuples and attributes", w.maxDataPerWrite)
+	}
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var head, ts uint64
+		head, ts, err = w.next(ctx, tenantID)
+		if err != nil {
+			break
+		}
+
+		var ops []types.TransactWriteItem
+		ops, err = w.writeOperations(ctx, tenantID, tupleCollection, attributeCollection, ts)
+		if err != nil {
+			break
+		}
+
+		err = w.commit(ctx, tenantID, head, ts, ops)
+		if errors.Is(err, errTooManyItems) {
+			return nil, err
+		}
+		if isConflict(err) {
+			continue
+		}
+		if err != nil {
+			break
+		}
+
+		return snapshot.Token{Value: ts}.Encode(), nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to write data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// Delete deletes relation tuples and attributes matching the given filters from the storage.
+func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var head, ts uint64
+		head, ts, err = w.next(ctx, tenantID)
+		if err != nil {
+			break
+		}
+
+		var ops []types.TransactWriteItem
+		ops, err = w.deleteOperations(ctx, tenantID, tupleFilter, attributeFilter, ts)
+		if err != nil {
+			break
+		}
+
+		err = w.commit(ctx, tenantID, head, ts, ops)
+		if errors.Is(err, errTooManyItems) {
+			return nil, err
+		}
+		if isConflict(err) {
+			continue
+		}
+		if err != nil {
+			break
+		}
+
+		return snapshot.Token{Value: ts}.Encode(), nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// RunTransaction deletes and then writes the relation tuples and attributes of the transaction under a single
+// timestamp.
+func (w *DataWriter) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.run-transaction")
+	defer span.End()
+
+	if len(transaction.Tuples.GetTuples())+len(transaction.Attributes.GetAttributes()) > w.maxDataPerWrite {
+		return nil, fmt.Errorf("max data per write exceeded, dynamodb writes are limited to %d tuples and attributes", w.maxDataPerWrite)
+	}
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var head, ts uint64
+		head, ts, err = w.next(ctx, tenantID)
+		if err != nil {
+			break
+		}
+
+		var ops []types.TransactWriteItem
+		ops, err = w.transactionOperations(ctx, tenantID, transaction, ts)
+		if err != nil {
+			break
+		}
+
+		err = w.commit(ctx, tenantID, head, ts, ops)
+		if errors.Is(err, errTooManyItems) {
+			return nil, err
+		}
+		if isConflict(err) {
+			continue
+		}
+		if err != nil {
+			break
+		}
+
+		return snapshot.Token{Value: ts}.Encode(), nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to run data transaction: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// MigrateSchema writes the definitions of a schema version along with the deletions and writes of the transaction
+// migrating the data to it. The definitions are written along with the move of the head snapshot, so that the
+// version is not read before the data matching it.
+func (w *DataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.migrate-schema")
+	defer span.End()
+
+	puts := make([]types.TransactWriteItem, 0, len(definitions))
+	for _, d := range definitions {
+		puts = append(puts, types.TransactWriteItem{Put: &types.Put{
+			TableName: aws.String(w.database.Table),
+			Item:      utils.SchemaDefinitionToItem(d),
+		}})
+	}
+
+	for i := 0; i <= w.maxRetries; i++ {
+		var head, ts uint64
+		head, ts, err = w.next(ctx, tenantID)
+		if err != nil {
+			break
+		}
+
+		// The latest version is read after the head snapshot, so that the migrations writing a version meanwhile
+		// move the head and fail the commit of this one, which reads the version again when it is retried.
+		if transaction.SchemaVersion != nil {
+			var version string
+			version, err = headVersion(ctx, w.database, tenantID)
+			if err != nil {
+				break
+			}
+			if version != *transaction.SchemaVersion {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String())
+			}
+		}
+
+		var ops []types.TransactWriteItem
+		ops, err = w.transactionOperations(ctx, tenantID, transaction, ts)
+		if err != nil {
+			break
+		}
+
+		err = w.commit(ctx, tenantID, head, ts, ops, puts...)
+		if errors.Is(err, errTooManyItems) {
+			return nil, err
+		}
+		if isConflict(err) {
+			continue
+		}
+		if err != nil {
+			break
+		}
+
+		return snapshot.Token{Value: ts}.Encode(), nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to migrate schema: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// next reads the head snapshot of the tenant and picks the timestamp of the next write, which is the
+// current time unless the clock is behind the head.
+func (w *DataWriter) next(ctx context.Context, tenantID string) (head, ts uint64, err error) {
+	head, err = headSnapshot(ctx, w.database, tenantID)
+	if err != nil {
+		return 0, 0, err
+	}
+	ts = uint64(time.Now().UnixNano())
+	if ts <= head {
+		ts = head + 1
+	}
+	return head, ts, nil
+}
+
+// writeOperations builds the operations that store the tuples and attributes as live items created at ts,
+// turning the live items they replace into history copies.
+func (w *DataWriter) writeOperations(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection, ts uint64) ([]types.TransactWriteItem, error) {
+	items := map[string]map[string]types.AttributeValue{}
+	var order []string
+
+	titer := tupleCollection.CreateTupleIterator()
+	for titer.HasNext() {
+		t := titer.GetNext()
+		srelation := t.GetSubject().GetRelation()
+		if srelation == tuple.ELLIPSIS {
+			srelation = ""
+		}
+		item := utils.TupleToItem(utils.TupleItem{
+			Tuple: storage.RelationTuple{
+				TenantID:        tenantID,
+				EntityType:      t.GetEntity().GetType(),
+				EntityID:        t.GetEntity().GetId(),
+				Relation:        t.GetRelation(),
+				SubjectType:     t.GetSubject().GetType(),
+				SubjectID:       t.GetSubject().GetId(),
+				SubjectRelation: srelation,
+			},
+			CreatedAt: ts,
+		})
+		order = addItem(items, order, item)
+	}
+
+	aiter := attributeCollection.CreateAttributeIterator()
+	for aiter.HasNext() {
+		a := aiter.GetNext()
+		item, err := utils.AttributeToItem(utils.AttributeItem{
+			Attribute: storage.Attribute{
+				TenantID:   tenantID,
+				EntityType: a.GetEntity().GetType(),
+				EntityID:   a.GetEntity().GetId(),
+				Attribute:  a.GetAttribute(),
+				Value:      a.GetValue(),
+			},
+			CreatedAt: ts,
+		})
+		if err != nil {
+			return nil, err
+		}
+		order = addItem(items, order, item)
+	}
+
+	keys := make([]map[string]types.AttributeValue, 0, len(order))
+	for _, k := range order {
+		keys = append(keys, keyOf(items[k]))
+	}
+
+	existing, err := w.batchGet(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make([]types.TransactWriteItem, 0, len(order)+len(existing))
+	for _, old := range existing {
+		h, err := historyItem(old, ts)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, types.TransactWriteItem{Put: &types.Put{TableName: aws.String(w.database.Table), Item: h}})
+	}
+	for _, k := range order {
+		ops = append(ops, types.TransactWriteItem{Put: &types.Put{TableName: aws.String(w.database.Table), Item: items[k]}})
+	}
+
+	return ops, nil
+}
+
+// deleteOperations builds the operations that turn the live items matching the filters into history copies
+// expiring at ts.
+func (w *DataWriter) deleteOperations(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter, ts uint64) ([]types.TransactWriteItem, error) {
+	var ops []types.TransactWriteItem
+
+	if !validation.IsTupleFilterEmpty(tupleFilter) {
+		tuples, _, err := readTuples(ctx, w.database, tenantID, tupleFilter, live, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tuples {
+			item := utils.TupleToItem(t)
+			t.ExpiredAt = ts
+			ops = append(ops,
+				types.TransactWriteItem{Delete: &types.Delete{TableName: aws.String(w.database.Table), Key: keyOf(item)}},
+				types.TransactWriteItem{Put: &types.Put{TableName: aws.String(w.database.Table), Item: utils.TupleToItem(t)}},
+			)
+		}
+	}
+
+	if !validation.IsAttributeFilterEmpty(attributeFilter) {
+		attributes, _, err := readAttributes(ctx, w.database, tenantID, attributeFilter, live, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range attributes {
+			item, err := utils.AttributeToItem(a)
+			if err != nil {
+				return nil, err
+			}
+			a.ExpiredAt = ts
+			h, err := utils.AttributeToItem(a)
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops,
+				types.TransactWriteItem{Delete: &types.Delete{TableName: aws.String(w.database.Table), Key: keyOf(item)}},
+				types.TransactWriteItem{Put: &types.Put{TableName: aws.String(w.database.Table), Item: h}},
+			)
+		}
+	}
+
+	return ops, nil
+}
+
+// transactionOperations builds the operations of the deletions and the writes of the transaction. A transaction can
+// only touch an item once, so live items matching several deletions are deleted once, and the deletions of the
+// live items the writes replace are left to the writes, which turn them into history copies as well.
+func (w *DataWriter) transactionOperations(ctx context.Context, tenantID string, transaction *database.Transaction, ts uint64) ([]types.TransactWriteItem, error) {
+	writes, err := w.writeOperations(ctx, tenantID, transaction.Tuples, transaction.Attributes, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	touched := map[string]struct{}{}
+	for _, op := range writes {
+		touched[utils.Join(utils.GetS(op.Put.Item, db.PartitionKey), utils.GetS(op.Put.Item, db.SortKey))] = struct{}{}
+	}
+
+	var ops []types.TransactWriteItem
+	for i := range transaction.TupleFilters {
+		deletions, err := w.deleteOperations(ctx, tenantID, transaction.TupleFilters[i], transaction.AttributeFilters[i], ts)
+		if err != nil {
+			return nil, err
+		}
+		// Deletions come in pairs, the deletion of the live item followed by its history copy
+		for j := 0; j+1 < len(deletions); j += 2 {
+			k := utils.Join(utils.GetS(deletions[j].Delete.Key, db.PartitionKey), utils.GetS(deletions[j].Delete.Key, db.SortKey))
+			if _, ok := touched[k]; ok {
+				continue
+			}
+			touched[k] = struct{}{}
+			ops = append(ops, deletions[j], deletions[j+1])
+		}
+	}
+
+	return append(ops, writes...), nil
+}
+
+// commit runs the operations, followed by the final ones, in a single transaction moving the head snapshot of the
+// tenant from head to ts. Operations that don't fit in it along with the move of the head are rejected with
+// errTooManyItems.
+func (w *DataWriter) commit(ctx context.Context, tenantID string, head, ts uint64, ops []types.TransactWriteItem, final ...types.TransactWriteItem) error {
+	if len(ops)+len(final)+1 > _maxTransactItems {
+		return errTooManyItems
+	}
+
+	items := make([]types.TransactWriteItem, 0, len(ops)+len(final)+1)
+	items = append(items, ops...)
+	items = append(items, final...)
+	items = append(items, types.TransactWriteItem{Update: &types.Update{
+		TableName: aws.String(w.database.Table),
+		Key: map[string]types.AttributeValue{
+			db.PartitionKey: utils.S(utils.HeadPartition(tenantID)),
+			db.SortKey:      utils.S(utils.HeadKey),
+		},
+		UpdateExpression:    aws.String("SET #snap = :ts, #kind = :kind, #tid = :tid"),
+		ConditionExpression: aws.String("attribute_not_exists(#snap) OR #snap = :head"),
+		ExpressionAttributeNames: map[string]string{
+			"#snap": utils.AttrSnapshot,
+			"#kind": utils.AttrKind,
+			"#tid":  utils.AttrTenantID,
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":ts":   utils.N(ts),
+			":head": utils.N(head),
+			":kind": utils.S(utils.KindHead),
+			":tid":  utils.S(tenantID),
+		},
+	}})
+
+	_, err := w.database.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: items})
+	return err
+}
+
+// batchGet reads the items with the given keys, skipping the keys that have no item.
+func (w *DataWriter) batchGet(ctx context.Context, keys []map[string]types.AttributeValue) ([]map[string]types.AttributeValue, error) {
+	var items []map[string]types.AttributeValue
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > _maxBatchGetItems {
+			n = _maxBatchGetItems
+		}
+		request := map[string]types.KeysAndAttributes{
+			w.database.Table: {Keys: keys[:n], ConsistentRead: aws.Bool(true)},
+		}
+		keys = keys[n:]
+
+		for len(request) > 0 {
+			out, err := w.database.Client.BatchGetItem(ctx, &dynamodb.BatchGetItemInput{RequestItems: request})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, out.Responses[w.database.Table]...)
+			request = out.UnprocessedKeys
+		}
+	}
+	return items, nil
+}
+
+// historyItem converts a live item to its history copy expiring at ts.
+func historyItem(item map[string]types.AttributeValue, ts uint64) (map[string]types.AttributeValue, error) {
+	if utils.GetS(item, utils.AttrKind) == utils.KindAttribute {
+		a, err := utils.ItemToAttribute(item)
+		if err != nil {
+			return nil, err
+		}
+		a.ExpiredAt = ts
+		return utils.AttributeToItem(a)
+	}
+	t, err := utils.ItemToTuple(item)
+	if err != nil {
+		return nil, err
+	}
+	t.ExpiredAt = ts
+	return utils.TupleToItem(t), nil
+}
+
+// addItem adds the item to the set of items keyed by its primary key, replacing an earlier item with the
+// same key, and returns the keys in the order they were first added.
+func addItem(items map[string]map[string]types.AttributeValue, order []string, item map[string]types.AttributeValue) []string {
+	k := utils.Join(utils.GetS(item, db.PartitionKey), utils.GetS(item, db.SortKey))
+	if _, ok := items[k]; !ok {
+		order = append(order, k)
+	}
+	items[k] = item
+	return order
+}
+
+// keyOf returns the primary key of the item.
+func keyOf(item map[string]types.AttributeValue) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		db.PartitionKey: item[db.PartitionKey],
+		db.SortKey:      item[db.SortKey],
+	}
+}
+
+// isConflict reports whether a transaction was cancelled because another writer moved the head snapshot
+// or touched the same items concurrently, in which case the write can be retried.
+func isConflict(err error) bool {
+	var canceled *types.TransactionCanceledException
+	if !errors.As(err, &canceled) {
+		return false
+	}
+	for _, reason := range canceled.CancellationReasons {
+		if code := aws.ToString(reason.Code); code == "ConditionalCheckFailed" || code == "TransactionConflict" {
+			return true
+		}
+	}
+	return false
+}
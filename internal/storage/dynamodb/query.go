@@ -0,0 +1,172 @@
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// access describes how a set of items is read from the table: a query over one partition of the table or of
+// the subject index, optionally narrowed by a sort key condition, or a scan over every partition with a prefix.
+type access struct {
+	// index is the name of the secondary index to read, empty for the table itself.
+	index string
+	// partition is the partition key to query. When it is empty the table is scanned for partitions starting with prefix.
+	partition string
+	// prefix narrows the sort keys of a query, or the partition keys of a scan, to those starting with it.
+	prefix string
+	// after narrows the sort keys of a query to those sorting after it.
+	after string
+}
+
+// keyNames returns the names of the key attributes that identify a position when reading through the access.
+func (a access) keyNames() []string {
+	if a.index == db.SubjectIndex {
+		return []string{db.PartitionKey, db.SortKey, db.SubjectPartitionKey, db.SubjectSortKey}
+	}
+	return []string{db.PartitionKey, db.SortKey}
+}
+
+// tupleAccess picks the cheapest access for the relation tuples matching the filter. The remaining
+// conditions of the filter are applied to the items that are read.
+func tupleAccess(tenantID string, filter *base.TupleFilter) access {
+	switch {
+	case filter.GetEntity().GetType() != "":
+		a := access{partition: utils.TuplePartition(tenantID, filter.GetEntity().GetType())}
+		if len(filter.GetEntity().GetIds()) == 1 {
+			a.prefix = utils.Join(filter.GetEntity().GetIds()[0], "")
+			if filter.GetRelation() != "" {
+				a.prefix = utils.Join(filter.GetEntity().GetIds()[0], filter.GetRelation(), "")
+			}
+		}
+		return a
+	case filter.GetSubject().GetType() != "":
+		a := access{index: db.SubjectIndex, partition: utils.SubjectPartition(tenantID, filter.GetSubject().GetType())}
+		if len(filter.GetSubject().GetIds()) == 1 {
+			a.prefix = utils.Join(filter.GetSubject().GetIds()[0], "")
+			if filter.GetSubject().GetRelation() != "" {
+				a.prefix = utils.Join(filter.GetSubject().GetIds()[0], filter.GetSubject().GetRelation(), "")
+			}
+		}
+		return a
+	default:
+		return access{prefix: utils.TuplePartition(tenantID, "")}
+	}
+}
+
+// attributeAccess picks the cheapest access for the attributes matching the filter.
+func attributeAccess(tenantID string, filter *base.AttributeFilter) access {
+	if filter.GetEntity().GetType() == "" {
+		return access{prefix: utils.AttributePartition(tenantID, "")}
+	}
+	a := access{partition: utils.AttributePartition(tenantID, filter.GetEntity().GetType())}
+	if len(filter.GetEntity().GetIds()) == 1 {
+		a.prefix = utils.Join(filter.GetEntity().GetIds()[0], "")
+	}
+	return a
+}
+
+// iterate reads the items of the access page by page, starting after the given key, and passes them to fn
+// in sort key order until fn returns false or the items are exhausted.
+func iterate(ctx context.Context, database *db.DynamoDB, a access, start map[string]types.AttributeValue, fn func(item map[string]types.AttributeValue) (bool, error)) error {
+	for {
+		var items []map[string]types.AttributeValue
+		var last map[string]types.AttributeValue
+
+		if a.partition == "" {
+			out, err := database.Client.Scan(ctx, &dynamodb.ScanInput{
+				TableName:                 aws.String(database.Table),
+				FilterExpression:          aws.String("begins_with(#pk, :prefix)"),
+				ExpressionAttributeNames:  map[string]string{"#pk": db.PartitionKey},
+				ExpressionAttributeValues: map[string]types.AttributeValue{":prefix": utils.S(a.prefix)},
+				ExclusiveStartKey:         start,
+				ConsistentRead:            aws.Bool(true),
+			})
+			if err != nil {
+				return err
+			}
+			items, last = out.Items, out.LastEvaluatedKey
+		} else {
+			names := map[string]string{"#pk": db.PartitionKey}
+			values := map[string]types.AttributeValue{":pk": utils.S(a.partition)}
+			condition := "#pk = :pk"
+
+			sk := db.SortKey
+			if a.index == db.SubjectIndex {
+				names["#pk"], sk = db.SubjectPartitionKey, db.SubjectSortKey
+			}
+			switch {
+			case a.after != "":
+				names["#sk"], values[":sk"] = sk, utils.S(a.after)
+				condition += " AND #sk > :sk"
+			case a.prefix != "":
+				names["#sk"], values[":sk"] = sk, utils.S(a.prefix)
+				condition += " AND begins_with(#sk, :sk)"
+			}
+
+			input := &dynamodb.QueryInput{
+				TableName:                 aws.String(database.Table),
+				KeyConditionExpression:    aws.String(condition),
+				ExpressionAttributeNames:  names,
+				ExpressionAttributeValues: values,
+				ExclusiveStartKey:         start,
+			}
+			if a.index != "" {
+				input.IndexName = aws.String(a.index)
+			} else {
+				input.ConsistentRead = aws.Bool(true)
+			}
+
+			out, err := database.Client.Query(ctx, input)
+			if err != nil {
+				return err
+			}
+			items, last = out.Items, out.LastEvaluatedKey
+		}
+
+		for _, item := range items {
+			next, err := fn(item)
+			if err != nil {
+				return err
+			}
+			if !next {
+				return nil
+			}
+		}
+
+		if len(last) == 0 {
+			return nil
+		}
+		start = last
+	}
+}
+
+// encodeKey serializes the key attributes of an item so that reading can be resumed after it.
+func encodeKey(item map[string]types.AttributeValue, names []string) string {
+	key := make(map[string]string, len(names))
+	for _, name := range names {
+		key[name] = utils.GetS(item, name)
+	}
+	b, _ := json.Marshal(key)
+	return string(b)
+}
+
+// decodeKey parses a key serialized by encodeKey.
+func decodeKey(value string) (map[string]types.AttributeValue, error) {
+	var key map[string]string
+	if err := json.Unmarshal([]byte(value), &key); err != nil {
+		return nil, err
+	}
+	item := make(map[string]types.AttributeValue, len(key))
+	for name, v := range key {
+		item[name] = utils.S(v)
+	}
+	return item, nil
+}
@@ -0,0 +1,218 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReader - Structure for Schema Reader
+type SchemaReader struct {
+	database *db.DynamoDB
+	// versions are the schema versions compiled to read the definitions of their entities
+	versions *storage.CompiledVersions
+}
+
+// NewSchemaReader - Creates a new SchemaReader
+func NewSchemaReader(database *db.DynamoDB) *SchemaReader {
+	return &SchemaReader{
+		database: database,
+		versions: storage.NewCompiledVersions(),
+	}
+}
+
+// ReadSchema returns the schema definition for a specific tenant and version as a structured object.
+func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string) (sch *base.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema")
+	defer span.End()
+
+	definitions, err := r.ReadSchemaDefinitions(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	serialized := make([]string, 0, len(definitions))
+	for _, d := range definitions {
+		serialized = append(serialized, d.Serialized())
+	}
+
+	sch, err = schema.NewSchemaFromStringDefinitions(false, serialized...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return sch, nil
+}
+
+// ReadEntityDefinition reads entity config from the storage.
+func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name, version string) (definition *base.EntityDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-entity-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = r.versions.Entity(tenantID, def.Version, name, func() (*base.SchemaDefinition, error) {
+		return r.ReadSchema(ctx, tenantID, def.Version)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+	return definition, def.Version, nil
+}
+
+// ReadRuleDefinition reads rule config from the storage.
+func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, name, version string) (definition *base.RuleDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-rule-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	var sch *base.SchemaDefinition
+	sch, err = schema.NewSchemaFromStringDefinitions(false, def.Serialized())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = schema.GetRuleByName(sch, name)
+	return definition, def.Version, err
+}
+
+// HeadVersion finds the latest version of the schema for the tenant. Versions sort by creation time.
+func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.head-version")
+	defer span.End()
+
+	version, err = headVersion(ctx, r.database, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read head version: ", slog.Any("error", err))
+
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if version == "" {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+
+	return version, nil
+}
+
+// headVersion reads the latest schema version of the tenant, empty if the tenant has no schema.
+func headVersion(ctx context.Context, database *db.DynamoDB, tenantID string) (string, error) {
+	out, err := database.Client.Query(ctx, &dynamodb.QueryInput{
+		TableName:                 aws.String(database.Table),
+		KeyConditionExpression:    aws.String("#pk = :pk"),
+		ExpressionAttributeNames:  map[string]string{"#pk": db.PartitionKey},
+		ExpressionAttributeValues: map[string]types.AttributeValue{":pk": utils.S(utils.SchemaPartition(tenantID))},
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int32(1),
+		ConsistentRead:            aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(out.Items) == 0 {
+		return "", nil
+	}
+
+	return utils.GetS(out.Items[0], utils.AttrVersion), nil
+}
+
+// ReadSchemaDefinitions reads the serialized definitions of a schema version, ordered by name.
+func (r *SchemaReader) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-definitions")
+	defer span.End()
+
+	a := access{partition: utils.SchemaPartition(tenantID), prefix: utils.SchemaKey(version, "")}
+	err = iterate(ctx, r.database, a, nil, func(item map[string]types.AttributeValue) (bool, error) {
+		definitions = append(definitions, utils.ItemToSchemaDefinition(item))
+		return true, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema definitions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return definitions, nil
+}
+
+// ReadSchemaVersions reads the versions of the schema of the tenant, oldest first. The definitions of the schema
+// partition sort by version, then by name.
+func (r *SchemaReader) ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-versions")
+	defer span.End()
+
+	a := access{partition: utils.SchemaPartition(tenantID)}
+	err = iterate(ctx, r.database, a, nil, func(item map[string]types.AttributeValue) (bool, error) {
+		version := utils.GetS(item, utils.AttrVersion)
+		if len(versions) == 0 || versions[len(versions)-1] != version {
+			versions = append(versions, version)
+		}
+		return true, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema versions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return versions, nil
+}
+
+// readDefinition reads a single serialized definition of a schema version.
+func (r *SchemaReader) readDefinition(ctx context.Context, tenantID, name, version string) (storage.SchemaDefinition, error) {
+	out, err := r.database.Client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(r.database.Table),
+		Key: map[string]types.AttributeValue{
+			db.PartitionKey: utils.S(utils.SchemaPartition(tenantID)),
+			db.SortKey:      utils.S(utils.SchemaKey(version, name)),
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if len(out.Item) == 0 {
+		return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+	return utils.ItemToSchemaDefinition(out.Item), nil
+}
@@ -0,0 +1,61 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaWriter - Structure for Schema Writer
+type SchemaWriter struct {
+	database *db.DynamoDB
+}
+
+// NewSchemaWriter creates a new SchemaWriter
+func NewSchemaWriter(database *db.DynamoDB) *SchemaWriter {
+	return &SchemaWriter{
+		database: database,
+	}
+}
+
+// WriteSchema writes the definitions of a schema version to the storage.
+func (w *SchemaWriter) WriteSchema(ctx context.Context, definitions []storage.SchemaDefinition) (err error) {
+	ctx, span := tracer.Start(ctx, "schema-writer.write-schema")
+	defer span.End()
+
+	ops := make([]types.TransactWriteItem, 0, len(definitions))
+	for _, d := range definitions {
+		ops = append(ops, types.TransactWriteItem{Put: &types.Put{
+			TableName: aws.String(w.database.Table),
+			Item:      utils.SchemaDefinitionToItem(d),
+		}})
+	}
+
+	for len(ops) > 0 {
+		n := len(ops)
+		if n > _maxTransactItems {
+			n = _maxTransactItems
+		}
+		if _, err = w.database.Client.TransactWriteItems(ctx, &dynamodb.TransactWriteItemsInput{TransactItems: ops[:n]}); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Failed to write schema: ", slog.Any("error", err))
+
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		ops = ops[n:]
+	}
+
+	return nil
+}
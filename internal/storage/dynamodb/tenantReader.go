@@ -0,0 +1,78 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantReader - Structure for Tenant Reader
+type TenantReader struct {
+	database *db.DynamoDB
+}
+
+// NewTenantReader creates a new TenantReader
+func NewTenantReader(database *db.DynamoDB) *TenantReader {
+	return &TenantReader{
+		database: database,
+	}
+}
+
+// ListTenants reads the tenants ordered by ID, a page at a time.
+func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-reader.list-tenants")
+	defer span.End()
+
+	var after string
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		after = t.(utils.ContinuousToken).Value
+	}
+
+	tenants = make([]*base.Tenant, 0, pagination.PageSize()+1)
+	err = iterate(ctx, r.database, access{partition: utils.TenantsPartition, after: after}, nil, func(item map[string]types.AttributeValue) (bool, error) {
+		tenants = append(tenants, itemToTenant(item).ToTenant())
+		return len(tenants) <= int(pagination.PageSize()), nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to list tenants: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if len(tenants) > int(pagination.PageSize()) {
+		tenants = tenants[:pagination.PageSize()]
+		return tenants, utils.NewContinuousToken(tenants[len(tenants)-1].GetId()).Encode(), nil
+	}
+
+	return tenants, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// itemToTenant converts a dynamodb item to a tenant.
+func itemToTenant(item map[string]types.AttributeValue) storage.Tenant {
+	createdAt, _ := utils.GetN(item, utils.AttrCreatedAt)
+	return storage.Tenant{
+		ID:        utils.GetS(item, db.SortKey),
+		Name:      utils.GetS(item, utils.AttrName),
+		CreatedAt: time.Unix(0, int64(createdAt)),
+	}
+}
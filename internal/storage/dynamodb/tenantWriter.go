@@ -0,0 +1,100 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantWriter - Structure for Tenant Writer
+type TenantWriter struct {
+	database *db.DynamoDB
+}
+
+// NewTenantWriter creates a new TenantWriter
+func NewTenantWriter(database *db.DynamoDB) *TenantWriter {
+	return &TenantWriter{
+		database: database,
+	}
+}
+
+// CreateTenant creates a tenant, failing if a tenant with the same ID exists.
+func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.create-tenant")
+	defer span.End()
+
+	tenant := storage.Tenant{
+		ID:        id,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+
+	_, err = w.database.Client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(w.database.Table),
+		Item: map[string]types.AttributeValue{
+			db.PartitionKey:     utils.S(utils.TenantsPartition),
+			db.SortKey:          utils.S(id),
+			utils.AttrKind:      utils.S(utils.KindTenant),
+			utils.AttrName:      utils.S(name),
+			utils.AttrCreatedAt: utils.N(uint64(tenant.CreatedAt.UnixNano())),
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#pk)"),
+		ExpressionAttributeNames: map[string]string{"#pk": db.PartitionKey},
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		var exists *types.ConditionalCheckFailedException
+		if errors.As(err, &exists) {
+			slog.Error("Duplicate key violation: Tenant with ID already exists", slog.Any("id", id))
+
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+		}
+
+		slog.Error("Failed to create tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return tenant.ToTenant(), nil
+}
+
+// DeleteTenant deletes a tenant and returns it.
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+
+	out, err := w.database.Client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(w.database.Table),
+		Key: map[string]types.AttributeValue{
+			db.PartitionKey: utils.S(utils.TenantsPartition),
+			db.SortKey:      utils.S(tenantID),
+		},
+		ReturnValues: types.ReturnValueAllOld,
+	})
+	if err != nil || len(out.Attributes) == 0 {
+		if err == nil {
+			err = errors.New(base.ErrorCode_ERROR_CODE_NOT_FOUND.String())
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return itemToTenant(out.Attributes).ToTenant(), nil
+}
@@ -0,0 +1,7 @@
+package dynamodb
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("storage.dynamodb")
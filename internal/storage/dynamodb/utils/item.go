@@ -0,0 +1,335 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	streamTypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/storage"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Item kinds, stored in the kind attribute so that stream records can be told apart.
+const (
+	KindTuple     = "tuple"
+	KindAttribute = "attribute"
+	KindSchema    = "schema"
+	KindHead      = "head"
+	KindTenant    = "tenant"
+)
+
+// Non-key attribute names of the items.
+const (
+	AttrKind            = "kind"
+	AttrTenantID        = "tid"
+	AttrEntityType      = "et"
+	AttrEntityID        = "eid"
+	AttrRelation        = "rel"
+	AttrSubjectType     = "st"
+	AttrSubjectID       = "sid"
+	AttrSubjectRelation = "srel"
+	AttrAttribute       = "attr"
+	AttrValue           = "val"
+	AttrCreatedAt       = "ca"
+	AttrExpiredAt       = "xa"
+	AttrName            = "name"
+	AttrVersion         = "ver"
+	AttrDefinition      = "def"
+	AttrSnapshot        = "snap"
+)
+
+const (
+	separator = "#"
+	// historyMarker separates the live key of an item from the creation time of its history copies.
+	// It sorts after every character allowed in identifiers, so the live item and the history copies of
+	// one key stay adjacent and sort after any other key sharing the prefix.
+	historyMarker = "~"
+
+	// TenantsPartition is the partition holding one item per tenant.
+	TenantsPartition = "TENANT"
+	// HeadKey is the sort key of the item holding the head snapshot of a tenant.
+	HeadKey = "HEAD"
+)
+
+// Join - joins key segments with the key separator
+func Join(segments ...string) string {
+	return strings.Join(segments, separator)
+}
+
+// After - returns the smallest sort key that sorts after every item whose sort key starts with the given segment
+func After(segment string) string {
+	return segment + separator + historyMarker
+}
+
+// TuplePartition - partition key of the relation tuples of an entity type
+func TuplePartition(tenantID, entityType string) string {
+	return Join(tenantID, "TUPLE", entityType)
+}
+
+// SubjectPartition - subject index partition key of the relation tuples of a subject type
+func SubjectPartition(tenantID, subjectType string) string {
+	return Join(tenantID, "SUBJ", subjectType)
+}
+
+// AttributePartition - partition key of the attributes of an entity type
+func AttributePartition(tenantID, entityType string) string {
+	return Join(tenantID, "ATTR", entityType)
+}
+
+// SchemaPartition - partition key of the schema definitions of a tenant
+func SchemaPartition(tenantID string) string {
+	return Join(tenantID, "SCHEMA")
+}
+
+// HeadPartition - partition key of the head snapshot of a tenant
+func HeadPartition(tenantID string) string {
+	return Join(tenantID, "HEAD")
+}
+
+// TupleKey - sort key of a live relation tuple
+func TupleKey(t storage.RelationTuple) string {
+	return Join(t.EntityID, t.Relation, t.SubjectType, t.SubjectID, t.SubjectRelation)
+}
+
+// SubjectKey - subject index sort key of a live relation tuple
+func SubjectKey(t storage.RelationTuple) string {
+	return Join(t.SubjectID, t.SubjectRelation, t.EntityType, t.EntityID, t.Relation)
+}
+
+// AttributeKey - sort key of a live attribute
+func AttributeKey(a storage.Attribute) string {
+	return Join(a.EntityID, a.Attribute)
+}
+
+// SchemaKey - sort key of a schema definition
+func SchemaKey(version, name string) string {
+	return Join(version, name)
+}
+
+// HistoryKey - sort key of the history copy of an item created at the given time
+func HistoryKey(key string, createdAt uint64) string {
+	return fmt.Sprintf("%s%s%s%020d", key, separator, historyMarker, createdAt)
+}
+
+// Visible - reports whether an item created and expired at the given times is part of the snapshot.
+// An expiry time of zero marks a live item.
+func Visible(createdAt, expiredAt, snap uint64) bool {
+	return createdAt <= snap && (expiredAt == 0 || expiredAt > snap)
+}
+
+// TupleItem - relation tuple together with its lifetime
+type TupleItem struct {
+	Tuple     storage.RelationTuple
+	CreatedAt uint64
+	ExpiredAt uint64
+}
+
+// AttributeItem - attribute together with its lifetime
+type AttributeItem struct {
+	Attribute storage.Attribute
+	CreatedAt uint64
+	ExpiredAt uint64
+}
+
+// TupleToItem - converts a relation tuple to a dynamodb item. Expired tuples are stored as history copies.
+func TupleToItem(t TupleItem) map[string]types.AttributeValue {
+	sk, gsk := TupleKey(t.Tuple), SubjectKey(t.Tuple)
+	if t.ExpiredAt != 0 {
+		sk, gsk = HistoryKey(sk, t.CreatedAt), HistoryKey(gsk, t.CreatedAt)
+	}
+	return map[string]types.AttributeValue{
+		db.PartitionKey:        S(TuplePartition(t.Tuple.TenantID, t.Tuple.EntityType)),
+		db.SortKey:             S(sk),
+		db.SubjectPartitionKey: S(SubjectPartition(t.Tuple.TenantID, t.Tuple.SubjectType)),
+		db.SubjectSortKey:      S(gsk),
+		AttrKind:               S(KindTuple),
+		AttrTenantID:           S(t.Tuple.TenantID),
+		AttrEntityType:         S(t.Tuple.EntityType),
+		AttrEntityID:           S(t.Tuple.EntityID),
+		AttrRelation:           S(t.Tuple.Relation),
+		AttrSubjectType:        S(t.Tuple.SubjectType),
+		AttrSubjectID:          S(t.Tuple.SubjectID),
+		AttrSubjectRelation:    S(t.Tuple.SubjectRelation),
+		AttrCreatedAt:          N(t.CreatedAt),
+		AttrExpiredAt:          N(t.ExpiredAt),
+	}
+}
+
+// ItemToTuple - converts a dynamodb item to a relation tuple
+func ItemToTuple(item map[string]types.AttributeValue) (t TupleItem, err error) {
+	t.Tuple = storage.RelationTuple{
+		TenantID:        GetS(item, AttrTenantID),
+		EntityType:      GetS(item, AttrEntityType),
+		EntityID:        GetS(item, AttrEntityID),
+		Relation:        GetS(item, AttrRelation),
+		SubjectType:     GetS(item, AttrSubjectType),
+		SubjectID:       GetS(item, AttrSubjectID),
+		SubjectRelation: GetS(item, AttrSubjectRelation),
+	}
+	if t.CreatedAt, err = GetN(item, AttrCreatedAt); err != nil {
+		return t, err
+	}
+	if t.ExpiredAt, err = GetN(item, AttrExpiredAt); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+// AttributeToItem - converts an attribute to a dynamodb item. Expired attributes are stored as history copies.
+func AttributeToItem(a AttributeItem) (map[string]types.AttributeValue, error) {
+	value, err := proto.Marshal(a.Attribute.Value)
+	if err != nil {
+		return nil, err
+	}
+	sk := AttributeKey(a.Attribute)
+	if a.ExpiredAt != 0 {
+		sk = HistoryKey(sk, a.CreatedAt)
+	}
+	return map[string]types.AttributeValue{
+		db.PartitionKey: S(AttributePartition(a.Attribute.TenantID, a.Attribute.EntityType)),
+		db.SortKey:      S(sk),
+		AttrKind:        S(KindAttribute),
+		AttrTenantID:    S(a.Attribute.TenantID),
+		AttrEntityType:  S(a.Attribute.EntityType),
+		AttrEntityID:    S(a.Attribute.EntityID),
+		AttrAttribute:   S(a.Attribute.Attribute),
+		AttrValue:       &types.AttributeValueMemberB{Value: value},
+		AttrCreatedAt:   N(a.CreatedAt),
+		AttrExpiredAt:   N(a.ExpiredAt),
+	}, nil
+}
+
+// ItemToAttribute - converts a dynamodb item to an attribute
+func ItemToAttribute(item map[string]types.AttributeValue) (a AttributeItem, err error) {
+	a.Attribute = storage.Attribute{
+		TenantID:   GetS(item, AttrTenantID),
+		EntityType: GetS(item, AttrEntityType),
+		EntityID:   GetS(item, AttrEntityID),
+		Attribute:  GetS(item, AttrAttribute),
+		Value:      &anypb.Any{},
+	}
+	v, ok := item[AttrValue].(*types.AttributeValueMemberB)
+	if !ok {
+		return a, errors.New("attribute item has no value")
+	}
+	if err = proto.Unmarshal(v.Value, a.Attribute.Value); err != nil {
+		return a, err
+	}
+	if a.CreatedAt, err = GetN(item, AttrCreatedAt); err != nil {
+		return a, err
+	}
+	if a.ExpiredAt, err = GetN(item, AttrExpiredAt); err != nil {
+		return a, err
+	}
+	return a, nil
+}
+
+// SchemaDefinitionToItem - converts a schema definition to a dynamodb item
+func SchemaDefinitionToItem(d storage.SchemaDefinition) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{
+		db.PartitionKey: S(SchemaPartition(d.TenantID)),
+		db.SortKey:      S(SchemaKey(d.Version, d.Name)),
+		AttrKind:        S(KindSchema),
+		AttrTenantID:    S(d.TenantID),
+		AttrName:        S(d.Name),
+		AttrVersion:     S(d.Version),
+		AttrDefinition:  &types.AttributeValueMemberB{Value: d.SerializedDefinition},
+	}
+}
+
+// ItemToSchemaDefinition - converts a dynamodb item to a schema definition
+func ItemToSchemaDefinition(item map[string]types.AttributeValue) storage.SchemaDefinition {
+	d := storage.SchemaDefinition{
+		TenantID: GetS(item, AttrTenantID),
+		Name:     GetS(item, AttrName),
+		Version:  GetS(item, AttrVersion),
+	}
+	if v, ok := item[AttrDefinition].(*types.AttributeValueMemberB); ok {
+		d.SerializedDefinition = v.Value
+	}
+	return d
+}
+
+// MatchTuple - reports whether the relation tuple matches the given filter
+func MatchTuple(filter *base.TupleFilter, t storage.RelationTuple) bool {
+	switch {
+	case filter.GetEntity().GetType() != "" && t.EntityType != filter.GetEntity().GetType():
+		return false
+	case len(filter.GetEntity().GetIds()) > 0 && !slices.Contains(filter.GetEntity().GetIds(), t.EntityID):
+		return false
+	case filter.GetRelation() != "" && t.Relation != filter.GetRelation():
+		return false
+	case filter.GetSubject().GetType() != "" && t.SubjectType != filter.GetSubject().GetType():
+		return false
+	case len(filter.GetSubject().GetIds()) > 0 && !slices.Contains(filter.GetSubject().GetIds(), t.SubjectID):
+		return false
+	case filter.GetSubject().GetRelation() != "" && t.SubjectRelation != filter.GetSubject().GetRelation():
+		return false
+	}
+	return true
+}
+
+// MatchAttribute - reports whether the attribute matches the given filter
+func MatchAttribute(filter *base.AttributeFilter, a storage.Attribute) bool {
+	switch {
+	case filter.GetEntity().GetType() != "" && a.EntityType != filter.GetEntity().GetType():
+		return false
+	case len(filter.GetEntity().GetIds()) > 0 && !slices.Contains(filter.GetEntity().GetIds(), a.EntityID):
+		return false
+	case len(filter.GetAttributes()) > 0 && !slices.Contains(filter.GetAttributes(), a.Attribute):
+		return false
+	}
+	return true
+}
+
+// S - string attribute value
+func S(v string) types.AttributeValue {
+	return &types.AttributeValueMemberS{Value: v}
+}
+
+// N - number attribute value
+func N(v uint64) types.AttributeValue {
+	return &types.AttributeValueMemberN{Value: strconv.FormatUint(v, 10)}
+}
+
+// GetS - reads a string attribute of the item, or an empty string if it is missing
+func GetS(item map[string]types.AttributeValue, name string) string {
+	if v, ok := item[name].(*types.AttributeValueMemberS); ok {
+		return v.Value
+	}
+	return ""
+}
+
+// GetN - reads a number attribute of the item, or zero if it is missing
+func GetN(item map[string]types.AttributeValue, name string) (uint64, error) {
+	v, ok := item[name].(*types.AttributeValueMemberN)
+	if !ok {
+		return 0, nil
+	}
+	return strconv.ParseUint(v.Value, 10, 64)
+}
+
+// FromStreamItem - converts an item of a stream record to a table item. Only the attribute types
+// stored by the storage are converted.
+func FromStreamItem(image map[string]streamTypes.AttributeValue) map[string]types.AttributeValue {
+	item := make(map[string]types.AttributeValue, len(image))
+	for name, value := range image {
+		switch v := value.(type) {
+		case *streamTypes.AttributeValueMemberS:
+			item[name] = &types.AttributeValueMemberS{Value: v.Value}
+		case *streamTypes.AttributeValueMemberN:
+			item[name] = &types.AttributeValueMemberN{Value: v.Value}
+		case *streamTypes.AttributeValueMemberB:
+			item[name] = &types.AttributeValueMemberB{Value: v.Value}
+		}
+	}
+	return item
+}
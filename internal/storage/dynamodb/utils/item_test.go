@@ -0,0 +1,131 @@
+package utils_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestVisible(t *testing.T) {
+	assert.True(t, utils.Visible(10, 0, 10))
+	assert.True(t, utils.Visible(10, 20, 19))
+	assert.False(t, utils.Visible(10, 20, 20))
+	assert.False(t, utils.Visible(10, 0, 9))
+}
+
+func TestTupleItemRoundTrip(t *testing.T) {
+	tuple := storage.RelationTuple{
+		TenantID:        "t1",
+		EntityType:      "organization",
+		EntityID:        "1",
+		Relation:        "member",
+		SubjectType:     "team",
+		SubjectID:       "2",
+		SubjectRelation: "member",
+	}
+
+	item := utils.TupleToItem(utils.TupleItem{Tuple: tuple, CreatedAt: 10})
+	assert.Equal(t, "t1#TUPLE#organization", utils.GetS(item, db.PartitionKey))
+	assert.Equal(t, "1#member#team#2#member", utils.GetS(item, db.SortKey))
+	assert.Equal(t, "t1#SUBJ#team", utils.GetS(item, db.SubjectPartitionKey))
+	assert.Equal(t, "2#member#organization#1#member", utils.GetS(item, db.SubjectSortKey))
+
+	got, err := utils.ItemToTuple(item)
+	assert.NoError(t, err)
+	assert.Equal(t, utils.TupleItem{Tuple: tuple, CreatedAt: 10}, got)
+
+	history := utils.TupleToItem(utils.TupleItem{Tuple: tuple, CreatedAt: 10, ExpiredAt: 20})
+	assert.Equal(t, "1#member#team#2#member#~00000000000000000010", utils.GetS(history, db.SortKey))
+	assert.Equal(t, "2#member#organization#1#member#~00000000000000000010", utils.GetS(history, db.SubjectSortKey))
+}
+
+func TestAttributeItemRoundTrip(t *testing.T) {
+	value, err := anypb.New(&base.BooleanValue{Data: true})
+	assert.NoError(t, err)
+
+	attribute := storage.Attribute{
+		TenantID:   "t1",
+		EntityType: "repository",
+		EntityID:   "1",
+		Attribute:  "public",
+		Value:      value,
+	}
+
+	item, err := utils.AttributeToItem(utils.AttributeItem{Attribute: attribute, CreatedAt: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, "t1#ATTR#repository", utils.GetS(item, db.PartitionKey))
+	assert.Equal(t, "1#public", utils.GetS(item, db.SortKey))
+
+	got, err := utils.ItemToAttribute(item)
+	assert.NoError(t, err)
+	assert.Equal(t, "public", got.Attribute.Attribute)
+	assert.Equal(t, uint64(10), got.CreatedAt)
+
+	data := &base.BooleanValue{}
+	assert.NoError(t, got.Attribute.Value.UnmarshalTo(data))
+	assert.True(t, data.GetData())
+}
+
+func TestKeyOrdering(t *testing.T) {
+	// The live item of a key and its history copies sort before the items of every other entity.
+	keys := []string{
+		utils.Join("1", "viewer"),
+		utils.HistoryKey(utils.Join("1", "owner"), 5),
+		utils.Join("10", "owner"),
+		utils.Join("1", "owner"),
+		utils.Join("1-a", "owner"),
+	}
+	sort.Strings(keys)
+
+	assert.Equal(t, []string{
+		"1#owner",
+		"1#owner#~00000000000000000005",
+		"1#viewer",
+		"1-a#owner",
+		"10#owner",
+	}, keys)
+
+	for _, k := range keys[:3] {
+		assert.Less(t, k, utils.After("1"))
+	}
+	for _, k := range keys[3:] {
+		assert.Greater(t, k, utils.After("1"))
+	}
+}
+
+func TestMatchTuple(t *testing.T) {
+	tuple := storage.RelationTuple{
+		EntityType:  "organization",
+		EntityID:    "1",
+		Relation:    "admin",
+		SubjectType: "user",
+		SubjectID:   "2",
+	}
+
+	assert.True(t, utils.MatchTuple(&base.TupleFilter{}, tuple))
+	assert.True(t, utils.MatchTuple(&base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1", "3"}},
+		Relation: "admin",
+		Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"2"}},
+	}, tuple))
+	assert.False(t, utils.MatchTuple(&base.TupleFilter{Entity: &base.EntityFilter{Ids: []string{"3"}}}, tuple))
+	assert.False(t, utils.MatchTuple(&base.TupleFilter{Subject: &base.SubjectFilter{Relation: "member"}}, tuple))
+}
+
+func TestMatchAttribute(t *testing.T) {
+	attribute := storage.Attribute{
+		EntityType: "repository",
+		EntityID:   "1",
+		Attribute:  "public",
+	}
+
+	assert.True(t, utils.MatchAttribute(&base.AttributeFilter{Attributes: []string{"public"}}, attribute))
+	assert.False(t, utils.MatchAttribute(&base.AttributeFilter{Entity: &base.EntityFilter{Type: "organization"}}, attribute))
+}
@@ -0,0 +1,221 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	streamTypes "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+
+	"github.com/Permify/permify/internal/storage/dynamodb/snapshot"
+	"github.com/Permify/permify/internal/storage/dynamodb/utils"
+	db "github.com/Permify/permify/pkg/database/dynamodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Watch is an implementation of the storage.Watch interface that follows the stream of the table.
+// Stored live items are reported as creations and stored history copies as deletions, grouped by
+// the snapshot of the write they belong to.
+type Watch struct {
+	database *db.DynamoDB
+	// options
+	bufferSize int
+}
+
+// NewWatcher returns a new instance of the Watch.
+func NewWatcher(database *db.DynamoDB) *Watch {
+	return &Watch{
+		database:   database,
+		bufferSize: _defaultWatchBufferSize,
+	}
+}
+
+// Watch returns a channel that emits a stream of changes to the relation tuples and attributes of the tenant
+// made after the given snapshot.
+func (w *Watch) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	changes := make(chan *base.DataChanges, w.bufferSize)
+	errs := make(chan error, 1)
+
+	slog.Info("Watching for changes in the database. ", slog.Any("tenant_id", tenantID), slog.Any("snapshot", snap))
+
+	cr, err := decodeSnapshot(snap)
+	if err != nil {
+		errs <- err
+
+		slog.Error("Failed to decode snapshot.", slog.Any("error", err))
+
+		return changes, errs
+	}
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		table, err := w.database.Client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(w.database.Table)})
+		if err != nil {
+			slog.Error("Failed to describe table. ", slog.Any("error", err))
+			errs <- err
+			return
+		}
+		if table.Table.LatestStreamArn == nil {
+			errs <- errors.New("dynamodb table has no stream enabled")
+			return
+		}
+		arn := table.Table.LatestStreamArn
+
+		// iterators holds the next shard iterator of every open shard that is being read.
+		iterators := map[string]*string{}
+
+		for {
+			if err := w.discoverShards(ctx, arn, iterators); err != nil {
+				slog.Error("Failed to discover stream shards. ", slog.Any("error", err))
+				errs <- err
+				return
+			}
+
+			updates := map[uint64]*base.DataChanges{}
+			for id, iterator := range iterators {
+				if iterator == nil {
+					continue
+				}
+				out, err := w.database.Streams.GetRecords(ctx, &dynamodbstreams.GetRecordsInput{ShardIterator: iterator})
+				if err != nil {
+					slog.Error("Failed to get stream records. ", slog.Any("shard", id), slog.Any("error", err))
+					errs <- err
+					return
+				}
+				for _, record := range out.Records {
+					collect(updates, tenantID, cr, record)
+				}
+				if out.NextShardIterator == nil {
+					// The shard is closed and fully read, but it stays known so it is not read again.
+					iterators[id] = nil
+					continue
+				}
+				iterators[id] = out.NextShardIterator
+			}
+
+			timestamps := make([]uint64, 0, len(updates))
+			for ts := range updates {
+				timestamps = append(timestamps, ts)
+			}
+			sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+			for _, ts := range timestamps {
+				select {
+				case changes <- updates[ts]:
+					slog.Info("Sent updates to the changes channel for snapshot. ", slog.Any("snapshot", ts))
+				case <-ctx.Done():
+					slog.Error("Context canceled, stopping watch.")
+					errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+					return
+				}
+				cr = ts
+			}
+
+			if len(timestamps) == 0 {
+				sleep := time.NewTimer(100 * time.Millisecond)
+
+				select {
+				case <-sleep.C:
+				case <-ctx.Done():
+					slog.Error("Context canceled, stopping watch.")
+					errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+					return
+				}
+			}
+		}
+	}()
+
+	slog.Info("Watch started successfully.")
+
+	return changes, errs
+}
+
+// discoverShards adds an iterator starting at the oldest record for every shard of the stream not seen yet.
+func (w *Watch) discoverShards(ctx context.Context, arn *string, iterators map[string]*string) error {
+	var start *string
+	for {
+		out, err := w.database.Streams.DescribeStream(ctx, &dynamodbstreams.DescribeStreamInput{
+			StreamArn:             arn,
+			ExclusiveStartShardId: start,
+		})
+		if err != nil {
+			return err
+		}
+		for _, shard := range out.StreamDescription.Shards {
+			id := aws.ToString(shard.ShardId)
+			if _, ok := iterators[id]; ok {
+				continue
+			}
+			it, err := w.database.Streams.GetShardIterator(ctx, &dynamodbstreams.GetShardIteratorInput{
+				StreamArn:         arn,
+				ShardId:           shard.ShardId,
+				ShardIteratorType: streamTypes.ShardIteratorTypeTrimHorizon,
+			})
+			if err != nil {
+				return err
+			}
+			iterators[id] = it.ShardIterator
+		}
+		start = out.StreamDescription.LastEvaluatedShardId
+		if start == nil {
+			return nil
+		}
+	}
+}
+
+// collect adds the change described by the stream record to the changes of its snapshot, if the record
+// stores a relation tuple or attribute of the tenant after the given snapshot.
+func collect(updates map[uint64]*base.DataChanges, tenantID string, after uint64, record streamTypes.Record) {
+	// Replacing a live item modifies it, while deleting it removes it and inserts its history copy.
+	if (record.EventName != streamTypes.OperationTypeInsert && record.EventName != streamTypes.OperationTypeModify) || record.Dynamodb == nil {
+		return
+	}
+
+	item := utils.FromStreamItem(record.Dynamodb.NewImage)
+	if utils.GetS(item, utils.AttrTenantID) != tenantID {
+		return
+	}
+
+	var change *base.DataChange
+	var createdAt, expiredAt uint64
+	switch utils.GetS(item, utils.AttrKind) {
+	case utils.KindTuple:
+		t, err := utils.ItemToTuple(item)
+		if err != nil {
+			return
+		}
+		createdAt, expiredAt = t.CreatedAt, t.ExpiredAt
+		change = &base.DataChange{Type: &base.DataChange_Tuple{Tuple: t.Tuple.ToTuple()}}
+	case utils.KindAttribute:
+		a, err := utils.ItemToAttribute(item)
+		if err != nil {
+			return
+		}
+		createdAt, expiredAt = a.CreatedAt, a.ExpiredAt
+		change = &base.DataChange{Type: &base.DataChange_Attribute{Attribute: a.Attribute.ToAttribute()}}
+	default:
+		return
+	}
+
+	ts := createdAt
+	change.Operation = base.DataChange_OPERATION_CREATE
+	if expiredAt != 0 {
+		ts = expiredAt
+		change.Operation = base.DataChange_OPERATION_DELETE
+	}
+	if ts <= after {
+		return
+	}
+
+	if _, ok := updates[ts]; !ok {
+		updates[ts] = &base.DataChanges{SnapToken: snapshot.Token{Value: ts}.Encode().String()}
+	}
+	updates[ts].DataChanges = append(updates[ts].DataChanges, change)
+}
@@ -197,7 +197,7 @@ func (r *DataReader) ReadAttributes(_ context.Context, tenantID string, filter *
 
 	// Get the result iterator using lower bound.
 	var result memdb.ResultIterator
-	result, err = txn.LowerBound(RelationTuplesTable, index, args...)
+	result, err = txn.LowerBound(AttributesTable, index, args...)
 	if err != nil {
 		return nil, database.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
@@ -323,5 +323,15 @@ func (r *DataReader) QueryUniqueSubjectReferences(_ context.Context, tenantID st
 
 // HeadSnapshot - Reads the latest version of the snapshot from the repository.
 func (r *DataReader) HeadSnapshot(_ context.Context, _ string) (token.SnapToken, error) {
-	return snapshot.NewToken(time.Now()), nil
+	return snapshot.NewToken(r.database.Now()), nil
+}
+
+// ReadRelationshipHistory - The memory engine does not keep history, so reading it is not supported.
+func (r *DataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _, _ time.Time, _ database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+}
+
+// SnapshotAt - The memory engine does not keep history, so past states cannot be read.
+func (r *DataReader) SnapshotAt(_ context.Context, _ string, _ time.Time) (token.SnapToken, error) {
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
 }
@@ -3,13 +3,13 @@ package memory
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/hashicorp/go-memdb"
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/snapshot"
 	"github.com/Permify/permify/internal/storage/memory/utils"
+	"github.com/Permify/permify/internal/validation"
 	"github.com/Permify/permify/pkg/database"
 	db "github.com/Permify/permify/pkg/database/memory"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -30,17 +30,95 @@ func NewDataWriter(database *db.Memory) *DataWriter {
 
 // WriteRelationships - Write a Relation to repository
 func (r *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
-	var err error
-
-	tupleIterator := tupleCollection.CreateTupleIterator()
-	attributeIterator := attributesCollection.CreateAttributeIterator()
-	if !tupleIterator.HasNext() && !attributeIterator.HasNext() {
+	if len(tupleCollection.GetTuples()) == 0 && len(attributesCollection.GetAttributes()) == 0 {
 		return token.NewNoopToken().Encode(), nil
 	}
 
 	txn := r.database.DB.Txn(true)
 	defer txn.Abort()
 
+	if err := write(txn, tenantID, tupleCollection, attributesCollection); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return snapshot.NewToken(r.database.Now()).Encode(), nil
+}
+
+// Delete - Delete relationship from repository
+func (r *DataWriter) Delete(_ context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+	txn := r.database.DB.Txn(true)
+	defer txn.Abort()
+
+	if err := remove(txn, tenantID, tupleFilter, attributeFilter); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return snapshot.NewToken(r.database.Now()).Encode(), nil
+}
+
+// RunTransaction - Delete and write relationships and attributes in a single transaction
+func (r *DataWriter) RunTransaction(_ context.Context, tenantID string, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	txn := r.database.DB.Txn(true)
+	defer txn.Abort()
+
+	for i := range transaction.TupleFilters {
+		if err := remove(txn, tenantID, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := write(txn, tenantID, transaction.Tuples, transaction.Attributes); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return snapshot.NewToken(r.database.Now()).Encode(), nil
+}
+
+// MigrateSchema - Write a schema version and migrate relationships and attributes to it in a single transaction
+func (r *DataWriter) MigrateSchema(_ context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token.EncodedSnapToken, error) {
+	// The head version is held until the new version replaces it, so that the version expected is still the latest
+	// one when the migration is committed
+	mu.Lock()
+	defer mu.Unlock()
+
+	if transaction.SchemaVersion != nil && headVersion[tenantID] != *transaction.SchemaVersion {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String())
+	}
+
+	txn := r.database.DB.Txn(true)
+	defer txn.Abort()
+
+	var version string
+	for _, definition := range definitions {
+		if err := txn.Insert(SchemaDefinitionsTable, definition); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		version = definition.Version
+	}
+
+	for i := range transaction.TupleFilters {
+		if err := remove(txn, tenantID, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := write(txn, tenantID, transaction.Tuples, transaction.Attributes); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+
+	headVersion[tenantID] = version
+
+	return snapshot.NewToken(r.database.Now()).Encode(), nil
+}
+
+// write inserts the relationships and attributes in the transaction
+func write(txn *memdb.Txn, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) error {
+	tupleIterator := tupleCollection.CreateTupleIterator()
 	for tupleIterator.HasNext() {
 		bt := tupleIterator.GetNext()
 		srelation := bt.GetSubject().GetRelation()
@@ -57,11 +135,12 @@ func (r *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *
 			SubjectID:       bt.GetSubject().GetId(),
 			SubjectRelation: srelation,
 		}
-		if err = txn.Insert(RelationTuplesTable, t); err != nil {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		if err := txn.Insert(RelationTuplesTable, t); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 	}
 
+	attributeIterator := attributesCollection.CreateAttributeIterator()
 	for attributeIterator.HasNext() {
 		at := attributeIterator.GetNext()
 
@@ -73,59 +152,53 @@ func (r *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *
 			Attribute:  at.GetAttribute(),
 			Value:      at.GetValue(),
 		}
-		if err = txn.Insert(AttributesTable, t); err != nil {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		if err := txn.Insert(AttributesTable, t); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	return nil
 }
 
-// Delete - Delete relationship from repository
-func (r *DataWriter) Delete(_ context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
-	var err error
-	txn := r.database.DB.Txn(true)
-	defer txn.Abort()
-
-	tIndex, tArgs := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, tupleFilter)
-	var tit memdb.ResultIterator
-	tit, err = txn.Get(RelationTuplesTable, tIndex, tArgs...)
-	if err != nil {
-		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-	}
-
-	tFit := memdb.NewFilterIterator(tit, utils.FilterRelationTuplesQuery(tenantID, tupleFilter))
-	for obj := tFit.Next(); obj != nil; obj = tFit.Next() {
-		t, ok := obj.(storage.RelationTuple)
-		if !ok {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
-		}
-		err = txn.Delete(RelationTuplesTable, t)
+// remove deletes the relationships and attributes matching the filters in the transaction, skipping the empty filters
+func remove(txn *memdb.Txn, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) error {
+	if !validation.IsTupleFilterEmpty(tupleFilter) {
+		tIndex, tArgs := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, tupleFilter)
+		tit, err := txn.Get(RelationTuplesTable, tIndex, tArgs...)
 		if err != nil {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
-	}
 
-	aIndex, args := utils.GetAttributesIndexNameAndArgsByFilters(tenantID, attributeFilter)
-	var aIt memdb.ResultIterator
-	aIt, err = txn.Get(AttributesTable, aIndex, args...)
-	if err != nil {
-		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		tFit := memdb.NewFilterIterator(tit, utils.FilterRelationTuplesQuery(tenantID, tupleFilter))
+		for obj := tFit.Next(); obj != nil; obj = tFit.Next() {
+			t, ok := obj.(storage.RelationTuple)
+			if !ok {
+				return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			if err = txn.Delete(RelationTuplesTable, t); err != nil {
+				return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			}
+		}
 	}
 
-	fit := memdb.NewFilterIterator(aIt, utils.FilterAttributesQuery(tenantID, attributeFilter))
-	for obj := fit.Next(); obj != nil; obj = fit.Next() {
-		t, ok := obj.(storage.RelationTuple)
-		if !ok {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
-		}
-		err = txn.Delete(RelationTuplesTable, t)
+	if !validation.IsAttributeFilterEmpty(attributeFilter) {
+		aIndex, args := utils.GetAttributesIndexNameAndArgsByFilters(tenantID, attributeFilter)
+		aIt, err := txn.Get(AttributesTable, aIndex, args...)
 		if err != nil {
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		fit := memdb.NewFilterIterator(aIt, utils.FilterAttributesQuery(tenantID, attributeFilter))
+		for obj := fit.Next(); obj != nil; obj = fit.Next() {
+			a, ok := obj.(storage.Attribute)
+			if !ok {
+				return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			if err = txn.Delete(AttributesTable, a); err != nil {
+				return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			}
 		}
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	return nil
 }
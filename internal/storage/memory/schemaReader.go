@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"errors"
+	"sort"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -15,12 +16,15 @@ import (
 // SchemaReader - Structure for Schema Reader
 type SchemaReader struct {
 	database *db.Memory
+	// versions are the schema versions compiled to read the definitions of their entities
+	versions *storage.CompiledVersions
 }
 
 // NewSchemaReader - Creates a new SchemaReader
 func NewSchemaReader(database *db.Memory) *SchemaReader {
 	return &SchemaReader{
 		database: database,
+		versions: storage.NewCompiledVersions(),
 	}
 }
 
@@ -48,7 +52,7 @@ func (r *SchemaReader) ReadSchema(_ context.Context, tenantID, version string) (
 }
 
 // ReadEntityDefinition - Reads a Entity Definition from repository
-func (r *SchemaReader) ReadEntityDefinition(_ context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error) {
+func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 	var raw interface{}
@@ -59,12 +63,9 @@ func (r *SchemaReader) ReadEntityDefinition(_ context.Context, tenantID, entityN
 
 	def, ok := raw.(storage.SchemaDefinition)
 	if ok {
-		var sch *base.SchemaDefinition
-		sch, err = schema.NewSchemaFromStringDefinitions(false, def.Serialized())
-		if err != nil {
-			return nil, "", err
-		}
-		definition, err = schema.GetEntityByName(sch, entityName)
+		definition, err = r.versions.Entity(tenantID, def.Version, entityName, func() (*base.SchemaDefinition, error) {
+			return r.ReadSchema(ctx, tenantID, def.Version)
+		})
 		if err != nil {
 			return nil, "", err
 		}
@@ -113,3 +114,43 @@ func (r *SchemaReader) HeadVersion(_ context.Context, tenantID string) (string,
 
 	return version, nil
 }
+
+// ReadSchemaDefinitions - Reads the serialized definitions of a schema version
+func (r *SchemaReader) ReadSchemaDefinitions(_ context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	var it memdb.ResultIterator
+	it, err = txn.Get(SchemaDefinitionsTable, "version", tenantID, version)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		definitions = append(definitions, obj.(storage.SchemaDefinition))
+	}
+
+	return definitions, nil
+}
+
+// ReadSchemaVersions - Reads the versions of the schema, oldest first. Versions sort by creation time.
+func (r *SchemaReader) ReadSchemaVersions(_ context.Context, tenantID string) (versions []string, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	var it memdb.ResultIterator
+	it, err = txn.Get(SchemaDefinitionsTable, "tenant", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	seen := map[string]bool{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		version := obj.(storage.SchemaDefinition).Version
+		if !seen[version] {
+			seen[version] = true
+			versions = append(versions, version)
+		}
+	}
+	sort.Strings(versions)
+
+	return versions, nil
+}
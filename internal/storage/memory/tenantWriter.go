@@ -3,7 +3,6 @@ package memory
 import (
 	"context"
 	"errors"
-	"time"
 
 	"github.com/Permify/permify/internal/storage"
 	db "github.com/Permify/permify/pkg/database/memory"
@@ -27,7 +26,7 @@ func (w *TenantWriter) CreateTenant(_ context.Context, id, name string) (result
 	tenant := storage.Tenant{
 		ID:        id,
 		Name:      name,
-		CreatedAt: time.Now(),
+		CreatedAt: w.database.Now(),
 	}
 	txn := w.database.DB.Txn(true)
 	defer txn.Abort()
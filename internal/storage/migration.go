@@ -1,216 +1,167 @@
 package storage
 
 import (
+	"context"
+	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/pressly/goose/v3"
 
 	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/pkg/database"
+	DDDatabase "github.com/Permify/permify/pkg/database/dynamodb"
+	MGDatabase "github.com/Permify/permify/pkg/database/mongodb"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	SPDatabase "github.com/Permify/permify/pkg/database/spanner"
+	api "github.com/Permify/permify/pkg/storage"
 )
 
 const (
 	postgresMigrationDir = "postgres/migrations"
 	postgresDialect      = "postgres"
 	migrationsTable      = "migrations"
+	// migrationLockID is the key of the advisory lock Postgres migrations run under
+	migrationLockID int64 = 8_214_309_577_460_102
 )
 
 //go:embed postgres/migrations/*.sql
 var postgresMigrations embed.FS
 
-// Migrate performs database migrations depending on the given configuration.
+// Migrate performs database migrations depending on the given configuration. Postgres migrations run under an
+// advisory lock, so replicas starting together migrate the database one at a time.
 func Migrate(conf config.Database) (err error) {
 	switch conf.Engine {
 	case database.POSTGRES.String():
-		// Create a new Postgres database connection
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(conf.URI)
-		if err != nil {
-			return err
-		}
-		// Ensure database connection is closed when function returns
-		defer closeDB(db)
-
-		// Set table name for migrations
-		goose.SetTableName(migrationsTable)
-
-		// Set dialect to be used for migration
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		// Set file system for migration scripts
-		goose.SetBaseFS(postgresMigrations)
-
-		// Perform migration
-		if err = goose.Up(db.DB, postgresMigrationDir); err != nil {
-			return err
-		}
-
-		return nil
+		return withPostgres(conf.URI, func(db *sql.DB) error {
+			return withMigrationLock(db, conf.MigrationLockTimeout, func() error {
+				return goose.Up(db, postgresMigrationDir)
+			})
+		})
 	case database.MEMORY.String():
 		// No migrations needed for in-memory database
 		return nil
+	case database.DYNAMODB.String():
+		// DynamoDB has no schema beyond the table and its index, which are created if missing
+		return createDynamoDBTable(conf.URI)
+	case database.MONGODB.String():
+		// MongoDB creates collections on first use, only the indexes need to be created
+		return createMongoDBIndexes(conf.URI)
+	case database.SPANNER.String():
+		// Spanner tables are created with IF NOT EXISTS, so creating them is idempotent
+		return createSpannerTables(conf.URI)
 	default:
-		// Unsupported database engine
-		return fmt.Errorf("%s connection is unsupported", conf.Engine)
+		// Custom engines registered through the public storage registry run their own migration
+		return migrateRegistered(conf.Engine, api.Config{
+			URI:                   conf.URI,
+			MaxOpenConnections:    conf.MaxOpenConnections,
+			MaxIdleConnections:    conf.MaxIdleConnections,
+			MaxConnectionIdleTime: conf.MaxConnectionIdleTime,
+			MaxConnectionLifetime: conf.MaxConnectionLifetime,
+		})
 	}
 }
 
 // MigrateUp performs all available database migrations to update the schema to the latest version.
-func MigrateUp(engine, uri string) (err error) {
+func MigrateUp(engine, uri string, lockTimeout time.Duration) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
-		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.Up(db.DB, postgresMigrationDir); err != nil {
-			return err
-		}
-
-		return nil
+		return withPostgres(uri, func(db *sql.DB) error {
+			return withMigrationLock(db, lockTimeout, func() error {
+				return goose.Up(db, postgresMigrationDir)
+			})
+		})
 	case database.MEMORY.String():
 		return nil
+	case database.DYNAMODB.String():
+		return createDynamoDBTable(uri)
+	case database.MONGODB.String():
+		return createMongoDBIndexes(uri)
+	case database.SPANNER.String():
+		return createSpannerTables(uri)
 	default:
-		return fmt.Errorf("%s connection is unsupported", engine)
+		return migrateRegistered(engine, api.Config{URI: uri})
 	}
 }
 
 // MigrateUpTo performs database migrations up to a specific version.
-func MigrateUpTo(engine, uri string, p int64) (err error) {
+func MigrateUpTo(engine, uri string, p int64, lockTimeout time.Duration) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
-		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.UpTo(db.DB, postgresMigrationDir, p); err != nil {
-			return err
-		}
-
-		return nil
+		return withPostgres(uri, func(db *sql.DB) error {
+			return withMigrationLock(db, lockTimeout, func() error {
+				return goose.UpTo(db, postgresMigrationDir, p)
+			})
+		})
 	case database.MEMORY.String():
 		return nil
+	case database.DYNAMODB.String():
+		return createDynamoDBTable(uri)
+	case database.MONGODB.String():
+		return createMongoDBIndexes(uri)
+	case database.SPANNER.String():
+		return createSpannerTables(uri)
 	default:
-		return fmt.Errorf("%s connection is unsupported", engine)
+		return migrateRegistered(engine, api.Config{URI: uri})
 	}
 }
 
 // MigrateDown undoes all database migrations, reverting the schema to the initial state.
-func MigrateDown(engine, uri string) (err error) {
+func MigrateDown(engine, uri string, lockTimeout time.Duration) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
-		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.Down(db.DB, postgresMigrationDir); err != nil {
-			return err
-		}
-
-		return nil
-	case database.MEMORY.String():
+		return withPostgres(uri, func(db *sql.DB) error {
+			return withMigrationLock(db, lockTimeout, func() error {
+				return goose.Down(db, postgresMigrationDir)
+			})
+		})
+	case database.MEMORY.String(), database.DYNAMODB.String(), database.MONGODB.String(), database.SPANNER.String():
 		return nil
 	default:
+		if _, ok := api.Lookup(engine); ok {
+			return nil
+		}
 		return fmt.Errorf("%s connection is unsupported", engine)
 	}
 }
 
 // MigrateDownTo undoes database migrations down to a specific version.
-func MigrateDownTo(engine, uri string, p int64) (err error) {
+func MigrateDownTo(engine, uri string, p int64, lockTimeout time.Duration) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
-		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.DownTo(db.DB, postgresMigrationDir, p); err != nil {
-			return err
-		}
-
-		return nil
-	case database.MEMORY.String():
+		return withPostgres(uri, func(db *sql.DB) error {
+			return withMigrationLock(db, lockTimeout, func() error {
+				return goose.DownTo(db, postgresMigrationDir, p)
+			})
+		})
+	case database.MEMORY.String(), database.DYNAMODB.String(), database.MONGODB.String(), database.SPANNER.String():
 		return nil
 	default:
+		if _, ok := api.Lookup(engine); ok {
+			return nil
+		}
 		return fmt.Errorf("%s connection is unsupported", engine)
 	}
 }
 
 // MigrateReset roll back all migrations.
-func MigrateReset(engine, uri string) (err error) {
+func MigrateReset(engine, uri string, lockTimeout time.Duration) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
-		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
-
-		if err = goose.SetDialect(postgresDialect); err != nil {
-			return err
-		}
-
-		goose.SetBaseFS(postgresMigrations)
-
-		if err = goose.Reset(db.DB, postgresMigrationDir); err != nil {
-			return err
-		}
-
-		return nil
-	case database.MEMORY.String():
+		return withPostgres(uri, func(db *sql.DB) error {
+			return withMigrationLock(db, lockTimeout, func() error {
+				return goose.Reset(db, postgresMigrationDir)
+			})
+		})
+	case database.MEMORY.String(), database.DYNAMODB.String(), database.MONGODB.String(), database.SPANNER.String():
 		return nil
 	default:
+		if _, ok := api.Lookup(engine); ok {
+			return nil
+		}
 		return fmt.Errorf("%s connection is unsupported", engine)
 	}
 }
@@ -219,31 +170,127 @@ func MigrateReset(engine, uri string) (err error) {
 func MigrateStatus(engine, uri string) (err error) {
 	switch engine {
 	case database.POSTGRES.String():
-		var db *PQDatabase.Postgres
-		db, err = PQDatabase.New(uri)
-		if err != nil {
-			return err
+		return withPostgres(uri, func(db *sql.DB) error {
+			return goose.Status(db, postgresMigrationDir)
+		})
+	case database.MEMORY.String(), database.DYNAMODB.String(), database.MONGODB.String(), database.SPANNER.String():
+		return nil
+	default:
+		if _, ok := api.Lookup(engine); ok {
+			return nil
 		}
-		defer closeDB(db)
-
-		goose.SetTableName(migrationsTable)
+		return fmt.Errorf("%s connection is unsupported", engine)
+	}
+}
 
-		if err = goose.SetDialect(postgresDialect); err != nil {
+// MigrationVersion returns the version of the last migration applied to the database, and the version of the last
+// migration known, which migrating up brings the database to. Engines without versioned migrations are at version 0.
+func MigrationVersion(ctx context.Context, engine, uri string) (current, latest int64, err error) {
+	switch engine {
+	case database.POSTGRES.String():
+		err = withPostgres(uri, func(db *sql.DB) error {
+			current, err = goose.GetDBVersionContext(ctx, db)
+			if err != nil {
+				return err
+			}
+			latest, err = latestPostgresMigration()
 			return err
+		})
+		return current, latest, err
+	case database.MEMORY.String(), database.DYNAMODB.String(), database.MONGODB.String(), database.SPANNER.String():
+		return 0, 0, nil
+	default:
+		if _, ok := api.Lookup(engine); ok {
+			return 0, 0, nil
 		}
+		return 0, 0, fmt.Errorf("%s connection is unsupported", engine)
+	}
+}
 
-		goose.SetBaseFS(postgresMigrations)
+// latestPostgresMigration returns the version of the last embedded Postgres migration.
+func latestPostgresMigration() (int64, error) {
+	migrations, err := goose.CollectMigrations(postgresMigrationDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, err
+	}
+	return last.Version, nil
+}
 
-		if err = goose.Status(db.DB, postgresMigrationDir); err != nil {
-			return err
+// withPostgres connects to the Postgres database of the uri, sets goose up for the embedded migrations and calls
+// fn with the connection, closing it once fn returns.
+func withPostgres(uri string, fn func(db *sql.DB) error) error {
+	db, err := PQDatabase.New(uri)
+	if err != nil {
+		return err
+	}
+	// Ensure database connection is closed when function returns
+	defer closeDB(db)
+
+	// Set table name for migrations
+	goose.SetTableName(migrationsTable)
+
+	// Set dialect to be used for migration
+	if err = goose.SetDialect(postgresDialect); err != nil {
+		return err
+	}
+
+	// Set file system for migration scripts
+	goose.SetBaseFS(postgresMigrations)
+
+	return fn(db.DB)
+}
+
+// withMigrationLock calls fn holding the advisory lock of migrations, waiting for it for up to timeout, or as long
+// as it takes if timeout is 0. The lock belongs to the session of a connection kept aside for it, and is released
+// once fn returns, or by the database if the connection is lost.
+func withMigrationLock(db *sql.DB, timeout time.Duration, fn func() error) (err error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := conn.Close(); cerr != nil {
+			log.Printf("failed to close the migration lock connection: %v", cerr)
 		}
+	}()
 
-		return nil
-	case database.MEMORY.String():
-		return nil
-	default:
+	lockCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		lockCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if _, err = conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		if errors.Is(lockCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("migration lock wasn't acquired in %s, another migration may be running: %w", timeout, err)
+		}
+		return err
+	}
+	defer func() {
+		if _, uerr := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockID); uerr != nil {
+			log.Printf("failed to release the migration lock: %v", uerr)
+		}
+	}()
+
+	return fn()
+}
+
+// migrateRegistered runs the migration of the custom engine registered under the name. Engines without a
+// migration need none.
+func migrateRegistered(engine string, conf api.Config) error {
+	e, ok := api.Lookup(engine)
+	if !ok {
 		return fmt.Errorf("%s connection is unsupported", engine)
 	}
+	if e.Migrate == nil {
+		return nil
+	}
+	return e.Migrate(conf)
 }
 
 // closeDB cleanly closes the database connection and logs if an error occurs.
@@ -252,3 +299,40 @@ func closeDB(db *PQDatabase.Postgres) {
 		log.Printf("failed to close the database: %v", err)
 	}
 }
+
+// createDynamoDBTable creates the DynamoDB table described by the uri if it does not exist yet.
+func createDynamoDBTable(uri string) error {
+	db, err := DDDatabase.New(uri)
+	if err != nil {
+		return err
+	}
+	return db.CreateTable(context.Background())
+}
+
+// createMongoDBIndexes creates the indexes of the MongoDB database described by the uri if they do not exist yet.
+func createMongoDBIndexes(uri string) error {
+	db, err := MGDatabase.New(uri)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("failed to close the database: %v", err)
+		}
+	}()
+	return db.CreateIndexes(context.Background())
+}
+
+// createSpannerTables creates the tables and indexes of the Spanner database described by the uri if they do not exist yet.
+func createSpannerTables(uri string) error {
+	db, err := SPDatabase.New(uri)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("failed to close the database: %v", err)
+		}
+	}()
+	return db.CreateTables(context.Background())
+}
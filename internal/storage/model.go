@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	base "github.com/Permify/permify/pkg/pb/base/v1"
+	api "github.com/Permify/permify/pkg/storage"
 )
 
 // RelationTuple - Structure for Relational Tuple
@@ -59,17 +60,7 @@ func (r Attribute) ToAttribute() *base.Attribute {
 }
 
 // SchemaDefinition - Structure for Schema Definition
-type SchemaDefinition struct {
-	TenantID             string
-	Name                 string
-	SerializedDefinition []byte
-	Version              string
-}
-
-// Serialized - get schema serialized definition
-func (e SchemaDefinition) Serialized() string {
-	return string(e.SerializedDefinition)
-}
+type SchemaDefinition = api.SchemaDefinition
 
 // Tenant - Structure for tenant
 type Tenant struct {
@@ -0,0 +1,457 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage/mongodb/snapshot"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReader - Structure for Data Reader
+type DataReader struct {
+	database *db.MongoDB
+}
+
+// NewDataReader - Creates a new DataReader
+func NewDataReader(database *db.MongoDB) *DataReader {
+	return &DataReader{
+		database: database,
+	}
+}
+
+// QueryRelationships reads relation tuples from the storage based on the given filter.
+func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var docs []utils.TupleDocument
+	err = find(ctx, r.database.DB.Collection(db.RelationTuplesCollection), utils.And(utils.TuplesFilter(tenantID, filter), utils.SnapshotFilter(st)), nil, &docs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query relationships: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	collection := database.NewTupleCollection()
+	for _, d := range docs {
+		collection.Add(d.ToRelationTuple().ToTuple())
+	}
+
+	return collection.CreateTupleIterator(), nil
+}
+
+// ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
+func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeObjectID(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	var docs []utils.TupleDocument
+	err = find(ctx, r.database.DB.Collection(db.RelationTuplesCollection), utils.And(utils.TuplesFilter(tenantID, filter), utils.SnapshotFilter(st), from), page(pagination), &docs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read relationships: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	if len(docs) > int(pagination.PageSize()) {
+		ct = utils.NewContinuousToken(docs[pagination.PageSize()].ID.Hex()).Encode()
+		docs = docs[:pagination.PageSize()]
+	}
+
+	collection = database.NewTupleCollection()
+	for _, d := range docs {
+		collection.Add(d.ToRelationTuple().ToTuple())
+	}
+
+	return collection, ct, nil
+}
+
+// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
+func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-single-attribute")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var doc utils.AttributeDocument
+	err = r.database.DB.Collection(db.AttributesCollection).FindOne(ctx, utils.And(utils.AttributesFilter(tenantID, filter), utils.SnapshotFilter(st))).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query single attribute: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	a, err := doc.ToAttribute()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+
+	return a.ToAttribute(), nil
+}
+
+// QueryAttributes reads multiple attributes from the storage based on the given filter.
+func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (it *database.AttributeIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var docs []utils.AttributeDocument
+	err = find(ctx, r.database.DB.Collection(db.AttributesCollection), utils.And(utils.AttributesFilter(tenantID, filter), utils.SnapshotFilter(st)), nil, &docs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query attributes: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	collection := database.NewAttributeCollection()
+	for _, d := range docs {
+		a, err := d.ToAttribute()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		collection.Add(a.ToAttribute())
+	}
+
+	return collection.CreateAttributeIterator(), nil
+}
+
+// ReadAttributes reads multiple attributes from the storage based on the given filter and pagination.
+func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeObjectID(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	var docs []utils.AttributeDocument
+	err = find(ctx, r.database.DB.Collection(db.AttributesCollection), utils.And(utils.AttributesFilter(tenantID, filter), utils.SnapshotFilter(st), from), page(pagination), &docs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read attributes: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	if len(docs) > int(pagination.PageSize()) {
+		ct = utils.NewContinuousToken(docs[pagination.PageSize()].ID.Hex()).Encode()
+		docs = docs[:pagination.PageSize()]
+	}
+
+	collection = database.NewAttributeCollection()
+	for _, d := range docs {
+		a, err := d.ToAttribute()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		collection.Add(a.ToAttribute())
+	}
+
+	return collection, ct, nil
+}
+
+// QueryUniqueEntities reads the distinct IDs of the entities of the given type that have relation tuples or
+// attributes in the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-entities")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeString(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	match := bson.D{{Key: "$match", Value: utils.And(
+		bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "entity_type", Value: name}, {Key: "entity_id", Value: bson.M{"$gte": from}}},
+		utils.SnapshotFilter(st),
+	)}}
+	project := bson.D{{Key: "$project", Value: bson.M{"_id": 0, "value": "$entity_id"}}}
+
+	ids, err = distinct(ctx, r.database.DB.Collection(db.RelationTuplesCollection), mongo.Pipeline{
+		match,
+		project,
+		{{Key: "$unionWith", Value: bson.M{"coll": db.AttributesCollection, "pipeline": mongo.Pipeline{match, project}}}},
+	}, pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query unique entities: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return paginateValues(ids, pagination)
+}
+
+// QueryUniqueSubjectReferences reads the distinct IDs of the subjects of the given type and relation that appear
+// in relation tuples of the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-subject-reference")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeString(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	ids, err = distinct(ctx, r.database.DB.Collection(db.RelationTuplesCollection), mongo.Pipeline{
+		{{Key: "$match", Value: utils.And(
+			bson.D{
+				{Key: "tenant_id", Value: tenantID},
+				{Key: "subject_type", Value: subjectReference.GetType()},
+				{Key: "subject_relation", Value: subjectReference.GetRelation()},
+				{Key: "subject_id", Value: bson.M{"$gte": from}},
+			},
+			utils.SnapshotFilter(st),
+		)}},
+		{{Key: "$project", Value: bson.M{"_id": 0, "value": "$subject_id"}}},
+	}, pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query unique subject references: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return paginateValues(ids, pagination)
+}
+
+// ReadRelationshipHistory reads the creations and deletions of relation tuples matching the given filter, oldest first.
+func (r *DataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _, _ time.Time, _ database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+}
+
+// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant.
+func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "data-reader.head-snapshot")
+	defer span.End()
+
+	var head struct {
+		TxID int64 `bson:"tx_id"`
+	}
+	err := r.database.DB.Collection(db.HeadsCollection).FindOne(ctx, bson.M{"_id": tenantID}).Decode(&head)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read head snapshot: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(head.TxID)), nil
+}
+
+// SnapshotAt returns the snapshot token of the last write of the tenant made at or before the given point in time.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "data-reader.snapshot-at")
+	defer span.End()
+
+	var tx utils.TransactionDocument
+	err := r.database.DB.Collection(db.TransactionsCollection).FindOne(ctx,
+		bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "timestamp", Value: bson.M{"$lte": at}}},
+		options.FindOne().SetSort(bson.D{{Key: "tx_id", Value: -1}}),
+	).Decode(&tx)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read snapshot at the given time: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(tx.TxID)), nil
+}
+
+// find runs the query and decodes every matching document into results.
+func find(ctx context.Context, collection *mongo.Collection, filter bson.D, opts *options.FindOptions, results interface{}) error {
+	if opts == nil {
+		opts = options.Find()
+	}
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	return cursor.All(ctx, results)
+}
+
+// page returns the find options reading one page of the pagination, plus one document telling whether there are more.
+func page(pagination database.Pagination) *options.FindOptions {
+	return options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pagination.PageSize()) + 1)
+}
+
+// distinct runs the pipeline, which must produce documents with a single value field, and returns the distinct
+// values in ascending order, one more than the page size of the pagination at most.
+func distinct(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline, pagination database.Pagination) ([]string, error) {
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.M{"_id": "$value"}}},
+		bson.D{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		bson.D{{Key: "$limit", Value: int64(pagination.PageSize()) + 1}},
+	)
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(docs))
+	for _, d := range docs {
+		values = append(values, d.ID)
+	}
+	return values, nil
+}
+
+// paginateValues cuts the values to the page size of the pagination. The token is the first value of the next page.
+func paginateValues(values []string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	if len(values) > int(pagination.PageSize()) {
+		return values[:pagination.PageSize()], utils.NewContinuousToken(values[pagination.PageSize()]).Encode(), nil
+	}
+	return values, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// decodeSnapshot decodes an encoded snapshot token to its transaction ID.
+func decodeSnapshot(snap string) (uint64, error) {
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return 0, err
+	}
+	return st.(snapshot.Token).Value, nil
+}
+
+// decodeObjectID decodes the continuous token of the pagination to a filter matching the documents from the
+// first document of the page on.
+func decodeObjectID(pagination database.Pagination) (bson.D, error) {
+	if pagination.Token() == "" {
+		return bson.D{}, nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(t.(utils.ContinuousToken).Value)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return bson.D{{Key: "_id", Value: bson.M{"$gte": id}}}, nil
+}
+
+// decodeString decodes the continuous token of the pagination to the first value of the page.
+func decodeString(pagination database.Pagination) (string, error) {
+	if pagination.Token() == "" {
+		return "", nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+	if err != nil {
+		return "", err
+	}
+	return t.(utils.ContinuousToken).Value, nil
+}
@@ -0,0 +1,326 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/mongodb/snapshot"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// DataWriter - Structure for Data Writer
+//
+// Every write runs in a multi-document transaction that increments the head transaction ID of the tenant.
+// Since all writes of a tenant update its head document, concurrent writes conflict and the driver retries
+// them, so transaction IDs follow the commit order.
+type DataWriter struct {
+	database *db.MongoDB
+	// options
+	maxDataPerWrite int
+}
+
+// NewDataWriter - Creates a new DataWriter
+func NewDataWriter(database *db.MongoDB) *DataWriter {
+	return &DataWriter{
+		database:        database,
+		maxDataPerWrite: _defaultMaxDataPerWrite,
+	}
+}
+
+// Write writes relation tuples and attributes to the storage.
+func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.write")
+	defer span.End()
+
+	if len(tupleCollection.GetTuples())+len(attributeCollection.GetAttributes()) > w.maxDataPerWrite {
+		return nil, errors.New("max data per write exceeded")
+	}
+
+	txID, err := w.transaction(ctx, tenantID, func(sc mongo.SessionContext, txID int64) error {
+		return w.write(sc, tenantID, txID, tupleCollection, attributeCollection)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to write data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(txID)).Encode(), nil
+}
+
+// Delete deletes relation tuples and attributes matching the given filters from the storage.
+func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
+
+	txID, err := w.transaction(ctx, tenantID, func(sc mongo.SessionContext, txID int64) error {
+		return w.delete(sc, tenantID, txID, tupleFilter, attributeFilter)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(txID)).Encode(), nil
+}
+
+// RunTransaction deletes and then writes the relation tuples and attributes of the transaction in a single
+// multi-document transaction.
+func (w *DataWriter) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.run-transaction")
+	defer span.End()
+
+	if len(transaction.Tuples.GetTuples())+len(transaction.Attributes.GetAttributes()) > w.maxDataPerWrite {
+		return nil, errors.New("max data per write exceeded")
+	}
+
+	txID, err := w.transaction(ctx, tenantID, func(sc mongo.SessionContext, txID int64) error {
+		for i := range transaction.TupleFilters {
+			if err := w.delete(sc, tenantID, txID, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
+			}
+		}
+		return w.write(sc, tenantID, txID, transaction.Tuples, transaction.Attributes)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to run data transaction: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(txID)).Encode(), nil
+}
+
+// errSchemaVersionConflict aborts the schema migrations expecting a version that is no longer the latest one.
+var errSchemaVersionConflict = errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String())
+
+// MigrateSchema writes the definitions of a schema version along with the deletions and writes of the transaction
+// migrating the data to it in a single multi-document transaction.
+func (w *DataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.migrate-schema")
+	defer span.End()
+
+	docs := make([]interface{}, 0, len(definitions))
+	for _, d := range definitions {
+		docs = append(docs, utils.SchemaDefinitionDocument{
+			TenantID:             d.TenantID,
+			Name:                 d.Name,
+			SerializedDefinition: d.SerializedDefinition,
+			Version:              d.Version,
+		})
+	}
+
+	// The latest version is read after the head of the tenant is moved, so concurrent migrations conflict on the head
+	// and are retried, reading the version the other one wrote.
+	txID, err := w.transaction(ctx, tenantID, func(sc mongo.SessionContext, txID int64) error {
+		if transaction.SchemaVersion != nil {
+			var head utils.SchemaDefinitionDocument
+			err := w.database.DB.Collection(db.SchemaDefinitionsCollection).FindOne(sc,
+				bson.M{"tenant_id": tenantID},
+				options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}).SetProjection(bson.M{"version": 1}),
+			).Decode(&head)
+			if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return err
+			}
+			if head.Version != *transaction.SchemaVersion {
+				return errSchemaVersionConflict
+			}
+		}
+
+		if len(docs) > 0 {
+			if _, err := w.database.DB.Collection(db.SchemaDefinitionsCollection).InsertMany(sc, docs); err != nil {
+				return err
+			}
+		}
+		for i := range transaction.TupleFilters {
+			if err := w.delete(sc, tenantID, txID, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
+			}
+		}
+		return w.write(sc, tenantID, txID, transaction.Tuples, transaction.Attributes)
+	})
+	if errors.Is(err, errSchemaVersionConflict) {
+		return nil, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to migrate schema: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(uint64(txID)).Encode(), nil
+}
+
+// write expires the live documents of the relation tuples and attributes and inserts them as created by the
+// transaction.
+func (w *DataWriter) write(sc mongo.SessionContext, tenantID string, txID int64, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) error {
+	if len(tupleCollection.GetTuples()) > 0 {
+		expire := bson.A{}
+		docs := make([]interface{}, 0, len(tupleCollection.GetTuples()))
+
+		titer := tupleCollection.CreateTupleIterator()
+		for titer.HasNext() {
+			t := titer.GetNext()
+			srelation := t.GetSubject().GetRelation()
+			if srelation == tuple.ELLIPSIS {
+				srelation = ""
+			}
+			doc := utils.TupleDocument{
+				TenantID:        tenantID,
+				EntityType:      t.GetEntity().GetType(),
+				EntityID:        t.GetEntity().GetId(),
+				Relation:        t.GetRelation(),
+				SubjectType:     t.GetSubject().GetType(),
+				SubjectID:       t.GetSubject().GetId(),
+				SubjectRelation: srelation,
+				CreatedTxID:     txID,
+			}
+			expire = append(expire, bson.M{
+				"entity_type":      doc.EntityType,
+				"entity_id":        doc.EntityID,
+				"relation":         doc.Relation,
+				"subject_type":     doc.SubjectType,
+				"subject_id":       doc.SubjectID,
+				"subject_relation": doc.SubjectRelation,
+			})
+			docs = append(docs, doc)
+		}
+
+		if err := expireDocuments(sc, w.database.DB.Collection(db.RelationTuplesCollection), tenantID, bson.D{{Key: "$or", Value: expire}}, txID); err != nil {
+			return err
+		}
+		if _, err := w.database.DB.Collection(db.RelationTuplesCollection).InsertMany(sc, docs); err != nil {
+			return err
+		}
+	}
+
+	if len(attributeCollection.GetAttributes()) > 0 {
+		expire := bson.A{}
+		docs := make([]interface{}, 0, len(attributeCollection.GetAttributes()))
+
+		aiter := attributeCollection.CreateAttributeIterator()
+		for aiter.HasNext() {
+			a := aiter.GetNext()
+			value, err := proto.Marshal(a.GetValue())
+			if err != nil {
+				return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+			}
+			expire = append(expire, bson.M{
+				"entity_type": a.GetEntity().GetType(),
+				"entity_id":   a.GetEntity().GetId(),
+				"attribute":   a.GetAttribute(),
+			})
+			docs = append(docs, utils.AttributeDocument{
+				TenantID:    tenantID,
+				EntityType:  a.GetEntity().GetType(),
+				EntityID:    a.GetEntity().GetId(),
+				Attribute:   a.GetAttribute(),
+				Value:       value,
+				CreatedTxID: txID,
+			})
+		}
+
+		if err := expireDocuments(sc, w.database.DB.Collection(db.AttributesCollection), tenantID, bson.D{{Key: "$or", Value: expire}}, txID); err != nil {
+			return err
+		}
+		if _, err := w.database.DB.Collection(db.AttributesCollection).InsertMany(sc, docs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete expires the live documents matching the filters, skipping the empty filters.
+func (w *DataWriter) delete(sc mongo.SessionContext, tenantID string, txID int64, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) error {
+	if !validation.IsTupleFilterEmpty(tupleFilter) {
+		if err := expireDocuments(sc, w.database.DB.Collection(db.RelationTuplesCollection), tenantID, utils.TuplesFilter(tenantID, tupleFilter), txID); err != nil {
+			return err
+		}
+	}
+	if !validation.IsAttributeFilterEmpty(attributeFilter) {
+		if err := expireDocuments(sc, w.database.DB.Collection(db.AttributesCollection), tenantID, utils.AttributesFilter(tenantID, attributeFilter), txID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transaction runs fn in a transaction that moves the head of the tenant to the next transaction ID and records
+// the transaction, and returns the ID.
+func (w *DataWriter) transaction(ctx context.Context, tenantID string, fn func(sc mongo.SessionContext, txID int64) error) (int64, error) {
+	session, err := w.database.Client.StartSession()
+	if err != nil {
+		return 0, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		var head struct {
+			TxID int64 `bson:"tx_id"`
+		}
+		err := w.database.DB.Collection(db.HeadsCollection).FindOneAndUpdate(sc,
+			bson.M{"_id": tenantID},
+			bson.M{"$inc": bson.M{"tx_id": int64(1)}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&head)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = w.database.DB.Collection(db.TransactionsCollection).InsertOne(sc, utils.TransactionDocument{
+			TenantID:  tenantID,
+			TxID:      head.TxID,
+			Timestamp: time.Now().UTC(),
+			Actor:     authn.ActorFromContext(ctx),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return head.TxID, fn(sc, head.TxID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.(int64), nil
+}
+
+// expireDocuments marks the live documents of the tenant matching the filter as expired by the transaction.
+func expireDocuments(sc mongo.SessionContext, collection *mongo.Collection, tenantID string, filter bson.D, txID int64) error {
+	_, err := collection.UpdateMany(sc,
+		utils.And(bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "expired_tx_id", Value: int64(0)}}, filter),
+		bson.M{"$set": bson.M{"expired_tx_id": txID}},
+	)
+	return err
+}
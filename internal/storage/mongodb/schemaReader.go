@@ -0,0 +1,212 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReader - Structure for Schema Reader
+type SchemaReader struct {
+	database *db.MongoDB
+	// versions are the schema versions compiled to read the definitions of their entities
+	versions *storage.CompiledVersions
+}
+
+// NewSchemaReader - Creates a new SchemaReader
+func NewSchemaReader(database *db.MongoDB) *SchemaReader {
+	return &SchemaReader{
+		database: database,
+		versions: storage.NewCompiledVersions(),
+	}
+}
+
+// ReadSchema returns the schema definition for a specific tenant and version as a structured object.
+func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string) (sch *base.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema")
+	defer span.End()
+
+	definitions, err := r.ReadSchemaDefinitions(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	serialized := make([]string, 0, len(definitions))
+	for _, d := range definitions {
+		serialized = append(serialized, d.Serialized())
+	}
+
+	sch, err = schema.NewSchemaFromStringDefinitions(false, serialized...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return sch, nil
+}
+
+// ReadEntityDefinition reads entity config from the storage.
+func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name, version string) (definition *base.EntityDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-entity-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = r.versions.Entity(tenantID, def.Version, name, func() (*base.SchemaDefinition, error) {
+		return r.ReadSchema(ctx, tenantID, def.Version)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+	return definition, def.Version, nil
+}
+
+// ReadRuleDefinition reads rule config from the storage.
+func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, name, version string) (definition *base.RuleDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-rule-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	var sch *base.SchemaDefinition
+	sch, err = schema.NewSchemaFromStringDefinitions(false, def.Serialized())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = schema.GetRuleByName(sch, name)
+	return definition, def.Version, err
+}
+
+// HeadVersion finds the latest version of the schema for the tenant. Versions sort by creation time.
+func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.head-version")
+	defer span.End()
+
+	var doc utils.SchemaDefinitionDocument
+	err = r.database.DB.Collection(db.SchemaDefinitionsCollection).FindOne(ctx,
+		bson.M{"tenant_id": tenantID},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}).SetProjection(bson.M{"version": 1}),
+	).Decode(&doc)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		}
+
+		slog.Error("Failed to read head version: ", slog.Any("error", err))
+
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return doc.Version, nil
+}
+
+// ReadSchemaDefinitions reads the serialized definitions of a schema version, ordered by name.
+func (r *SchemaReader) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-definitions")
+	defer span.End()
+
+	var docs []utils.SchemaDefinitionDocument
+	err = find(ctx, r.database.DB.Collection(db.SchemaDefinitionsCollection),
+		bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "version", Value: version}},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}}),
+		&docs,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema definitions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	definitions = make([]storage.SchemaDefinition, 0, len(docs))
+	for _, d := range docs {
+		definitions = append(definitions, storage.SchemaDefinition{
+			TenantID:             d.TenantID,
+			Name:                 d.Name,
+			SerializedDefinition: d.SerializedDefinition,
+			Version:              d.Version,
+		})
+	}
+
+	return definitions, nil
+}
+
+// ReadSchemaVersions reads the versions of the schema of the tenant, oldest first. Versions sort by creation time.
+func (r *SchemaReader) ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-versions")
+	defer span.End()
+
+	values, err := r.database.DB.Collection(db.SchemaDefinitionsCollection).Distinct(ctx, "version", bson.M{"tenant_id": tenantID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema versions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	versions = make([]string, 0, len(values))
+	for _, v := range values {
+		if version, ok := v.(string); ok {
+			versions = append(versions, version)
+		}
+	}
+	sort.Strings(versions)
+
+	return versions, nil
+}
+
+// readDefinition reads a single serialized definition of a schema version.
+func (r *SchemaReader) readDefinition(ctx context.Context, tenantID, name, version string) (storage.SchemaDefinition, error) {
+	var doc utils.SchemaDefinitionDocument
+	err := r.database.DB.Collection(db.SchemaDefinitionsCollection).FindOne(ctx,
+		bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "name", Value: name}, {Key: "version", Value: version}},
+	).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		}
+		return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	return storage.SchemaDefinition{
+		TenantID:             doc.TenantID,
+		Name:                 doc.Name,
+		SerializedDefinition: doc.SerializedDefinition,
+		Version:              doc.Version,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaWriter - Structure for Schema Writer
+type SchemaWriter struct {
+	database *db.MongoDB
+}
+
+// NewSchemaWriter creates a new SchemaWriter
+func NewSchemaWriter(database *db.MongoDB) *SchemaWriter {
+	return &SchemaWriter{
+		database: database,
+	}
+}
+
+// WriteSchema writes the definitions of a schema version to the storage.
+func (w *SchemaWriter) WriteSchema(ctx context.Context, definitions []storage.SchemaDefinition) (err error) {
+	ctx, span := tracer.Start(ctx, "schema-writer.write-schema")
+	defer span.End()
+
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, 0, len(definitions))
+	for _, d := range definitions {
+		docs = append(docs, utils.SchemaDefinitionDocument{
+			TenantID:             d.TenantID,
+			Name:                 d.Name,
+			SerializedDefinition: d.SerializedDefinition,
+			Version:              d.Version,
+		})
+	}
+
+	if _, err = w.database.DB.Collection(db.SchemaDefinitionsCollection).InsertMany(ctx, docs); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to write schema: ", slog.Any("error", err))
+
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil
+}
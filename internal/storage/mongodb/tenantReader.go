@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantReader - Structure for Tenant Reader
+type TenantReader struct {
+	database *db.MongoDB
+}
+
+// NewTenantReader creates a new TenantReader
+func NewTenantReader(database *db.MongoDB) *TenantReader {
+	return &TenantReader{
+		database: database,
+	}
+}
+
+// ListTenants reads the tenants ordered by ID, a page at a time.
+func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-reader.list-tenants")
+	defer span.End()
+
+	from, err := decodeString(pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	var docs []utils.TenantDocument
+	err = find(ctx, r.database.DB.Collection(db.TenantsCollection),
+		bson.D{{Key: "_id", Value: bson.M{"$gte": from}}},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(pagination.PageSize())+1),
+		&docs,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to list tenants: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	if len(docs) > int(pagination.PageSize()) {
+		ct = utils.NewContinuousToken(docs[pagination.PageSize()].ID).Encode()
+		docs = docs[:pagination.PageSize()]
+	}
+
+	tenants = make([]*base.Tenant, 0, len(docs))
+	for _, d := range docs {
+		tenants = append(tenants, storage.Tenant{ID: d.ID, Name: d.Name, CreatedAt: d.CreatedAt}.ToTenant())
+	}
+
+	return tenants, ct, nil
+}
@@ -0,0 +1,76 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantWriter - Structure for Tenant Writer
+type TenantWriter struct {
+	database *db.MongoDB
+}
+
+// NewTenantWriter creates a new TenantWriter
+func NewTenantWriter(database *db.MongoDB) *TenantWriter {
+	return &TenantWriter{
+		database: database,
+	}
+}
+
+// CreateTenant creates a tenant, failing if a tenant with the same ID exists.
+func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.create-tenant")
+	defer span.End()
+
+	doc := utils.TenantDocument{
+		ID:        id,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if _, err = w.database.DB.Collection(db.TenantsCollection).InsertOne(ctx, doc); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		if mongo.IsDuplicateKeyError(err) {
+			slog.Error("Duplicate key violation: Tenant with ID already exists", slog.Any("id", id))
+
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+		}
+
+		slog.Error("Failed to create tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return storage.Tenant{ID: doc.ID, Name: doc.Name, CreatedAt: doc.CreatedAt}.ToTenant(), nil
+}
+
+// DeleteTenant deletes a tenant and returns it.
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+
+	var doc utils.TenantDocument
+	if err = w.database.DB.Collection(db.TenantsCollection).FindOneAndDelete(ctx, bson.M{"_id": tenantID}).Decode(&doc); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return storage.Tenant{ID: doc.ID, Name: doc.Name, CreatedAt: doc.CreatedAt}.ToTenant(), nil
+}
@@ -0,0 +1,7 @@
+package mongodb
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("storage.mongodb")
@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TupleDocument - Structure of a relation tuple document
+type TupleDocument struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	TenantID        string             `bson:"tenant_id"`
+	EntityType      string             `bson:"entity_type"`
+	EntityID        string             `bson:"entity_id"`
+	Relation        string             `bson:"relation"`
+	SubjectType     string             `bson:"subject_type"`
+	SubjectID       string             `bson:"subject_id"`
+	SubjectRelation string             `bson:"subject_relation"`
+	CreatedTxID     int64              `bson:"created_tx_id"`
+	ExpiredTxID     int64              `bson:"expired_tx_id"`
+}
+
+// ToRelationTuple - Convert the document to a storage relation tuple
+func (d TupleDocument) ToRelationTuple() storage.RelationTuple {
+	return storage.RelationTuple{
+		TenantID:        d.TenantID,
+		EntityType:      d.EntityType,
+		EntityID:        d.EntityID,
+		Relation:        d.Relation,
+		SubjectType:     d.SubjectType,
+		SubjectID:       d.SubjectID,
+		SubjectRelation: d.SubjectRelation,
+	}
+}
+
+// AttributeDocument - Structure of an attribute document. The value is the serialized protobuf Any.
+type AttributeDocument struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	TenantID    string             `bson:"tenant_id"`
+	EntityType  string             `bson:"entity_type"`
+	EntityID    string             `bson:"entity_id"`
+	Attribute   string             `bson:"attribute"`
+	Value       []byte             `bson:"value"`
+	CreatedTxID int64              `bson:"created_tx_id"`
+	ExpiredTxID int64              `bson:"expired_tx_id"`
+}
+
+// ToAttribute - Convert the document to a storage attribute
+func (d AttributeDocument) ToAttribute() (storage.Attribute, error) {
+	value := &anypb.Any{}
+	if err := proto.Unmarshal(d.Value, value); err != nil {
+		return storage.Attribute{}, err
+	}
+	return storage.Attribute{
+		TenantID:   d.TenantID,
+		EntityType: d.EntityType,
+		EntityID:   d.EntityID,
+		Attribute:  d.Attribute,
+		Value:      value,
+	}, nil
+}
+
+// SchemaDefinitionDocument - Structure of a schema definition document
+type SchemaDefinitionDocument struct {
+	TenantID             string `bson:"tenant_id"`
+	Name                 string `bson:"name"`
+	SerializedDefinition []byte `bson:"serialized_definition"`
+	Version              string `bson:"version"`
+}
+
+// TenantDocument - Structure of a tenant document
+type TenantDocument struct {
+	ID        string    `bson:"_id"`
+	Name      string    `bson:"name"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// TransactionDocument - Structure of a transaction document. Transaction IDs increase by one per write of a tenant.
+type TransactionDocument struct {
+	TenantID  string    `bson:"tenant_id"`
+	TxID      int64     `bson:"tx_id"`
+	Timestamp time.Time `bson:"timestamp"`
+	Actor     string    `bson:"actor"`
+}
+
+// SnapshotFilter - Filter matching the documents that are part of the snapshot of the given transaction
+func SnapshotFilter(snap uint64) bson.D {
+	return bson.D{
+		{Key: "created_tx_id", Value: bson.M{"$lte": int64(snap)}},
+		{Key: "$or", Value: bson.A{
+			bson.M{"expired_tx_id": int64(0)},
+			bson.M{"expired_tx_id": bson.M{"$gt": int64(snap)}},
+		}},
+	}
+}
+
+// TuplesFilter - Filter matching the relation tuples of the tenant selected by the given filter
+func TuplesFilter(tenantID string, filter *base.TupleFilter) bson.D {
+	f := bson.D{{Key: "tenant_id", Value: tenantID}}
+	if filter.GetEntity().GetType() != "" {
+		f = append(f, bson.E{Key: "entity_type", Value: filter.GetEntity().GetType()})
+	}
+	if len(filter.GetEntity().GetIds()) > 0 {
+		f = append(f, bson.E{Key: "entity_id", Value: bson.M{"$in": filter.GetEntity().GetIds()}})
+	}
+	if filter.GetRelation() != "" {
+		f = append(f, bson.E{Key: "relation", Value: filter.GetRelation()})
+	}
+	if filter.GetSubject().GetType() != "" {
+		f = append(f, bson.E{Key: "subject_type", Value: filter.GetSubject().GetType()})
+	}
+	if len(filter.GetSubject().GetIds()) > 0 {
+		f = append(f, bson.E{Key: "subject_id", Value: bson.M{"$in": filter.GetSubject().GetIds()}})
+	}
+	if filter.GetSubject().GetRelation() != "" {
+		f = append(f, bson.E{Key: "subject_relation", Value: filter.GetSubject().GetRelation()})
+	}
+	return f
+}
+
+// AttributesFilter - Filter matching the attributes of the tenant selected by the given filter
+func AttributesFilter(tenantID string, filter *base.AttributeFilter) bson.D {
+	f := bson.D{{Key: "tenant_id", Value: tenantID}}
+	if filter.GetEntity().GetType() != "" {
+		f = append(f, bson.E{Key: "entity_type", Value: filter.GetEntity().GetType()})
+	}
+	if len(filter.GetEntity().GetIds()) > 0 {
+		f = append(f, bson.E{Key: "entity_id", Value: bson.M{"$in": filter.GetEntity().GetIds()}})
+	}
+	if len(filter.GetAttributes()) > 0 {
+		f = append(f, bson.E{Key: "attribute", Value: bson.M{"$in": filter.GetAttributes()}})
+	}
+	return f
+}
+
+// And - Combines filters into one that matches the documents matching all of them, skipping empty filters
+func And(filters ...bson.D) bson.D {
+	clauses := make(bson.A, 0, len(filters))
+	for _, f := range filters {
+		if len(f) > 0 {
+			clauses = append(clauses, f)
+		}
+	}
+	return bson.D{{Key: "$and", Value: clauses}}
+}
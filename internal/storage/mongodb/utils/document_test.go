@@ -0,0 +1,81 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestSnapshotFilter(t *testing.T) {
+	assert.Equal(t, bson.D{
+		{Key: "created_tx_id", Value: bson.M{"$lte": int64(42)}},
+		{Key: "$or", Value: bson.A{
+			bson.M{"expired_tx_id": int64(0)},
+			bson.M{"expired_tx_id": bson.M{"$gt": int64(42)}},
+		}},
+	}, utils.SnapshotFilter(42))
+}
+
+func TestTuplesFilter(t *testing.T) {
+	filter := utils.TuplesFilter("t1", &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1", "2"}},
+		Relation: "member",
+		Subject:  &base.SubjectFilter{Type: "user", Relation: ""},
+	})
+
+	assert.Equal(t, bson.D{
+		{Key: "tenant_id", Value: "t1"},
+		{Key: "entity_type", Value: "organization"},
+		{Key: "entity_id", Value: bson.M{"$in": []string{"1", "2"}}},
+		{Key: "relation", Value: "member"},
+		{Key: "subject_type", Value: "user"},
+	}, filter)
+}
+
+func TestAttributesFilter(t *testing.T) {
+	filter := utils.AttributesFilter("t1", &base.AttributeFilter{
+		Entity:     &base.EntityFilter{Type: "repository"},
+		Attributes: []string{"public"},
+	})
+
+	assert.Equal(t, bson.D{
+		{Key: "tenant_id", Value: "t1"},
+		{Key: "entity_type", Value: "repository"},
+		{Key: "attribute", Value: bson.M{"$in": []string{"public"}}},
+	}, filter)
+}
+
+func TestAnd(t *testing.T) {
+	a := bson.D{{Key: "a", Value: 1}}
+	b := bson.D{{Key: "b", Value: 2}}
+
+	assert.Equal(t, bson.D{{Key: "$and", Value: bson.A{a, b}}}, utils.And(a, bson.D{}, b))
+}
+
+func TestAttributeDocument(t *testing.T) {
+	value, err := anypb.New(&base.StringValue{Data: "hello"})
+	assert.NoError(t, err)
+
+	b, err := proto.Marshal(value)
+	assert.NoError(t, err)
+
+	a, err := utils.AttributeDocument{
+		TenantID:   "t1",
+		EntityType: "repository",
+		EntityID:   "1",
+		Attribute:  "name",
+		Value:      b,
+	}.ToAttribute()
+	assert.NoError(t, err)
+
+	data := &base.StringValue{}
+	assert.NoError(t, a.Value.UnmarshalTo(data))
+	assert.Equal(t, "hello", data.GetData())
+	assert.Equal(t, "name", a.ToAttribute().GetAttribute())
+}
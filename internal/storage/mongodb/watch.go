@@ -0,0 +1,189 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Permify/permify/internal/storage/mongodb/snapshot"
+	"github.com/Permify/permify/internal/storage/mongodb/utils"
+	db "github.com/Permify/permify/pkg/database/mongodb"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Watch is an implementation of the storage.Watch interface that follows a change stream of the transactions
+// of the tenant. Transactions are inserted in the same multi-document transaction as their data, so the changes
+// of a transaction are committed once its insert is reported.
+type Watch struct {
+	database *db.MongoDB
+	// options
+	bufferSize int
+}
+
+// NewWatcher returns a new instance of the Watch.
+func NewWatcher(database *db.MongoDB) *Watch {
+	return &Watch{
+		database:   database,
+		bufferSize: _defaultWatchBufferSize,
+	}
+}
+
+// Watch returns a channel that emits a stream of changes to the relation tuples and attributes of the tenant
+// made after the given snapshot.
+func (w *Watch) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	changes := make(chan *base.DataChanges, w.bufferSize)
+	errs := make(chan error, 1)
+
+	slog.Info("Watching for changes in the database. ", slog.Any("tenant_id", tenantID), slog.Any("snapshot", snap))
+
+	cr, err := decodeSnapshot(snap)
+	if err != nil {
+		errs <- err
+
+		slog.Error("Failed to decode snapshot.", slog.Any("error", err))
+
+		return changes, errs
+	}
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		// The stream is opened before catching up, so that no transaction committed in between is missed.
+		stream, err := w.database.DB.Collection(db.TransactionsCollection).Watch(ctx, mongo.Pipeline{
+			{{Key: "$match", Value: bson.D{
+				{Key: "operationType", Value: "insert"},
+				{Key: "fullDocument.tenant_id", Value: tenantID},
+			}}},
+		})
+		if err != nil {
+			slog.Error("Failed to open change stream. ", slog.Any("error", err))
+			errs <- err
+			return
+		}
+		defer stream.Close(context.Background())
+
+		send := func(txID int64) bool {
+			if uint64(txID) <= cr {
+				return true
+			}
+			updates, err := w.getChanges(ctx, tenantID, txID)
+			if err != nil {
+				slog.Error("Failed to get changes for transaction. ", slog.Any("id", txID), slog.Any("error", err))
+				errs <- err
+				return false
+			}
+			select {
+			case changes <- updates:
+				slog.Info("Sent updates to the changes channel for transaction. ", slog.Any("id", txID))
+			case <-ctx.Done():
+				slog.Error("Context canceled, stopping watch.")
+				errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+				return false
+			}
+			cr = uint64(txID)
+			return true
+		}
+
+		var missed []utils.TransactionDocument
+		err = find(ctx, w.database.DB.Collection(db.TransactionsCollection),
+			bson.D{{Key: "tenant_id", Value: tenantID}, {Key: "tx_id", Value: bson.M{"$gt": int64(cr)}}},
+			options.Find().SetSort(bson.D{{Key: "tx_id", Value: 1}}),
+			&missed,
+		)
+		if err != nil {
+			slog.Error("Failed to get recent transactions. ", slog.Any("error", err))
+			errs <- err
+			return
+		}
+		for _, tx := range missed {
+			if !send(tx.TxID) {
+				return
+			}
+		}
+
+		for stream.Next(ctx) {
+			var event struct {
+				FullDocument utils.TransactionDocument `bson:"fullDocument"`
+			}
+			if err := stream.Decode(&event); err != nil {
+				slog.Error("Failed to decode change event. ", slog.Any("error", err))
+				errs <- err
+				return
+			}
+			if !send(event.FullDocument.TxID) {
+				return
+			}
+		}
+
+		if ctx.Err() != nil {
+			slog.Error("Context canceled, stopping watch.")
+			errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+			return
+		}
+		if err := stream.Err(); err != nil {
+			slog.Error("Change stream failed. ", slog.Any("error", err))
+			errs <- err
+		}
+	}()
+
+	slog.Info("Watch started successfully.")
+
+	return changes, errs
+}
+
+// getChanges retrieves the relation tuples and attributes created or expired by the transaction.
+func (w *Watch) getChanges(ctx context.Context, tenantID string, txID int64) (*base.DataChanges, error) {
+	changes := &base.DataChanges{
+		SnapToken: snapshot.NewToken(uint64(txID)).Encode().String(),
+	}
+
+	filter := bson.D{
+		{Key: "tenant_id", Value: tenantID},
+		{Key: "$or", Value: bson.A{bson.M{"created_tx_id": txID}, bson.M{"expired_tx_id": txID}}},
+	}
+
+	var tuples []utils.TupleDocument
+	if err := find(ctx, w.database.DB.Collection(db.RelationTuplesCollection), filter, nil, &tuples); err != nil {
+		return nil, err
+	}
+	for _, t := range tuples {
+		op := base.DataChange_OPERATION_CREATE
+		if t.ExpiredTxID == txID {
+			op = base.DataChange_OPERATION_DELETE
+		}
+		changes.DataChanges = append(changes.DataChanges, &base.DataChange{
+			Operation: op,
+			Type: &base.DataChange_Tuple{
+				Tuple: t.ToRelationTuple().ToTuple(),
+			},
+		})
+	}
+
+	var attributes []utils.AttributeDocument
+	if err := find(ctx, w.database.DB.Collection(db.AttributesCollection), filter, nil, &attributes); err != nil {
+		return nil, err
+	}
+	for _, d := range attributes {
+		a, err := d.ToAttribute()
+		if err != nil {
+			return nil, err
+		}
+		op := base.DataChange_OPERATION_CREATE
+		if d.ExpiredTxID == txID {
+			op = base.DataChange_OPERATION_DELETE
+		}
+		changes.DataChanges = append(changes.DataChanges, &base.DataChange{
+			Operation: op,
+			Type: &base.DataChange_Attribute{
+				Attribute: a.ToAttribute(),
+			},
+		})
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"golang.org/x/crypto/acme/autocert"
+
+	db "github.com/Permify/permify/pkg/database/postgres"
+)
+
+// AutocertCache - autocert.Cache keeping the certificates obtained from the ACME certificate authority, and the key
+// of its account, in the database, so that the replicas sharing it share them
+type AutocertCache struct {
+	database *db.Postgres
+}
+
+// NewAutocertCache - Creates a new AutocertCache
+func NewAutocertCache(database *db.Postgres) *AutocertCache {
+	return &AutocertCache{
+		database: database,
+	}
+}
+
+// Get - Returns the data of the key, or autocert.ErrCacheMiss if it is not cached
+func (c *AutocertCache) Get(ctx context.Context, key string) (data []byte, err error) {
+	ctx, span := tracer.Start(ctx, "autocert-cache.get")
+	defer span.End()
+
+	query := c.database.Builder.Select("data").From(AutocertCacheTable).Where(squirrel.Eq{"key": key}).RunWith(c.database.DB)
+	err = query.QueryRowContext(ctx).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, autocert.ErrCacheMiss
+	}
+	return data, err
+}
+
+// Put - Caches the data of the key, replacing its previous data
+func (c *AutocertCache) Put(ctx context.Context, key string, data []byte) error {
+	ctx, span := tracer.Start(ctx, "autocert-cache.put")
+	defer span.End()
+
+	query := c.database.Builder.Insert(AutocertCacheTable).Columns("key", "data").Values(key, data).
+		Suffix("ON CONFLICT (key) DO UPDATE SET data = EXCLUDED.data, updated_at = now() AT TIME ZONE 'UTC'").
+		RunWith(c.database.DB)
+	_, err := query.ExecContext(ctx)
+	return err
+}
+
+// Delete - Removes the data of the key from the cache
+func (c *AutocertCache) Delete(ctx context.Context, key string) error {
+	ctx, span := tracer.Start(ctx, "autocert-cache.delete")
+	defer span.End()
+
+	query := c.database.Builder.Delete(AutocertCacheTable).Where(squirrel.Eq{"key": key}).RunWith(c.database.DB)
+	_, err := query.ExecContext(ctx)
+	return err
+}
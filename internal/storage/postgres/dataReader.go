@@ -8,10 +8,12 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/golang/protobuf/jsonpb"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.opentelemetry.io/otel/codes"
 
@@ -933,6 +935,274 @@ func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID
 	return subjectIDs, database.NewNoopContinuousToken().Encode(), nil
 }
 
+// ReadRelationshipHistory reads the creations and deletions of relation tuples matching the given filter, ordered by transaction.
+// Only changes whose transaction records are still retained can be read; older ones are removed by the garbage collector.
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) (changes []*base.TupleChange, ct database.EncodedContinuousToken, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "data-reader.read-relationship-history")
+	defer span.End()
+
+	slog.Info("Reading relationship history for tenantID: ", slog.String("tenant_id", tenantID))
+
+	// Build one select per kind of change. A created tuple is joined with the transaction that created it,
+	// and an expired tuple with the transaction that expired it.
+	kinds := []struct {
+		kind   int
+		column string
+	}{
+		{kind: 0, column: "created_tx_id"},
+		{kind: 1, column: "expired_tx_id"},
+	}
+
+	var union squirrel.SelectBuilder
+	for i, k := range kinds {
+		b := squirrel.Select(
+			"r.id", "r.entity_type", "r.entity_id", "r.relation", "r.subject_type", "r.subject_id", "r.subject_relation",
+			"t.id AS tx", "t.timestamp", "t.actor", fmt.Sprintf("%d AS kind", k.kind),
+		).From(RelationTuplesTable + " r").
+			Join(fmt.Sprintf("%s t ON t.id = r.%s", TransactionsTable, k.column)).
+			Where(squirrel.Eq{"r.tenant_id": tenantID})
+		b = utils.TuplesFilterQueryForSelectBuilder(b, filter)
+		if !start.IsZero() {
+			b = b.Where(squirrel.GtOrEq{"t.timestamp": start.UTC()})
+		}
+		if !end.IsZero() {
+			b = b.Where(squirrel.LtOrEq{"t.timestamp": end.UTC()})
+		}
+
+		if i == 0 {
+			union = b
+			continue
+		}
+
+		var q string
+		var a []interface{}
+		q, a, err = b.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Failed to generate SQL query: ", slog.Any("error", err))
+
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+		union = union.Suffix("UNION ALL "+q, a...)
+	}
+
+	builder := r.database.Builder.Select("*").FromSelect(union, "changes")
+
+	// Apply the pagination token, which holds the position of the last change of the previous page.
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Failed to apply pagination token: ", slog.Any("error", err))
+
+			return nil, nil, err
+		}
+
+		parts := strings.Split(t.(utils.ContinuousToken).Value, ":")
+		if len(parts) != 3 {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		position := make([]uint64, len(parts))
+		for i, part := range parts {
+			position[i], err = strconv.ParseUint(part, 10, 64)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+
+				slog.Error("Failed to parse pagination token: ", slog.Any("error", err))
+
+				return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+			}
+		}
+		builder = builder.Where("(tx, id, kind) > (?::xid8, ?, ?)", strconv.FormatUint(position[0], 10), position[1], position[2])
+	}
+
+	builder = builder.OrderBy("tx", "id", "kind").Limit(uint64(pagination.PageSize() + 1))
+
+	// Generate the SQL query and arguments.
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to generate SQL query: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	slog.Debug("Generated SQL query: ", slog.String("query", query), "arguments", args)
+
+	// Execute the query.
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to execute SQL query: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	// Iterate through the rows and build the list of changes.
+	var last string
+	var more bool
+	changes = make([]*base.TupleChange, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		rt := storage.RelationTuple{}
+		var tx types.XID8
+		var timestamp time.Time
+		var actor string
+		var kind int
+		err = rows.Scan(&rt.ID, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &tx, &timestamp, &actor, &kind)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Failed to scan result rows: ", slog.Any("error", err))
+
+			return nil, nil, err
+		}
+
+		// The query fetches one extra row to tell whether there is another page.
+		if len(changes) == int(pagination.PageSize()) {
+			more = true
+			break
+		}
+
+		operation := base.DataChange_OPERATION_CREATE
+		if kind == 1 {
+			operation = base.DataChange_OPERATION_DELETE
+		}
+
+		changes = append(changes, &base.TupleChange{
+			Operation: operation,
+			Tuple:     rt.ToTuple(),
+			SnapToken: snapshot.Token{Value: tx}.Encode().String(),
+			Timestamp: timestamppb.New(timestamp),
+			Actor:     actor,
+		})
+		last = fmt.Sprintf("%d:%d:%d", tx.Uint, rt.ID, kind)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to process result rows: ", slog.Any("error", err))
+
+		return nil, nil, err
+	}
+
+	slog.Info("Successfully read relationship history from the database. Number of changes returned: ", slog.Any("count", len(changes)))
+
+	// Return the results and encoded continuous token for pagination.
+	if more {
+		return changes, utils.NewContinuousToken(last).Encode(), nil
+	}
+
+	return changes, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// LookupEntities reads the identifiers of the entities of the lookup plan the subject is in, evaluating the set
+// operations of the plan in a single query instead of reading the candidate entities of each of its nodes.
+func (r *DataReader) LookupEntities(ctx context.Context, tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap string) (ids []string, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "data-reader.lookup-entities")
+	defer span.End()
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Begin a new read-only transaction with the specified isolation level.
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Rollback the transaction in case of any error.
+	defer utils.Rollback(tx)
+
+	// The relationships of wildcard entities, or of subjects the plan doesn't follow, are left to the lookup engine.
+	query, args := utils.LookupGuardQuery(tenantID, plan, st.(snapshot.Token).Value.Uint)
+
+	var unsupported bool
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&unsupported)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to execute SQL query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if unsupported {
+		return nil, storage.ErrLookupUnsupported
+	}
+
+	query, args = utils.LookupQuery(tenantID, plan, subject, st.(snapshot.Token).Value.Uint)
+
+	slog.Debug("Generated SQL query: ", slog.String("query", query), "with args", slog.Any("arguments", args))
+
+	// Execute the query and retrieve the rows.
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to execute SQL query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID string
+		err = rows.Scan(&entityID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		ids = append(ids, entityID)
+	}
+
+	// Check for any errors during iteration.
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Commit the transaction.
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 // HeadSnapshot retrieves the latest snapshot token associated with the tenant.
 func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
 	// Start a new trace span and end it when the function exits.
@@ -976,3 +1246,79 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	// Return the latest snapshot token associated with the tenant.
 	return snapshot.Token{Value: xid}, nil
 }
+
+// SnapshotAt retrieves the snapshot token that was current for the tenant at the given point in time.
+// Snapshots older than the oldest retained transaction have been garbage collected and cannot be served.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := tracer.Start(ctx, "data-reader.snapshot-at")
+	defer span.End()
+
+	slog.Info("Getting snapshot at the given time for tenantID: ", slog.String("tenant_id", tenantID), slog.Time("at", at))
+
+	// Find the oldest retained transaction of the tenant. State before it cannot be reconstructed, either because
+	// it was removed by the garbage collector or because nothing had been written yet.
+	var horizon time.Time
+	builder := r.database.Builder.Select("timestamp").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("id ASC").Limit(1)
+	query, args, err := builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to build the query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	err = r.database.DB.QueryRowContext(ctx, query, args...).Scan(&horizon)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to execute query: ", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	// Transaction timestamps are stored in UTC without a time zone.
+	at = at.UTC()
+	if err == nil && at.Before(horizon) {
+		err = errors.New(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var xid types.XID8
+
+	// Build the query to find the latest transaction of the tenant committed at or before the given time.
+	builder = r.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).Where(squirrel.LtOrEq{"timestamp": at}).OrderBy("id DESC").Limit(1)
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to build the query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	err = r.database.DB.QueryRowContext(ctx, query, args...).Scan(&xid)
+	if err != nil {
+		// If no rows are found, the tenant had no data at that time.
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot.Token{Value: types.XID8{Uint: 0}}, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to execute query: ", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	slog.Info("Successfully retrieved snapshot token at the given time")
+
+	return snapshot.Token{Value: xid}, nil
+}
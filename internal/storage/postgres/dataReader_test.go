@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
@@ -86,6 +88,115 @@ var _ = Describe("DataReader", func() {
 		})
 	})
 
+	Context("Snapshot At", func() {
+		It("should retrieve the snapshot that was current at the given time", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			first, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			time.Sleep(time.Millisecond * 10)
+			between := time.Now()
+			time.Sleep(time.Millisecond * 10)
+
+			tup2, err := tuple.Tuple("organization:organization-2#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup2), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The snapshot between the two writes should be the first write
+			snap, err := dataReader.SnapshotAt(ctx, "t1", between)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snap.Encode()).Should(Equal(first))
+
+			// Reading at that snapshot should only return the first tuple
+			it, err := dataReader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+				},
+			}, snap.Encode().String())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var ids []string
+			for it.HasNext() {
+				ids = append(ids, it.GetNext().GetEntity().GetId())
+			}
+			Expect(ids).Should(Equal([]string{"organization-1"}))
+
+			// Points in time before any retained transaction cannot be served
+			_, err = dataReader.SnapshotAt(ctx, "t1", time.Now().Add(-time.Hour))
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String()))
+
+			// The horizon is the one of the tenant, the transactions of the other tenants don't extend it
+			_, err = dataWriter.Write(ctx, "t2", database.NewTupleCollection(tup1), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataReader.SnapshotAt(ctx, "t2", between)
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String()))
+		})
+	})
+
+	Context("Read Relationship History", func() {
+		It("should read creations and deletions in order with the actor that made them", func() {
+			ctx := authn.ContextWithActor(context.Background(), "user-admin")
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("organization:organization-1#admin@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token2, err := dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"organization-1"},
+				},
+				Subject: &base.SubjectFilter{
+					Type: "user",
+					Ids:  []string{"user-1"},
+				},
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			filter := &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"organization-1"},
+				},
+			}
+
+			changes1, ct1, err := dataReader.ReadRelationshipHistory(ctx, "t1", filter, time.Time{}, time.Time{}, database.NewPagination(database.Size(2), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(changes1)).Should(Equal(2))
+			Expect(ct1.String()).ShouldNot(Equal(""))
+
+			Expect(changes1[0].GetOperation()).Should(Equal(base.DataChange_OPERATION_CREATE))
+			Expect(changes1[0].GetSnapToken()).Should(Equal(token1.String()))
+			Expect(changes1[0].GetActor()).Should(Equal("user-admin"))
+			Expect(changes1[1].GetOperation()).Should(Equal(base.DataChange_OPERATION_CREATE))
+
+			changes2, ct2, err := dataReader.ReadRelationshipHistory(ctx, "t1", filter, time.Time{}, time.Time{}, database.NewPagination(database.Size(2), database.Token(ct1.String())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(changes2)).Should(Equal(1))
+			Expect(ct2.String()).Should(Equal(""))
+
+			Expect(changes2[0].GetOperation()).Should(Equal(base.DataChange_OPERATION_DELETE))
+			Expect(changes2[0].GetSnapToken()).Should(Equal(token2.String()))
+			Expect(changes2[0].GetTuple().GetSubject().GetId()).Should(Equal("user-1"))
+
+			// Changes committed after the end of the time range are excluded
+			changes3, _, err := dataReader.ReadRelationshipHistory(ctx, "t1", filter, time.Time{}, time.Now().Add(-time.Hour), database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(changes3)).Should(Equal(0))
+		})
+	})
+
 	Context("Query Relationships", func() {
 		It("should write relationships and query relationships correctly", func() {
 			ctx := context.Background()
@@ -591,4 +702,83 @@ var _ = Describe("DataReader", func() {
 			Expect(isSameArray(refs4, []string{"organization-8"})).Should(BeTrue())
 		})
 	})
+
+	Context("Lookup Entities", func() {
+		It("should evaluate the set operations of the lookup plan", func() {
+			ctx := context.Background()
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"organization:1#admin@user:1",
+				"organization:2#admin@group:1#member",
+				"group:1#member@user:1",
+				"doc:1#org@organization:1",
+				"doc:2#org@organization:2",
+				"doc:3#org@organization:1",
+				"doc:3#owner@user:1",
+				"doc:4#owner@user:1",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// permission edit = org.admin not owner
+			member := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "group", Relation: "member", Branches: []storage.LookupBranch{{SubjectType: "user"}}}
+			admin := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "organization", Relation: "admin", Branches: []storage.LookupBranch{{SubjectType: "user"}, {SubjectType: "group", SubjectRelation: "member", Subjects: member}}}
+			owner := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "doc", Relation: "owner", Branches: []storage.LookupBranch{{SubjectType: "user"}}}
+			plan := &storage.LookupPlan{Operation: storage.LookupExclusion, Children: []*storage.LookupPlan{
+				{Operation: storage.LookupRelationships, EntityType: "doc", Relation: "org", Branches: []storage.LookupBranch{{SubjectType: "organization", AnyRelation: true, Subjects: admin}}},
+				owner,
+			}}
+
+			ids, err := dataReader.LookupEntities(ctx, "t1", plan, &base.Subject{Type: "user", Id: "1"}, token1.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(isSameArray(ids, []string{"1", "2"})).Should(BeTrue())
+
+			// The relationships of wildcard entities are left to the lookup engine
+			wildcard, err := tuple.Tuple("doc:*#owner@user:2")
+			Expect(err).ShouldNot(HaveOccurred())
+			token2, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(wildcard), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ids, err = dataReader.LookupEntities(ctx, "t1", plan, &base.Subject{Type: "user", Id: "1"}, token1.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(isSameArray(ids, []string{"1", "2"})).Should(BeTrue())
+
+			_, err = dataReader.LookupEntities(ctx, "t1", plan, &base.Subject{Type: "user", Id: "1"}, token2.String())
+			Expect(err).Should(Equal(storage.ErrLookupUnsupported))
+		})
+	})
+
+	Context("Statement Cache", func() {
+		It("should reuse the statements of the queries at every snapshot", func() {
+			ctx := context.Background()
+
+			filter := &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"organization-1", "*"},
+				},
+				Relation: "admin",
+			}
+
+			for i := 0; i < 3; i++ {
+				t, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				token, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(t), database.NewAttributeCollection())
+				Expect(err).ShouldNot(HaveOccurred())
+
+				_, err = dataReader.QueryRelationships(ctx, "t1", filter, token.String())
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			stats := db.(*PQDatabase.Postgres).StatementCacheStats()
+			Expect(stats.Hits).Should(BeNumerically(">", 0))
+		})
+	})
 })
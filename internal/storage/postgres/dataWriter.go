@@ -11,7 +11,10 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/postgres/snapshot"
 	"github.com/Permify/permify/internal/storage/postgres/types"
 	"github.com/Permify/permify/internal/storage/postgres/utils"
@@ -51,242 +54,194 @@ func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection
 		return nil, errors.New("max data per write exceeded")
 	}
 
-	for i := 0; i <= w.maxRetries; i++ {
-		var tx *sql.Tx
-		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-
-			slog.Error("Failed to begin transaction: ", slog.Any("error", err))
-
-			return nil, err
-		}
-
-		slog.Debug("Inserting transaction record for tenant: ", slog.String("tenant_id", tenantID))
-
-		transaction := w.database.Builder.Insert("transactions").
-			Columns("tenant_id").
-			Values(tenantID).
-			Suffix("RETURNING id").RunWith(tx)
-		if err != nil {
-			utils.Rollback(tx)
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-
-			slog.Error("Failed to insert transaction record for tenant: ", slog.Any("error", err))
-
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
-		}
-
-		var xid types.XID8
-		err = transaction.QueryRowContext(ctx).Scan(&xid)
-		if err != nil {
-			utils.Rollback(tx)
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-
-			slog.Error("Failed to query row context: ", slog.Any("error", err))
-
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-		}
-
-		slog.Debug("Retrieved transaction: ", slog.Any("transaction", transaction), "for tenant: ", slog.Any("tenant_id", tenantID))
-
-		slog.Debug("Processing tuples and executing insert query. ")
-		if len(tupleCollection.GetTuples()) > 0 {
+	token, err = w.run(ctx, span, tenantID, func(tx *sql.Tx, xid types.XID8) error {
+		return w.write(ctx, span, tx, tenantID, xid, tupleCollection, attributeCollection)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			tuplesInsertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_tx_id, tenant_id")
+	slog.Info("Data successfully written to the database.")
 
-			deleteClauses := squirrel.Or{}
+	return token, nil
+}
 
-			titer := tupleCollection.CreateTupleIterator()
-			for titer.HasNext() {
-				t := titer.GetNext()
-				srelation := t.GetSubject().GetRelation()
-				if srelation == tuple.ELLIPSIS {
-					srelation = ""
-				}
+func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
 
-				// Build the condition for this tuple.
-				condition := squirrel.Eq{
-					"entity_type":      t.GetEntity().GetType(),
-					"entity_id":        t.GetEntity().GetId(),
-					"relation":         t.GetRelation(),
-					"subject_type":     t.GetSubject().GetType(),
-					"subject_id":       t.GetSubject().GetId(),
-					"subject_relation": srelation,
-				}
+	slog.Info("Deleting data from the database. TenantID: ", slog.String("tenant_id", tenantID), "Max Retries: ", slog.Any("max_retries", w.maxRetries))
 
-				// Add the condition to the OR slice.
-				deleteClauses = append(deleteClauses, condition)
+	token, err = w.run(ctx, span, tenantID, func(tx *sql.Tx, xid types.XID8) error {
+		return w.delete(ctx, span, tx, tenantID, xid, tupleFilter, attributeFilter)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-				tuplesInsertBuilder = tuplesInsertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), srelation, xid, tenantID)
-			}
+	slog.Info("Data successfully deleted from the database.")
 
-			tDeleteBuilder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{
-				"expired_tx_id": "0",
-				"tenant_id":     tenantID,
-			}).Where(deleteClauses)
+	return token, nil
+}
 
-			var tdquery string
-			var tdargs []interface{}
+// RunTransaction deletes and then writes the relation tuples and attributes of the transaction in a single database
+// transaction, so that all of its changes share one transaction id.
+func (w *DataWriter) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.run-transaction")
+	defer span.End()
 
-			tdquery, tdargs, err = tDeleteBuilder.ToSql()
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
+	slog.Info("Running data transaction on the database. TenantID: ", slog.String("tenant_id", tenantID), "Max Retries: ", slog.Any("max_retries", w.maxRetries))
 
-				slog.Error("Failed to build SQL query for tuple deletion: ", slog.Any("error", err))
+	if len(transaction.Tuples.GetTuples())+len(transaction.Attributes.GetAttributes()) > w.maxDataPerWrite {
+		return nil, errors.New("max data per write exceeded")
+	}
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	token, err = w.run(ctx, span, tenantID, func(tx *sql.Tx, xid types.XID8) error {
+		for i := range transaction.TupleFilters {
+			if err := w.delete(ctx, span, tx, tenantID, xid, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
 			}
+		}
+		return w.write(ctx, span, tx, tenantID, xid, transaction.Tuples, transaction.Attributes)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			_, err = tx.ExecContext(ctx, tdquery, tdargs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
-
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+	slog.Info("Data transaction successfully committed to the database.")
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-			}
-
-			var tiquery string
-			var tiargs []interface{}
+	return token, nil
+}
 
-			tiquery, tiargs, err = tuplesInsertBuilder.ToSql()
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
+// MigrateSchema writes the definitions of a schema version along with the deletions and writes of the transaction
+// migrating the data to it in a single database transaction, so that no snapshot holds the schema without its data.
+func (w *DataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.migrate-schema")
+	defer span.End()
 
-				slog.Error("Failed to build SQL query for tuples insert: ", slog.Any("error", err))
+	slog.Info("Migrating schema on the database. TenantID: ", slog.String("tenant_id", tenantID), "Max Retries: ", slog.Any("max_retries", w.maxRetries))
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	token, err = w.run(ctx, span, tenantID, func(tx *sql.Tx, xid types.XID8) error {
+		if transaction.SchemaVersion != nil {
+			if err := w.checkSchemaVersion(ctx, span, tx, tenantID, *transaction.SchemaVersion); err != nil {
+				return err
 			}
+		}
 
-			_, err = tx.ExecContext(ctx, tiquery, tiargs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
+		insertBuilder := w.database.Builder.Insert(SchemaDefinitionTable).Columns("name, serialized_definition, version, tenant_id")
+		for _, definition := range definitions {
+			insertBuilder = insertBuilder.Values(definition.Name, definition.SerializedDefinition, definition.Version, definition.TenantID)
+		}
 
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+		query, args, err := insertBuilder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+		if err = w.exec(ctx, span, tx, query, args); err != nil {
+			return err
+		}
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		for i := range transaction.TupleFilters {
+			if err := w.delete(ctx, span, tx, tenantID, xid, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
 			}
 		}
+		return w.write(ctx, span, tx, tenantID, xid, transaction.Tuples, transaction.Attributes)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		if len(attributeCollection.GetAttributes()) > 0 {
-
-			attributesInsertBuilder := w.database.Builder.Insert(AttributesTable).Columns("entity_type, entity_id, attribute, value, created_tx_id, tenant_id")
-
-			deleteClauses := squirrel.Or{}
-
-			aiter := attributeCollection.CreateAttributeIterator()
-			for aiter.HasNext() {
-				a := aiter.GetNext()
-
-				m := jsonpb.Marshaler{}
-				jsonStr, err := m.MarshalToString(a.GetValue())
-				if err != nil {
-					utils.Rollback(tx)
-					span.RecordError(err)
-					span.SetStatus(otelCodes.Error, err.Error())
-
-					slog.Error("Failed to convert the value to string: ", slog.Any("error", err))
-
-					return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
-				}
-
-				// Build the condition for this tuple.
-				condition := squirrel.Eq{
-					"entity_type": a.GetEntity().GetType(),
-					"entity_id":   a.GetEntity().GetId(),
-					"attribute":   a.GetAttribute(),
-				}
+	slog.Info("Schema migration successfully committed to the database.")
 
-				// Add the condition to the OR slice.
-				deleteClauses = append(deleteClauses, condition)
+	return token, nil
+}
 
-				attributesInsertBuilder = attributesInsertBuilder.Values(a.GetEntity().GetType(), a.GetEntity().GetId(), a.GetAttribute(), jsonStr, xid, tenantID)
-			}
+// checkSchemaVersion fails with a schema version conflict unless the latest schema version of the tenant is version.
+// The version is read in the serializable transaction writing the new one, so concurrent migrations based on the same
+// version can't both be committed.
+func (w *DataWriter) checkSchemaVersion(ctx context.Context, span trace.Span, tx *sql.Tx, tenantID, version string) error {
+	query, args, err := w.database.Builder.
+		Select("version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("version DESC").Limit(1).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
 
-			tDeleteBuilder := w.database.Builder.Update(AttributesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{
-				"expired_tx_id": "0",
-				"tenant_id":     tenantID,
-			}).Where(deleteClauses)
+	var head string
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&head)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if isSerializationFailure(err) {
+			return err
+		}
 
-			var adquery string
-			var adargs []interface{}
+		slog.Error("Failed to read the head version: ", slog.Any("error", err))
 
-			adquery, adargs, err = tDeleteBuilder.ToSql()
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
 
-				slog.Error("Failed to build SQL query for attribute delete: ", slog.Any("error", err))
+	if head != version {
+		return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String())
+	}
+	return nil
+}
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
-			}
+// run runs fn in a serializable transaction recorded for the tenant, retrying it when it could not be serialized,
+// and returns the token of the snapshot the transaction committed.
+func (w *DataWriter) run(ctx context.Context, span trace.Span, tenantID string, fn func(tx *sql.Tx, xid types.XID8) error) (token token.EncodedSnapToken, err error) {
+	for i := 0; i <= w.maxRetries; i++ {
+		var tx *sql.Tx
+		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
 
-			_, err = tx.ExecContext(ctx, adquery, adargs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
+			slog.Error("Failed to begin transaction: ", slog.Any("error", err))
 
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+			return nil, err
+		}
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-			}
+		slog.Debug("Inserting transaction record for tenant: ", slog.String("tenant_id", tenantID))
 
-			var aquery string
-			var aargs []interface{}
+		transaction := w.database.Builder.Insert("transactions").
+			Columns("tenant_id", "actor").
+			Values(tenantID, authn.ActorFromContext(ctx)).
+			Suffix("RETURNING id").RunWith(tx)
 
-			aquery, aargs, err = attributesInsertBuilder.ToSql()
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
+		var xid types.XID8
+		err = transaction.QueryRowContext(ctx).Scan(&xid)
+		if err != nil {
+			utils.Rollback(tx)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
 
-				slog.Error("Failed to build query for attribute insertion: ", slog.Any("error", err))
+			slog.Error("Failed to query row context: ", slog.Any("error", err))
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
-			}
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
 
-			_, err = tx.ExecContext(ctx, aquery, aargs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+		slog.Debug("Retrieved transaction: ", slog.Any("transaction", transaction), "for tenant: ", slog.Any("tenant_id", tenantID))
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		err = fn(tx, xid)
+		if err != nil {
+			utils.Rollback(tx)
+			if isSerializationFailure(err) {
+				continue
 			}
+			return nil, err
 		}
 
 		if err = tx.Commit(); err != nil {
 			utils.Rollback(tx)
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
-			if strings.Contains(err.Error(), "could not serialize") {
+			if isSerializationFailure(err) {
 				continue
 			}
 			slog.Error("Failed to commiting database transaction: ", slog.Any("error", err))
@@ -294,153 +249,240 @@ func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
 
-		slog.Info("Data successfully written to the database.")
-
 		return snapshot.NewToken(xid).Encode(), nil
 	}
 
-	slog.Error("Failed to write data to the database. Max retries reached. Aborting operation. ", slog.Any("error", errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())))
+	slog.Error("Failed to apply data to the database. Max retries reached. Aborting operation. ", slog.Any("error", errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())))
 
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
 }
 
-func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error) {
-	ctx, span := tracer.Start(ctx, "data-writer.delete")
-	defer span.End()
+// write expires the live rows of the relation tuples and attributes in the transaction and inserts them as created
+// by it. Errors caused by serialization failures are returned as they are, so that the transaction is retried.
+func (w *DataWriter) write(ctx context.Context, span trace.Span, tx *sql.Tx, tenantID string, xid types.XID8, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (err error) {
+	slog.Debug("Processing tuples and executing insert query. ")
+	if len(tupleCollection.GetTuples()) > 0 {
 
-	slog.Info("Deleting data from the database. TenantID: ", slog.String("tenant_id", tenantID), "Max Retries: ", slog.Any("max_retries", w.maxRetries))
+		tuplesInsertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_tx_id, tenant_id")
 
-	for i := 0; i <= w.maxRetries; i++ {
-		var tx *sql.Tx
-		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
+		deleteClauses := squirrel.Or{}
 
-			slog.Error("Failed to begin transaction: ", slog.Any("error", err))
+		titer := tupleCollection.CreateTupleIterator()
+		for titer.HasNext() {
+			t := titer.GetNext()
+			srelation := t.GetSubject().GetRelation()
+			if srelation == tuple.ELLIPSIS {
+				srelation = ""
+			}
 
-			return nil, err
+			// Build the condition for this tuple.
+			condition := squirrel.Eq{
+				"entity_type":      t.GetEntity().GetType(),
+				"entity_id":        t.GetEntity().GetId(),
+				"relation":         t.GetRelation(),
+				"subject_type":     t.GetSubject().GetType(),
+				"subject_id":       t.GetSubject().GetId(),
+				"subject_relation": srelation,
+			}
+
+			// Add the condition to the OR slice.
+			deleteClauses = append(deleteClauses, condition)
+
+			tuplesInsertBuilder = tuplesInsertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), srelation, xid, tenantID)
 		}
 
-		slog.Debug("Deleting transaction record for tenant: ", slog.String("tenant_id", tenantID))
+		tDeleteBuilder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{
+			"expired_tx_id": "0",
+			"tenant_id":     tenantID,
+		}).Where(deleteClauses)
 
-		transaction := w.database.Builder.Insert("transactions").
-			Columns("tenant_id").
-			Values(tenantID).
-			Suffix("RETURNING id").RunWith(tx)
+		var tdquery string
+		var tdargs []interface{}
+
+		tdquery, tdargs, err = tDeleteBuilder.ToSql()
 		if err != nil {
-			utils.Rollback(tx)
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 
-			slog.Error("Failed to insert transaction record for tenant: ", slog.Any("error", err))
+			slog.Error("Failed to build SQL query for tuple deletion: ", slog.Any("error", err))
 
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
 		}
 
-		var xid types.XID8
-		err = transaction.QueryRowContext(ctx).Scan(&xid)
+		if err = w.exec(ctx, span, tx, tdquery, tdargs); err != nil {
+			return err
+		}
+
+		var tiquery string
+		var tiargs []interface{}
+
+		tiquery, tiargs, err = tuplesInsertBuilder.ToSql()
 		if err != nil {
-			utils.Rollback(tx)
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 
-			slog.Error("Failed to query row context: ", slog.Any("error", err))
+			slog.Error("Failed to build SQL query for tuples insert: ", slog.Any("error", err))
 
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
 		}
 
-		slog.Debug("Retrieved transaction: ", slog.Any("transaction", transaction), "for tenant: ", slog.Any("tenant_id", tenantID))
+		if err = w.exec(ctx, span, tx, tiquery, tiargs); err != nil {
+			return err
+		}
+	}
+
+	if len(attributeCollection.GetAttributes()) > 0 {
 
-		slog.Debug("Processing tuple and executing update query. ")
+		attributesInsertBuilder := w.database.Builder.Insert(AttributesTable).Columns("entity_type, entity_id, attribute, value, created_tx_id, tenant_id")
 
-		if !validation.IsTupleFilterEmpty(tupleFilter) {
-			tbuilder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{"expired_tx_id": "0", "tenant_id": tenantID})
-			tbuilder = utils.TuplesFilterQueryForUpdateBuilder(tbuilder, tupleFilter)
+		deleteClauses := squirrel.Or{}
 
-			var tquery string
-			var targs []interface{}
+		aiter := attributeCollection.CreateAttributeIterator()
+		for aiter.HasNext() {
+			a := aiter.GetNext()
 
-			tquery, targs, err = tbuilder.ToSql()
+			m := jsonpb.Marshaler{}
+			jsonStr, err := m.MarshalToString(a.GetValue())
 			if err != nil {
-				utils.Rollback(tx)
 				span.RecordError(err)
 				span.SetStatus(otelCodes.Error, err.Error())
 
-				slog.Error("Failed to build SQL query for tuple updation: ", slog.Any("error", err))
+				slog.Error("Failed to convert the value to string: ", slog.Any("error", err))
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+				return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
 			}
 
-			_, err = tx.ExecContext(ctx, tquery, targs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
+			// Build the condition for this tuple.
+			condition := squirrel.Eq{
+				"entity_type": a.GetEntity().GetType(),
+				"entity_id":   a.GetEntity().GetId(),
+				"attribute":   a.GetAttribute(),
+			}
 
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+			// Add the condition to the OR slice.
+			deleteClauses = append(deleteClauses, condition)
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-			}
+			attributesInsertBuilder = attributesInsertBuilder.Values(a.GetEntity().GetType(), a.GetEntity().GetId(), a.GetAttribute(), jsonStr, xid, tenantID)
 		}
 
-		slog.Debug("Processing attribute and executing update query.")
+		tDeleteBuilder := w.database.Builder.Update(AttributesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{
+			"expired_tx_id": "0",
+			"tenant_id":     tenantID,
+		}).Where(deleteClauses)
 
-		if !validation.IsAttributeFilterEmpty(attributeFilter) {
-			abuilder := w.database.Builder.Update(AttributesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{"expired_tx_id": "0", "tenant_id": tenantID})
-			abuilder = utils.AttributesFilterQueryForUpdateBuilder(abuilder, attributeFilter)
+		var adquery string
+		var adargs []interface{}
 
-			var aquery string
-			var aargs []interface{}
+		adquery, adargs, err = tDeleteBuilder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
 
-			aquery, aargs, err = abuilder.ToSql()
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
+			slog.Error("Failed to build SQL query for attribute delete: ", slog.Any("error", err))
 
-				slog.Error("Failed to build SQL query for attribute updation: ", slog.Any("error", err))
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
-			}
+		if err = w.exec(ctx, span, tx, adquery, adargs); err != nil {
+			return err
+		}
 
-			_, err = tx.ExecContext(ctx, aquery, aargs...)
-			if err != nil {
-				utils.Rollback(tx)
-				span.RecordError(err)
-				span.SetStatus(otelCodes.Error, err.Error())
-				if strings.Contains(err.Error(), "could not serialize") {
-					continue
-				}
+		var aquery string
+		var aargs []interface{}
 
-				slog.Error("Failed to execute context query: ", slog.Any("error", err))
+		aquery, aargs, err = attributesInsertBuilder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
 
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
-			}
+			slog.Error("Failed to build query for attribute insertion: ", slog.Any("error", err))
+
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
 		}
 
-		if err = tx.Commit(); err != nil {
-			utils.Rollback(tx)
+		if err = w.exec(ctx, span, tx, aquery, aargs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete expires the live rows of the relation tuples and attributes matching the filters in the transaction,
+// skipping the empty filters. Errors caused by serialization failures are returned as they are, so that the
+// transaction is retried.
+func (w *DataWriter) delete(ctx context.Context, span trace.Span, tx *sql.Tx, tenantID string, xid types.XID8, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (err error) {
+	slog.Debug("Processing tuple and executing update query. ")
+
+	if !validation.IsTupleFilterEmpty(tupleFilter) {
+		tbuilder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{"expired_tx_id": "0", "tenant_id": tenantID})
+		tbuilder = utils.TuplesFilterQueryForUpdateBuilder(tbuilder, tupleFilter)
+
+		var tquery string
+		var targs []interface{}
+
+		tquery, targs, err = tbuilder.ToSql()
+		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
-			if strings.Contains(err.Error(), "could not serialize") {
-				continue
-			}
 
-			slog.Error("Failed to commiting database transaction: ", slog.Any("error", err))
+			slog.Error("Failed to build SQL query for tuple updation: ", slog.Any("error", err))
 
-			return nil, err
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		if err = w.exec(ctx, span, tx, tquery, targs); err != nil {
+			return err
 		}
+	}
 
-		slog.Info("Data successfully deleted from the database.")
+	slog.Debug("Processing attribute and executing update query.")
 
-		return snapshot.NewToken(xid).Encode(), nil
+	if !validation.IsAttributeFilterEmpty(attributeFilter) {
+		abuilder := w.database.Builder.Update(AttributesTable).Set("expired_tx_id", xid).Where(squirrel.Eq{"expired_tx_id": "0", "tenant_id": tenantID})
+		abuilder = utils.AttributesFilterQueryForUpdateBuilder(abuilder, attributeFilter)
+
+		var aquery string
+		var aargs []interface{}
+
+		aquery, aargs, err = abuilder.ToSql()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+
+			slog.Error("Failed to build SQL query for attribute updation: ", slog.Any("error", err))
+
+			return errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+
+		if err = w.exec(ctx, span, tx, aquery, aargs); err != nil {
+			return err
+		}
 	}
 
-	slog.Error("Failed to delete data from the database. Max retries reached. Aborting operation. ", slog.Any("error", errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())))
+	return nil
+}
+
+// exec executes the query in the transaction. Serialization failures are returned as they are, so that the
+// transaction is retried, and other failures as execution errors.
+func (w *DataWriter) exec(ctx context.Context, span trace.Span, tx *sql.Tx, query string, args []interface{}) error {
+	_, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if isSerializationFailure(err) {
+			return err
+		}
+
+		slog.Error("Failed to execute context query: ", slog.Any("error", err))
 
-	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	return nil
+}
+
+// isSerializationFailure reports whether the transaction failed because it could not be serialized with concurrent
+// ones, in which case it can be retried.
+func isSerializationFailure(err error) bool {
+	return strings.Contains(err.Error(), "could not serialize")
 }
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS actor VARCHAR NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS actor;
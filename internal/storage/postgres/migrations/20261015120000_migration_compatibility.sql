@@ -0,0 +1,17 @@
+-- +goose Up
+-- minimum_version is the oldest migration binaries must know to run against the database. Migrations that only
+-- expand the schema leave it as is, so binaries of the previous versions keep running during rollouts. Migrations
+-- that break them, e.g. by dropping or renaming what they use, raise it to their own version in their Up and
+-- restore it in their Down.
+CREATE TABLE IF NOT EXISTS migration_compatibility (
+    id              BOOLEAN DEFAULT TRUE NOT NULL,
+    minimum_version BIGINT               NOT NULL,
+    CONSTRAINT pk_migration_compatibility PRIMARY KEY (id),
+    CONSTRAINT ck_migration_compatibility_single_row CHECK (id)
+);
+
+-- The attributes migration renamed a column of schema_definitions.
+INSERT INTO migration_compatibility (minimum_version) VALUES (20230729145034) ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS migration_compatibility;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- autocert_cache keeps the certificates obtained from the ACME certificate authority with the database autocert
+-- cache, along with the key of the account, so that the replicas share them and restarts don't request them again.
+CREATE TABLE IF NOT EXISTS autocert_cache (
+    key        VARCHAR   NOT NULL,
+    data       BYTEA     NOT NULL,
+    updated_at TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_autocert_cache PRIMARY KEY (key)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS autocert_cache;
@@ -20,6 +20,8 @@ type SchemaReader struct {
 	database *db.Postgres
 	// options
 	txOptions sql.TxOptions
+	// versions are the schema versions compiled to read the definitions of their entities
+	versions *storage.CompiledVersions
 }
 
 // NewSchemaReader - Creates a new SchemaReader
@@ -27,6 +29,7 @@ func NewSchemaReader(database *db.Postgres) *SchemaReader {
 	return &SchemaReader{
 		database:  database,
 		txOptions: sql.TxOptions{Isolation: sql.LevelReadCommitted, ReadOnly: true},
+		versions:  storage.NewCompiledVersions(),
 	}
 }
 
@@ -153,8 +156,9 @@ func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name,
 		return nil, "", errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
 	}
 
-	var sch *base.SchemaDefinition
-	sch, err = schema.NewSchemaFromStringDefinitions(false, def.Serialized())
+	definition, err = r.versions.Entity(tenantID, def.Version, name, func() (*base.SchemaDefinition, error) {
+		return r.ReadSchema(ctx, tenantID, def.Version)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -164,8 +168,6 @@ func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name,
 		return nil, "", err
 	}
 
-	definition, err = schema.GetEntityByName(sch, name)
-
 	slog.Info("Successfully retrieved", slog.Any("schema definition", definition))
 
 	return definition, def.Version, err
@@ -290,3 +292,126 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versio
 
 	return version, nil
 }
+
+// ReadSchemaDefinitions reads the serialized definitions of a schema version without compiling them.
+func (r *SchemaReader) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-definitions")
+	defer span.End()
+
+	slog.Info("Reading schema definitions: ", slog.Any("tenant_id", tenantID), slog.Any("version", version))
+
+	builder := r.database.Builder.Select("name, serialized_definition, version").From(SchemaDefinitionTable).Where(squirrel.Eq{"version": version, "tenant_id": tenantID}).OrderBy("name")
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error in building SQL query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	slog.Debug("Executing SQL query: ", slog.Any("query", query), slog.Any("arguments", args))
+
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error in executing query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		sd := storage.SchemaDefinition{TenantID: tenantID}
+		err = rows.Scan(&sd.Name, &sd.SerializedDefinition, &sd.Version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Error scanning rows: ", slog.Any("error", err))
+
+			return nil, err
+		}
+		definitions = append(definitions, sd)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error iterating over rows: ", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	slog.Info("Successfully retrieved", slog.Any("schema definitions", len(definitions)))
+
+	return definitions, nil
+}
+
+// ReadSchemaVersions reads the versions of the schema of the tenant, oldest first. Versions sort by creation time.
+func (r *SchemaReader) ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-versions")
+	defer span.End()
+
+	slog.Info("Reading schema versions: ", slog.Any("tenant_id", tenantID))
+
+	builder := r.database.Builder.Select("DISTINCT version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("version")
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error in building SQL query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+
+	slog.Debug("Executing SQL query: ", slog.Any("query", query), slog.Any("arguments", args))
+
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error in executing query: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version string
+		if err = rows.Scan(&version); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			slog.Error("Error scanning rows: ", slog.Any("error", err))
+
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Error iterating over rows: ", slog.Any("error", err))
+
+		return nil, err
+	}
+
+	return versions, nil
+}
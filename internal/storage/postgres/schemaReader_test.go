@@ -67,6 +67,28 @@ var _ = Describe("SchemaReader", func() {
 		})
 	})
 
+	Context("Read Schema Definitions", func() {
+		It("should read the serialized definitions of a schema version", func() {
+			ctx := context.Background()
+
+			version := xid.New().String()
+
+			schema := []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version},
+				{TenantID: "t1", Name: "organization", SerializedDefinition: []byte("entity organization { relation admin @user}"), Version: version},
+			}
+
+			err := schemaWriter.WriteSchema(ctx, schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			definitions, err := schemaReader.ReadSchemaDefinitions(ctx, "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Definitions are returned ordered by name
+			Expect(definitions).Should(Equal([]storage.SchemaDefinition{schema[1], schema[0]}))
+		})
+	})
+
 	Context("Read Schema", func() {
 		It("should write and then read the schema for a tenant", func() {
 			ctx := context.Background()
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/pkg/errors"
 
@@ -29,32 +30,41 @@ const (
 )
 
 // SnapshotQuery adds conditions to a SELECT query for checking transaction visibility based on created and expired transaction IDs.
-// The query checks if transactions are visible in a snapshot associated with the provided value.
+// The query checks if transactions are visible in a snapshot associated with the provided value. The value is a
+// parameter of the query, so the query is the same statement at every snapshot and its prepared statement is reused.
 func SnapshotQuery(sl squirrel.SelectBuilder, value uint64) squirrel.SelectBuilder {
-	// Convert the value to a string once to reduce redundant calls to fmt.Sprintf.
-	valStr := fmt.Sprintf("'%v'::xid8", value)
+	createdWhere, expiredWhere := snapshotConditions(value)
+
+	// Add the created and expired conditions to the SELECT query.
+	return sl.Where(createdWhere).Where(expiredWhere)
+}
+
+// snapshotConditions returns the conditions of the visibility of the created and expired transaction IDs in the
+// snapshot associated with the provided value, as parameters.
+func snapshotConditions(value uint64) (squirrel.Sqlizer, squirrel.Sqlizer) {
+	// The value is passed as text, which casts to xid8 whatever the mode queries are executed with.
+	valStr := strconv.FormatUint(value, 10)
 
 	// Create a subquery for the snapshot associated with the provided value.
-	snapshotQuery := fmt.Sprintf("(select snapshot from transactions where id = %s)", valStr)
+	snapshotQuery := "(select snapshot from transactions where id = ?::xid8)"
 
 	// Create an expression to check if a transaction with a specific created_tx_id is visible in the snapshot.
-	visibilityExpr := squirrel.Expr(fmt.Sprintf("pg_visible_in_snapshot(created_tx_id, %s) = true", snapshotQuery))
+	visibilityExpr := squirrel.Expr(fmt.Sprintf("pg_visible_in_snapshot(created_tx_id, %s) = true", snapshotQuery), valStr)
 	// Create an expression to check if the created_tx_id is equal to the provided value.
-	createdExpr := squirrel.Expr(fmt.Sprintf("created_tx_id = %s", valStr))
+	createdExpr := squirrel.Expr("created_tx_id = ?::xid8", valStr)
 	// Use OR condition for the created expressions.
 	createdWhere := squirrel.Or{visibilityExpr, createdExpr}
 
 	// Create an expression to check if a transaction with a specific expired_tx_id is not visible in the snapshot.
-	expiredVisibilityExpr := squirrel.Expr(fmt.Sprintf("pg_visible_in_snapshot(expired_tx_id, %s) = false", snapshotQuery))
+	expiredVisibilityExpr := squirrel.Expr(fmt.Sprintf("pg_visible_in_snapshot(expired_tx_id, %s) = false", snapshotQuery), valStr)
 	// Create an expression to check if the expired_tx_id is equal to zero.
 	expiredZeroExpr := squirrel.Expr("expired_tx_id = '0'::xid8")
 	// Create an expression to check if the expired_tx_id is not equal to the provided value.
-	expiredNotExpr := squirrel.Expr(fmt.Sprintf("expired_tx_id <> %s", valStr))
+	expiredNotExpr := squirrel.Expr("expired_tx_id <> ?::xid8", valStr)
 	// Use AND condition for the expired expressions, checking both visibility and non-equality with value.
 	expiredWhere := squirrel.And{squirrel.Or{expiredVisibilityExpr, expiredZeroExpr}, expiredNotExpr}
 
-	// Add the created and expired conditions to the SELECT query.
-	return sl.Where(createdWhere).Where(expiredWhere)
+	return createdWhere, expiredWhere
 }
 
 // snapshotQuery function generates two strings representing conditions to be applied in a SQL query to filter data based on visibility of transactions.
@@ -114,6 +124,6 @@ func GenerateGCQuery(table string, value uint64) squirrel.DeleteBuilder {
 // Rollback - Rollbacks a transaction and logs the error
 func Rollback(tx *sql.Tx) {
 	if err := tx.Rollback(); !errors.Is(err, sql.ErrTxDone) && err != nil {
-		slog.Error("failed to rollback transaction", err)
+		slog.Error("failed to rollback transaction", slog.Any("error", err))
 	}
 }
@@ -15,11 +15,13 @@ func TestSnapshotQuery(t *testing.T) {
 	revision := uint64(42)
 
 	query := utils.SnapshotQuery(sl, revision)
-	sql, _, err := query.ToSql()
+	sql, args, err := query.ToSql()
 
 	assert.NoError(t, err)
-	expectedSQL := "SELECT column FROM table WHERE (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '42'::xid8)) = true OR created_tx_id = '42'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '42'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '42'::xid8)"
+	expectedSQL := "SELECT column FROM table WHERE (pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = ?::xid8)) = true OR created_tx_id = ?::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = ?::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> ?::xid8)"
 	assert.Equal(t, expectedSQL, sql)
+	// The snapshot is a parameter, so the statement is the same at every snapshot
+	assert.Equal(t, []interface{}{"42", "42", "42", "42"}, args)
 }
 
 func TestGarbageCollectQuery(t *testing.T) {
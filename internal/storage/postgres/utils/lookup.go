@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/storage"
+)
+
+// LookupQuery builds the query of the identifiers of the entities of the lookup plan the subject is in, at the
+// snapshot. Each node of the plan is a common table expression, and the set operations of the plan are evaluated
+// by the database with UNION, INTERSECT and EXCEPT.
+func LookupQuery(tenantID string, plan *storage.LookupPlan, subject *base.Subject, snap uint64) (string, []interface{}) {
+	condition, snapshotArgs := lookupSnapshot(snap)
+	q := &lookupQuery{
+		tenantID:     tenantID,
+		subject:      subject,
+		snapshot:     condition,
+		snapshotArgs: snapshotArgs,
+		names:        map[*storage.LookupPlan]string{},
+	}
+	root := q.node(plan)
+	query := fmt.Sprintf("WITH %s SELECT DISTINCT entity_id FROM %s", strings.Join(q.ctes, ", "), root)
+	sql, _ := squirrel.Dollar.ReplacePlaceholders(query)
+	return sql, q.args
+}
+
+// LookupGuardQuery builds the query of whether the relationships the lookup plan reads include ones it can't
+// evaluate at the snapshot: the relationships of wildcard entities, and the ones of subjects none of the branches of
+// the node reading them follows.
+func LookupGuardQuery(tenantID string, plan *storage.LookupPlan, snap uint64) (string, []interface{}) {
+	args := []interface{}{tenantID}
+	var leaves []string
+	visited := map[*storage.LookupPlan]struct{}{}
+
+	var walk func(plan *storage.LookupPlan)
+	walk = func(plan *storage.LookupPlan) {
+		if _, ok := visited[plan]; ok {
+			return
+		}
+		visited[plan] = struct{}{}
+
+		if plan.Operation != storage.LookupRelationships {
+			for _, child := range plan.Children {
+				walk(child)
+			}
+			return
+		}
+
+		var classes []string
+		args = append(args, plan.EntityType, plan.Relation)
+		for _, branch := range plan.Branches {
+			switch {
+			case branch.Subjects == nil:
+				classes = append(classes, "(subject_type = ? AND subject_relation IN ('', '...'))")
+				args = append(args, branch.SubjectType)
+			case branch.AnyRelation:
+				classes = append(classes, "(subject_type = ? AND subject_id <> '*')")
+				args = append(args, branch.SubjectType)
+			default:
+				classes = append(classes, "(subject_type = ? AND subject_relation = ? AND subject_id <> '*')")
+				args = append(args, branch.SubjectType, branch.SubjectRelation)
+			}
+		}
+		leaves = append(leaves, fmt.Sprintf("(entity_type = ? AND relation = ? AND (entity_id = '*' OR NOT (%s)))", disjunction(classes)))
+
+		for _, branch := range plan.Branches {
+			if branch.Subjects != nil {
+				walk(branch.Subjects)
+			}
+		}
+	}
+	walk(plan)
+
+	condition, snapshotArgs := lookupSnapshot(snap)
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM relation_tuples WHERE tenant_id = ? AND (%s) AND %s)", strings.Join(leaves, " OR "), condition)
+	sql, _ := squirrel.Dollar.ReplacePlaceholders(query)
+	return sql, append(args, snapshotArgs...)
+}
+
+// lookupQuery - Common table expressions of the nodes of a lookup plan, named in the order they are defined
+type lookupQuery struct {
+	tenantID     string
+	subject      *base.Subject
+	snapshot     string
+	snapshotArgs []interface{}
+
+	names map[*storage.LookupPlan]string
+	ctes  []string
+	args  []interface{}
+}
+
+// node defines the common table expression of the node of the plan after the ones of its operands, and returns its
+// name. The nodes shared by several nodes of the plan are defined once.
+func (q *lookupQuery) node(plan *storage.LookupPlan) string {
+	if name, ok := q.names[plan]; ok {
+		return name
+	}
+
+	var body string
+	var args []interface{}
+	switch plan.Operation {
+	case storage.LookupRelationships:
+		var branches []string
+		for _, branch := range plan.Branches {
+			switch {
+			case branch.Subjects == nil:
+				// Direct subjects only match the subject of the lookup or the wildcard of its type
+				if branch.SubjectType != q.subject.GetType() {
+					continue
+				}
+				branches = append(branches, "(subject_type = ? AND subject_relation IN ('', '...') AND subject_id IN (?, '*'))")
+				args = append(args, branch.SubjectType, q.subject.GetId())
+			case branch.AnyRelation:
+				branches = append(branches, fmt.Sprintf("(subject_type = ? AND subject_id IN (SELECT entity_id FROM %s))", q.node(branch.Subjects)))
+				args = append(args, branch.SubjectType)
+			default:
+				branches = append(branches, fmt.Sprintf("(subject_type = ? AND subject_relation = ? AND subject_id IN (SELECT entity_id FROM %s))", q.node(branch.Subjects)))
+				args = append(args, branch.SubjectType, branch.SubjectRelation)
+			}
+		}
+		body = fmt.Sprintf("SELECT entity_id FROM relation_tuples WHERE tenant_id = ? AND entity_type = ? AND relation = ? AND (%s) AND %s", disjunction(branches), q.snapshot)
+		args = append([]interface{}{q.tenantID, plan.EntityType, plan.Relation}, args...)
+		args = append(args, q.snapshotArgs...)
+	default:
+		operator := " UNION "
+		switch plan.Operation {
+		case storage.LookupIntersection:
+			operator = " INTERSECT "
+		case storage.LookupExclusion:
+			// EXCEPT is left associative, so the chain excludes each of the others from the first
+			operator = " EXCEPT "
+		}
+		operands := make([]string, 0, len(plan.Children))
+		for _, child := range plan.Children {
+			operands = append(operands, fmt.Sprintf("SELECT entity_id FROM %s", q.node(child)))
+		}
+		body = strings.Join(operands, operator)
+	}
+
+	name := fmt.Sprintf("n%d", len(q.ctes)+1)
+	q.names[plan] = name
+	q.ctes = append(q.ctes, fmt.Sprintf("%s AS (%s)", name, body))
+	q.args = append(q.args, args...)
+	return name
+}
+
+// lookupSnapshot returns the condition of the visibility of the relationships at the snapshot, and its arguments.
+func lookupSnapshot(snap uint64) (string, []interface{}) {
+	createdWhere, expiredWhere := snapshotConditions(snap)
+	condition, args, _ := squirrel.And{createdWhere, expiredWhere}.ToSql()
+	return condition, args
+}
+
+// disjunction joins the conditions with OR, the empty disjunction being false.
+func disjunction(conditions []string) string {
+	if len(conditions) == 0 {
+		return "FALSE"
+	}
+	return strings.Join(conditions, " OR ")
+}
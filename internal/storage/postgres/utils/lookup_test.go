@@ -0,0 +1,72 @@
+package utils_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Permify/permify/internal/storage/postgres/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/storage"
+)
+
+// lookupSnapshot is the visibility of the relationships at the snapshot, its parameters starting at the number.
+func lookupSnapshot(n int) string {
+	return fmt.Sprintf("((pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = $%d::xid8)) = true OR created_tx_id = $%d::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = $%d::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> $%d::xid8))", n, n+1, n+2, n+3)
+}
+
+var snapshotArgs = []interface{}{"42", "42", "42", "42"}
+
+// lookupPlan is the plan of "permission edit = org.admin not owner", with the owners of the docs being users, and
+// the admins of the organizations users and the members of groups.
+func lookupPlan() *storage.LookupPlan {
+	member := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "group", Relation: "member", Branches: []storage.LookupBranch{{SubjectType: "user"}}}
+	admin := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "organization", Relation: "admin", Branches: []storage.LookupBranch{{SubjectType: "user"}, {SubjectType: "group", SubjectRelation: "member", Subjects: member}}}
+	org := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "doc", Relation: "org", Branches: []storage.LookupBranch{{SubjectType: "organization", AnyRelation: true, Subjects: admin}}}
+	owner := &storage.LookupPlan{Operation: storage.LookupRelationships, EntityType: "doc", Relation: "owner", Branches: []storage.LookupBranch{{SubjectType: "user"}}}
+	return &storage.LookupPlan{Operation: storage.LookupExclusion, Children: []*storage.LookupPlan{org, owner}}
+}
+
+func TestLookupQuery(t *testing.T) {
+	query, args := utils.LookupQuery("t1", lookupPlan(), &base.Subject{Type: "user", Id: "1"}, 42)
+
+	expectedSQL := "WITH " +
+		"n1 AS (SELECT entity_id FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2 AND relation = $3 AND ((subject_type = $4 AND subject_relation IN ('', '...') AND subject_id IN ($5, '*'))) AND " + lookupSnapshot(6) + "), " +
+		"n2 AS (SELECT entity_id FROM relation_tuples WHERE tenant_id = $10 AND entity_type = $11 AND relation = $12 AND ((subject_type = $13 AND subject_relation IN ('', '...') AND subject_id IN ($14, '*')) OR (subject_type = $15 AND subject_relation = $16 AND subject_id IN (SELECT entity_id FROM n1))) AND " + lookupSnapshot(17) + "), " +
+		"n3 AS (SELECT entity_id FROM relation_tuples WHERE tenant_id = $21 AND entity_type = $22 AND relation = $23 AND ((subject_type = $24 AND subject_id IN (SELECT entity_id FROM n2))) AND " + lookupSnapshot(25) + "), " +
+		"n4 AS (SELECT entity_id FROM relation_tuples WHERE tenant_id = $29 AND entity_type = $30 AND relation = $31 AND ((subject_type = $32 AND subject_relation IN ('', '...') AND subject_id IN ($33, '*'))) AND " + lookupSnapshot(34) + "), " +
+		"n5 AS (SELECT entity_id FROM n3 EXCEPT SELECT entity_id FROM n4) " +
+		"SELECT DISTINCT entity_id FROM n5"
+	assert.Equal(t, expectedSQL, query)
+	expectedArgs := append([]interface{}{"t1", "group", "member", "user", "1"}, snapshotArgs...)
+	expectedArgs = append(append(expectedArgs, "t1", "organization", "admin", "user", "1", "group", "member"), snapshotArgs...)
+	expectedArgs = append(append(expectedArgs, "t1", "doc", "org", "organization"), snapshotArgs...)
+	expectedArgs = append(append(expectedArgs, "t1", "doc", "owner", "user", "1"), snapshotArgs...)
+	assert.Equal(t, expectedArgs, args)
+
+	// The direct subjects of other types than the subject can't match it
+	query, args = utils.LookupQuery("t1", lookupPlan().Children[1], &base.Subject{Type: "group", Id: "1"}, 42)
+	assert.Equal(t, "WITH n1 AS (SELECT entity_id FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2 AND relation = $3 AND (FALSE) AND "+lookupSnapshot(4)+") SELECT DISTINCT entity_id FROM n1", query)
+	assert.Equal(t, append([]interface{}{"t1", "doc", "owner"}, snapshotArgs...), args)
+}
+
+func TestLookupGuardQuery(t *testing.T) {
+	query, args := utils.LookupGuardQuery("t1", lookupPlan(), 42)
+
+	expectedSQL := "SELECT EXISTS (SELECT 1 FROM relation_tuples WHERE tenant_id = $1 AND (" +
+		"(entity_type = $2 AND relation = $3 AND (entity_id = '*' OR NOT ((subject_type = $4 AND subject_id <> '*')))) OR " +
+		"(entity_type = $5 AND relation = $6 AND (entity_id = '*' OR NOT ((subject_type = $7 AND subject_relation IN ('', '...')) OR (subject_type = $8 AND subject_relation = $9 AND subject_id <> '*')))) OR " +
+		"(entity_type = $10 AND relation = $11 AND (entity_id = '*' OR NOT ((subject_type = $12 AND subject_relation IN ('', '...'))))) OR " +
+		"(entity_type = $13 AND relation = $14 AND (entity_id = '*' OR NOT ((subject_type = $15 AND subject_relation IN ('', '...')))))" +
+		") AND " + lookupSnapshot(16) + ")"
+	assert.Equal(t, expectedSQL, query)
+	assert.Equal(t, []interface{}{
+		"t1",
+		"doc", "org", "organization",
+		"organization", "admin", "user", "group", "member",
+		"group", "member", "user",
+		"doc", "owner", "user",
+		"42", "42", "42", "42",
+	}, args)
+}
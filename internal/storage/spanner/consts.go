@@ -0,0 +1,6 @@
+package spanner
+
+const (
+	_defaultMaxDataPerWrite = 100
+	_defaultWatchBufferSize = 100
+)
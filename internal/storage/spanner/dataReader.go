@@ -0,0 +1,485 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/api/iterator"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/Permify/permify/internal/storage/spanner/snapshot"
+	"github.com/Permify/permify/internal/storage/spanner/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReader - Structure for Data Reader
+//
+// Snapshot tokens are commit timestamps, and every read of a snapshot is a stale read at its timestamp, so reads
+// see exactly the writes committed at or before it. Snapshots stay readable for the version retention period of
+// the database.
+type DataReader struct {
+	database *db.Spanner
+}
+
+// NewDataReader - Creates a new DataReader
+func NewDataReader(database *db.Spanner) *DataReader {
+	return &DataReader{
+		database: database,
+	}
+}
+
+// QueryRelationships reads relation tuples from the storage based on the given filter.
+func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	collection := database.NewTupleCollection()
+	err = query(ctx, r.at(st), utils.TuplesWhere(tenantID, filter).Statement(db.RelationTuplesTable, utils.TupleKeyColumns, ""), func(row *spanner.Row) error {
+		t, err := utils.TupleFromRow(tenantID, row)
+		if err != nil {
+			return err
+		}
+		collection.Add(t.ToTuple())
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query relationships: ", slog.Any("error", err))
+
+		return nil, readError(err)
+	}
+
+	return collection.CreateTupleIterator(), nil
+}
+
+// ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
+func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-relationships")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeKey(pagination, len(utils.TupleKeyColumns))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	statement := utils.TuplesWhere(tenantID, filter).From(utils.TupleKeyColumns, from).Statement(db.RelationTuplesTable, utils.TupleKeyColumns, page(utils.TupleKeyColumns, pagination))
+
+	collection = database.NewTupleCollection()
+	ct = database.NewNoopContinuousToken().Encode()
+	err = query(ctx, r.at(st), statement, func(row *spanner.Row) error {
+		t, err := utils.TupleFromRow(tenantID, row)
+		if err != nil {
+			return err
+		}
+		if len(collection.GetTuples()) == int(pagination.PageSize()) {
+			ct = utils.NewContinuousToken(utils.EncodeKey(utils.TupleKey(t))).Encode()
+			return nil
+		}
+		collection.Add(t.ToTuple())
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read relationships: ", slog.Any("error", err))
+
+		return nil, nil, readError(err)
+	}
+
+	return collection, ct, nil
+}
+
+// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
+func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-single-attribute")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	err = query(ctx, r.at(st), utils.AttributesWhere(tenantID, filter).Statement(db.AttributesTable, utils.AttributeColumns, "LIMIT 1"), func(row *spanner.Row) error {
+		a, err := utils.AttributeFromRow(tenantID, row)
+		if err != nil {
+			return err
+		}
+		attribute = a.ToAttribute()
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query single attribute: ", slog.Any("error", err))
+
+		return nil, readError(err)
+	}
+
+	return attribute, nil
+}
+
+// QueryAttributes reads multiple attributes from the storage based on the given filter.
+func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (it *database.AttributeIterator, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	collection := database.NewAttributeCollection()
+	err = query(ctx, r.at(st), utils.AttributesWhere(tenantID, filter).Statement(db.AttributesTable, utils.AttributeColumns, ""), func(row *spanner.Row) error {
+		a, err := utils.AttributeFromRow(tenantID, row)
+		if err != nil {
+			return err
+		}
+		collection.Add(a.ToAttribute())
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query attributes: ", slog.Any("error", err))
+
+		return nil, readError(err)
+	}
+
+	return collection.CreateAttributeIterator(), nil
+}
+
+// ReadAttributes reads multiple attributes from the storage based on the given filter and pagination.
+func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.read-attributes")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeKey(pagination, len(utils.AttributeKeyColumns))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	statement := utils.AttributesWhere(tenantID, filter).From(utils.AttributeKeyColumns, from).Statement(db.AttributesTable, utils.AttributeColumns, page(utils.AttributeKeyColumns, pagination))
+
+	collection = database.NewAttributeCollection()
+	ct = database.NewNoopContinuousToken().Encode()
+	err = query(ctx, r.at(st), statement, func(row *spanner.Row) error {
+		a, err := utils.AttributeFromRow(tenantID, row)
+		if err != nil {
+			return err
+		}
+		if len(collection.GetAttributes()) == int(pagination.PageSize()) {
+			ct = utils.NewContinuousToken(utils.EncodeKey(utils.AttributeKey(a))).Encode()
+			return nil
+		}
+		collection.Add(a.ToAttribute())
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read attributes: ", slog.Any("error", err))
+
+		return nil, nil, readError(err)
+	}
+
+	return collection, ct, nil
+}
+
+// QueryUniqueEntities reads the distinct IDs of the entities of the given type that have relation tuples or
+// attributes in the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-entities")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeKey(pagination, 1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	where := utils.NewWhere(tenantID).Eq("entity_type", name).From([]string{"entity_id"}, from)
+	statement := spanner.Statement{
+		SQL: "SELECT DISTINCT entity_id FROM (" +
+			"SELECT entity_id FROM " + db.RelationTuplesTable + " WHERE " + where.String() +
+			" UNION ALL SELECT entity_id FROM " + db.AttributesTable + " WHERE " + where.String() +
+			") " + page([]string{"entity_id"}, pagination),
+		Params: where.Params(),
+	}
+
+	ids, ct, err = r.distinct(ctx, st, statement, pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query unique entities: ", slog.Any("error", err))
+
+		return nil, nil, readError(err)
+	}
+
+	return ids, ct, nil
+}
+
+// QueryUniqueSubjectReferences reads the distinct IDs of the subjects of the given type and relation that appear
+// in relation tuples of the snapshot, in ascending order.
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-reader.query-unique-subject-reference")
+	defer span.End()
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	from, err := decodeKey(pagination, 1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	statement := utils.NewWhere(tenantID).
+		Eq("subject_type", subjectReference.GetType()).
+		Eq("subject_relation", subjectReference.GetRelation()).
+		From([]string{"subject_id"}, from).
+		Statement(db.RelationTuplesTable, []string{"DISTINCT subject_id"}, page([]string{"subject_id"}, pagination))
+
+	ids, ct, err = r.distinct(ctx, st, statement, pagination)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to query unique subject references: ", slog.Any("error", err))
+
+		return nil, nil, readError(err)
+	}
+
+	return ids, ct, nil
+}
+
+// ReadRelationshipHistory reads the creations and deletions of relation tuples matching the given filter, oldest first.
+func (r *DataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _, _ time.Time, _ database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+}
+
+// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant. The head is the
+// commit timestamp of the last write of the tenant, or the timestamp of the read if the tenant has no writes yet.
+func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "data-reader.head-snapshot")
+	defer span.End()
+
+	txn := r.database.Client.Single()
+	row, err := txn.ReadRow(ctx, db.HeadsTable, spanner.Key{tenantID}, []string{"committed_at"})
+	if err != nil && spanner.ErrCode(err) != grpcCodes.NotFound {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read head snapshot: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if row == nil {
+		at, err := txn.Timestamp()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		return snapshot.NewToken(at), nil
+	}
+
+	var committedAt time.Time
+	if err = row.Columns(&committedAt); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(committedAt), nil
+}
+
+// SnapshotAt returns the snapshot token of the given point in time. Points in the future are read as of now, and
+// points older than the version retention period of the database cannot be served.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "data-reader.snapshot-at")
+	defer span.End()
+
+	slog.Info("Getting snapshot at the given time for tenantID: ", slog.String("tenant_id", tenantID), slog.Time("at", at))
+
+	retention, err := r.versionRetentionPeriod(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read the version retention period: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	now := time.Now()
+	if at.Before(now.Add(-retention)) {
+		err = errors.New(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if at.After(now) {
+		at = now
+	}
+
+	return snapshot.NewToken(at), nil
+}
+
+// versionRetentionPeriod reads how long the database keeps old versions of its data.
+func (r *DataReader) versionRetentionPeriod(ctx context.Context) (time.Duration, error) {
+	retention := "1h"
+	err := query(ctx, r.database.Client.Single(), spanner.Statement{
+		SQL: "SELECT OPTION_VALUE FROM INFORMATION_SCHEMA.DATABASE_OPTIONS WHERE OPTION_NAME = 'version_retention_period'",
+	}, func(row *spanner.Row) error {
+		return row.Columns(&retention)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return utils.ParseRetentionPeriod(retention)
+}
+
+// at returns a single use read-only transaction reading the snapshot.
+func (r *DataReader) at(st snapshot.Token) *spanner.ReadOnlyTransaction {
+	return r.database.Client.Single().WithTimestampBound(spanner.ReadTimestamp(st.Time()))
+}
+
+// distinct reads one page of the single string column values the statement produces. The token is the first
+// value of the next page.
+func (r *DataReader) distinct(ctx context.Context, st snapshot.Token, statement spanner.Statement, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	ids := make([]string, 0, pagination.PageSize())
+	ct := database.NewNoopContinuousToken().Encode()
+	err := query(ctx, r.at(st), statement, func(row *spanner.Row) error {
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return err
+		}
+		if len(ids) == int(pagination.PageSize()) {
+			ct = utils.NewContinuousToken(utils.EncodeKey([]string{id})).Encode()
+			return nil
+		}
+		ids = append(ids, id)
+		return nil
+	})
+	return ids, ct, err
+}
+
+// query runs the statement in the transaction and calls fn for every row of the result.
+func query(ctx context.Context, txn interface {
+	Query(ctx context.Context, statement spanner.Statement) *spanner.RowIterator
+}, statement spanner.Statement, fn func(row *spanner.Row) error,
+) error {
+	it := txn.Query(ctx, statement)
+	defer it.Stop()
+	for {
+		row, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+// page returns the clause ordering the rows by the columns and reading one page of the pagination, plus one row
+// telling whether there are more.
+func page(columns []string, pagination database.Pagination) string {
+	return "ORDER BY " + strings.Join(columns, ", ") + " LIMIT " + strconv.Itoa(int(pagination.PageSize())+1)
+}
+
+// readError converts an error of a snapshot read to the error returned to callers. Reads older than the version
+// retention period of the database are rejected by Spanner as a failed precondition.
+func readError(err error) error {
+	if spanner.ErrCode(err) == grpcCodes.FailedPrecondition {
+		return errors.New(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String())
+	}
+	return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+}
+
+// decodeSnapshot decodes an encoded snapshot token.
+func decodeSnapshot(snap string) (snapshot.Token, error) {
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return snapshot.Token{}, err
+	}
+	return st.(snapshot.Token), nil
+}
+
+// decodeKey decodes the continuous token of the pagination to the key of the first row of the page.
+func decodeKey(pagination database.Pagination, size int) ([]string, error) {
+	if pagination.Token() == "" {
+		return nil, nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+	if err != nil {
+		return nil, err
+	}
+	key, err := utils.DecodeKey(t.(utils.ContinuousToken).Value, size)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return key, nil
+}
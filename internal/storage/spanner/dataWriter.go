@@ -0,0 +1,324 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/spanner/snapshot"
+	"github.com/Permify/permify/internal/storage/spanner/utils"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// DataWriter - Structure for Data Writer
+//
+// Every write runs in a read-write transaction that buffers its mutations together with the changes it makes and
+// the head of the tenant, all stamped with the commit timestamp. Spanner orders commit timestamps by commit order
+// across regions, so the timestamp returned by a write is a snapshot that contains it and every earlier write.
+type DataWriter struct {
+	database *db.Spanner
+	// options
+	maxDataPerWrite int
+}
+
+// NewDataWriter - Creates a new DataWriter
+func NewDataWriter(database *db.Spanner) *DataWriter {
+	return &DataWriter{
+		database:        database,
+		maxDataPerWrite: _defaultMaxDataPerWrite,
+	}
+}
+
+// Write writes relation tuples and attributes to the storage.
+func (w *DataWriter) Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.write")
+	defer span.End()
+
+	if len(tupleCollection.GetTuples())+len(attributeCollection.GetAttributes()) > w.maxDataPerWrite {
+		return nil, errors.New("max data per write exceeded")
+	}
+
+	committedAt, err := w.database.Client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		changes := newChangeBuffer(ctx, tenantID)
+		if err := changes.write(tupleCollection, attributeCollection); err != nil {
+			return err
+		}
+		return txn.BufferWrite(changes.commit())
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to write data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(committedAt).Encode(), nil
+}
+
+// Delete deletes relation tuples and attributes matching the given filters from the storage.
+func (w *DataWriter) Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.delete")
+	defer span.End()
+
+	committedAt, err := w.database.Client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		changes := newChangeBuffer(ctx, tenantID)
+		if err := changes.delete(ctx, txn, tupleFilter, attributeFilter); err != nil {
+			return err
+		}
+		return txn.BufferWrite(changes.commit())
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete data: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(committedAt).Encode(), nil
+}
+
+// RunTransaction deletes and then writes the relation tuples and attributes of the transaction in a single
+// read-write transaction. The deletions read the rows as of the start of the transaction, since reads do not see
+// the mutations buffered before them.
+func (w *DataWriter) RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.run-transaction")
+	defer span.End()
+
+	if len(transaction.Tuples.GetTuples())+len(transaction.Attributes.GetAttributes()) > w.maxDataPerWrite {
+		return nil, errors.New("max data per write exceeded")
+	}
+
+	committedAt, err := w.database.Client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		changes := newChangeBuffer(ctx, tenantID)
+		for i := range transaction.TupleFilters {
+			if err := changes.delete(ctx, txn, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
+			}
+		}
+		if err := changes.write(transaction.Tuples, transaction.Attributes); err != nil {
+			return err
+		}
+
+		return txn.BufferWrite(changes.commit())
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to run data transaction: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return snapshot.NewToken(committedAt).Encode(), nil
+}
+
+// MigrateSchema writes the definitions of a schema version along with the deletions and writes of the transaction
+// migrating the data to it in a single read-write transaction.
+func (w *DataWriter) MigrateSchema(ctx context.Context, tenantID string, definitions []storage.SchemaDefinition, transaction *database.Transaction) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "data-writer.migrate-schema")
+	defer span.End()
+
+	// conflict is set when the latest version of the tenant isn't the version the transaction expects
+	var conflict bool
+	committedAt, err := w.database.Client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		conflict = false
+		if transaction.SchemaVersion != nil {
+			var head string
+			err := query(ctx, txn, spanner.Statement{
+				SQL:    "SELECT version FROM " + db.SchemaDefinitionsTable + " WHERE tenant_id = @tenant_id ORDER BY version DESC LIMIT 1",
+				Params: map[string]interface{}{"tenant_id": tenantID},
+			}, func(row *spanner.Row) error {
+				return row.Columns(&head)
+			})
+			if err != nil {
+				return err
+			}
+			if head != *transaction.SchemaVersion {
+				conflict = true
+				return nil
+			}
+		}
+
+		changes := newChangeBuffer(ctx, tenantID)
+		for i := range transaction.TupleFilters {
+			if err := changes.delete(ctx, txn, transaction.TupleFilters[i], transaction.AttributeFilters[i]); err != nil {
+				return err
+			}
+		}
+		if err := changes.write(transaction.Tuples, transaction.Attributes); err != nil {
+			return err
+		}
+
+		mutations := changes.commit()
+		for _, d := range definitions {
+			mutations = append(mutations, spanner.Insert(db.SchemaDefinitionsTable,
+				[]string{"tenant_id", "version", "name", "serialized_definition"},
+				[]interface{}{d.TenantID, d.Version, d.Name, d.SerializedDefinition},
+			))
+		}
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to migrate schema: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if conflict {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_CONFLICT.String())
+	}
+
+	return snapshot.NewToken(committedAt).Encode(), nil
+}
+
+// changeBuffer collects the mutations of a transaction along with the rows recording its changes.
+type changeBuffer struct {
+	tenantID  string
+	actor     string
+	mutations []*spanner.Mutation
+	seq       int
+	// deleted holds the rows deleted already, so that rows matching several filters are deleted once
+	deleted map[string]struct{}
+}
+
+// newChangeBuffer returns an empty buffer for a transaction of the tenant made by the actor of the context.
+func newChangeBuffer(ctx context.Context, tenantID string) *changeBuffer {
+	return &changeBuffer{
+		tenantID: tenantID,
+		actor:    authn.ActorFromContext(ctx),
+		deleted:  map[string]struct{}{},
+	}
+}
+
+// write buffers the mutations storing the relation tuples and attributes.
+func (b *changeBuffer) write(tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection) error {
+	titer := tupleCollection.CreateTupleIterator()
+	for titer.HasNext() {
+		t := utils.NewRelationTuple(b.tenantID, titer.GetNext())
+		if err := b.addTuple(utils.TupleMutation(t), base.DataChange_OPERATION_CREATE, t); err != nil {
+			return err
+		}
+	}
+
+	aiter := attributeCollection.CreateAttributeIterator()
+	for aiter.HasNext() {
+		a := aiter.GetNext()
+		attribute := storage.Attribute{
+			TenantID:   b.tenantID,
+			EntityType: a.GetEntity().GetType(),
+			EntityID:   a.GetEntity().GetId(),
+			Attribute:  a.GetAttribute(),
+			Value:      a.GetValue(),
+		}
+		m, err := utils.AttributeMutation(attribute)
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		if err := b.addAttribute(m, base.DataChange_OPERATION_CREATE, attribute); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delete reads the rows matching the filters and buffers the mutations deleting them, skipping the empty filters.
+func (b *changeBuffer) delete(ctx context.Context, txn *spanner.ReadWriteTransaction, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) error {
+	if !validation.IsTupleFilterEmpty(tupleFilter) {
+		err := query(ctx, txn, utils.TuplesWhere(b.tenantID, tupleFilter).Statement(db.RelationTuplesTable, utils.TupleKeyColumns, ""), func(row *spanner.Row) error {
+			t, err := utils.TupleFromRow(b.tenantID, row)
+			if err != nil {
+				return err
+			}
+			return b.deleteTuple(t)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !validation.IsAttributeFilterEmpty(attributeFilter) {
+		err := query(ctx, txn, utils.AttributesWhere(b.tenantID, attributeFilter).Statement(db.AttributesTable, utils.AttributeColumns, ""), func(row *spanner.Row) error {
+			a, err := utils.AttributeFromRow(b.tenantID, row)
+			if err != nil {
+				return err
+			}
+			return b.deleteAttribute(a)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addTuple buffers the mutation of the relation tuple and records the change it makes.
+func (b *changeBuffer) addTuple(m *spanner.Mutation, op base.DataChange_Operation, t storage.RelationTuple) error {
+	return b.add(m, &base.DataChange{
+		Operation: op,
+		Type:      &base.DataChange_Tuple{Tuple: t.ToTuple()},
+	})
+}
+
+// addAttribute buffers the mutation of the attribute and records the change it makes.
+func (b *changeBuffer) addAttribute(m *spanner.Mutation, op base.DataChange_Operation, a storage.Attribute) error {
+	return b.add(m, &base.DataChange{
+		Operation: op,
+		Type:      &base.DataChange_Attribute{Attribute: a.ToAttribute()},
+	})
+}
+
+// deleteTuple buffers the deletion of the relation tuple, unless it is deleted already.
+func (b *changeBuffer) deleteTuple(t storage.RelationTuple) error {
+	key := db.RelationTuplesTable + ":" + tuple.ToString(t.ToTuple())
+	if _, ok := b.deleted[key]; ok {
+		return nil
+	}
+	b.deleted[key] = struct{}{}
+	return b.addTuple(utils.TupleDeletion(t), base.DataChange_OPERATION_DELETE, t)
+}
+
+// deleteAttribute buffers the deletion of the attribute, unless it is deleted already.
+func (b *changeBuffer) deleteAttribute(a storage.Attribute) error {
+	key := db.AttributesTable + ":" + a.EntityType + ":" + a.EntityID + "$" + a.Attribute
+	if _, ok := b.deleted[key]; ok {
+		return nil
+	}
+	b.deleted[key] = struct{}{}
+	return b.addAttribute(utils.AttributeDeletion(a), base.DataChange_OPERATION_DELETE, a)
+}
+
+// add buffers the mutation and the row recording the change.
+func (b *changeBuffer) add(m *spanner.Mutation, change *base.DataChange) error {
+	c, err := utils.ChangeMutation(b.tenantID, b.seq, b.actor, change)
+	if err != nil {
+		return err
+	}
+	b.seq++
+	b.mutations = append(b.mutations, m, c)
+	return nil
+}
+
+// commit returns the buffered mutations followed by the one moving the head of the tenant.
+func (b *changeBuffer) commit() []*spanner.Mutation {
+	return append(b.mutations, utils.HeadMutation(b.tenantID))
+}
@@ -0,0 +1,207 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaReader - Structure for Schema Reader
+type SchemaReader struct {
+	database *db.Spanner
+	// versions are the schema versions compiled to read the definitions of their entities
+	versions *storage.CompiledVersions
+}
+
+// NewSchemaReader - Creates a new SchemaReader
+func NewSchemaReader(database *db.Spanner) *SchemaReader {
+	return &SchemaReader{
+		database: database,
+		versions: storage.NewCompiledVersions(),
+	}
+}
+
+// ReadSchema returns the schema definition for a specific tenant and version as a structured object.
+func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string) (sch *base.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema")
+	defer span.End()
+
+	definitions, err := r.ReadSchemaDefinitions(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	serialized := make([]string, 0, len(definitions))
+	for _, d := range definitions {
+		serialized = append(serialized, d.Serialized())
+	}
+
+	sch, err = schema.NewSchemaFromStringDefinitions(false, serialized...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return sch, nil
+}
+
+// ReadEntityDefinition reads entity config from the storage.
+func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name, version string) (definition *base.EntityDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-entity-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = r.versions.Entity(tenantID, def.Version, name, func() (*base.SchemaDefinition, error) {
+		return r.ReadSchema(ctx, tenantID, def.Version)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+	return definition, def.Version, nil
+}
+
+// ReadRuleDefinition reads rule config from the storage.
+func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, name, version string) (definition *base.RuleDefinition, v string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-rule-definition")
+	defer span.End()
+
+	def, err := r.readDefinition(ctx, tenantID, name, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	var sch *base.SchemaDefinition
+	sch, err = schema.NewSchemaFromStringDefinitions(false, def.Serialized())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, "", err
+	}
+
+	definition, err = schema.GetRuleByName(sch, name)
+	return definition, def.Version, err
+}
+
+// HeadVersion finds the latest version of the schema for the tenant. Versions sort by creation time.
+func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.head-version")
+	defer span.End()
+
+	err = query(ctx, r.database.Client.Single(), spanner.Statement{
+		SQL:    "SELECT version FROM " + db.SchemaDefinitionsTable + " WHERE tenant_id = @tenant_id ORDER BY version DESC LIMIT 1",
+		Params: map[string]interface{}{"tenant_id": tenantID},
+	}, func(row *spanner.Row) error {
+		return row.Columns(&version)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read head version: ", slog.Any("error", err))
+
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	if version == "" {
+		err = errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return "", err
+	}
+
+	return version, nil
+}
+
+// ReadSchemaDefinitions reads the serialized definitions of a schema version, ordered by name.
+func (r *SchemaReader) ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []storage.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-definitions")
+	defer span.End()
+
+	err = query(ctx, r.database.Client.Single(), spanner.Statement{
+		SQL:    "SELECT name, serialized_definition FROM " + db.SchemaDefinitionsTable + " WHERE tenant_id = @tenant_id AND version = @version ORDER BY name",
+		Params: map[string]interface{}{"tenant_id": tenantID, "version": version},
+	}, func(row *spanner.Row) error {
+		d := storage.SchemaDefinition{TenantID: tenantID, Version: version}
+		if err := row.Columns(&d.Name, &d.SerializedDefinition); err != nil {
+			return err
+		}
+		definitions = append(definitions, d)
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema definitions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return definitions, nil
+}
+
+// ReadSchemaVersions reads the versions of the schema of the tenant, oldest first. Versions sort by creation time.
+func (r *SchemaReader) ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-versions")
+	defer span.End()
+
+	err = query(ctx, r.database.Client.Single(), spanner.Statement{
+		SQL:    "SELECT DISTINCT version FROM " + db.SchemaDefinitionsTable + " WHERE tenant_id = @tenant_id ORDER BY version",
+		Params: map[string]interface{}{"tenant_id": tenantID},
+	}, func(row *spanner.Row) error {
+		var version string
+		if err := row.Columns(&version); err != nil {
+			return err
+		}
+		versions = append(versions, version)
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to read schema versions: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return versions, nil
+}
+
+// readDefinition reads a single serialized definition of a schema version.
+func (r *SchemaReader) readDefinition(ctx context.Context, tenantID, name, version string) (storage.SchemaDefinition, error) {
+	row, err := r.database.Client.Single().ReadRow(ctx, db.SchemaDefinitionsTable, spanner.Key{tenantID, version, name}, []string{"serialized_definition"})
+	if err != nil {
+		if spanner.ErrCode(err) == grpcCodes.NotFound {
+			return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		}
+		return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	d := storage.SchemaDefinition{TenantID: tenantID, Name: name, Version: version}
+	if err = row.Columns(&d.SerializedDefinition); err != nil {
+		return storage.SchemaDefinition{}, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	return d, nil
+}
@@ -0,0 +1,55 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// SchemaWriter - Structure for Schema Writer
+type SchemaWriter struct {
+	database *db.Spanner
+}
+
+// NewSchemaWriter creates a new SchemaWriter
+func NewSchemaWriter(database *db.Spanner) *SchemaWriter {
+	return &SchemaWriter{
+		database: database,
+	}
+}
+
+// WriteSchema writes the definitions of a schema version to the storage.
+func (w *SchemaWriter) WriteSchema(ctx context.Context, definitions []storage.SchemaDefinition) (err error) {
+	ctx, span := tracer.Start(ctx, "schema-writer.write-schema")
+	defer span.End()
+
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	mutations := make([]*spanner.Mutation, 0, len(definitions))
+	for _, d := range definitions {
+		mutations = append(mutations, spanner.Insert(db.SchemaDefinitionsTable,
+			[]string{"tenant_id", "version", "name", "serialized_definition"},
+			[]interface{}{d.TenantID, d.Version, d.Name, d.SerializedDefinition},
+		))
+	}
+
+	if _, err = w.database.Client.Apply(ctx, mutations); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to write schema: ", slog.Any("error", err))
+
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return nil
+}
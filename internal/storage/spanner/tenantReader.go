@@ -0,0 +1,72 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/spanner/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantReader - Structure for Tenant Reader
+type TenantReader struct {
+	database *db.Spanner
+}
+
+// NewTenantReader creates a new TenantReader
+func NewTenantReader(database *db.Spanner) *TenantReader {
+	return &TenantReader{
+		database: database,
+	}
+}
+
+// ListTenants reads the tenants ordered by ID, a page at a time.
+func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-reader.list-tenants")
+	defer span.End()
+
+	from, err := decodeKey(pagination, 1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+
+	sql := "SELECT id, name, created_at FROM " + db.TenantsTable
+	params := map[string]interface{}{}
+	if len(from) > 0 {
+		sql += " WHERE id >= @from"
+		params["from"] = from[0]
+	}
+
+	ct = database.NewNoopContinuousToken().Encode()
+	err = query(ctx, r.database.Client.Single(), spanner.Statement{SQL: sql + " " + page([]string{"id"}, pagination), Params: params}, func(row *spanner.Row) error {
+		var t storage.Tenant
+		if err := row.Columns(&t.ID, &t.Name, &t.CreatedAt); err != nil {
+			return err
+		}
+		if len(tenants) == int(pagination.PageSize()) {
+			ct = utils.NewContinuousToken(utils.EncodeKey([]string{t.ID})).Encode()
+			return nil
+		}
+		tenants = append(tenants, t.ToTenant())
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to list tenants: ", slog.Any("error", err))
+
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return tenants, ct, nil
+}
@@ -0,0 +1,88 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"go.opentelemetry.io/otel/codes"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/Permify/permify/internal/storage"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// TenantWriter - Structure for Tenant Writer
+type TenantWriter struct {
+	database *db.Spanner
+}
+
+// NewTenantWriter creates a new TenantWriter
+func NewTenantWriter(database *db.Spanner) *TenantWriter {
+	return &TenantWriter{
+		database: database,
+	}
+}
+
+// CreateTenant creates a tenant, failing if a tenant with the same ID exists.
+func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.create-tenant")
+	defer span.End()
+
+	tenant := storage.Tenant{
+		ID:        id,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	_, err = w.database.Client.Apply(ctx, []*spanner.Mutation{
+		spanner.Insert(db.TenantsTable, []string{"id", "name", "created_at"}, []interface{}{tenant.ID, tenant.Name, tenant.CreatedAt}),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		if spanner.ErrCode(err) == grpcCodes.AlreadyExists {
+			slog.Error("Duplicate key violation: Tenant with ID already exists", slog.Any("id", id))
+
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+		}
+
+		slog.Error("Failed to create tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return tenant.ToTenant(), nil
+}
+
+// DeleteTenant deletes a tenant and returns it.
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+
+	tenant := storage.Tenant{ID: tenantID}
+	_, err = w.database.Client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, db.TenantsTable, spanner.Key{tenantID}, []string{"name", "created_at"})
+		if err != nil {
+			return err
+		}
+		if err = row.Columns(&tenant.Name, &tenant.CreatedAt); err != nil {
+			return err
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Delete(db.TenantsTable, spanner.Key{tenantID})})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.Error("Failed to delete tenant: ", slog.Any("error", err))
+
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return tenant.ToTenant(), nil
+}
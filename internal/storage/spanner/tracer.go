@@ -0,0 +1,7 @@
+package spanner
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("storage.spanner")
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/storage"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// TupleFromRow - Converts a row holding the key columns of a relation tuple to a storage relation tuple
+func TupleFromRow(tenantID string, row *spanner.Row) (storage.RelationTuple, error) {
+	t := storage.RelationTuple{TenantID: tenantID}
+	err := row.Columns(&t.EntityType, &t.EntityID, &t.Relation, &t.SubjectType, &t.SubjectID, &t.SubjectRelation)
+	return t, err
+}
+
+// TupleKey - Returns the key columns of the relation tuple, in primary key order after the tenant
+func TupleKey(t storage.RelationTuple) []string {
+	return []string{t.EntityType, t.EntityID, t.Relation, t.SubjectType, t.SubjectID, t.SubjectRelation}
+}
+
+// NewRelationTuple - Converts a base tuple of the tenant to a storage relation tuple. Subjects without a relation
+// and subjects with the ellipsis relation are stored alike.
+func NewRelationTuple(tenantID string, t *base.Tuple) storage.RelationTuple {
+	srelation := t.GetSubject().GetRelation()
+	if srelation == tuple.ELLIPSIS {
+		srelation = ""
+	}
+	return storage.RelationTuple{
+		TenantID:        tenantID,
+		EntityType:      t.GetEntity().GetType(),
+		EntityID:        t.GetEntity().GetId(),
+		Relation:        t.GetRelation(),
+		SubjectType:     t.GetSubject().GetType(),
+		SubjectID:       t.GetSubject().GetId(),
+		SubjectRelation: srelation,
+	}
+}
+
+// TupleMutation - Returns the mutation writing the relation tuple at the commit timestamp
+func TupleMutation(t storage.RelationTuple) *spanner.Mutation {
+	return spanner.InsertOrUpdate(db.RelationTuplesTable,
+		append([]string{"tenant_id"}, append(TupleKeyColumns, "committed_at")...),
+		[]interface{}{t.TenantID, t.EntityType, t.EntityID, t.Relation, t.SubjectType, t.SubjectID, t.SubjectRelation, spanner.CommitTimestamp},
+	)
+}
+
+// TupleDeletion - Returns the mutation deleting the relation tuple
+func TupleDeletion(t storage.RelationTuple) *spanner.Mutation {
+	return spanner.Delete(db.RelationTuplesTable, spanner.Key{t.TenantID, t.EntityType, t.EntityID, t.Relation, t.SubjectType, t.SubjectID, t.SubjectRelation})
+}
+
+// AttributeFromRow - Converts a row holding the columns of an attribute to a storage attribute
+func AttributeFromRow(tenantID string, row *spanner.Row) (storage.Attribute, error) {
+	a := storage.Attribute{TenantID: tenantID}
+	var value []byte
+	if err := row.Columns(&a.EntityType, &a.EntityID, &a.Attribute, &value); err != nil {
+		return storage.Attribute{}, err
+	}
+	a.Value = &anypb.Any{}
+	if err := proto.Unmarshal(value, a.Value); err != nil {
+		return storage.Attribute{}, err
+	}
+	return a, nil
+}
+
+// AttributeKey - Returns the key columns of the attribute, in primary key order after the tenant
+func AttributeKey(a storage.Attribute) []string {
+	return []string{a.EntityType, a.EntityID, a.Attribute}
+}
+
+// AttributeMutation - Returns the mutation writing the attribute at the commit timestamp
+func AttributeMutation(a storage.Attribute) (*spanner.Mutation, error) {
+	value, err := proto.Marshal(a.Value)
+	if err != nil {
+		return nil, err
+	}
+	return spanner.InsertOrUpdate(db.AttributesTable,
+		[]string{"tenant_id", "entity_type", "entity_id", "attribute", "value", "committed_at"},
+		[]interface{}{a.TenantID, a.EntityType, a.EntityID, a.Attribute, value, spanner.CommitTimestamp},
+	), nil
+}
+
+// AttributeDeletion - Returns the mutation deleting the attribute
+func AttributeDeletion(a storage.Attribute) *spanner.Mutation {
+	return spanner.Delete(db.AttributesTable, spanner.Key{a.TenantID, a.EntityType, a.EntityID, a.Attribute})
+}
+
+// ChangeMutation - Returns the mutation recording the change at the commit timestamp. The sequence number orders
+// the changes of a commit.
+func ChangeMutation(tenantID string, seq int, actor string, change *base.DataChange) (*spanner.Mutation, error) {
+	b, err := proto.Marshal(change)
+	if err != nil {
+		return nil, err
+	}
+	return spanner.Insert(db.ChangesTable,
+		[]string{"tenant_id", "committed_at", "seq", "actor", "change"},
+		[]interface{}{tenantID, spanner.CommitTimestamp, int64(seq), actor, b},
+	), nil
+}
+
+// HeadMutation - Returns the mutation moving the head of the tenant to the commit timestamp
+func HeadMutation(tenantID string) *spanner.Mutation {
+	return spanner.InsertOrUpdate(db.HeadsTable, []string{"tenant_id", "committed_at"}, []interface{}{tenantID, spanner.CommitTimestamp})
+}
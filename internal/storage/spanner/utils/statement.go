@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Key columns of the relation tuples and attributes tables, in primary key order after the tenant.
+var (
+	TupleKeyColumns     = []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}
+	AttributeKeyColumns = []string{"entity_type", "entity_id", "attribute"}
+	AttributeColumns    = []string{"entity_type", "entity_id", "attribute", "value"}
+)
+
+// Where - Conditions of a statement joined with AND, along with the parameters they refer to
+type Where struct {
+	conditions []string
+	params     map[string]interface{}
+}
+
+// NewWhere - Creates the conditions selecting the rows of the tenant
+func NewWhere(tenantID string) *Where {
+	w := &Where{params: map[string]interface{}{}}
+	return w.Eq("tenant_id", tenantID)
+}
+
+// param - Registers the value as a new parameter and returns its reference
+func (w *Where) param(value interface{}) string {
+	name := fmt.Sprintf("p%d", len(w.params))
+	w.params[name] = value
+	return "@" + name
+}
+
+// Eq - Adds a condition matching rows whose column equals the value
+func (w *Where) Eq(column string, value interface{}) *Where {
+	w.conditions = append(w.conditions, column+" = "+w.param(value))
+	return w
+}
+
+// In - Adds a condition matching rows whose column is one of the values. No condition is added for no values.
+func (w *Where) In(column string, values []string) *Where {
+	if len(values) == 0 {
+		return w
+	}
+	w.conditions = append(w.conditions, column+" IN UNNEST("+w.param(values)+")")
+	return w
+}
+
+// From - Adds a condition matching rows whose columns sort, in the given order, at or after the key
+func (w *Where) From(columns, key []string) *Where {
+	if len(key) == 0 {
+		return w
+	}
+	refs := make([]string, len(key))
+	for i := range key {
+		refs[i] = w.param(key[i])
+	}
+	alternatives := make([]string, 0, len(key))
+	for i := range key {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, columns[j]+" = "+refs[j])
+		}
+		op := " > "
+		if i == len(key)-1 {
+			op = " >= "
+		}
+		terms = append(terms, columns[i]+op+refs[i])
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+	w.conditions = append(w.conditions, "("+strings.Join(alternatives, " OR ")+")")
+	return w
+}
+
+// String - Returns the conditions as the body of a WHERE clause
+func (w *Where) String() string {
+	return strings.Join(w.conditions, " AND ")
+}
+
+// Params - Returns the parameters the conditions refer to
+func (w *Where) Params() map[string]interface{} {
+	return w.params
+}
+
+// Statement - Builds the statement selecting the columns of the matching rows, followed by the suffix
+func (w *Where) Statement(table string, columns []string, suffix string) spanner.Statement {
+	sql := "SELECT " + strings.Join(columns, ", ") + " FROM " + table + " WHERE " + w.String()
+	if suffix != "" {
+		sql += " " + suffix
+	}
+	return spanner.Statement{SQL: sql, Params: w.params}
+}
+
+// ParseRetentionPeriod - Parses a version retention period option of the database, such as 1h, 3600s or 7d
+func ParseRetentionPeriod(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// TuplesWhere - Builds the conditions selecting the relation tuples of the tenant matching the filter
+func TuplesWhere(tenantID string, filter *base.TupleFilter) *Where {
+	w := NewWhere(tenantID)
+	if filter.GetEntity().GetType() != "" {
+		w.Eq("entity_type", filter.GetEntity().GetType())
+	}
+	w.In("entity_id", filter.GetEntity().GetIds())
+	if filter.GetRelation() != "" {
+		w.Eq("relation", filter.GetRelation())
+	}
+	if filter.GetSubject().GetType() != "" {
+		w.Eq("subject_type", filter.GetSubject().GetType())
+	}
+	w.In("subject_id", filter.GetSubject().GetIds())
+	if filter.GetSubject().GetRelation() != "" {
+		w.Eq("subject_relation", filter.GetSubject().GetRelation())
+	}
+	return w
+}
+
+// AttributesWhere - Builds the conditions selecting the attributes of the tenant matching the filter
+func AttributesWhere(tenantID string, filter *base.AttributeFilter) *Where {
+	w := NewWhere(tenantID)
+	if filter.GetEntity().GetType() != "" {
+		w.Eq("entity_type", filter.GetEntity().GetType())
+	}
+	w.In("entity_id", filter.GetEntity().GetIds())
+	w.In("attribute", filter.GetAttributes())
+	return w
+}
+
+// EncodeKey - Encodes the key of a row to the value of a continuous token
+func EncodeKey(key []string) string {
+	b, _ := json.Marshal(key)
+	return string(b)
+}
+
+// DecodeKey - Decodes the value of a continuous token to the key of a row. The key must have the given size.
+func DecodeKey(value string, size int) ([]string, error) {
+	var key []string
+	if err := json.Unmarshal([]byte(value), &key); err != nil {
+		return nil, err
+	}
+	if len(key) != size {
+		return nil, fmt.Errorf("key has %d columns, expected %d", len(key), size)
+	}
+	return key, nil
+}
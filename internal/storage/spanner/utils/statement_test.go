@@ -0,0 +1,71 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Permify/permify/internal/storage/spanner/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestTuplesWhere(t *testing.T) {
+	w := utils.TuplesWhere("t1", &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"1", "2"}},
+		Relation: "admin",
+		Subject:  &base.SubjectFilter{Type: "user"},
+	})
+
+	assert.Equal(t, "tenant_id = @p0 AND entity_type = @p1 AND entity_id IN UNNEST(@p2) AND relation = @p3 AND subject_type = @p4", w.String())
+	assert.Equal(t, map[string]interface{}{
+		"p0": "t1",
+		"p1": "organization",
+		"p2": []string{"1", "2"},
+		"p3": "admin",
+		"p4": "user",
+	}, w.Params())
+}
+
+func TestAttributesWhere(t *testing.T) {
+	w := utils.AttributesWhere("t1", &base.AttributeFilter{Attributes: []string{"public"}})
+
+	assert.Equal(t, "tenant_id = @p0 AND attribute IN UNNEST(@p1)", w.String())
+}
+
+func TestFrom(t *testing.T) {
+	w := utils.NewWhere("t1").From([]string{"a", "b", "c"}, []string{"1", "2", "3"})
+
+	assert.Equal(t, "tenant_id = @p0 AND ((a > @p1) OR (a = @p1 AND b > @p2) OR (a = @p1 AND b = @p2 AND c >= @p3))", w.String())
+
+	statement := utils.NewWhere("t1").From([]string{"a"}, nil).Statement("attributes", []string{"a"}, "LIMIT 1")
+	assert.Equal(t, "SELECT a FROM attributes WHERE tenant_id = @p0 LIMIT 1", statement.SQL)
+}
+
+func TestKeyRoundTrip(t *testing.T) {
+	key := []string{"organization", "1", "admin", "user", "2", ""}
+
+	got, err := utils.DecodeKey(utils.EncodeKey(key), len(key))
+	assert.NoError(t, err)
+	assert.Equal(t, key, got)
+
+	_, err = utils.DecodeKey(utils.EncodeKey(key), 3)
+	assert.Error(t, err)
+}
+
+func TestParseRetentionPeriod(t *testing.T) {
+	d, err := utils.ParseRetentionPeriod("7d")
+	assert.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, d)
+
+	d, err = utils.ParseRetentionPeriod("1h")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, d)
+
+	d, err = utils.ParseRetentionPeriod("3600s")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, d)
+
+	_, err = utils.ParseRetentionPeriod("xd")
+	assert.Error(t, err)
+}
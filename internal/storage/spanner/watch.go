@@ -0,0 +1,135 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/storage/spanner/snapshot"
+	db "github.com/Permify/permify/pkg/database/spanner"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Watch is an implementation of the storage.Watch interface that polls the changes recorded by the writes of the
+// tenant. A strong read returns every commit up to its timestamp, and later commits get later timestamps, so
+// following the commit timestamps never skips a change.
+type Watch struct {
+	database *db.Spanner
+	// options
+	bufferSize int
+}
+
+// NewWatcher returns a new instance of the Watch.
+func NewWatcher(database *db.Spanner) *Watch {
+	return &Watch{
+		database:   database,
+		bufferSize: _defaultWatchBufferSize,
+	}
+}
+
+// Watch returns a channel that emits a stream of changes to the relation tuples and attributes of the tenant
+// made after the given snapshot.
+func (w *Watch) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	changes := make(chan *base.DataChanges, w.bufferSize)
+	errs := make(chan error, 1)
+
+	slog.Info("Watching for changes in the database. ", slog.Any("tenant_id", tenantID), slog.Any("snapshot", snap))
+
+	st, err := decodeSnapshot(snap)
+	if err != nil {
+		errs <- err
+
+		slog.Error("Failed to decode snapshot.", slog.Any("error", err))
+
+		return changes, errs
+	}
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		cr := st.Time()
+
+		for {
+			updates, err := w.getChanges(ctx, tenantID, cr)
+			if err != nil {
+				slog.Error("Failed to get recent changes. ", slog.Any("error", err))
+				errs <- err
+				return
+			}
+
+			for _, u := range updates {
+				select {
+				case changes <- u:
+					slog.Info("Sent updates to the changes channel for commit. ", slog.Any("snap_token", u.GetSnapToken()))
+				case <-ctx.Done():
+					slog.Error("Context canceled, stopping watch.")
+					errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+					return
+				}
+
+				t, err := snapshot.EncodedToken{Value: u.GetSnapToken()}.Decode()
+				if err != nil {
+					errs <- err
+					return
+				}
+				cr = t.(snapshot.Token).Time()
+			}
+
+			if len(updates) == 0 {
+				sleep := time.NewTimer(100 * time.Millisecond)
+
+				select {
+				case <-sleep.C:
+					slog.Info("No recent changes, waiting for changes...")
+				case <-ctx.Done():
+					slog.Error("Context canceled, stopping watch.")
+					errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+					return
+				}
+			}
+		}
+	}()
+
+	slog.Info("Watch started successfully.")
+
+	return changes, errs
+}
+
+// getChanges reads the changes of the tenant committed after the given time, grouped by commit.
+func (w *Watch) getChanges(ctx context.Context, tenantID string, after time.Time) ([]*base.DataChanges, error) {
+	var updates []*base.DataChanges
+	var last time.Time
+
+	err := query(ctx, w.database.Client.Single(), spanner.Statement{
+		SQL:    "SELECT committed_at, change FROM " + db.ChangesTable + " WHERE tenant_id = @tenant_id AND committed_at > @after ORDER BY committed_at, seq",
+		Params: map[string]interface{}{"tenant_id": tenantID, "after": after},
+	}, func(row *spanner.Row) error {
+		var committedAt time.Time
+		var b []byte
+		if err := row.Columns(&committedAt, &b); err != nil {
+			return err
+		}
+		change := &base.DataChange{}
+		if err := proto.Unmarshal(b, change); err != nil {
+			return err
+		}
+		if len(updates) == 0 || !committedAt.Equal(last) {
+			updates = append(updates, &base.DataChanges{
+				SnapToken: snapshot.NewToken(committedAt).Encode().String(),
+			})
+			last = committedAt
+		}
+		updates[len(updates)-1].DataChanges = append(updates[len(updates)-1].DataChanges, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
@@ -2,46 +2,35 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
+	api "github.com/Permify/permify/pkg/storage"
 	"github.com/Permify/permify/pkg/token"
 )
 
 // DataReader - Interface for reading Data from the storage.
-type DataReader interface {
-	// QueryRelationships reads relation tuples from the storage based on the given filter.
-	// It returns an iterator to iterate over the tuples and any error encountered.
-	QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (iterator *database.TupleIterator, err error)
+type DataReader = api.DataReader
 
-	// ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
-	// It returns a collection of tuples, a continuous token indicating the position in the data set, and any error encountered.
-	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
+// EntityLookup - Optional interface of the data readers evaluating the lookup plans of entities in the storage.
+type EntityLookup = api.EntityLookup
 
-	// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
-	// It returns the retrieved attribute and any error encountered.
-	QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error)
-
-	// QueryAttributes reads multiple attributes from the storage based on the given filter.
-	// It returns an iterator to iterate over the attributes and any error encountered.
-	QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (iterator *database.AttributeIterator, err error)
-
-	// ReadAttributes reads multiple attributes from the storage based on the given filter and pagination.
-	// It returns a collection of attributes, a continuous token indicating the position in the data set, and any error encountered.
-	ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error)
-
-	// QueryUniqueEntities reads unique entities from the storage based on the given filter and pagination.
-	// It returns a slice of entity IDs, a continuous token indicating the position in the data set, and any error encountered.
-	QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
+type (
+	LookupPlan      = api.LookupPlan
+	LookupBranch    = api.LookupBranch
+	LookupOperation = api.LookupOperation
+)
 
-	// QueryUniqueSubjectReferences reads unique subject references from the storage based on the given filter and pagination.
-	// It returns a slice of subject reference IDs, a continuous token indicating the position in the data set, and any error encountered.
-	QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
+const (
+	LookupRelationships = api.LookupRelationships
+	LookupUnion         = api.LookupUnion
+	LookupIntersection  = api.LookupIntersection
+	LookupExclusion     = api.LookupExclusion
+)
 
-	// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant.
-	// It returns the snapshot token representing the version of the snapshot and any error encountered.
-	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
-}
+// ErrLookupUnsupported is returned by the data readers that can't evaluate a lookup plan.
+var ErrLookupUnsupported = api.ErrLookupUnsupported
 
 type NoopDataReader struct{}
 
@@ -77,18 +66,21 @@ func (f *NoopDataReader) QueryUniqueSubjectReferences(_ context.Context, _ strin
 	return []string{}, database.NewNoopContinuousToken().Encode(), nil
 }
 
+func (f *NoopDataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _, _ time.Time, _ database.Pagination) ([]*base.TupleChange, database.EncodedContinuousToken, error) {
+	return []*base.TupleChange{}, database.NewNoopContinuousToken().Encode(), nil
+}
+
 func (f *NoopDataReader) HeadSnapshot(_ context.Context, _ string) (token.SnapToken, error) {
 	return token.NewNoopToken(), nil
 }
 
-// DataWriter - Writes relation tuples to the storage.
-type DataWriter interface {
-	// Write writes relation tuples to the storage.
-	Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error)
-	// Delete deletes relation tuples from the storage.
-	Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error)
+func (f *NoopDataReader) SnapshotAt(_ context.Context, _ string, _ time.Time) (token.SnapToken, error) {
+	return token.NewNoopToken(), nil
 }
 
+// DataWriter - Writes relation tuples to the storage.
+type DataWriter = api.DataWriter
+
 type NoopDataWriter struct{}
 
 func NewNoopDataWriter() DataWriter {
@@ -103,18 +95,17 @@ func (n *NoopDataWriter) Delete(_ context.Context, _ string, _ *base.TupleFilter
 	return token.NewNoopToken().Encode(), nil
 }
 
-// SchemaReader - Reads schema definitions from the storage.
-type SchemaReader interface {
-	// ReadSchema reads entity config from the storage.
-	ReadSchema(ctx context.Context, tenantID, version string) (schema *base.SchemaDefinition, err error)
-	// ReadEntityDefinition reads entity config from the storage.
-	ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error)
-	// ReadRuleDefinition reads rule config from the storage.
-	ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (definition *base.RuleDefinition, v string, err error)
-	// HeadVersion reads the latest version of the schema from the storage.
-	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
+func (n *NoopDataWriter) RunTransaction(_ context.Context, _ string, _ *database.Transaction) (token.EncodedSnapToken, error) {
+	return token.NewNoopToken().Encode(), nil
 }
 
+func (n *NoopDataWriter) MigrateSchema(_ context.Context, _ string, _ []SchemaDefinition, _ *database.Transaction) (token.EncodedSnapToken, error) {
+	return token.NewNoopToken().Encode(), nil
+}
+
+// SchemaReader - Reads schema definitions from the storage.
+type SchemaReader = api.SchemaReader
+
 type NoopSchemaReader struct{}
 
 func NewNoopSchemaReader() SchemaReader {
@@ -137,12 +128,17 @@ func (n *NoopSchemaReader) HeadVersion(_ context.Context, _ string) (string, err
 	return "", nil
 }
 
-// SchemaWriter - Writes schema definitions to the storage.
-type SchemaWriter interface {
-	// WriteSchema writes schema to the storage.
-	WriteSchema(ctx context.Context, definitions []SchemaDefinition) (err error)
+func (n *NoopSchemaReader) ReadSchemaDefinitions(_ context.Context, _, _ string) ([]SchemaDefinition, error) {
+	return []SchemaDefinition{}, nil
 }
 
+func (n *NoopSchemaReader) ReadSchemaVersions(_ context.Context, _ string) ([]string, error) {
+	return []string{}, nil
+}
+
+// SchemaWriter - Writes schema definitions to the storage.
+type SchemaWriter = api.SchemaWriter
+
 type NoopSchemaWriter struct{}
 
 func NewNoopSchemaWriter() SchemaWriter {
@@ -154,10 +150,7 @@ func (n *NoopSchemaWriter) WriteSchema(_ context.Context, _ []SchemaDefinition)
 }
 
 // Watcher - Watches relation tuple changes from the storage.
-type Watcher interface {
-	// Watch watches relation tuple changes from the storage.
-	Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error)
-}
+type Watcher = api.Watcher
 
 type NoopWatcher struct{}
 
@@ -178,10 +171,7 @@ func (n *NoopWatcher) Watch(_ context.Context, _, _ string) (<-chan *base.DataCh
 }
 
 // TenantReader - Reads tenants from the storage.
-type TenantReader interface {
-	// ListTenants reads tenants from the storage.
-	ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
-}
+type TenantReader = api.TenantReader
 
 type NoopTenantReader struct{}
 
@@ -194,12 +184,7 @@ func (n *NoopTenantReader) ListTenants(_ context.Context, _ database.Pagination)
 }
 
 // TenantWriter - Writes tenants to the storage.
-type TenantWriter interface {
-	// CreateTenant writes tenant to the storage.
-	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
-	// DeleteTenant deletes tenant from the storage.
-	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
-}
+type TenantWriter = api.TenantWriter
 
 type NoopTenantWriter struct{}
 
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/Permify/permify/internal/schema"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// compiledVersionsSize is the number of schema versions a schema reader keeps compiled.
+const compiledVersionsSize = 256
+
+// CompiledVersions - Schema versions compiled by a schema reader. An entity is compiled with the other definitions
+// of its version, which synthesize the permissions walking relations of theirs on it, so the schema readers compile
+// a version once and read the definitions of its entities from it. Versions never change once written, so they are
+// kept until the least recently read ones are evicted.
+type CompiledVersions struct {
+	schemas *lru.Cache
+}
+
+// NewCompiledVersions - Creates new compiled versions
+func NewCompiledVersions() *CompiledVersions {
+	schemas, err := lru.New(compiledVersionsSize)
+	if err != nil {
+		panic(err)
+	}
+	return &CompiledVersions{schemas: schemas}
+}
+
+// Entity - Returns the definition of the entity in the version of the schema of the tenant, compiling the version
+// read with read unless it is compiled already
+func (c *CompiledVersions) Entity(tenantID, version, name string, read func() (*base.SchemaDefinition, error)) (*base.EntityDefinition, error) {
+	key := tenantID + "|" + version
+	sch, ok := c.schemas.Get(key)
+	if !ok {
+		compiled, err := read()
+		if err != nil {
+			return nil, err
+		}
+		c.schemas.Add(key, compiled)
+		sch = compiled
+	}
+	return schema.GetEntityByName(sch.(*base.SchemaDefinition), name)
+}
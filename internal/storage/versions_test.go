@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Permify/permify/internal/schema"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestCompiledVersions(t *testing.T) {
+	reads := 0
+	read := func() (*base.SchemaDefinition, error) {
+		reads++
+		return schema.NewSchemaFromStringDefinitions(true, "entity user {}", "entity doc {\n\trelation owner @user\n}")
+	}
+
+	versions := NewCompiledVersions()
+	doc, err := versions.Entity("t1", "v1", "doc", read)
+	require.NoError(t, err)
+	assert.Contains(t, doc.GetRelations(), "owner")
+
+	// The entities of a version compiled already are read from it
+	_, err = versions.Entity("t1", "v1", "user", read)
+	require.NoError(t, err)
+	_, err = versions.Entity("t1", "v1", "missing", read)
+	assert.Error(t, err)
+	assert.Equal(t, 1, reads)
+
+	// while the other versions, and the versions of the other tenants, are compiled on their own
+	_, err = versions.Entity("t1", "v2", "doc", read)
+	require.NoError(t, err)
+	_, err = versions.Entity("t2", "v1", "doc", read)
+	require.NoError(t, err)
+	assert.Equal(t, 3, reads)
+}
@@ -0,0 +1,31 @@
+package supervisor
+
+import (
+	"context"
+)
+
+// Supervisor - Process supervisor notified of the state of the server, such as systemd or the Windows service
+// manager
+type Supervisor interface {
+	// Ready notifies the supervisor that the server is serving.
+	Ready() error
+	// Stopping notifies the supervisor that the server is stopping.
+	Stopping() error
+	// Watchdog notifies the supervisor that the server is alive while healthy returns true, for the supervisor to
+	// restart it otherwise, until the context is done.
+	Watchdog(ctx context.Context, healthy func() bool) error
+}
+
+// contextKey - Key of the supervisor in the context
+type contextKey struct{}
+
+// NewContext - Returns a copy of the context carrying the supervisor of the process, which runs the server
+func NewContext(ctx context.Context, s Supervisor) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext - Returns the supervisor the context carries, if any
+func FromContext(ctx context.Context) (Supervisor, bool) {
+	s, ok := ctx.Value(contextKey{}).(Supervisor)
+	return s, ok
+}
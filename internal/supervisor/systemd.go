@@ -0,0 +1,83 @@
+package supervisor
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Systemd - Supervisor notifying systemd of the state of the server through the socket of NOTIFY_SOCKET, for the
+// services of Type=notify, and pinging its watchdog if WatchdogSec is set
+type Systemd struct {
+	socket string
+}
+
+// NewSystemd - Returns the supervisor of the process if systemd runs it with a notification socket
+func NewSystemd() (*Systemd, bool) {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil, false
+	}
+	return &Systemd{socket: socket}, true
+}
+
+// Ready notifies systemd that the server is serving.
+func (s *Systemd) Ready() error {
+	return s.notify("READY=1\nSTATUS=serving")
+}
+
+// Stopping notifies systemd that the server is stopping.
+func (s *Systemd) Stopping() error {
+	return s.notify("STOPPING=1\nSTATUS=stopping")
+}
+
+// Watchdog pings the watchdog of systemd at half of its interval while the server is healthy, until the context is
+// done. It returns at once if the watchdog is not enabled for the process.
+func (s *Systemd) Watchdog(ctx context.Context, healthy func() bool) error {
+	interval, ok := watchdogInterval()
+	if !ok {
+		return nil
+	}
+
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if !healthy() {
+				continue
+			}
+			if err := s.notify("WATCHDOG=1"); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// notify sends the state to the socket of systemd. Sockets in the abstract namespace start with @.
+func (s *Systemd) notify(state string) error {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: s.socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// watchdogInterval returns the interval of the watchdog of systemd, if it is enabled for the process.
+func watchdogInterval() (time.Duration, bool) {
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0, false
+	}
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, false
+	}
+	return time.Duration(usec) * time.Microsecond, true
+}
@@ -0,0 +1,69 @@
+package supervisor
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemd(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+	_, ok := NewSystemd()
+	assert.False(t, ok)
+
+	socket := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	received := func() string {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		buf := make([]byte, 256)
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		return string(buf[:n])
+	}
+
+	t.Setenv("NOTIFY_SOCKET", socket)
+	systemd, ok := NewSystemd()
+	require.True(t, ok)
+
+	require.NoError(t, systemd.Ready())
+	assert.Equal(t, "READY=1\nSTATUS=serving", received())
+
+	// The watchdog is only pinged while the server is healthy
+	t.Setenv("WATCHDOG_USEC", "20000")
+	var healthy atomic.Bool
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- systemd.Watchdog(ctx, healthy.Load)
+	}()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+	_, err = conn.Read(make([]byte, 256))
+	assert.Error(t, err)
+
+	healthy.Store(true)
+	assert.Equal(t, "WATCHDOG=1", received())
+	cancel()
+	assert.NoError(t, <-done)
+
+	require.NoError(t, systemd.Stopping())
+	for {
+		if state := received(); state != "WATCHDOG=1" {
+			assert.Equal(t, "STOPPING=1\nSTATUS=stopping", state)
+			break
+		}
+	}
+
+	// The watchdog of another process is not pinged
+	t.Setenv("WATCHDOG_PID", "1")
+	assert.NoError(t, systemd.Watchdog(context.Background(), healthy.Load))
+}
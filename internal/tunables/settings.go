@@ -0,0 +1,99 @@
+package tunables
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateSetter - Rate limiter whose rate can be changed at runtime
+type RateSetter interface {
+	Rate() int64
+	SetRate(reqPerSec int64) error
+}
+
+// TTLSetter - Cache whose time to live can be changed at runtime
+type TTLSetter interface {
+	TTL() time.Duration
+	SetTTL(ttl time.Duration) error
+}
+
+// RatioSetter - Sampler whose ratio can be changed at runtime
+type RatioSetter interface {
+	Ratio() float64
+	SetRatio(ratio float64) error
+}
+
+// LogLevel - Tunable of the level of the logs
+func LogLevel(level *slog.LevelVar) Tunable {
+	return Tunable{
+		Name:        "log.level",
+		Description: "Minimum level of the logs: debug, info, warn or error.",
+		Get: func() string {
+			return strings.ToLower(level.Level().String())
+		},
+		Set: func(value string) error {
+			var l slog.Level
+			if err := l.UnmarshalText([]byte(value)); err != nil {
+				return err
+			}
+			level.Set(l)
+			return nil
+		},
+	}
+}
+
+// SamplingRatio - Tunable of the ratio of the traces sampled
+func SamplingRatio(sampler RatioSetter) Tunable {
+	return Tunable{
+		Name:        "tracer.sampling_ratio",
+		Description: "Ratio of the traces sampled, between 0 and 1.",
+		Get: func() string {
+			return strconv.FormatFloat(sampler.Ratio(), 'g', -1, 64)
+		},
+		Set: func(value string) error {
+			ratio, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			return sampler.SetRatio(ratio)
+		},
+	}
+}
+
+// RateLimit - Tunable of the requests per second the server allows
+func RateLimit(limiter RateSetter) Tunable {
+	return Tunable{
+		Name:        "server.rate_limit",
+		Description: "Tokens per second taken by the requests to the server, by the weights of their methods.",
+		Get: func() string {
+			return strconv.FormatInt(limiter.Rate(), 10)
+		},
+		Set: func(value string) error {
+			rate, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			return limiter.SetRate(rate)
+		},
+	}
+}
+
+// IdempotencyTTL - Tunable of how long the responses of the requests carrying an idempotency key are remembered
+func IdempotencyTTL(idempotency TTLSetter) Tunable {
+	return Tunable{
+		Name:        "service.idempotency.ttl",
+		Description: "How long the responses of the requests carrying an idempotency key are kept for their retries, e.g. 30m.",
+		Get: func() string {
+			return idempotency.TTL().String()
+		},
+		Set: func(value string) error {
+			ttl, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			return idempotency.SetTTL(ttl)
+		},
+	}
+}
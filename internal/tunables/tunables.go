@@ -0,0 +1,136 @@
+package tunables
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Permify/permify/pkg/clock"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// maxChanges is the number of the recent changes of the tunables kept for audit.
+const maxChanges = 100
+
+// Tunable - Setting of the server that can be changed at runtime, without restarting it
+type Tunable struct {
+	// Name is the name of the tunable, the key of the setting in the configuration
+	Name string
+	// Description is what the tunable sets and the values it accepts
+	Description string
+	// Get returns the current value of the tunable
+	Get func() string
+	// Set parses the value and applies it, returning an error without applying it if it is not valid
+	Set func(value string) error
+}
+
+// Change - Change of a tunable, kept for audit
+type Change struct {
+	Time time.Time
+	// Actor is the authenticated caller who changed the tunable, empty when authentication is disabled
+	Actor    string
+	Name     string
+	Previous string
+	Value    string
+}
+
+// Registry - Tunables of the server, along with the recent changes of them. The changes are logged with the actor
+// who made them as warnings, so that they are kept unless the logs are turned down to errors only.
+type Registry struct {
+	clock clock.Clock
+
+	mu       sync.Mutex
+	tunables map[string]Tunable
+	// changes are the recent changes, from the oldest to the newest
+	changes []Change
+}
+
+// NewRegistry - Creates a new registry of the tunables
+func NewRegistry(tunables ...Tunable) *Registry {
+	r := &Registry{
+		clock:    clock.New(),
+		tunables: map[string]Tunable{},
+	}
+	for _, t := range tunables {
+		r.Register(t)
+	}
+	return r
+}
+
+// Register - Adds the tunable to the registry, replacing the one of the same name
+func (r *Registry) Register(t Tunable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tunables[t.Name] = t
+}
+
+// Tunables - Returns the tunables, ordered by name
+func (r *Registry) Tunables() []Tunable {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tunables := make([]Tunable, 0, len(r.tunables))
+	for _, t := range r.tunables {
+		tunables = append(tunables, t)
+	}
+	sort.Slice(tunables, func(i, j int) bool {
+		return tunables[i].Name < tunables[j].Name
+	})
+	return tunables
+}
+
+// Changes - Returns the recent changes of the tunables, from the oldest to the newest
+func (r *Registry) Changes() []Change {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Change{}, r.changes...)
+}
+
+// Update - Sets the tunables to the values, by name, on behalf of the actor, and returns the changes made, ordered by
+// name. Either all the values are applied, or none of them: the tunables set before a value fails are set back.
+func (r *Registry) Update(ctx context.Context, actor string, values map[string]string) ([]Change, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if _, ok := r.tunables[name]; !ok {
+			return nil, fmt.Errorf("%s: unknown tunable %q", base.ErrorCode_ERROR_CODE_VALIDATION.String(), name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := r.clock.Now()
+	changes := make([]Change, 0, len(names))
+	for _, name := range names {
+		t := r.tunables[name]
+		previous := t.Get()
+		if err := t.Set(values[name]); err != nil {
+			for i := len(changes) - 1; i >= 0; i-- {
+				_ = r.tunables[changes[i].Name].Set(changes[i].Previous)
+			}
+			return nil, fmt.Errorf("%s: invalid value of tunable %q: %v", base.ErrorCode_ERROR_CODE_VALIDATION.String(), name, err)
+		}
+		if value := t.Get(); value != previous {
+			changes = append(changes, Change{Time: now, Actor: actor, Name: name, Previous: previous, Value: value})
+		}
+	}
+
+	for _, c := range changes {
+		slog.WarnContext(ctx, "⚙️ tunable changed at runtime",
+			slog.String("actor", c.Actor),
+			slog.String("name", c.Name),
+			slog.String("previous", c.Previous),
+			slog.String("value", c.Value),
+		)
+	}
+	r.changes = append(r.changes, changes...)
+	if len(r.changes) > maxChanges {
+		r.changes = append([]Change{}, r.changes[len(r.changes)-maxChanges:]...)
+	}
+	return changes, nil
+}
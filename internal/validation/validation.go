@@ -3,6 +3,10 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/pkg/attribute"
@@ -18,6 +22,11 @@ func ValidateTuple(definition *base.EntityDefinition, tup *base.Tuple) (err erro
 		return errors.New(base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL.String())
 	}
 
+	// A wildcard subject stands for every subject of its type, so it cannot be a set of subjects
+	if tup.GetSubject().GetId() == tuple.WILDCARD && tup.GetSubject().GetRelation() != "" {
+		return errors.New(base.ErrorCode_ERROR_CODE_SUBJECT_RELATION_MUST_BE_EMPTY.String())
+	}
+
 	// Initialize variables for the relation definition and valid types
 	var rel *base.RelationDefinition
 	var vt []string
@@ -47,6 +56,90 @@ func ValidateTuple(definition *base.EntityDefinition, tup *base.Tuple) (err erro
 	return nil
 }
 
+// ValidateCardinality checks if the number of relationships the entity has through each relation of its entity
+// definition is within the cardinality of the relation. 'counts' holds the number of relationships of the entity by
+// relation name, with the ones being written included. It returns an error naming the first relation out of bounds.
+func ValidateCardinality(definition *base.EntityDefinition, entity *base.Entity, counts map[string]int) (err error) {
+	// Iterate over the relations in order, so that the error is the same for the same data
+	names := make([]string, 0, len(definition.GetRelations()))
+	for name := range definition.GetRelations() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cardinality := definition.GetRelations()[name].GetCardinality()
+		if cardinality == nil {
+			continue
+		}
+
+		count := counts[name]
+		if count >= int(cardinality.GetMin()) && (cardinality.GetMax() == 0 || count <= int(cardinality.GetMax())) {
+			continue
+		}
+
+		var expected string
+		switch {
+		case cardinality.GetMin() == cardinality.GetMax():
+			expected = fmt.Sprintf("exactly %d", cardinality.GetMin())
+		case cardinality.GetMax() == 0:
+			expected = fmt.Sprintf("at least %d", cardinality.GetMin())
+		case cardinality.GetMin() == 0:
+			expected = fmt.Sprintf("at most %d", cardinality.GetMax())
+		default:
+			expected = fmt.Sprintf("at least %d and at most %d", cardinality.GetMin(), cardinality.GetMax())
+		}
+
+		return fmt.Errorf("%s: %s has %d relationships, the relation allows %s",
+			base.ErrorCode_ERROR_CODE_RELATION_CARDINALITY.String(), tuple.EntityAndRelationToString(entity, name), count, expected)
+	}
+
+	// If every relation is within its cardinality, return nil
+	return nil
+}
+
+// uuidPattern matches UUIDs in their canonical form, in either case
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// patterns caches the compiled id format patterns of the schemas by their source
+var patterns sync.Map
+
+// ValidateID checks if the id conforms to the id format of the entity definition. Any id is valid when the
+// definition has no id format. It returns an error naming the id and the expected format if it is invalid.
+func ValidateID(definition *base.EntityDefinition, id string) (err error) {
+	format := definition.GetIdFormat()
+
+	var valid bool
+	var expected string
+	switch format.GetKind() {
+	case base.IdFormat_KIND_UUID:
+		valid, expected = uuidPattern.MatchString(id), "a uuid"
+	case base.IdFormat_KIND_INTEGER:
+		_, perr := strconv.ParseUint(id, 10, 64)
+		valid, expected = perr == nil, "an integer"
+	case base.IdFormat_KIND_PATTERN:
+		var pattern *regexp.Regexp
+		if cached, ok := patterns.Load(format.GetPattern()); ok {
+			pattern = cached.(*regexp.Regexp)
+		} else {
+			pattern, err = regexp.Compile(format.GetPattern())
+			if err != nil {
+				return err
+			}
+			patterns.Store(format.GetPattern(), pattern)
+		}
+		valid, expected = pattern.MatchString(id), fmt.Sprintf("an id matching %s", format.GetPattern())
+	default:
+		return nil
+	}
+
+	if !valid {
+		return fmt.Errorf("%s: %s is not a valid id of %s, expected %s",
+			base.ErrorCode_ERROR_CODE_INVALID_ID_FORMAT.String(), id, definition.GetName(), expected)
+	}
+	return nil
+}
+
 // ValidateTupleFilter checks if the provided filter conforms to the entity definition
 func ValidateTupleFilter(tupleFilter *base.TupleFilter) (err error) {
 	if IsTupleFilterEmpty(tupleFilter) {
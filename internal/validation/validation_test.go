@@ -515,5 +515,49 @@ var _ = Describe("validation", func() {
 				})
 			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
 		})
+		It("Case 9", func() {
+			definition := &base.EntityDefinition{
+				Name: "document",
+				Relations: map[string]*base.RelationDefinition{
+					"owner": {
+						Name:               "owner",
+						RelationReferences: []*base.RelationReference{{Type: "user"}},
+						Cardinality:        &base.RelationCardinality{Min: 1, Max: 1},
+					},
+					"viewer": {
+						Name:               "viewer",
+						RelationReferences: []*base.RelationReference{{Type: "user"}},
+					},
+				},
+			}
+			entity := &base.Entity{Type: "document", Id: "1"}
+
+			Expect(ValidateCardinality(definition, entity, map[string]int{"owner": 1, "viewer": 5})).Should(Succeed())
+
+			err := ValidateCardinality(definition, entity, map[string]int{"owner": 2})
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_RELATION_CARDINALITY.String() +
+				": document:1#owner has 2 relationships, the relation allows exactly 1"))
+
+			err = ValidateCardinality(definition, entity, map[string]int{"viewer": 1})
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_RELATION_CARDINALITY.String() +
+				": document:1#owner has 0 relationships, the relation allows exactly 1"))
+		})
+		It("Case 10", func() {
+			Expect(ValidateID(&base.EntityDefinition{Name: "user"}, "anything")).Should(Succeed())
+
+			uuid := &base.EntityDefinition{Name: "user", IdFormat: &base.IdFormat{Kind: base.IdFormat_KIND_UUID}}
+			Expect(ValidateID(uuid, "0b6a4c3e-5f1d-4a8b-9c2e-7d3f1a6b8c90")).Should(Succeed())
+			Expect(ValidateID(uuid, "1").Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ID_FORMAT.String() +
+				": 1 is not a valid id of user, expected a uuid"))
+
+			integer := &base.EntityDefinition{Name: "order", IdFormat: &base.IdFormat{Kind: base.IdFormat_KIND_INTEGER}}
+			Expect(ValidateID(integer, "42")).Should(Succeed())
+			Expect(ValidateID(integer, "o-42")).Should(HaveOccurred())
+
+			pattern := &base.EntityDefinition{Name: "repository", IdFormat: &base.IdFormat{Kind: base.IdFormat_KIND_PATTERN, Pattern: "^[a-z]+$"}}
+			Expect(ValidateID(pattern, "permify")).Should(Succeed())
+			Expect(ValidateID(pattern, "Permify").Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ID_FORMAT.String() +
+				": Permify is not a valid id of repository, expected an id matching ^[a-z]+$"))
+		})
 	})
 })
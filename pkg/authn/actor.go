@@ -0,0 +1,22 @@
+package authn
+
+import (
+	"context"
+)
+
+// actorKey is the context key under which the authenticated caller is stored.
+type actorKey struct{}
+
+// ContextWithActor returns a copy of ctx carrying the given actor.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored in ctx, or an empty string
+// when the request was not authenticated.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+// Package authn defines the interface of authentication providers and a registry through which custom providers
+// are compiled into Permify and selected with the authn.method setting.
+package authn
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// Provider - Authenticates the requests of the API
+type Provider interface {
+	// Authenticate verifies the credentials of the request in ctx, which carries the incoming gRPC metadata, and
+	// returns the context to serve the request with. Providers attach the authenticated caller with
+	// ContextWithActor. Rejected requests return an error, usually a gRPC status with the Unauthenticated code.
+	Authenticate(ctx context.Context) (context.Context, error)
+}
+
+// Factory - Creates a provider. The settings are the authn.<method> section of the configuration.
+type Factory func(ctx context.Context, settings map[string]interface{}) (Provider, error)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Factory{}
+)
+
+// builtin holds the methods shipped with Permify, which cannot be replaced.
+var builtin = map[string]bool{
+	"preshared": true,
+	"oidc":      true,
+	"spiffe":    true,
+}
+
+// Register makes an authentication provider available under the given method, which is then selected with
+// authn.method: <method>. It is meant to be called from an init function. Register panics if the method is
+// empty, taken by a built-in or already registered provider, or if the factory is nil.
+func Register(method string, factory Factory) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	if method == "" {
+		panic("authn: Register method is empty")
+	}
+	if factory == nil {
+		panic(fmt.Sprintf("authn: Register factory for method %s is nil", method))
+	}
+	if builtin[method] {
+		panic(fmt.Sprintf("authn: Register called for built-in method %s", method))
+	}
+	if _, dup := providers[method]; dup {
+		panic(fmt.Sprintf("authn: Register called twice for method %s", method))
+	}
+
+	providers[method] = factory
+}
+
+// Lookup returns the factory registered under the given method.
+func Lookup(method string) (Factory, bool) {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+	factory, ok := providers[method]
+	return factory, ok
+}
+
+// Methods returns the sorted names of the registered methods.
+func Methods() []string {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+	methods := make([]string, 0, len(providers))
+	for method := range providers {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// unregister removes the provider registered under the given method. It exists for tests.
+func unregister(method string) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	delete(providers, method)
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor that authenticates every request with the provider.
+func UnaryServerInterceptor(p Provider) grpc.UnaryServerInterceptor {
+	return grpcAuth.UnaryServerInterceptor(p.Authenticate)
+}
+
+// StreamServerInterceptor returns a gRPC stream server interceptor that authenticates every stream with the provider
+// when it is opened.
+func StreamServerInterceptor(p Provider) grpc.StreamServerInterceptor {
+	return grpcAuth.StreamServerInterceptor(p.Authenticate)
+}
@@ -0,0 +1,76 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type testProvider struct {
+	err error
+}
+
+func (p testProvider) Authenticate(ctx context.Context) (context.Context, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return ContextWithActor(ctx, "user:1"), nil
+}
+
+func testFactory(_ context.Context, settings map[string]interface{}) (Provider, error) {
+	if settings["reject"] == true {
+		return testProvider{err: errors.New("rejected")}, nil
+	}
+	return testProvider{}, nil
+}
+
+func TestRegister(t *testing.T) {
+	defer unregister("custom")
+
+	_, ok := Lookup("custom")
+	assert.False(t, ok)
+
+	Register("custom", testFactory)
+
+	factory, ok := Lookup("custom")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"custom"}, Methods())
+
+	p, err := factory(context.Background(), nil)
+	assert.NoError(t, err)
+	ctx, err := p.Authenticate(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "user:1", ActorFromContext(ctx))
+
+	assert.Panics(t, func() { Register("custom", testFactory) })
+}
+
+func TestRegisterRejectsInvalidProviders(t *testing.T) {
+	assert.Panics(t, func() { Register("", testFactory) })
+	assert.Panics(t, func() { Register("oidc", testFactory) })
+	assert.Panics(t, func() { Register("nil", nil) })
+
+	_, ok := Lookup("nil")
+	assert.False(t, ok)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var actor string
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		actor = ActorFromContext(ctx)
+		return "ok", nil
+	}
+
+	resp, err := UnaryServerInterceptor(testProvider{})(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.Equal(t, "user:1", actor)
+
+	actor = ""
+	_, err = UnaryServerInterceptor(testProvider{err: errors.New("rejected")})(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.Error(t, err)
+	assert.Equal(t, "", actor)
+}
@@ -1,19 +1,25 @@
 package balancer
 
 import (
+	"encoding/json"
 	"sync"
 
 	"google.golang.org/grpc/balancer"
 	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
 )
 
-// NewConsistentHashBalancerBuilder returns a consistentHashBalancerBuilder.
-func NewConsistentHashBalancerBuilder() balancer.Builder {
-	return &consistentHashBalancerBuilder{}
+// NewConsistentHashBalancerBuilder returns a consistentHashBalancerBuilder. The observers receive the state of the
+// hash rings of the balancers it builds whenever their nodes change, and the dispatches to the nodes if they implement
+// DispatchObserver.
+func NewConsistentHashBalancerBuilder(observers ...Observer) balancer.Builder {
+	return &consistentHashBalancerBuilder{observers: observers}
 }
 
-// consistentHashBalancerBuilder is an empty struct with functions Build and Name, implemented from balancer.Builder
-type consistentHashBalancerBuilder struct{}
+// consistentHashBalancerBuilder implements balancer.Builder and balancer.ConfigParser
+type consistentHashBalancerBuilder struct {
+	observers []Observer
+}
 
 // Build creates a consistentHashBalancer, and starts its scManager.
 func (builder *consistentHashBalancerBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
@@ -26,6 +32,9 @@ func (builder *consistentHashBalancerBuilder) Build(cc balancer.ClientConn, opts
 		activePickResults:   NewQueue(),
 		subConnPickCounts:   make(map[balancer.SubConn]*int32),
 		subConnStatusMap:    make(map[balancer.SubConn]bool),
+		config:              defaultConfig(),
+		observers:           builder.observers,
+		dispatches:          newDispatches(builder.observers),
 	}
 	go b.manageSubConnections()
 	return b
@@ -35,3 +44,8 @@ func (builder *consistentHashBalancerBuilder) Build(cc balancer.ClientConn, opts
 func (builder *consistentHashBalancerBuilder) Name() string {
 	return Policy
 }
+
+// ParseConfig parses the load balancing config of the service config.
+func (builder *consistentHashBalancerBuilder) ParseConfig(js json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	return parseConfig(js)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 
@@ -70,6 +71,21 @@ type consistentHashBalancer struct {
 
 	// balancerLock is a mutex used to ensure thread safety, especially when accessing the subConnPickCounts map.
 	balancerLock sync.Mutex
+
+	// config is the configuration of the hash ring.
+	config *Config
+
+	// observers receive the state of the hash ring whenever its nodes change.
+	observers []Observer
+
+	// ringNodes, ringState and ringOwners are the nodes of the current hash ring, its state and the owners of the
+	// sampled keys, to which the next ring is compared.
+	ringNodes  string
+	ringState  RingState
+	ringOwners []string
+
+	// dispatches tracks the dispatches of the pickers to the nodes, ejecting the ones failing consecutive dispatches.
+	dispatches *dispatches
 }
 
 // UpdateClientConnState processes the provided ClientConnState and updates
@@ -78,6 +94,12 @@ func (b *consistentHashBalancer) UpdateClientConnState(s balancer.ClientConnStat
 	b.balancerLock.Lock() // Ensure exclusive access to balancers data.
 	defer b.balancerLock.Unlock()
 
+	// Use the hash ring configuration of the service config, if it has one.
+	if cfg, ok := s.BalancerConfig.(*Config); ok {
+		b.config = cfg
+	}
+	b.dispatches.configure(b.config.OutlierDetection)
+
 	// Update address information and get a set of active addresses.
 	addrsSet := b.updateAddressInfo(s)
 
@@ -124,7 +146,9 @@ func (b *consistentHashBalancer) updateAddressInfo(s balancer.ClientConnState) m
 
 // createNewSubConn creates a new sub-connection for the provided address.
 func (b *consistentHashBalancer) createNewSubConn(a resolver.Address, addr string) error {
-	newSC, err := b.clientConn.NewSubConn([]resolver.Address{a}, balancer.NewSubConnOptions{HealthCheckEnabled: false})
+	// The sub-connection is health checked when the service config has a health check config, an unhealthy node
+	// leaving the ring until it reports serving again.
+	newSC, err := b.clientConn.NewSubConn([]resolver.Address{a}, balancer.NewSubConnOptions{HealthCheckEnabled: true})
 	if err != nil {
 		return err
 	}
@@ -193,8 +217,37 @@ func (b *consistentHashBalancer) regeneratePicker() {
 		b.currentPicker = base.NewErrPicker(b.mergeErrors())
 	} else {
 		b.connectionState = connectivity.Ready
-		b.currentPicker = NewConsistentHashPicker(availableSCs)
+		b.currentPicker = b.newPicker(availableSCs)
+	}
+}
+
+// newPicker creates the picker of the available sub-connections, reporting the hash ring to the observers when its
+// nodes changed.
+func (b *consistentHashBalancer) newPicker(availableSCs map[string]balancer.SubConn) balancer.Picker {
+	addrs := make([]string, 0, len(availableSCs))
+	for addr := range availableSCs {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	ring, err := newRing(addrs, b.config)
+	if err != nil {
+		// The config is validated when it is parsed, so this only happens with a config set by hand.
+		return base.NewErrPicker(err)
 	}
+
+	nodes := fmt.Sprintf("%s|%d|%v", b.config.Hash, b.config.VirtualNodes, addrs)
+	if len(b.observers) > 0 && nodes != b.ringNodes {
+		owners := sampleOwners(ring)
+		state := ringState(addrs, b.config, owners)
+		m := movement(b.ringState, state, b.ringOwners, owners)
+		for _, observer := range b.observers {
+			observer.RingChanged(state, m)
+		}
+		b.ringNodes, b.ringState, b.ringOwners = nodes, state, owners
+	}
+
+	return newConsistentHashPicker(availableSCs, ring, b.dispatches)
 }
 
 // mergeErrors -
@@ -324,7 +377,7 @@ func (b *consistentHashBalancer) resetSubConnWithAddr(address string) error {
 	}
 
 	// Create a new SubConn with the address information.
-	newSubConn, err := b.clientConn.NewSubConn([]resolver.Address{addressInfo}, balancer.NewSubConnOptions{HealthCheckEnabled: false})
+	newSubConn, err := b.clientConn.NewSubConn([]resolver.Address{addressInfo}, balancer.NewSubConnOptions{HealthCheckEnabled: true})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,167 @@
+package balancer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultConsecutiveFailures is the number of consecutive failed dispatches ejecting a node when the outlier
+	// detection config doesn't set it.
+	DefaultConsecutiveFailures = 5
+
+	// DefaultEjectionTime is how long a node stays ejected when the outlier detection config doesn't set it.
+	DefaultEjectionTime = 30 * time.Second
+)
+
+// OutlierDetection - Configuration of the ejection of the nodes failing consecutive dispatches. The keys of an
+// ejected node are dispatched to the next nodes of the ring until the ejection time passes, without changing the
+// ring, so that they return to it once it does.
+type OutlierDetection struct {
+	// ConsecutiveFailures is the number of consecutive failed dispatches ejecting a node.
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+	// EjectionTime is how long a node stays ejected, as a duration string, e.g. "30s".
+	EjectionTime string `json:"ejectionTime,omitempty"`
+
+	ejectionTime time.Duration
+}
+
+// parse validates the outlier detection config, filling the fields it doesn't set with the defaults.
+func (o *OutlierDetection) parse() error {
+	if o.ConsecutiveFailures == 0 {
+		o.ConsecutiveFailures = DefaultConsecutiveFailures
+	}
+	if o.ConsecutiveFailures < 0 {
+		return fmt.Errorf("consistent hash balancer: consecutive failures must be positive, got %d", o.ConsecutiveFailures)
+	}
+	o.ejectionTime = DefaultEjectionTime
+	if o.EjectionTime != "" {
+		d, err := time.ParseDuration(o.EjectionTime)
+		if err != nil {
+			return fmt.Errorf("consistent hash balancer: invalid ejection time: %w", err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("consistent hash balancer: ejection time must be positive, got %s", o.EjectionTime)
+		}
+		o.ejectionTime = d
+	}
+	return nil
+}
+
+// DispatchObserver - Receives the latency of each dispatch to the nodes of a balancer and whether the node failed it,
+// and the nodes ejected for failing consecutive dispatches. The observers given to the builder that implement it receive them.
+type DispatchObserver interface {
+	Dispatched(address string, latency time.Duration, failed bool)
+	Ejected(address string, until time.Time)
+}
+
+// dispatches tracks the outcome of the dispatches to the nodes, reporting them to the observers and ejecting the
+// nodes failing consecutive dispatches when outlier detection is configured.
+type dispatches struct {
+	mu        sync.Mutex
+	config    *OutlierDetection
+	failures  map[string]int
+	ejections map[string]time.Time
+	observers []DispatchObserver
+	now       func() time.Time
+}
+
+// newDispatches creates the tracker of the dispatches, reporting them to the observers implementing DispatchObserver.
+func newDispatches(observers []Observer) *dispatches {
+	d := &dispatches{
+		failures:  map[string]int{},
+		ejections: map[string]time.Time{},
+		now:       time.Now,
+	}
+	for _, observer := range observers {
+		if o, ok := observer.(DispatchObserver); ok {
+			d.observers = append(d.observers, o)
+		}
+	}
+	return d
+}
+
+// configure replaces the outlier detection config, nil disabling the ejections and returning the ejected nodes to
+// the ring.
+func (d *dispatches) configure(config *OutlierDetection) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.config = config
+	if config == nil {
+		d.failures = map[string]int{}
+		d.ejections = map[string]time.Time{}
+	}
+}
+
+// ejected returns whether the node is ejected, returning it to the ring once its ejection time has passed.
+func (d *dispatches) ejected(address string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	until, ok := d.ejections[address]
+	if !ok {
+		return false
+	}
+	if d.now().Before(until) {
+		return true
+	}
+	delete(d.ejections, address)
+	d.failures[address] = 0
+	return false
+}
+
+// done returns the callback of a dispatch to the node, started now.
+func (d *dispatches) done(address string) func(balancer.DoneInfo) {
+	start := d.now()
+	return func(info balancer.DoneInfo) {
+		latency := d.now().Sub(start)
+		failure := failed(info.Err)
+
+		var until time.Time
+		d.mu.Lock()
+		if d.config != nil {
+			if failure {
+				d.failures[address]++
+				if _, ok := d.ejections[address]; !ok && d.failures[address] >= d.config.ConsecutiveFailures {
+					until = d.now().Add(d.config.ejectionTime)
+					d.ejections[address] = until
+				}
+			} else {
+				d.failures[address] = 0
+			}
+		}
+		d.mu.Unlock()
+
+		for _, observer := range d.observers {
+			observer.Dispatched(address, latency, failure)
+			if !until.IsZero() {
+				observer.Ejected(address, until)
+			}
+		}
+	}
+}
+
+// failed returns whether the error of a dispatch is a failure of the node, rather than of the request.
+func failed(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// PeerStats - Dispatches to a node of the ring, as aggregated by a DispatchObserver
+type PeerStats struct {
+	// Dispatches is the number of dispatches to the node, and Failures the ones that failed
+	Dispatches int64
+	Failures   int64
+	// Latency is the moving average of the latency of the dispatches to the node
+	Latency time.Duration
+	// EjectedUntil is when the node returns to the ring, zero unless it is ejected
+	EjectedUntil time.Time
+}
@@ -0,0 +1,94 @@
+package balancer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// namedSubConn - SubConn identified by the address of its node
+type namedSubConn struct {
+	balancer.SubConn
+	addr string
+}
+
+// dispatchRecorder - Observer recording the dispatches and the ejections
+type dispatchRecorder struct {
+	ringRecorder
+	failures  int
+	successes int
+	ejected   []string
+}
+
+func (r *dispatchRecorder) Dispatched(_ string, _ time.Duration, failed bool) {
+	if failed {
+		r.failures++
+	} else {
+		r.successes++
+	}
+}
+
+func (r *dispatchRecorder) Ejected(address string, _ time.Time) {
+	r.ejected = append(r.ejected, address)
+}
+
+var _ = Describe("Outlier detection", func() {
+	It("should parse the ejection time and fill the defaults", func() {
+		cfg, err := parseConfig(json.RawMessage(`{"outlierDetection": {"ejectionTime": "1m"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.OutlierDetection.ConsecutiveFailures).To(Equal(DefaultConsecutiveFailures))
+		Expect(cfg.OutlierDetection.ejectionTime).To(Equal(time.Minute))
+
+		_, err = parseConfig(json.RawMessage(`{"outlierDetection": {"ejectionTime": "soon"}}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should dispatch the keys of an ejected node to the next node until the ejection time passes", func() {
+		recorder := &dispatchRecorder{}
+		now := time.Now()
+		tracked := newDispatches([]Observer{recorder})
+		tracked.now = func() time.Time { return now }
+		tracked.configure(&OutlierDetection{ConsecutiveFailures: 2, ejectionTime: time.Minute})
+
+		subConns := map[string]balancer.SubConn{}
+		for _, addr := range []string{"node-1", "node-2", "node-3"} {
+			subConns[addr] = &namedSubConn{addr: addr}
+		}
+		ring, err := newRing([]string{"node-1", "node-2", "node-3"}, defaultConfig())
+		Expect(err).ToNot(HaveOccurred())
+		picker := newConsistentHashPicker(subConns, ring, tracked)
+
+		info := balancer.PickInfo{Ctx: context.WithValue(context.Background(), Key, "key")}
+		pick := func() (string, balancer.PickResult) {
+			result, err := picker.Pick(info)
+			Expect(err).ToNot(HaveOccurred())
+			return result.SubConn.(*namedSubConn).addr, result
+		}
+
+		owner, result := pick()
+		result.Done(balancer.DoneInfo{})
+		// Errors of the requests are not failures of the node
+		_, result = pick()
+		result.Done(balancer.DoneInfo{Err: status.Error(codes.NotFound, "not found")})
+		for i := 0; i < 2; i++ {
+			_, result = pick()
+			result.Done(balancer.DoneInfo{Err: status.Error(codes.Unavailable, "unavailable")})
+		}
+		Expect(recorder.successes).To(Equal(2))
+		Expect(recorder.failures).To(Equal(2))
+		Expect(recorder.ejected).To(Equal([]string{owner}))
+
+		next, _ := pick()
+		Expect(next).ToNot(Equal(owner))
+
+		now = now.Add(time.Minute)
+		back, _ := pick()
+		Expect(back).To(Equal(owner))
+	})
+})
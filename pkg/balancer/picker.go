@@ -15,6 +15,7 @@ type ConsistentHashPicker struct {
 	subConns map[string]balancer.SubConn // Map of server addresses to their respective SubConns
 	mu       sync.RWMutex                // Mutex to protect concurrent access to subConns
 	hashRing *hashring.HashRing          // Hash ring used for consistent hashing
+	tracked  *dispatches                 // Tracker of the dispatches to the nodes, if the balancer has one
 }
 
 // PickResult represents the result of a pick operation.
@@ -25,7 +26,7 @@ type PickResult struct {
 }
 
 // NewConsistentHashPicker initializes and returns a new ConsistentHashPicker.
-// It creates a hash ring from the provided set of backend server addresses.
+// It creates a hash ring from the provided set of backend server addresses, with the default configuration.
 func NewConsistentHashPicker(subConns map[string]balancer.SubConn) *ConsistentHashPicker {
 	addrs := make([]string, 0, len(subConns))
 
@@ -34,11 +35,22 @@ func NewConsistentHashPicker(subConns map[string]balancer.SubConn) *ConsistentHa
 		addrs = append(addrs, addr)
 	}
 
-	slog.Debug("consistent hash picker built", slog.Any("addresses", addrs))
+	ring, err := newRing(addrs, defaultConfig())
+	if err != nil {
+		panic(err)
+	}
+	return newConsistentHashPicker(subConns, ring, nil)
+}
+
+// newConsistentHashPicker returns a new ConsistentHashPicker picking the sub-connections with the hash ring, skipping
+// the nodes the tracker of the dispatches ejected, if it is not nil.
+func newConsistentHashPicker(subConns map[string]balancer.SubConn, ring *hashring.HashRing, tracked *dispatches) *ConsistentHashPicker {
+	slog.Debug("consistent hash picker built", slog.Int("nodes", ring.Size()))
 
 	return &ConsistentHashPicker{
 		subConns: subConns,
-		hashRing: hashring.New(addrs),
+		hashRing: ring,
+		tracked:  tracked,
 	}
 }
 
@@ -55,7 +67,8 @@ func (p *ConsistentHashPicker) Pick(info balancer.PickInfo) (balancer.PickResult
 
 	// Safely read from the subConns map using the read lock
 	p.mu.RLock()
-	if targetAddr, ok := p.hashRing.GetNode(key); ok {
+	targetAddr, ok := p.target(key)
+	if ok {
 		ret.SubConn = p.subConns[targetAddr]
 	}
 	p.mu.RUnlock()
@@ -64,5 +77,24 @@ func (p *ConsistentHashPicker) Pick(info balancer.PickInfo) (balancer.PickResult
 	if ret.SubConn == nil {
 		return ret, balancer.ErrNoSubConnAvailable
 	}
+	if p.tracked != nil {
+		ret.Done = p.tracked.done(targetAddr)
+	}
 	return ret, nil
 }
+
+// target returns the node owning the key, or the next node of the ring that is not ejected if it is. The owner is
+// returned when every node is ejected.
+func (p *ConsistentHashPicker) target(key string) (string, bool) {
+	owner, ok := p.hashRing.GetNode(key)
+	if !ok || p.tracked == nil || !p.tracked.ejected(owner) {
+		return owner, ok
+	}
+	nodes, _ := p.hashRing.GetNodes(key, p.hashRing.Size())
+	for _, node := range nodes {
+		if !p.tracked.ejected(node) {
+			return node, true
+		}
+	}
+	return owner, true
+}
@@ -0,0 +1,225 @@
+package balancer
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/serialx/hashring"
+	"google.golang.org/grpc/serviceconfig"
+)
+
+const (
+	// DefaultVirtualNodes is the number of virtual nodes of each node of the ring when the config doesn't set it.
+	DefaultVirtualNodes = 100
+
+	// DefaultHash is the hash function of the ring when the config doesn't set it.
+	DefaultHash = "md5"
+
+	// ringSamples is the number of keys sampled to estimate the ownership of the nodes and the keys moved by a change
+	// of the nodes of the ring.
+	ringSamples = 10000
+)
+
+// Hashes are the hash functions the ring can place the nodes and the keys with.
+var Hashes = []string{"md5", "sha256", "xxhash"}
+
+// Config - Configuration of the consistent hash balancer, set through the load balancing config of the service
+// config, e.g. {"loadBalancingConfig": [{"consistenthashpolicy": {"virtualNodes": 100, "hash": "md5",
+// "outlierDetection": {"consecutiveFailures": 5, "ejectionTime": "30s"}}}]}
+type Config struct {
+	serviceconfig.LoadBalancingConfig `json:"-"`
+
+	// VirtualNodes is the number of points of each node on the ring. More points spread the keys more evenly
+	// across the nodes, at the cost of a larger ring.
+	VirtualNodes int `json:"virtualNodes,omitempty"`
+	// Hash is the hash function placing the nodes and the keys on the ring.
+	Hash string `json:"hash,omitempty"`
+	// OutlierDetection ejects the nodes failing consecutive dispatches for a while, disabled if not set.
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+}
+
+// defaultConfig returns the config of the balancers whose service config doesn't set one.
+func defaultConfig() *Config {
+	return &Config{VirtualNodes: DefaultVirtualNodes, Hash: DefaultHash}
+}
+
+// parseConfig parses and validates the load balancing config, filling the fields it doesn't set with the defaults.
+func parseConfig(js json.RawMessage) (*Config, error) {
+	cfg := defaultConfig()
+	if len(js) > 0 {
+		if err := json.Unmarshal(js, cfg); err != nil {
+			return nil, fmt.Errorf("consistent hash balancer: invalid config: %w", err)
+		}
+	}
+	if cfg.VirtualNodes == 0 {
+		cfg.VirtualNodes = DefaultVirtualNodes
+	}
+	if cfg.VirtualNodes < 0 {
+		return nil, fmt.Errorf("consistent hash balancer: virtual nodes must be positive, got %d", cfg.VirtualNodes)
+	}
+	if cfg.Hash == "" {
+		cfg.Hash = DefaultHash
+	}
+	if _, err := hashFunc(cfg.Hash); err != nil {
+		return nil, err
+	}
+	if cfg.OutlierDetection != nil {
+		if err := cfg.OutlierDetection.parse(); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// uint64Key - Key of the ring for the hash functions with 64-bit sums
+type uint64Key uint64
+
+// Less - Orders the keys on the ring
+func (k uint64Key) Less(other hashring.HashKey) bool {
+	return k < other.(uint64Key)
+}
+
+// hashFunc returns the hash function of the given name.
+func hashFunc(name string) (hashring.HashFunc, error) {
+	switch name {
+	case "md5":
+		return hashring.NewHash(md5.New).Use(hashring.NewInt64PairHashKey)
+	case "sha256":
+		return hashring.NewHash(sha256.New).FirstBytes(16).Use(hashring.NewInt64PairHashKey)
+	case "xxhash":
+		return func(b []byte) hashring.HashKey {
+			return uint64Key(xxhash.Sum64(b))
+		}, nil
+	default:
+		return nil, fmt.Errorf("consistent hash balancer: unknown hash function %q, must be one of %v", name, Hashes)
+	}
+}
+
+// newRing creates the ring of the nodes with the config.
+func newRing(nodes []string, cfg *Config) (*hashring.HashRing, error) {
+	fn, err := hashFunc(cfg.Hash)
+	if err != nil {
+		return nil, err
+	}
+	weights := make(map[string]int, len(nodes))
+	for _, node := range nodes {
+		weights[node] = cfg.VirtualNodes
+	}
+	return hashring.NewWithHashAndWeights(weights, fn), nil
+}
+
+// RingState - State of the hash ring of a balancer
+type RingState struct {
+	// Hash is the hash function of the ring
+	Hash string
+	// VirtualNodes is the number of virtual nodes of each node
+	VirtualNodes int
+	// Nodes are the nodes of the ring, ordered by address
+	Nodes []NodeState
+}
+
+// NodeState - State of a node of the hash ring
+type NodeState struct {
+	Address      string
+	VirtualNodes int
+	// Ownership is the estimated share of the keys the node owns, between 0 and 1
+	Ownership float64
+}
+
+// Movement - Keys moved between the nodes by a change of the nodes of the ring
+type Movement struct {
+	// Added and Removed are the addresses of the nodes that joined and left the ring
+	Added   []string
+	Removed []string
+	// Moved is the estimated share of the keys whose node changed, between 0 and 1
+	Moved float64
+	// Minimum is the share of the keys that had to move for the nodes to own their new shares, which consistent
+	// hashing bounds the moved keys to ideally
+	Minimum float64
+}
+
+// Observer - Receives the state of the hash ring of a balancer whenever its nodes change, along with the keys the
+// change moved. The movement of the first ring of a balancer is empty.
+type Observer interface {
+	RingChanged(state RingState, movement Movement)
+}
+
+// sampleOwners returns the node owning each of the sampled keys.
+func sampleOwners(ring *hashring.HashRing) []string {
+	owners := make([]string, ringSamples)
+	for i := range owners {
+		owners[i], _ = ring.GetNode("ring-sample-" + strconv.Itoa(i))
+	}
+	return owners
+}
+
+// ringState returns the state of the ring of the nodes, estimating their ownership from the owners of the samples.
+func ringState(nodes []string, cfg *Config, owners []string) RingState {
+	counts := map[string]int{}
+	for _, owner := range owners {
+		counts[owner]++
+	}
+	sorted := append([]string(nil), nodes...)
+	sort.Strings(sorted)
+
+	state := RingState{Hash: cfg.Hash, VirtualNodes: cfg.VirtualNodes, Nodes: make([]NodeState, 0, len(sorted))}
+	for _, node := range sorted {
+		state.Nodes = append(state.Nodes, NodeState{
+			Address:      node,
+			VirtualNodes: cfg.VirtualNodes,
+			Ownership:    float64(counts[node]) / float64(len(owners)),
+		})
+	}
+	return state
+}
+
+// movement returns the keys moved between the owners of the samples before and after a change of the nodes.
+func movement(before, after RingState, ownersBefore, ownersAfter []string) Movement {
+	var m Movement
+	if len(ownersBefore) == 0 {
+		return m
+	}
+
+	moved := 0
+	for i := range ownersAfter {
+		if ownersBefore[i] != ownersAfter[i] {
+			moved++
+		}
+	}
+	m.Moved = float64(moved) / float64(len(ownersAfter))
+
+	shares := map[string]float64{}
+	for _, node := range before.Nodes {
+		shares[node.Address] -= node.Ownership
+	}
+	for _, node := range after.Nodes {
+		shares[node.Address] += node.Ownership
+	}
+	for _, share := range shares {
+		if share > 0 {
+			m.Minimum += share
+		}
+	}
+
+	existed := map[string]bool{}
+	for _, node := range before.Nodes {
+		existed[node.Address] = true
+	}
+	for _, node := range after.Nodes {
+		if !existed[node.Address] {
+			m.Added = append(m.Added, node.Address)
+		}
+		delete(existed, node.Address)
+	}
+	for _, node := range before.Nodes {
+		if existed[node.Address] {
+			m.Removed = append(m.Removed, node.Address)
+		}
+	}
+	return m
+}
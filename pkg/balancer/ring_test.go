@@ -0,0 +1,75 @@
+package balancer
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/balancer"
+)
+
+// ringRecorder - Observer recording the changes of the ring
+type ringRecorder struct {
+	states    []RingState
+	movements []Movement
+}
+
+func (r *ringRecorder) RingChanged(state RingState, movement Movement) {
+	r.states = append(r.states, state)
+	r.movements = append(r.movements, movement)
+}
+
+var _ = Describe("Ring", func() {
+	Describe("parseConfig", func() {
+		It("should fill the fields the config doesn't set with the defaults", func() {
+			cfg, err := parseConfig(json.RawMessage(`{"hash": "xxhash"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.VirtualNodes).To(Equal(DefaultVirtualNodes))
+			Expect(cfg.Hash).To(Equal("xxhash"))
+		})
+
+		It("should reject unknown hash functions and negative virtual nodes", func() {
+			_, err := parseConfig(json.RawMessage(`{"hash": "crc32"}`))
+			Expect(err).To(HaveOccurred())
+			_, err = parseConfig(json.RawMessage(`{"virtualNodes": -1}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("newPicker", func() {
+		subConns := func(addrs ...string) map[string]balancer.SubConn {
+			m := map[string]balancer.SubConn{}
+			for _, addr := range addrs {
+				m[addr] = nil
+			}
+			return m
+		}
+
+		for _, hash := range Hashes {
+			hash := hash
+			It("should spread the keys evenly and move few of them when a node joins, with "+hash, func() {
+				recorder := &ringRecorder{}
+				b := &consistentHashBalancer{config: &Config{VirtualNodes: 100, Hash: hash}, observers: []Observer{recorder}}
+
+				b.newPicker(subConns("node-1", "node-2", "node-3"))
+				// The ring is only reported when its nodes change
+				b.newPicker(subConns("node-1", "node-2", "node-3"))
+				b.newPicker(subConns("node-1", "node-2", "node-3", "node-4"))
+				Expect(recorder.states).To(HaveLen(2))
+
+				for _, node := range recorder.states[1].Nodes {
+					Expect(node.VirtualNodes).To(Equal(100))
+					Expect(node.Ownership).To(BeNumerically("~", 0.25, 0.1))
+				}
+
+				Expect(recorder.movements[0]).To(Equal(Movement{}))
+				m := recorder.movements[1]
+				Expect(m.Added).To(Equal([]string{"node-4"}))
+				Expect(m.Removed).To(BeEmpty())
+				Expect(m.Minimum).To(BeNumerically("~", recorder.states[1].Nodes[3].Ownership, 0.001))
+				// Only the keys of the new node move
+				Expect(m.Moved).To(BeNumerically("~", m.Minimum, 0.001))
+			})
+		}
+	})
+})
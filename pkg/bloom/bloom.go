@@ -0,0 +1,99 @@
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// Filter - Probabilistic set of keys. A key that was added is always reported as possibly present, and a key that
+// wasn't is reported as absent, except for the false positives whose rate the filter is sized for.
+type Filter struct {
+	mu sync.RWMutex
+	// bits are the bits of the filter, set by the hashes of the keys
+	bits []uint64
+	// hashes is the number of bits set by each key
+	hashes uint64
+	// capacity is the number of keys the filter is sized for, and count the number of keys added
+	capacity int
+	count    int
+}
+
+// New - Creates a new filter of the capacity keys at most, whose false positive rate is falsePositiveRate as long as
+// it holds no more keys than that.
+func New(capacity int, falsePositiveRate float64) *Filter {
+	if capacity < 1 {
+		capacity = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+
+	// Optimal number of bits and hashes for the capacity and the rate
+	m := math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(capacity)*math.Ln2))
+
+	return &Filter{
+		bits:     make([]uint64, (uint64(m)+63)/64),
+		hashes:   uint64(k),
+		capacity: capacity,
+	}
+}
+
+// Add - Adds the key to the filter
+func (f *Filter) Add(key string) {
+	h1, h2 := hash(key)
+	size := uint64(len(f.bits)) * 64
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+	f.count++
+}
+
+// Test - Returns whether the key may have been added to the filter, false meaning it definitely wasn't
+func (f *Filter) Test(key string) bool {
+	h1, h2 := hash(key)
+	size := uint64(len(f.bits)) * 64
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for i := uint64(0); i < f.hashes; i++ {
+		bit := (h1 + i*h2) % size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Full - Returns whether the filter holds more keys than it is sized for, its false positive rate growing past the
+// one it was created with.
+func (f *Filter) Full() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.count > f.capacity
+}
+
+// Count - Returns the number of keys added to the filter, counting the keys added more than once each time
+func (f *Filter) Count() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.count
+}
+
+// hash returns the two hashes of the key the bits of the key are derived from. They are computed with different
+// functions, so that the keys whose first hashes collide still set different bits.
+func hash(key string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	// The second hash is odd so that it is never zero, which would set the same bit for all the hashes of the key.
+	// The sizes of the filters are multiples of 64, so the bits of a key may still repeat when it shares an odd
+	// factor with the size.
+	return h.Sum64(), xxhash.Sum64String(key) | 1
+}
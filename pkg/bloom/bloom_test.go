@@ -0,0 +1,32 @@
+package bloom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	filter := New(1000, 0.01)
+
+	for i := 0; i < 1000; i++ {
+		filter.Add(fmt.Sprintf("doc:%d#viewer", i))
+	}
+	for i := 0; i < 1000; i++ {
+		assert.True(t, filter.Test(fmt.Sprintf("doc:%d#viewer", i)), "added keys are never reported absent")
+	}
+	assert.False(t, filter.Full())
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if filter.Test(fmt.Sprintf("doc:%d#editor", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300, "the false positive rate stays close to the one the filter is sized for")
+
+	filter.Add("doc:1000#viewer")
+	assert.True(t, filter.Full())
+	assert.Equal(t, 1001, filter.Count())
+}
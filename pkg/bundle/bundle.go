@@ -0,0 +1,119 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// File - Bundle as it is written in a file, with the relationships and the attributes it is seeded with in their
+// string forms, e.g. doc:1#owner@user:1 and doc:1$public|boolean:true
+type File struct {
+	Name          string   `yaml:"name" json:"name"`
+	Version       string   `yaml:"version" json:"version"`
+	Schema        string   `yaml:"schema" json:"schema"`
+	Relationships []string `yaml:"relationships" json:"relationships"`
+	Attributes    []string `yaml:"attributes" json:"attributes"`
+}
+
+// Bundle - Converts the file to the bundle applied with the ApplyBundle API
+func (f File) Bundle() (*base.Bundle, error) {
+	b := &base.Bundle{
+		Name:    f.Name,
+		Version: f.Version,
+		Schema:  f.Schema,
+	}
+
+	for _, r := range f.Relationships {
+		t, err := tuple.Tuple(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r, err)
+		}
+		b.Tuples = append(b.Tuples, t)
+	}
+
+	for _, a := range f.Attributes {
+		attr, err := attribute.Attribute(a)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", a, err)
+		}
+		b.Attributes = append(b.Attributes, attr)
+	}
+
+	return b, nil
+}
+
+// Digest - Returns the SHA-256 digest of the deterministic encoding of the bundle, which is what its signature signs
+func Digest(b *base.Bundle) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// Sign - Signs the bundle with the Ed25519 private key and returns the base64 encoded signature
+func Sign(b *base.Bundle, key ed25519.PrivateKey) (string, error) {
+	digest, err := Digest(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, digest)), nil
+}
+
+// Verify - Checks that the base64 encoded signature is a signature of the bundle by one of the public keys
+func Verify(b *base.Bundle, signature string, keys []ed25519.PublicKey) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	digest, err := Digest(b)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if ed25519.Verify(key, digest, sig) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// ParsePublicKey - Parses a base64 encoded Ed25519 public key
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// ParsePrivateKey - Parses a base64 encoded Ed25519 private key, either the key itself or its seed
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	key, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	switch len(key) {
+	case ed25519.PrivateKeySize:
+		return key, nil
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(key), nil
+	default:
+		return nil, ErrInvalidKey
+	}
+}
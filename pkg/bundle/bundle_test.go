@@ -0,0 +1,121 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestBundle -
+func TestBundle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "bundle-suite")
+}
+
+var _ = Describe("bundle", func() {
+	file := File{
+		Name:    "docs",
+		Version: "1.0.0",
+		Schema: `entity user {}
+
+entity doc {
+	relation owner @user
+	attribute public boolean
+
+	permission edit = owner
+}`,
+		Relationships: []string{"doc:1#owner@user:1"},
+		Attributes:    []string{"doc:1$public|boolean:true"},
+	}
+
+	Context("Bundle", func() {
+		It("Case 1", func() {
+			b, err := file.Bundle()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(b.GetName()).Should(Equal("docs"))
+			Expect(b.GetTuples()).Should(HaveLen(1))
+			Expect(b.GetTuples()[0].GetSubject().GetId()).Should(Equal("1"))
+			Expect(b.GetAttributes()).Should(HaveLen(1))
+			Expect(b.GetAttributes()[0].GetAttribute()).Should(Equal("public"))
+		})
+
+		It("Case 2", func() {
+			_, err := File{Relationships: []string{"doc:1#owner"}}.Bundle()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("Sign and Verify", func() {
+		It("Case 1", func() {
+			public, private, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			b, err := file.Bundle()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			signature, err := Sign(b, private)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(Verify(b, signature, []ed25519.PublicKey{public})).Should(Succeed())
+
+			// A bundle decoded from its encoding verifies as well
+			data, err := proto.Marshal(b)
+			Expect(err).ShouldNot(HaveOccurred())
+			decoded, err := file.Bundle()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(proto.Unmarshal(data, decoded)).Should(Succeed())
+			Expect(Verify(decoded, signature, []ed25519.PublicKey{public})).Should(Succeed())
+		})
+
+		It("Case 2", func() {
+			public, private, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ShouldNot(HaveOccurred())
+			other, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			b, err := file.Bundle()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			signature, err := Sign(b, private)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(Verify(b, signature, []ed25519.PublicKey{other})).Should(MatchError(ErrInvalidSignature))
+			Expect(Verify(b, signature, []ed25519.PublicKey{other, public})).Should(Succeed())
+			Expect(Verify(b, "", []ed25519.PublicKey{public})).Should(MatchError(ErrMissingSignature))
+			Expect(Verify(b, "not base64", []ed25519.PublicKey{public})).Should(MatchError(ErrInvalidSignature))
+
+			b.Tuples[0].Subject.Id = "2"
+			Expect(Verify(b, signature, []ed25519.PublicKey{public})).Should(MatchError(ErrInvalidSignature))
+		})
+	})
+
+	Context("Keys", func() {
+		It("Case 1", func() {
+			public, private, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			key, err := ParsePublicKey(base64.StdEncoding.EncodeToString(public))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(key).Should(Equal(public))
+
+			seed, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(private.Seed()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(seed).Should(Equal(private))
+
+			full, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(private))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(full).Should(Equal(private))
+
+			_, err = ParsePublicKey(base64.StdEncoding.EncodeToString(public[:10]))
+			Expect(err).Should(MatchError(ErrInvalidKey))
+			_, err = ParsePrivateKey("not base64")
+			Expect(err).Should(MatchError(ErrInvalidKey))
+		})
+	})
+})
@@ -0,0 +1,11 @@
+package bundle
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidKey       = errors.New("invalid key")
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	_defaultCacheTTL     = 10 * time.Second
+	_defaultCacheSize    = 100_000
+	_defaultWatchBackoff = time.Second
+)
+
+// CacheOption - Type for check cache options
+type CacheOption func(*CheckCache)
+
+// WithCacheTTL - Bounds the time a result is served from the cache for, and so its staleness should a change be
+// missed, e.g. while the Watch stream reconnects
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *CheckCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithCacheSize - Bounds the number of results kept in the cache, across the tenants
+func WithCacheSize(size int) CacheOption {
+	return func(c *CheckCache) {
+		c.size = size
+	}
+}
+
+// CheckCache - Serves the results of the checks from a local cache, invalidating the results of a tenant as soon as
+// the Watch stream of the tenant reports a change to its data. As the results of a check may depend on any of the
+// data of the tenant through the schema, a change invalidates all the results of the tenant. Results are only
+// cached while the stream of their tenant is up, and for the TTL at most. Writes of the schema aren't reported by
+// the stream, so the results of the checks evaluated on the latest schema version may be served for the TTL after
+// a new version is written.
+type CheckCache struct {
+	client *Client
+	ttl    time.Duration
+	size   int
+
+	mu      sync.Mutex
+	tenants map[string]*tenantCache
+	entries int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// tenantCache - Cached results of the checks of a tenant
+type tenantCache struct {
+	// watching is whether the changes of the tenant are watched, from the first message of its Watch stream
+	watching bool
+	// generation is incremented on each invalidation, so that the results of the checks sent before are not cached
+	generation uint64
+	results    map[string]cachedResult
+}
+
+// cachedResult - Cached result of a check
+type cachedResult struct {
+	response *base.PermissionCheckResponse
+	// snapToken is the snap token of the last write of the client to the tenant when the check was sent, so that the
+	// client reads its own writes
+	snapToken string
+	expires   time.Time
+}
+
+// NewCheckCache - Creates a new CheckCache of the checks of the client. Close stops the Watch streams.
+func NewCheckCache(client *Client, opts ...CacheOption) *CheckCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &CheckCache{
+		client:  client,
+		ttl:     _defaultCacheTTL,
+		size:    _defaultCacheSize,
+		tenants: map[string]*tenantCache{},
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	// custom options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Check - Returns the result of the check from the cache if it has it, and evaluates it otherwise. Checks setting a
+// snap token, a snapshot time, contextual data or debug are always evaluated by the servers.
+func (c *CheckCache) Check(ctx context.Context, req *base.PermissionCheckRequest, opts ...grpc.CallOption) (*base.PermissionCheckResponse, error) {
+	if !cacheable(req) {
+		return c.client.Permission.Check(ctx, req, opts...)
+	}
+
+	key := cacheKey(req)
+	snapToken := c.client.SnapToken(req.GetTenantId())
+
+	c.mu.Lock()
+	tenant := c.tenant(req.GetTenantId())
+	if result, ok := tenant.results[key]; ok {
+		if result.snapToken == snapToken && time.Now().Before(result.expires) {
+			c.mu.Unlock()
+			return proto.Clone(result.response).(*base.PermissionCheckResponse), nil
+		}
+		delete(tenant.results, key)
+		c.entries--
+	}
+	generation, watching := tenant.generation, tenant.watching
+	c.mu.Unlock()
+
+	response, err := c.client.Permission.Check(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if watching && tenant.watching && tenant.generation == generation && c.entries < c.size {
+		if _, ok := tenant.results[key]; !ok {
+			c.entries++
+		}
+		tenant.results[key] = cachedResult{response: response, snapToken: snapToken, expires: time.Now().Add(c.ttl)}
+	}
+	return response, nil
+}
+
+// Invalidate - Removes the cached results of the tenant
+func (c *CheckCache) Invalidate(tenantID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if tenant, ok := c.tenants[tenantID]; ok {
+		c.invalidate(tenant)
+	}
+}
+
+// Close - Stops the Watch streams of the tenants
+func (c *CheckCache) Close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// tenant returns the cache of the tenant, watching its changes if it is the first check of the tenant. c.mu must
+// be held.
+func (c *CheckCache) tenant(tenantID string) *tenantCache {
+	tenant, ok := c.tenants[tenantID]
+	if !ok {
+		tenant = &tenantCache{results: map[string]cachedResult{}}
+		c.tenants[tenantID] = tenant
+		c.wg.Add(1)
+		go c.watch(tenantID, tenant)
+	}
+	return tenant
+}
+
+// invalidate removes the cached results of the tenant. c.mu must be held.
+func (c *CheckCache) invalidate(tenant *tenantCache) {
+	tenant.generation++
+	c.entries -= len(tenant.results)
+	tenant.results = map[string]cachedResult{}
+}
+
+// setWatching records whether the Watch stream of the tenant is up, removing its results when it isn't
+func (c *CheckCache) setWatching(tenant *tenantCache, watching bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tenant.watching = watching
+	if !watching {
+		c.invalidate(tenant)
+	}
+}
+
+// watch invalidates the results of the tenant on each change reported by its Watch stream, reconnecting the stream
+// until the cache is closed
+func (c *CheckCache) watch(tenantID string, tenant *tenantCache) {
+	defer c.wg.Done()
+	for {
+		c.follow(tenantID, tenant)
+		c.setWatching(tenant, false)
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(_defaultWatchBackoff):
+		}
+	}
+}
+
+// follow invalidates the results of the tenant on each change reported by its Watch stream, until the stream fails.
+// The stream is set up before the servers watch the changes of the tenant, from the snapshot they read then, so the
+// results are only cached from its first message, a change or a keepalive, which is sent once they do.
+func (c *CheckCache) follow(tenantID string, tenant *tenantCache) {
+	stream, err := c.client.Watch.Watch(c.ctx, &base.WatchRequest{TenantId: tenantID})
+	if err != nil {
+		return
+	}
+	for first := true; ; first = false {
+		response, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		if first {
+			c.setWatching(tenant, true)
+		}
+		if len(response.GetChanges().GetDataChanges()) > 0 {
+			c.Invalidate(tenantID)
+		}
+	}
+}
+
+// cacheable returns whether the result of the check can be served from the cache
+func cacheable(req *base.PermissionCheckRequest) bool {
+	metadata := req.GetMetadata()
+	return metadata.GetSnapToken() == "" && metadata.GetSnapshotTime() == nil && !metadata.GetDebug() &&
+		len(req.GetContext().GetTuples()) == 0 && len(req.GetContext().GetAttributes()) == 0 &&
+		req.GetContext().GetData() == nil && len(req.GetArguments()) == 0
+}
+
+// cacheKey returns the key of the result of the check in the cache of its tenant
+func cacheKey(req *base.PermissionCheckRequest) string {
+	return fmt.Sprintf("%s#%s@%s|%s|%d", tuple.EntityToString(req.GetEntity()), req.GetPermission(),
+		tuple.SubjectToString(req.GetSubject()), req.GetMetadata().GetSchemaVersion(), req.GetMetadata().GetDepth())
+}
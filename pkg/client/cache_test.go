@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// testWatchServer - Streams the messages it is given to the watchers, signaling each watcher on started
+type testWatchServer struct {
+	base.UnimplementedWatchServer
+
+	started   chan string
+	responses chan *base.WatchResponse
+}
+
+func (s *testWatchServer) Watch(req *base.WatchRequest, stream base.Watch_WatchServer) error {
+	s.started <- req.GetTenantId()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case response := <-s.responses:
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func TestCheckCache(t *testing.T) {
+	ctx := context.Background()
+	watch := &testWatchServer{started: make(chan string, 1), responses: make(chan *base.WatchResponse)}
+	server := &testServer{watch: watch}
+	c := newTestClient(t, server)
+	cache := NewCheckCache(c, WithCacheTTL(time.Minute))
+	defer cache.Close()
+
+	req := &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+		Entity:     &base.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	}
+	check := func() {
+		response, err := cache.Check(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, base.CheckResult_CHECK_RESULT_ALLOWED, response.GetCan())
+	}
+
+	// Results aren't cached until the changes of the tenant are watched, even once its stream is set up
+	check()
+	assert.Equal(t, "t1", <-watch.started)
+	checks := server.count()
+	check()
+	check()
+	assert.Equal(t, checks+2, server.count())
+
+	// which the first message of the stream tells
+	watch.responses <- &base.WatchResponse{Keepalive: true}
+	assert.Eventually(t, func() bool {
+		check()
+		checks := server.count()
+		check()
+		return server.count() == checks
+	}, time.Second, 10*time.Millisecond)
+	checks = server.count()
+	check()
+	assert.Equal(t, checks, server.count())
+
+	// Checks setting a snap token of their own are evaluated by the servers
+	_, err := cache.Check(ctx, &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "own", Depth: 20},
+		Entity:     &base.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, checks+1, server.count())
+
+	// A change to the data of the tenant invalidates its results
+	watch.responses <- &base.WatchResponse{Changes: &base.DataChanges{DataChanges: []*base.DataChange{{Operation: base.DataChange_OPERATION_CREATE}}}}
+	assert.Eventually(t, func() bool {
+		check()
+		return server.count() > checks+1
+	}, time.Second, 10*time.Millisecond)
+
+	// So do the writes of the client, which reads its own writes
+	checks = server.count()
+	check()
+	assert.Equal(t, checks, server.count())
+	_, err = c.WriteRelationships(ctx, "t1", "document:1#owner@user:1")
+	require.NoError(t, err)
+	check()
+	assert.Equal(t, checks+1, server.count())
+	check()
+	assert.Equal(t, checks+1, server.count())
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultRetries  = 3
+	_defaultBackoff  = 100 * time.Millisecond
+	_defaultPoolSize = 1
+)
+
+// retryableCodes are the codes of the transient errors the requests are retried on
+var retryableCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted}
+
+// Client - Client of the gRPC API of Permify, exposing a typed client for each of its services along with helpers
+// for the common requests
+type Client struct {
+	Permission base.PermissionClient
+	Schema     base.SchemaClient
+	Data       base.DataClient
+	Tenancy    base.TenancyClient
+	Watch      base.WatchClient
+	Admin      base.AdminClient
+
+	tls         *tls.Config
+	token       string
+	retries     uint
+	backoff     time.Duration
+	poolSize    int
+	snapTokens  bool
+	dialOptions []grpc.DialOption
+
+	pool   *pool
+	tokens *snapTokens
+}
+
+// New - Creates a new Client of the servers of the target, e.g. localhost:3478 or dns:///permify:3478. By default,
+// the transient errors are retried, the writes carry an idempotency key so that their retries are applied once,
+// and the reads of a tenant are evaluated at least at the snapshot of the last write of the client to it.
+func New(target string, opts ...Option) (*Client, error) {
+	c := &Client{
+		retries:    _defaultRetries,
+		backoff:    _defaultBackoff,
+		poolSize:   _defaultPoolSize,
+		snapTokens: true,
+		tokens:     newSnapTokens(),
+	}
+
+	// custom options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.poolSize < 1 {
+		return nil, errors.New("the pool size must be at least 1")
+	}
+
+	var unary []grpc.UnaryClientInterceptor
+	var stream []grpc.StreamClientInterceptor
+	if c.snapTokens {
+		unary = append(unary, c.tokens.unaryClientInterceptor())
+		stream = append(stream, c.tokens.streamClientInterceptor())
+	}
+	// Keys are set before the retries, so that all the attempts of a write share theirs
+	unary = append(unary, idempotencyKeys())
+	if c.retries > 0 {
+		retryOptions := []retry.CallOption{
+			retry.WithMax(c.retries),
+			retry.WithBackoff(retry.BackoffExponential(c.backoff)),
+			retry.WithCodes(retryableCodes...),
+		}
+		unary = append(unary, retry.UnaryClientInterceptor(retryOptions...))
+		stream = append(stream, retry.StreamClientInterceptor(retryOptions...))
+	}
+
+	creds := insecure.NewCredentials()
+	if c.tls != nil {
+		creds = credentials.NewTLS(c.tls)
+	}
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(unary...),
+		grpc.WithChainStreamInterceptor(stream...),
+	}
+	if c.token != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(bearerToken{token: c.token, secure: c.tls != nil}))
+	}
+	dialOptions = append(dialOptions, c.dialOptions...)
+
+	c.pool = &pool{}
+	for i := 0; i < c.poolSize; i++ {
+		conn, err := grpc.Dial(target, dialOptions...)
+		if err != nil {
+			_ = c.pool.Close()
+			return nil, err
+		}
+		c.pool.conns = append(c.pool.conns, conn)
+	}
+
+	c.Permission = base.NewPermissionClient(c.pool)
+	c.Schema = base.NewSchemaClient(c.pool)
+	c.Data = base.NewDataClient(c.pool)
+	c.Tenancy = base.NewTenancyClient(c.pool)
+	c.Watch = base.NewWatchClient(c.pool)
+	c.Admin = base.NewAdminClient(c.pool)
+
+	return c, nil
+}
+
+// Close - Closes the connections of the client
+func (c *Client) Close() error {
+	return c.pool.Close()
+}
+
+// SnapToken - Returns the snap token of the last write of the client to the tenant, empty if there wasn't any
+func (c *Client) SnapToken(tenantID string) string {
+	return c.tokens.get(tenantID)
+}
+
+// bearerToken - Credentials authenticating the calls with a bearer token
+type bearerToken struct {
+	token  string
+	secure bool
+}
+
+// GetRequestMetadata returns the authorization header of the calls.
+func (b bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + b.token}, nil
+}
+
+// RequireTransportSecurity returns whether the token is only sent over TLS, as it is when the client uses TLS.
+func (b bearerToken) RequireTransportSecurity() bool {
+	return b.secure
+}
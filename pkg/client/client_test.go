@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// testServer - Records the requests it receives, failing the first writes with the unavailable status
+type testServer struct {
+	base.UnimplementedPermissionServer
+	base.UnimplementedDataServer
+
+	mu            sync.Mutex
+	failures      int
+	keys          []string
+	checks        []*base.PermissionCheckRequest
+	authorization []string
+
+	// watch serves the Watch service, if not nil
+	watch base.WatchServer
+}
+
+func (s *testServer) Write(ctx context.Context, req *base.DataWriteRequest) (*base.DataWriteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, req.GetIdempotencyKey())
+	if s.failures > 0 {
+		s.failures--
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &base.DataWriteResponse{SnapToken: "token-" + req.GetTenantId()}, nil
+}
+
+func (s *testServer) Check(ctx context.Context, req *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks = append(s.checks, req)
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = append(s.authorization, md.Get("authorization")...)
+	return &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED}, nil
+}
+
+// count returns the number of checks the server evaluated
+func (s *testServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.checks)
+}
+
+func newTestClient(t *testing.T, server *testServer, opts ...Option) *Client {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	base.RegisterPermissionServer(srv, server)
+	base.RegisterDataServer(srv, server)
+	if server.watch != nil {
+		base.RegisterWatchServer(srv, server.watch)
+	}
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }
+	opts = append([]Option{WithDialOptions(grpc.WithContextDialer(dialer))}, opts...)
+	c, err := New("bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	server := &testServer{failures: 2}
+	c := newTestClient(t, server, WithRetries(3, time.Millisecond), WithPoolSize(2), WithToken("secret"))
+
+	// The write is retried with the idempotency key of its first attempt
+	token, err := c.WriteRelationships(ctx, "t1", "document:1#owner@user:1")
+	require.NoError(t, err)
+	assert.Equal(t, "token-t1", token)
+	assert.Equal(t, "token-t1", c.SnapToken("t1"))
+	require.Len(t, server.keys, 3)
+	assert.NotEmpty(t, server.keys[0])
+	assert.Equal(t, server.keys[0], server.keys[1])
+	assert.Equal(t, server.keys[0], server.keys[2])
+
+	// Checks of the tenant are evaluated at least at the snapshot of the write, unless they set their own
+	can, err := c.Check(ctx, "t1", "document:1", "view", "user:1")
+	require.NoError(t, err)
+	assert.True(t, can)
+	_, err = c.Check(ctx, "t2", "document:1", "view", "organization:1#member")
+	require.NoError(t, err)
+	req := &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "own", Depth: 20},
+		Entity:     &base.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	}
+	_, err = c.Permission.Check(ctx, req)
+	require.NoError(t, err)
+
+	require.Len(t, server.checks, 3)
+	assert.Equal(t, "token-t1", server.checks[0].GetMetadata().GetSnapToken())
+	assert.Empty(t, server.checks[1].GetMetadata().GetSnapToken())
+	assert.Equal(t, "member", server.checks[1].GetSubject().GetRelation())
+	assert.Equal(t, "own", server.checks[2].GetMetadata().GetSnapToken())
+	assert.Equal(t, []string{"Bearer secret", "Bearer secret", "Bearer secret"}, server.authorization)
+
+	_, err = c.Check(ctx, "t1", "document", "view", "user:1")
+	assert.Error(t, err)
+}
+
+func TestClient_WithoutSnapTokens(t *testing.T) {
+	ctx := context.Background()
+	server := &testServer{}
+	c := newTestClient(t, server, WithSnapTokens(false))
+
+	_, err := c.WriteRelationships(ctx, "t1", "document:1#owner@user:1")
+	require.NoError(t, err)
+	_, err = c.Check(ctx, "t1", "document:1", "view", "user:1")
+	require.NoError(t, err)
+
+	require.Len(t, server.checks, 1)
+	assert.Empty(t, server.checks[0].GetMetadata().GetSnapToken())
+
+	_, err = New("bufnet", WithPoolSize(0))
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// _defaultDepth is the depth of the checks and lookups of the helpers
+const _defaultDepth = 20
+
+// Check - Returns whether the subject, e.g. user:1 or organization:1#member, has the permission on the entity, e.g.
+// document:1, in the tenant
+func (c *Client) Check(ctx context.Context, tenantID, entity, permission, subject string) (bool, error) {
+	en, err := tuple.E(entity)
+	if err != nil {
+		return false, err
+	}
+	sub, err := parseSubject(subject)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := c.Permission.Check(ctx, &base.PermissionCheckRequest{
+		TenantId:   tenantID,
+		Metadata:   &base.PermissionCheckRequestMetadata{Depth: _defaultDepth},
+		Entity:     en,
+		Permission: permission,
+		Subject:    sub,
+	})
+	if err != nil {
+		return false, err
+	}
+	return response.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED, nil
+}
+
+// LookupEntity - Returns the identifiers of the entities of the type the subject has the permission on, in the tenant
+func (c *Client) LookupEntity(ctx context.Context, tenantID, entityType, permission, subject string) ([]string, error) {
+	sub, err := parseSubject(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.Permission.LookupEntity(ctx, &base.PermissionLookupEntityRequest{
+		TenantId:   tenantID,
+		Metadata:   &base.PermissionLookupEntityRequestMetadata{Depth: _defaultDepth},
+		EntityType: entityType,
+		Permission: permission,
+		Subject:    sub,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetEntityIds(), nil
+}
+
+// WriteRelationships - Writes the relationships, e.g. document:1#owner@user:1, to the tenant and returns the snap
+// token of the write
+func (c *Client) WriteRelationships(ctx context.Context, tenantID string, relationships ...string) (string, error) {
+	tuples := make([]*base.Tuple, 0, len(relationships))
+	for _, relationship := range relationships {
+		t, err := tuple.Tuple(relationship)
+		if err != nil {
+			return "", err
+		}
+		tuples = append(tuples, t)
+	}
+
+	response, err := c.Data.Write(ctx, &base.DataWriteRequest{
+		TenantId: tenantID,
+		Metadata: &base.DataWriteRequestMetadata{},
+		Tuples:   tuples,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.GetSnapToken(), nil
+}
+
+// parseSubject parses a subject, e.g. user:1 or organization:1#member
+func parseSubject(subject string) (*base.Subject, error) {
+	ear, err := tuple.EAR(subject)
+	if err != nil {
+		return nil, err
+	}
+	return &base.Subject{
+		Type:     ear.GetEntity().GetType(),
+		Id:       ear.GetEntity().GetId(),
+		Relation: ear.GetRelation(),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// snapTokens - Snap tokens of the last writes of the client, by tenant, set on the reads of the tenants not setting
+// a snap token of their own so that the client reads its own writes
+type snapTokens struct {
+	mu     sync.RWMutex
+	tokens map[string]string
+}
+
+// newSnapTokens - Creates new snapTokens
+func newSnapTokens() *snapTokens {
+	return &snapTokens{tokens: map[string]string{}}
+}
+
+// get returns the snap token of the last write to the tenant, empty if there wasn't any
+func (s *snapTokens) get(tenantID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tokens[tenantID]
+}
+
+// set records the snap token of a write to the tenant
+func (s *snapTokens) set(tenantID, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[tenantID] = token
+}
+
+// prepare returns the request with the snap token of the tenant in its metadata, unless it sets a snap token or a
+// snapshot time of its own, along with its tenant
+func (s *snapTokens) prepare(req interface{}) (interface{}, string) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return req, ""
+	}
+	tenantID := stringValue(msg.ProtoReflect(), "tenant_id")
+	token := s.get(tenantID)
+	if token == "" {
+		return req, tenantID
+	}
+
+	fd := msg.ProtoReflect().Descriptor().Fields().ByName("metadata")
+	if fd == nil || fd.Message() == nil || !isString(fd.Message().Fields().ByName("snap_token")) {
+		return req, tenantID
+	}
+	metadata := msg.ProtoReflect().Get(fd).Message()
+	if stringValue(metadata, "snap_token") != "" {
+		return req, tenantID
+	}
+	if snapshot := metadata.Descriptor().Fields().ByName("snapshot_time"); snapshot != nil && metadata.Has(snapshot) {
+		return req, tenantID
+	}
+
+	// The request of the caller is left untouched
+	clone := proto.Clone(msg)
+	metadata = clone.ProtoReflect().Mutable(fd).Message()
+	metadata.Set(metadata.Descriptor().Fields().ByName("snap_token"), protoreflect.ValueOfString(token))
+	return clone, tenantID
+}
+
+// record keeps the snap token of the response, if it is the response of a write to the tenant
+func (s *snapTokens) record(tenantID string, reply interface{}) {
+	msg, ok := reply.(proto.Message)
+	if !ok || tenantID == "" {
+		return
+	}
+	if token := stringValue(msg.ProtoReflect(), "snap_token"); token != "" {
+		s.set(tenantID, token)
+	}
+}
+
+// unaryClientInterceptor - Returns an interceptor setting and recording the snap tokens of the unary calls
+func (s *snapTokens) unaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		req, tenantID := s.prepare(req)
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return err
+		}
+		s.record(tenantID, reply)
+		return nil
+	}
+}
+
+// streamClientInterceptor - Returns an interceptor setting and recording the snap tokens of the streams
+func (s *snapTokens) streamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &snapTokenStream{ClientStream: stream, tokens: s}, nil
+	}
+}
+
+// snapTokenStream - Client stream setting the snap tokens of the messages it sends and recording the ones it receives
+type snapTokenStream struct {
+	grpc.ClientStream
+	tokens   *snapTokens
+	tenantID string
+}
+
+// SendMsg sends the message with the snap token of its tenant.
+func (s *snapTokenStream) SendMsg(m interface{}) error {
+	m, s.tenantID = s.tokens.prepare(m)
+	return s.ClientStream.SendMsg(m)
+}
+
+// RecvMsg receives the message, recording its snap token.
+func (s *snapTokenStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.tokens.record(s.tenantID, m)
+	return nil
+}
+
+// idempotencyKeys - Returns an interceptor setting an idempotency key on the writes not setting one of their own, so
+// that their retries are applied once
+func idempotencyKeys() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if msg, ok := req.(proto.Message); ok {
+			fd := msg.ProtoReflect().Descriptor().Fields().ByName("idempotency_key")
+			if isString(fd) && stringValue(msg.ProtoReflect(), "idempotency_key") == "" {
+				clone := proto.Clone(msg)
+				clone.ProtoReflect().Set(fd, protoreflect.ValueOfString(xid.New().String()))
+				req = clone
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// isString returns whether the field is a singular string field
+func isString(fd protoreflect.FieldDescriptor) bool {
+	return fd != nil && fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated
+}
+
+// stringValue returns the value of the string field of the message, empty if it has no such field
+func stringValue(msg protoreflect.Message, name protoreflect.Name) string {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if !isString(fd) {
+		return ""
+	}
+	return msg.Get(fd).String()
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Option - Type for client options
+type Option func(*Client)
+
+// WithTLS - Connects to the servers with TLS, verifying them with the configuration
+func WithTLS(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tls = config
+	}
+}
+
+// WithToken - Authenticates the requests with the bearer token, e.g. a preshared key or an OIDC token
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries - Retries the requests failing with a transient error up to max times, waiting backoff before the
+// first retry and twice as long before each of the next ones. Zero disables the retries.
+func WithRetries(max uint, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = max
+		c.backoff = backoff
+	}
+}
+
+// WithPoolSize - Spreads the requests across size connections to the target, so that busy clients aren't limited by
+// the concurrent streams of a single HTTP/2 connection
+func WithPoolSize(size int) Option {
+	return func(c *Client) {
+		c.poolSize = size
+	}
+}
+
+// WithSnapTokens - Whether the requests reading the data of a tenant are evaluated at least at the snapshot of the
+// last write of the client to the tenant, when they don't set a snap token of their own
+func WithSnapTokens(enabled bool) Option {
+	return func(c *Client) {
+		c.snapTokens = enabled
+	}
+}
+
+// WithDialOptions - Dials the connections with the additional options
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(c *Client) {
+		c.dialOptions = append(c.dialOptions, opts...)
+	}
+}
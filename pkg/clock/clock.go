@@ -0,0 +1,59 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock - Source of the current time. Components that depend on time take a Clock, so that tests can control the
+// time they see with a Mock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// system - Clock of the system
+type system struct{}
+
+// Now - Returns the current time of the system
+func (system) Now() time.Time {
+	return time.Now()
+}
+
+// New - Creates the clock of the system
+func New() Clock {
+	return system{}
+}
+
+// Mock - Clock whose time only moves when it is set or advanced. It is safe for concurrent use.
+type Mock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewMock - Creates a new mock clock at the given time
+func NewMock(now time.Time) *Mock {
+	return &Mock{now: now}
+}
+
+// Now - Returns the time of the clock
+func (m *Mock) Now() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.now
+}
+
+// Set - Sets the time of the clock
+func (m *Mock) Set(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = now
+}
+
+// Add - Advances the clock by the duration, which may be negative, and returns its new time
+func (m *Mock) Add(d time.Duration) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+	return m.now
+}
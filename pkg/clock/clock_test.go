@@ -0,0 +1,39 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestClock -
+func TestClock(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "clock-suite")
+}
+
+var _ = Describe("clock", func() {
+	Context("Mock", func() {
+		It("Case 1", func() {
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			m := NewMock(start)
+			Expect(m.Now()).Should(Equal(start))
+
+			Expect(m.Add(time.Hour)).Should(Equal(start.Add(time.Hour)))
+			Expect(m.Now()).Should(Equal(start.Add(time.Hour)))
+
+			m.Set(start)
+			Expect(m.Now()).Should(Equal(start))
+		})
+	})
+
+	Context("System", func() {
+		It("Case 1", func() {
+			before := time.Now()
+			now := New().Now()
+			Expect(now).ShouldNot(BeTemporally("<", before))
+		})
+	})
+})
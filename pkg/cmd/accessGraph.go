@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/internal/accessgraph"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	format        = "format"
+	graphDepth    = "depth"
+	graphMaxNodes = "max-nodes"
+	snapToken     = "snap-token"
+)
+
+// NewAccessGraphCommand - Creates new access graph command
+func NewAccessGraphCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-graph <tenant> <subject>",
+		Short: "export the entities a subject can reach and its permissions on each as a JSON or DOT graph",
+		RunE:  accessGraph(),
+		Args:  cobra.ExactArgs(2),
+	}
+
+	// add flags to the access graph command
+	cmd.PersistentFlags().String(address, "localhost:3478", "gRPC address of the server to read the relationships and check the permissions from")
+	cmd.PersistentFlags().String(apiToken, "", "preshared key or token to authenticate with")
+	cmd.PersistentFlags().Bool(useTLS, false, "connect to the server over TLS")
+	cmd.PersistentFlags().String(schemaVersion, "", "schema version to check the permissions against, the head version of the tenant if not set")
+	cmd.PersistentFlags().String(snapToken, "", "snap token of the snapshot to read the relationships and check the permissions at")
+	cmd.PersistentFlags().String(format, "json", "format of the graph, json or dot")
+	cmd.PersistentFlags().String(output, "", "file to write the graph to, the standard output if not set")
+	cmd.PersistentFlags().Int(graphDepth, accessgraph.DefaultDepth, "maximum number of relationships walked from the subject")
+	cmd.PersistentFlags().Int(graphMaxNodes, accessgraph.DefaultMaxNodes, "maximum number of entities of the graph")
+
+	return cmd
+}
+
+// accessGraph - permify access-graph command
+func accessGraph() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{address, apiToken, schemaVersion, snapToken, format, output})
+		if err != nil {
+			return err
+		}
+		secure, err := cmd.Flags().GetBool(useTLS)
+		if err != nil {
+			return err
+		}
+		depth, err := cmd.Flags().GetInt(graphDepth)
+		if err != nil {
+			return err
+		}
+		maxNodes, err := cmd.Flags().GetInt(graphMaxNodes)
+		if err != nil {
+			return err
+		}
+
+		var write func(g *accessgraph.Graph, w io.Writer) error
+		switch flags[format] {
+		case "json":
+			write = (*accessgraph.Graph).WriteJSON
+		case "dot":
+			write = (*accessgraph.Graph).WriteDOT
+		default:
+			return fmt.Errorf("unknown format %q, expected json or dot", flags[format])
+		}
+
+		ear, err := tuple.EAR(args[1])
+		if err != nil {
+			return err
+		}
+		subject := &base.Subject{Type: ear.GetEntity().GetType(), Id: ear.GetEntity().GetId(), Relation: ear.GetRelation()}
+
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.Dial(flags[address], grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if flags[apiToken] != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+		}
+
+		opts := []accessgraph.Option{accessgraph.Depth(depth), accessgraph.MaxNodes(maxNodes)}
+		if flags[schemaVersion] != "" {
+			opts = append(opts, accessgraph.SchemaVersion(flags[schemaVersion]))
+		}
+		if flags[snapToken] != "" {
+			opts = append(opts, accessgraph.SnapToken(flags[snapToken]))
+		}
+
+		graph, err := accessgraph.Walk(ctx, base.NewDataClient(conn), base.NewPermissionClient(conn), args[0], subject, opts...)
+		if err != nil {
+			return err
+		}
+
+		out := io.Writer(os.Stdout)
+		if flags[output] != "" {
+			file, err := os.Create(flags[output])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			out = file
+		}
+		if err := write(graph, out); err != nil {
+			return err
+		}
+
+		if graph.Truncated {
+			fmt.Fprintf(os.Stderr, "the graph was truncated at %d relationships from the subject or %d entities\n", depth, maxNodes)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/backup"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/objectstorage"
+	"github.com/Permify/permify/internal/storage"
+)
+
+const (
+	tenants = "tenant"
+	output  = "output"
+	input   = "input"
+)
+
+// NewBackupCommand - Creates new backup command
+func NewBackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "back up and restore schemas, relationships and attributes",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewBackupCreateCommand())
+	cmd.AddCommand(NewBackupRestoreCommand())
+
+	return cmd
+}
+
+// NewBackupCreateCommand - Creates new backup create command
+func NewBackupCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "write a consistent snapshot of the given tenants, or of all tenants, to a file or object storage",
+		RunE:  backupCreate(),
+		Args:  cobra.NoArgs,
+	}
+
+	// add flags to the backup create command
+	cmd.PersistentFlags().String(databaseEngine, "", "database engine")
+	cmd.PersistentFlags().String(databaseURI, "", "database URI")
+	cmd.PersistentFlags().StringSlice(tenants, []string{}, "tenants to back up, all tenants if not set")
+	cmd.PersistentFlags().String(output, "-", "file path, s3://bucket/key or gs://bucket/key to write the backup to, - for standard output")
+
+	return cmd
+}
+
+// NewBackupRestoreCommand - Creates new backup restore command
+func NewBackupRestoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore a backup into a fresh database",
+		RunE:  backupRestore(),
+		Args:  cobra.NoArgs,
+	}
+
+	// add flags to the backup restore command
+	cmd.PersistentFlags().String(databaseEngine, "", "database engine")
+	cmd.PersistentFlags().String(databaseURI, "", "database URI")
+	cmd.PersistentFlags().String(input, "-", "file path, s3://bucket/key or gs://bucket/key to read the backup from, - for standard input")
+
+	return cmd
+}
+
+// backupCreate - permify backup create command
+func backupCreate() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{databaseEngine, databaseURI, output})
+		if err != nil {
+			return err
+		}
+
+		ids, err := cmd.Flags().GetStringSlice(tenants)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		db, err := factories.DatabaseFactory(config.Database{Engine: flags[databaseEngine], URI: flags[databaseURI]})
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		w, err := objectstorage.NewWriter(ctx, flags[output])
+		if err != nil {
+			return err
+		}
+
+		err = backup.NewBackup(
+			factories.TenantReaderFactory(db),
+			factories.SchemaReaderFactory(db),
+			factories.DataReaderFactory(db),
+		).Create(ctx, ids, w)
+		if err != nil {
+			w.Abort(err)
+			return err
+		}
+
+		return w.Close()
+	}
+}
+
+// backupRestore - permify backup restore command
+func backupRestore() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{databaseEngine, databaseURI, input})
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		// Bring the target database to the latest schema before writing into it.
+		err = storage.MigrateUp(flags[databaseEngine], flags[databaseURI], config.DefaultConfig().Database.MigrationLockTimeout)
+		if err != nil {
+			return err
+		}
+
+		db, err := factories.DatabaseFactory(config.Database{Engine: flags[databaseEngine], URI: flags[databaseURI]})
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		r, err := objectstorage.NewReader(ctx, flags[input])
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		return backup.NewRestorer(
+			factories.TenantWriterFactory(db),
+			factories.SchemaWriterFactory(db),
+			factories.DataWriterFactory(db),
+		).Restore(ctx, r)
+	}
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Permify/permify/internal/objectstorage"
+	"github.com/Permify/permify/pkg/bundle"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	privateKey = "private-key"
+)
+
+// NewBundleCommand - Creates new bundle command
+func NewBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "build and sign bundles of a schema and its seed data for the ApplyBundle API",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewBundleBuildCommand())
+	cmd.AddCommand(NewBundleKeygenCommand())
+
+	return cmd
+}
+
+// NewBundleBuildCommand - Creates new bundle build command
+func NewBundleBuildCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build <location>",
+		Short: "build the body of an ApplyBundle request from a bundle file, signing it if a private key is given",
+		RunE:  bundleBuild(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	// add flags to the bundle build command
+	cmd.PersistentFlags().String(privateKey, "", "base64 encoded Ed25519 private key or seed to sign the bundle with")
+	cmd.PersistentFlags().String(output, "-", "file path, s3://bucket/key or gs://bucket/key to write the request body to, - for standard output")
+
+	return cmd
+}
+
+// NewBundleKeygenCommand - Creates new bundle keygen command
+func NewBundleKeygenCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "keygen",
+		Short: "generate an Ed25519 key pair to sign bundles with",
+		RunE:  bundleKeygen(),
+		Args:  cobra.NoArgs,
+	}
+}
+
+// bundleBuild - permify bundle build command
+func bundleBuild() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{privateKey, output})
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		r, err := objectstorage.NewReader(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+
+		f := bundle.File{}
+		if err = yaml.Unmarshal(data, &f); err != nil {
+			return err
+		}
+
+		b, err := f.Bundle()
+		if err != nil {
+			return err
+		}
+
+		request := &base.SchemaApplyBundleRequest{Bundle: b}
+		if flags[privateKey] != "" {
+			key, err := bundle.ParsePrivateKey(flags[privateKey])
+			if err != nil {
+				return err
+			}
+			request.Signature, err = bundle.Sign(b, key)
+			if err != nil {
+				return err
+			}
+		}
+
+		body, err := protojson.MarshalOptions{Multiline: true}.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = append(body, '\n')
+
+		w, err := objectstorage.NewWriter(ctx, flags[output])
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(body); err != nil {
+			w.Abort(err)
+			return err
+		}
+		return w.Close()
+	}
+}
+
+// bundleKeygen - permify bundle keygen command
+func bundleKeygen() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		public, private, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("private key: %s\n", base64.StdEncoding.EncodeToString(private.Seed()))
+		fmt.Printf("public key: %s\n", base64.StdEncoding.EncodeToString(public))
+		return nil
+	}
+}
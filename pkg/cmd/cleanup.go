@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/internal/cleanup"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	fix = "fix"
+)
+
+// NewCleanupCommand - Creates new cleanup command
+func NewCleanupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup <tenant>",
+		Short: "report the duplicate relationships and attributes of a tenant and the ones its schema does not allow, and optionally fix them",
+		RunE:  cleanupTenant(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	// add flags to the cleanup command
+	cmd.PersistentFlags().String(address, "localhost:3478", "gRPC address of the server to scan the tenant of")
+	cmd.PersistentFlags().String(apiToken, "", "preshared key or token to authenticate with")
+	cmd.PersistentFlags().Bool(useTLS, false, "connect to the server over TLS")
+	cmd.PersistentFlags().String(schemaVersion, "", "schema version to validate the relationships and attributes against, the head version of the tenant if not set")
+	cmd.PersistentFlags().String(format, "text", "format of the report, text or json")
+	cmd.PersistentFlags().Bool(fix, false, "delete the duplicate and invalid relationships and attributes, keeping a single copy of the valid ones")
+
+	return cmd
+}
+
+// cleanupTenant - permify cleanup command
+func cleanupTenant() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{address, apiToken, schemaVersion, format})
+		if err != nil {
+			return err
+		}
+		secure, err := cmd.Flags().GetBool(useTLS)
+		if err != nil {
+			return err
+		}
+		fixing, err := cmd.Flags().GetBool(fix)
+		if err != nil {
+			return err
+		}
+		if flags[format] != "text" && flags[format] != "json" {
+			return fmt.Errorf("unknown format %q, expected text or json", flags[format])
+		}
+
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.Dial(flags[address], grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if flags[apiToken] != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+		}
+
+		data := base.NewDataClient(conn)
+		report, err := cleanup.Scan(ctx, base.NewSchemaClient(conn), data, args[0], flags[schemaVersion])
+		if err != nil {
+			return err
+		}
+
+		if flags[format] == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(report); err != nil {
+				return err
+			}
+		} else {
+			for _, issue := range report.Issues {
+				if issue.Detail != "" {
+					fmt.Printf("%s: %s (%s)\n", issue.Kind, issue.Item, issue.Detail)
+					continue
+				}
+				fmt.Printf("%s: %s\n", issue.Kind, issue.Item)
+			}
+			fmt.Printf("scanned %d relationships and %d attributes of %s, found %d issues\n", report.Relationships, report.Attributes, args[0], len(report.Issues))
+		}
+
+		if !fixing {
+			return nil
+		}
+		fixed, err := cleanup.Fix(ctx, data, report)
+		if err != nil {
+			return fmt.Errorf("fixed %d of %d issues: %w", fixed, len(report.Issues), err)
+		}
+		fmt.Fprintf(os.Stderr, "fixed %d of %d issues\n", fixed, len(report.Issues))
+		return nil
+	}
+}
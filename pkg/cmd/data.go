@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/internal/relationmigration"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	entityType   = "entity"
+	relationFrom = "from"
+	relationTo   = "to"
+	batchSize    = "batch-size"
+	deleteSource = "delete-source"
+	stateFile    = "state"
+)
+
+// NewDataCommand - Creates new data command
+func NewDataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data",
+		Short: "manage the relationships and attributes of a tenant",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewDataMigrateRelationCommand())
+
+	return cmd
+}
+
+// NewDataMigrateRelationCommand - Creates new data migrate-relation command
+func NewDataMigrateRelationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-relation <tenant>",
+		Short: "rename a relation by rewriting its relationships in batches, copying them until the old relation is dropped",
+		Long: `Rename a relation by rewriting its relationships in batches, along with the relationships whose subjects are
+sets of the relation, e.g. team:1#member.
+
+The schema must define both relations while the migration runs. By default the relationships are copied to the new
+relation, so that both relations hold them: checks keep working with permissions reading either relation while the
+clients move to the new one. Once they have, run the migration again with --delete-source to move the relationships
+written to the old relation since, and delete the old relationships, after which the old relation can be removed
+from the schema.
+
+The progress is saved to the state file after every batch, and an interrupted migration resumes from it.`,
+		RunE: migrateRelation(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	// add flags to the data migrate-relation command
+	cmd.PersistentFlags().String(address, "localhost:3478", "gRPC address of the server to migrate the tenant of")
+	cmd.PersistentFlags().String(apiToken, "", "preshared key or token to authenticate with")
+	cmd.PersistentFlags().Bool(useTLS, false, "connect to the server over TLS")
+	cmd.PersistentFlags().String(entityType, "", "entity type of the relation")
+	cmd.PersistentFlags().String(relationFrom, "", "current name of the relation")
+	cmd.PersistentFlags().String(relationTo, "", "new name of the relation")
+	cmd.PersistentFlags().Int(batchSize, 100, "number of relationships rewritten at once, at most 100")
+	cmd.PersistentFlags().Bool(deleteSource, false, "delete the relationships of the old relation as they are rewritten")
+	cmd.PersistentFlags().String(stateFile, "", "file the progress is saved to and resumed from, the migration can't be resumed if not set")
+
+	return cmd
+}
+
+// migrateRelation - permify data migrate-relation command
+func migrateRelation() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{address, apiToken, entityType, relationFrom, relationTo, stateFile})
+		if err != nil {
+			return err
+		}
+		secure, err := cmd.Flags().GetBool(useTLS)
+		if err != nil {
+			return err
+		}
+		size, err := cmd.Flags().GetInt(batchSize)
+		if err != nil {
+			return err
+		}
+		deleting, err := cmd.Flags().GetBool(deleteSource)
+		if err != nil {
+			return err
+		}
+		if flags[entityType] == "" || flags[relationFrom] == "" || flags[relationTo] == "" {
+			return errors.New("--entity, --from and --to are required")
+		}
+
+		m := relationmigration.Migration{
+			TenantID:     args[0],
+			EntityType:   flags[entityType],
+			From:         flags[relationFrom],
+			To:           flags[relationTo],
+			BatchSize:    size,
+			DeleteSource: deleting,
+		}
+
+		progress := relationmigration.NewProgress(m)
+		if flags[stateFile] != "" {
+			b, err := os.ReadFile(flags[stateFile])
+			if err == nil {
+				if err = json.Unmarshal(b, progress); err != nil {
+					return fmt.Errorf("reading the state file: %w", err)
+				}
+				fmt.Fprintf(os.Stderr, "resuming the migration, %d relationships migrated\n", progress.Migrated)
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.Dial(flags[address], grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if flags[apiToken] != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+		}
+
+		err = relationmigration.Run(ctx, base.NewSchemaClient(conn), base.NewDataClient(conn), m, progress, func(p *relationmigration.Progress) error {
+			if !p.Done {
+				fmt.Fprintf(os.Stderr, "migrated %d relationships, filter %d of %d\n", p.Migrated, p.Filter+1, p.Filters())
+			}
+			if flags[stateFile] == "" {
+				return nil
+			}
+			return saveProgress(flags[stateFile], p)
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("migrated %d relationships of %s#%s to %s#%s\n", progress.Migrated, m.EntityType, m.From, m.EntityType, m.To)
+		return nil
+	}
+}
+
+// saveProgress - Replaces the state file with the progress
+func saveProgress(path string, progress *relationmigration.Progress) error {
+	b, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
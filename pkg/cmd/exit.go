@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/Permify/permify/internal/diagnostics"
+	"github.com/Permify/permify/internal/servers"
+)
+
+// Exit codes of the commands by the class of their failure, so that orchestration systems can tell a configuration
+// to fix from a dependency to wait for or a failure to restart on
+const (
+	ExitFailure     = 1 // Failures of any other class
+	ExitConfig      = 2 // The configuration is invalid
+	ExitDiagnostics = 3 // A startup check of the databases, the certificates or the OIDC issuer failed
+	ExitStart       = 4 // A server failed to start, e.g. on a port already in use
+	ExitServe       = 5 // A server failed while serving
+	ExitShutdown    = 6 // A server failed to stop gracefully
+)
+
+// configError - Error of an invalid configuration
+type configError struct {
+	err error
+}
+
+func (e configError) Error() string { return e.err.Error() }
+
+func (e configError) Unwrap() error { return e.err }
+
+// invalidConfig marks the error as the one of an invalid configuration.
+func invalidConfig(err error) error {
+	return configError{err: err}
+}
+
+// ExitCode - Returns the exit code of the class of the error, 0 if it is nil. The classes are checked in the order
+// of the exit codes, so that the error a failure caused the others with, e.g. a server failing to stop after
+// another failed while serving, decides the exit code.
+func ExitCode(err error) int {
+	var (
+		invalid configError
+		start   *servers.StartError
+		serve   *servers.ServeError
+		stop    *servers.StopError
+	)
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &invalid):
+		return ExitConfig
+	case errors.Is(err, diagnostics.ErrFailed):
+		return ExitDiagnostics
+	case errors.As(err, &start):
+		return ExitStart
+	case errors.As(err, &serve):
+		return ExitServe
+	case errors.As(err, &stop):
+		return ExitShutdown
+	default:
+		return ExitFailure
+	}
+}
@@ -21,6 +21,15 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	// Profile
+	flags.String("profile", conf.Profile, "profile the settings exposing the internals of the server default by: dev or prod")
+	if err = viper.BindPFlag("profile", flags.Lookup("profile")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("profile", "PERMIFY_PROFILE"); err != nil {
+		panic(err)
+	}
+
 	// Server
 	flags.Int64("server-rate-limit", conf.Server.RateLimit, "the maximum number of requests the server should handle per second")
 	if err = viper.BindPFlag("server.rate_limit", flags.Lookup("server-rate-limit")); err != nil {
@@ -30,6 +39,70 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.Bool("server-rate-limit-redis-enabled", conf.Server.RateLimitRedis.Enabled, "switch option for sharing the rate limit of the replicas through redis")
+	if err = viper.BindPFlag("server.rate_limit_redis.enabled", flags.Lookup("server-rate-limit-redis-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.enabled", "PERMIFY_RATE_LIMIT_REDIS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("server-rate-limit-redis-address", conf.Server.RateLimitRedis.Address, "address of the redis server the rate limit is shared through")
+	if err = viper.BindPFlag("server.rate_limit_redis.address", flags.Lookup("server-rate-limit-redis-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.address", "PERMIFY_RATE_LIMIT_REDIS_ADDRESS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("server-rate-limit-redis-username", conf.Server.RateLimitRedis.Username, "username to authenticate to the redis server with")
+	if err = viper.BindPFlag("server.rate_limit_redis.username", flags.Lookup("server-rate-limit-redis-username")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.username", "PERMIFY_RATE_LIMIT_REDIS_USERNAME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("server-rate-limit-redis-password", conf.Server.RateLimitRedis.Password, "password to authenticate to the redis server with")
+	if err = viper.BindPFlag("server.rate_limit_redis.password", flags.Lookup("server-rate-limit-redis-password")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.password", "PERMIFY_RATE_LIMIT_REDIS_PASSWORD"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("server-rate-limit-redis-db", conf.Server.RateLimitRedis.DB, "redis database the rate limit bucket is stored in")
+	if err = viper.BindPFlag("server.rate_limit_redis.db", flags.Lookup("server-rate-limit-redis-db")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.db", "PERMIFY_RATE_LIMIT_REDIS_DB"); err != nil {
+		panic(err)
+	}
+
+	flags.String("server-rate-limit-redis-key", conf.Server.RateLimitRedis.Key, "redis key the rate limit bucket is stored at, shared by the replicas")
+	if err = viper.BindPFlag("server.rate_limit_redis.key", flags.Lookup("server-rate-limit-redis-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.key", "PERMIFY_RATE_LIMIT_REDIS_KEY"); err != nil {
+		panic(err)
+	}
+
+	flags.Int64("server-rate-limit-redis-replicas", conf.Server.RateLimitRedis.Replicas, "number of replicas sharing the rate limit, each limited to an even share of it while redis can't be reached")
+	if err = viper.BindPFlag("server.rate_limit_redis.replicas", flags.Lookup("server-rate-limit-redis-replicas")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit_redis.replicas", "PERMIFY_RATE_LIMIT_REDIS_REPLICAS"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("server-verbose-errors", conf.Server.VerboseErrors, "keep the underlying details of internal failures in their errors")
+	if err = viper.BindPFlag("server.verbose_errors", flags.Lookup("server-verbose-errors")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.verbose_errors", "PERMIFY_VERBOSE_ERRORS"); err != nil {
+		panic(err)
+	}
+
 	// GRPC Server
 	flags.String("grpc-port", conf.Server.GRPC.Port, "port that GRPC server run on")
 	if err = viper.BindPFlag("server.grpc.port", flags.Lookup("grpc-port")); err != nil {
@@ -39,6 +112,38 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.StringSlice("grpc-addresses", conf.Server.GRPC.Addresses, "addresses the GRPC server listens on as host:port, all the interfaces on the grpc port if empty")
+	if err = viper.BindPFlag("server.grpc.addresses", flags.Lookup("grpc-addresses")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.grpc.addresses", "PERMIFY_GRPC_ADDRESSES"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("grpc-reuse-port", conf.Server.GRPC.ReusePort, "open the GRPC listeners with SO_REUSEPORT, so that a new process can listen before the previous one stops")
+	if err = viper.BindPFlag("server.grpc.reuse_port", flags.Lookup("grpc-reuse-port")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.grpc.reuse_port", "PERMIFY_GRPC_REUSE_PORT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("grpc-socket-activation", conf.Server.GRPC.SocketActivation, "serve GRPC on the sockets passed by systemd socket activation")
+	if err = viper.BindPFlag("server.grpc.socket_activation", flags.Lookup("grpc-socket-activation")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.grpc.socket_activation", "PERMIFY_GRPC_SOCKET_ACTIVATION"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("grpc-reflection", conf.Server.GRPC.Reflection, "serve the GRPC reflection service")
+	if err = viper.BindPFlag("server.grpc.reflection", flags.Lookup("grpc-reflection")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.grpc.reflection", "PERMIFY_GRPC_REFLECTION"); err != nil {
+		panic(err)
+	}
+
 	flags.Bool("grpc-tls-enabled", conf.Server.GRPC.TLSConfig.Enabled, "switch option for GRPC tls server")
 	if err = viper.BindPFlag("server.grpc.tls.enabled", flags.Lookup("grpc-tls-enabled")); err != nil {
 		panic(err)
@@ -72,6 +177,14 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
+	flags.String("http-address", conf.Server.HTTP.Address, "address the HTTP server binds to, all the interfaces if not set")
+	if err = viper.BindPFlag("server.http.address", flags.Lookup("http-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.http.address", "PERMIFY_HTTP_ADDRESS"); err != nil {
+		panic(err)
+	}
+
 	flags.String("http-port", conf.Server.HTTP.Port, "HTTP port address")
 	if err = viper.BindPFlag("server.http.port", flags.Lookup("http-port")); err != nil {
 		panic(err)
@@ -112,291 +225,1639 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 
-	// PROFILER
-	flags.Bool("profiler-enabled", conf.Profiler.Enabled, "switch option for profiler")
-	if err = viper.BindPFlag("profiler.enabled", flags.Lookup("profiler-enabled")); err != nil {
+	flags.StringSlice("http-cors-allowed-origin-patterns", conf.Server.HTTP.CORSAllowedOriginPatterns, "CORS allowed origin regular expressions for http gateway")
+	if err = viper.BindPFlag("server.http.cors_allowed_origin_patterns", flags.Lookup("http-cors-allowed-origin-patterns")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("profiler.enabled", "PERMIFY_PROFILER_ENABLED"); err != nil {
+	if err = viper.BindEnv("server.http.cors_allowed_origin_patterns", "PERMIFY_HTTP_CORS_ALLOWED_ORIGIN_PATTERNS"); err != nil {
 		panic(err)
 	}
 
-	flags.String("profiler-port", conf.Profiler.Port, "profiler port address")
-	if err = viper.BindPFlag("profiler.port", flags.Lookup("profiler-port")); err != nil {
+	flags.Int("http-cors-max-age", conf.Server.HTTP.CORSMaxAge, "seconds CORS preflight responses of http gateway may be cached for")
+	if err = viper.BindPFlag("server.http.cors_max_age", flags.Lookup("http-cors-max-age")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("profiler.port", "PERMIFY_PROFILER_PORT"); err != nil {
+	if err = viper.BindEnv("server.http.cors_max_age", "PERMIFY_HTTP_CORS_MAX_AGE"); err != nil {
 		panic(err)
 	}
 
-	// LOG
-	flags.String("log-level", conf.Log.Level, "real time logs of authorization. Permify uses zerolog as a logger")
-	if err = viper.BindPFlag("logger.level", flags.Lookup("log-level")); err != nil {
+	flags.Duration("http-read-timeout", conf.Server.HTTP.ReadTimeout, "maximum duration for reading an entire request, 0 for no limit")
+	if err = viper.BindPFlag("server.http.read_timeout", flags.Lookup("http-read-timeout")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("logger.level", "PERMIFY_LOG_LEVEL"); err != nil {
+	if err = viper.BindEnv("server.http.read_timeout", "PERMIFY_HTTP_READ_TIMEOUT"); err != nil {
 		panic(err)
 	}
 
-	// AUTHN
-	flags.Bool("authn-enabled", conf.Authn.Enabled, "enable server authentication")
-	if err = viper.BindPFlag("authn.enabled", flags.Lookup("authn-enabled")); err != nil {
+	flags.Duration("http-read-header-timeout", conf.Server.HTTP.ReadHeaderTimeout, "maximum duration for reading the headers of a request")
+	if err = viper.BindPFlag("server.http.read_header_timeout", flags.Lookup("http-read-header-timeout")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.enabled", "PERMIFY_AUTHN_ENABLED"); err != nil {
+	if err = viper.BindEnv("server.http.read_header_timeout", "PERMIFY_HTTP_READ_HEADER_TIMEOUT"); err != nil {
 		panic(err)
 	}
 
-	flags.String("authn-method", conf.Authn.Method, "server authentication method")
-	if err = viper.BindPFlag("authn.method", flags.Lookup("authn-method")); err != nil {
+	flags.Duration("http-write-timeout", conf.Server.HTTP.WriteTimeout, "maximum duration before timing out the writes of a response, 0 for no limit")
+	if err = viper.BindPFlag("server.http.write_timeout", flags.Lookup("http-write-timeout")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.method", "PERMIFY_AUTHN_METHOD"); err != nil {
+	if err = viper.BindEnv("server.http.write_timeout", "PERMIFY_HTTP_WRITE_TIMEOUT"); err != nil {
 		panic(err)
 	}
 
-	flags.StringSlice("authn-preshared-keys", conf.Authn.Preshared.Keys, "preshared key/keys for server authentication")
-	if err = viper.BindPFlag("authn.preshared.keys", flags.Lookup("authn-preshared-keys")); err != nil {
+	flags.Duration("http-idle-timeout", conf.Server.HTTP.IdleTimeout, "maximum duration to wait for the next request on a keep-alive connection")
+	if err = viper.BindPFlag("server.http.idle_timeout", flags.Lookup("http-idle-timeout")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.preshared.keys", "PERMIFY_AUTHN_PRESHARED_KEYS"); err != nil {
+	if err = viper.BindEnv("server.http.idle_timeout", "PERMIFY_HTTP_IDLE_TIMEOUT"); err != nil {
 		panic(err)
 	}
 
-	flags.String("authn-oidc-issuer", conf.Authn.Oidc.Issuer, "issuer identifier of the OpenID Connect Provider")
-	if err = viper.BindPFlag("authn.oidc.issuer", flags.Lookup("authn-oidc-issuer")); err != nil {
+	flags.Int("http-max-header-bytes", conf.Server.HTTP.MaxHeaderBytes, "maximum size of the headers of a request")
+	if err = viper.BindPFlag("server.http.max_header_bytes", flags.Lookup("http-max-header-bytes")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.issuer", "PERMIFY_AUTHN_OIDC_ISSUER"); err != nil {
+	if err = viper.BindEnv("server.http.max_header_bytes", "PERMIFY_HTTP_MAX_HEADER_BYTES"); err != nil {
 		panic(err)
 	}
 
-	flags.String("authn-oidc-client-id", conf.Authn.Oidc.ClientID, "client ID which requested the token from OIDC issuer")
-	if err = viper.BindPFlag("authn.oidc.client_id", flags.Lookup("authn-oidc-client-id")); err != nil {
+	flags.Bool("http-openapi-enabled", conf.Server.HTTP.OpenAPIEnabled, "serve the OpenAPI specification and Swagger UI from the http server")
+	if err = viper.BindPFlag("server.http.openapi_enabled", flags.Lookup("http-openapi-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("authn.oidc.client_id", "PERMIFY_AUTHN_OIDC_CLIENT_ID"); err != nil {
+	if err = viper.BindEnv("server.http.openapi_enabled", "PERMIFY_HTTP_OPENAPI_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	// TRACER
-	flags.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
-	if err = viper.BindPFlag("tracer.enabled", flags.Lookup("tracer-enabled")); err != nil {
+	flags.Bool("http-http3-enabled", conf.Server.HTTP.HTTP3Enabled, "also serve the http server over HTTP/3 on the UDP port of the same number, advertised with Alt-Svc")
+	if err = viper.BindPFlag("server.http.http3_enabled", flags.Lookup("http-http3-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("tracer.enabled", "PERMIFY_TRACER_ENABLED"); err != nil {
+	if err = viper.BindEnv("server.http.http3_enabled", "PERMIFY_HTTP_HTTP3_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.String("tracer-exporter", conf.Tracer.Exporter, "export uri for tracing data")
-	if err = viper.BindPFlag("tracer.exporter", flags.Lookup("tracer-exporter")); err != nil {
+	// Autocert
+	flags.Bool("server-autocert-enabled", conf.Server.Autocert.Enabled, "obtain and renew the certificates of the HTTP and GRPC servers automatically through ACME")
+	if err = viper.BindPFlag("server.autocert.enabled", flags.Lookup("server-autocert-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("tracer.exporter", "PERMIFY_TRACER_EXPORTER"); err != nil {
+	if err = viper.BindEnv("server.autocert.enabled", "PERMIFY_AUTOCERT_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.String("tracer-endpoint", conf.Tracer.Endpoint, "can be; jaeger, signoz, zipkin or otlp. (integrated tracing tools)")
-	if err = viper.BindPFlag("tracer.endpoint", flags.Lookup("tracer-endpoint")); err != nil {
+	flags.StringSlice("server-autocert-hostnames", conf.Server.Autocert.Hostnames, "hostnames the certificates are obtained for")
+	if err = viper.BindPFlag("server.autocert.hostnames", flags.Lookup("server-autocert-hostnames")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("tracer.endpoint", "PERMIFY_TRACER_ENDPOINT"); err != nil {
+	if err = viper.BindEnv("server.autocert.hostnames", "PERMIFY_AUTOCERT_HOSTNAMES"); err != nil {
 		panic(err)
 	}
 
-	// METER
-	flags.Bool("meter-enabled", conf.Meter.Enabled, "switch option for metric")
-	if err = viper.BindPFlag("meter.enabled", flags.Lookup("meter-enabled")); err != nil {
+	flags.String("server-autocert-email", conf.Server.Autocert.Email, "contact email of the ACME account, notified of the problems with the certificates")
+	if err = viper.BindPFlag("server.autocert.email", flags.Lookup("server-autocert-email")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("meter.enabled", "PERMIFY_METER_ENABLED"); err != nil {
+	if err = viper.BindEnv("server.autocert.email", "PERMIFY_AUTOCERT_EMAIL"); err != nil {
 		panic(err)
 	}
 
-	flags.String("meter-exporter", conf.Meter.Exporter, "export uri for metric data")
-	if err = viper.BindPFlag("meter.exporter", flags.Lookup("meter-exporter")); err != nil {
+	flags.String("server-autocert-directory-url", conf.Server.Autocert.DirectoryURL, "directory URL of the ACME certificate authority, Let's Encrypt if not set")
+	if err = viper.BindPFlag("server.autocert.directory_url", flags.Lookup("server-autocert-directory-url")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("meter.exporter", "PERMIFY_METER_EXPORTER"); err != nil {
+	if err = viper.BindEnv("server.autocert.directory_url", "PERMIFY_AUTOCERT_DIRECTORY_URL"); err != nil {
 		panic(err)
 	}
 
-	flags.String("meter-endpoint", conf.Meter.Endpoint, "can be; otlp. (integrated metric tools)")
-	if err = viper.BindPFlag("meter.endpoint", flags.Lookup("meter-endpoint")); err != nil {
+	flags.String("server-autocert-cache", conf.Server.Autocert.Cache, "where the certificates are kept, dir or database")
+	if err = viper.BindPFlag("server.autocert.cache", flags.Lookup("server-autocert-cache")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("meter.endpoint", "PERMIFY_METER_ENDPOINT"); err != nil {
+	if err = viper.BindEnv("server.autocert.cache", "PERMIFY_AUTOCERT_CACHE"); err != nil {
 		panic(err)
 	}
 
-	// SERVICE
-	flags.Bool("service-circuit-breaker", conf.Service.CircuitBreaker, "switch option for service circuit breaker")
-	if err = viper.BindPFlag("service.circuit_breaker", flags.Lookup("service-circuit-breaker")); err != nil {
+	flags.String("server-autocert-cache-dir", conf.Server.Autocert.CacheDir, "directory the certificates are kept in with the dir cache")
+	if err = viper.BindPFlag("server.autocert.cache_dir", flags.Lookup("server-autocert-cache-dir")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.circuit_breaker", "PERMIFY_SERVICE_CIRCUIT_BREAKER"); err != nil {
+	if err = viper.BindEnv("server.autocert.cache_dir", "PERMIFY_AUTOCERT_CACHE_DIR"); err != nil {
 		panic(err)
 	}
 
-	flags.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
-	if err = viper.BindPFlag("service.watch.enabled", flags.Lookup("service-watch-enabled")); err != nil {
+	flags.String("server-autocert-challenge-address", conf.Server.Autocert.ChallengeAddress, "address of the server answering the HTTP-01 challenges, e.g. :80, not served if not set")
+	if err = viper.BindPFlag("server.autocert.challenge_address", flags.Lookup("server-autocert-challenge-address")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.watch.enabled", "PERMIFY_SERVICE_WATCH_ENABLED"); err != nil {
+	if err = viper.BindEnv("server.autocert.challenge_address", "PERMIFY_AUTOCERT_CHALLENGE_ADDRESS"); err != nil {
 		panic(err)
 	}
 
-	flags.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
-	if err = viper.BindPFlag("service.schema.cache.number_of_counters", flags.Lookup("service-schema-cache-number-of-counters")); err != nil {
+	// Envoy External Authorization
+	flags.Bool("server-ext-authz-enabled", conf.Server.ExtAuthz.Enabled, "serve the Envoy external authorization API on the GRPC server")
+	if err = viper.BindPFlag("server.ext_authz.enabled", flags.Lookup("server-ext-authz-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.schema.cache.number_of_counters", "PERMIFY_SERVICE_SCHEMA_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+	if err = viper.BindEnv("server.ext_authz.enabled", "PERMIFY_EXT_AUTHZ_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.String("service-schema-cache-max-cost", conf.Service.Schema.Cache.MaxCost, "schema service cache max cost")
-	if err = viper.BindPFlag("service.schema.cache.max_cost", flags.Lookup("service-schema-cache-max-cost")); err != nil {
+	flags.String("server-ext-authz-tenant", conf.Server.ExtAuthz.Tenant, "tenant of the external authorization checks of the rules not setting one")
+	if err = viper.BindPFlag("server.ext_authz.tenant", flags.Lookup("server-ext-authz-tenant")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.schema.cache.max_cost", "PERMIFY_SERVICE_SCHEMA_CACHE_MAX_COST"); err != nil {
+	if err = viper.BindEnv("server.ext_authz.tenant", "PERMIFY_EXT_AUTHZ_TENANT"); err != nil {
 		panic(err)
 	}
 
-	flags.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
-	if err = viper.BindPFlag("service.permission.concurrency_limit", flags.Lookup("service-permission-concurrency-limit")); err != nil {
+	flags.Int32("server-ext-authz-depth", conf.Server.ExtAuthz.Depth, "depth of the external authorization checks")
+	if err = viper.BindPFlag("server.ext_authz.depth", flags.Lookup("server-ext-authz-depth")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.permission.concurrency_limit", "PERMIFY_SERVICE_PERMISSION_CONCURRENCY_LIMIT"); err != nil {
+	if err = viper.BindEnv("server.ext_authz.depth", "PERMIFY_EXT_AUTHZ_DEPTH"); err != nil {
 		panic(err)
 	}
 
-	flags.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
-	if err = viper.BindPFlag("service.permission.cache.number_of_counters", flags.Lookup("service-permission-cache-number-of-counters")); err != nil {
+	flags.Bool("server-ext-authz-allow-unmatched", conf.Server.ExtAuthz.AllowUnmatched, "allow the requests no external authorization rule matches, instead of denying them")
+	if err = viper.BindPFlag("server.ext_authz.allow_unmatched", flags.Lookup("server-ext-authz-allow-unmatched")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.permission.cache.number_of_counters", "PERMIFY_SERVICE_PERMISSION_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+	if err = viper.BindEnv("server.ext_authz.allow_unmatched", "PERMIFY_EXT_AUTHZ_ALLOW_UNMATCHED"); err != nil {
 		panic(err)
 	}
 
-	flags.String("service-permission-cache-max-cost", conf.Service.Permission.Cache.MaxCost, "permission service cache max cost")
-	if err = viper.BindPFlag("service.permission.cache.max_cost", flags.Lookup("service-permission-cache-max-cost")); err != nil {
+	// Gateway
+	flags.Bool("gateway-enabled", conf.Server.Gateway.Enabled, "run only the http gateway, forwarding requests to remote grpc servers")
+	if err = viper.BindPFlag("server.gateway.enabled", flags.Lookup("gateway-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("service.permission.cache.max_cost", "PERMIFY_SERVICE_PERMISSION_CACHE_MAX_COST"); err != nil {
+	if err = viper.BindEnv("server.gateway.enabled", "PERMIFY_GATEWAY_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	// DATABASE
-	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
-	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
+	flags.StringSlice("gateway-targets", conf.Server.Gateway.Targets, "grpc servers the http gateway forwards requests to")
+	if err = viper.BindPFlag("server.gateway.targets", flags.Lookup("gateway-targets")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.engine", "PERMIFY_DATABASE_ENGINE"); err != nil {
+	if err = viper.BindEnv("server.gateway.targets", "PERMIFY_GATEWAY_TARGETS"); err != nil {
 		panic(err)
 	}
 
-	flags.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
-	if err = viper.BindPFlag("database.uri", flags.Lookup("database-uri")); err != nil {
+	flags.String("gateway-load-balancing", conf.Server.Gateway.LoadBalancing, "load balancing policy across the gateway targets, round_robin or pick_first")
+	if err = viper.BindPFlag("server.gateway.load_balancing", flags.Lookup("gateway-load-balancing")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.uri", "PERMIFY_DATABASE_URI"); err != nil {
+	if err = viper.BindEnv("server.gateway.load_balancing", "PERMIFY_GATEWAY_LOAD_BALANCING"); err != nil {
 		panic(err)
 	}
 
-	flags.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
-	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
+	flags.Bool("gateway-tls-enabled", conf.Server.Gateway.TLSConfig.Enabled, "switch option for tls connections to the gateway targets")
+	if err = viper.BindPFlag("server.gateway.tls.enabled", flags.Lookup("gateway-tls-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.auto_migrate", "PERMIFY_DATABASE_AUTO_MIGRATE"); err != nil {
+	if err = viper.BindEnv("server.gateway.tls.enabled", "PERMIFY_GATEWAY_TLS_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "maximum number of parallel connections that can be made to the database at any time")
-	if err = viper.BindPFlag("database.max_open_connections", flags.Lookup("database-max-open-connections")); err != nil {
+	flags.String("gateway-tls-cert-path", conf.Server.Gateway.TLSConfig.CertPath, "certificate path used to verify the gateway targets")
+	if err = viper.BindPFlag("server.gateway.tls.cert", flags.Lookup("gateway-tls-cert-path")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_open_connections", "PERMIFY_DATABASE_MAX_OPEN_CONNECTIONS"); err != nil {
+	if err = viper.BindEnv("server.gateway.tls.cert", "PERMIFY_GATEWAY_TLS_CERT_PATH"); err != nil {
 		panic(err)
 	}
 
-	flags.Int("database-max-idle-connections", conf.Database.MaxIdleConnections, "maximum number of idle connections that can be made to the database at any time")
-	if err = viper.BindPFlag("database.max_idle_connections", flags.Lookup("database-max-idle-connections")); err != nil {
+	// PROFILER
+	flags.Bool("profiler-enabled", conf.Profiler.Enabled, "switch option for profiler")
+	if err = viper.BindPFlag("profiler.enabled", flags.Lookup("profiler-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_idle_connections", "PERMIFY_DATABASE_MAX_IDLE_CONNECTIONS"); err != nil {
+	if err = viper.BindEnv("profiler.enabled", "PERMIFY_PROFILER_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.Duration("database-max-connection-lifetime", conf.Database.MaxConnectionLifetime, "maximum amount of time a connection may be reused")
-	if err = viper.BindPFlag("database.max_connection_lifetime", flags.Lookup("database-max-connection-lifetime")); err != nil {
+	flags.String("profiler-address", conf.Profiler.Address, "address the profiler binds to, all the interfaces if not set")
+	if err = viper.BindPFlag("profiler.address", flags.Lookup("profiler-address")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_connection_lifetime", "PERMIFY_DATABASE_MAX_CONNECTION_LIFETIME"); err != nil {
+	if err = viper.BindEnv("profiler.address", "PERMIFY_PROFILER_ADDRESS"); err != nil {
 		panic(err)
 	}
 
-	flags.Duration("database-max-connection-idle-time", conf.Database.MaxConnectionIdleTime, "maximum amount of time a connection may be idle")
-	if err = viper.BindPFlag("database.max_connection_idle_time", flags.Lookup("database-max-connection-idle-time")); err != nil {
+	flags.String("profiler-port", conf.Profiler.Port, "profiler port address")
+	if err = viper.BindPFlag("profiler.port", flags.Lookup("profiler-port")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_connection_idle_time", "PERMIFY_DATABASE_MAX_CONNECTION_IDLE_TIME"); err != nil {
+	if err = viper.BindEnv("profiler.port", "PERMIFY_PROFILER_PORT"); err != nil {
 		panic(err)
 	}
 
-	flags.Bool("database-garbage-collection-enabled", conf.Database.GarbageCollection.Enabled, "use database garbage collection for expired relationships and attributes")
-	if err = viper.BindPFlag("database.garbage_collection.enabled", flags.Lookup("database-garbage-collection-enabled")); err != nil {
+	flags.String("profiler-auth-method", conf.Profiler.Auth.Method, "authentication of the profiler: basic or preshared, none if not set")
+	if err = viper.BindPFlag("profiler.auth.method", flags.Lookup("profiler-auth-method")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.garbage_collection.enabled", "PERMIFY_DATABASE_GARBAGE_COLLECTION_ENABLED"); err != nil {
+	if err = viper.BindEnv("profiler.auth.method", "PERMIFY_PROFILER_AUTH_METHOD"); err != nil {
 		panic(err)
 	}
 
-	flags.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
-	if err = viper.BindPFlag("database.garbage_collection.interval", flags.Lookup("database-garbage-collection-interval")); err != nil {
+	flags.String("profiler-auth-basic-auth-user", conf.Profiler.Auth.BasicAuthUser, "user of the basic authentication of the profiler")
+	if err = viper.BindPFlag("profiler.auth.basic_auth_user", flags.Lookup("profiler-auth-basic-auth-user")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.garbage_collection.interval", "PERMIFY_DATABASE_GARBAGE_COLLECTION_INTERVAL"); err != nil {
+	if err = viper.BindEnv("profiler.auth.basic_auth_user", "PERMIFY_PROFILER_AUTH_BASIC_AUTH_USER"); err != nil {
 		panic(err)
 	}
 
-	flags.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
-	if err = viper.BindPFlag("database.garbage_collection.timeout", flags.Lookup("database-garbage-collection-timeout")); err != nil {
+	flags.String("profiler-auth-basic-auth-password", conf.Profiler.Auth.BasicAuthPassword, "password of the basic authentication of the profiler")
+	if err = viper.BindPFlag("profiler.auth.basic_auth_password", flags.Lookup("profiler-auth-basic-auth-password")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.garbage_collection.timeout", "PERMIFY_DATABASE_GARBAGE_COLLECTION_TIMEOUT"); err != nil {
+	if err = viper.BindEnv("profiler.auth.basic_auth_password", "PERMIFY_PROFILER_AUTH_BASIC_AUTH_PASSWORD"); err != nil {
 		panic(err)
 	}
 
-	flags.Duration("database-garbage-collection-window", conf.Database.GarbageCollection.Window, "window for database garbage collection")
-	if err = viper.BindPFlag("database.garbage_collection.window", flags.Lookup("database-garbage-collection-window")); err != nil {
+	flags.StringSlice("profiler-auth-keys", conf.Profiler.Auth.Keys, "keys accepted as bearer tokens by the preshared authentication of the profiler")
+	if err = viper.BindPFlag("profiler.auth.keys", flags.Lookup("profiler-auth-keys")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.garbage_collection.window", "PERMIFY_DATABASE_GARBAGE_COLLECTION_WINDOW"); err != nil {
+	if err = viper.BindEnv("profiler.auth.keys", "PERMIFY_PROFILER_AUTH_KEYS"); err != nil {
 		panic(err)
 	}
 
-	// Distributed
-	flags.Bool("distributed-enabled", conf.Distributed.Enabled, "enable distributed")
-	if err = viper.BindPFlag("distributed.enabled", flags.Lookup("distributed-enabled")); err != nil {
+	flags.Bool("profiler-push-enabled", conf.Profiler.Push.Enabled, "push the profiles of the node to a pyroscope server continuously")
+	if err = viper.BindPFlag("profiler.push.enabled", flags.Lookup("profiler-push-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("distributed.enabled", "PERMIFY_DISTRIBUTED_ENABLED"); err != nil {
+	if err = viper.BindEnv("profiler.push.enabled", "PERMIFY_PROFILER_PUSH_ENABLED"); err != nil {
 		panic(err)
 	}
 
-	flags.String("distributed-address", conf.Distributed.Address, "distributed address")
-	if err = viper.BindPFlag("distributed.address", flags.Lookup("distributed-address")); err != nil {
+	flags.String("profiler-push-endpoint", conf.Profiler.Push.Endpoint, "address of the pyroscope server the profiles are pushed to")
+	if err = viper.BindPFlag("profiler.push.endpoint", flags.Lookup("profiler-push-endpoint")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("distributed.address", "PERMIFY_DISTRIBUTED_ADDRESS"); err != nil {
+	if err = viper.BindEnv("profiler.push.endpoint", "PERMIFY_PROFILER_PUSH_ENDPOINT"); err != nil {
 		panic(err)
 	}
 
-	flags.String("distributed-port", conf.Distributed.Port, "distributed port")
-	if err = viper.BindPFlag("distributed.port", flags.Lookup("distributed-port")); err != nil {
+	flags.String("profiler-push-application-name", conf.Profiler.Push.ApplicationName, "name of the application the profiles are pushed as")
+	if err = viper.BindPFlag("profiler.push.application_name", flags.Lookup("profiler-push-application-name")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("distributed.port", "PERMIFY_DISTRIBUTED_PORT"); err != nil {
+	if err = viper.BindEnv("profiler.push.application_name", "PERMIFY_PROFILER_PUSH_APPLICATION_NAME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("profiler-push-basic-auth-user", conf.Profiler.Push.BasicAuthUser, "user to authenticate to the pyroscope server with")
+	if err = viper.BindPFlag("profiler.push.basic_auth_user", flags.Lookup("profiler-push-basic-auth-user")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("profiler.push.basic_auth_user", "PERMIFY_PROFILER_PUSH_BASIC_AUTH_USER"); err != nil {
+		panic(err)
+	}
+
+	flags.String("profiler-push-basic-auth-password", conf.Profiler.Push.BasicAuthPassword, "password to authenticate to the pyroscope server with")
+	if err = viper.BindPFlag("profiler.push.basic_auth_password", flags.Lookup("profiler-push-basic-auth-password")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("profiler.push.basic_auth_password", "PERMIFY_PROFILER_PUSH_BASIC_AUTH_PASSWORD"); err != nil {
+		panic(err)
+	}
+
+	// LOG
+	flags.String("log-level", conf.Log.Level, "real time logs of authorization. Permify uses zerolog as a logger")
+	if err = viper.BindPFlag("logger.level", flags.Lookup("log-level")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("logger.level", "PERMIFY_LOG_LEVEL"); err != nil {
+		panic(err)
+	}
+
+	flags.String("log-redaction-mode", conf.Log.Redaction.Mode, "redaction of the identifiers of entities and subjects from logs, traces and error messages: none, hash or truncate")
+	if err = viper.BindPFlag("logger.redaction.mode", flags.Lookup("log-redaction-mode")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("logger.redaction.mode", "PERMIFY_LOG_REDACTION_MODE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("log-redaction-salt", conf.Log.Redaction.Salt, "salt identifiers are hashed with")
+	if err = viper.BindPFlag("logger.redaction.salt", flags.Lookup("log-redaction-salt")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("logger.redaction.salt", "PERMIFY_LOG_REDACTION_SALT"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("log-redaction-length", conf.Log.Redaction.Length, "number of characters truncated identifiers keep")
+	if err = viper.BindPFlag("logger.redaction.length", flags.Lookup("log-redaction-length")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("logger.redaction.length", "PERMIFY_LOG_REDACTION_LENGTH"); err != nil {
+		panic(err)
+	}
+
+	// AUTHN
+	flags.Bool("authn-enabled", conf.Authn.Enabled, "enable server authentication")
+	if err = viper.BindPFlag("authn.enabled", flags.Lookup("authn-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.enabled", "PERMIFY_AUTHN_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("authn-method", conf.Authn.Method, "server authentication method")
+	if err = viper.BindPFlag("authn.method", flags.Lookup("authn-method")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.method", "PERMIFY_AUTHN_METHOD"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("authn-preshared-keys", conf.Authn.Preshared.Keys, "preshared key/keys for server authentication")
+	if err = viper.BindPFlag("authn.preshared.keys", flags.Lookup("authn-preshared-keys")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.preshared.keys", "PERMIFY_AUTHN_PRESHARED_KEYS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("authn-oidc-issuer", conf.Authn.Oidc.Issuer, "issuer identifier of the OpenID Connect Provider")
+	if err = viper.BindPFlag("authn.oidc.issuer", flags.Lookup("authn-oidc-issuer")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.issuer", "PERMIFY_AUTHN_OIDC_ISSUER"); err != nil {
+		panic(err)
+	}
+
+	flags.String("authn-oidc-client-id", conf.Authn.Oidc.ClientID, "client ID which requested the token from OIDC issuer")
+	if err = viper.BindPFlag("authn.oidc.client_id", flags.Lookup("authn-oidc-client-id")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.client_id", "PERMIFY_AUTHN_OIDC_CLIENT_ID"); err != nil {
+		panic(err)
+	}
+
+	flags.String("authn-spiffe-trust-domain", conf.Authn.Spiffe.TrustDomain, "trust domain of the SPIFFE IDs allowed to call the server")
+	if err = viper.BindPFlag("authn.spiffe.trust_domain", flags.Lookup("authn-spiffe-trust-domain")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.spiffe.trust_domain", "PERMIFY_AUTHN_SPIFFE_TRUST_DOMAIN"); err != nil {
+		panic(err)
+	}
+
+	flags.String("authn-spiffe-bundle-path", conf.Authn.Spiffe.BundlePath, "path to the SPIFFE bundle of the trust domain")
+	if err = viper.BindPFlag("authn.spiffe.bundle_path", flags.Lookup("authn-spiffe-bundle-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.spiffe.bundle_path", "PERMIFY_AUTHN_SPIFFE_BUNDLE_PATH"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("authn-spiffe-allowed-ids", conf.Authn.Spiffe.AllowedIDs, "patterns of the SPIFFE IDs allowed to call the server")
+	if err = viper.BindPFlag("authn.spiffe.allowed_ids", flags.Lookup("authn-spiffe-allowed-ids")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.spiffe.allowed_ids", "PERMIFY_AUTHN_SPIFFE_ALLOWED_IDS"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("authn-spiffe-audiences", conf.Authn.Spiffe.Audiences, "audiences accepted in JWT-SVIDs")
+	if err = viper.BindPFlag("authn.spiffe.audiences", flags.Lookup("authn-spiffe-audiences")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.spiffe.audiences", "PERMIFY_AUTHN_SPIFFE_AUDIENCES"); err != nil {
+		panic(err)
+	}
+
+	// TRACER
+	flags.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
+	if err = viper.BindPFlag("tracer.enabled", flags.Lookup("tracer-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("tracer.enabled", "PERMIFY_TRACER_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("tracer-exporter", conf.Tracer.Exporter, "export uri for tracing data")
+	if err = viper.BindPFlag("tracer.exporter", flags.Lookup("tracer-exporter")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("tracer.exporter", "PERMIFY_TRACER_EXPORTER"); err != nil {
+		panic(err)
+	}
+
+	flags.String("tracer-endpoint", conf.Tracer.Endpoint, "can be; jaeger, signoz, zipkin or otlp. (integrated tracing tools)")
+	if err = viper.BindPFlag("tracer.endpoint", flags.Lookup("tracer-endpoint")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("tracer.endpoint", "PERMIFY_TRACER_ENDPOINT"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("tracer-sampling-ratio", conf.Tracer.SamplingRatio, "ratio of the traces sampled, between 0 and 1")
+	if err = viper.BindPFlag("tracer.sampling_ratio", flags.Lookup("tracer-sampling-ratio")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("tracer.sampling_ratio", "PERMIFY_TRACER_SAMPLING_RATIO"); err != nil {
+		panic(err)
+	}
+
+	// METER
+	flags.Bool("meter-enabled", conf.Meter.Enabled, "switch option for metric")
+	if err = viper.BindPFlag("meter.enabled", flags.Lookup("meter-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("meter.enabled", "PERMIFY_METER_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("meter-exporter", conf.Meter.Exporter, "export uri for metric data")
+	if err = viper.BindPFlag("meter.exporter", flags.Lookup("meter-exporter")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("meter.exporter", "PERMIFY_METER_EXPORTER"); err != nil {
+		panic(err)
+	}
+
+	flags.String("meter-endpoint", conf.Meter.Endpoint, "can be; otlp. (integrated metric tools)")
+	if err = viper.BindPFlag("meter.endpoint", flags.Lookup("meter-endpoint")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("meter.endpoint", "PERMIFY_METER_ENDPOINT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("meter-slo-enabled", conf.Meter.SLO.Enabled, "export the availability and latency of requests against service level objectives")
+	if err = viper.BindPFlag("meter.slo.enabled", flags.Lookup("meter-slo-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("meter.slo.enabled", "PERMIFY_METER_SLO_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	// SERVICE
+	flags.Bool("service-circuit-breaker", conf.Service.CircuitBreaker, "switch option for service circuit breaker")
+	if err = viper.BindPFlag("service.circuit_breaker", flags.Lookup("service-circuit-breaker")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.circuit_breaker", "PERMIFY_SERVICE_CIRCUIT_BREAKER"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
+	if err = viper.BindPFlag("service.watch.enabled", flags.Lookup("service-watch-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.enabled", "PERMIFY_SERVICE_WATCH_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-watch-keepalive-interval", conf.Service.Watch.KeepaliveInterval, "interval of the keepalive messages sent to watch clients while there are no changes, none if 0")
+	if err = viper.BindPFlag("service.watch.keepalive_interval", flags.Lookup("service-watch-keepalive-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.keepalive_interval", "PERMIFY_SERVICE_WATCH_KEEPALIVE_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-watch-buffer-size", conf.Service.Watch.BufferSize, "number of changes buffered by each watch stream for the client")
+	if err = viper.BindPFlag("service.watch.buffer_size", flags.Lookup("service-watch-buffer-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.buffer_size", "PERMIFY_SERVICE_WATCH_BUFFER_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-watch-slow-consumer-policy", conf.Service.Watch.SlowConsumerPolicy, "what happens once the buffer of a watch stream is full, disconnect or drop")
+	if err = viper.BindPFlag("service.watch.slow_consumer_policy", flags.Lookup("service-watch-slow-consumer-policy")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.slow_consumer_policy", "PERMIFY_SERVICE_WATCH_SLOW_CONSUMER_POLICY"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-watch-hub-enabled", conf.Service.Watch.Hub.Enabled, "switch option for reading the changes of each tenant once for all the watch streams of the node")
+	if err = viper.BindPFlag("service.watch.hub.enabled", flags.Lookup("service-watch-hub-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.hub.enabled", "PERMIFY_SERVICE_WATCH_HUB_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-watch-hub-history-size", conf.Service.Watch.Hub.HistorySize, "number of the last changes of each tenant kept for the watch streams starting from them")
+	if err = viper.BindPFlag("service.watch.hub.history_size", flags.Lookup("service-watch-hub-history-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.watch.hub.history_size", "PERMIFY_SERVICE_WATCH_HUB_HISTORY_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-capture-enabled", conf.Service.Capture.Enabled, "switch option for capturing a sample of the check and lookup requests")
+	if err = viper.BindPFlag("service.capture.enabled", flags.Lookup("service-capture-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.capture.enabled", "PERMIFY_SERVICE_CAPTURE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-capture-path", conf.Service.Capture.Path, "file the captured requests are appended to")
+	if err = viper.BindPFlag("service.capture.path", flags.Lookup("service-capture-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.capture.path", "PERMIFY_SERVICE_CAPTURE_PATH"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("service-capture-sample-rate", conf.Service.Capture.SampleRate, "share of the requests that are captured, between 0 and 1")
+	if err = viper.BindPFlag("service.capture.sample_rate", flags.Lookup("service-capture-sample-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.capture.sample_rate", "PERMIFY_SERVICE_CAPTURE_SAMPLE_RATE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-decision-log-enabled", conf.Service.DecisionLog.Enabled, "switch option for logging the decisions of permission checks")
+	if err = viper.BindPFlag("service.decision_log.enabled", flags.Lookup("service-decision-log-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.enabled", "PERMIFY_SERVICE_DECISION_LOG_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("service-decision-log-sample-rate", conf.Service.DecisionLog.SampleRate, "share of the checks whose decisions are logged, between 0 and 1")
+	if err = viper.BindPFlag("service.decision_log.sample_rate", flags.Lookup("service-decision-log-sample-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.sample_rate", "PERMIFY_SERVICE_DECISION_LOG_SAMPLE_RATE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-sink", conf.Service.DecisionLog.Sink, "store decisions are written to: object, clickhouse or bigquery")
+	if err = viper.BindPFlag("service.decision_log.sink", flags.Lookup("service-decision-log-sink")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.sink", "PERMIFY_SERVICE_DECISION_LOG_SINK"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-location", conf.Service.DecisionLog.Location, "directory, s3:// or gs:// prefix the object sink writes batches of decisions to")
+	if err = viper.BindPFlag("service.decision_log.location", flags.Lookup("service-decision-log-location")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.location", "PERMIFY_SERVICE_DECISION_LOG_LOCATION"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-decision-log-batch-size", conf.Service.DecisionLog.BatchSize, "maximum number of decisions written at once")
+	if err = viper.BindPFlag("service.decision_log.batch_size", flags.Lookup("service-decision-log-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.batch_size", "PERMIFY_SERVICE_DECISION_LOG_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-decision-log-flush-interval", conf.Service.DecisionLog.FlushInterval, "maximum duration decisions wait before they are written")
+	if err = viper.BindPFlag("service.decision_log.flush_interval", flags.Lookup("service-decision-log-flush-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.flush_interval", "PERMIFY_SERVICE_DECISION_LOG_FLUSH_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-decision-log-queue-size", conf.Service.DecisionLog.QueueSize, "number of decisions waiting to be written, more are dropped")
+	if err = viper.BindPFlag("service.decision_log.queue_size", flags.Lookup("service-decision-log-queue-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.queue_size", "PERMIFY_SERVICE_DECISION_LOG_QUEUE_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-clickhouse-url", conf.Service.DecisionLog.ClickHouse.URL, "url of the http interface of clickhouse")
+	if err = viper.BindPFlag("service.decision_log.clickhouse.url", flags.Lookup("service-decision-log-clickhouse-url")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.clickhouse.url", "PERMIFY_SERVICE_DECISION_LOG_CLICKHOUSE_URL"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-clickhouse-table", conf.Service.DecisionLog.ClickHouse.Table, "clickhouse table decisions are inserted into")
+	if err = viper.BindPFlag("service.decision_log.clickhouse.table", flags.Lookup("service-decision-log-clickhouse-table")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.clickhouse.table", "PERMIFY_SERVICE_DECISION_LOG_CLICKHOUSE_TABLE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-clickhouse-username", conf.Service.DecisionLog.ClickHouse.Username, "username to authenticate to clickhouse with")
+	if err = viper.BindPFlag("service.decision_log.clickhouse.username", flags.Lookup("service-decision-log-clickhouse-username")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.clickhouse.username", "PERMIFY_SERVICE_DECISION_LOG_CLICKHOUSE_USERNAME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-clickhouse-password", conf.Service.DecisionLog.ClickHouse.Password, "password to authenticate to clickhouse with")
+	if err = viper.BindPFlag("service.decision_log.clickhouse.password", flags.Lookup("service-decision-log-clickhouse-password")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.clickhouse.password", "PERMIFY_SERVICE_DECISION_LOG_CLICKHOUSE_PASSWORD"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-bigquery-project", conf.Service.DecisionLog.BigQuery.Project, "project of the bigquery dataset")
+	if err = viper.BindPFlag("service.decision_log.bigquery.project", flags.Lookup("service-decision-log-bigquery-project")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.bigquery.project", "PERMIFY_SERVICE_DECISION_LOG_BIGQUERY_PROJECT"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-bigquery-dataset", conf.Service.DecisionLog.BigQuery.Dataset, "bigquery dataset of the table")
+	if err = viper.BindPFlag("service.decision_log.bigquery.dataset", flags.Lookup("service-decision-log-bigquery-dataset")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.bigquery.dataset", "PERMIFY_SERVICE_DECISION_LOG_BIGQUERY_DATASET"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-decision-log-bigquery-table", conf.Service.DecisionLog.BigQuery.Table, "bigquery table decisions are inserted into")
+	if err = viper.BindPFlag("service.decision_log.bigquery.table", flags.Lookup("service-decision-log-bigquery-table")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.decision_log.bigquery.table", "PERMIFY_SERVICE_DECISION_LOG_BIGQUERY_TABLE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-anomaly-enabled", conf.Service.Anomaly.Enabled, "switch option for flagging unusual patterns in the decisions and changes")
+	if err = viper.BindPFlag("service.anomaly.enabled", flags.Lookup("service-anomaly-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.enabled", "PERMIFY_SERVICE_ANOMALY_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-anomaly-queue-size", conf.Service.Anomaly.QueueSize, "number of decisions and changes waiting to be analyzed, more are dropped")
+	if err = viper.BindPFlag("service.anomaly.queue_size", flags.Lookup("service-anomaly-queue-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.queue_size", "PERMIFY_SERVICE_ANOMALY_QUEUE_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-anomaly-webhook-url", conf.Service.Anomaly.Webhook.URL, "URL the alerts are posted to")
+	if err = viper.BindPFlag("service.anomaly.webhook.url", flags.Lookup("service-anomaly-webhook-url")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.webhook.url", "PERMIFY_SERVICE_ANOMALY_WEBHOOK_URL"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-anomaly-webhook-secret", conf.Service.Anomaly.Webhook.Secret, "secret the alerts are signed with")
+	if err = viper.BindPFlag("service.anomaly.webhook.secret", flags.Lookup("service-anomaly-webhook-secret")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.webhook.secret", "PERMIFY_SERVICE_ANOMALY_WEBHOOK_SECRET"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-anomaly-webhook-timeout", conf.Service.Anomaly.Webhook.Timeout, "maximum duration of a webhook request")
+	if err = viper.BindPFlag("service.anomaly.webhook.timeout", flags.Lookup("service-anomaly-webhook-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.webhook.timeout", "PERMIFY_SERVICE_ANOMALY_WEBHOOK_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-anomaly-grant-spike-enabled", conf.Service.Anomaly.GrantSpike.Enabled, "switch option for flagging spikes of the relationships written by tenant")
+	if err = viper.BindPFlag("service.anomaly.grant_spike.enabled", flags.Lookup("service-anomaly-grant-spike-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.grant_spike.enabled", "PERMIFY_SERVICE_ANOMALY_GRANT_SPIKE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-anomaly-grant-spike-window", conf.Service.Anomaly.GrantSpike.Window, "duration of the windows relationships written are counted in")
+	if err = viper.BindPFlag("service.anomaly.grant_spike.window", flags.Lookup("service-anomaly-grant-spike-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.grant_spike.window", "PERMIFY_SERVICE_ANOMALY_GRANT_SPIKE_WINDOW"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-anomaly-grant-spike-min", conf.Service.Anomaly.GrantSpike.Min, "minimum number of relationships written in a window to flag it")
+	if err = viper.BindPFlag("service.anomaly.grant_spike.min", flags.Lookup("service-anomaly-grant-spike-min")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.grant_spike.min", "PERMIFY_SERVICE_ANOMALY_GRANT_SPIKE_MIN"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("service-anomaly-grant-spike-factor", conf.Service.Anomaly.GrantSpike.Factor, "minimum ratio of the relationships written in a window to the usual number to flag it")
+	if err = viper.BindPFlag("service.anomaly.grant_spike.factor", flags.Lookup("service-anomaly-grant-spike-factor")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.grant_spike.factor", "PERMIFY_SERVICE_ANOMALY_GRANT_SPIKE_FACTOR"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-anomaly-access-spike-enabled", conf.Service.Anomaly.AccessSpike.Enabled, "switch option for flagging spikes of the checks allowed by subject")
+	if err = viper.BindPFlag("service.anomaly.access_spike.enabled", flags.Lookup("service-anomaly-access-spike-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.access_spike.enabled", "PERMIFY_SERVICE_ANOMALY_ACCESS_SPIKE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-anomaly-access-spike-window", conf.Service.Anomaly.AccessSpike.Window, "duration of the windows allowed checks are counted in")
+	if err = viper.BindPFlag("service.anomaly.access_spike.window", flags.Lookup("service-anomaly-access-spike-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.access_spike.window", "PERMIFY_SERVICE_ANOMALY_ACCESS_SPIKE_WINDOW"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-anomaly-access-spike-min", conf.Service.Anomaly.AccessSpike.Min, "minimum number of checks allowed to a subject in a window to flag it")
+	if err = viper.BindPFlag("service.anomaly.access_spike.min", flags.Lookup("service-anomaly-access-spike-min")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.access_spike.min", "PERMIFY_SERVICE_ANOMALY_ACCESS_SPIKE_MIN"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("service-anomaly-access-spike-factor", conf.Service.Anomaly.AccessSpike.Factor, "minimum ratio of the checks allowed in a window to the usual number to flag it")
+	if err = viper.BindPFlag("service.anomaly.access_spike.factor", flags.Lookup("service-anomaly-access-spike-factor")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.access_spike.factor", "PERMIFY_SERVICE_ANOMALY_ACCESS_SPIKE_FACTOR"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-anomaly-escalation-enabled", conf.Service.Anomaly.Escalation.Enabled, "switch option for flagging the grants of privileged relations")
+	if err = viper.BindPFlag("service.anomaly.escalation.enabled", flags.Lookup("service-anomaly-escalation-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.escalation.enabled", "PERMIFY_SERVICE_ANOMALY_ESCALATION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("service-anomaly-escalation-relations", conf.Service.Anomaly.Escalation.Relations, "privileged relations, as entity type#relation")
+	if err = viper.BindPFlag("service.anomaly.escalation.relations", flags.Lookup("service-anomaly-escalation-relations")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.anomaly.escalation.relations", "PERMIFY_SERVICE_ANOMALY_ESCALATION_RELATIONS"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-limits-max-contextual-tuples", conf.Service.Limits.MaxContextualTuples, "maximum number of contextual tuples and attributes of a request (0 for no limit)")
+	if err = viper.BindPFlag("service.limits.max_contextual_tuples", flags.Lookup("service-limits-max-contextual-tuples")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.limits.max_contextual_tuples", "PERMIFY_SERVICE_LIMITS_MAX_CONTEXTUAL_TUPLES"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-limits-max-batch-size", conf.Service.Limits.MaxBatchSize, "maximum number of tuples and attributes written by a request, across its operations (0 for no limit)")
+	if err = viper.BindPFlag("service.limits.max_batch_size", flags.Lookup("service-limits-max-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.limits.max_batch_size", "PERMIFY_SERVICE_LIMITS_MAX_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-limits-max-filter-complexity", conf.Service.Limits.MaxFilterComplexity, "maximum number of entity and subject identifiers and attribute names of the filters of a request (0 for no limit)")
+	if err = viper.BindPFlag("service.limits.max_filter_complexity", flags.Lookup("service-limits-max-filter-complexity")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.limits.max_filter_complexity", "PERMIFY_SERVICE_LIMITS_MAX_FILTER_COMPLEXITY"); err != nil {
+		panic(err)
+	}
+
+	flags.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
+	if err = viper.BindPFlag("service.schema.cache.number_of_counters", flags.Lookup("service-schema-cache-number-of-counters")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.cache.number_of_counters", "PERMIFY_SERVICE_SCHEMA_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-cache-max-cost", conf.Service.Schema.Cache.MaxCost, "schema service cache max cost")
+	if err = viper.BindPFlag("service.schema.cache.max_cost", flags.Lookup("service-schema-cache-max-cost")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.cache.max_cost", "PERMIFY_SERVICE_SCHEMA_CACHE_MAX_COST"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-schema-deduplicate", conf.Service.Schema.Deduplicate, "return the latest schema version for writes of the schema it already holds instead of writing a new one")
+	if err = viper.BindPFlag("service.schema.deduplicate", flags.Lookup("service-schema-deduplicate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.deduplicate", "PERMIFY_SERVICE_SCHEMA_DEDUPLICATE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-base", conf.Service.Schema.Base, "tenant holding the base schema the schemas of the other tenants extend")
+	if err = viper.BindPFlag("service.schema.base", flags.Lookup("service-schema-base")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.base", "PERMIFY_SERVICE_SCHEMA_BASE"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("service-schema-bundle-public-keys", conf.Service.Schema.Bundle.PublicKeys, "base64 encoded Ed25519 public keys bundles must be signed with")
+	if err = viper.BindPFlag("service.schema.bundle.public_keys", flags.Lookup("service-schema-bundle-public-keys")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.bundle.public_keys", "PERMIFY_SERVICE_SCHEMA_BUNDLE_PUBLIC_KEYS"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-schema-git-enabled", conf.Service.Schema.Git.Enabled, "sync the schemas of tenants from a Git repository")
+	if err = viper.BindPFlag("service.schema.git.enabled", flags.Lookup("service-schema-git-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.enabled", "PERMIFY_SERVICE_SCHEMA_GIT_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-git-url", conf.Service.Schema.Git.URL, "URL of the Git repository to sync schemas from")
+	if err = viper.BindPFlag("service.schema.git.url", flags.Lookup("service-schema-git-url")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.url", "PERMIFY_SERVICE_SCHEMA_GIT_URL"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-git-branch", conf.Service.Schema.Git.Branch, "branch of the Git repository the schema is read from")
+	if err = viper.BindPFlag("service.schema.git.branch", flags.Lookup("service-schema-git-branch")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.branch", "PERMIFY_SERVICE_SCHEMA_GIT_BRANCH"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-git-path", conf.Service.Schema.Git.Path, "schema file, or directory of .perm files, in the Git repository")
+	if err = viper.BindPFlag("service.schema.git.path", flags.Lookup("service-schema-git-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.path", "PERMIFY_SERVICE_SCHEMA_GIT_PATH"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-schema-git-interval", conf.Service.Schema.Git.Interval, "interval between pulls of the Git repository")
+	if err = viper.BindPFlag("service.schema.git.interval", flags.Lookup("service-schema-git-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.interval", "PERMIFY_SERVICE_SCHEMA_GIT_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("service-schema-git-tenants", conf.Service.Schema.Git.Tenants, "tenants the schema of the Git repository is applied to")
+	if err = viper.BindPFlag("service.schema.git.tenants", flags.Lookup("service-schema-git-tenants")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.tenants", "PERMIFY_SERVICE_SCHEMA_GIT_TENANTS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-git-username", conf.Service.Schema.Git.Username, "username to authenticate to the Git repository with")
+	if err = viper.BindPFlag("service.schema.git.username", flags.Lookup("service-schema-git-username")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.username", "PERMIFY_SERVICE_SCHEMA_GIT_USERNAME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-schema-git-password", conf.Service.Schema.Git.Password, "password or access token to authenticate to the Git repository with")
+	if err = viper.BindPFlag("service.schema.git.password", flags.Lookup("service-schema-git-password")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.git.password", "PERMIFY_SERVICE_SCHEMA_GIT_PASSWORD"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
+	if err = viper.BindPFlag("service.permission.concurrency_limit", flags.Lookup("service-permission-concurrency-limit")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.concurrency_limit", "PERMIFY_SERVICE_PERMISSION_CONCURRENCY_LIMIT"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-permission-expand-max-nodes", conf.Service.Permission.ExpandMaxNodes, "maximum number of nodes of expansion trees, beyond which they are pruned (0 for no limit)")
+	if err = viper.BindPFlag("service.permission.expand_max_nodes", flags.Lookup("service-permission-expand-max-nodes")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.expand_max_nodes", "PERMIFY_SERVICE_PERMISSION_EXPAND_MAX_NODES"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-permission-check-timeout", conf.Service.Permission.CheckTimeout, "maximum duration of the evaluation of a check, whatever the deadline of the client (0 for no limit)")
+	if err = viper.BindPFlag("service.permission.check_timeout", flags.Lookup("service-permission-check-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.check_timeout", "PERMIFY_SERVICE_PERMISSION_CHECK_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-permission-decision-tenant", conf.Service.Permission.Decision.Tenant, "tenant of the OPA-style decisions not setting one")
+	if err = viper.BindPFlag("service.permission.decision.tenant", flags.Lookup("service-permission-decision-tenant")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision.tenant", "PERMIFY_SERVICE_PERMISSION_DECISION_TENANT"); err != nil {
+		panic(err)
+	}
+
+	flags.Int32("service-permission-decision-depth", conf.Service.Permission.Decision.Depth, "depth of the checks of the OPA-style decisions")
+	if err = viper.BindPFlag("service.permission.decision.depth", flags.Lookup("service-permission-decision-depth")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision.depth", "PERMIFY_SERVICE_PERMISSION_DECISION_DEPTH"); err != nil {
+		panic(err)
+	}
+
+	flags.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
+	if err = viper.BindPFlag("service.permission.cache.number_of_counters", flags.Lookup("service-permission-cache-number-of-counters")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.cache.number_of_counters", "PERMIFY_SERVICE_PERMISSION_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-permission-cache-max-cost", conf.Service.Permission.Cache.MaxCost, "permission service cache max cost")
+	if err = viper.BindPFlag("service.permission.cache.max_cost", flags.Lookup("service-permission-cache-max-cost")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.cache.max_cost", "PERMIFY_SERVICE_PERMISSION_CACHE_MAX_COST"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-permission-shadow-enabled", conf.Service.Permission.Shadow.Enabled, "switch option for evaluating checks against the candidate schema versions of tenants as well")
+	if err = viper.BindPFlag("service.permission.shadow.enabled", flags.Lookup("service-permission-shadow-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.shadow.enabled", "PERMIFY_SERVICE_PERMISSION_SHADOW_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.StringToString("service-permission-shadow-candidates", conf.Service.Permission.Shadow.Candidates, "candidate schema versions by tenant, e.g. t1=2UMhEfIp5d4rzeHwLAiQshwPHy0")
+	if err = viper.BindPFlag("service.permission.shadow.candidates", flags.Lookup("service-permission-shadow-candidates")); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-permission-shadow-concurrency-limit", conf.Service.Permission.Shadow.ConcurrencyLimit, "limit for shadow checks running at once, further ones are dropped")
+	if err = viper.BindPFlag("service.permission.shadow.concurrency_limit", flags.Lookup("service-permission-shadow-concurrency-limit")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.shadow.concurrency_limit", "PERMIFY_SERVICE_PERMISSION_SHADOW_CONCURRENCY_LIMIT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-data-strict-validation-enabled", conf.Service.Data.StrictValidation.Enabled, "switch option for validating the written relationships and attributes against the head schema version of tenants as well")
+	if err = viper.BindPFlag("service.data.strict_validation.enabled", flags.Lookup("service-data-strict-validation-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.data.strict_validation.enabled", "PERMIFY_SERVICE_DATA_STRICT_VALIDATION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("service-data-strict-validation-tenants", conf.Service.Data.StrictValidation.Tenants, "tenants whose writes are validated strictly, all tenants if not set")
+	if err = viper.BindPFlag("service.data.strict_validation.tenants", flags.Lookup("service-data-strict-validation-tenants")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.data.strict_validation.tenants", "PERMIFY_SERVICE_DATA_STRICT_VALIDATION_TENANTS"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("service-data-strict-validation-exempt", conf.Service.Data.StrictValidation.Exempt, "tenants whose writes are not validated strictly")
+	if err = viper.BindPFlag("service.data.strict_validation.exempt", flags.Lookup("service-data-strict-validation-exempt")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.data.strict_validation.exempt", "PERMIFY_SERVICE_DATA_STRICT_VALIDATION_EXEMPT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-idempotency-enabled", conf.Service.Idempotency.Enabled, "switch option for honoring the idempotency keys of the write requests")
+	if err = viper.BindPFlag("service.idempotency.enabled", flags.Lookup("service-idempotency-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.enabled", "PERMIFY_SERVICE_IDEMPOTENCY_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("service-idempotency-ttl", conf.Service.Idempotency.TTL, "how long the response of a write request is kept for the retries with its idempotency key")
+	if err = viper.BindPFlag("service.idempotency.ttl", flags.Lookup("service-idempotency-ttl")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.ttl", "PERMIFY_SERVICE_IDEMPOTENCY_TTL"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("service-idempotency-max-keys", conf.Service.Idempotency.MaxKeys, "number of idempotency keys kept at most, the oldest being forgotten first")
+	if err = viper.BindPFlag("service.idempotency.max_keys", flags.Lookup("service-idempotency-max-keys")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.max_keys", "PERMIFY_SERVICE_IDEMPOTENCY_MAX_KEYS"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("service-idempotency-redis", conf.Service.Idempotency.Redis, "keep the responses in the redis server of the shared rate limit, recognizing the retries reaching any of the replicas")
+	if err = viper.BindPFlag("service.idempotency.redis", flags.Lookup("service-idempotency-redis")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.redis", "PERMIFY_SERVICE_IDEMPOTENCY_REDIS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("service-idempotency-key", conf.Service.Idempotency.Key, "prefix of the redis keys the responses are kept at")
+	if err = viper.BindPFlag("service.idempotency.key", flags.Lookup("service-idempotency-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.idempotency.key", "PERMIFY_SERVICE_IDEMPOTENCY_KEY"); err != nil {
+		panic(err)
+	}
+
+	// DATABASE
+	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
+	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.engine", "PERMIFY_DATABASE_ENGINE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
+	if err = viper.BindPFlag("database.uri", flags.Lookup("database-uri")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.uri", "PERMIFY_DATABASE_URI"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
+	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.auto_migrate", "PERMIFY_DATABASE_AUTO_MIGRATE"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-migration-lock-timeout", conf.Database.MigrationLockTimeout, "maximum duration to wait for the migrations of other replicas to finish, 0 for no limit")
+	if err = viper.BindPFlag("database.migration_lock_timeout", flags.Lookup("database-migration-lock-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.migration_lock_timeout", "PERMIFY_DATABASE_MIGRATION_LOCK_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-preflight", conf.Database.Preflight, "refuse starting against databases migrated to versions incompatible with this one")
+	if err = viper.BindPFlag("database.preflight", flags.Lookup("database-preflight")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.preflight", "PERMIFY_DATABASE_PREFLIGHT"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "maximum number of parallel connections that can be made to the database at any time")
+	if err = viper.BindPFlag("database.max_open_connections", flags.Lookup("database-max-open-connections")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_open_connections", "PERMIFY_DATABASE_MAX_OPEN_CONNECTIONS"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("database-max-idle-connections", conf.Database.MaxIdleConnections, "maximum number of idle connections that can be made to the database at any time")
+	if err = viper.BindPFlag("database.max_idle_connections", flags.Lookup("database-max-idle-connections")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_idle_connections", "PERMIFY_DATABASE_MAX_IDLE_CONNECTIONS"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-max-connection-lifetime", conf.Database.MaxConnectionLifetime, "maximum amount of time a connection may be reused")
+	if err = viper.BindPFlag("database.max_connection_lifetime", flags.Lookup("database-max-connection-lifetime")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_connection_lifetime", "PERMIFY_DATABASE_MAX_CONNECTION_LIFETIME"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-max-connection-idle-time", conf.Database.MaxConnectionIdleTime, "maximum amount of time a connection may be idle")
+	if err = viper.BindPFlag("database.max_connection_idle_time", flags.Lookup("database-max-connection-idle-time")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_connection_idle_time", "PERMIFY_DATABASE_MAX_CONNECTION_IDLE_TIME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-query-exec-mode", conf.Database.QueryExecMode, "mode postgres queries are executed with, one of cache_statement, cache_describe, describe_exec, exec or simple_protocol")
+	if err = viper.BindPFlag("database.query_exec_mode", flags.Lookup("database-query-exec-mode")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.query_exec_mode", "PERMIFY_DATABASE_QUERY_EXEC_MODE"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("database-statement-cache-size", conf.Database.StatementCacheSize, "maximum number of statements cached on each postgres connection")
+	if err = viper.BindPFlag("database.statement_cache_size", flags.Lookup("database-statement-cache-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.statement_cache_size", "PERMIFY_DATABASE_STATEMENT_CACHE_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-batch-reads-enabled", conf.Database.BatchReads.Enabled, "read the relationships of entities requested while a read of the same relation is in flight with a single query")
+	if err = viper.BindPFlag("database.batch_reads.enabled", flags.Lookup("database-batch-reads-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.batch_reads.enabled", "PERMIFY_DATABASE_BATCH_READS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("database-batch-reads-max-size", conf.Database.BatchReads.MaxSize, "maximum number of entities read by a single batched query")
+	if err = viper.BindPFlag("database.batch_reads.max_size", flags.Lookup("database-batch-reads-max-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.batch_reads.max_size", "PERMIFY_DATABASE_BATCH_READS_MAX_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-existence-filter-enabled", conf.Database.ExistenceFilter.Enabled, "answer the reads of the relationships of entities that definitely have none with bloom filters of the relations of the entities of each tenant")
+	if err = viper.BindPFlag("database.existence_filter.enabled", flags.Lookup("database-existence-filter-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.existence_filter.enabled", "PERMIFY_DATABASE_EXISTENCE_FILTER_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("database-existence-filter-capacity", conf.Database.ExistenceFilter.Capacity, "number of relations of entities the existence filter of a tenant is sized for at least")
+	if err = viper.BindPFlag("database.existence_filter.capacity", flags.Lookup("database-existence-filter-capacity")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.existence_filter.capacity", "PERMIFY_DATABASE_EXISTENCE_FILTER_CAPACITY"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("database-existence-filter-false-positive-rate", conf.Database.ExistenceFilter.FalsePositiveRate, "rate of the reads of absent relations the existence filters still read from the database")
+	if err = viper.BindPFlag("database.existence_filter.false_positive_rate", flags.Lookup("database-existence-filter-false-positive-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.existence_filter.false_positive_rate", "PERMIFY_DATABASE_EXISTENCE_FILTER_FALSE_POSITIVE_RATE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-garbage-collection-enabled", conf.Database.GarbageCollection.Enabled, "use database garbage collection for expired relationships and attributes")
+	if err = viper.BindPFlag("database.garbage_collection.enabled", flags.Lookup("database-garbage-collection-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.enabled", "PERMIFY_DATABASE_GARBAGE_COLLECTION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
+	if err = viper.BindPFlag("database.garbage_collection.interval", flags.Lookup("database-garbage-collection-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.interval", "PERMIFY_DATABASE_GARBAGE_COLLECTION_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
+	if err = viper.BindPFlag("database.garbage_collection.timeout", flags.Lookup("database-garbage-collection-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.timeout", "PERMIFY_DATABASE_GARBAGE_COLLECTION_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-garbage-collection-window", conf.Database.GarbageCollection.Window, "window for database garbage collection")
+	if err = viper.BindPFlag("database.garbage_collection.window", flags.Lookup("database-garbage-collection-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.window", "PERMIFY_DATABASE_GARBAGE_COLLECTION_WINDOW"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("database-region-check-interval", conf.Database.RegionCheckInterval, "interval between the health checks of the database regions and the refreshes of the tenants kept in them")
+	if err = viper.BindPFlag("database.region_check_interval", flags.Lookup("database-region-check-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.region_check_interval", "PERMIFY_DATABASE_REGION_CHECK_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("database-encryption-enabled", conf.Database.Encryption.Enabled, "encrypt the identifiers of entities and subjects before they are stored")
+	if err = viper.BindPFlag("database.encryption.enabled", flags.Lookup("database-encryption-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.enabled", "PERMIFY_DATABASE_ENCRYPTION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-key", conf.Database.Encryption.Key, "base64 key of 32 bytes identifiers are encrypted with, or the key wrapped by the kms")
+	if err = viper.BindPFlag("database.encryption.key", flags.Lookup("database-encryption-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.key", "PERMIFY_DATABASE_ENCRYPTION_KEY"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-kms-provider", conf.Database.Encryption.KMS.Provider, "key management service unwrapping the encryption key, vault for the transit engine of hashicorp vault")
+	if err = viper.BindPFlag("database.encryption.kms.provider", flags.Lookup("database-encryption-kms-provider")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.kms.provider", "PERMIFY_DATABASE_ENCRYPTION_KMS_PROVIDER"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-kms-address", conf.Database.Encryption.KMS.Address, "address of the key management service")
+	if err = viper.BindPFlag("database.encryption.kms.address", flags.Lookup("database-encryption-kms-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.kms.address", "PERMIFY_DATABASE_ENCRYPTION_KMS_ADDRESS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-kms-token", conf.Database.Encryption.KMS.Token, "token to authenticate to the key management service with")
+	if err = viper.BindPFlag("database.encryption.kms.token", flags.Lookup("database-encryption-kms-token")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.kms.token", "PERMIFY_DATABASE_ENCRYPTION_KMS_TOKEN"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-kms-mount", conf.Database.Encryption.KMS.Mount, "mount path of the vault transit engine")
+	if err = viper.BindPFlag("database.encryption.kms.mount", flags.Lookup("database-encryption-kms-mount")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.kms.mount", "PERMIFY_DATABASE_ENCRYPTION_KMS_MOUNT"); err != nil {
+		panic(err)
+	}
+
+	flags.String("database-encryption-kms-key-name", conf.Database.Encryption.KMS.KeyName, "name of the key wrapping the encryption key")
+	if err = viper.BindPFlag("database.encryption.kms.key_name", flags.Lookup("database-encryption-kms-key-name")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.kms.key_name", "PERMIFY_DATABASE_ENCRYPTION_KMS_KEY_NAME"); err != nil {
+		panic(err)
+	}
+
+	// Distributed
+	flags.Bool("distributed-enabled", conf.Distributed.Enabled, "enable distributed")
+	if err = viper.BindPFlag("distributed.enabled", flags.Lookup("distributed-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.enabled", "PERMIFY_DISTRIBUTED_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-address", conf.Distributed.Address, "distributed address")
+	if err = viper.BindPFlag("distributed.address", flags.Lookup("distributed-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.address", "PERMIFY_DISTRIBUTED_ADDRESS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-port", conf.Distributed.Port, "distributed port")
+	if err = viper.BindPFlag("distributed.port", flags.Lookup("distributed-port")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.port", "PERMIFY_DISTRIBUTED_PORT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("distributed-tls-enabled", conf.Distributed.TLS.Enabled, "enable mutual TLS for the dispatches between the nodes")
+	if err = viper.BindPFlag("distributed.tls.enabled", flags.Lookup("distributed-tls-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.tls.enabled", "PERMIFY_DISTRIBUTED_TLS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-tls-cert", conf.Distributed.TLS.CertPath, "certificate the node presents to its peers")
+	if err = viper.BindPFlag("distributed.tls.cert", flags.Lookup("distributed-tls-cert")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.tls.cert", "PERMIFY_DISTRIBUTED_TLS_CERT"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-tls-key", conf.Distributed.TLS.KeyPath, "private key of the certificate the node presents to its peers")
+	if err = viper.BindPFlag("distributed.tls.key", flags.Lookup("distributed-tls-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.tls.key", "PERMIFY_DISTRIBUTED_TLS_KEY"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-tls-ca", conf.Distributed.TLS.CAPath, "CA the certificates of the peers are verified against")
+	if err = viper.BindPFlag("distributed.tls.ca", flags.Lookup("distributed-tls-ca")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.tls.ca", "PERMIFY_DISTRIBUTED_TLS_CA"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-tls-server-name", conf.Distributed.TLS.ServerName, "name the certificates of the peers are verified for")
+	if err = viper.BindPFlag("distributed.tls.server_name", flags.Lookup("distributed-tls-server-name")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.tls.server_name", "PERMIFY_DISTRIBUTED_TLS_SERVER_NAME"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-shared-secret", conf.Distributed.SharedSecret, "secret the nodes authenticate their dispatches with")
+	if err = viper.BindPFlag("distributed.shared_secret", flags.Lookup("distributed-shared-secret")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.shared_secret", "PERMIFY_DISTRIBUTED_SHARED_SECRET"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-invoke-address", conf.Distributed.Invoke.Address, "address the invoke server binds to, all the interfaces if not set")
+	if err = viper.BindPFlag("distributed.invoke.address", flags.Lookup("distributed-invoke-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.invoke.address", "PERMIFY_DISTRIBUTED_INVOKE_ADDRESS"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("distributed-invoke-validation", conf.Distributed.Invoke.Validation, "validate the requests dispatched by the peers again")
+	if err = viper.BindPFlag("distributed.invoke.validation", flags.Lookup("distributed-invoke-validation")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.invoke.validation", "PERMIFY_DISTRIBUTED_INVOKE_VALIDATION"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("distributed-invoke-max-message-size", conf.Distributed.Invoke.MaxMessageSize, "maximum size in bytes of the messages of the dispatches between the nodes")
+	if err = viper.BindPFlag("distributed.invoke.max_message_size", flags.Lookup("distributed-invoke-max-message-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.invoke.max_message_size", "PERMIFY_DISTRIBUTED_INVOKE_MAX_MESSAGE_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("distributed-invoke-deduplication", conf.Distributed.Invoke.Deduplication, "evaluate the same checks dispatched by several peers at once a single time")
+	if err = viper.BindPFlag("distributed.invoke.deduplication", flags.Lookup("distributed-invoke-deduplication")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.invoke.deduplication", "PERMIFY_DISTRIBUTED_INVOKE_DEDUPLICATION"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("distributed-ring-virtual-nodes", conf.Distributed.Ring.VirtualNodes, "number of virtual nodes of each node on the consistent hash ring")
+	if err = viper.BindPFlag("distributed.ring.virtual_nodes", flags.Lookup("distributed-ring-virtual-nodes")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.ring.virtual_nodes", "PERMIFY_DISTRIBUTED_RING_VIRTUAL_NODES"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-ring-hash", conf.Distributed.Ring.Hash, "hash function of the consistent hash ring: md5, sha256 or xxhash")
+	if err = viper.BindPFlag("distributed.ring.hash", flags.Lookup("distributed-ring-hash")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.ring.hash", "PERMIFY_DISTRIBUTED_RING_HASH"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("distributed-peers-health-check", conf.Distributed.Peers.HealthCheck, "health check the peers, taking the unhealthy ones out of the consistent hash ring")
+	if err = viper.BindPFlag("distributed.peers.health_check", flags.Lookup("distributed-peers-health-check")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.health_check", "PERMIFY_DISTRIBUTED_PEERS_HEALTH_CHECK"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("distributed-peers-keepalive-time", conf.Distributed.Peers.KeepaliveTime, "interval of the pings keeping the idle connections to the peers warm, 0 to disable them")
+	if err = viper.BindPFlag("distributed.peers.keepalive_time", flags.Lookup("distributed-peers-keepalive-time")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.keepalive_time", "PERMIFY_DISTRIBUTED_PEERS_KEEPALIVE_TIME"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("distributed-peers-keepalive-timeout", conf.Distributed.Peers.KeepaliveTimeout, "time a ping waits to be acknowledged before its connection to the peer is closed")
+	if err = viper.BindPFlag("distributed.peers.keepalive_timeout", flags.Lookup("distributed-peers-keepalive-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.keepalive_timeout", "PERMIFY_DISTRIBUTED_PEERS_KEEPALIVE_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("distributed-peers-outlier-detection-enabled", conf.Distributed.Peers.OutlierDetection.Enabled, "eject the peers failing consecutive dispatches from the consistent hash ring for a while")
+	if err = viper.BindPFlag("distributed.peers.outlier_detection.enabled", flags.Lookup("distributed-peers-outlier-detection-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.outlier_detection.enabled", "PERMIFY_DISTRIBUTED_PEERS_OUTLIER_DETECTION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("distributed-peers-outlier-detection-consecutive-failures", conf.Distributed.Peers.OutlierDetection.ConsecutiveFailures, "number of consecutive failed dispatches ejecting a peer")
+	if err = viper.BindPFlag("distributed.peers.outlier_detection.consecutive_failures", flags.Lookup("distributed-peers-outlier-detection-consecutive-failures")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.outlier_detection.consecutive_failures", "PERMIFY_DISTRIBUTED_PEERS_OUTLIER_DETECTION_CONSECUTIVE_FAILURES"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("distributed-peers-outlier-detection-ejection-time", conf.Distributed.Peers.OutlierDetection.EjectionTime, "how long a peer failing consecutive dispatches stays ejected")
+	if err = viper.BindPFlag("distributed.peers.outlier_detection.ejection_time", flags.Lookup("distributed-peers-outlier-detection-ejection-time")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.peers.outlier_detection.ejection_time", "PERMIFY_DISTRIBUTED_PEERS_OUTLIER_DETECTION_EJECTION_TIME"); err != nil {
+		panic(err)
+	}
+
+	// Reload
+	flags.Bool("reload-enabled", conf.Reload.Enabled, "reload the log level, rate limits, preshared keys and cors policies when the config file changes or on SIGHUP")
+	if err = viper.BindPFlag("reload.enabled", flags.Lookup("reload-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("reload.enabled", "PERMIFY_RELOAD_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	// Diagnostics
+	flags.Bool("diagnostics-enabled", conf.Diagnostics.Enabled, "run the checks of the database, certificates and oidc issuer on startup, refusing to start if any of them fails")
+	if err = viper.BindPFlag("diagnostics.enabled", flags.Lookup("diagnostics-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("diagnostics.enabled", "PERMIFY_DIAGNOSTICS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("diagnostics-timeout", conf.Diagnostics.Timeout, "time each startup check has to complete")
+	if err = viper.BindPFlag("diagnostics.timeout", flags.Lookup("diagnostics-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("diagnostics.timeout", "PERMIFY_DIAGNOSTICS_TIMEOUT"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("diagnostics-max-clock-skew", conf.Diagnostics.MaxClockSkew, "skew allowed between the clocks of the server and the database, 0 to not check it")
+	if err = viper.BindPFlag("diagnostics.max_clock_skew", flags.Lookup("diagnostics-max-clock-skew")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("diagnostics.max_clock_skew", "PERMIFY_DIAGNOSTICS_MAX_CLOCK_SKEW"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("diagnostics-certificate-expiry-warning", conf.Diagnostics.CertificateExpiryWarning, "warn about the certificates expiring within this duration")
+	if err = viper.BindPFlag("diagnostics.certificate_expiry_warning", flags.Lookup("diagnostics-certificate-expiry-warning")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("diagnostics.certificate_expiry_warning", "PERMIFY_DIAGNOSTICS_CERTIFICATE_EXPIRY_WARNING"); err != nil {
+		panic(err)
+	}
+
+	// Chaos
+	flags.Bool("chaos-enabled", conf.Chaos.Enabled, "enable injecting faults into storage calls and peer dispatches")
+	if err = viper.BindPFlag("chaos.enabled", flags.Lookup("chaos-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.enabled", "PERMIFY_CHAOS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("chaos-storage-latency", conf.Chaos.Storage.Latency, "latency added to the delayed storage calls")
+	if err = viper.BindPFlag("chaos.storage.latency", flags.Lookup("chaos-storage-latency")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.storage.latency", "PERMIFY_CHAOS_STORAGE_LATENCY"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("chaos-storage-latency-percentage", conf.Chaos.Storage.LatencyPercentage, "percentage of storage calls that are delayed")
+	if err = viper.BindPFlag("chaos.storage.latency_percentage", flags.Lookup("chaos-storage-latency-percentage")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.storage.latency_percentage", "PERMIFY_CHAOS_STORAGE_LATENCY_PERCENTAGE"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("chaos-storage-error-percentage", conf.Chaos.Storage.ErrorPercentage, "percentage of storage calls that fail")
+	if err = viper.BindPFlag("chaos.storage.error_percentage", flags.Lookup("chaos-storage-error-percentage")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.storage.error_percentage", "PERMIFY_CHAOS_STORAGE_ERROR_PERCENTAGE"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("chaos-dispatch-latency", conf.Chaos.Dispatch.Latency, "latency added to the delayed peer dispatches")
+	if err = viper.BindPFlag("chaos.dispatch.latency", flags.Lookup("chaos-dispatch-latency")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.dispatch.latency", "PERMIFY_CHAOS_DISPATCH_LATENCY"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("chaos-dispatch-latency-percentage", conf.Chaos.Dispatch.LatencyPercentage, "percentage of peer dispatches that are delayed")
+	if err = viper.BindPFlag("chaos.dispatch.latency_percentage", flags.Lookup("chaos-dispatch-latency-percentage")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.dispatch.latency_percentage", "PERMIFY_CHAOS_DISPATCH_LATENCY_PERCENTAGE"); err != nil {
+		panic(err)
+	}
+
+	flags.Float64("chaos-dispatch-error-percentage", conf.Chaos.Dispatch.ErrorPercentage, "percentage of peer dispatches that fail")
+	if err = viper.BindPFlag("chaos.dispatch.error_percentage", flags.Lookup("chaos-dispatch-error-percentage")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("chaos.dispatch.error_percentage", "PERMIFY_CHAOS_DISPATCH_ERROR_PERCENTAGE"); err != nil {
+		panic(err)
+	}
+
+	// Replication
+	flags.Bool("replication-enabled", conf.Replication.Enabled, "run the deployment as a region of a replicated deployment")
+	if err = viper.BindPFlag("replication.enabled", flags.Lookup("replication-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.enabled", "PERMIFY_REPLICATION_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-region", conf.Replication.Region, "label of the region of the deployment")
+	if err = viper.BindPFlag("replication.region", flags.Lookup("replication-region")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.region", "PERMIFY_REPLICATION_REGION"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-write-region", conf.Replication.WriteRegion, "region accepting writes, which the other regions replicate")
+	if err = viper.BindPFlag("replication.write_region", flags.Lookup("replication-write-region")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.write_region", "PERMIFY_REPLICATION_WRITE_REGION"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-source", conf.Replication.Source, "grpc address of the write region the other regions read its changes from")
+	if err = viper.BindPFlag("replication.source", flags.Lookup("replication-source")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.source", "PERMIFY_REPLICATION_SOURCE"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-key", conf.Replication.Key, "preshared key to authenticate to the write region with")
+	if err = viper.BindPFlag("replication.key", flags.Lookup("replication-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.key", "PERMIFY_REPLICATION_KEY"); err != nil {
+		panic(err)
+	}
+
+	flags.Bool("replication-tls-enabled", conf.Replication.TLSConfig.Enabled, "use tls for the connection to the write region")
+	if err = viper.BindPFlag("replication.tls.enabled", flags.Lookup("replication-tls-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.tls.enabled", "PERMIFY_REPLICATION_TLS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-tls-cert", conf.Replication.TLSConfig.CertPath, "certificate path used to verify the write region")
+	if err = viper.BindPFlag("replication.tls.cert", flags.Lookup("replication-tls-cert")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.tls.cert", "PERMIFY_REPLICATION_TLS_CERT"); err != nil {
+		panic(err)
+	}
+
+	flags.StringSlice("replication-tenants", conf.Replication.Tenants, "tenants replicated")
+	if err = viper.BindPFlag("replication.tenants", flags.Lookup("replication-tenants")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.tenants", "PERMIFY_REPLICATION_TENANTS"); err != nil {
+		panic(err)
+	}
+
+	flags.String("replication-state-path", conf.Replication.StatePath, "file recording the last change of the write region applied for each tenant")
+	if err = viper.BindPFlag("replication.state_path", flags.Lookup("replication-state-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.state_path", "PERMIFY_REPLICATION_STATE_PATH"); err != nil {
+		panic(err)
+	}
+
+	flags.Int("replication-history-size", conf.Replication.HistorySize, "number of changes applied kept by tenant to map the tokens of engines without ordered tokens")
+	if err = viper.BindPFlag("replication.history_size", flags.Lookup("replication-history-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.history_size", "PERMIFY_REPLICATION_HISTORY_SIZE"); err != nil {
+		panic(err)
+	}
+
+	flags.Duration("replication-read-your-writes-timeout", conf.Replication.ReadYourWritesTimeout, "maximum duration to wait for the snapshot of a request to be replicated")
+	if err = viper.BindPFlag("replication.read_your_writes_timeout", flags.Lookup("replication-read-your-writes-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("replication.read_your_writes_timeout", "PERMIFY_REPLICATION_READ_YOUR_WRITES_TIMEOUT"); err != nil {
 		panic(err)
 	}
 }
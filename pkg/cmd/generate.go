@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/codegen"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/pkg/development/file"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	pkgSchema "github.com/Permify/permify/pkg/schema"
+)
+
+const (
+	lang          = "lang"
+	packageName   = "package"
+	tenant        = "tenant"
+	schemaVersion = "schema-version"
+)
+
+// NewGenerateCommand - Creates new generate command
+func NewGenerateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [file]",
+		Short: "generate typed constants and check helpers for the entities, relations and permissions of a schema",
+		Long: "Generates code from the schema of a file, or from the schema of a tenant read from the database when no " +
+			"file is given.",
+		RunE: generate(),
+		Args: cobra.MaximumNArgs(1),
+	}
+
+	// add flags to the generate command
+	cmd.PersistentFlags().String(lang, codegen.GO.String(), "language of the generated code, go or ts")
+	cmd.PersistentFlags().String(packageName, "permissions", "package of the generated Go code")
+	cmd.PersistentFlags().String(output, "-", "file to write the generated code to, - for standard output")
+	cmd.PersistentFlags().String(databaseEngine, "", "database engine to read the schema of the tenant from")
+	cmd.PersistentFlags().String(databaseURI, "", "database URI to read the schema of the tenant from")
+	cmd.PersistentFlags().String(tenant, "t1", "tenant whose schema is read from the database")
+	cmd.PersistentFlags().String(schemaVersion, "", "version of the schema of the tenant, the latest if not set")
+
+	return cmd
+}
+
+// generate - permify generate command
+func generate() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{lang, packageName, output, databaseEngine, databaseURI, tenant, schemaVersion})
+		if err != nil {
+			return err
+		}
+
+		var sch *base.SchemaDefinition
+		if len(args) == 1 {
+			sch, err = schemaFromFile(args[0])
+		} else {
+			sch, err = schemaFromDatabase(flags)
+		}
+		if err != nil {
+			return err
+		}
+
+		code, err := codegen.Generate(codegen.Language(flags[lang]), sch, codegen.Options{Package: flags[packageName]})
+		if err != nil {
+			return err
+		}
+
+		if flags[output] == "-" {
+			_, err = os.Stdout.Write(code)
+			return err
+		}
+		return os.WriteFile(flags[output], code, 0o644)
+	}
+}
+
+// schemaFromFile - Reads the schema of a .perm schema file, or of a validation file given by path or URL
+func schemaFromFile(path string) (*base.SchemaDefinition, error) {
+	loader := pkgSchema.NewSchemaLoader()
+
+	if strings.HasSuffix(path, ".perm") {
+		definitions, err := loader.LoadSchema(path)
+		if err != nil {
+			return nil, err
+		}
+		return schema.NewSchemaFromStringDefinitions(true, definitions)
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := file.NewDecoderFromURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &file.Shape{}
+	if err = decoder.Decode(s); err != nil {
+		return nil, err
+	}
+
+	definitions, err := loader.LoadSchema(s.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewSchemaFromStringDefinitions(true, definitions)
+}
+
+// schemaFromDatabase - Reads the schema of the tenant from the database
+func schemaFromDatabase(flags map[string]string) (*base.SchemaDefinition, error) {
+	if flags[databaseEngine] == "" || flags[databaseURI] == "" {
+		return nil, errors.New("either a schema file or a database engine and URI must be given")
+	}
+
+	db, err := factories.DatabaseFactory(config.Database{Engine: flags[databaseEngine], URI: flags[databaseURI]})
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return factories.SchemaReaderFactory(db).ReadSchema(context.Background(), flags[tenant], flags[schemaVersion])
+}
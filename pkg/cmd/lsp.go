@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/lsp"
+)
+
+// NewLSPCommand - Creates new lsp command
+func NewLSPCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lsp",
+		Short: "start a language server for schema files on standard input and output",
+		RunE:  runLSP(),
+		Args:  cobra.NoArgs,
+	}
+}
+
+// runLSP - permify lsp command
+func runLSP() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		return lsp.NewServer(os.Stdin, os.Stdout).Run(cmd.Context())
+	}
+}
@@ -1,16 +1,21 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/cobra"
 
+	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/storage"
 )
 
 const (
 	target         = "target"
+	lockTimeout    = "lock-timeout"
 	databaseEngine = "database-engine"
 	databaseURI    = "database-uri"
 )
@@ -42,6 +47,7 @@ func NewMigrateUpCommand() *cobra.Command {
 
 	// add flags to the migration up command
 	cmd.PersistentFlags().String(target, "0", "version to migrate")
+	cmd.PersistentFlags().String(lockTimeout, config.DefaultConfig().Database.MigrationLockTimeout.String(), "maximum duration to wait for other migrations to finish, 0 for no limit")
 	cmd.PersistentFlags().String(databaseEngine, "", "database engine")
 	cmd.PersistentFlags().String(databaseURI, "", "database URI")
 
@@ -59,6 +65,7 @@ func NewMigrateDownCommand() *cobra.Command {
 
 	// add flags to the migration down command
 	cmd.PersistentFlags().String(target, "0", "version to rollback")
+	cmd.PersistentFlags().String(lockTimeout, config.DefaultConfig().Database.MigrationLockTimeout.String(), "maximum duration to wait for other migrations to finish, 0 for no limit")
 	cmd.PersistentFlags().String(databaseEngine, "", "database engine")
 	cmd.PersistentFlags().String(databaseURI, "", "database URI")
 
@@ -90,7 +97,8 @@ func NewMigrateResetCommand() *cobra.Command {
 		Args:  cobra.NoArgs,
 	}
 
-	// add flags to the migration status command
+	// add flags to the migration reset command
+	cmd.PersistentFlags().String(lockTimeout, config.DefaultConfig().Database.MigrationLockTimeout.String(), "maximum duration to wait for other migrations to finish, 0 for no limit")
 	cmd.PersistentFlags().String(databaseEngine, "", "database engine")
 	cmd.PersistentFlags().String(databaseURI, "", "database URI")
 
@@ -100,7 +108,7 @@ func NewMigrateResetCommand() *cobra.Command {
 // migrateUp - permify migrate up command
 func migrateUp() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		flags, err := getFlags(cmd, []string{target, databaseEngine, databaseURI})
+		flags, err := getFlags(cmd, []string{target, lockTimeout, databaseEngine, databaseURI})
 		if err != nil {
 			return err
 		}
@@ -110,18 +118,23 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		timeout, err := time.ParseDuration(flags[lockTimeout])
+		if err != nil {
+			return err
+		}
+
 		if p == 0 {
-			return storage.MigrateUp(flags[databaseEngine], flags[databaseURI])
+			return storage.MigrateUp(flags[databaseEngine], flags[databaseURI], timeout)
 		}
 
-		return storage.MigrateUpTo(flags[databaseEngine], flags[databaseURI], p)
+		return storage.MigrateUpTo(flags[databaseEngine], flags[databaseURI], p, timeout)
 	}
 }
 
 // migrateDown - permify migrate down command
 func migrateDown() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		flags, err := getFlags(cmd, []string{target, databaseEngine, databaseURI})
+		flags, err := getFlags(cmd, []string{target, lockTimeout, databaseEngine, databaseURI})
 		if err != nil {
 			return err
 		}
@@ -131,23 +144,33 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
+		timeout, err := time.ParseDuration(flags[lockTimeout])
+		if err != nil {
+			return err
+		}
+
 		if p == 0 {
-			return storage.MigrateDown(flags[databaseEngine], flags[databaseURI])
+			return storage.MigrateDown(flags[databaseEngine], flags[databaseURI], timeout)
 		}
 
-		return storage.MigrateDownTo(flags[databaseEngine], flags[databaseURI], p)
+		return storage.MigrateDownTo(flags[databaseEngine], flags[databaseURI], p, timeout)
 	}
 }
 
 // migrateReset - permify migrate reset command
 func migrateReset() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		flags, err := getFlags(cmd, []string{databaseEngine, databaseURI})
+		flags, err := getFlags(cmd, []string{lockTimeout, databaseEngine, databaseURI})
+		if err != nil {
+			return err
+		}
+
+		timeout, err := time.ParseDuration(flags[lockTimeout])
 		if err != nil {
 			return err
 		}
 
-		return storage.MigrateReset(flags[databaseEngine], flags[databaseURI])
+		return storage.MigrateReset(flags[databaseEngine], flags[databaseURI], timeout)
 	}
 }
 
@@ -159,7 +182,19 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		return storage.MigrateStatus(flags[databaseEngine], flags[databaseURI])
+		err = storage.MigrateStatus(flags[databaseEngine], flags[databaseURI])
+		if err != nil {
+			return err
+		}
+
+		// Report the versions the Admin service's MigrationStatus API reports as well.
+		current, latest, err := storage.MigrationVersion(context.Background(), flags[databaseEngine], flags[databaseURI])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("current version: %d, latest version: %d\n", current, latest)
+		return nil
 	}
 }
 
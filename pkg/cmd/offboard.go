@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/internal/offboarding"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	confirm = "confirm"
+	audit   = "audit"
+	reason  = "reason"
+)
+
+// NewOffboardCommand - Creates new offboard command
+func NewOffboardCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offboard <subject>",
+		Short: "find and delete every relationship a subject appears in across tenants, recording the deletions in an audit log",
+		RunE:  offboard(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	// add flags to the offboard command
+	cmd.PersistentFlags().String(address, "localhost:3478", "gRPC address of the server to delete the relationships from")
+	cmd.PersistentFlags().String(apiToken, "", "preshared key or token to authenticate with")
+	cmd.PersistentFlags().Bool(useTLS, false, "connect to the server over TLS")
+	cmd.PersistentFlags().StringSlice(tenants, []string{}, "tenants to delete the relationships from, all tenants if not set")
+	cmd.PersistentFlags().Bool(confirm, false, "delete the relationships found, they are only listed if not set")
+	cmd.PersistentFlags().String(audit, "offboarding.jsonl", "file the audit records of the deletions are appended to")
+	cmd.PersistentFlags().String(reason, "", "reason of the deletions recorded in the audit log")
+
+	return cmd
+}
+
+// offboard - permify offboard command
+func offboard() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{address, apiToken, audit, reason})
+		if err != nil {
+			return err
+		}
+		secure, err := cmd.Flags().GetBool(useTLS)
+		if err != nil {
+			return err
+		}
+		deleting, err := cmd.Flags().GetBool(confirm)
+		if err != nil {
+			return err
+		}
+		ids, err := cmd.Flags().GetStringSlice(tenants)
+		if err != nil {
+			return err
+		}
+
+		entity, err := tuple.E(args[0])
+		if err != nil {
+			return err
+		}
+
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.Dial(flags[address], grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if flags[apiToken] != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+		}
+
+		if len(ids) == 0 {
+			ids, err = offboarding.Tenants(ctx, base.NewTenancyClient(conn))
+			if err != nil {
+				return err
+			}
+		}
+
+		var log *os.File
+		if deleting {
+			log, err = os.OpenFile(flags[audit], os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return err
+			}
+			defer log.Close()
+		}
+
+		data := base.NewDataClient(conn)
+		total := 0
+		for _, id := range ids {
+			tuples, err := offboarding.Find(ctx, data, id, entity)
+			if err != nil {
+				return fmt.Errorf("tenant %s: %w", id, err)
+			}
+			if len(tuples) == 0 {
+				continue
+			}
+
+			relationships := make([]string, 0, len(tuples))
+			fmt.Printf("tenant %s: %d relationships\n", id, len(tuples))
+			for _, t := range tuples {
+				relationships = append(relationships, tuple.ToString(t))
+				fmt.Printf("  %s\n", tuple.ToString(t))
+			}
+			total += len(tuples)
+
+			if !deleting {
+				continue
+			}
+
+			token, err := offboarding.Delete(ctx, data, id, entity)
+			if err != nil {
+				return fmt.Errorf("tenant %s: %w", id, err)
+			}
+			err = offboarding.WriteRecord(log, offboarding.Record{
+				Time:          time.Now().UTC(),
+				TenantID:      id,
+				Subject:       tuple.EntityToString(entity),
+				Reason:        flags[reason],
+				SnapToken:     token,
+				Relationships: relationships,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !deleting {
+			fmt.Printf("found %d relationships of %s in %d tenants, run again with --%s to delete them\n", total, args[0], len(ids), confirm)
+			return nil
+		}
+		fmt.Printf("deleted %d relationships of %s in %d tenants, recorded in %s\n", total, args[0], len(ids), flags[audit])
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Permify/permify/internal/capture"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	address  = "address"
+	apiToken = "token"
+	useTLS   = "tls"
+)
+
+// NewReplayCommand - Creates new replay command
+func NewReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <file>",
+		Short: "replay captured check and lookup requests against a server and compare their results and latencies",
+		RunE:  replay(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	// add flags to the replay command
+	cmd.PersistentFlags().String(address, "localhost:3478", "gRPC address of the server to replay the requests against")
+	cmd.PersistentFlags().String(apiToken, "", "preshared key or token to authenticate with")
+	cmd.PersistentFlags().Bool(useTLS, false, "connect to the server over TLS")
+	cmd.PersistentFlags().String(schemaVersion, "", "schema version to replay the requests against, the head version of their tenants if not set")
+
+	return cmd
+}
+
+// replay - permify replay command
+func replay() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{address, apiToken, schemaVersion})
+		if err != nil {
+			return err
+		}
+		secure, err := cmd.Flags().GetBool(useTLS)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		creds := insecure.NewCredentials()
+		if secure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		conn, err := grpc.Dial(flags[address], grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		ctx := context.Background()
+		if flags[apiToken] != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+		}
+
+		var opts []capture.ReplayOption
+		if flags[schemaVersion] != "" {
+			opts = append(opts, capture.SchemaVersion(flags[schemaVersion]))
+		}
+
+		report, err := capture.Replay(ctx, base.NewPermissionClient(conn), file, opts...)
+		if err != nil {
+			return err
+		}
+
+		for _, mismatch := range report.Mismatches {
+			fmt.Printf("line %d: %s %s\n  captured: %s\n  replayed: %s\n", mismatch.Line, mismatch.Method, mismatch.Request, mismatch.Captured, mismatch.Replayed)
+		}
+		fmt.Printf("replayed %d requests, %d matched, %d differed\n", report.Total, report.Matched, len(report.Mismatches))
+		fmt.Printf("captured latency: p50 %s, p95 %s, p99 %s\n", report.Captured.P50, report.Captured.P95, report.Captured.P99)
+		fmt.Printf("replayed latency: p50 %s, p95 %s, p99 %s\n", report.Replayed.P50, report.Replayed.P95, report.Replayed.P99)
+
+		if len(report.Mismatches) > 0 {
+			return fmt.Errorf("%d of %d replayed requests differ from the capture", len(report.Mismatches), report.Total)
+		}
+		return nil
+	}
+}
@@ -2,35 +2,60 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
 
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/metric"
 
 	"github.com/Permify/permify/internal/engines/balancer"
 	"github.com/Permify/permify/internal/engines/cache"
 	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/middleware"
 	"github.com/Permify/permify/internal/storage/postgres/gc"
+	"github.com/Permify/permify/pkg/database"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
 
 	"github.com/fatih/color"
+	"github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	grpcBalancer "google.golang.org/grpc/balancer"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/anomaly"
+	"github.com/Permify/permify/internal/capture"
+	"github.com/Permify/permify/internal/chaos"
 	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/decisionlog"
+	"github.com/Permify/permify/internal/diagnostics"
+	"github.com/Permify/permify/internal/encryption"
 	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/gitsync"
+	"github.com/Permify/permify/internal/redaction"
+	"github.com/Permify/permify/internal/replication"
 	"github.com/Permify/permify/internal/servers"
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/decorators"
+	"github.com/Permify/permify/internal/supervisor"
+	"github.com/Permify/permify/internal/tunables"
+	consistentbalancer "github.com/Permify/permify/pkg/balancer"
+	"github.com/Permify/permify/pkg/bundle"
 	pkgcache "github.com/Permify/permify/pkg/cache"
 	"github.com/Permify/permify/pkg/cache/ristretto"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/telemetry"
 	"github.com/Permify/permify/pkg/telemetry/meterexporters"
 	"github.com/Permify/permify/pkg/telemetry/tracerexporters"
@@ -43,7 +68,7 @@ func NewServeCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "serve",
 		Short: "serve the Permify server",
-		RunE:  serve(),
+		RunE:  supervised(serve()),
 		Args:  cobra.NoArgs,
 	}
 }
@@ -55,62 +80,123 @@ func NewServeCommand() *cobra.Command {
 // It returns an error if there is an issue with any of the components or if any goroutine fails.
 func serve() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		var cfg *config.Config
-		var err error
-		cfgFile := viper.GetString("config.file")
-		if cfgFile != "" {
-			cfg, err = config.NewConfigWithFile(cfgFile)
-			if err != nil {
-				return fmt.Errorf("failed to create new config: %w", err)
-			}
-
-			if err = viper.Unmarshal(cfg); err != nil {
-				return fmt.Errorf("failed to unmarshal config: %w", err)
-			}
-		} else {
-			// Load configuration
-			cfg, err = config.NewConfig()
-			if err != nil {
-				return fmt.Errorf("failed to create new config: %w", err)
-			}
-
-			if err = viper.Unmarshal(cfg); err != nil {
-				return fmt.Errorf("failed to unmarshal config: %w", err)
-			}
+		cfg, err := loadConfig()
+		if err != nil {
+			return invalidConfig(err)
 		}
 
 		// Print banner and initialize logger
 		red := color.New(color.FgGreen)
 		_, _ = red.Printf(internal.Banner, internal.Version)
 
-		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level: getLogLevel(cfg.Log.Level),
-		}))
+		redactor, err := redaction.NewRedactor(cfg.Log.Redaction)
+		if err != nil {
+			return invalidConfig(fmt.Errorf("invalid redaction configuration: %w", err))
+		}
+
+		// The level of the logs can be changed at runtime through the Admin service
+		level := new(slog.LevelVar)
+		level.Set(getLogLevel(cfg.Log.Level))
+
+		var handler slog.Handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Level: level,
+		})
+		if redactor.Enabled() {
+			handler = redaction.NewHandler(handler, redactor)
+		}
+
+		logger := slog.New(handler)
 
 		slog.SetDefault(logger)
 
+		// Settings that can be changed at runtime through the Admin service
+		registry := tunables.NewRegistry(tunables.LogLevel(level))
+		// Settings reloaded with the configuration
+		reloads := []config.ReloadFunc{reloadLogLevel(level)}
+
 		slog.Info("🚀 starting permify service...")
 
 		// Set up context and signal handling
-		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
 		defer stop()
 
-		// Run database migration if enabled
+		// Run only the HTTP gateway if enabled, without a database or engines of its own
+		if cfg.Server.Gateway.Enabled {
+			return gateway(ctx, cfg)
+		}
+
+		// Refuse to start servers that would listen on the same port of the same interface
+		if err = servers.ValidateListeners(&cfg.Server, &cfg.Distributed, &cfg.Profiler); err != nil {
+			return invalidConfig(err)
+		}
+
+		// Run database migration if enabled. Replicas starting together wait for the one migrating the database, and
+		// don't serve it if it couldn't be migrated.
 		if cfg.Database.AutoMigrate {
 			err = storage.Migrate(cfg.Database)
 			if err != nil {
-				slog.Error("failed to migrate database: %w", err)
+				slog.Error("failed to migrate database", slog.Any("error", err))
+				return err
+			}
+			for _, region := range cfg.Database.Regions {
+				err = storage.Migrate(factories.RegionConfig(cfg.Database, region))
+				if err != nil {
+					slog.Error("failed to migrate database", slog.String("region", region.Name), slog.Any("error", err))
+					return err
+				}
+			}
+		}
+
+		// Check the databases, the certificates and the OIDC issuer before serving anything, refusing to start with
+		// the errors of all the failed checks. Without them, only the compatibility of the databases is checked.
+		if cfg.Diagnostics.Enabled {
+			report := diagnostics.Run(ctx, cfg.Diagnostics.Timeout, diagnosticChecks(cfg)...)
+			report.Log()
+			if err = report.Err(); err != nil {
+				return err
+			}
+		} else if cfg.Database.Preflight {
+			err = storage.Preflight(ctx, cfg.Database)
+			if err != nil {
+				slog.Error("database is incompatible with this version", slog.Any("error", err))
+				return err
+			}
+			for _, region := range cfg.Database.Regions {
+				err = storage.Preflight(ctx, factories.RegionConfig(cfg.Database, region))
+				if err != nil {
+					slog.Error("database is incompatible with this version", slog.String("region", region.Name), slog.Any("error", err))
+					return err
+				}
 			}
 		}
 
 		// Initialize database
 		db, err := factories.DatabaseFactory(cfg.Database)
 		if err != nil {
-			slog.Error("failed to initialize database: %w", err)
+			slog.Error("failed to initialize database", slog.Any("error", err))
 		}
 		defer func() {
 			if err = db.Close(); err != nil {
-				slog.Error("failed to close database: %v", err)
+				slog.Error("failed to close database", slog.Any("error", err))
+			}
+		}()
+
+		// Databases of the regions tenants are kept in, the storage of their tenants is routed to
+		residency, err := factories.ResidencyFactory(cfg.Database, db)
+		if err != nil {
+			slog.Error("failed to initialize database regions", slog.Any("error", err))
+			return err
+		}
+		defer func() {
+			if err = residency.Close(); err != nil {
+				slog.Error("failed to close database regions", slog.Any("error", err))
+			}
+		}()
+
+		// Track the health of the database regions and the tenants assigned to them
+		go func() {
+			if err := residency.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error(err.Error())
 			}
 		}()
 
@@ -125,8 +211,17 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				slog.Error(err.Error())
 			}
+			if redactor.Enabled() {
+				exporter = redaction.NewSpanExporter(exporter, redactor)
+			}
 
-			shutdown := telemetry.NewTracer(exporter)
+			sampler, err := telemetry.NewRatioSampler(cfg.Tracer.SamplingRatio)
+			if err != nil {
+				return invalidConfig(fmt.Errorf("invalid tracer configuration: %w", err))
+			}
+			registry.Register(tunables.SamplingRatio(sampler))
+
+			shutdown := telemetry.NewTracer(exporter, sampler)
 
 			defer func() {
 				if err = shutdown(context.Background()); err != nil {
@@ -136,22 +231,28 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		}
 
 		// Garbage collection
-		if cfg.Database.GarbageCollection.Timeout > 0 && cfg.Database.GarbageCollection.Enabled && cfg.Database.Engine != "memory" {
+		if cfg.Database.GarbageCollection.Timeout > 0 && cfg.Database.GarbageCollection.Enabled && cfg.Database.Engine == "postgres" {
 			slog.Info("🗑️ starting database garbage collection...")
 
-			garbageCollector := gc.NewGC(
-				db.(*PQDatabase.Postgres),
-				gc.Interval(cfg.Database.GarbageCollection.Interval),
-				gc.Window(cfg.Database.GarbageCollection.Window),
-				gc.Timeout(cfg.Database.GarbageCollection.Timeout),
-			)
+			databases := []database.Database{db}
+			for _, regionDB := range residency.Databases() {
+				databases = append(databases, regionDB)
+			}
 
-			go func() {
-				err = garbageCollector.Start(ctx)
-				if err != nil {
-					slog.Error(err.Error())
-				}
-			}()
+			for _, gcDB := range databases {
+				garbageCollector := gc.NewGC(
+					gcDB.(*PQDatabase.Postgres),
+					gc.Interval(cfg.Database.GarbageCollection.Interval),
+					gc.Window(cfg.Database.GarbageCollection.Window),
+					gc.Timeout(cfg.Database.GarbageCollection.Timeout),
+				)
+
+				go func() {
+					if err := garbageCollector.Start(ctx); err != nil {
+						slog.Error(err.Error())
+					}
+				}()
+			}
 		}
 
 		// Meter
@@ -169,6 +270,13 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		// Hit rate of the statement caches of the connections to the postgres databases
+		if cfg.Database.Engine == database.POSTGRES.String() {
+			if err = observeStatementCaches(meter, db, residency.Databases()); err != nil {
+				slog.Error(err.Error())
+			}
+		}
+
 		// schema cache
 		var schemaCache pkgcache.Cache
 		schemaCache, err = ristretto.New(ristretto.NumberOfCounters(cfg.Service.Schema.Cache.NumberOfCounters), ristretto.MaxCost(cfg.Service.Schema.Cache.MaxCost))
@@ -183,18 +291,61 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			slog.Error(err.Error())
 		}
 
+		// Encrypt the identifiers of entities and subjects before they are stored
+		var cipher *encryption.Cipher
+		if cfg.Database.Encryption.Enabled {
+			key, err := encryption.LoadKey(ctx, cfg.Database.Encryption)
+			if err != nil {
+				slog.Error("failed to load the encryption key", slog.Any("error", err))
+				return err
+			}
+			cipher, err = encryption.NewCipher(key)
+			if err != nil {
+				return err
+			}
+		}
+
 		watcher := storage.NewNoopWatcher()
 		if cfg.Service.Watch.Enabled {
-			watcher = factories.WatcherFactory(db)
+			watcher = residency.Watcher(factories.WatcherFactory(db))
+			if cipher != nil {
+				watcher = decorators.NewWatcherWithEncryption(watcher, cipher)
+			}
+
+			// Read the changes of each tenant once for all the watch streams of the node
+			if cfg.Service.Watch.Hub.Enabled {
+				watcher = decorators.NewWatcherWithHub(watcher, cfg.Service.Watch.Hub.HistorySize, cfg.Service.Watch.BufferSize)
+			}
 		}
 
-		// Initialize the storage with factory methods
-		dataReader := factories.DataReaderFactory(db)
-		dataWriter := factories.DataWriterFactory(db)
-		schemaReader := factories.SchemaReaderFactory(db)
-		schemaWriter := factories.SchemaWriterFactory(db)
+		// Initialize the storage with factory methods, routing the tenants of the database regions to their databases
+		dataReader := residency.DataReader(factories.DataReaderFactory(db))
+		dataWriter := residency.DataWriter(factories.DataWriterFactory(db))
+		schemaReader := residency.SchemaReader(factories.SchemaReaderFactory(db))
+		schemaWriter := residency.SchemaWriter(factories.SchemaWriterFactory(db))
 		tenantReader := factories.TenantReaderFactory(db)
-		tenantWriter := factories.TenantWriterFactory(db)
+		tenantWriter := residency.TenantWriter(factories.TenantWriterFactory(db))
+
+		// Read the relationships of the subjects a check fans out to with a query per relation, rather than per subject
+		if cfg.Database.BatchReads.Enabled {
+			dataReader = decorators.NewDataReaderWithBatching(dataReader, cfg.Database.BatchReads.MaxSize)
+		}
+
+		// Answer the reads of the relations the entities definitely don't have, following the changes of the tenants
+		if cfg.Database.ExistenceFilter.Enabled && cfg.Database.Engine == database.POSTGRES.String() {
+			dataReader = decorators.NewDataReaderWithExistenceFilter(
+				dataReader,
+				residency.Watcher(factories.WatcherFactory(db)),
+				cfg.Database.ExistenceFilter.Capacity,
+				cfg.Database.ExistenceFilter.FalsePositiveRate,
+				meter,
+			)
+		}
+
+		if cipher != nil {
+			dataReader = decorators.NewDataReaderWithEncryption(dataReader, cipher)
+			dataWriter = decorators.NewDataWriterWithEncryption(dataWriter, cipher)
+		}
 
 		// Add caching to the schema reader using a decorator
 		schemaReader = decorators.NewSchemaReaderWithCache(schemaReader, schemaCache)
@@ -210,21 +361,72 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			schemaReader = decorators.NewSchemaReaderWithCircuitBreaker(schemaReader)
 		}
 
+		// Inject faults into the storage calls if chaos testing is enabled
+		var dispatchOptions []grpc.DialOption
+		if cfg.Chaos.Enabled {
+			slog.Warn("⚠️ fault injection is enabled, storage calls and peer dispatches may be delayed or fail")
+
+			storageInjector, err := chaos.NewInjector(chaos.Fault(cfg.Chaos.Storage))
+			if err != nil {
+				return err
+			}
+			dataWriter = decorators.NewDataWriterWithFaults(dataWriter, storageInjector)
+			dataReader = decorators.NewDataReaderWithFaults(dataReader, storageInjector)
+			schemaWriter = decorators.NewSchemaWriterWithFaults(schemaWriter, storageInjector)
+			schemaReader = decorators.NewSchemaReaderWithFaults(schemaReader, storageInjector)
+
+			dispatchInjector, err := chaos.NewInjector(chaos.Fault(cfg.Chaos.Dispatch))
+			if err != nil {
+				return err
+			}
+			dispatchOptions = append(dispatchOptions, grpc.WithUnaryInterceptor(dispatchInjector.UnaryClientInterceptor()))
+		}
+
+		// Git sync of the schemas of tenants
+		if cfg.Service.Schema.Git.Enabled {
+			slog.Info("🔄 starting schema sync from git...", slog.String("url", cfg.Service.Schema.Git.URL), slog.String("branch", cfg.Service.Schema.Git.Branch))
+
+			opts := []gitsync.Option{
+				gitsync.Branch(cfg.Service.Schema.Git.Branch),
+				gitsync.Path(cfg.Service.Schema.Git.Path),
+				gitsync.Interval(cfg.Service.Schema.Git.Interval),
+				gitsync.Tenants(cfg.Service.Schema.Git.Tenants...),
+			}
+			if cfg.Service.Schema.Git.Username != "" || cfg.Service.Schema.Git.Password != "" {
+				opts = append(opts, gitsync.BasicAuth(cfg.Service.Schema.Git.Username, cfg.Service.Schema.Git.Password))
+			}
+
+			syncer := gitsync.NewSyncer(schemaReader, schemaWriter, cfg.Service.Schema.Git.URL, opts...)
+
+			go func() {
+				if err := syncer.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+					slog.Error(err.Error())
+				}
+			}()
+		}
+
 		// Initialize the engines using the key manager, schema reader, and relationship reader
-		checkEngine := engines.NewCheckEngine(schemaReader, dataReader, engines.CheckConcurrencyLimit(cfg.Service.Permission.ConcurrencyLimit))
-		expandEngine := engines.NewExpandEngine(schemaReader, dataReader)
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader, engines.CheckConcurrencyLimit(cfg.Service.Permission.ConcurrencyLimit), engines.CheckMeter(meter))
+		expandEngine := engines.NewExpandEngine(schemaReader, dataReader, engines.ExpandMaxNodes(cfg.Service.Permission.ExpandMaxNodes))
 
 		// Declare a variable `checker` of type `invoke.Check`.
 		var checker invoke.Check
 
+		// The state of the hash ring the checks are dispatched with, reported by the Admin service.
+		var ring *balancer.RingMonitor
+
 		// Create the checker either with load balancing or caching capabilities.
 		if cfg.Distributed.Enabled {
+			ring = balancer.NewRingMonitor(meter)
+			grpcBalancer.Register(consistentbalancer.NewConsistentHashBalancerBuilder(ring))
+
 			checker, err = balancer.NewCheckEngineWithBalancer(
 				checkEngine,
 				schemaReader,
 				&cfg.Distributed,
 				&cfg.Server.GRPC,
 				&cfg.Authn,
+				dispatchOptions...,
 			)
 			// Handle potential error during checker creation.
 			if err != nil {
@@ -237,8 +439,13 @@ func serve() func(cmd *cobra.Command, args []string) error {
 
 		// Create a localChecker which directly checks without considering distributed setup.
 		// This also includes caching capabilities.
+		var localCheck invoke.Check = checkEngine
+		if cfg.Distributed.Enabled && cfg.Distributed.Invoke.Deduplication {
+			// The checks the peers dispatch to this node as the owner of their subproblems share their evaluations
+			localCheck = cache.NewCheckEngineWithInflightRegistry(checkEngine, schemaReader, meter)
+		}
 		localChecker := cache.NewCheckEngineWithCache(
-			checkEngine,
+			localCheck,
 			schemaReader,
 			engineKeyCache,
 		)
@@ -260,6 +467,9 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			engines.SubjectPermissionConcurrencyLimit(cfg.Service.Permission.ConcurrencyLimit),
 		)
 
+		// Initialize the pathEngine, which explains checks with the relationship paths connecting subjects to permissions.
+		pathEngine := engines.NewPathEngine(checkEngine, schemaReader, dataReader)
+
 		// Create a new invoker that is used to directly call various functions or engines.
 		// It encompasses the schema, data, checker, and other engines.
 		invoker := invoke.NewDirectInvoker(
@@ -269,6 +479,7 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			expandEngine,
 			lookupEngine,
 			subjectPermissionEngine,
+			pathEngine,
 			meter,
 		)
 
@@ -283,12 +494,307 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			expandEngine,
 			lookupEngine,
 			subjectPermissionEngine,
+			pathEngine,
 			meter,
 		)
 
+		// Evaluate the checks of tenants against their candidate schema versions as well, serving the answers of the
+		// active ones. The engines keep dispatching sub-checks to the direct invoker.
+		var served invoke.Invoker = invoker
+		if cfg.Service.Permission.Shadow.Enabled && len(cfg.Service.Permission.Shadow.Candidates) > 0 {
+			served = invoke.NewShadowInvoker(invoker, cfg.Service.Permission.Shadow.Candidates, cfg.Service.Permission.Shadow.ConcurrencyLimit, meter)
+
+			slog.Info("👥 shadow evaluating checks", slog.Any("candidates", cfg.Service.Permission.Shadow.Candidates))
+		}
+
+		// Configure the streams of the watch service
+		switch cfg.Service.Watch.SlowConsumerPolicy {
+		case servers.SlowConsumerDisconnect, servers.SlowConsumerDrop:
+		default:
+			return invalidConfig(fmt.Errorf("unknown watch slow consumer policy: %s", cfg.Service.Watch.SlowConsumerPolicy))
+		}
+		containerOptions := []servers.ContainerOption{
+			servers.WithWatch(cfg.Service.Watch),
+			servers.WithData(cfg.Service.Data),
+			servers.WithLimits(cfg.Service.Limits),
+			servers.WithSchemaDeduplication(cfg.Service.Schema.Deduplicate),
+			servers.WithSchemaBase(cfg.Service.Schema.Base),
+			servers.WithCheckTimeout(cfg.Service.Permission.CheckTimeout),
+			servers.WithDecision(cfg.Service.Permission.Decision),
+			servers.WithMeter(meter),
+			servers.WithDatabase(cfg.Database),
+			servers.WithDatabaseRegions(residency),
+			servers.WithTunables(registry),
+		}
+		if ring != nil {
+			containerOptions = append(containerOptions, servers.WithHashRing(ring))
+		}
+
+		// Notify the process supervisor, the Windows service manager or systemd, of the readiness of the servers
+		sup, ok := supervisor.FromContext(cmd.Context())
+		if !ok {
+			sup, ok = supervisor.NewSystemd()
+		}
+		if ok {
+			containerOptions = append(containerOptions, servers.WithSupervisor(sup))
+		}
+		if cfg.Service.Data.StrictValidation.Enabled {
+			slog.Info("🛡️ validating writes against the head schema versions", slog.Any("tenants", cfg.Service.Data.StrictValidation.Tenants), slog.Any("exempt", cfg.Service.Data.StrictValidation.Exempt))
+		}
+
+		// Parse the public keys bundles must be signed with
+		for _, k := range cfg.Service.Schema.Bundle.PublicKeys {
+			key, err := bundle.ParsePublicKey(k)
+			if err != nil {
+				return fmt.Errorf("failed to parse bundle public key: %w", err)
+			}
+			containerOptions = append(containerOptions, servers.WithBundleKeys(key))
+		}
+
+		// Obtain and renew the certificates of the HTTP and gRPC servers automatically
+		if cfg.Server.Autocert.Enabled {
+			cache, err := factories.AutocertCacheFactory(cfg.Server.Autocert, db)
+			if err != nil {
+				return invalidConfig(err)
+			}
+			manager, err := servers.NewAutocertManager(cfg.Server.Autocert, cache)
+			if err != nil {
+				return invalidConfig(err)
+			}
+			containerOptions = append(containerOptions, servers.WithAutocert(manager))
+			slog.Info("🔒 obtaining certificates automatically", slog.Any("hostnames", cfg.Server.Autocert.Hostnames), slog.String("cache", cfg.Server.Autocert.Cache))
+		}
+
+		// Serve the external authorization API of Envoy
+		if cfg.Server.ExtAuthz.Enabled {
+			rules, err := servers.NewExtAuthzRules(cfg.Server.ExtAuthz)
+			if err != nil {
+				return invalidConfig(err)
+			}
+			containerOptions = append(containerOptions, servers.WithExtAuthz(rules))
+		}
+
+		// Run as a region of a replicated deployment, replicating the changes of the write region in the other regions
+		if cfg.Replication.Enabled {
+			if err = validateReplication(&cfg.Replication); err != nil {
+				return invalidConfig(err)
+			}
+
+			progress := replication.NewProgress(cfg.Replication.HistorySize, factories.SnapTokenDecoderFactory(db))
+			if cfg.Replication.Region != cfg.Replication.WriteRegion {
+				conn, err := replication.DialSource(&cfg.Replication)
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+
+				replicator := replication.NewReplicator(
+					base.NewWatchClient(conn),
+					dataReader,
+					dataWriter,
+					progress,
+					cfg.Replication.Tenants,
+					replication.StatePath(cfg.Replication.StatePath),
+				)
+				go func() {
+					if err := replicator.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+						slog.Error(err.Error())
+					}
+				}()
+			}
+
+			interceptor := replication.NewInterceptor(cfg.Replication.Region, cfg.Replication.WriteRegion, progress, cfg.Replication.ReadYourWritesTimeout)
+			containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, interceptor.Unary()))
+
+			slog.Info("🌍 running as a region of a replicated deployment", slog.String("region", cfg.Replication.Region), slog.String("write_region", cfg.Replication.WriteRegion))
+		}
+
+		// The Redis server the replicas share the rate limit and the idempotency keys through
+		var client *redis.Client
+		if cfg.Server.RateLimitRedis.Enabled || (cfg.Service.Idempotency.Enabled && cfg.Service.Idempotency.Redis) {
+			client = redis.NewClient(&redis.Options{
+				Addr:     cfg.Server.RateLimitRedis.Address,
+				Username: cfg.Server.RateLimitRedis.Username,
+				Password: cfg.Server.RateLimitRedis.Password,
+				DB:       cfg.Server.RateLimitRedis.DB,
+			})
+			defer client.Close()
+		}
+
+		// Share the rate limit of the replicas through Redis, or limit the requests of the server alone
+		if cfg.Server.RateLimitRedis.Enabled {
+			limiter := middleware.NewRedisRateLimiter(client, cfg.Server.RateLimitRedis.Key, cfg.Server.RateLimit, cfg.Server.RateLimitRedis.Replicas, servers.RateLimitWeights(&cfg.Server))
+			containerOptions = append(containerOptions, servers.WithRateLimiter(limiter))
+			registry.Register(tunables.RateLimit(limiter))
+			reloads = append(reloads, reloadRateLimit(limiter))
+
+			slog.Info("🚦 sharing rate limit through redis", slog.String("address", cfg.Server.RateLimitRedis.Address), slog.String("key", cfg.Server.RateLimitRedis.Key))
+		} else {
+			limiter := middleware.NewRateLimiter(cfg.Server.RateLimit, servers.RateLimitWeights(&cfg.Server))
+			containerOptions = append(containerOptions, servers.WithRateLimiter(limiter))
+			registry.Register(tunables.RateLimit(limiter))
+			reloads = append(reloads, reloadRateLimit(limiter))
+		}
+
+		// Apply the retries of the writes carrying an idempotency key once, across the replicas if they are shared
+		// through Redis
+		if cfg.Service.Idempotency.Enabled {
+			if cfg.Service.Idempotency.Redis {
+				idempotency := middleware.NewRedisIdempotency(client, cfg.Service.Idempotency.Key, cfg.Service.Idempotency.TTL)
+				containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, idempotency.UnaryServerInterceptor()))
+				registry.Register(tunables.IdempotencyTTL(idempotency))
+
+				slog.Info("🔁 sharing idempotency keys through redis", slog.String("address", cfg.Server.RateLimitRedis.Address), slog.String("key", cfg.Service.Idempotency.Key))
+			} else {
+				idempotency := middleware.NewIdempotency(cfg.Service.Idempotency.TTL, cfg.Service.Idempotency.MaxKeys)
+				containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, idempotency.UnaryServerInterceptor()))
+				registry.Register(tunables.IdempotencyTTL(idempotency))
+			}
+		}
+
+		// Service level objective metrics
+		if cfg.Meter.SLO.Enabled {
+			objectives := make([]middleware.Objective, 0, len(cfg.Meter.SLO.Objectives))
+			for _, o := range cfg.Meter.SLO.Objectives {
+				objectives = append(objectives, middleware.Objective{
+					Method:        o.Method,
+					Availability:  o.Availability,
+					Latency:       o.Latency,
+					LatencyTarget: o.LatencyTarget,
+				})
+			}
+
+			slo, err := middleware.NewSLO(meter, objectives...)
+			if err != nil {
+				return fmt.Errorf("failed to create slo metrics: %w", err)
+			}
+			containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, slo.UnaryServerInterceptor()))
+		}
+
+		// Log the decisions of a sample of the checks to an external store
+		if cfg.Service.DecisionLog.Enabled {
+			sink, err := decisionlog.NewSink(ctx, cfg.Service.DecisionLog)
+			if err != nil {
+				return fmt.Errorf("failed to create decision log sink: %w", err)
+			}
+
+			decisions, err := decisionlog.NewLogger(sink, cfg.Service.DecisionLog)
+			if err != nil {
+				return err
+			}
+			containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, decisions.UnaryServerInterceptor()))
+
+			decisionsDone := make(chan struct{})
+			go func() {
+				defer close(decisionsDone)
+				if err := decisions.Start(ctx); err != nil {
+					slog.Error(err.Error())
+				}
+			}()
+			// Wait for the decisions queued by the time the servers stop to be written.
+			defer func() {
+				stop()
+				<-decisionsDone
+			}()
+
+			slog.Info("🧾 logging decisions", slog.String("sink", cfg.Service.DecisionLog.Sink), slog.Float64("sample_rate", cfg.Service.DecisionLog.SampleRate))
+		}
+
+		// Flag unusual patterns in the decisions and changes of the server, and alert through the webhook
+		if cfg.Service.Anomaly.Enabled {
+			var analyzers []anomaly.Analyzer
+			if spike := cfg.Service.Anomaly.GrantSpike; spike.Enabled {
+				analyzers = append(analyzers, anomaly.NewGrantSpike(spike.Window, spike.Min, spike.Factor))
+			}
+			if spike := cfg.Service.Anomaly.AccessSpike; spike.Enabled {
+				analyzers = append(analyzers, anomaly.NewAccessSpike(spike.Window, spike.Min, spike.Factor))
+			}
+			if cfg.Service.Anomaly.Escalation.Enabled {
+				analyzers = append(analyzers, anomaly.NewEscalation(cfg.Service.Anomaly.Escalation.Relations))
+			}
+
+			var notifier anomaly.Notifier
+			if webhook := cfg.Service.Anomaly.Webhook; webhook.URL != "" {
+				notifier = anomaly.NewWebhookNotifier(webhook.URL, webhook.Secret, webhook.Timeout)
+			}
+
+			detector := anomaly.NewDetector(notifier, cfg.Service.Anomaly.QueueSize, analyzers...)
+			containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, detector.UnaryServerInterceptor()))
+			go func() {
+				if err := detector.Start(ctx); err != nil {
+					slog.Error(err.Error())
+				}
+			}()
+
+			slog.Info("🚨 detecting anomalies", slog.Int("analyzers", len(analyzers)), slog.Bool("webhook", notifier != nil))
+		}
+
+		// Redact the identifiers of the error messages of the responses
+		if redactor.Enabled() {
+			containerOptions = append(containerOptions,
+				servers.WithUnaryInterceptors(servers.BeforeRateLimit, redactor.UnaryServerInterceptor()),
+				servers.WithStreamInterceptors(servers.BeforeRateLimit, redactor.StreamServerInterceptor()),
+			)
+		}
+
+		// Label the goroutines of the requests with their method and tenant in the profiles
+		if cfg.Profiler.Enabled || cfg.Profiler.Push.Enabled {
+			labels := middleware.NewProfileLabels()
+			containerOptions = append(containerOptions,
+				servers.WithUnaryInterceptors(servers.BeforeRateLimit, labels.UnaryServerInterceptor()),
+				servers.WithStreamInterceptors(servers.BeforeRateLimit, labels.StreamServerInterceptor()),
+			)
+		}
+
+		// Push the profiles of the node continuously
+		if cfg.Profiler.Push.Enabled {
+			stop, err := telemetry.NewProfiler(
+				cfg.Profiler.Push.Endpoint,
+				cfg.Profiler.Push.ApplicationName,
+				cfg.Profiler.Push.BasicAuthUser,
+				cfg.Profiler.Push.BasicAuthPassword,
+			)
+			if err != nil {
+				slog.Error("failed to start pushing the profiles", slog.Any("error", err))
+				return err
+			}
+			defer func() {
+				if err := stop(); err != nil {
+					slog.Error(err.Error())
+				}
+			}()
+
+			slog.Info("🔥 pushing profiles", slog.String("endpoint", cfg.Profiler.Push.Endpoint))
+		}
+
+		// Capture a sample of the check and lookup requests
+		if cfg.Service.Capture.Enabled {
+			file, err := os.OpenFile(cfg.Service.Capture.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return fmt.Errorf("failed to open capture file: %w", err)
+			}
+			defer file.Close()
+
+			c, err := capture.NewCapture(file, cfg.Service.Capture.SampleRate)
+			if err != nil {
+				return err
+			}
+			containerOptions = append(containerOptions, servers.WithUnaryInterceptors(servers.AfterAuthn, c.UnaryServerInterceptor()))
+
+			slog.Info("📼 capturing requests", slog.String("path", cfg.Service.Capture.Path), slog.Float64("sample_rate", cfg.Service.Capture.SampleRate))
+		}
+
+		// Messages of the error codes in the locales of the requests
+		errorCatalog, err := servers.NewErrorCatalog(cfg.Server.ErrorMessages)
+		if err != nil {
+			return invalidConfig(fmt.Errorf("invalid error messages: %w", err))
+		}
+		errorCatalog.SetVerbose(cfg.Server.VerboseErrors)
+		containerOptions = append(containerOptions, servers.WithErrorCatalog(errorCatalog))
+
 		// Initialize the container which brings together multiple components such as the invoker, data readers/writers, and schema handlers.
 		container := servers.NewContainer(
-			invoker,
+			served,
 			dataReader,
 			dataWriter,
 			schemaReader,
@@ -296,6 +802,7 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			tenantReader,
 			tenantWriter,
 			watcher,
+			containerOptions...,
 		)
 
 		// Create an error group with the provided context
@@ -314,27 +821,234 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			)
 		})
 
-		// Wait for the error group to finish and log any errors
+		// Reload the log level, the rate limits, the preshared keys and the CORS policies with the configuration
+		if cfg.Reload.Enabled {
+			reloader := config.NewReloader(cfg, viper.ConfigFileUsed(), loadConfig, append(reloads, container.Reload)...)
+			g.Go(func() error {
+				if err := reloader.Run(ctx); err != nil {
+					slog.Error("failed to watch the configuration for reloads", slog.Any("error", err))
+				}
+				return nil
+			})
+
+			slog.Info("🔁 reloading the configuration on changes", slog.String("file", viper.ConfigFileUsed()))
+		}
+
+		// Wait for the error group to finish, returning the errors the servers failed with for the exit code
 		if err = g.Wait(); err != nil {
 			slog.Error(err.Error())
 		}
 
-		return nil
+		return err
+	}
+}
+
+// gateway runs the HTTP server alone in front of the remote gRPC servers of the gateway configuration,
+// along with tracing if enabled.
+func gateway(ctx context.Context, cfg *config.Config) error {
+	if cfg.Tracer.Enabled {
+		exporter, err := tracerexporters.ExporterFactory(
+			cfg.Tracer.Exporter,
+			cfg.Tracer.Endpoint,
+			cfg.Tracer.Insecure,
+		)
+		if err != nil {
+			slog.Error(err.Error())
+		}
+
+		sampler, err := telemetry.NewRatioSampler(cfg.Tracer.SamplingRatio)
+		if err != nil {
+			return invalidConfig(fmt.Errorf("invalid tracer configuration: %w", err))
+		}
+
+		shutdown := telemetry.NewTracer(exporter, sampler)
+
+		defer func() {
+			if err = shutdown(context.Background()); err != nil {
+				slog.Error(err.Error())
+			}
+		}()
+	}
+
+	if err := servers.RunGateway(ctx, &cfg.Server, &cfg.Server.Gateway); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// validateReplication checks the regions of the replication configuration.
+// observeStatementCaches reports the lookups of the statement caches of the connections to the postgres databases, by
+// region for the databases of the regions.
+func observeStatementCaches(meter api.Meter, db database.Database, regions map[string]database.Database) error {
+	databases := map[string]*PQDatabase.Postgres{}
+	if pg, ok := db.(*PQDatabase.Postgres); ok {
+		databases[""] = pg
+	}
+	for region, regionDB := range regions {
+		if pg, ok := regionDB.(*PQDatabase.Postgres); ok {
+			databases[region] = pg
+		}
+	}
+
+	observe := func(value func(stats PQDatabase.StatementCacheStats) int64) api.Int64Callback {
+		return func(_ context.Context, o api.Int64Observer) error {
+			for region, pg := range databases {
+				var opts []api.ObserveOption
+				if region != "" {
+					opts = append(opts, api.WithAttributes(attribute.String("region", region)))
+				}
+				o.Observe(value(pg.StatementCacheStats()), opts...)
+			}
+			return nil
+		}
+	}
+
+	_, err := meter.Int64ObservableCounter("db_statement_cache_hits",
+		api.WithDescription("Number of the queries executed with a statement cached on their connection to the database"),
+		api.WithInt64Callback(observe(func(stats PQDatabase.StatementCacheStats) int64 { return stats.Hits })),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.Int64ObservableCounter("db_statement_cache_misses",
+		api.WithDescription("Number of the queries whose statement was prepared by the database before they were executed"),
+		api.WithInt64Callback(observe(func(stats PQDatabase.StatementCacheStats) int64 { return stats.Misses })),
+	)
+	return err
+}
+
+// diagnosticChecks returns the startup checks of the databases of the regions, the certificates of the servers and
+// of the dispatches, and the OIDC issuer, as configured.
+func diagnosticChecks(cfg *config.Config) []diagnostics.Check {
+	checks := []diagnostics.Check{
+		diagnostics.Database("database", cfg.Database, cfg.Diagnostics.MaxClockSkew),
+		diagnostics.Migrations("database migrations", cfg.Database),
+	}
+	for _, region := range cfg.Database.Regions {
+		conf := factories.RegionConfig(cfg.Database, region)
+		checks = append(checks,
+			diagnostics.Database(fmt.Sprintf("database of region %s", region.Name), conf, cfg.Diagnostics.MaxClockSkew),
+			diagnostics.Migrations(fmt.Sprintf("database migrations of region %s", region.Name), conf),
+		)
+	}
+
+	certificates := map[string]string{}
+	if cfg.Server.HTTP.TLSConfig.Enabled {
+		certificates["http certificate"] = cfg.Server.HTTP.TLSConfig.CertPath
+	}
+	if cfg.Server.GRPC.TLSConfig.Enabled {
+		certificates["grpc certificate"] = cfg.Server.GRPC.TLSConfig.CertPath
+	}
+	if cfg.Distributed.Enabled && cfg.Distributed.TLS.Enabled {
+		certificates["distributed certificate"] = cfg.Distributed.TLS.CertPath
+		certificates["distributed ca"] = cfg.Distributed.TLS.CAPath
+	}
+	names := make([]string, 0, len(certificates))
+	for name, path := range certificates {
+		if path != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		checks = append(checks, diagnostics.Certificate(name, certificates[name], cfg.Diagnostics.CertificateExpiryWarning))
+	}
+
+	if cfg.Authn.Enabled && cfg.Authn.Method == "oidc" {
+		checks = append(checks, diagnostics.OIDC("oidc discovery", cfg.Authn.Oidc.Issuer))
 	}
+	return checks
+}
+
+func validateReplication(conf *config.Replication) error {
+	if conf.Region == "" || conf.WriteRegion == "" {
+		return errors.New("replication region and write region are required")
+	}
+	if strings.Contains(conf.Region, ":") || strings.Contains(conf.WriteRegion, ":") {
+		return errors.New("replication regions can't contain ':'")
+	}
+	if conf.Region != conf.WriteRegion && conf.Source == "" {
+		return errors.New("replication source is required outside the write region")
+	}
+	return nil
+}
+
+// logLevels are the slog.Level values of the log levels of the configuration.
+var logLevels = map[string]slog.Level{
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+	"debug": slog.LevelDebug,
 }
 
 // getLogLevel converts a string representation of log level to its corresponding slog.Level value.
 func getLogLevel(level string) slog.Level {
-	switch level {
-	case "info":
-		return slog.LevelInfo // Return Info level
-	case "warn":
-		return slog.LevelWarn // Return Warning level
-	case "error":
-		return slog.LevelError // Return Error level
-	case "debug":
-		return slog.LevelDebug // Return Debug level
-	default:
-		return slog.LevelInfo // Default to Info level if unrecognized
+	if l, ok := logLevels[level]; ok {
+		return l
+	}
+	return slog.LevelInfo // Default to Info level if unrecognized
+}
+
+// loadConfig loads the configuration from the file of the config flag, or from ./config/config.yaml, along with
+// the flags and the environment variables.
+func loadConfig() (*config.Config, error) {
+	var cfg *config.Config
+	var err error
+	if cfgFile := viper.GetString("config.file"); cfgFile != "" {
+		cfg, err = config.NewConfigWithFile(cfgFile)
+	} else {
+		cfg, err = config.NewConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new config: %w", err)
+	}
+
+	if err = viper.Unmarshal(cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	return cfg, nil
+}
+
+// reloadLogLevel reloads the level of the logs with the configuration. The level is only set when the
+// configuration changes it, so that the one set at runtime through the Admin service is kept otherwise.
+func reloadLogLevel(level *slog.LevelVar) config.ReloadFunc {
+	return func(previous, next *config.Config) (func(), error) {
+		if next.Log.Level == previous.Log.Level {
+			return func() {}, nil
+		}
+		l, ok := logLevels[next.Log.Level]
+		if !ok {
+			return nil, fmt.Errorf("invalid logger.level: %s", next.Log.Level)
+		}
+		return func() { level.Set(l) }, nil
+	}
+}
+
+// reloadableLimiter - Rate limiter whose limits are reloaded with the configuration
+type reloadableLimiter interface {
+	SetRate(reqPerSec int64) error
+	SetWeights(weights map[string]int64)
+}
+
+// reloadRateLimit reloads the rate limit and the weights of the methods with the configuration. Like the log
+// level, they are only set when the configuration changes them.
+func reloadRateLimit(limiter reloadableLimiter) config.ReloadFunc {
+	return func(previous, next *config.Config) (func(), error) {
+		rate := next.Server.RateLimit != previous.Server.RateLimit
+		if rate && next.Server.RateLimit <= 0 {
+			return nil, fmt.Errorf("invalid server.rate_limit: %d", next.Server.RateLimit)
+		}
+		weights := servers.RateLimitWeights(&next.Server)
+		reweight := !maps.Equal(weights, servers.RateLimitWeights(&previous.Server))
+		return func() {
+			if rate {
+				_ = limiter.SetRate(next.Server.RateLimit)
+			}
+			if reweight {
+				limiter.SetWeights(weights)
+			}
+		}, nil
 	}
 }
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// supervised runs the command as is, outside of Windows.
+func supervised(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return run
+}
@@ -0,0 +1,114 @@
+//go:build windows
+
+package cmd
+
+import (
+	"context"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/windows/svc"
+
+	"github.com/Permify/permify/internal/supervisor"
+)
+
+// _serviceName is the name the Windows service is run as. The service manager ignores it for the services running
+// in a process of their own.
+const _serviceName = "permify"
+
+// supervised runs the command as a Windows service when the service manager starts it, reporting the state of the
+// server to the service manager and stopping it when the service is stopped. It runs the command as is otherwise.
+func supervised(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		service, err := svc.IsWindowsService()
+		if err != nil || !service {
+			return run(cmd, args)
+		}
+
+		var runErr error
+		err = svc.Run(_serviceName, &windowsService{run: func(ctx context.Context) error {
+			cmd.SetContext(ctx)
+			runErr = run(cmd, args)
+			return runErr
+		}})
+		if err != nil {
+			return err
+		}
+		return runErr
+	}
+}
+
+// windowsService - Handler of the Windows service running the server
+type windowsService struct {
+	run func(ctx context.Context) error
+}
+
+// Execute runs the server until it stops or the service is stopped, exiting with the exit code of its error.
+func (s *windowsService) Execute(_ []string, requests <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	status := &serviceStatus{changes: changes}
+	status.set(svc.Status{State: svc.StartPending})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.run(supervisor.NewContext(ctx, status))
+	}()
+
+	for {
+		select {
+		case err := <-done:
+			status.set(svc.Status{State: svc.StopPending})
+			if code := ExitCode(err); code != 0 {
+				return true, uint32(code)
+			}
+			return false, 0
+		case r := <-requests:
+			switch r.Cmd {
+			case svc.Interrogate:
+				status.set(status.get())
+			case svc.Stop, svc.Shutdown:
+				cancel()
+			}
+		}
+	}
+}
+
+// serviceStatus - Supervisor reporting the state of the server to the Windows service manager
+type serviceStatus struct {
+	changes chan<- svc.Status
+
+	mu     sync.Mutex
+	status svc.Status
+}
+
+// Ready reports the service running, accepting to be stopped.
+func (s *serviceStatus) Ready() error {
+	s.set(svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown})
+	return nil
+}
+
+// Stopping reports the service stopping.
+func (s *serviceStatus) Stopping() error {
+	s.set(svc.Status{State: svc.StopPending})
+	return nil
+}
+
+// Watchdog returns at once, as the service manager has no watchdog.
+func (s *serviceStatus) Watchdog(context.Context, func() bool) error {
+	return nil
+}
+
+func (s *serviceStatus) get() svc.Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.status
+}
+
+func (s *serviceStatus) set(status svc.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+	s.changes <- status
+}
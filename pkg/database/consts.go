@@ -6,6 +6,9 @@ type Engine string
 const (
 	POSTGRES Engine = "postgres"
 	MEMORY   Engine = "memory"
+	DYNAMODB Engine = "dynamodb"
+	MONGODB  Engine = "mongodb"
+	SPANNER  Engine = "spanner"
 )
 
 // String - Convert to string
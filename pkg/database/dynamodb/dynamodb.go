@@ -0,0 +1,136 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+)
+
+// Key attribute and index names of the table. Every item of the table is addressed by a partition key
+// and a sort key, and relation tuples are additionally indexed by subject on the subject index.
+const (
+	PartitionKey        = "pk"
+	SortKey             = "sk"
+	SubjectPartitionKey = "gsi1pk"
+	SubjectSortKey      = "gsi1sk"
+	SubjectIndex        = "subject-index"
+)
+
+// DynamoDB - Structure for DynamoDB instance
+type DynamoDB struct {
+	Client  *dynamodb.Client
+	Streams *dynamodbstreams.Client
+	Table   string
+}
+
+// New - Creates new dynamodb instance. The uri has the form dynamodb://<table>?region=<region>&endpoint=<endpoint>,
+// where region and endpoint are optional and default to the AWS environment. Credentials are read from the environment.
+func New(uri string) (*DynamoDB, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "dynamodb" || u.Host == "" {
+		return nil, errors.New("dynamodb uri must have the form dynamodb://<table>")
+	}
+
+	var opts []func(*awsConfig.LoadOptions) error
+	if region := u.Query().Get("region"); region != "" {
+		opts = append(opts, awsConfig.WithRegion(region))
+	}
+
+	cfg, err := awsConfig.LoadDefaultConfig(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	endpoint := u.Query().Get("endpoint")
+
+	return &DynamoDB{
+		Client: dynamodb.NewFromConfig(cfg, func(o *dynamodb.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+		}),
+		Streams: dynamodbstreams.NewFromConfig(cfg, func(o *dynamodbstreams.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = aws.String(endpoint)
+			}
+		}),
+		Table: u.Host,
+	}, nil
+}
+
+// GetEngineType - Get the engine type which is dynamodb in string
+func (d *DynamoDB) GetEngineType() string {
+	return "dynamodb"
+}
+
+// Close - Close dynamodb instance, the clients hold no connections that need closing
+func (d *DynamoDB) Close() error {
+	return nil
+}
+
+// IsReady - Check if the table exists and is active
+func (d *DynamoDB) IsReady(ctx context.Context) (bool, error) {
+	out, err := d.Client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(d.Table)})
+	if err != nil {
+		return false, err
+	}
+	return out.Table.TableStatus == types.TableStatusActive, nil
+}
+
+// CreateTable - Creates the table, its subject index and its stream if the table does not exist yet,
+// and waits for it to become active.
+func (d *DynamoDB) CreateTable(ctx context.Context) error {
+	_, err := d.Client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(d.Table)})
+	if err == nil {
+		return nil
+	}
+	var notFound *types.ResourceNotFoundException
+	if !errors.As(err, &notFound) {
+		return err
+	}
+
+	_, err = d.Client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(d.Table),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{AttributeName: aws.String(PartitionKey), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String(SortKey), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String(SubjectPartitionKey), AttributeType: types.ScalarAttributeTypeS},
+			{AttributeName: aws.String(SubjectSortKey), AttributeType: types.ScalarAttributeTypeS},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String(PartitionKey), KeyType: types.KeyTypeHash},
+			{AttributeName: aws.String(SortKey), KeyType: types.KeyTypeRange},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(SubjectIndex),
+				KeySchema: []types.KeySchemaElement{
+					{AttributeName: aws.String(SubjectPartitionKey), KeyType: types.KeyTypeHash},
+					{AttributeName: aws.String(SubjectSortKey), KeyType: types.KeyTypeRange},
+				},
+				Projection: &types.Projection{ProjectionType: types.ProjectionTypeAll},
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+		StreamSpecification: &types.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: types.StreamViewTypeNewAndOldImages,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return dynamodb.NewTableExistsWaiter(d.Client).Wait(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(d.Table)}, 5*time.Minute)
+}
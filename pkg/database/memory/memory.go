@@ -3,8 +3,11 @@ package memory
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
+
+	"github.com/Permify/permify/pkg/clock"
 )
 
 // Memory - Structure for in memory db
@@ -12,14 +15,29 @@ type Memory struct {
 	sync.RWMutex
 
 	DB *memdb.MemDB
+
+	clock clock.Clock
 }
 
 // New - Creates new database schema in memory
-func New(schema *memdb.DBSchema) (*Memory, error) {
+func New(schema *memdb.DBSchema, opts ...Option) (*Memory, error) {
 	db, err := memdb.NewMemDB(schema)
-	return &Memory{
-		DB: db,
-	}, err
+	m := &Memory{
+		DB:    db,
+		clock: clock.New(),
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m, err
+}
+
+// Now - Returns the current time of the clock of the database
+func (m *Memory) Now() time.Time {
+	return m.clock.Now()
 }
 
 // GetEngineType - Gets engine type, returns as string
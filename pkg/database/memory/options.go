@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"github.com/Permify/permify/pkg/clock"
+)
+
+// Option - Option of the in memory db
+type Option func(m *Memory)
+
+// Clock - Sets the clock the storage reads the time of snapshots and tenants from
+func Clock(c clock.Clock) Option {
+	return func(m *Memory) {
+		m.clock = c
+	}
+}
@@ -0,0 +1,133 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+)
+
+// Collection names of the database.
+const (
+	RelationTuplesCollection    = "relation_tuples"
+	AttributesCollection        = "attributes"
+	SchemaDefinitionsCollection = "schema_definitions"
+	TransactionsCollection      = "transactions"
+	HeadsCollection             = "heads"
+	TenantsCollection           = "tenants"
+)
+
+// MongoDB - Structure for MongoDB instance
+type MongoDB struct {
+	Client *mongo.Client
+	DB     *mongo.Database
+	// options
+	maxConnectionIdleTime time.Duration
+	maxOpenConnections    int
+	maxIdleConnections    int
+}
+
+// New - Creates new mongodb instance. The database is the one named in the path of the uri.
+// Writes run in multi-document transactions, so the deployment must be a replica set or a sharded cluster.
+func New(uri string, opts ...Option) (*MongoDB, error) {
+	m := &MongoDB{}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	cs, err := connstring.ParseAndValidate(uri)
+	if err != nil {
+		return nil, err
+	}
+	if cs.Database == "" {
+		return nil, errors.New("mongodb uri must name a database, e.g. mongodb://host:27017/permify")
+	}
+
+	clientOptions := options.Client().ApplyURI(uri)
+	if m.maxOpenConnections != 0 {
+		clientOptions.SetMaxPoolSize(uint64(m.maxOpenConnections))
+	}
+	if m.maxIdleConnections != 0 {
+		clientOptions.SetMinPoolSize(uint64(m.maxIdleConnections))
+	}
+	if m.maxConnectionIdleTime != 0 {
+		clientOptions.SetMaxConnIdleTime(m.maxConnectionIdleTime)
+	}
+
+	client, err := mongo.Connect(context.Background(), clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := backoff.NewExponentialBackOff()
+	policy.MaxElapsedTime = 1 * time.Minute
+	err = backoff.Retry(func() error {
+		return client.Ping(context.Background(), nil)
+	}, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Client = client
+	m.DB = client.Database(cs.Database)
+	return m, nil
+}
+
+// GetEngineType - Get the engine type which is mongodb in string
+func (m *MongoDB) GetEngineType() string {
+	return "mongodb"
+}
+
+// Close - Close mongodb instance
+func (m *MongoDB) Close() error {
+	return m.Client.Disconnect(context.Background())
+}
+
+// IsReady - Check if the database is ready
+func (m *MongoDB) IsReady(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := m.Client.Ping(ctx, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateIndexes - Creates the indexes the storage relies on. Existing indexes are left untouched.
+func (m *MongoDB) CreateIndexes(ctx context.Context) error {
+	indexes := map[string][]mongo.IndexModel{
+		RelationTuplesCollection: {
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "entity_type", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "relation", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "subject_type", Value: 1}, {Key: "subject_id", Value: 1}, {Key: "subject_relation", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "created_tx_id", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "expired_tx_id", Value: 1}}},
+		},
+		AttributesCollection: {
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "entity_type", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "attribute", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "created_tx_id", Value: 1}}},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "expired_tx_id", Value: 1}}},
+		},
+		SchemaDefinitionsCollection: {
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "version", Value: 1}, {Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		TransactionsCollection: {
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "tx_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "timestamp", Value: 1}}},
+		},
+	}
+
+	for collection, models := range indexes {
+		if _, err := m.DB.Collection(collection).Indexes().CreateMany(ctx, models); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
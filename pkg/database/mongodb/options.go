@@ -0,0 +1,29 @@
+package mongodb
+
+import (
+	"time"
+)
+
+// Option - Option type
+type Option func(*MongoDB)
+
+// MaxOpenConnections - Defines the maximum size of the connection pool for mongodb
+func MaxOpenConnections(size int) Option {
+	return func(m *MongoDB) {
+		m.maxOpenConnections = size
+	}
+}
+
+// MaxIdleConnections - Defines the minimum number of connections kept in the pool for mongodb
+func MaxIdleConnections(c int) Option {
+	return func(m *MongoDB) {
+		m.maxIdleConnections = c
+	}
+}
+
+// MaxConnectionIdleTime - Defines maximum connection idle time for mongodb
+func MaxConnectionIdleTime(d time.Duration) Option {
+	return func(m *MongoDB) {
+		m.maxConnectionIdleTime = d
+	}
+}
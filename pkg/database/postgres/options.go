@@ -34,3 +34,18 @@ func MaxConnectionLifeTime(d time.Duration) Option {
 		p.maxConnectionLifeTime = d
 	}
 }
+
+// QueryExecMode - Defines the mode queries are executed with, cache_statement preparing the statements of the queries
+// once per connection
+func QueryExecMode(mode string) Option {
+	return func(p *Postgres) {
+		p.queryExecMode = mode
+	}
+}
+
+// StatementCacheSize - Defines the maximum number of statements cached on each connection
+func StatementCacheSize(size int) Option {
+	return func(p *Postgres) {
+		p.statementCacheSize = size
+	}
+}
@@ -9,7 +9,8 @@ import (
 
 	"github.com/Masterminds/squirrel"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 )
 
 // Postgres - Structure for Postresql instance
@@ -21,6 +22,10 @@ type Postgres struct {
 	maxConnectionIdleTime time.Duration
 	maxOpenConnections    int
 	maxIdleConnections    int
+	queryExecMode         string
+	statementCacheSize    int
+	// statements counts the lookups of the statement caches of the connections
+	statements *statementTracer
 }
 
 // New - Creates new postgresql db instance
@@ -28,6 +33,7 @@ func New(uri string, opts ...Option) (*Postgres, error) {
 	pg := &Postgres{
 		maxOpenConnections: _defaultMaxOpenConnections,
 		maxIdleConnections: _defaultMaxIdleConnections,
+		statements:         &statementTracer{},
 	}
 
 	// Custom options
@@ -37,11 +43,29 @@ func New(uri string, opts ...Option) (*Postgres, error) {
 
 	pg.Builder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
-	db, err := sql.Open("pgx", uri)
+	config, err := pgx.ParseConfig(uri)
 	if err != nil {
 		return nil, err
 	}
 
+	// The hot queries are the same statements whatever their arguments, so each connection prepares them once and
+	// reuses them from its statement cache unless the mode set doesn't cache them.
+	if pg.queryExecMode != "" {
+		config.DefaultQueryExecMode, err = parseQueryExecMode(pg.queryExecMode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pg.statementCacheSize != 0 {
+		config.StatementCacheCapacity = pg.statementCacheSize
+		config.DescriptionCacheCapacity = pg.statementCacheSize
+	}
+
+	config.Tracer = pg.statements
+
+	db := stdlib.OpenDB(*config)
+
 	if pg.maxOpenConnections != 0 {
 		db.SetMaxOpenConns(pg.maxOpenConnections)
 	}
@@ -75,6 +99,11 @@ func New(uri string, opts ...Option) (*Postgres, error) {
 	return pg, nil
 }
 
+// StatementCacheStats - Returns the lookups of the statement caches of the connections to the database so far
+func (p *Postgres) StatementCacheStats() StatementCacheStats {
+	return p.statements.stats()
+}
+
 // GetEngineType - Get the engine type which is postgresql in string
 func (p *Postgres) GetEngineType() string {
 	return "postgres"
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// queryExecModes are the modes queries can be executed with, by their names in the connection strings of pgx
+var queryExecModes = map[string]pgx.QueryExecMode{
+	"cache_statement": pgx.QueryExecModeCacheStatement,
+	"cache_describe":  pgx.QueryExecModeCacheDescribe,
+	"describe_exec":   pgx.QueryExecModeDescribeExec,
+	"exec":            pgx.QueryExecModeExec,
+	"simple_protocol": pgx.QueryExecModeSimpleProtocol,
+}
+
+// parseQueryExecMode returns the query exec mode of the name.
+func parseQueryExecMode(name string) (pgx.QueryExecMode, error) {
+	mode, ok := queryExecModes[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown query exec mode %q, expected one of cache_statement, cache_describe, describe_exec, exec or simple_protocol", name)
+	}
+	return mode, nil
+}
+
+// StatementCacheStats - Lookups of the statement caches of the connections to the database
+type StatementCacheStats struct {
+	// Hits are the queries executed with a statement from the cache of their connection
+	Hits int64
+	// Misses are the queries whose statement was prepared, or described, by the database first
+	Misses int64
+}
+
+// preparedKey - Key of the context of a query, recording whether its statement was prepared
+type preparedKey struct{}
+
+// statementTracer counts the lookups of the statement caches, a query missing the cache of its connection preparing
+// its statement before it is executed.
+type statementTracer struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// TraceQueryStart records in the context of the query whether its statement gets prepared.
+func (t *statementTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, preparedKey{}, new(bool))
+}
+
+// TraceQueryEnd counts the lookup of the statement of the query, when its connection caches them.
+func (t *statementTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, _ pgx.TraceQueryEndData) {
+	prepared, ok := ctx.Value(preparedKey{}).(*bool)
+	if !ok {
+		return
+	}
+	switch conn.Config().DefaultQueryExecMode {
+	case pgx.QueryExecModeCacheStatement, pgx.QueryExecModeCacheDescribe:
+		if *prepared {
+			t.misses.Add(1)
+		} else {
+			t.hits.Add(1)
+		}
+	}
+}
+
+// TracePrepareStart records that the statement of the query is prepared.
+func (t *statementTracer) TracePrepareStart(ctx context.Context, _ *pgx.Conn, _ pgx.TracePrepareStartData) context.Context {
+	if prepared, ok := ctx.Value(preparedKey{}).(*bool); ok {
+		*prepared = true
+	}
+	return ctx
+}
+
+// TracePrepareEnd - Nothing to record at the end of the preparation of a statement
+func (t *statementTracer) TracePrepareEnd(context.Context, *pgx.Conn, pgx.TracePrepareEndData) {}
+
+// stats returns the lookups counted so far.
+func (t *statementTracer) stats() StatementCacheStats {
+	return StatementCacheStats{
+		Hits:   t.hits.Load(),
+		Misses: t.misses.Load(),
+	}
+}
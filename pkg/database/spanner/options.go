@@ -0,0 +1,18 @@
+package spanner
+
+// Option - Option type
+type Option func(*Spanner)
+
+// MaxOpenConnections - Defines the maximum number of sessions opened by the session pool for spanner
+func MaxOpenConnections(size int) Option {
+	return func(s *Spanner) {
+		s.maxOpenConnections = size
+	}
+}
+
+// MaxIdleConnections - Defines the minimum number of sessions kept open by the session pool for spanner
+func MaxIdleConnections(c int) Option {
+	return func(s *Spanner) {
+		s.maxIdleConnections = c
+	}
+}
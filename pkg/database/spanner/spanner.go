@@ -0,0 +1,156 @@
+package spanner
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	admin "cloud.google.com/go/spanner/admin/database/apiv1"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+)
+
+// Table names of the database.
+const (
+	RelationTuplesTable    = "relation_tuples"
+	AttributesTable        = "attributes"
+	SchemaDefinitionsTable = "schema_definitions"
+	ChangesTable           = "changes"
+	HeadsTable             = "heads"
+	TenantsTable           = "tenants"
+)
+
+// ddl creates the tables and indexes the storage relies on. Rows are deleted when relation tuples and attributes
+// are deleted; older versions stay readable through stale reads for the version retention period of the database.
+// Changes are kept for the watch API and expire after a week.
+var ddl = []string{
+	`CREATE TABLE IF NOT EXISTS relation_tuples (
+		tenant_id STRING(128) NOT NULL,
+		entity_type STRING(64) NOT NULL,
+		entity_id STRING(256) NOT NULL,
+		relation STRING(64) NOT NULL,
+		subject_type STRING(64) NOT NULL,
+		subject_id STRING(256) NOT NULL,
+		subject_relation STRING(64) NOT NULL,
+		committed_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+	) PRIMARY KEY (tenant_id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation)`,
+	`CREATE INDEX IF NOT EXISTS relation_tuples_subject ON relation_tuples (tenant_id, subject_type, subject_id, subject_relation)`,
+	`CREATE TABLE IF NOT EXISTS attributes (
+		tenant_id STRING(128) NOT NULL,
+		entity_type STRING(64) NOT NULL,
+		entity_id STRING(256) NOT NULL,
+		attribute STRING(64) NOT NULL,
+		value BYTES(MAX) NOT NULL,
+		committed_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+	) PRIMARY KEY (tenant_id, entity_type, entity_id, attribute)`,
+	`CREATE TABLE IF NOT EXISTS schema_definitions (
+		tenant_id STRING(128) NOT NULL,
+		version STRING(64) NOT NULL,
+		name STRING(64) NOT NULL,
+		serialized_definition BYTES(MAX) NOT NULL,
+	) PRIMARY KEY (tenant_id, version, name)`,
+	`CREATE TABLE IF NOT EXISTS changes (
+		tenant_id STRING(128) NOT NULL,
+		committed_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+		seq INT64 NOT NULL,
+		actor STRING(MAX),
+		change BYTES(MAX) NOT NULL,
+	) PRIMARY KEY (tenant_id, committed_at, seq),
+	ROW DELETION POLICY (OLDER_THAN(committed_at, INTERVAL 7 DAY))`,
+	`CREATE TABLE IF NOT EXISTS heads (
+		tenant_id STRING(128) NOT NULL,
+		committed_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+	) PRIMARY KEY (tenant_id)`,
+	`CREATE TABLE IF NOT EXISTS tenants (
+		id STRING(128) NOT NULL,
+		name STRING(MAX) NOT NULL,
+		created_at TIMESTAMP NOT NULL,
+	) PRIMARY KEY (id)`,
+}
+
+var databaseName = regexp.MustCompile(`^projects/[^/]+/instances/[^/]+/databases/[^/]+$`)
+
+// Spanner - Structure for Cloud Spanner instance
+type Spanner struct {
+	Client   *spanner.Client
+	Database string
+	// options
+	maxOpenConnections int
+	maxIdleConnections int
+}
+
+// New - Creates new spanner instance. The uri has the form spanner://projects/<project>/instances/<instance>/databases/<database>.
+// Credentials are read from the environment, and SPANNER_EMULATOR_HOST points the client at an emulator.
+func New(uri string, opts ...Option) (*Spanner, error) {
+	s := &Spanner{
+		Database: strings.TrimPrefix(uri, "spanner://"),
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if !databaseName.MatchString(s.Database) {
+		return nil, errors.New("spanner uri must have the form spanner://projects/<project>/instances/<instance>/databases/<database>")
+	}
+
+	config := spanner.DefaultSessionPoolConfig
+	if s.maxOpenConnections != 0 {
+		config.MaxOpened = uint64(s.maxOpenConnections)
+	}
+	if s.maxIdleConnections != 0 {
+		config.MinOpened = uint64(s.maxIdleConnections)
+	}
+
+	client, err := spanner.NewClientWithConfig(context.Background(), s.Database, spanner.ClientConfig{SessionPoolConfig: config})
+	if err != nil {
+		return nil, err
+	}
+
+	s.Client = client
+	return s, nil
+}
+
+// GetEngineType - Get the engine type which is spanner in string
+func (s *Spanner) GetEngineType() string {
+	return "spanner"
+}
+
+// Close - Close spanner instance
+func (s *Spanner) Close() error {
+	s.Client.Close()
+	return nil
+}
+
+// IsReady - Check if the database is ready
+func (s *Spanner) IsReady(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	iter := s.Client.Single().Query(ctx, spanner.Statement{SQL: "SELECT 1"})
+	defer iter.Stop()
+	if _, err := iter.Next(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateTables - Creates the tables and indexes the storage relies on. Existing ones are left untouched.
+func (s *Spanner) CreateTables(ctx context.Context) error {
+	client, err := admin.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	op, err := client.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+		Database:   s.Database,
+		Statements: ddl,
+	})
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
@@ -0,0 +1,47 @@
+package database
+
+import (
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Transaction - Deletions and writes of relation tuples and attributes applied atomically. The deletions apply to the
+// data stored before the transaction, and the writes are applied after every deletion.
+type Transaction struct {
+	TupleFilters     []*base.TupleFilter
+	AttributeFilters []*base.AttributeFilter
+	Tuples           *TupleCollection
+	Attributes       *AttributeCollection
+	// SchemaVersion, when set, is the latest schema version the tenant must still have when the transaction is
+	// applied along with a schema migration, empty for tenants without a schema.
+	SchemaVersion *string
+}
+
+// NewTransaction - Create new empty transaction.
+func NewTransaction() *Transaction {
+	return &Transaction{
+		Tuples:     NewTupleCollection(),
+		Attributes: NewAttributeCollection(),
+	}
+}
+
+// Write - Add the tuples and attributes to the ones the transaction writes.
+func (t *Transaction) Write(tuples []*base.Tuple, attributes []*base.Attribute) {
+	for _, tuple := range tuples {
+		t.Tuples.Add(tuple)
+	}
+	for _, attribute := range attributes {
+		t.Attributes.Add(attribute)
+	}
+}
+
+// Delete - Add the filters to the ones selecting the tuples and attributes the transaction deletes.
+func (t *Transaction) Delete(tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) {
+	t.TupleFilters = append(t.TupleFilters, tupleFilter)
+	t.AttributeFilters = append(t.AttributeFilters, attributeFilter)
+}
+
+// ExpectSchemaVersion - Make the schema migration applying the transaction fail with a schema version conflict unless
+// the latest schema version of the tenant is still version, empty for tenants without a schema.
+func (t *Transaction) ExpectSchemaVersion(version string) {
+	t.SchemaVersion = &version
+}
@@ -236,6 +236,10 @@ func references(entity *base.EntityDefinition) (coverage SchemaCoverage) {
 	}
 	// Iterate over all permissions in the entity
 	for _, permission := range entity.GetPermissions() {
+		// Permissions synthesized by the compiler are covered through the permissions walking them
+		if permission.GetSynthetic() {
+			continue
+		}
 		// Format and append the permission to the coverage struct
 		formattedPermission := fmt.Sprintf("%s#%s", entity.GetName(), permission.GetName())
 		coverage.Assertions = append(coverage.Assertions, formattedPermission)
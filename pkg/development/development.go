@@ -63,6 +63,7 @@ func NewContainer() *Development {
 	expandEngine := engines.NewExpandEngine(schemaReader, dataReader)
 	lookupEngine := engines.NewLookupEngine(checkEngine, schemaReader, dataReader)
 	subjectPermissionEngine := engines.NewSubjectPermission(checkEngine, schemaReader)
+	pathEngine := engines.NewPathEngine(checkEngine, schemaReader, dataReader)
 
 	invoker := invoke.NewDirectInvoker(
 		schemaReader,
@@ -71,6 +72,7 @@ func NewContainer() *Development {
 		expandEngine,
 		lookupEngine,
 		subjectPermissionEngine,
+		pathEngine,
 		telemetry.NewNoopMeter(),
 	)
 
@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"strings"
+)
+
 // Schema represents the parsed schema, which contains all the statements
 // and extracted entity and relational references used by the schema. It
 // is used as an intermediate representation before generating the
@@ -26,3 +30,15 @@ func (sch *Schema) GetReferences() *References {
 func (sch *Schema) SetReferences(refs *References) {
 	sch.references = refs
 }
+
+// String returns a string representation of the schema, its statements separated by new lines.
+func (sch *Schema) String() string {
+	var sb strings.Builder
+	for i, s := range sch.Statements {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(s.String())
+	}
+	return sb.String()
+}
@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/token"
@@ -15,11 +16,12 @@ type Statement interface {
 
 // EntityStatement represents a statement that refers to an entity.
 type EntityStatement struct {
-	Entity               token.Token // token.ENTITY
-	Name                 token.Token // token.IDENT
-	RelationStatements   []Statement // Statements that define relationships between entities
-	AttributeStatements  []Statement // Statements that define attributes of the entity
-	PermissionStatements []Statement // Statements that define permissions performed on the entity
+	Entity               token.Token        // token.ENTITY
+	Name                 token.Token        // token.IDENT
+	IDFormat             *IDFormatStatement // Format of the ids of the entity, nil if any id is accepted
+	RelationStatements   []Statement        // Statements that define relationships between entities
+	AttributeStatements  []Statement        // Statements that define attributes of the entity
+	PermissionStatements []Statement        // Statements that define permissions performed on the entity
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -31,6 +33,10 @@ func (ls *EntityStatement) String() string {
 	sb.WriteString("entity")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
+	if ls.IDFormat != nil {
+		sb.WriteString(" ")
+		sb.WriteString(ls.IDFormat.String())
+	}
 	sb.WriteString(" {")
 	sb.WriteString("\n")
 
@@ -73,6 +79,8 @@ type AttributeStatement struct {
 	Attribute     token.Token // token.ATTRIBUTE
 	Name          token.Token // token.IDENT
 	AttributeType AttributeTypeStatement
+	Default       *token.Token // token.BOOLEAN, token.INTEGER, token.DOUBLE or token.STRING, nil if there is no default
+	Required      bool         // Whether every entity must have a value for the attribute
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -88,6 +96,19 @@ func (as *AttributeStatement) String() string {
 	sb.WriteString(" ")
 	sb.WriteString(as.AttributeType.String())
 
+	if as.Default != nil {
+		sb.WriteString(" = ")
+		if as.Default.Type == token.STRING {
+			sb.WriteString(quote(as.Default.Literal))
+		} else {
+			sb.WriteString(as.Default.Literal)
+		}
+	}
+
+	if as.Required {
+		sb.WriteString(" (required)")
+	}
+
 	// Return the final string.
 	return sb.String()
 }
@@ -96,11 +117,63 @@ func (as *AttributeStatement) GetName() string {
 	return as.Name.Literal
 }
 
+// IDFormatStatement represents the format of the ids of an entity, such as (id uuid), (id int) or
+// (id "^[a-z]+$") for a regular expression.
+type IDFormatStatement struct {
+	LP     token.Token // token.LP
+	Format token.Token // token.IDENT for uuid or int, token.STRING for a regular expression
+}
+
+// String returns a string representation of the IDFormatStatement.
+func (is *IDFormatStatement) String() string {
+	if is.Format.Type == token.STRING {
+		return fmt.Sprintf("(id %s)", quote(is.Format.Literal))
+	}
+	return fmt.Sprintf("(id %s)", is.Format.Literal)
+}
+
+// DeprecationStatement represents the marker of a deprecated relation or permission, such as @deprecated or
+// @deprecated("use editor") with the reason of the deprecation.
+type DeprecationStatement struct {
+	Sign   token.Token // token.SIGN
+	Reason token.Token // token.STRING, empty if no reason is given
+}
+
+// String returns a string representation of the DeprecationStatement.
+func (ds *DeprecationStatement) String() string {
+	if ds.Reason.Literal == "" {
+		return "@deprecated"
+	}
+	return fmt.Sprintf("@deprecated(%s)", quote(ds.Reason.Literal))
+}
+
+// ShadowStatement represents the marker of a shadow permission, such as @shadow(legacy_edit) with the name of the
+// legacy permission whose result checks of the permission return.
+type ShadowStatement struct {
+	Sign   token.Token // token.SIGN
+	Legacy token.Token // token.IDENT
+}
+
+// String returns a string representation of the ShadowStatement.
+func (ss *ShadowStatement) String() string {
+	return fmt.Sprintf("@shadow(%s)", ss.Legacy.Literal)
+}
+
+// quote returns the string literal of the value, escaped so that the lexer reads it back as it is.
+func quote(value string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+	return `"` + r.Replace(value) + `"`
+}
+
 // RelationStatement represents a statement that defines a relationship between two entities.
 type RelationStatement struct {
 	Relation      token.Token             // token.RELATION
 	Name          token.Token             // token.IDENT
 	RelationTypes []RelationTypeStatement // Statements that define the types of the relationship
+	Cardinality   *CardinalityStatement   // Bounds on the number of subjects of the relationship, nil if unbounded
+	Deprecation   *DeprecationStatement   // Marker of the deprecation of the relation, nil if it is not deprecated
+	Operator      token.Token             // token.AND or token.NOT combining the relationships with the rewrite
+	Rewrite       *ExpressionStatement    // Relations and permissions the relationships are combined with, nil if none
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -110,6 +183,10 @@ func (ls *RelationStatement) statementNode() {}
 func (ls *RelationStatement) String() string {
 	var sb strings.Builder
 	sb.WriteString("\t")
+	if ls.Deprecation != nil {
+		sb.WriteString(ls.Deprecation.String())
+		sb.WriteString(" ")
+	}
 	sb.WriteString("relation")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
@@ -121,6 +198,18 @@ func (ls *RelationStatement) String() string {
 		sb.WriteString(" ")
 	}
 
+	if ls.Cardinality != nil {
+		sb.WriteString(ls.Cardinality.String())
+		sb.WriteString(" ")
+	}
+
+	if ls.Rewrite != nil {
+		sb.WriteString(ls.Operator.Literal)
+		sb.WriteString(" ")
+		sb.WriteString(ls.Rewrite.String())
+		sb.WriteString(" ")
+	}
+
 	// Return the final string.
 	return sb.String()
 }
@@ -129,6 +218,28 @@ func (ls *RelationStatement) GetName() string {
 	return ls.Name.Literal
 }
 
+// CardinalityStatement represents the bounds on the number of subjects of a relationship, such as (exactly 1),
+// (at least 1), (at most 3) or (at least 1, at most 3).
+type CardinalityStatement struct {
+	LP  token.Token // token.LP
+	Min int         // Minimum number of subjects
+	Max int         // Maximum number of subjects, zero if there is no maximum
+}
+
+// String returns a string representation of the CardinalityStatement.
+func (cs *CardinalityStatement) String() string {
+	switch {
+	case cs.Min == cs.Max:
+		return fmt.Sprintf("(exactly %d)", cs.Min)
+	case cs.Max == 0:
+		return fmt.Sprintf("(at least %d)", cs.Min)
+	case cs.Min == 0:
+		return fmt.Sprintf("(at most %d)", cs.Max)
+	default:
+		return fmt.Sprintf("(at least %d, at most %d)", cs.Min, cs.Max)
+	}
+}
+
 // AttributeTypeStatement represents a statement that defines the type of a relationship.
 type AttributeTypeStatement struct {
 	Type    token.Token // token.IDENT
@@ -180,9 +291,12 @@ func IsDirectEntityReference(s RelationTypeStatement) bool {
 // PermissionStatement represents an permission statement, which consists of an permission name and an optional expression statement.
 // It implements the Statement interface.
 type PermissionStatement struct {
-	Permission          token.Token // token.PERMISSION
+	Permission          token.Token // token.PERMISSION, or token.IDENT for an alias
 	Name                token.Token // token.IDENT
 	ExpressionStatement Statement
+	Alias               bool                  // Whether the permission is an alias, another name of a relation or permission of the entity
+	Deprecation         *DeprecationStatement // Marker of the deprecation of the permission, nil if it is not deprecated
+	Shadow              *ShadowStatement      // Marker of the legacy permission of a shadow permission, nil if it is not a shadow
 }
 
 // statementNode is a marker method used to implement the Statement interface.
@@ -192,7 +306,19 @@ func (ls *PermissionStatement) statementNode() {}
 func (ls *PermissionStatement) String() string {
 	var sb strings.Builder
 	sb.WriteString("\t")
-	sb.WriteString("permission")
+	if ls.Deprecation != nil {
+		sb.WriteString(ls.Deprecation.String())
+		sb.WriteString(" ")
+	}
+	if ls.Shadow != nil {
+		sb.WriteString(ls.Shadow.String())
+		sb.WriteString(" ")
+	}
+	if ls.Alias {
+		sb.WriteString("alias")
+	} else {
+		sb.WriteString("permission")
+	}
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
 	sb.WriteString(" = ")
@@ -0,0 +1,138 @@
+package ast
+
+import (
+	"sort"
+
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// Visitor - visits the nodes of a syntax tree walked with Walk. If the visitor w returned by Visit is not nil,
+// the children of the node are walked with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the syntax tree rooted at node in depth-first order, in the order of the source: the
+// statements of a schema, the relations, attributes and permissions of an entity, the types of a relation or an
+// attribute, the expression of a permission, the operands of an infix expression, the arguments of a call and
+// the argument types of a rule.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *Schema:
+		for _, s := range n.Statements {
+			Walk(v, s)
+		}
+	case *EntityStatement:
+		for _, s := range n.RelationStatements {
+			Walk(v, s)
+		}
+		for _, s := range n.AttributeStatements {
+			Walk(v, s)
+		}
+		for _, s := range n.PermissionStatements {
+			Walk(v, s)
+		}
+	case *RelationStatement:
+		for i := range n.RelationTypes {
+			Walk(v, &n.RelationTypes[i])
+		}
+	case *AttributeStatement:
+		Walk(v, &n.AttributeType)
+	case *PermissionStatement:
+		if n.ExpressionStatement != nil {
+			Walk(v, n.ExpressionStatement)
+		}
+	case *ExpressionStatement:
+		if n.Expression != nil {
+			Walk(v, n.Expression)
+		}
+	case *InfixExpression:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	case *Call:
+		for i := range n.Arguments {
+			Walk(v, &n.Arguments[i])
+		}
+	case *RuleStatement:
+		// The argument types are copies of the values of the map, walked in the order of their names in the source.
+		params := make([]token.Token, 0, len(n.Arguments))
+		for param := range n.Arguments {
+			params = append(params, param)
+		}
+		sort.Slice(params, func(i, j int) bool {
+			return Before(params[i].PositionInfo, params[j].PositionInfo)
+		})
+		for _, param := range params {
+			typ := n.Arguments[param]
+			Walk(v, &typ)
+		}
+	}
+
+	v.Visit(nil)
+}
+
+// inspector - adapts a function to the Visitor interface
+type inspector func(Node) bool
+
+// Visit calls the function and keeps walking the children of the node if it returns true.
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the syntax tree rooted at node in the order of Walk, calling f for every node. The children
+// of a node are visited if f returns true for it, and f is called with nil once they all are.
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Pos returns the position of the first token of the node, the keyword that opens it for statements.
+func Pos(node Node) token.PositionInfo {
+	switch n := node.(type) {
+	case *Schema:
+		if len(n.Statements) > 0 {
+			return Pos(n.Statements[0])
+		}
+	case *EntityStatement:
+		return n.Entity.PositionInfo
+	case *RelationStatement:
+		return n.Relation.PositionInfo
+	case *AttributeStatement:
+		return n.Attribute.PositionInfo
+	case *PermissionStatement:
+		return n.Permission.PositionInfo
+	case *RuleStatement:
+		return n.Rule.PositionInfo
+	case *RelationTypeStatement:
+		return n.Sign.PositionInfo
+	case *AttributeTypeStatement:
+		return n.Type.PositionInfo
+	case *ExpressionStatement:
+		if n.Expression != nil {
+			return Pos(n.Expression)
+		}
+	case *InfixExpression:
+		return Pos(n.Left)
+	case *Identifier:
+		if len(n.Idents) > 0 {
+			return n.Idents[0].PositionInfo
+		}
+	case *Call:
+		return n.Name.PositionInfo
+	}
+	return token.PositionInfo{}
+}
+
+// Before reports whether the position a comes before the position b in the source.
+func Before(a, b token.PositionInfo) bool {
+	if a.LinePosition != b.LinePosition {
+		return a.LinePosition < b.LinePosition
+	}
+	return a.ColumnPosition < b.ColumnPosition
+}
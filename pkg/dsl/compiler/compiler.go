@@ -3,9 +3,15 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/pkg/dsl/ast"
 	"github.com/Permify/permify/pkg/dsl/token"
@@ -13,12 +19,20 @@ import (
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
+// _maxSyntheticNameLength is the maximum length of the names of the synthesized permissions, the one of permission
+// names.
+const _maxSyntheticNameLength = 64
+
 // Compiler compiles an AST schema into a list of entity definitions.
 type Compiler struct {
 	// The AST schema to be compiled
 	schema *ast.Schema
 	// Whether to skip reference validation during compilation
 	withReferenceValidation bool
+	// The permissions synthesized to walk the identifiers crossing more than one relation, by entity
+	synthesized map[string][]*base.PermissionDefinition
+	// The identifiers the synthesized permissions walk, by entity and name of the permission
+	walks map[string]string
 }
 
 // NewCompiler returns a new Compiler instance with the given schema and reference validation flag.
@@ -40,6 +54,9 @@ func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition,
 		}
 	}
 
+	t.synthesized = map[string][]*base.PermissionDefinition{}
+	t.walks = map[string]string{}
+
 	// Create an empty slice to hold the entity definitions.
 	entities := make([]*base.EntityDefinition, 0, len(t.schema.Statements))
 	rules := make([]*base.RuleDefinition, 0, len(t.schema.Statements))
@@ -84,6 +101,14 @@ func (t *Compiler) Compile() ([]*base.EntityDefinition, []*base.RuleDefinition,
 		}
 	}
 
+	// Add the permissions synthesized while compiling the entities to the entities they walk
+	for _, entityDef := range entities {
+		for _, permissionDefinition := range t.synthesized[entityDef.GetName()] {
+			entityDef.Permissions[permissionDefinition.GetName()] = permissionDefinition
+			entityDef.References[permissionDefinition.GetName()] = base.EntityDefinition_REFERENCE_PERMISSION
+		}
+	}
+
 	return entities, rules, nil
 }
 
@@ -98,6 +123,22 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		References:  map[string]base.EntityDefinition_Reference{},
 	}
 
+	// Compile the format of the ids
+	if sc.IDFormat != nil {
+		switch sc.IDFormat.Format.Literal {
+		case "uuid":
+			entityDefinition.IdFormat = &base.IdFormat{Kind: base.IdFormat_KIND_UUID}
+		case "int":
+			entityDefinition.IdFormat = &base.IdFormat{Kind: base.IdFormat_KIND_INTEGER}
+		}
+		if sc.IDFormat.Format.Type == token.STRING {
+			if _, err := regexp.Compile(sc.IDFormat.Format.Literal); err != nil {
+				return nil, compileError(sc.IDFormat.Format.PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ID_FORMAT.String())
+			}
+			entityDefinition.IdFormat = &base.IdFormat{Kind: base.IdFormat_KIND_PATTERN, Pattern: sc.IDFormat.Format.Literal}
+		}
+	}
+
 	// Compile relations
 	for _, rs := range sc.RelationStatements {
 		// Cast the relation statement
@@ -120,6 +161,38 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 			})
 		}
 
+		// Compile the cardinality of the relation
+		if st.Cardinality != nil {
+			relationDefinition.Cardinality = &base.RelationCardinality{
+				Min: uint32(st.Cardinality.Min),
+				Max: uint32(st.Cardinality.Max),
+			}
+		}
+
+		// Compile the deprecation of the relation
+		if st.Deprecation != nil {
+			relationDefinition.Deprecation = &base.Deprecation{Reason: st.Deprecation.Reason.Literal}
+		}
+
+		// Compile the rewrite the relationships of the relation are combined with
+		if st.Rewrite != nil {
+			ch, err := t.compileChildren(entityDefinition.GetName(), st.Rewrite.Expression)
+			if err != nil {
+				return nil, err
+			}
+
+			rewrite := &base.Rewrite{Children: []*base.Child{ch}}
+			switch st.Operator.Type {
+			case token.AND:
+				rewrite.RewriteOperation = base.Rewrite_OPERATION_INTERSECTION
+			case token.NOT:
+				rewrite.RewriteOperation = base.Rewrite_OPERATION_EXCLUSION
+			default:
+				return nil, compileError(st.Operator.PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String())
+			}
+			relationDefinition.Rewrite = rewrite
+		}
+
 		// Add the relation definition and reference
 		entityDefinition.Relations[relationDefinition.GetName()] = relationDefinition
 		entityDefinition.References[relationDefinition.GetName()] = base.EntityDefinition_REFERENCE_RELATION
@@ -137,8 +210,17 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		}
 
 		attributeDefinition := &base.AttributeDefinition{
-			Name: st.Name.Literal,
-			Type: typ,
+			Name:     st.Name.Literal,
+			Type:     typ,
+			Required: st.Required,
+		}
+
+		// Compile the default value of the attribute
+		if st.Default != nil {
+			attributeDefinition.DefaultValue, err = getDefaultValue(typ, *st.Default)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		entityDefinition.Attributes[attributeDefinition.GetName()] = attributeDefinition
@@ -164,13 +246,152 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 			Name:  st.Name.Literal,
 			Child: ch,
 		}
+
+		// An alias names a relation or a permission of the entity, which it is checked as
+		if st.Alias {
+			target := ch.GetLeaf().GetComputedUserSet().GetRelation()
+			if target == "" {
+				return nil, compileError(st.Name.PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+			}
+			permissionDefinition.AliasOf = target
+		}
+
+		// Compile the deprecation of the permission
+		if st.Deprecation != nil {
+			permissionDefinition.Deprecation = &base.Deprecation{Reason: st.Deprecation.Reason.Literal}
+		}
+
+		// A shadow permission refers to its legacy permission, which it is checked as, and keeps its own logic aside
+		if st.Shadow != nil {
+			typ, _ := t.schema.GetReferences().GetReferenceType(utils.Key(entityDefinition.GetName(), st.Shadow.Legacy.Literal))
+			if typ != ast.RELATION && typ != ast.PERMISSION {
+				return nil, compileError(st.Shadow.Legacy.PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+			}
+			legacy, err := t.compileIdentifier(entityDefinition.GetName(), &ast.Identifier{Idents: []token.Token{st.Shadow.Legacy}})
+			if err != nil {
+				return nil, err
+			}
+			permissionDefinition.Shadow = permissionDefinition.GetChild()
+			permissionDefinition.Child = legacy
+		}
 		entityDefinition.Permissions[permissionDefinition.GetName()] = permissionDefinition
 		entityDefinition.References[permissionDefinition.GetName()] = base.EntityDefinition_REFERENCE_PERMISSION
 	}
 
+	// Reject the permissions referring back to themselves, if reference validation is enabled
+	if t.withReferenceValidation {
+		if err := validateCycles(sc, entityDefinition); err != nil {
+			return nil, err
+		}
+	}
+
 	return entityDefinition, nil
 }
 
+// validateCycles returns an error naming the cycle if a permission of the entity, or a relation combined with other
+// ones, refers back to itself through the relations and permissions of the entity, such as a = b and b = a, which no
+// check could ever end. Permissions walking a relation, such as parent.view in a hierarchy of folders, are recursive
+// through the relationships rather than the schema, so the cycles relationships may form are detected while
+// checking them instead.
+func validateCycles(sc *ast.EntityStatement, definition *base.EntityDefinition) error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := map[string]int{}
+	var path []string
+
+	// visit returns the cycle the permission of the name leads to, if any
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			i := slices.Index(path, name)
+			return append(slices.Clone(path[i:]), name)
+		}
+
+		var children []*base.Child
+		if permission, ok := definition.GetPermissions()[name]; ok {
+			children = []*base.Child{permission.GetChild()}
+			if shadow := permission.GetShadow(); shadow != nil {
+				children = append(children, shadow)
+			}
+		} else {
+			children = definition.GetRelations()[name].GetRewrite().GetChildren()
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		var next []string
+		for _, child := range children {
+			next = append(next, computedRelations(child)...)
+		}
+		for _, next := range next {
+			if cycle := visit(next); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, ms := range append(slices.Clone(sc.RelationStatements), sc.PermissionStatements...) {
+		cycle := visit(memberName(ms).Literal)
+		if cycle == nil {
+			continue
+		}
+
+		steps := make([]string, len(cycle))
+		for i, name := range cycle {
+			steps[i] = utils.Key(definition.GetName(), name)
+		}
+		return errors.New(compileError(memberPosition(sc, cycle[0]), base.ErrorCode_ERROR_CODE_CYCLE_DETECTED.String()).Error() + ": " + strings.Join(steps, " -> "))
+	}
+	return nil
+}
+
+// computedRelations returns the relations and permissions of the entity the child refers to, without walking a
+// relation.
+func computedRelations(child *base.Child) []string {
+	if leaf := child.GetLeaf(); leaf != nil {
+		if computed := leaf.GetComputedUserSet(); computed != nil {
+			return []string{computed.GetRelation()}
+		}
+		return nil
+	}
+
+	var relations []string
+	for _, ch := range child.GetRewrite().GetChildren() {
+		relations = append(relations, computedRelations(ch)...)
+	}
+	return relations
+}
+
+// memberPosition returns the position of the name of the relation or permission of the entity.
+func memberPosition(sc *ast.EntityStatement, name string) token.PositionInfo {
+	for _, ms := range append(slices.Clone(sc.RelationStatements), sc.PermissionStatements...) {
+		if n := memberName(ms); n.Literal == name {
+			return n.PositionInfo
+		}
+	}
+	return sc.Name.PositionInfo
+}
+
+// memberName returns the name of the relation or permission statement.
+func memberName(ms ast.Statement) token.Token {
+	switch st := ms.(type) {
+	case *ast.RelationStatement:
+		return st.Name
+	case *ast.PermissionStatement:
+		return st.Name
+	}
+	return token.Token{}
+}
+
 // compileRule compiles an ast.RuleStatement into a base.RuleDefinition object.
 // It takes an *ast.RuleStatement as input, processes its arguments, and
 // returns a *base.RuleDefinition or an error.
@@ -182,10 +403,16 @@ func (t *Compiler) compileRule(sc *ast.RuleStatement) (*base.RuleDefinition, err
 		Arguments: map[string]base.AttributeType{},
 	}
 
-	var envOptions []cel.EnvOption
+	// The request variable and the numeric helpers are available to every rule.
+	envOptions := utils.RuleEnvOptions()
 
 	// Iterate over the arguments in the rule statement.
 	for name, ty := range sc.Arguments {
+		// The request variable can't be shadowed by an argument.
+		if name.Literal == utils.RequestVariable {
+			return nil, compileError(name.PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+
 		// For each argument, use the getArgumentTypeIfExist function to determine the attribute type.
 		typ, err := getArgumentTypeIfExist(ty)
 		// If the attribute type is not recognized, return an error.
@@ -402,8 +629,140 @@ func (t *Compiler) compileIdentifier(entityName string, ident *ast.Identifier) (
 		return child, nil
 	}
 
-	// If the identifier has more than two segments, return an error
-	return nil, compileError(ident.Idents[2].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+	// If the identifier has more than two segments, it walks more than one relation
+	return t.compileRelationWalk(entityName, ident)
+}
+
+// compileRelationWalk compiles an identifier walking more than one relation, such as folder.parent.org.admin, into a
+// tuple to user set of its first relation and of a permission synthesized on each entity the relation relates to,
+// which walks the rest of the identifier. The synthesized permissions walk the rest the same way, down to the last
+// two segments, which are compiled as any tuple to user set. Permissions walking the same segments are synthesized
+// once by entity.
+func (t *Compiler) compileRelationWalk(entityName string, ident *ast.Identifier) (*base.Child, error) {
+	rest := &ast.Identifier{Idents: ident.Idents[1:]}
+	return t.compileWalk(entityName, ident.Idents[0], ident.Idents[1], syntheticName(rest), rest.String(), func(typ string) (*base.Child, error) {
+		return t.compileIdentifier(typ, rest)
+	})
+}
+
+// compileWalk compiles a walk of the relation into a tuple to user set of the relation and of the permission named
+// name, which is synthesized on each entity the relation relates to with the child compile returns for the entity.
+// The permission is synthesized once by entity for the same walk, and pos is reported when its name is taken.
+func (t *Compiler) compileWalk(entityName string, relation, pos token.Token, name, walk string, compile func(typ string) (*base.Child, error)) (*base.Child, error) {
+	types, exist := t.schema.GetReferences().GetRelationReferenceTypesIfExist(utils.Key(entityName, relation.Literal))
+	if !exist {
+		return nil, compileError(relation.PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+	}
+
+	for _, typ := range types {
+		key := utils.Key(typ.Type.Literal, name)
+
+		// The permission is already synthesized, unless its name is taken by another one
+		if w, ok := t.walks[key]; ok {
+			if w != walk {
+				return nil, compileError(pos.PositionInfo, base.ErrorCode_ERROR_CODE_DUPLICATED_PERMISSION_REFERENCE.String())
+			}
+			continue
+		}
+		if _, exist := t.schema.GetReferences().GetReferenceType(key); exist {
+			return nil, compileError(pos.PositionInfo, base.ErrorCode_ERROR_CODE_DUPLICATED_PERMISSION_REFERENCE.String())
+		}
+		t.walks[key] = walk
+
+		ch, err := compile(typ.Type.Literal)
+		if err != nil {
+			return nil, err
+		}
+
+		t.synthesized[typ.Type.Literal] = append(t.synthesized[typ.Type.Literal], &base.PermissionDefinition{
+			Name:      name,
+			Child:     ch,
+			Synthetic: true,
+		})
+	}
+
+	// Compile the walk into a TupleToUserSetIdentifier of the synthesized permission
+	leaf, err := t.compileTupleToUserSetIdentifier(relation.Literal, name)
+	if err != nil {
+		return nil, compileError(relation.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+
+	return &base.Child{Type: &base.Child_Leaf{Leaf: leaf}}, nil
+}
+
+// compileCallWalk compiles a call reading attributes of related entities, such as is_public(parent.classification),
+// into a walk of their first relation, the call being evaluated on each entity the relation relates to with the
+// relation dropped from the arguments. The attributes must be read through the same relations, and context
+// arguments are passed along.
+func (t *Compiler) compileCallWalk(entityName string, call *ast.Call, relations []token.Token) (*base.Child, error) {
+	rest := &ast.Call{Name: call.Name}
+	segments := []token.Token{call.Name}
+	for _, argument := range call.Arguments {
+		if isContextArgument(argument) {
+			rest.Arguments = append(rest.Arguments, argument)
+			segments = append(segments, argument.Idents...)
+			continue
+		}
+
+		if len(argument.Idents) != len(relations)+1 {
+			return nil, compileError(argument.Idents[0].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_WALK.String())
+		}
+		for i, relation := range relations {
+			if argument.Idents[i].Literal != relation.Literal {
+				return nil, compileError(argument.Idents[i].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_WALK.String())
+			}
+		}
+
+		rest.Arguments = append(rest.Arguments, ast.Identifier{Idents: argument.Idents[1:]})
+		segments = append(segments, argument.Idents[1:]...)
+	}
+
+	return t.compileWalk(entityName, relations[0], call.Name, syntheticName(&ast.Identifier{Idents: segments}), rest.String(), func(typ string) (*base.Child, error) {
+		return t.compileCall(typ, rest)
+	})
+}
+
+// walkedRelations returns the relations the attribute arguments of the call are read through, none if the call reads
+// the attributes of its own entity.
+func walkedRelations(call *ast.Call) []token.Token {
+	for _, argument := range call.Arguments {
+		if !isContextArgument(argument) && len(argument.Idents) > 1 {
+			return argument.Idents[:len(argument.Idents)-1]
+		}
+	}
+	return nil
+}
+
+// isContextArgument returns whether the argument of a call is read from the context of the request, such as
+// request.day_of_week.
+func isContextArgument(argument ast.Identifier) bool {
+	return len(argument.Idents) > 1 && argument.Idents[0].Literal == "request"
+}
+
+// syntheticName returns the name of the permission synthesized to walk the identifier, its segments each preceded by
+// an underscore, such as _parent_org_admin. Names longer than permission names can be are cut, and end with a hash of
+// the identifier instead.
+func syntheticName(ident *ast.Identifier) string {
+	var sb strings.Builder
+	for _, segment := range ident.Idents {
+		sb.WriteString("_")
+		sb.WriteString(segment.Literal)
+	}
+	name := sb.String()
+	if len(name) <= _maxSyntheticNameLength {
+		return name
+	}
+
+	// Permission names are made of letters, so is the hash
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(ident.String()))
+	sum := h.Sum32()
+	suffix := make([]byte, 7)
+	for i := range suffix {
+		suffix[i] = byte('a' + sum%26)
+		sum /= 26
+	}
+	return name[:_maxSyntheticNameLength-len(suffix)-1] + "_" + string(suffix)
 }
 
 // compileCall compiles a function call within the Compiler.
@@ -416,6 +775,11 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 	// Create a slice to store the call arguments.
 	var arguments []*base.Argument
 
+	// A call reading attributes of related entities is evaluated on them.
+	if relations := walkedRelations(call); len(relations) > 0 {
+		return t.compileCallWalk(entityName, call, relations)
+	}
+
 	// Create a map to store the types of the rule arguments, only if reference validation is enabled.
 	var types map[string]string
 
@@ -657,3 +1021,30 @@ func getArgumentTypeIfExist(tkn ast.AttributeTypeStatement) (base.AttributeType,
 
 	return attrType, nil
 }
+
+// getDefaultValue converts the default value of an attribute to a value of the type of the attribute. Only attributes
+// of scalar types can have a default value.
+func getDefaultValue(typ base.AttributeType, def token.Token) (*anypb.Any, error) {
+	var value proto.Message
+	switch {
+	case typ == base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN && def.Type == token.BOOLEAN:
+		value = &base.BooleanValue{Data: def.Literal == "true"}
+	case typ == base.AttributeType_ATTRIBUTE_TYPE_STRING && def.Type == token.STRING:
+		value = &base.StringValue{Data: def.Literal}
+	case typ == base.AttributeType_ATTRIBUTE_TYPE_INTEGER && def.Type == token.INTEGER:
+		i, err := strconv.ParseInt(def.Literal, 10, 32)
+		if err != nil {
+			return nil, compileError(def.PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		value = &base.IntegerValue{Data: int32(i)}
+	case typ == base.AttributeType_ATTRIBUTE_TYPE_DOUBLE && (def.Type == token.DOUBLE || def.Type == token.INTEGER):
+		f, err := strconv.ParseFloat(def.Literal, 64)
+		if err != nil {
+			return nil, compileError(def.PositionInfo, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		value = &base.DoubleValue{Data: f}
+	default:
+		return nil, compileError(def.PositionInfo, base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String())
+	}
+	return anypb.New(value)
+}
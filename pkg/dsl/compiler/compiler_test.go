@@ -380,6 +380,7 @@ var _ = Describe("compiler", func() {
 			entity repository {
 		
 				relation parent @organization
+				relation admin @user
 				permission update = parent.parent.admin or admin
 			}
 			`).Parse()
@@ -388,8 +389,19 @@ var _ = Describe("compiler", func() {
 
 			c := NewCompiler(true, sch)
 
-			_, _, err = c.Compile()
-			Expect(err).Should(Equal(errors.New("18:40: not supported relation walk")))
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The walk is compiled into a permission synthesized on the entity of the first relation
+			update := is[3].GetPermissions()["update"].GetChild().GetRewrite().GetChildren()[0].GetLeaf().GetTupleToUserSet()
+			Expect(update.GetTupleSet().GetRelation()).Should(Equal("parent"))
+			Expect(update.GetComputed().GetRelation()).Should(Equal("_parent_admin"))
+
+			synthesized := is[2].GetPermissions()["_parent_admin"]
+			Expect(synthesized.GetSynthetic()).Should(BeTrue())
+			Expect(synthesized.GetChild().GetLeaf().GetTupleToUserSet().GetTupleSet().GetRelation()).Should(Equal("parent"))
+			Expect(synthesized.GetChild().GetLeaf().GetTupleToUserSet().GetComputed().GetRelation()).Should(Equal("admin"))
+			Expect(is[2].GetReferences()["_parent_admin"]).Should(Equal(base.EntityDefinition_REFERENCE_PERMISSION))
 		})
 
 		It("Case 7", func() {
@@ -2132,5 +2144,507 @@ var _ = Describe("compiler", func() {
 
 			Expect(err.Error()).Should(Equal("15:29: schema compile"))
 		})
+		It("Case 23", func() {
+			sch, err := parser.NewParser(`
+				entity organization {
+					attribute balance double = -1.5
+					attribute region string = "eu"
+					attribute public boolean = true
+					attribute owner string (required)
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			attributes := is[0].GetAttributes()
+
+			balance := &base.DoubleValue{}
+			Expect(attributes["balance"].GetDefaultValue().UnmarshalTo(balance)).Should(Succeed())
+			Expect(balance.GetData()).Should(Equal(-1.5))
+
+			region := &base.StringValue{}
+			Expect(attributes["region"].GetDefaultValue().UnmarshalTo(region)).Should(Succeed())
+			Expect(region.GetData()).Should(Equal("eu"))
+
+			public := &base.BooleanValue{}
+			Expect(attributes["public"].GetDefaultValue().UnmarshalTo(public)).Should(Succeed())
+			Expect(public.GetData()).Should(BeTrue())
+
+			Expect(attributes["owner"].GetDefaultValue()).Should(BeNil())
+			Expect(attributes["owner"].GetRequired()).Should(BeTrue())
+		})
+
+		It("Case 24", func() {
+			sch, err := parser.NewParser(`
+				entity organization {
+					attribute balance integer = "a lot"
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+
+			Expect(err.Error()).Should(Equal("3:35: attribute type mismatch"))
+		})
+
+		It("Case 25", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation editor @user
+					@deprecated("use editor") relation writer @user
+
+					permission edit = editor or writer
+					@deprecated("use edit") alias update = edit
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			document := is[1]
+			Expect(document.GetRelations()["writer"].GetDeprecation().GetReason()).Should(Equal("use editor"))
+			Expect(document.GetRelations()["editor"].GetDeprecation()).Should(BeNil())
+
+			update := document.GetPermissions()["update"]
+			Expect(update.GetAliasOf()).Should(Equal("edit"))
+			Expect(update.GetDeprecation().GetReason()).Should(Equal("use edit"))
+			Expect(update.GetChild().GetLeaf().GetComputedUserSet().GetRelation()).Should(Equal("edit"))
+			Expect(document.GetReferences()["update"]).Should(Equal(base.EntityDefinition_REFERENCE_PERMISSION))
+		})
+
+		It("Case 26", func() {
+			sch, err := parser.NewParser(`
+				entity document {
+					attribute public boolean
+					alias open = public
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 27", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity organization {
+					relation admin @user
+				}
+
+				entity folder {
+					relation parent @folder
+					relation org @organization
+				}
+
+				entity document {
+					relation folder @folder
+					permission view = folder.parent.org.admin
+					permission edit = folder.parent.org.admin and folder.org.admin
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			folder, document := is[2], is[3]
+			Expect(document.GetPermissions()["view"].GetChild().GetLeaf().GetTupleToUserSet().GetComputed().GetRelation()).Should(Equal("_parent_org_admin"))
+
+			// Each walk is synthesized once by entity, down to the last relation
+			var synthesized []string
+			for name, permission := range folder.GetPermissions() {
+				if permission.GetSynthetic() {
+					synthesized = append(synthesized, name)
+				}
+			}
+			Expect(synthesized).Should(ConsistOf("_parent_org_admin", "_org_admin"))
+			Expect(folder.GetPermissions()["_org_admin"].GetChild().GetLeaf().GetTupleToUserSet().GetComputed().GetRelation()).Should(Equal("admin"))
+			Expect(is[1].GetPermissions()).Should(BeEmpty())
+		})
+
+		It("Case 28", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity organization {
+					relation admin @user
+				}
+
+				entity folder {
+					relation org @organization
+					permission _org_admin = org.admin
+				}
+
+				entity document {
+					relation folder @folder
+					permission view = folder.org.admin
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(Equal(errors.New("15:32: duplicated permission reference")))
+
+			sch, err = parser.NewParser(`
+				entity user {}
+
+				entity folder {
+					relation owner @user
+				}
+
+				entity document {
+					relation folder @folder
+					permission view = folder.parent.owner
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c = NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 29", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation owner @user
+					permission view = owner or edit
+					permission edit = manage
+					permission manage = owner and view
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(Equal(errors.New("6:18: cycle detected: document#view -> document#edit -> document#manage -> document#view")))
+		})
+
+		It("Case 30", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity folder {
+					relation parent @folder
+					relation owner @user
+					permission view = owner or parent.view
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("Case 31", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation reviewer @user
+					relation banned @user
+					relation approver @user and reviewer
+					relation viewer @user not banned
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			relations := is[1].GetRelations()
+			Expect(relations["reviewer"].GetRewrite()).Should(BeNil())
+
+			Expect(relations["approver"].GetRewrite().GetRewriteOperation()).Should(Equal(base.Rewrite_OPERATION_INTERSECTION))
+			Expect(relations["approver"].GetRewrite().GetChildren()[0].GetLeaf().GetComputedUserSet().GetRelation()).Should(Equal("reviewer"))
+
+			Expect(relations["viewer"].GetRewrite().GetRewriteOperation()).Should(Equal(base.Rewrite_OPERATION_EXCLUSION))
+			Expect(relations["viewer"].GetRewrite().GetChildren()[0].GetLeaf().GetComputedUserSet().GetRelation()).Should(Equal("banned"))
+		})
+
+		It("Case 32", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation approver @user and approver
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("cycle detected: document#approver -> document#approver"))
+		})
+
+		It("Case 33", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					attribute region string
+
+					permission view = from_region(region)
+				}
+
+				rule from_region(region string) {
+					request.context.region == region && request.time < timestamp('2030-01-01T00:00:00Z') && request.actor != ''
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, rules, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rules[0].GetArguments()).Should(Equal(map[string]base.AttributeType{"region": base.AttributeType_ATTRIBUTE_TYPE_STRING}))
+		})
+
+		It("Case 34", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {}
+
+				rule from_region(request string) {
+					request == 'eu'
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			// The request variable can't be shadowed by an argument
+			_, _, err = c.Compile()
+			Expect(err).Should(Equal(errors.New("6:23: invalid argument")))
+		})
+
+		It("Case 35", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					attribute region string
+
+					permission view = from_region(region)
+				}
+
+				rule from_region(region string) {
+					request.zone == region
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			// The fields of the request are checked like the arguments
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("undefined field 'zone'"))
+		})
+
+		It("Case 36", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity folder {
+					relation parent @folder
+					attribute classification string
+				}
+
+				entity document {
+					relation folder @folder
+					permission view = is_public(folder.classification)
+					permission edit = in_region(folder.parent.classification, request.region)
+				}
+
+				rule is_public(classification string) {
+					classification == 'public'
+				}
+
+				rule in_region(classification string, region string) {
+					classification == region
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The calls are evaluated on the related entities
+			view := is[2].GetPermissions()["view"].GetChild().GetLeaf().GetTupleToUserSet()
+			Expect(view.GetTupleSet().GetRelation()).Should(Equal("folder"))
+			Expect(view.GetComputed().GetRelation()).Should(Equal("_is_public_classification"))
+
+			edit := is[2].GetPermissions()["edit"].GetChild().GetLeaf().GetTupleToUserSet()
+			Expect(edit.GetComputed().GetRelation()).Should(Equal("_in_region_parent_classification_request_region"))
+
+			permissions := is[1].GetPermissions()
+			Expect(permissions["_is_public_classification"].GetChild().GetLeaf().GetCall().GetRuleName()).Should(Equal("is_public"))
+			Expect(permissions["_in_region_parent_classification_request_region"].GetChild().GetLeaf().GetTupleToUserSet().GetComputed().GetRelation()).Should(Equal("_in_region_classification_request_region"))
+
+			call := permissions["_in_region_classification_request_region"].GetChild().GetLeaf().GetCall()
+			Expect(call.GetArguments()[0].GetComputedAttribute().GetName()).Should(Equal("classification"))
+			Expect(call.GetArguments()[1].GetContextAttribute().GetName()).Should(Equal("region"))
+		})
+
+		It("Case 37", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity folder {
+					relation parent @folder
+					attribute classification string
+				}
+
+				entity document {
+					relation folder @folder
+					attribute classification string
+					permission view = same(folder.classification, classification)
+				}
+
+				rule same(classification string, level string) {
+					classification == level
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			// The attributes of a call are read from the same entities
+			_, _, err = c.Compile()
+			Expect(err).Should(Equal(errors.New("12:53: not supported walk")))
+		})
+
+		It("Case 38", func() {
+			sch, err := parser.NewParser(`
+				entity account {
+					attribute balance double
+					attribute overdraft integer
+					permission withdraw = check_balance(balance, overdraft)
+				}
+
+				rule check_balance(balance double, overdraft integer) {
+					between(balance + overdraft, 0, 5000.5) && balance > 10
+				}
+
+				rule check_overflow(overdraft integer) {
+					saturatingMultiply(clamp(overdraft, 0, 100), 2) < 200
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			// Integers and doubles are mixed without converting them
+			_, _, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("Case 39", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation owner @user
+					relation editor @user
+
+					permission edit = owner
+
+					@shadow(edit)
+					permission edit_next = owner or editor
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, _, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			permissions := is[1].GetPermissions()
+			Expect(permissions["edit"].GetShadow()).Should(BeNil())
+
+			// The shadow permission is checked as its legacy permission, and keeps its own logic aside
+			Expect(permissions["edit_next"].GetChild().GetLeaf().GetComputedUserSet().GetRelation()).Should(Equal("edit"))
+			Expect(permissions["edit_next"].GetShadow().GetRewrite().GetRewriteOperation()).Should(Equal(base.Rewrite_OPERATION_UNION))
+			Expect(permissions["edit_next"].GetShadow().GetRewrite().GetChildren()).Should(HaveLen(2))
+		})
+
+		It("Case 40", func() {
+			for body, expected := range map[string]string{
+				// the legacy permission is a relation or a permission of the entity
+				"@shadow(reviewer) permission edit = owner": "7:10: undefined relation reference",
+				"@shadow(region) permission edit = owner":   "7:10: undefined relation reference",
+				// the legacy permission does not lead back to the shadow permission
+				"permission view = edit\n@shadow(view) permission edit = owner": "cycle detected: document#view -> document#edit -> document#view",
+			} {
+				sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation owner @user
+					attribute region string
+` + body + `
+				}
+			`).Parse()
+				Expect(err).ShouldNot(HaveOccurred(), body)
+
+				c := NewCompiler(true, sch)
+
+				_, _, err = c.Compile()
+				Expect(err).Should(HaveOccurred(), body)
+				Expect(err.Error()).Should(ContainSubstring(expected), body)
+			}
+		})
 	})
 })
@@ -0,0 +1,81 @@
+// Package dsl parses and compiles schemas written in the Permify language, for the server and for tools built on
+// the language such as editors, linters and code generators.
+//
+// The work is split into subpackages: token defines the tokens and their positions, lexer splits a schema into
+// tokens, parser builds the syntax tree defined in ast from them, and compiler turns the tree into the entity and
+// rule definitions used by the engines. The types of ast are stable; Walk, Inspect and Pos of the ast package
+// traverse a tree and locate its nodes in the source.
+//
+// Compile runs the whole pipeline and reports its failures as an *Error carrying the position of the problem.
+package dsl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Error - Failure to parse or compile a schema at a position of its source
+type Error struct {
+	// Position of the problem in the source.
+	Position token.PositionInfo
+	// Message describing the problem.
+	Message string
+}
+
+// Error returns the error as line:column: message.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v:%v: %s", e.Position.LinePosition, e.Position.ColumnPosition, e.Message)
+}
+
+// Parse parses the schema into its syntax tree.
+func Parse(schema string) (*ast.Schema, error) {
+	sch, err := parser.NewParser(schema).Parse()
+	if err != nil {
+		return nil, toError(err)
+	}
+	return sch, nil
+}
+
+// Compile parses the schema and compiles it into entity and rule definitions with their references validated,
+// returning the syntax tree along with them. The tree is also returned with the error of a schema that parses but
+// does not compile.
+func Compile(schema string) (*ast.Schema, []*base.EntityDefinition, []*base.RuleDefinition, error) {
+	sch, err := Parse(schema)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+	if err != nil {
+		return sch, nil, nil, toError(err)
+	}
+
+	return sch, entities, rules, nil
+}
+
+// toError converts an error of the parser or the compiler, which starts with line:column:, to an *Error. Errors
+// without a position are returned as they are.
+func toError(err error) error {
+	parts := strings.SplitN(err.Error(), ":", 3)
+	if len(parts) != 3 {
+		return err
+	}
+
+	line, lerr := strconv.Atoi(parts[0])
+	column, cerr := strconv.Atoi(parts[1])
+	if lerr != nil || cerr != nil {
+		return err
+	}
+
+	return &Error{
+		Position: token.PositionInfo{LinePosition: line, ColumnPosition: column},
+		Message:  strings.TrimSpace(parts[2]),
+	}
+}
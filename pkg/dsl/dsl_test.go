@@ -0,0 +1,157 @@
+package dsl
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// TestDSL -
+func TestDSL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "dsl-suite")
+}
+
+var _ = Describe("dsl", func() {
+	Context("Compile", func() {
+		It("Case 1 - Valid schema", func() {
+			sch, entities, _, err := Compile(`
+entity user {}
+
+entity repository {
+	relation owner @user
+	permission edit = owner
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sch.Statements).Should(HaveLen(2))
+			Expect(entities).Should(HaveLen(2))
+		})
+
+		It("Case 2 - Parse error", func() {
+			_, _, _, err := Compile(`entity user {
+	relation & @user
+}`)
+			var e *Error
+			Expect(err).Should(BeAssignableToTypeOf(e))
+			e = err.(*Error)
+			Expect(e.Position).Should(Equal(token.PositionInfo{LinePosition: 2, ColumnPosition: 13}))
+			Expect(e.Message).Should(Equal("expected next token to be IDENT, got AMPERSAND instead"))
+		})
+
+		It("Case 3 - Compile error", func() {
+			sch, _, _, err := Compile(`
+entity user {}
+
+entity repository {
+	permission edit = owner
+}`)
+			Expect(sch).ShouldNot(BeNil())
+			Expect(err).Should(Equal(&Error{
+				Position: token.PositionInfo{LinePosition: 5, ColumnPosition: 21},
+				Message:  "undefined relation reference",
+			}))
+			Expect(err.Error()).Should(Equal("5:21: undefined relation reference"))
+		})
+	})
+
+	Context("Walk", func() {
+		It("Case 1 - Nodes in source order", func() {
+			sch, err := Parse(`
+entity user {}
+
+entity repository {
+	relation owner @user
+	relation parent @organization#member
+	attribute public boolean
+	permission edit = owner or (parent and public)
+}
+
+rule is_weekday(day_of_week string) {
+	day_of_week != 'saturday'
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var nodes []string
+			ast.Inspect(sch, func(node ast.Node) bool {
+				switch n := node.(type) {
+				case *ast.EntityStatement:
+					nodes = append(nodes, "entity "+n.Name.Literal)
+				case *ast.RelationStatement:
+					nodes = append(nodes, "relation "+n.Name.Literal)
+				case *ast.RelationTypeStatement:
+					nodes = append(nodes, n.String())
+				case *ast.AttributeStatement:
+					nodes = append(nodes, "attribute "+n.Name.Literal)
+				case *ast.AttributeTypeStatement:
+					nodes = append(nodes, n.String())
+				case *ast.PermissionStatement:
+					nodes = append(nodes, "permission "+n.Name.Literal)
+				case *ast.InfixExpression:
+					nodes = append(nodes, n.Operator.String())
+				case *ast.Identifier:
+					nodes = append(nodes, n.String())
+				case *ast.RuleStatement:
+					nodes = append(nodes, "rule "+n.Name.Literal)
+				}
+				return true
+			})
+
+			Expect(nodes).Should(Equal([]string{
+				"entity user",
+				"entity repository",
+				"relation owner", "@user",
+				"relation parent", "@organization#member",
+				"attribute public", "boolean",
+				"permission edit", "or", "owner", "and", "parent", "public",
+				"rule is_weekday", "string",
+			}))
+		})
+
+		It("Case 2 - Skipping children", func() {
+			sch, err := Parse(`
+entity repository {
+	relation owner @user
+	permission edit = owner
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count := 0
+			ast.Inspect(sch, func(node ast.Node) bool {
+				if node != nil {
+					count++
+				}
+				_, isEntity := node.(*ast.EntityStatement)
+				return !isEntity
+			})
+			Expect(count).Should(Equal(2))
+		})
+	})
+
+	Context("Pos", func() {
+		It("Case 1 - Positions of nodes", func() {
+			sch, err := Parse(`entity repository {
+	relation owner @user
+	permission edit = owner
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			entity := sch.Statements[0].(*ast.EntityStatement)
+			Expect(ast.Pos(entity).Line()).Should(Equal(1))
+			Expect(ast.Pos(entity).Column()).Should(Equal(1))
+
+			relation := entity.RelationStatements[0].(*ast.RelationStatement)
+			Expect(ast.Pos(relation).Line()).Should(Equal(2))
+			Expect(ast.Pos(relation).Column()).Should(Equal(2))
+			Expect(relation.Name.PositionInfo.Column()).Should(Equal(11))
+			Expect(relation.Name.End().Column()).Should(Equal(16))
+
+			permission := entity.PermissionStatements[0].(*ast.PermissionStatement)
+			Expect(ast.Pos(permission.ExpressionStatement).Line()).Should(Equal(3))
+			Expect(ast.Pos(permission.ExpressionStatement).Column()).Should(Equal(20))
+		})
+	})
+})
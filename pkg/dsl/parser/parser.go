@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/ast"
@@ -202,6 +203,15 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 		return nil, p.Error()
 	}
 
+	// if the next token is a left parenthesis, the name of the entity is followed by the format of its ids
+	if p.peekTokenIs(token.LP) {
+		format, err := p.parseIDFormatStatement()
+		if err != nil {
+			return nil, p.Error()
+		}
+		stmt.IDFormat = format
+	}
+
 	// expect the next token to be a left brace token, indicating the start of the entity's body
 	if !p.expectAndNext(token.LCB) {
 		return nil, p.Error()
@@ -234,6 +244,53 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 				return nil, p.Error()
 			}
 			stmt.PermissionStatements = append(stmt.PermissionStatements, action)
+		case token.SIGN:
+			// markers precede the relation, permission or alias they mark
+			deprecation, shadow, err := p.parseMarkers()
+			if err != nil {
+				return nil, p.Error()
+			}
+			// only permissions can be shadows of legacy permissions
+			if shadow != nil && !p.currentTokenIs(token.PERMISSION) {
+				p.currentError(token.PERMISSION)
+				return nil, p.Error()
+			}
+			if p.currentTokenIs(token.RELATION) {
+				relation, err := p.parseRelationStatement(stmt.Name.Literal)
+				if err != nil {
+					return nil, p.Error()
+				}
+				relation.Deprecation = deprecation
+				stmt.RelationStatements = append(stmt.RelationStatements, relation)
+				break
+			}
+			var action *ast.PermissionStatement
+			if p.currentTokenIs(token.PERMISSION) {
+				parsed, err := p.parsePermissionStatement(stmt.Name.Literal)
+				if err != nil {
+					return nil, p.Error()
+				}
+				action = parsed.(*ast.PermissionStatement)
+			} else {
+				action, err = p.parseAliasStatement(stmt.Name.Literal)
+				if err != nil {
+					return nil, p.Error()
+				}
+			}
+			action.Deprecation = deprecation
+			action.Shadow = shadow
+			stmt.PermissionStatements = append(stmt.PermissionStatements, action)
+		case token.IDENT:
+			// alias is not a keyword, so that it can still name relations and permissions
+			if !p.isAlias() {
+				p.currentError(token.RELATION, token.PERMISSION, token.ATTRIBUTE)
+				return nil, p.Error()
+			}
+			alias, err := p.parseAliasStatement(stmt.Name.Literal)
+			if err != nil {
+				return nil, p.Error()
+			}
+			stmt.PermissionStatements = append(stmt.PermissionStatements, alias)
 		default:
 			// if the currentToken is not recognized, check if it is a newline, left brace, or right brace token, and skip it if it is
 			if !p.currentTokenIs(token.NEWLINE) && !p.currentTokenIs(token.LCB) && !p.currentTokenIs(token.RCB) {
@@ -370,6 +427,71 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 	return stmt, nil
 }
 
+// parseIDFormatStatement method parses the format of the ids of an ENTITY statement, the id keyword followed by
+// uuid, int or a string holding a regular expression, in parentheses, and returns an IDFormatStatement AST node
+func (p *Parser) parseIDFormatStatement() (*ast.IDFormatStatement, error) {
+	// expect the next token to be a left parenthesis, indicating the start of the format
+	if !p.expectAndNext(token.LP) {
+		return nil, p.Error()
+	}
+	stmt := &ast.IDFormatStatement{LP: p.currentToken}
+
+	// expect the format to start with id
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	if p.currentToken.Literal != "id" {
+		p.idFormatError(p.currentToken.Literal)
+		return nil, p.Error()
+	}
+
+	// the format is either uuid or int, or a regular expression
+	if p.peekTokenIs(token.STRING) {
+		p.next()
+	} else {
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		if p.currentToken.Literal != "uuid" && p.currentToken.Literal != "int" {
+			p.idFormatError(p.currentToken.Literal)
+			return nil, p.Error()
+		}
+	}
+	stmt.Format = p.currentToken
+
+	// expect the next token to be a right parenthesis, indicating the end of the format
+	if !p.expectAndNext(token.RP) {
+		return nil, p.Error()
+	}
+
+	return stmt, nil
+}
+
+// parseAttributeDefault method parses the default value of an ATTRIBUTE statement, a boolean, a number that may be
+// negative or a string, and returns its token
+func (p *Parser) parseAttributeDefault() (*token.Token, error) {
+	// a minus sign is folded into the literal of the number it precedes
+	sign := ""
+	if p.peekTokenIs(token.MINUS) {
+		p.next()
+		sign = "-"
+		if !p.peekTokenIs(token.INTEGER, token.DOUBLE) {
+			p.peekError(token.INTEGER, token.DOUBLE)
+			return nil, p.Error()
+		}
+	}
+
+	if !p.peekTokenIs(token.BOOLEAN, token.INTEGER, token.DOUBLE, token.STRING) {
+		p.peekError(token.BOOLEAN, token.INTEGER, token.DOUBLE, token.STRING)
+		return nil, p.Error()
+	}
+	p.next()
+
+	def := p.currentToken
+	def.Literal = sign + def.Literal
+	return &def, nil
+}
+
 // parseRelationStatement method parses a RELATION statement and returns a RelationStatement AST node
 func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeStatement, error) {
 	// create a new RelationStatement object and set its Relation field to the currentToken
@@ -398,6 +520,32 @@ func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeState
 
 	stmt.AttributeType = atstmt
 
+	// if the next token is an assignment, the type is followed by the default value of the attribute
+	if p.peekTokenIs(token.ASSIGN) {
+		p.next()
+		def, err := p.parseAttributeDefault()
+		if err != nil {
+			return nil, p.Error()
+		}
+		stmt.Default = def
+	}
+
+	// if the next token is a left parenthesis, the attribute is required
+	if p.peekTokenIs(token.LP) {
+		p.next()
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		if p.currentToken.Literal != "required" || stmt.Default != nil {
+			p.attributeError(stmt.Name.Literal, p.currentToken.Literal)
+			return nil, p.Error()
+		}
+		if !p.expectAndNext(token.RP) {
+			return nil, p.Error()
+		}
+		stmt.Required = true
+	}
+
 	key := utils.Key(entityName, stmt.Name.Literal)
 	// add the relation reference to the Parser's relationReferences and relationalReferences maps
 	err := p.references.SetAttributeReferences(key, atstmt)
@@ -437,6 +585,35 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 		stmt.RelationTypes = append(stmt.RelationTypes, *relationStatement)
 	}
 
+	// if the next token is a left parenthesis, the relation types are followed by the cardinality of the relation
+	if p.peekTokenIs(token.LP) {
+		cardinality, err := p.parseCardinalityStatement()
+		if err != nil {
+			return nil, p.Error()
+		}
+		stmt.Cardinality = cardinality
+	}
+
+	// if the next token is and or not, the relationships are combined with a relation or permission of the entity,
+	// or a parenthesized expression of them
+	if p.peekTokenIs(token.AND) || p.peekTokenIs(token.NOT) {
+		p.next()
+		stmt.Operator = p.currentToken
+		p.next()
+
+		ex, err := p.parseExpression(AND_OR_NOT)
+		if err != nil {
+			return nil, p.Error()
+		}
+		stmt.Rewrite = &ast.ExpressionStatement{Expression: ex}
+
+		// a single operator combines the relationships, so that the order of the operations is explicit
+		if p.isInfixOperator(p.peekToken.Type) {
+			p.peekError(token.NEWLINE)
+			return nil, p.Error()
+		}
+	}
+
 	key := utils.Key(entityName, relationName)
 
 	// add the relation reference to the Parser's relationReferences and relationalReferences maps
@@ -450,6 +627,193 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 	return stmt, nil
 }
 
+// parseCardinalityStatement method parses the cardinality of a RELATION statement, a parenthesized list of bounds
+// separated by commas, each one of exactly N, at least N or at most N, and returns a CardinalityStatement AST node
+func (p *Parser) parseCardinalityStatement() (*ast.CardinalityStatement, error) {
+	// expect the next token to be a left parenthesis, indicating the start of the cardinality
+	if !p.expectAndNext(token.LP) {
+		return nil, p.Error()
+	}
+	stmt := &ast.CardinalityStatement{LP: p.currentToken}
+
+	var hasMin, hasMax bool
+	for {
+		// each bound starts with exactly, at least or at most
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		bound := p.currentToken.Literal
+		if bound == "at" {
+			if !p.expectAndNext(token.IDENT) {
+				return nil, p.Error()
+			}
+			bound += " " + p.currentToken.Literal
+		}
+
+		// the bound is followed by the number of subjects
+		if !p.expectAndNext(token.INTEGER) {
+			return nil, p.Error()
+		}
+		n, err := strconv.Atoi(p.currentToken.Literal)
+		if err != nil {
+			p.currentError(token.INTEGER)
+			return nil, p.Error()
+		}
+
+		switch {
+		case bound == "exactly" && !hasMin && !hasMax && n > 0:
+			stmt.Min, stmt.Max = n, n
+			hasMin, hasMax = true, true
+		case bound == "at least" && !hasMin && n > 0:
+			stmt.Min = n
+			hasMin = true
+		case bound == "at most" && !hasMax && n > 0:
+			stmt.Max = n
+			hasMax = true
+		default:
+			p.cardinalityError(bound, n)
+			return nil, p.Error()
+		}
+
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
+		p.next()
+	}
+
+	// the maximum cannot be less than the minimum
+	if stmt.Max != 0 && stmt.Max < stmt.Min {
+		p.cardinalityError("at most", stmt.Max)
+		return nil, p.Error()
+	}
+
+	// expect the next token to be a right parenthesis, indicating the end of the cardinality
+	if !p.expectAndNext(token.RP) {
+		return nil, p.Error()
+	}
+
+	return stmt, nil
+}
+
+// isAlias returns whether the current token starts an ALIAS statement.
+func (p *Parser) isAlias() bool {
+	return p.currentTokenIs(token.IDENT) && p.currentToken.Literal == "alias"
+}
+
+// parseMarkers method parses the markers preceding a relation, a permission or an alias, each one at most once, and
+// moves to the statement they mark, on the same line or on the next one
+func (p *Parser) parseMarkers() (deprecation *ast.DeprecationStatement, shadow *ast.ShadowStatement, err error) {
+	for p.currentTokenIs(token.SIGN) {
+		// the currentToken is the SIGN token starting the marker
+		sign := p.currentToken
+
+		// expect the name of the marker
+		if !p.expectAndNext(token.IDENT) {
+			return nil, nil, p.Error()
+		}
+		switch {
+		case p.currentToken.Literal == "deprecated" && deprecation == nil:
+			deprecation, err = p.parseDeprecationStatement(sign)
+		case p.currentToken.Literal == "shadow" && shadow == nil:
+			shadow, err = p.parseShadowStatement(sign)
+		default:
+			p.markerError(p.currentToken.Literal)
+			return nil, nil, p.Error()
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// move to the next marker or to the statement the markers mark
+		p.next()
+		for p.currentTokenIs(token.NEWLINE) {
+			p.next()
+		}
+	}
+
+	if !p.currentTokenIs(token.RELATION, token.PERMISSION) && !p.isAlias() {
+		p.currentError(token.RELATION, token.PERMISSION)
+		return nil, nil, p.Error()
+	}
+
+	return deprecation, shadow, nil
+}
+
+// parseDeprecationStatement method parses the @deprecated marker of a relation, a permission or an alias, optionally
+// followed by the reason of the deprecation in parentheses
+func (p *Parser) parseDeprecationStatement(sign token.Token) (*ast.DeprecationStatement, error) {
+	stmt := &ast.DeprecationStatement{Sign: sign}
+
+	// if the next token is a left parenthesis, the marker is followed by the reason of the deprecation
+	if p.peekTokenIs(token.LP) {
+		p.next()
+		if !p.expectAndNext(token.STRING) {
+			return nil, p.Error()
+		}
+		stmt.Reason = p.currentToken
+		if !p.expectAndNext(token.RP) {
+			return nil, p.Error()
+		}
+	}
+
+	return stmt, nil
+}
+
+// parseShadowStatement method parses the @shadow marker of a permission, followed by the name of the legacy
+// permission in parentheses
+func (p *Parser) parseShadowStatement(sign token.Token) (*ast.ShadowStatement, error) {
+	stmt := &ast.ShadowStatement{Sign: sign}
+
+	// expect the name of the legacy permission in parentheses
+	if !p.expectAndNext(token.LP) {
+		return nil, p.Error()
+	}
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Legacy = p.currentToken
+	if !p.expectAndNext(token.RP) {
+		return nil, p.Error()
+	}
+
+	return stmt, nil
+}
+
+// parseAliasStatement method parses an ALIAS statement, another name of a relation or a permission of the entity,
+// such as alias writer = editor, and returns it as a PermissionStatement AST node granting what the name it aliases
+// grants
+func (p *Parser) parseAliasStatement(entityName string) (*ast.PermissionStatement, error) {
+	// the currentToken is the alias identifier
+	stmt := &ast.PermissionStatement{Permission: p.currentToken, Alias: true}
+
+	// expect the next token to be the name of the alias
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+
+	key := utils.Key(entityName, stmt.Name.Literal)
+	// add the alias as a permission reference, so that it can be checked and referenced as one
+	err := p.references.SetPermissionReference(key)
+	if err != nil {
+		p.duplicationError(key) // Generate an error message indicating a duplication error
+		return nil, p.Error()
+	}
+
+	// expect the name to be followed by an ASSIGN token and the name of the relation or permission it aliases
+	if !p.expectAndNext(token.ASSIGN) {
+		return nil, p.Error()
+	}
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.ExpressionStatement = &ast.ExpressionStatement{
+		Expression: &ast.Identifier{Idents: []token.Token{p.currentToken}},
+	}
+
+	return stmt, nil
+}
+
 // parseRelationTypeStatement method parses a single relation type within a RELATION statement and returns a RelationTypeStatement AST node
 func (p *Parser) parseRelationTypeStatement() (*ast.RelationTypeStatement, error) {
 	// expect the currentToken to be a SIGN token, indicating the start of the relation type
@@ -813,6 +1177,34 @@ func (p *Parser) duplicationError(key string) {
 	p.errors = append(p.errors, msg)
 }
 
+// attributeError adds an error message to the parser's error list indicating that the options of an attribute are
+// not valid, as an attribute is either required or has a default value.
+func (p *Parser) attributeError(name, literal string) {
+	msg := fmt.Sprintf("%v:%v:invalid option %s of attribute %s, expected either a default value or required", p.l.GetLinePosition(), p.l.GetColumnPosition(), literal, name)
+	p.errors = append(p.errors, msg)
+}
+
+// idFormatError adds an error message to the parser's error list indicating that the format of the ids of an
+// entity is not valid, as the format must be id followed by uuid, int or a regular expression.
+func (p *Parser) idFormatError(literal string) {
+	msg := fmt.Sprintf("%v:%v:invalid id format %s, expected id uuid, id int or id followed by a regular expression", p.l.GetLinePosition(), p.l.GetColumnPosition(), literal)
+	p.errors = append(p.errors, msg)
+}
+
+// markerError adds an error message to the parser's error list indicating that the marker of a relation or a
+// permission is unknown or repeated, as it can be marked once as deprecated and once as a shadow.
+func (p *Parser) markerError(literal string) {
+	msg := fmt.Sprintf("%v:%v:unknown marker @%s, expected @deprecated or @shadow once each", p.l.GetLinePosition(), p.l.GetColumnPosition(), literal)
+	p.errors = append(p.errors, msg)
+}
+
+// cardinalityError adds an error message to the parser's error list indicating that a bound of the cardinality of
+// a relation is not valid, either because it is unknown, repeated, conflicting or not positive.
+func (p *Parser) cardinalityError(bound string, n int) {
+	msg := fmt.Sprintf("%v:%v:invalid cardinality bound %s %d", p.l.GetLinePosition(), p.l.GetColumnPosition(), bound, n)
+	p.errors = append(p.errors, msg)
+}
+
 // noPrefixParseFnError adds an error message to the parser's error list indicating that no prefix parsing
 // function was found for a given token type.
 // It takes a token type as an argument that indicates the type of the token for which a parsing function is missing.
@@ -771,5 +771,315 @@ var _ = Describe("parser", func() {
 			// Ensure the error message contains the expected string
 			Expect(err.Error()).Should(ContainSubstring("6:18:expected next token to be IDENT, got ASSIGN instead"))
 		})
+		It("Case 23", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation owner @user (exactly 1)
+				relation editor @user (at least 1, at most 3)
+				relation viewer @user (at most 10)
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[1].(*ast.EntityStatement)
+
+			owner := st.RelationStatements[0].(*ast.RelationStatement)
+			Expect(owner.RelationTypes[0].Type.Literal).Should(Equal("user"))
+			Expect(*owner.Cardinality).Should(Equal(ast.CardinalityStatement{LP: owner.Cardinality.LP, Min: 1, Max: 1}))
+			Expect(owner.Cardinality.String()).Should(Equal("(exactly 1)"))
+
+			editor := st.RelationStatements[1].(*ast.RelationStatement)
+			Expect(editor.Cardinality.Min).Should(Equal(1))
+			Expect(editor.Cardinality.Max).Should(Equal(3))
+			Expect(editor.Cardinality.String()).Should(Equal("(at least 1, at most 3)"))
+
+			viewer := st.RelationStatements[2].(*ast.RelationStatement)
+			Expect(viewer.Cardinality.String()).Should(Equal("(at most 10)"))
+		})
+
+		It("Case 24", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation owner @user (at least 3, at most 2)
+			}
+			`)
+
+			_, err := pr.Parse()
+
+			// Ensure an error is returned
+			Expect(err).Should(HaveOccurred())
+
+			// Ensure the error message contains the expected string
+			Expect(err.Error()).Should(ContainSubstring("invalid cardinality bound at most 2"))
+		})
+		It("Case 25", func() {
+			pr := NewParser(`
+			entity user (id uuid) {}
+
+			entity order (id int) {}
+
+			entity repository (id "^[a-z0-9_-]+\\.git$") {
+				relation owner @user
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			user := schema.Statements[0].(*ast.EntityStatement)
+			Expect(user.IDFormat.Format.Literal).Should(Equal("uuid"))
+
+			order := schema.Statements[1].(*ast.EntityStatement)
+			Expect(order.IDFormat.Format.Literal).Should(Equal("int"))
+
+			repository := schema.Statements[2].(*ast.EntityStatement)
+			Expect(repository.IDFormat.Format.Type.String()).Should(Equal("STRING"))
+			Expect(repository.IDFormat.Format.Literal).Should(Equal(`^[a-z0-9_-]+\.git$`))
+
+			// The string representation parses back to the same format
+			reparsed, err := NewParser(repository.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reparsed.Statements[0].(*ast.EntityStatement).IDFormat.Format.Literal).Should(Equal(`^[a-z0-9_-]+\.git$`))
+		})
+
+		It("Case 26", func() {
+			pr := NewParser(`
+			entity user (id email) {}
+			`)
+
+			_, err := pr.Parse()
+
+			// Ensure an error is returned
+			Expect(err).Should(HaveOccurred())
+
+			// Ensure the error message contains the expected string
+			Expect(err.Error()).Should(ContainSubstring("invalid id format email"))
+		})
+		It("Case 27", func() {
+			pr := NewParser(`
+			entity organization {
+				attribute balance integer = -100
+				attribute region string = "eu"
+				attribute owner string (required)
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[0].(*ast.EntityStatement)
+
+			balance := st.AttributeStatements[0].(*ast.AttributeStatement)
+			Expect(balance.Default.Literal).Should(Equal("-100"))
+			Expect(balance.String()).Should(Equal("\tattribute balance integer = -100"))
+
+			region := st.AttributeStatements[1].(*ast.AttributeStatement)
+			Expect(region.Default.Literal).Should(Equal("eu"))
+			Expect(region.String()).Should(Equal("\tattribute region string = \"eu\""))
+
+			owner := st.AttributeStatements[2].(*ast.AttributeStatement)
+			Expect(owner.Default).Should(BeNil())
+			Expect(owner.Required).Should(BeTrue())
+			Expect(owner.String()).Should(Equal("\tattribute owner string (required)"))
+		})
+
+		It("Case 28", func() {
+			pr := NewParser(`
+			entity organization {
+				attribute region string = "eu" (required)
+			}
+			`)
+
+			_, err := pr.Parse()
+
+			// Ensure an error is returned
+			Expect(err).Should(HaveOccurred())
+
+			// Ensure the error message contains the expected string
+			Expect(err.Error()).Should(ContainSubstring("invalid option required of attribute region"))
+		})
+
+		It("Case 29", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation editor @user
+				@deprecated("use editor") relation writer @user
+
+				permission edit = editor
+				@deprecated
+				permission update = edit
+				@deprecated("use edit") alias write = edit
+				alias modify = editor
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[1].(*ast.EntityStatement)
+
+			writer := st.RelationStatements[1].(*ast.RelationStatement)
+			Expect(writer.Deprecation.Reason.Literal).Should(Equal("use editor"))
+			Expect(writer.String()).Should(Equal("\t@deprecated(\"use editor\") relation writer @user "))
+			Expect(st.RelationStatements[0].(*ast.RelationStatement).Deprecation).Should(BeNil())
+
+			update := st.PermissionStatements[1].(*ast.PermissionStatement)
+			Expect(update.Deprecation.Reason.Literal).Should(Equal(""))
+			Expect(update.Alias).Should(BeFalse())
+
+			write := st.PermissionStatements[2].(*ast.PermissionStatement)
+			Expect(write.Alias).Should(BeTrue())
+			Expect(write.String()).Should(Equal("\t@deprecated(\"use edit\") alias write = edit"))
+
+			modify := st.PermissionStatements[3].(*ast.PermissionStatement)
+			Expect(modify.Alias).Should(BeTrue())
+			Expect(modify.Deprecation).Should(BeNil())
+
+			// The statements are read back as they are written
+			again, err := NewParser(schema.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.String()).Should(Equal(schema.String()))
+		})
+
+		It("Case 30", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				@experimental relation editor @user
+			}
+			`)
+
+			_, err := pr.Parse()
+
+			// Ensure an error is returned
+			Expect(err).Should(HaveOccurred())
+
+			// Ensure the error message contains the expected string
+			Expect(err.Error()).Should(ContainSubstring("unknown marker @experimental"))
+		})
+
+		It("Case 31", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation reviewer @user
+				relation banned @user
+				relation approver @user (at most 3) and reviewer
+				relation viewer @user not (banned or approver)
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[1].(*ast.EntityStatement)
+
+			approver := st.RelationStatements[2].(*ast.RelationStatement)
+			Expect(approver.Operator.Literal).Should(Equal("and"))
+			Expect(approver.Rewrite.String()).Should(Equal("reviewer"))
+			Expect(approver.Cardinality.Max).Should(Equal(3))
+			Expect(approver.String()).Should(Equal("\trelation approver @user (at most 3) and reviewer "))
+
+			viewer := st.RelationStatements[3].(*ast.RelationStatement)
+			Expect(viewer.Operator.Literal).Should(Equal("not"))
+			Expect(viewer.Rewrite.String()).Should(Equal("(banned or approver)"))
+
+			Expect(st.RelationStatements[0].(*ast.RelationStatement).Rewrite).Should(BeNil())
+
+			// The statements are read back as they are written
+			again, err := NewParser(schema.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.String()).Should(Equal(schema.String()))
+		})
+
+		It("Case 32", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation reviewer @user
+				relation banned @user
+				relation approver @user and reviewer not banned
+			}
+			`)
+
+			_, err := pr.Parse()
+
+			// A single operator combines the relationships
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected next token to be NEWLINE"))
+		})
+
+		It("Case 33", func() {
+			pr := NewParser(`
+			entity user {}
+
+			entity document {
+				relation owner @user
+				relation editor @user
+
+				permission edit = owner
+
+				@shadow(edit)
+				@deprecated("use edit")
+				permission edit_next = owner or editor
+
+				@shadow(edit) permission view = editor
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[1].(*ast.EntityStatement)
+
+			Expect(st.PermissionStatements[0].(*ast.PermissionStatement).Shadow).Should(BeNil())
+
+			next := st.PermissionStatements[1].(*ast.PermissionStatement)
+			Expect(next.Shadow.Legacy.Literal).Should(Equal("edit"))
+			Expect(next.Deprecation.Reason.Literal).Should(Equal("use edit"))
+			Expect(next.String()).Should(Equal("\t@deprecated(\"use edit\") @shadow(edit) permission edit_next = (owner or editor)"))
+
+			view := st.PermissionStatements[2].(*ast.PermissionStatement)
+			Expect(view.Shadow.Legacy.Literal).Should(Equal("edit"))
+			Expect(view.Deprecation).Should(BeNil())
+
+			// The statements are read back as they are written
+			again, err := NewParser(schema.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again.String()).Should(Equal(schema.String()))
+		})
+
+		It("Case 34", func() {
+			for _, body := range []string{
+				// only permissions can be shadows
+				"@shadow(owner) relation editor @user",
+				"@shadow(owner) alias writer = owner",
+				// a shadow names its legacy permission
+				"@shadow permission edit = owner",
+				// a permission is marked once as a shadow
+				"@shadow(owner) @shadow(owner) permission edit = owner",
+			} {
+				_, err := NewParser(`
+				entity user {}
+
+				entity document {
+					relation owner @user
+					` + body + `
+				}
+				`).Parse()
+				Expect(err).Should(HaveOccurred(), body)
+			}
+		})
 	})
 })
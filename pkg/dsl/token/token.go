@@ -8,6 +8,17 @@ type PositionInfo struct {
 	ColumnPosition int
 }
 
+// Line - returns the one-based line of the position.
+func (p PositionInfo) Line() int {
+	return p.LinePosition
+}
+
+// Column - returns the one-based column of the position. The lexer counts columns from one past the first one,
+// so the column is one less than ColumnPosition.
+func (p PositionInfo) Column() int {
+	return p.ColumnPosition - 1
+}
+
 // Type - defines a custom type for tokens.
 type Type string
 
@@ -32,6 +43,11 @@ type Token struct {
 	Literal string
 }
 
+// End - returns the position right after the literal of the token, on the line of the token.
+func (t Token) End() PositionInfo {
+	return PositionInfo{LinePosition: t.PositionInfo.LinePosition, ColumnPosition: t.PositionInfo.ColumnPosition + len(t.Literal)}
+}
+
 // New - creates a new Token with the given type and literal value.
 func New(positionInfo PositionInfo, typ Type, ch byte) Token {
 	return Token{PositionInfo: positionInfo, Type: typ, Literal: string(ch)}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"math"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// numericTypes are the numeric types of the attributes, which the numeric helpers accept in any combination.
+var numericTypes = []*cel.Type{cel.IntType, cel.DoubleType}
+
+// NumericEnvOptions returns the CEL environment options of the numeric helpers of the rules:
+//   - integers and doubles are ordered with each other by their values,
+//   - arithmetic mixing integers and doubles promotes the integers to doubles and results in a double,
+//   - between(value, min, max) checks that a number is within inclusive bounds, of any numeric types,
+//   - clamp(value, min, max) bounds a number, of the type of its bounds,
+//   - saturatingAdd, saturatingSubtract and saturatingMultiply compute with integers, resulting in the largest or
+//     smallest integer instead of failing the evaluation when the result overflows.
+func NumericEnvOptions() []cel.EnvOption {
+	opts := []cel.EnvOption{cel.CrossTypeNumericComparisons(true)}
+
+	for _, op := range []struct {
+		function string
+		overload string
+		compute  func(a, b float64) float64
+	}{
+		{operators.Add, "add", func(a, b float64) float64 { return a + b }},
+		{operators.Subtract, "subtract", func(a, b float64) float64 { return a - b }},
+		{operators.Multiply, "multiply", func(a, b float64) float64 { return a * b }},
+		{operators.Divide, "divide", func(a, b float64) float64 { return a / b }},
+	} {
+		compute := op.compute
+		binding := cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			return types.Double(compute(toFloat(lhs), toFloat(rhs)))
+		})
+		intDouble, doubleInt := op.overload+"_int_double", op.overload+"_double_int"
+		opts = append(opts,
+			// The operators have a single binding for all of their overloads, so the mixed overloads are only declared
+			// on them for the type-checker, and bound under a function of their own the program dispatches them by id.
+			cel.Function(op.function,
+				cel.Overload(intDouble, []*cel.Type{cel.IntType, cel.DoubleType}, cel.DoubleType),
+				cel.Overload(doubleInt, []*cel.Type{cel.DoubleType, cel.IntType}, cel.DoubleType),
+			),
+			cel.Function("@numeric_"+op.overload,
+				cel.Overload(intDouble, []*cel.Type{cel.IntType, cel.DoubleType}, cel.DoubleType, binding),
+				cel.Overload(doubleInt, []*cel.Type{cel.DoubleType, cel.IntType}, cel.DoubleType, binding),
+			),
+		)
+	}
+
+	var between []cel.FunctionOpt
+	for _, value := range numericTypes {
+		for _, lower := range numericTypes {
+			for _, upper := range numericTypes {
+				between = append(between, cel.Overload(
+					"between_"+value.String()+"_"+lower.String()+"_"+upper.String(),
+					[]*cel.Type{value, lower, upper},
+					cel.BoolType,
+					cel.FunctionBinding(betweenBinding),
+				))
+			}
+		}
+	}
+	opts = append(opts, cel.Function("between", between...))
+
+	opts = append(opts,
+		cel.Function("clamp",
+			cel.Overload("clamp_int_int_int", []*cel.Type{cel.IntType, cel.IntType, cel.IntType}, cel.IntType,
+				cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+					return types.Int(min(max(args[0].(types.Int), args[1].(types.Int)), args[2].(types.Int)))
+				})),
+			cel.Overload("clamp_double_double_double", []*cel.Type{cel.DoubleType, cel.DoubleType, cel.DoubleType}, cel.DoubleType,
+				cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+					return types.Double(math.Min(math.Max(float64(args[0].(types.Double)), float64(args[1].(types.Double))), float64(args[2].(types.Double))))
+				})),
+		),
+		saturating("saturatingAdd", saturatingAdd),
+		saturating("saturatingSubtract", saturatingSubtract),
+		saturating("saturatingMultiply", saturatingMultiply),
+	)
+
+	return opts
+}
+
+// saturating declares the saturating integer arithmetic function computed by compute.
+func saturating(name string, compute func(a, b int64) int64) cel.EnvOption {
+	return cel.Function(name,
+		cel.Overload(name+"_int_int", []*cel.Type{cel.IntType, cel.IntType}, cel.IntType,
+			cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+				return types.Int(compute(int64(lhs.(types.Int)), int64(rhs.(types.Int))))
+			})),
+	)
+}
+
+// betweenBinding returns whether the first argument is within the inclusive bounds of the other two. Integers are
+// compared exactly, and compared with doubles by their values.
+func betweenBinding(args ...ref.Val) ref.Val {
+	value, lower, upper := args[0], args[1], args[2]
+	if v, ok := value.(types.Int); ok {
+		l, lok := lower.(types.Int)
+		u, uok := upper.(types.Int)
+		if lok && uok {
+			return types.Bool(l <= v && v <= u)
+		}
+	}
+	v := toFloat(value)
+	return types.Bool(toFloat(lower) <= v && v <= toFloat(upper))
+}
+
+// toFloat returns the value of an integer or a double as a float64.
+func toFloat(val ref.Val) float64 {
+	switch v := val.(type) {
+	case types.Int:
+		return float64(v)
+	case types.Double:
+		return float64(v)
+	default:
+		return math.NaN()
+	}
+}
+
+// saturatingAdd returns a + b, or the largest or smallest integer if the sum overflows.
+func saturatingAdd(a, b int64) int64 {
+	sum := a + b
+	if (a^sum)&(b^sum) < 0 {
+		return bound(a < 0)
+	}
+	return sum
+}
+
+// saturatingSubtract returns a - b, or the largest or smallest integer if the difference overflows.
+func saturatingSubtract(a, b int64) int64 {
+	difference := a - b
+	if (a^b)&(a^difference) < 0 {
+		return bound(a < 0)
+	}
+	return difference
+}
+
+// saturatingMultiply returns a * b, or the largest or smallest integer if the product overflows.
+func saturatingMultiply(a, b int64) int64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return bound((a < 0) != (b < 0))
+	}
+	return product
+}
+
+// bound returns the smallest integer if negative, the largest one otherwise.
+func bound(negative bool) int64 {
+	if negative {
+		return math.MinInt64
+	}
+	return math.MaxInt64
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -22,11 +23,38 @@ func Key(v1, v2 string) string {
 	return sb.String()
 }
 
+// RequestVariable is the variable rules read the request the check is evaluated for from, as a base.RequestInfo.
+const RequestVariable = "request"
+
+// RequestEnvOptions returns the CEL environment options declaring the request variable of the rules.
+func RequestEnvOptions() []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Types(&base.RequestInfo{}),
+		cel.Variable(RequestVariable, cel.ObjectType("base.v1.RequestInfo")),
+	}
+}
+
+// RuleEnvOptions returns the CEL environment options every rule is compiled with, the request variable and the numeric
+// helpers.
+func RuleEnvOptions() []cel.EnvOption {
+	return append(RequestEnvOptions(), NumericEnvOptions()...)
+}
+
+// ReadsRequest returns whether the checked expression of a rule reads the request variable.
+func ReadsRequest(expr *exprpb.CheckedExpr) bool {
+	for _, ref := range expr.GetReferenceMap() {
+		if ref.GetName() == RequestVariable {
+			return true
+		}
+	}
+	return false
+}
+
 // ArgumentsAsCelEnv converts a map of attributes to a CEL environment.
 // It iterates through the map, retrieves the CEL type for each attribute,
-// and appends it to an array of CEL environment options.
+// and appends it to an array of CEL environment options, along with the options of RuleEnvOptions.
 func ArgumentsAsCelEnv(arguments map[string]base.AttributeType) (*cel.Env, error) {
-	opts := make([]cel.EnvOption, 0, len(arguments))
+	opts := RuleEnvOptions()
 	for name, typ := range arguments {
 		typ, err := GetCelType(typ)
 		if err != nil {
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"math"
 	"testing"
 
 	"github.com/google/cel-go/cel"
@@ -251,4 +252,70 @@ var _ = Describe("Utils", func() {
 			})
 		})
 	})
+
+	Describe("NumericEnvOptions function", func() {
+		evaluate := func(expression string, vars map[string]interface{}) (interface{}, error) {
+			env, err := ArgumentsAsCelEnv(map[string]base.AttributeType{
+				"count":   base.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+				"balance": base.AttributeType_ATTRIBUTE_TYPE_DOUBLE,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			ast, issues := env.Compile(expression)
+			Expect(issues.Err()).NotTo(HaveOccurred())
+
+			prg, err := env.Program(ast)
+			Expect(err).NotTo(HaveOccurred())
+
+			out, _, err := prg.Eval(vars)
+			if err != nil {
+				return nil, err
+			}
+			return out.Value(), nil
+		}
+
+		It("should compare and combine integers with doubles", func() {
+			vars := map[string]interface{}{"count": 3, "balance": 2.5}
+
+			for expression, expected := range map[string]interface{}{
+				"balance > 2":              true,
+				"count >= balance":         true,
+				"double(count) == 3.0":     true,
+				"count + balance":          5.5,
+				"balance - count":          -0.5,
+				"count * balance":          7.5,
+				"count / 2.0":              1.5,
+				"count / 2":                int64(1),
+				"between(count, 1, 3)":     true,
+				"between(count, 3.5, 10)":  false,
+				"between(balance, 2, 2.5)": true,
+				"clamp(count, 5, 10)":      int64(5),
+				"clamp(balance, 0.0, 1.0)": 1.0,
+			} {
+				out, err := evaluate(expression, vars)
+				Expect(err).NotTo(HaveOccurred(), expression)
+				Expect(out).To(Equal(expected), expression)
+			}
+		})
+
+		It("should saturate integer arithmetic instead of overflowing", func() {
+			vars := map[string]interface{}{"count": math.MaxInt64, "balance": 0.0}
+
+			for expression, expected := range map[string]interface{}{
+				"saturatingAdd(count, 1)":            int64(math.MaxInt64),
+				"saturatingSubtract(-count, 2)":      int64(math.MinInt64),
+				"saturatingMultiply(count, -2)":      int64(math.MinInt64),
+				"saturatingMultiply(-count - 1, -1)": int64(math.MaxInt64),
+				"saturatingAdd(count, -1)":           int64(math.MaxInt64 - 1),
+				"saturatingMultiply(3, 4)":           int64(12),
+			} {
+				out, err := evaluate(expression, vars)
+				Expect(err).NotTo(HaveOccurred(), expression)
+				Expect(out).To(Equal(expected), expression)
+			}
+
+			_, err := evaluate("count + 1", vars)
+			Expect(err).To(MatchError(ContainSubstring("integer overflow")))
+		})
+	})
 })
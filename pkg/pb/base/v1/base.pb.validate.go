@@ -230,6 +230,167 @@ var _ interface {
 	ErrorName() string
 } = ContextValidationError{}
 
+// Validate checks the field values on RequestInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RequestInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RequestInfoMultiError, or
+// nil if none found.
+func (m *RequestInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestInfoValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestInfoValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestInfoValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ip
+
+	// no validation rules for Actor
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestInfoValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestInfoValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestInfoValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestInfoMultiError is an error wrapping multiple validation errors
+// returned by RequestInfo.ValidateAll() if the designated constraints aren't met.
+type RequestInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestInfoMultiError) AllErrors() []error { return m }
+
+// RequestInfoValidationError is the validation error returned by
+// RequestInfo.Validate if the designated constraints aren't met.
+type RequestInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestInfoValidationError) ErrorName() string { return "RequestInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RequestInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestInfoValidationError{}
+
 // Validate checks the field values on Child with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1288,6 +1449,35 @@ func (m *EntityDefinition) validate(all bool) error {
 
 	// no validation rules for References
 
+	if all {
+		switch v := interface{}(m.GetIdFormat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntityDefinitionValidationError{
+					field:  "IdFormat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntityDefinitionValidationError{
+					field:  "IdFormat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdFormat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntityDefinitionValidationError{
+				field:  "IdFormat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return EntityDefinitionMultiError(errors)
 	}
@@ -1368,6 +1558,109 @@ var _ interface {
 
 var _EntityDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
+// Validate checks the field values on IdFormat with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IdFormat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdFormat with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdFormatMultiError, or nil
+// if none found.
+func (m *IdFormat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdFormat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Pattern
+
+	if len(errors) > 0 {
+		return IdFormatMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdFormatMultiError is an error wrapping multiple validation errors returned
+// by IdFormat.ValidateAll() if the designated constraints aren't met.
+type IdFormatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdFormatMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdFormatMultiError) AllErrors() []error { return m }
+
+// IdFormatValidationError is the validation error returned by
+// IdFormat.Validate if the designated constraints aren't met.
+type IdFormatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdFormatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdFormatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdFormatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdFormatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdFormatValidationError) ErrorName() string { return "IdFormatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdFormatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdFormat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdFormatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdFormatValidationError{}
+
 // Validate checks the field values on RuleDefinition with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1569,6 +1862,37 @@ func (m *AttributeDefinition) validate(all bool) error {
 
 	// no validation rules for Type
 
+	if all {
+		switch v := interface{}(m.GetDefaultValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "DefaultValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDefaultValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDefinitionValidationError{
+				field:  "DefaultValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Required
+
 	if len(errors) > 0 {
 		return AttributeDefinitionMultiError(errors)
 	}
@@ -1729,6 +2053,93 @@ func (m *RelationDefinition) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetCardinality()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Cardinality",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Cardinality",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCardinality()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationDefinitionValidationError{
+				field:  "Cardinality",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeprecation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Deprecation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Deprecation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeprecation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationDefinitionValidationError{
+				field:  "Deprecation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRewrite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRewrite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationDefinitionValidationError{
+				field:  "Rewrite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RelationDefinitionMultiError(errors)
 	}
@@ -1799,7 +2210,115 @@ func (e RelationDefinitionValidationError) Error() string {
 		cause)
 }
 
-var _ error = RelationDefinitionValidationError{}
+var _ error = RelationDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationDefinitionValidationError{}
+
+var _RelationDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on RelationCardinality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationCardinality) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationCardinality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationCardinalityMultiError, or nil if none found.
+func (m *RelationCardinality) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationCardinality) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return RelationCardinalityMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationCardinalityMultiError is an error wrapping multiple validation
+// errors returned by RelationCardinality.ValidateAll() if the designated
+// constraints aren't met.
+type RelationCardinalityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationCardinalityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationCardinalityMultiError) AllErrors() []error { return m }
+
+// RelationCardinalityValidationError is the validation error returned by
+// RelationCardinality.Validate if the designated constraints aren't met.
+type RelationCardinalityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationCardinalityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationCardinalityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationCardinalityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationCardinalityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationCardinalityValidationError) ErrorName() string {
+	return "RelationCardinalityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationCardinalityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationCardinality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationCardinalityValidationError{}
 
 var _ interface {
 	Field() string
@@ -1807,9 +2326,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationDefinitionValidationError{}
-
-var _RelationDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+} = RelationCardinalityValidationError{}
 
 // Validate checks the field values on PermissionDefinition with the rules
 // defined in the proto definition for this message. If any rules are
@@ -1884,6 +2401,68 @@ func (m *PermissionDefinition) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDeprecation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Deprecation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Deprecation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeprecation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionDefinitionValidationError{
+				field:  "Deprecation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AliasOf
+
+	// no validation rules for Synthetic
+
+	if all {
+		switch v := interface{}(m.GetShadow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShadow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionDefinitionValidationError{
+				field:  "Shadow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionDefinitionMultiError(errors)
 	}
@@ -1966,6 +2545,107 @@ var _ interface {
 
 var _PermissionDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
+// Validate checks the field values on Deprecation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Deprecation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Deprecation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeprecationMultiError, or
+// nil if none found.
+func (m *Deprecation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Deprecation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DeprecationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeprecationMultiError is an error wrapping multiple validation errors
+// returned by Deprecation.ValidateAll() if the designated constraints aren't met.
+type DeprecationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeprecationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeprecationMultiError) AllErrors() []error { return m }
+
+// DeprecationValidationError is the validation error returned by
+// Deprecation.Validate if the designated constraints aren't met.
+type DeprecationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeprecationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeprecationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeprecationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeprecationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeprecationValidationError) ErrorName() string { return "DeprecationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeprecationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeprecation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeprecationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeprecationValidationError{}
+
 // Validate checks the field values on RelationReference with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -4985,6 +5665,10 @@ func (m *Expand) validate(all bool) error {
 
 	}
 
+	// no validation rules for Pruned
+
+	// no validation rules for ContinuousToken
+
 	switch v := m.Node.(type) {
 	case *Expand_Expand:
 		if v == nil {
@@ -6134,6 +6818,169 @@ var _ interface {
 	ErrorName() string
 } = DataChangeValidationError{}
 
+// Validate checks the field values on TupleChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TupleChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TupleChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TupleChangeMultiError, or
+// nil if none found.
+func (m *TupleChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TupleChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleChangeValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleChangeValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleChangeValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleChangeValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleChangeValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleChangeValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Actor
+
+	if len(errors) > 0 {
+		return TupleChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TupleChangeMultiError is an error wrapping multiple validation errors
+// returned by TupleChange.ValidateAll() if the designated constraints aren't met.
+type TupleChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TupleChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TupleChangeMultiError) AllErrors() []error { return m }
+
+// TupleChangeValidationError is the validation error returned by
+// TupleChange.Validate if the designated constraints aren't met.
+type TupleChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TupleChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TupleChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TupleChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TupleChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TupleChangeValidationError) ErrorName() string { return "TupleChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TupleChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTupleChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TupleChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TupleChangeValidationError{}
+
 // Validate checks the field values on StringValue with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
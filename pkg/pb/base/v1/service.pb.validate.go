@@ -405,6 +405,66 @@ func (m *PermissionCheckRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSnapshotTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshotTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckRequestMetadataValidationError{
+				field:  "SnapshotTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Debug
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckRequestMetadataValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionCheckRequestMetadataMultiError(errors)
 	}
@@ -643,6 +703,37 @@ func (m *PermissionCheckResponseMetadata) validate(all bool) error {
 
 	// no validation rules for CheckCount
 
+	if all {
+		switch v := interface{}(m.GetDebug()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckResponseMetadataValidationError{
+					field:  "Debug",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckResponseMetadataValidationError{
+					field:  "Debug",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDebug()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckResponseMetadataValidationError{
+				field:  "Debug",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestDependent
+
 	if len(errors) > 0 {
 		return PermissionCheckResponseMetadataMultiError(errors)
 	}
@@ -724,202 +815,86 @@ var _ interface {
 	ErrorName() string
 } = PermissionCheckResponseMetadataValidationError{}
 
-// Validate checks the field values on PermissionExpandRequest with the rules
+// Validate checks the field values on PermissionCheckDebug with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandRequest) Validate() error {
+func (m *PermissionCheckDebug) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PermissionCheckDebug with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PermissionExpandRequestMultiError, or nil if none found.
-func (m *PermissionExpandRequest) ValidateAll() error {
+// PermissionCheckDebugMultiError, or nil if none found.
+func (m *PermissionCheckDebug) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionExpandRequest) validate(all bool) error {
+func (m *PermissionCheckDebug) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := PermissionExpandRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionExpandRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionExpandRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := PermissionExpandRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionExpandRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetEntity() == nil {
-		err := PermissionExpandRequestValidationError{
-			field:  "Entity",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for CacheHit
 
-	if all {
-		switch v := interface{}(m.GetEntity()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionExpandRequestValidationError{
-				field:  "Entity",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CacheHits
 
-	if m.GetPermission() != "" {
+	// no validation rules for CacheMisses
 
-		if len(m.GetPermission()) > 64 {
-			err := PermissionExpandRequestValidationError{
-				field:  "Permission",
-				reason: "value length must be at most 64 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for DispatchCount
 
-		if !_PermissionExpandRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-			err := PermissionExpandRequestValidationError{
-				field:  "Permission",
-				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for SnapToken
 
-	}
+	// no validation rules for SchemaVersion
 
 	if all {
-		switch v := interface{}(m.GetContext()).(type) {
+		switch v := interface{}(m.GetDuration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Context",
+				errors = append(errors, PermissionCheckDebugValidationError{
+					field:  "Duration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Context",
+				errors = append(errors, PermissionCheckDebugValidationError{
+					field:  "Duration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionExpandRequestValidationError{
-				field:  "Context",
+			return PermissionCheckDebugValidationError{
+				field:  "Duration",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetArguments() {
+	for idx, item := range m.GetSchemaMismatches() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PermissionExpandRequestValidationError{
-						field:  fmt.Sprintf("Arguments[%v]", idx),
+					errors = append(errors, PermissionCheckDebugValidationError{
+						field:  fmt.Sprintf("SchemaMismatches[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PermissionExpandRequestValidationError{
-						field:  fmt.Sprintf("Arguments[%v]", idx),
+					errors = append(errors, PermissionCheckDebugValidationError{
+						field:  fmt.Sprintf("SchemaMismatches[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -927,8 +902,8 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PermissionExpandRequestValidationError{
-					field:  fmt.Sprintf("Arguments[%v]", idx),
+				return PermissionCheckDebugValidationError{
+					field:  fmt.Sprintf("SchemaMismatches[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -938,19 +913,19 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionExpandRequestMultiError(errors)
+		return PermissionCheckDebugMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionExpandRequestMultiError is an error wrapping multiple validation
-// errors returned by PermissionExpandRequest.ValidateAll() if the designated
+// PermissionCheckDebugMultiError is an error wrapping multiple validation
+// errors returned by PermissionCheckDebug.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionExpandRequestMultiError []error
+type PermissionCheckDebugMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandRequestMultiError) Error() string {
+func (m PermissionCheckDebugMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -959,11 +934,11 @@ func (m PermissionExpandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionExpandRequestMultiError) AllErrors() []error { return m }
+func (m PermissionCheckDebugMultiError) AllErrors() []error { return m }
 
-// PermissionExpandRequestValidationError is the validation error returned by
-// PermissionExpandRequest.Validate if the designated constraints aren't met.
-type PermissionExpandRequestValidationError struct {
+// PermissionCheckDebugValidationError is the validation error returned by
+// PermissionCheckDebug.Validate if the designated constraints aren't met.
+type PermissionCheckDebugValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -971,24 +946,24 @@ type PermissionExpandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionExpandRequestValidationError) Field() string { return e.field }
+func (e PermissionCheckDebugValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionExpandRequestValidationError) Reason() string { return e.reason }
+func (e PermissionCheckDebugValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionExpandRequestValidationError) Cause() error { return e.cause }
+func (e PermissionCheckDebugValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionExpandRequestValidationError) Key() bool { return e.key }
+func (e PermissionCheckDebugValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionExpandRequestValidationError) ErrorName() string {
-	return "PermissionExpandRequestValidationError"
+func (e PermissionCheckDebugValidationError) ErrorName() string {
+	return "PermissionCheckDebugValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionExpandRequestValidationError) Error() string {
+func (e PermissionCheckDebugValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1000,14 +975,14 @@ func (e PermissionExpandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionExpandRequest.%s: %s%s",
+		"invalid %sPermissionCheckDebug.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionExpandRequestValidationError{}
+var _ error = PermissionCheckDebugValidationError{}
 
 var _ interface {
 	Field() string
@@ -1015,52 +990,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionExpandRequestValidationError{}
-
-var _PermissionExpandRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
-
-var _PermissionExpandRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+} = PermissionCheckDebugValidationError{}
 
-// Validate checks the field values on PermissionExpandRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandRequestMetadata) Validate() error {
+// Validate checks the field values on SchemaMismatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaMismatch) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionExpandRequestMetadataMultiError, or nil if none found.
-func (m *PermissionExpandRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on SchemaMismatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaMismatchMultiError,
+// or nil if none found.
+func (m *SchemaMismatch) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionExpandRequestMetadata) validate(all bool) error {
+func (m *SchemaMismatch) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	// no validation rules for Item
 
-	// no validation rules for SnapToken
+	// no validation rules for Code
 
 	if len(errors) > 0 {
-		return PermissionExpandRequestMetadataMultiError(errors)
+		return SchemaMismatchMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionExpandRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by PermissionExpandRequestMetadata.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionExpandRequestMetadataMultiError []error
+// SchemaMismatchMultiError is an error wrapping multiple validation errors
+// returned by SchemaMismatch.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaMismatchMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandRequestMetadataMultiError) Error() string {
+func (m SchemaMismatchMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1069,12 +1040,11 @@ func (m PermissionExpandRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionExpandRequestMetadataMultiError) AllErrors() []error { return m }
+func (m SchemaMismatchMultiError) AllErrors() []error { return m }
 
-// PermissionExpandRequestMetadataValidationError is the validation error
-// returned by PermissionExpandRequestMetadata.Validate if the designated
-// constraints aren't met.
-type PermissionExpandRequestMetadataValidationError struct {
+// SchemaMismatchValidationError is the validation error returned by
+// SchemaMismatch.Validate if the designated constraints aren't met.
+type SchemaMismatchValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1082,24 +1052,22 @@ type PermissionExpandRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionExpandRequestMetadataValidationError) Field() string { return e.field }
+func (e SchemaMismatchValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionExpandRequestMetadataValidationError) Reason() string { return e.reason }
+func (e SchemaMismatchValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionExpandRequestMetadataValidationError) Cause() error { return e.cause }
+func (e SchemaMismatchValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionExpandRequestMetadataValidationError) Key() bool { return e.key }
+func (e SchemaMismatchValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionExpandRequestMetadataValidationError) ErrorName() string {
-	return "PermissionExpandRequestMetadataValidationError"
-}
+func (e SchemaMismatchValidationError) ErrorName() string { return "SchemaMismatchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PermissionExpandRequestMetadataValidationError) Error() string {
+func (e SchemaMismatchValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1111,14 +1079,14 @@ func (e PermissionExpandRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionExpandRequestMetadata.%s: %s%s",
+		"invalid %sSchemaMismatch.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionExpandRequestMetadataValidationError{}
+var _ error = SchemaMismatchValidationError{}
 
 var _ interface {
 	Field() string
@@ -1126,165 +1094,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionExpandRequestMetadataValidationError{}
+} = SchemaMismatchValidationError{}
 
-// Validate checks the field values on PermissionExpandResponse with the rules
+// Validate checks the field values on PermissionExpandRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandResponse) Validate() error {
+func (m *PermissionExpandRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandResponse with the
+// ValidateAll checks the field values on PermissionExpandRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PermissionExpandResponseMultiError, or nil if none found.
-func (m *PermissionExpandResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *PermissionExpandResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetTree()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandResponseValidationError{
-					field:  "Tree",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandResponseValidationError{
-					field:  "Tree",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTree()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionExpandResponseValidationError{
-				field:  "Tree",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return PermissionExpandResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// PermissionExpandResponseMultiError is an error wrapping multiple validation
-// errors returned by PermissionExpandResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionExpandResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m PermissionExpandResponseMultiError) AllErrors() []error { return m }
-
-// PermissionExpandResponseValidationError is the validation error returned by
-// PermissionExpandResponse.Validate if the designated constraints aren't met.
-type PermissionExpandResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e PermissionExpandResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e PermissionExpandResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e PermissionExpandResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e PermissionExpandResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e PermissionExpandResponseValidationError) ErrorName() string {
-	return "PermissionExpandResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e PermissionExpandResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sPermissionExpandResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = PermissionExpandResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = PermissionExpandResponseValidationError{}
-
-// Validate checks the field values on PermissionLookupEntityRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupEntityRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on PermissionLookupEntityRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityRequestMultiError, or nil if none found.
-func (m *PermissionLookupEntityRequest) ValidateAll() error {
+// PermissionExpandRequestMultiError, or nil if none found.
+func (m *PermissionExpandRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityRequest) validate(all bool) error {
+func (m *PermissionExpandRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := PermissionLookupEntityRequestValidationError{
+	if len(m.GetTenantId()) > 64 {
+		err := PermissionExpandRequestValidationError{
 			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
+			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
 			return err
@@ -1292,10 +1129,10 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionLookupEntityRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionLookupEntityRequestValidationError{
+	if !_PermissionExpandRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionExpandRequestValidationError{
 			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
 		}
 		if !all {
 			return err
@@ -1304,7 +1141,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := PermissionLookupEntityRequestValidationError{
+		err := PermissionExpandRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -1318,7 +1155,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
+				errors = append(errors, PermissionExpandRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1326,7 +1163,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
+				errors = append(errors, PermissionExpandRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1335,7 +1172,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupEntityRequestValidationError{
+			return PermissionExpandRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1343,53 +1180,9 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	}
 
-	if len(m.GetEntityType()) > 64 {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "EntityType",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupEntityRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "EntityType",
-			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetPermission()) > 64 {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Permission",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupEntityRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Permission",
-			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSubject() == nil {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Subject",
+	if m.GetEntity() == nil {
+		err := PermissionExpandRequestValidationError{
+			field:  "Entity",
 			reason: "value is required",
 		}
 		if !all {
@@ -1399,47 +1192,73 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSubject()).(type) {
+		switch v := interface{}(m.GetEntity()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
-					field:  "Subject",
+				errors = append(errors, PermissionExpandRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
-					field:  "Subject",
+				errors = append(errors, PermissionExpandRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupEntityRequestValidationError{
-				field:  "Subject",
+			return PermissionExpandRequestValidationError{
+				field:  "Entity",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetContext()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
-					field:  "Context",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetPermission() != "" {
+
+		if len(m.GetPermission()) > 64 {
+			err := PermissionExpandRequestValidationError{
+				field:  "Permission",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionExpandRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+			err := PermissionExpandRequestValidationError{
+				field:  "Permission",
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
+				errors = append(errors, PermissionExpandRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1448,7 +1267,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupEntityRequestValidationError{
+			return PermissionExpandRequestValidationError{
 				field:  "Context",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1456,20 +1275,73 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetArguments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionExpandRequestValidationError{
+						field:  fmt.Sprintf("Arguments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionExpandRequestValidationError{
+						field:  fmt.Sprintf("Arguments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionExpandRequestValidationError{
+					field:  fmt.Sprintf("Arguments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetMaxNodes() != 0 {
+
+		if m.GetMaxNodes() > 100000 {
+			err := PermissionExpandRequestValidationError{
+				field:  "MaxNodes",
+				reason: "value must be less than or equal to 100000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
 	if len(errors) > 0 {
-		return PermissionLookupEntityRequestMultiError(errors)
+		return PermissionExpandRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityRequestMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupEntityRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupEntityRequestMultiError []error
+// PermissionExpandRequestMultiError is an error wrapping multiple validation
+// errors returned by PermissionExpandRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionExpandRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityRequestMultiError) Error() string {
+func (m PermissionExpandRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1478,12 +1350,11 @@ func (m PermissionLookupEntityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityRequestMultiError) AllErrors() []error { return m }
+func (m PermissionExpandRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityRequestValidationError is the validation error
-// returned by PermissionLookupEntityRequest.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityRequestValidationError struct {
+// PermissionExpandRequestValidationError is the validation error returned by
+// PermissionExpandRequest.Validate if the designated constraints aren't met.
+type PermissionExpandRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1491,24 +1362,24 @@ type PermissionLookupEntityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityRequestValidationError) Field() string { return e.field }
+func (e PermissionExpandRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityRequestValidationError) Reason() string { return e.reason }
+func (e PermissionExpandRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityRequestValidationError) Cause() error { return e.cause }
+func (e PermissionExpandRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityRequestValidationError) Key() bool { return e.key }
+func (e PermissionExpandRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityRequestValidationError) ErrorName() string {
-	return "PermissionLookupEntityRequestValidationError"
+func (e PermissionExpandRequestValidationError) ErrorName() string {
+	return "PermissionExpandRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityRequestValidationError) Error() string {
+func (e PermissionExpandRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1520,14 +1391,14 @@ func (e PermissionLookupEntityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityRequest.%s: %s%s",
+		"invalid %sPermissionExpandRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityRequestValidationError{}
+var _ error = PermissionExpandRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1535,31 +1406,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityRequestValidationError{}
-
-var _PermissionLookupEntityRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = PermissionExpandRequestValidationError{}
 
-var _PermissionLookupEntityRequest_EntityType_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+var _PermissionExpandRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
 
-var _PermissionLookupEntityRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+var _PermissionExpandRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
-// Validate checks the field values on PermissionLookupEntityRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupEntityRequestMetadata) Validate() error {
+// Validate checks the field values on PermissionExpandRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionExpandRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityRequestMetadataMultiError, or nil if none found.
-func (m *PermissionLookupEntityRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on PermissionExpandRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionExpandRequestMetadataMultiError, or nil if none found.
+func (m *PermissionExpandRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
+func (m *PermissionExpandRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1570,32 +1438,20 @@ func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
 
 	// no validation rules for SnapToken
 
-	if m.GetDepth() < 3 {
-		err := PermissionLookupEntityRequestMetadataValidationError{
-			field:  "Depth",
-			reason: "value must be greater than or equal to 3",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return PermissionLookupEntityRequestMetadataMultiError(errors)
+		return PermissionExpandRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityRequestMetadataMultiError is an error wrapping
-// multiple validation errors returned by
-// PermissionLookupEntityRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionLookupEntityRequestMetadataMultiError []error
+// PermissionExpandRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by PermissionExpandRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionExpandRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
+func (m PermissionExpandRequestMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1604,12 +1460,12 @@ func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionExpandRequestMetadataMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityRequestMetadataValidationError is the validation error
-// returned by PermissionLookupEntityRequestMetadata.Validate if the
-// designated constraints aren't met.
-type PermissionLookupEntityRequestMetadataValidationError struct {
+// PermissionExpandRequestMetadataValidationError is the validation error
+// returned by PermissionExpandRequestMetadata.Validate if the designated
+// constraints aren't met.
+type PermissionExpandRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1617,24 +1473,24 @@ type PermissionLookupEntityRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionExpandRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionExpandRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionExpandRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionExpandRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityRequestMetadataValidationError) ErrorName() string {
-	return "PermissionLookupEntityRequestMetadataValidationError"
+func (e PermissionExpandRequestMetadataValidationError) ErrorName() string {
+	return "PermissionExpandRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
+func (e PermissionExpandRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1646,14 +1502,14 @@ func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityRequestMetadata.%s: %s%s",
+		"invalid %sPermissionExpandRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityRequestMetadataValidationError{}
+var _ error = PermissionExpandRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -1661,151 +1517,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityRequestMetadataValidationError{}
+} = PermissionExpandRequestMetadataValidationError{}
 
-// Validate checks the field values on PermissionLookupEntityResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PermissionExpandResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupEntityResponse) Validate() error {
+func (m *PermissionExpandResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityResponseMultiError, or nil if none found.
-func (m *PermissionLookupEntityResponse) ValidateAll() error {
+// ValidateAll checks the field values on PermissionExpandResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExpandResponseMultiError, or nil if none found.
+func (m *PermissionExpandResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityResponse) validate(all bool) error {
+func (m *PermissionExpandResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return PermissionLookupEntityResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// PermissionLookupEntityResponseMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupEntityResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupEntityResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityResponseMultiError) AllErrors() []error { return m }
-
-// PermissionLookupEntityResponseValidationError is the validation error
-// returned by PermissionLookupEntityResponse.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e PermissionLookupEntityResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e PermissionLookupEntityResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e PermissionLookupEntityResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e PermissionLookupEntityResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e PermissionLookupEntityResponseValidationError) ErrorName() string {
-	return "PermissionLookupEntityResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e PermissionLookupEntityResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = PermissionLookupEntityResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = PermissionLookupEntityResponseValidationError{}
-
-// Validate checks the field values on PermissionLookupEntityStreamResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupEntityStreamResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on PermissionLookupEntityStreamResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityStreamResponseMultiError, or nil if none found.
-func (m *PermissionLookupEntityStreamResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *PermissionLookupEntityStreamResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetTree()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionExpandResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTree()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionExpandResponseValidationError{
+				field:  "Tree",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
-
-	// no validation rules for EntityId
-
 	if len(errors) > 0 {
-		return PermissionLookupEntityStreamResponseMultiError(errors)
+		return PermissionExpandResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// PermissionLookupEntityStreamResponse.ValidateAll() if the designated
+// PermissionExpandResponseMultiError is an error wrapping multiple validation
+// errors returned by PermissionExpandResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionLookupEntityStreamResponseMultiError []error
+type PermissionExpandResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
+func (m PermissionExpandResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1814,12 +1592,11 @@ func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityStreamResponseMultiError) AllErrors() []error { return m }
+func (m PermissionExpandResponseMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityStreamResponseValidationError is the validation error
-// returned by PermissionLookupEntityStreamResponse.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityStreamResponseValidationError struct {
+// PermissionExpandResponseValidationError is the validation error returned by
+// PermissionExpandResponse.Validate if the designated constraints aren't met.
+type PermissionExpandResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1827,24 +1604,24 @@ type PermissionLookupEntityStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityStreamResponseValidationError) Field() string { return e.field }
+func (e PermissionExpandResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityStreamResponseValidationError) Reason() string { return e.reason }
+func (e PermissionExpandResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityStreamResponseValidationError) Cause() error { return e.cause }
+func (e PermissionExpandResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityStreamResponseValidationError) Key() bool { return e.key }
+func (e PermissionExpandResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityStreamResponseValidationError) ErrorName() string {
-	return "PermissionLookupEntityStreamResponseValidationError"
+func (e PermissionExpandResponseValidationError) ErrorName() string {
+	return "PermissionExpandResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
+func (e PermissionExpandResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1856,14 +1633,14 @@ func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityStreamResponse.%s: %s%s",
+		"invalid %sPermissionExpandResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityStreamResponseValidationError{}
+var _ error = PermissionExpandResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1871,24 +1648,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityStreamResponseValidationError{}
+} = PermissionExpandResponseValidationError{}
 
-// Validate checks the field values on PermissionEntityFilterRequest with the
+// Validate checks the field values on PermissionLookupEntityRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionEntityFilterRequest) Validate() error {
+func (m *PermissionLookupEntityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionEntityFilterRequest with
+// ValidateAll checks the field values on PermissionLookupEntityRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// PermissionEntityFilterRequestMultiError, or nil if none found.
-func (m *PermissionEntityFilterRequest) ValidateAll() error {
+// PermissionLookupEntityRequestMultiError, or nil if none found.
+func (m *PermissionLookupEntityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionEntityFilterRequest) validate(all bool) error {
+func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1896,7 +1673,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := PermissionEntityFilterRequestValidationError{
+		err := PermissionLookupEntityRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -1906,8 +1683,8 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionEntityFilterRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionEntityFilterRequestValidationError{
+	if !_PermissionLookupEntityRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupEntityRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -1918,7 +1695,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := PermissionEntityFilterRequestValidationError{
+		err := PermissionLookupEntityRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -1932,7 +1709,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1940,7 +1717,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1949,7 +1726,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionEntityFilterRequestValidationError{
+			return PermissionLookupEntityRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1957,40 +1734,66 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetEntityReference()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
-					field:  "EntityReference",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
-					field:  "EntityReference",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetEntityType()) > 64 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
 		}
-	} else if v, ok := interface{}(m.GetEntityReference()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionEntityFilterRequestValidationError{
-				field:  "EntityReference",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupEntityRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupEntityRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if all {
 		switch v := interface{}(m.GetSubject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1998,7 +1801,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2007,7 +1810,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionEntityFilterRequestValidationError{
+			return PermissionLookupEntityRequestValidationError{
 				field:  "Subject",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2019,7 +1822,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		switch v := interface{}(m.GetContext()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2027,7 +1830,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionEntityFilterRequestValidationError{
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2036,7 +1839,7 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionEntityFilterRequestValidationError{
+			return PermissionLookupEntityRequestValidationError{
 				field:  "Context",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2045,19 +1848,19 @@ func (m *PermissionEntityFilterRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionEntityFilterRequestMultiError(errors)
+		return PermissionLookupEntityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionEntityFilterRequestMultiError is an error wrapping multiple
-// validation errors returned by PermissionEntityFilterRequest.ValidateAll()
+// PermissionLookupEntityRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupEntityRequest.ValidateAll()
 // if the designated constraints aren't met.
-type PermissionEntityFilterRequestMultiError []error
+type PermissionLookupEntityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionEntityFilterRequestMultiError) Error() string {
+func (m PermissionLookupEntityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2066,12 +1869,12 @@ func (m PermissionEntityFilterRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionEntityFilterRequestMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntityRequestMultiError) AllErrors() []error { return m }
 
-// PermissionEntityFilterRequestValidationError is the validation error
-// returned by PermissionEntityFilterRequest.Validate if the designated
+// PermissionLookupEntityRequestValidationError is the validation error
+// returned by PermissionLookupEntityRequest.Validate if the designated
 // constraints aren't met.
-type PermissionEntityFilterRequestValidationError struct {
+type PermissionLookupEntityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2079,24 +1882,24 @@ type PermissionEntityFilterRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionEntityFilterRequestValidationError) Field() string { return e.field }
+func (e PermissionLookupEntityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionEntityFilterRequestValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionEntityFilterRequestValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionEntityFilterRequestValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionEntityFilterRequestValidationError) ErrorName() string {
-	return "PermissionEntityFilterRequestValidationError"
+func (e PermissionLookupEntityRequestValidationError) ErrorName() string {
+	return "PermissionLookupEntityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionEntityFilterRequestValidationError) Error() string {
+func (e PermissionLookupEntityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2108,14 +1911,14 @@ func (e PermissionEntityFilterRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionEntityFilterRequest.%s: %s%s",
+		"invalid %sPermissionLookupEntityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionEntityFilterRequestValidationError{}
+var _ error = PermissionLookupEntityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2123,27 +1926,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionEntityFilterRequestValidationError{}
+} = PermissionLookupEntityRequestValidationError{}
 
-var _PermissionEntityFilterRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _PermissionLookupEntityRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on PermissionEntityFilterRequestMetadata
+var _PermissionLookupEntityRequest_EntityType_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _PermissionLookupEntityRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on PermissionLookupEntityRequestMetadata
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *PermissionEntityFilterRequestMetadata) Validate() error {
+func (m *PermissionLookupEntityRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionEntityFilterRequestMetadata
+// ValidateAll checks the field values on PermissionLookupEntityRequestMetadata
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// PermissionEntityFilterRequestMetadataMultiError, or nil if none found.
-func (m *PermissionEntityFilterRequestMetadata) ValidateAll() error {
+// PermissionLookupEntityRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupEntityRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionEntityFilterRequestMetadata) validate(all bool) error {
+func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2155,7 +1962,7 @@ func (m *PermissionEntityFilterRequestMetadata) validate(all bool) error {
 	// no validation rules for SnapToken
 
 	if m.GetDepth() < 3 {
-		err := PermissionEntityFilterRequestMetadataValidationError{
+		err := PermissionLookupEntityRequestMetadataValidationError{
 			field:  "Depth",
 			reason: "value must be greater than or equal to 3",
 		}
@@ -2166,20 +1973,20 @@ func (m *PermissionEntityFilterRequestMetadata) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionEntityFilterRequestMetadataMultiError(errors)
+		return PermissionLookupEntityRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionEntityFilterRequestMetadataMultiError is an error wrapping
+// PermissionLookupEntityRequestMetadataMultiError is an error wrapping
 // multiple validation errors returned by
-// PermissionEntityFilterRequestMetadata.ValidateAll() if the designated
+// PermissionLookupEntityRequestMetadata.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionEntityFilterRequestMetadataMultiError []error
+type PermissionLookupEntityRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionEntityFilterRequestMetadataMultiError) Error() string {
+func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2188,12 +1995,12 @@ func (m PermissionEntityFilterRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionEntityFilterRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntityRequestMetadataMultiError) AllErrors() []error { return m }
 
-// PermissionEntityFilterRequestMetadataValidationError is the validation error
-// returned by PermissionEntityFilterRequestMetadata.Validate if the
+// PermissionLookupEntityRequestMetadataValidationError is the validation error
+// returned by PermissionLookupEntityRequestMetadata.Validate if the
 // designated constraints aren't met.
-type PermissionEntityFilterRequestMetadataValidationError struct {
+type PermissionLookupEntityRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2201,24 +2008,24 @@ type PermissionEntityFilterRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionEntityFilterRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionLookupEntityRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionEntityFilterRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntityRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionEntityFilterRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntityRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionEntityFilterRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntityRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionEntityFilterRequestMetadataValidationError) ErrorName() string {
-	return "PermissionEntityFilterRequestMetadataValidationError"
+func (e PermissionLookupEntityRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupEntityRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionEntityFilterRequestMetadataValidationError) Error() string {
+func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2230,14 +2037,14 @@ func (e PermissionEntityFilterRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionEntityFilterRequestMetadata.%s: %s%s",
+		"invalid %sPermissionLookupEntityRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionEntityFilterRequestMetadataValidationError{}
+var _ error = PermissionLookupEntityRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -2245,24 +2052,129 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionEntityFilterRequestMetadataValidationError{}
+} = PermissionLookupEntityRequestMetadataValidationError{}
 
-// Validate checks the field values on PermissionLookupSubjectRequest with the
+// Validate checks the field values on PermissionLookupEntityResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupSubjectRequest) Validate() error {
+func (m *PermissionLookupEntityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupSubjectRequest with
+// ValidateAll checks the field values on PermissionLookupEntityResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// PermissionLookupSubjectRequestMultiError, or nil if none found.
-func (m *PermissionLookupSubjectRequest) ValidateAll() error {
+// PermissionLookupEntityResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSubjectRequest) validate(all bool) error {
+func (m *PermissionLookupEntityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PermissionLookupEntityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupEntityResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupEntityResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupEntityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityResponseValidationError is the validation error
+// returned by PermissionLookupEntityResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityResponseValidationError{}
+
+// Validate checks the field values on
+// PermissionLookupEntityWithPermissionsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupEntityWithPermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// PermissionLookupEntityWithPermissionsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PermissionLookupEntityWithPermissionsRequestMultiError, or nil if none found.
+func (m *PermissionLookupEntityWithPermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupEntityWithPermissionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2270,7 +2182,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := PermissionLookupSubjectRequestValidationError{
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -2280,8 +2192,8 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionLookupSubjectRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionLookupSubjectRequestValidationError{
+	if !_PermissionLookupEntityWithPermissionsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -2292,7 +2204,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := PermissionLookupSubjectRequestValidationError{
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -2306,7 +2218,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2314,7 +2226,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2323,7 +2235,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupSubjectRequestValidationError{
+			return PermissionLookupEntityWithPermissionsRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2331,10 +2243,10 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		}
 	}
 
-	if m.GetEntity() == nil {
-		err := PermissionLookupSubjectRequestValidationError{
-			field:  "Entity",
-			reason: "value is required",
+	if len(m.GetEntityType()) > 64 {
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
 			return err
@@ -2342,39 +2254,21 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetEntity()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if !_PermissionLookupEntityWithPermissionsRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
 		}
-	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionLookupSubjectRequestValidationError{
-				field:  "Entity",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if len(m.GetPermission()) > 64 {
-		err := PermissionLookupSubjectRequestValidationError{
-			field:  "Permission",
-			reason: "value length must be at most 64 bytes",
+	if l := len(m.GetPermissions()); l < 1 || l > 10 {
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
+			field:  "Permissions",
+			reason: "value must contain between 1 and 10 items, inclusive",
 		}
 		if !all {
 			return err
@@ -2382,10 +2276,52 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionLookupSubjectRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-		err := PermissionLookupSubjectRequestValidationError{
-			field:  "Permission",
-			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+	_PermissionLookupEntityWithPermissionsRequest_Permissions_Unique := make(map[string]struct{}, len(m.GetPermissions()))
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if _, exists := _PermissionLookupEntityWithPermissionsRequest_Permissions_Unique[item]; exists {
+			err := PermissionLookupEntityWithPermissionsRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_PermissionLookupEntityWithPermissionsRequest_Permissions_Unique[item] = struct{}{}
+		}
+
+		if len(item) > 64 {
+			err := PermissionLookupEntityWithPermissionsRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionLookupEntityWithPermissionsRequest_Permissions_Pattern.MatchString(item) {
+			err := PermissionLookupEntityWithPermissionsRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionLookupEntityWithPermissionsRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -2394,28 +2330,28 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSubjectReference()).(type) {
+		switch v := interface{}(m.GetSubject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
-					field:  "SubjectReference",
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
+					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
-					field:  "SubjectReference",
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
+					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubjectReference()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupSubjectRequestValidationError{
-				field:  "SubjectReference",
+			return PermissionLookupEntityWithPermissionsRequestValidationError{
+				field:  "Subject",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2426,7 +2362,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		switch v := interface{}(m.GetContext()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2434,7 +2370,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+				errors = append(errors, PermissionLookupEntityWithPermissionsRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2443,7 +2379,7 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupSubjectRequestValidationError{
+			return PermissionLookupEntityWithPermissionsRequestValidationError{
 				field:  "Context",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2452,19 +2388,20 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionLookupSubjectRequestMultiError(errors)
+		return PermissionLookupEntityWithPermissionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupSubjectRequestMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupSubjectRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupSubjectRequestMultiError []error
+// PermissionLookupEntityWithPermissionsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupEntityWithPermissionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PermissionLookupEntityWithPermissionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSubjectRequestMultiError) Error() string {
+func (m PermissionLookupEntityWithPermissionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2473,12 +2410,13 @@ func (m PermissionLookupSubjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSubjectRequestMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntityWithPermissionsRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupSubjectRequestValidationError is the validation error
-// returned by PermissionLookupSubjectRequest.Validate if the designated
+// PermissionLookupEntityWithPermissionsRequestValidationError is the
+// validation error returned by
+// PermissionLookupEntityWithPermissionsRequest.Validate if the designated
 // constraints aren't met.
-type PermissionLookupSubjectRequestValidationError struct {
+type PermissionLookupEntityWithPermissionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2486,24 +2424,24 @@ type PermissionLookupSubjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSubjectRequestValidationError) Field() string { return e.field }
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSubjectRequestValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupSubjectRequestValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSubjectRequestValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSubjectRequestValidationError) ErrorName() string {
-	return "PermissionLookupSubjectRequestValidationError"
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) ErrorName() string {
+	return "PermissionLookupEntityWithPermissionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSubjectRequestValidationError) Error() string {
+func (e PermissionLookupEntityWithPermissionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2515,14 +2453,14 @@ func (e PermissionLookupSubjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSubjectRequest.%s: %s%s",
+		"invalid %sPermissionLookupEntityWithPermissionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSubjectRequestValidationError{}
+var _ error = PermissionLookupEntityWithPermissionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2530,66 +2468,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSubjectRequestValidationError{}
+} = PermissionLookupEntityWithPermissionsRequestValidationError{}
 
-var _PermissionLookupSubjectRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _PermissionLookupEntityWithPermissionsRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-var _PermissionLookupSubjectRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+var _PermissionLookupEntityWithPermissionsRequest_EntityType_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
-// Validate checks the field values on PermissionLookupSubjectRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupSubjectRequestMetadata) Validate() error {
+var _PermissionLookupEntityWithPermissionsRequest_Permissions_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on
+// PermissionLookupEntityWithPermissionsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupEntityWithPermissionsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// PermissionLookupSubjectRequestMetadata with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// PermissionLookupSubjectRequestMetadataMultiError, or nil if none found.
-func (m *PermissionLookupSubjectRequestMetadata) ValidateAll() error {
+// PermissionLookupEntityWithPermissionsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PermissionLookupEntityWithPermissionsResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntityWithPermissionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSubjectRequestMetadata) validate(all bool) error {
+func (m *PermissionLookupEntityWithPermissionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
-
-	// no validation rules for SnapToken
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
 
-	if m.GetDepth() < 3 {
-		err := PermissionLookupSubjectRequestMetadataValidationError{
-			field:  "Depth",
-			reason: "value must be greater than or equal to 3",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionLookupEntityWithPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionLookupEntityWithPermissionsResponseValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionLookupEntityWithPermissionsResponseValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return PermissionLookupSubjectRequestMetadataMultiError(errors)
+		return PermissionLookupEntityWithPermissionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupSubjectRequestMetadataMultiError is an error wrapping
+// PermissionLookupEntityWithPermissionsResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// PermissionLookupSubjectRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionLookupSubjectRequestMetadataMultiError []error
+// PermissionLookupEntityWithPermissionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type PermissionLookupEntityWithPermissionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSubjectRequestMetadataMultiError) Error() string {
+func (m PermissionLookupEntityWithPermissionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2598,12 +2557,13 @@ func (m PermissionLookupSubjectRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSubjectRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntityWithPermissionsResponseMultiError) AllErrors() []error { return m }
 
-// PermissionLookupSubjectRequestMetadataValidationError is the validation
-// error returned by PermissionLookupSubjectRequestMetadata.Validate if the
-// designated constraints aren't met.
-type PermissionLookupSubjectRequestMetadataValidationError struct {
+// PermissionLookupEntityWithPermissionsResponseValidationError is the
+// validation error returned by
+// PermissionLookupEntityWithPermissionsResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityWithPermissionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2611,24 +2571,26 @@ type PermissionLookupSubjectRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSubjectRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSubjectRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e PermissionLookupSubjectRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSubjectRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSubjectRequestMetadataValidationError) ErrorName() string {
-	return "PermissionLookupSubjectRequestMetadataValidationError"
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntityWithPermissionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSubjectRequestMetadataValidationError) Error() string {
+func (e PermissionLookupEntityWithPermissionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2640,14 +2602,14 @@ func (e PermissionLookupSubjectRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSubjectRequestMetadata.%s: %s%s",
+		"invalid %sPermissionLookupEntityWithPermissionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSubjectRequestMetadataValidationError{}
+var _ error = PermissionLookupEntityWithPermissionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2655,44 +2617,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSubjectRequestMetadataValidationError{}
+} = PermissionLookupEntityWithPermissionsResponseValidationError{}
 
-// Validate checks the field values on PermissionLookupSubjectResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupSubjectResponse) Validate() error {
+// Validate checks the field values on EntityPermissions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EntityPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupSubjectResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupSubjectResponseMultiError, or nil if none found.
-func (m *PermissionLookupSubjectResponse) ValidateAll() error {
+// ValidateAll checks the field values on EntityPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EntityPermissionsMultiError, or nil if none found.
+func (m *EntityPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSubjectResponse) validate(all bool) error {
+func (m *EntityPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for EntityId
+
 	if len(errors) > 0 {
-		return PermissionLookupSubjectResponseMultiError(errors)
+		return EntityPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupSubjectResponseMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupSubjectResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupSubjectResponseMultiError []error
+// EntityPermissionsMultiError is an error wrapping multiple validation errors
+// returned by EntityPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type EntityPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSubjectResponseMultiError) Error() string {
+func (m EntityPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2701,12 +2665,11 @@ func (m PermissionLookupSubjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSubjectResponseMultiError) AllErrors() []error { return m }
+func (m EntityPermissionsMultiError) AllErrors() []error { return m }
 
-// PermissionLookupSubjectResponseValidationError is the validation error
-// returned by PermissionLookupSubjectResponse.Validate if the designated
-// constraints aren't met.
-type PermissionLookupSubjectResponseValidationError struct {
+// EntityPermissionsValidationError is the validation error returned by
+// EntityPermissions.Validate if the designated constraints aren't met.
+type EntityPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2714,24 +2677,24 @@ type PermissionLookupSubjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSubjectResponseValidationError) Field() string { return e.field }
+func (e EntityPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSubjectResponseValidationError) Reason() string { return e.reason }
+func (e EntityPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupSubjectResponseValidationError) Cause() error { return e.cause }
+func (e EntityPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSubjectResponseValidationError) Key() bool { return e.key }
+func (e EntityPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSubjectResponseValidationError) ErrorName() string {
-	return "PermissionLookupSubjectResponseValidationError"
+func (e EntityPermissionsValidationError) ErrorName() string {
+	return "EntityPermissionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSubjectResponseValidationError) Error() string {
+func (e EntityPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2743,14 +2706,14 @@ func (e PermissionLookupSubjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSubjectResponse.%s: %s%s",
+		"invalid %sEntityPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSubjectResponseValidationError{}
+var _ error = EntityPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2758,69 +2721,175 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSubjectResponseValidationError{}
+} = EntityPermissionsValidationError{}
 
-// Validate checks the field values on PermissionSubjectPermissionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *PermissionSubjectPermissionRequest) Validate() error {
+// Validate checks the field values on PermissionLookupEntityStreamResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupEntityStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionSubjectPermissionRequest
+// ValidateAll checks the field values on PermissionLookupEntityStreamResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// PermissionSubjectPermissionRequestMultiError, or nil if none found.
-func (m *PermissionSubjectPermissionRequest) ValidateAll() error {
+// PermissionLookupEntityStreamResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntityStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
+func (m *PermissionLookupEntityStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := PermissionSubjectPermissionRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for EntityId
 
-	if !_PermissionSubjectPermissionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionSubjectPermissionRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return PermissionLookupEntityStreamResponseMultiError(errors)
 	}
 
-	if m.GetMetadata() == nil {
-		err := PermissionSubjectPermissionRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// PermissionLookupEntityStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionLookupEntityStreamResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntityStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityStreamResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityStreamResponseValidationError is the validation error
+// returned by PermissionLookupEntityStreamResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityStreamResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntityStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityStreamResponseValidationError{}
+
+// Validate checks the field values on PermissionEntityFilterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionEntityFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionEntityFilterRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionEntityFilterRequestMultiError, or nil if none found.
+func (m *PermissionEntityFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionEntityFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := PermissionEntityFilterRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionEntityFilterRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionEntityFilterRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionEntityFilterRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2828,7 +2897,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2837,7 +2906,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionSubjectPermissionRequestValidationError{
+			return PermissionEntityFilterRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2845,62 +2914,40 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 		}
 	}
 
-	if m.GetEntity() == nil {
-		err := PermissionSubjectPermissionRequestValidationError{
-			field:  "Entity",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetEntity()).(type) {
+		switch v := interface{}(m.GetEntityReference()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
-					field:  "Entity",
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
+					field:  "EntityReference",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
-					field:  "Entity",
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
+					field:  "EntityReference",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEntityReference()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionSubjectPermissionRequestValidationError{
-				field:  "Entity",
+			return PermissionEntityFilterRequestValidationError{
+				field:  "EntityReference",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetSubject() == nil {
-		err := PermissionSubjectPermissionRequestValidationError{
-			field:  "Subject",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
 		switch v := interface{}(m.GetSubject()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2908,7 +2955,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Subject",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2917,7 +2964,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionSubjectPermissionRequestValidationError{
+			return PermissionEntityFilterRequestValidationError{
 				field:  "Subject",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2929,7 +2976,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 		switch v := interface{}(m.GetContext()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2937,7 +2984,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+				errors = append(errors, PermissionEntityFilterRequestValidationError{
 					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2946,7 +2993,7 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionSubjectPermissionRequestValidationError{
+			return PermissionEntityFilterRequestValidationError{
 				field:  "Context",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2955,20 +3002,19 @@ func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionSubjectPermissionRequestMultiError(errors)
+		return PermissionEntityFilterRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionSubjectPermissionRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// PermissionSubjectPermissionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionSubjectPermissionRequestMultiError []error
+// PermissionEntityFilterRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionEntityFilterRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionEntityFilterRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionSubjectPermissionRequestMultiError) Error() string {
+func (m PermissionEntityFilterRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2977,12 +3023,12 @@ func (m PermissionSubjectPermissionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionSubjectPermissionRequestMultiError) AllErrors() []error { return m }
+func (m PermissionEntityFilterRequestMultiError) AllErrors() []error { return m }
 
-// PermissionSubjectPermissionRequestValidationError is the validation error
-// returned by PermissionSubjectPermissionRequest.Validate if the designated
+// PermissionEntityFilterRequestValidationError is the validation error
+// returned by PermissionEntityFilterRequest.Validate if the designated
 // constraints aren't met.
-type PermissionSubjectPermissionRequestValidationError struct {
+type PermissionEntityFilterRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2990,24 +3036,24 @@ type PermissionSubjectPermissionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionSubjectPermissionRequestValidationError) Field() string { return e.field }
+func (e PermissionEntityFilterRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionSubjectPermissionRequestValidationError) Reason() string { return e.reason }
+func (e PermissionEntityFilterRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionSubjectPermissionRequestValidationError) Cause() error { return e.cause }
+func (e PermissionEntityFilterRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionSubjectPermissionRequestValidationError) Key() bool { return e.key }
+func (e PermissionEntityFilterRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionSubjectPermissionRequestValidationError) ErrorName() string {
-	return "PermissionSubjectPermissionRequestValidationError"
+func (e PermissionEntityFilterRequestValidationError) ErrorName() string {
+	return "PermissionEntityFilterRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionSubjectPermissionRequestValidationError) Error() string {
+func (e PermissionEntityFilterRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3019,14 +3065,14 @@ func (e PermissionSubjectPermissionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionSubjectPermissionRequest.%s: %s%s",
+		"invalid %sPermissionEntityFilterRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionSubjectPermissionRequestValidationError{}
+var _ error = PermissionEntityFilterRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3034,28 +3080,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionSubjectPermissionRequestValidationError{}
+} = PermissionEntityFilterRequestValidationError{}
 
-var _PermissionSubjectPermissionRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _PermissionEntityFilterRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on
-// PermissionSubjectPermissionRequestMetadata with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PermissionSubjectPermissionRequestMetadata) Validate() error {
+// Validate checks the field values on PermissionEntityFilterRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionEntityFilterRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PermissionSubjectPermissionRequestMetadata with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// PermissionSubjectPermissionRequestMetadataMultiError, or nil if none found.
-func (m *PermissionSubjectPermissionRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on PermissionEntityFilterRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionEntityFilterRequestMetadataMultiError, or nil if none found.
+func (m *PermissionEntityFilterRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionSubjectPermissionRequestMetadata) validate(all bool) error {
+func (m *PermissionEntityFilterRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3066,10 +3111,8 @@ func (m *PermissionSubjectPermissionRequestMetadata) validate(all bool) error {
 
 	// no validation rules for SnapToken
 
-	// no validation rules for OnlyPermission
-
 	if m.GetDepth() < 3 {
-		err := PermissionSubjectPermissionRequestMetadataValidationError{
+		err := PermissionEntityFilterRequestMetadataValidationError{
 			field:  "Depth",
 			reason: "value must be greater than or equal to 3",
 		}
@@ -3080,20 +3123,20 @@ func (m *PermissionSubjectPermissionRequestMetadata) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PermissionSubjectPermissionRequestMetadataMultiError(errors)
+		return PermissionEntityFilterRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionSubjectPermissionRequestMetadataMultiError is an error wrapping
+// PermissionEntityFilterRequestMetadataMultiError is an error wrapping
 // multiple validation errors returned by
-// PermissionSubjectPermissionRequestMetadata.ValidateAll() if the designated
+// PermissionEntityFilterRequestMetadata.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionSubjectPermissionRequestMetadataMultiError []error
+type PermissionEntityFilterRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionSubjectPermissionRequestMetadataMultiError) Error() string {
+func (m PermissionEntityFilterRequestMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3102,12 +3145,7663 @@ func (m PermissionSubjectPermissionRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionSubjectPermissionRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionEntityFilterRequestMetadataMultiError) AllErrors() []error { return m }
 
-// PermissionSubjectPermissionRequestMetadataValidationError is the validation
+// PermissionEntityFilterRequestMetadataValidationError is the validation error
+// returned by PermissionEntityFilterRequestMetadata.Validate if the
+// designated constraints aren't met.
+type PermissionEntityFilterRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionEntityFilterRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionEntityFilterRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionEntityFilterRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionEntityFilterRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionEntityFilterRequestMetadataValidationError) ErrorName() string {
+	return "PermissionEntityFilterRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionEntityFilterRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionEntityFilterRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionEntityFilterRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionEntityFilterRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionLookupSubjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupSubjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSubjectRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSubjectRequestMultiError, or nil if none found.
+func (m *PermissionLookupSubjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSubjectRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEntity() == nil {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSubjectRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubjectReference()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "SubjectReference",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "SubjectReference",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubjectReference()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "SubjectReference",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSubjectRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSubjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectRequestMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectRequestValidationError is the validation error
+// returned by PermissionLookupSubjectRequest.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSubjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectRequestValidationError) ErrorName() string {
+	return "PermissionLookupSubjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectRequestValidationError{}
+
+var _PermissionLookupSubjectRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _PermissionLookupSubjectRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on PermissionLookupSubjectRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupSubjectRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// PermissionLookupSubjectRequestMetadata with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// PermissionLookupSubjectRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupSubjectRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if m.GetDepth() < 3 {
+		err := PermissionLookupSubjectRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupSubjectRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupSubjectRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectRequestMetadataValidationError is the validation
+// error returned by PermissionLookupSubjectRequestMetadata.Validate if the
+// designated constraints aren't met.
+type PermissionLookupSubjectRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupSubjectRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionLookupSubjectResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupSubjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSubjectResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSubjectResponseMultiError, or nil if none found.
+func (m *PermissionLookupSubjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSubjectResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSubjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectResponseValidationError is the validation error
+// returned by PermissionLookupSubjectResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSubjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectResponseValidationError) ErrorName() string {
+	return "PermissionLookupSubjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectResponseValidationError{}
+
+// Validate checks the field values on PermissionSubjectPermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PermissionSubjectPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionSubjectPermissionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionSubjectPermissionRequestMultiError, or nil if none found.
+func (m *PermissionSubjectPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionSubjectPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := PermissionSubjectPermissionRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionSubjectPermissionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionSubjectPermissionRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionSubjectPermissionRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSubjectPermissionRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEntity() == nil {
+		err := PermissionSubjectPermissionRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSubjectPermissionRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionSubjectPermissionRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSubjectPermissionRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSubjectPermissionRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PermissionSubjectPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionSubjectPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionSubjectPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionSubjectPermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionSubjectPermissionRequestMultiError) AllErrors() []error { return m }
+
+// PermissionSubjectPermissionRequestValidationError is the validation error
+// returned by PermissionSubjectPermissionRequest.Validate if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionSubjectPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionSubjectPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionSubjectPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionSubjectPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionSubjectPermissionRequestValidationError) ErrorName() string {
+	return "PermissionSubjectPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionSubjectPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionSubjectPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionSubjectPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionSubjectPermissionRequestValidationError{}
+
+var _PermissionSubjectPermissionRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on
+// PermissionSubjectPermissionRequestMetadata with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PermissionSubjectPermissionRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// PermissionSubjectPermissionRequestMetadata with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PermissionSubjectPermissionRequestMetadataMultiError, or nil if none found.
+func (m *PermissionSubjectPermissionRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionSubjectPermissionRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	// no validation rules for OnlyPermission
+
+	if m.GetDepth() < 3 {
+		err := PermissionSubjectPermissionRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionSubjectPermissionRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionSubjectPermissionRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionSubjectPermissionRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionSubjectPermissionRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionSubjectPermissionRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionSubjectPermissionRequestMetadataValidationError is the validation
 // error returned by PermissionSubjectPermissionRequestMetadata.Validate if
 // the designated constraints aren't met.
-type PermissionSubjectPermissionRequestMetadataValidationError struct {
+type PermissionSubjectPermissionRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionSubjectPermissionRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionSubjectPermissionRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionSubjectPermissionRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionSubjectPermissionRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionSubjectPermissionRequestMetadataValidationError) ErrorName() string {
+	return "PermissionSubjectPermissionRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionSubjectPermissionRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionSubjectPermissionRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionSubjectPermissionRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionSubjectPermissionRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionSubjectPermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PermissionSubjectPermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionSubjectPermissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionSubjectPermissionResponseMultiError, or nil if none found.
+func (m *PermissionSubjectPermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionSubjectPermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Results
+
+	if len(errors) > 0 {
+		return PermissionSubjectPermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionSubjectPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionSubjectPermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionSubjectPermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionSubjectPermissionResponseMultiError) AllErrors() []error { return m }
+
+// PermissionSubjectPermissionResponseValidationError is the validation error
+// returned by PermissionSubjectPermissionResponse.Validate if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionSubjectPermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionSubjectPermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionSubjectPermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionSubjectPermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionSubjectPermissionResponseValidationError) ErrorName() string {
+	return "PermissionSubjectPermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionSubjectPermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionSubjectPermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionSubjectPermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionSubjectPermissionResponseValidationError{}
+
+// Validate checks the field values on PermissionPathsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionPathsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionPathsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionPathsRequestMultiError, or nil if none found.
+func (m *PermissionPathsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionPathsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := PermissionPathsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionPathsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionPathsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionPathsRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathsRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEntity() == nil {
+		err := PermissionPathsRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathsRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := PermissionPathsRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionPathsRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := PermissionPathsRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionPathsRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathsRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathsRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathsRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetMaxPaths(); val < 0 || val > 100 {
+		err := PermissionPathsRequestValidationError{
+			field:  "MaxPaths",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionPathsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionPathsRequestMultiError is an error wrapping multiple validation
+// errors returned by PermissionPathsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionPathsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionPathsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionPathsRequestMultiError) AllErrors() []error { return m }
+
+// PermissionPathsRequestValidationError is the validation error returned by
+// PermissionPathsRequest.Validate if the designated constraints aren't met.
+type PermissionPathsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionPathsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionPathsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionPathsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionPathsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionPathsRequestValidationError) ErrorName() string {
+	return "PermissionPathsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionPathsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionPathsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionPathsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionPathsRequestValidationError{}
+
+var _PermissionPathsRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _PermissionPathsRequest_Permission_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on PermissionPathsRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionPathsRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionPathsRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionPathsRequestMetadataMultiError, or nil if none found.
+func (m *PermissionPathsRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionPathsRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if m.GetDepth() < 3 {
+		err := PermissionPathsRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionPathsRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionPathsRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by PermissionPathsRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionPathsRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionPathsRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionPathsRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionPathsRequestMetadataValidationError is the validation error
+// returned by PermissionPathsRequestMetadata.Validate if the designated
+// constraints aren't met.
+type PermissionPathsRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionPathsRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionPathsRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionPathsRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionPathsRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionPathsRequestMetadataValidationError) ErrorName() string {
+	return "PermissionPathsRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionPathsRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionPathsRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionPathsRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionPathsRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionPathsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionPathsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionPathsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionPathsResponseMultiError, or nil if none found.
+func (m *PermissionPathsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionPathsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPaths() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionPathsResponseValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionPathsResponseValidationError{
+						field:  fmt.Sprintf("Paths[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionPathsResponseValidationError{
+					field:  fmt.Sprintf("Paths[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return PermissionPathsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionPathsResponseMultiError is an error wrapping multiple validation
+// errors returned by PermissionPathsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionPathsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionPathsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionPathsResponseMultiError) AllErrors() []error { return m }
+
+// PermissionPathsResponseValidationError is the validation error returned by
+// PermissionPathsResponse.Validate if the designated constraints aren't met.
+type PermissionPathsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionPathsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionPathsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionPathsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionPathsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionPathsResponseValidationError) ErrorName() string {
+	return "PermissionPathsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionPathsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionPathsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionPathsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionPathsResponseValidationError{}
+
+// Validate checks the field values on PermissionPath with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PermissionPath) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionPath with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PermissionPathMultiError,
+// or nil if none found.
+func (m *PermissionPath) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionPath) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionPathValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionPathValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionPathValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PermissionPathMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionPathMultiError is an error wrapping multiple validation errors
+// returned by PermissionPath.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionPathMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionPathMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionPathMultiError) AllErrors() []error { return m }
+
+// PermissionPathValidationError is the validation error returned by
+// PermissionPath.Validate if the designated constraints aren't met.
+type PermissionPathValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionPathValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionPathValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionPathValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionPathValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionPathValidationError) ErrorName() string { return "PermissionPathValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PermissionPathValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionPath.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionPathValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionPathValidationError{}
+
+// Validate checks the field values on PermissionPathStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionPathStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionPathStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionPathStepMultiError, or nil if none found.
+func (m *PermissionPathStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionPathStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathStepValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathStepValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathStepValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Relation
+
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionPathStepValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionPathStepValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionPathStepValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PermissionPathStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionPathStepMultiError is an error wrapping multiple validation errors
+// returned by PermissionPathStep.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionPathStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionPathStepMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionPathStepMultiError) AllErrors() []error { return m }
+
+// PermissionPathStepValidationError is the validation error returned by
+// PermissionPathStep.Validate if the designated constraints aren't met.
+type PermissionPathStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionPathStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionPathStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionPathStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionPathStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionPathStepValidationError) ErrorName() string {
+	return "PermissionPathStepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionPathStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionPathStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionPathStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionPathStepValidationError{}
+
+// Validate checks the field values on PermissionDecisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionDecisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionDecisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionDecisionRequestMultiError, or nil if none found.
+func (m *PermissionDecisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionDecisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSubject()) < 1 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubject()) > 256 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAction()) < 1 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Action",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAction()) > 64 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Action",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetResource()) > 256 {
+		err := PermissionDecisionRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionDecisionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionDecisionRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionDecisionRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTenantId() != "" {
+
+		if len(m.GetTenantId()) > 128 {
+			err := PermissionDecisionRequestValidationError{
+				field:  "TenantId",
+				reason: "value length must be at most 128 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionDecisionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := PermissionDecisionRequestValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PermissionDecisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionDecisionRequestMultiError is an error wrapping multiple validation
+// errors returned by PermissionDecisionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PermissionDecisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionDecisionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionDecisionRequestMultiError) AllErrors() []error { return m }
+
+// PermissionDecisionRequestValidationError is the validation error returned by
+// PermissionDecisionRequest.Validate if the designated constraints aren't met.
+type PermissionDecisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionDecisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionDecisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionDecisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionDecisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionDecisionRequestValidationError) ErrorName() string {
+	return "PermissionDecisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionDecisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionDecisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionDecisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionDecisionRequestValidationError{}
+
+var _PermissionDecisionRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on PermissionDecisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionDecisionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionDecisionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionDecisionResponseMultiError, or nil if none found.
+func (m *PermissionDecisionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionDecisionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return PermissionDecisionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionDecisionResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionDecisionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PermissionDecisionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionDecisionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionDecisionResponseMultiError) AllErrors() []error { return m }
+
+// PermissionDecisionResponseValidationError is the validation error returned
+// by PermissionDecisionResponse.Validate if the designated constraints aren't met.
+type PermissionDecisionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionDecisionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionDecisionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionDecisionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionDecisionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionDecisionResponseValidationError) ErrorName() string {
+	return "PermissionDecisionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionDecisionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionDecisionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionDecisionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionDecisionResponseValidationError{}
+
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// nil if none found.
+func (m *WatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return WatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
+type WatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRequestMultiError) AllErrors() []error { return m }
+
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRequestValidationError{}
+
+var _WatchRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on WatchResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchResponseMultiError, or
+// nil if none found.
+func (m *WatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChanges()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchResponseValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchResponseValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchResponseValidationError{
+				field:  "Changes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Keepalive
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return WatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResponseMultiError) AllErrors() []error { return m }
+
+// WatchResponseValidationError is the validation error returned by
+// WatchResponse.Validate if the designated constraints aren't met.
+type WatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResponseValidationError) ErrorName() string { return "WatchResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResponseValidationError{}
+
+// Validate checks the field values on SchemaWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteRequestMultiError, or nil if none found.
+func (m *SchemaWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	// no validation rules for IfChanged
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := SchemaWriteRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
+
+// SchemaWriteRequestValidationError is the validation error returned by
+// SchemaWriteRequest.Validate if the designated constraints aren't met.
+type SchemaWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteRequestValidationError) ErrorName() string {
+	return "SchemaWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteRequestValidationError{}
+
+var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteResponseMultiError, or nil if none found.
+func (m *SchemaWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for Changed
+
+	// no validation rules for SchemaHash
+
+	if len(errors) > 0 {
+		return SchemaWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
+
+// SchemaWriteResponseValidationError is the validation error returned by
+// SchemaWriteResponse.Validate if the designated constraints aren't met.
+type SchemaWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteResponseValidationError) ErrorName() string {
+	return "SchemaWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteResponseValidationError{}
+
+// Validate checks the field values on Bundle with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Bundle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Bundle with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BundleMultiError, or nil if none found.
+func (m *Bundle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Bundle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Version
+
+	// no validation rules for Schema
+
+	if len(m.GetTuples()) > 1000 {
+		err := BundleValidationError{
+			field:  "Tuples",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := BundleValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BundleValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BundleValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BundleValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetAttributes()) > 1000 {
+		err := BundleValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := BundleValidationError{
+				field:  fmt.Sprintf("Attributes[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BundleValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BundleValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BundleValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BundleMultiError(errors)
+	}
+
+	return nil
+}
+
+// BundleMultiError is an error wrapping multiple validation errors returned by
+// Bundle.ValidateAll() if the designated constraints aren't met.
+type BundleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BundleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BundleMultiError) AllErrors() []error { return m }
+
+// BundleValidationError is the validation error returned by Bundle.Validate if
+// the designated constraints aren't met.
+type BundleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BundleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BundleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BundleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BundleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BundleValidationError) ErrorName() string { return "BundleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BundleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBundle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BundleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BundleValidationError{}
+
+// Validate checks the field values on SchemaApplyBundleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaApplyBundleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaApplyBundleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaApplyBundleRequestMultiError, or nil if none found.
+func (m *SchemaApplyBundleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaApplyBundleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaApplyBundleRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaApplyBundleRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaApplyBundleRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBundle() == nil {
+		err := SchemaApplyBundleRequestValidationError{
+			field:  "Bundle",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBundle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaApplyBundleRequestValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaApplyBundleRequestValidationError{
+					field:  "Bundle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaApplyBundleRequestValidationError{
+				field:  "Bundle",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Signature
+
+	if len(errors) > 0 {
+		return SchemaApplyBundleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaApplyBundleRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaApplyBundleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaApplyBundleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaApplyBundleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaApplyBundleRequestMultiError) AllErrors() []error { return m }
+
+// SchemaApplyBundleRequestValidationError is the validation error returned by
+// SchemaApplyBundleRequest.Validate if the designated constraints aren't met.
+type SchemaApplyBundleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaApplyBundleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaApplyBundleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaApplyBundleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaApplyBundleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaApplyBundleRequestValidationError) ErrorName() string {
+	return "SchemaApplyBundleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaApplyBundleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaApplyBundleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaApplyBundleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaApplyBundleRequestValidationError{}
+
+var _SchemaApplyBundleRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaApplyBundleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaApplyBundleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaApplyBundleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaApplyBundleResponseMultiError, or nil if none found.
+func (m *SchemaApplyBundleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaApplyBundleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	// no validation rules for Changed
+
+	// no validation rules for SchemaHash
+
+	if len(errors) > 0 {
+		return SchemaApplyBundleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaApplyBundleResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaApplyBundleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaApplyBundleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaApplyBundleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaApplyBundleResponseMultiError) AllErrors() []error { return m }
+
+// SchemaApplyBundleResponseValidationError is the validation error returned by
+// SchemaApplyBundleResponse.Validate if the designated constraints aren't met.
+type SchemaApplyBundleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaApplyBundleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaApplyBundleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaApplyBundleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaApplyBundleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaApplyBundleResponseValidationError) ErrorName() string {
+	return "SchemaApplyBundleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaApplyBundleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaApplyBundleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaApplyBundleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaApplyBundleResponseValidationError{}
+
+// Validate checks the field values on SchemaMigrateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaMigrateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaMigrateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaMigrateRequestMultiError, or nil if none found.
+func (m *SchemaMigrateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaMigrateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaMigrateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaMigrateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaMigrateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	// no validation rules for SchemaVersion
+
+	if len(m.GetMigrations()) > 100 {
+		err := SchemaMigrateRequestValidationError{
+			field:  "Migrations",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMigrations() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := SchemaMigrateRequestValidationError{
+				field:  fmt.Sprintf("Migrations[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaMigrateRequestValidationError{
+						field:  fmt.Sprintf("Migrations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaMigrateRequestValidationError{
+						field:  fmt.Sprintf("Migrations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaMigrateRequestValidationError{
+					field:  fmt.Sprintf("Migrations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetOperations()) > 100 {
+		err := SchemaMigrateRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := SchemaMigrateRequestValidationError{
+				field:  fmt.Sprintf("Operations[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaMigrateRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaMigrateRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaMigrateRequestValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := SchemaMigrateRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaMigrateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaMigrateRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaMigrateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaMigrateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaMigrateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaMigrateRequestMultiError) AllErrors() []error { return m }
+
+// SchemaMigrateRequestValidationError is the validation error returned by
+// SchemaMigrateRequest.Validate if the designated constraints aren't met.
+type SchemaMigrateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaMigrateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaMigrateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaMigrateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaMigrateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaMigrateRequestValidationError) ErrorName() string {
+	return "SchemaMigrateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaMigrateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaMigrateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaMigrateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaMigrateRequestValidationError{}
+
+var _SchemaMigrateRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaMigration with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaMigration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaMigration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaMigrationMultiError, or nil if none found.
+func (m *SchemaMigration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaMigration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofTypePresent := false
+	switch v := m.Type.(type) {
+	case *SchemaMigration_RenameRelation:
+		if v == nil {
+			err := SchemaMigrationValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetRenameRelation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaMigrationValidationError{
+						field:  "RenameRelation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaMigrationValidationError{
+						field:  "RenameRelation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRenameRelation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaMigrationValidationError{
+					field:  "RenameRelation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SchemaMigration_RenameAttribute:
+		if v == nil {
+			err := SchemaMigrationValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetRenameAttribute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaMigrationValidationError{
+						field:  "RenameAttribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaMigrationValidationError{
+						field:  "RenameAttribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRenameAttribute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaMigrationValidationError{
+					field:  "RenameAttribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := SchemaMigrationValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaMigrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaMigrationMultiError is an error wrapping multiple validation errors
+// returned by SchemaMigration.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaMigrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaMigrationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaMigrationMultiError) AllErrors() []error { return m }
+
+// SchemaMigrationValidationError is the validation error returned by
+// SchemaMigration.Validate if the designated constraints aren't met.
+type SchemaMigrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaMigrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaMigrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaMigrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaMigrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaMigrationValidationError) ErrorName() string { return "SchemaMigrationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaMigrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaMigration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaMigrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaMigrationValidationError{}
+
+// Validate checks the field values on SchemaMigrationRename with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaMigrationRename) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaMigrationRename with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaMigrationRenameMultiError, or nil if none found.
+func (m *SchemaMigrationRename) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaMigrationRename) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEntityType()) > 64 {
+		err := SchemaMigrationRenameValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaMigrationRename_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := SchemaMigrationRenameValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetFrom()) > 64 {
+		err := SchemaMigrationRenameValidationError{
+			field:  "From",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaMigrationRename_From_Pattern.MatchString(m.GetFrom()) {
+		err := SchemaMigrationRenameValidationError{
+			field:  "From",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTo()) > 64 {
+		err := SchemaMigrationRenameValidationError{
+			field:  "To",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaMigrationRename_To_Pattern.MatchString(m.GetTo()) {
+		err := SchemaMigrationRenameValidationError{
+			field:  "To",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaMigrationRenameMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaMigrationRenameMultiError is an error wrapping multiple validation
+// errors returned by SchemaMigrationRename.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaMigrationRenameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaMigrationRenameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaMigrationRenameMultiError) AllErrors() []error { return m }
+
+// SchemaMigrationRenameValidationError is the validation error returned by
+// SchemaMigrationRename.Validate if the designated constraints aren't met.
+type SchemaMigrationRenameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaMigrationRenameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaMigrationRenameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaMigrationRenameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaMigrationRenameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaMigrationRenameValidationError) ErrorName() string {
+	return "SchemaMigrationRenameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaMigrationRenameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaMigrationRename.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaMigrationRenameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaMigrationRenameValidationError{}
+
+var _SchemaMigrationRename_EntityType_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _SchemaMigrationRename_From_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _SchemaMigrationRename_To_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on SchemaMigrateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaMigrateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaMigrateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaMigrateResponseMultiError, or nil if none found.
+func (m *SchemaMigrateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaMigrateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	// no validation rules for SchemaHash
+
+	// no validation rules for Relationships
+
+	// no validation rules for Attributes
+
+	if len(errors) > 0 {
+		return SchemaMigrateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaMigrateResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaMigrateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaMigrateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaMigrateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaMigrateResponseMultiError) AllErrors() []error { return m }
+
+// SchemaMigrateResponseValidationError is the validation error returned by
+// SchemaMigrateResponse.Validate if the designated constraints aren't met.
+type SchemaMigrateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaMigrateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaMigrateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaMigrateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaMigrateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaMigrateResponseValidationError) ErrorName() string {
+	return "SchemaMigrateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaMigrateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaMigrateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaMigrateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaMigrateResponseValidationError{}
+
+// Validate checks the field values on SchemaReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadRequestMultiError, or nil if none found.
+func (m *SchemaReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+
+// SchemaReadRequestValidationError is the validation error returned by
+// SchemaReadRequest.Validate if the designated constraints aren't met.
+type SchemaReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadRequestValidationError) ErrorName() string {
+	return "SchemaReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestValidationError{}
+
+var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadRequestMetadataMultiError, or nil if none found.
+func (m *SchemaReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaReadRequestMetadataValidationError is the validation error returned by
+// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
+	return "SchemaReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestMetadataValidationError{}
+
+// Validate checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadResponseMultiError, or nil if none found.
+func (m *SchemaReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SchemaHash
+
+	if len(errors) > 0 {
+		return SchemaReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+
+// SchemaReadResponseValidationError is the validation error returned by
+// SchemaReadResponse.Validate if the designated constraints aren't met.
+type SchemaReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadResponseValidationError) ErrorName() string {
+	return "SchemaReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadResponseValidationError{}
+
+// Validate checks the field values on SchemaReadPartialRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadPartialRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadPartialRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadPartialRequestMultiError, or nil if none found.
+func (m *SchemaReadPartialRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadPartialRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaReadPartialRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaReadPartialRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaReadPartialRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaReadPartialRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadPartialRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadPartialRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadPartialRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntities()) > 100 {
+		err := SchemaReadPartialRequestValidationError{
+			field:  "Entities",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SchemaReadPartialRequest_Entities_Unique := make(map[string]struct{}, len(m.GetEntities()))
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if _, exists := _SchemaReadPartialRequest_Entities_Unique[item]; exists {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Entities[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SchemaReadPartialRequest_Entities_Unique[item] = struct{}{}
+		}
+
+		if len(item) > 64 {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Entities[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SchemaReadPartialRequest_Entities_Pattern.MatchString(item) {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Entities[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetRules()) > 100 {
+		err := SchemaReadPartialRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SchemaReadPartialRequest_Rules_Unique := make(map[string]struct{}, len(m.GetRules()))
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if _, exists := _SchemaReadPartialRequest_Rules_Unique[item]; exists {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Rules[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SchemaReadPartialRequest_Rules_Unique[item] = struct{}{}
+		}
+
+		if len(item) > 64 {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Rules[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SchemaReadPartialRequest_Rules_Pattern.MatchString(item) {
+			err := SchemaReadPartialRequestValidationError{
+				field:  fmt.Sprintf("Rules[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for IncludeReferences
+
+	if len(errors) > 0 {
+		return SchemaReadPartialRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadPartialRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadPartialRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaReadPartialRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadPartialRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadPartialRequestMultiError) AllErrors() []error { return m }
+
+// SchemaReadPartialRequestValidationError is the validation error returned by
+// SchemaReadPartialRequest.Validate if the designated constraints aren't met.
+type SchemaReadPartialRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadPartialRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadPartialRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadPartialRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadPartialRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadPartialRequestValidationError) ErrorName() string {
+	return "SchemaReadPartialRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadPartialRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadPartialRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadPartialRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadPartialRequestValidationError{}
+
+var _SchemaReadPartialRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _SchemaReadPartialRequest_Entities_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _SchemaReadPartialRequest_Rules_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on SchemaReadPartialResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadPartialResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadPartialResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadPartialResponseMultiError, or nil if none found.
+func (m *SchemaReadPartialResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadPartialResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadPartialResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadPartialResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadPartialResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SchemaHash
+
+	if len(errors) > 0 {
+		return SchemaReadPartialResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadPartialResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadPartialResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaReadPartialResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadPartialResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadPartialResponseMultiError) AllErrors() []error { return m }
+
+// SchemaReadPartialResponseValidationError is the validation error returned by
+// SchemaReadPartialResponse.Validate if the designated constraints aren't met.
+type SchemaReadPartialResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadPartialResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadPartialResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadPartialResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadPartialResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadPartialResponseValidationError) ErrorName() string {
+	return "SchemaReadPartialResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadPartialResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadPartialResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadPartialResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadPartialResponseValidationError{}
+
+// Validate checks the field values on DataWriteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteRequestMultiError, or nil if none found.
+func (m *DataWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := DataWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTuples()) > 100 {
+		err := DataWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetAttributes()) > 100 {
+		err := DataWriteRequestValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataWriteRequestValidationError{
+				field:  fmt.Sprintf("Attributes[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataWriteRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := DataWriteRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DataWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by DataWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteRequestMultiError) AllErrors() []error { return m }
+
+// DataWriteRequestValidationError is the validation error returned by
+// DataWriteRequest.Validate if the designated constraints aren't met.
+type DataWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteRequestValidationError) ErrorName() string { return "DataWriteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteRequestValidationError{}
+
+var _DataWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataWriteRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteRequestMetadataMultiError, or nil if none found.
+func (m *DataWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return DataWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by DataWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type DataWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataWriteRequestMetadataValidationError is the validation error returned by
+// DataWriteRequestMetadata.Validate if the designated constraints aren't met.
+type DataWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteRequestMetadataValidationError) ErrorName() string {
+	return "DataWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on DataWriteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteResponseMultiError, or nil if none found.
+func (m *DataWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteResponseMultiError is an error wrapping multiple validation errors
+// returned by DataWriteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteResponseMultiError) AllErrors() []error { return m }
+
+// DataWriteResponseValidationError is the validation error returned by
+// DataWriteResponse.Validate if the designated constraints aren't met.
+type DataWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteResponseValidationError) ErrorName() string {
+	return "DataWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMultiError, or nil if none found.
+func (m *RelationshipWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetTuples()); l < 1 || l > 100 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestValidationError is the validation error returned by
+// RelationshipWriteRequest.Validate if the designated constraints aren't met.
+type RelationshipWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestValidationError) ErrorName() string {
+	return "RelationshipWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestValidationError{}
+
+var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestMetadataValidationError is the validation error
+// returned by RelationshipWriteRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteResponseMultiError, or nil if none found.
+func (m *RelationshipWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteResponseValidationError is the validation error returned by
+// RelationshipWriteResponse.Validate if the designated constraints aren't met.
+type RelationshipWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteResponseValidationError) ErrorName() string {
+	return "RelationshipWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMultiError, or nil if none found.
+func (m *RelationshipReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFilter() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := RelationshipReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestValidationError is the validation error returned by
+// RelationshipReadRequest.Validate if the designated constraints aren't met.
+type RelationshipReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestValidationError) ErrorName() string {
+	return "RelationshipReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestValidationError{}
+
+var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetSnapshotTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshotTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestMetadataValidationError{
+				field:  "SnapshotTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestMetadataValidationError is the validation error
+// returned by RelationshipReadRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadResponseMultiError, or nil if none found.
+func (m *RelationshipReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return RelationshipReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadResponseValidationError is the validation error returned by
+// RelationshipReadResponse.Validate if the designated constraints aren't met.
+type RelationshipReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadResponseValidationError) ErrorName() string {
+	return "RelationshipReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadResponseValidationError{}
+
+// Validate checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadRequestMultiError, or nil if none found.
+func (m *AttributeReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributeReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := AttributeReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFilter() == nil {
+		err := AttributeReadRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := AttributeReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadRequestMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadRequestMultiError) AllErrors() []error { return m }
+
+// AttributeReadRequestValidationError is the validation error returned by
+// AttributeReadRequest.Validate if the designated constraints aren't met.
+type AttributeReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadRequestValidationError) ErrorName() string {
+	return "AttributeReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadRequestValidationError{}
+
+var _AttributeReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on AttributeReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadRequestMetadataMultiError, or nil if none found.
+func (m *AttributeReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetSnapshotTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestMetadataValidationError{
+					field:  "SnapshotTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshotTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestMetadataValidationError{
+				field:  "SnapshotTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by AttributeReadRequestMetadata.ValidateAll() if
+// the designated constraints aren't met.
+type AttributeReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// AttributeReadRequestMetadataValidationError is the validation error returned
+// by AttributeReadRequestMetadata.Validate if the designated constraints
+// aren't met.
+type AttributeReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadRequestMetadataValidationError) ErrorName() string {
+	return "AttributeReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadRequestMetadataValidationError{}
+
+// Validate checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadResponseMultiError, or nil if none found.
+func (m *AttributeReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttributeReadResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttributeReadResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttributeReadResponseValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return AttributeReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadResponseMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadResponseMultiError) AllErrors() []error { return m }
+
+// AttributeReadResponseValidationError is the validation error returned by
+// AttributeReadResponse.Validate if the designated constraints aren't met.
+type AttributeReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadResponseValidationError) ErrorName() string {
+	return "AttributeReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadResponseValidationError{}
+
+// Validate checks the field values on HistoryReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HistoryReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HistoryReadRequestMultiError, or nil if none found.
+func (m *HistoryReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := HistoryReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_HistoryReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := HistoryReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFilter() == nil {
+		err := HistoryReadRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryReadRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryReadRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryReadRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := HistoryReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return HistoryReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryReadRequestMultiError is an error wrapping multiple validation errors
+// returned by HistoryReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryReadRequestMultiError) AllErrors() []error { return m }
+
+// HistoryReadRequestValidationError is the validation error returned by
+// HistoryReadRequest.Validate if the designated constraints aren't met.
+type HistoryReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryReadRequestValidationError) ErrorName() string {
+	return "HistoryReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HistoryReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryReadRequestValidationError{}
+
+var _HistoryReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on HistoryReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HistoryReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HistoryReadResponseMultiError, or nil if none found.
+func (m *HistoryReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistoryReadResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistoryReadResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistoryReadResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return HistoryReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryReadResponseMultiError is an error wrapping multiple validation
+// errors returned by HistoryReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type HistoryReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryReadResponseMultiError) AllErrors() []error { return m }
+
+// HistoryReadResponseValidationError is the validation error returned by
+// HistoryReadResponse.Validate if the designated constraints aren't met.
+type HistoryReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryReadResponseValidationError) ErrorName() string {
+	return "HistoryReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HistoryReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryReadResponseValidationError{}
+
+// Validate checks the field values on DataDeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDeleteRequestMultiError, or nil if none found.
+func (m *DataDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTupleFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "TupleFilter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTupleFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataDeleteRequestValidationError{
+				field:  "TupleFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAttributeFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "AttributeFilter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributeFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataDeleteRequestValidationError{
+				field:  "AttributeFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := DataDeleteRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DataDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
+
+// DataDeleteRequestValidationError is the validation error returned by
+// DataDeleteRequest.Validate if the designated constraints aren't met.
+type DataDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDeleteRequestValidationError) ErrorName() string {
+	return "DataDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDeleteRequestValidationError{}
+
+var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDeleteResponseMultiError, or nil if none found.
+func (m *DataDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataDeleteResponseMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
+
+// DataDeleteResponseValidationError is the validation error returned by
+// DataDeleteResponse.Validate if the designated constraints aren't met.
+type DataDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataDeleteResponseValidationError) ErrorName() string {
+	return "DataDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataDeleteResponseValidationError{}
+
+// Validate checks the field values on RelationshipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteRequestMultiError, or nil if none found.
+func (m *RelationshipDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipDeleteRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteRequestValidationError is the validation error returned by
+// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
+type RelationshipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteRequestValidationError) ErrorName() string {
+	return "RelationshipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteRequestValidationError{}
+
+var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteResponseMultiError, or nil if none found.
+func (m *RelationshipDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteResponseValidationError is the validation error returned
+// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
+type RelationshipDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3115,24 +10809,24 @@ type PermissionSubjectPermissionRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionSubjectPermissionRequestMetadataValidationError) Field() string { return e.field }
+func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionSubjectPermissionRequestMetadataValidationError) Reason() string { return e.reason }
+func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionSubjectPermissionRequestMetadataValidationError) Cause() error { return e.cause }
+func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionSubjectPermissionRequestMetadataValidationError) Key() bool { return e.key }
+func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionSubjectPermissionRequestMetadataValidationError) ErrorName() string {
-	return "PermissionSubjectPermissionRequestMetadataValidationError"
+func (e RelationshipDeleteResponseValidationError) ErrorName() string {
+	return "RelationshipDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionSubjectPermissionRequestMetadataValidationError) Error() string {
+func (e RelationshipDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3144,14 +10838,14 @@ func (e PermissionSubjectPermissionRequestMetadataValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionSubjectPermissionRequestMetadata.%s: %s%s",
+		"invalid %sRelationshipDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionSubjectPermissionRequestMetadataValidationError{}
+var _ error = RelationshipDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3159,48 +10853,173 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionSubjectPermissionRequestMetadataValidationError{}
+} = RelationshipDeleteResponseValidationError{}
 
-// Validate checks the field values on PermissionSubjectPermissionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *PermissionSubjectPermissionResponse) Validate() error {
+// Validate checks the field values on DataTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataTransactionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionSubjectPermissionResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionSubjectPermissionResponseMultiError, or nil if none found.
-func (m *PermissionSubjectPermissionResponse) ValidateAll() error {
+// ValidateAll checks the field values on DataTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataTransactionRequestMultiError, or nil if none found.
+func (m *DataTransactionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionSubjectPermissionResponse) validate(all bool) error {
+func (m *DataTransactionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Results
+	if len(m.GetTenantId()) > 128 {
+		err := DataTransactionRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataTransactionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataTransactionRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := DataTransactionRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataTransactionRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataTransactionRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataTransactionRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetOperations()); l < 1 || l > 100 {
+		err := DataTransactionRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataTransactionRequestValidationError{
+				field:  fmt.Sprintf("Operations[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataTransactionRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataTransactionRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataTransactionRequestValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := DataTransactionRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return PermissionSubjectPermissionResponseMultiError(errors)
+		return DataTransactionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionSubjectPermissionResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// PermissionSubjectPermissionResponse.ValidateAll() if the designated
+// DataTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by DataTransactionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionSubjectPermissionResponseMultiError []error
+type DataTransactionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionSubjectPermissionResponseMultiError) Error() string {
+func (m DataTransactionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3209,12 +11028,118 @@ func (m PermissionSubjectPermissionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionSubjectPermissionResponseMultiError) AllErrors() []error { return m }
+func (m DataTransactionRequestMultiError) AllErrors() []error { return m }
 
-// PermissionSubjectPermissionResponseValidationError is the validation error
-// returned by PermissionSubjectPermissionResponse.Validate if the designated
+// DataTransactionRequestValidationError is the validation error returned by
+// DataTransactionRequest.Validate if the designated constraints aren't met.
+type DataTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataTransactionRequestValidationError) ErrorName() string {
+	return "DataTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataTransactionRequestValidationError{}
+
+var _DataTransactionRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataTransactionRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataTransactionRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataTransactionRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DataTransactionRequestMetadataMultiError, or nil if none found.
+func (m *DataTransactionRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataTransactionRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return DataTransactionRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataTransactionRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by DataTransactionRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type DataTransactionRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataTransactionRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataTransactionRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataTransactionRequestMetadataValidationError is the validation error
+// returned by DataTransactionRequestMetadata.Validate if the designated
 // constraints aren't met.
-type PermissionSubjectPermissionResponseValidationError struct {
+type DataTransactionRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3222,24 +11147,24 @@ type PermissionSubjectPermissionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionSubjectPermissionResponseValidationError) Field() string { return e.field }
+func (e DataTransactionRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionSubjectPermissionResponseValidationError) Reason() string { return e.reason }
+func (e DataTransactionRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionSubjectPermissionResponseValidationError) Cause() error { return e.cause }
+func (e DataTransactionRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionSubjectPermissionResponseValidationError) Key() bool { return e.key }
+func (e DataTransactionRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionSubjectPermissionResponseValidationError) ErrorName() string {
-	return "PermissionSubjectPermissionResponseValidationError"
+func (e DataTransactionRequestMetadataValidationError) ErrorName() string {
+	return "DataTransactionRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionSubjectPermissionResponseValidationError) Error() string {
+func (e DataTransactionRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3251,14 +11176,14 @@ func (e PermissionSubjectPermissionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionSubjectPermissionResponse.%s: %s%s",
+		"invalid %sDataTransactionRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionSubjectPermissionResponseValidationError{}
+var _ error = DataTransactionRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -3266,45 +11191,123 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionSubjectPermissionResponseValidationError{}
+} = DataTransactionRequestMetadataValidationError{}
 
-// Validate checks the field values on WatchRequest with the rules defined in
+// Validate checks the field values on DataOperation with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *WatchRequest) Validate() error {
+func (m *DataOperation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchRequest with the rules defined
+// ValidateAll checks the field values on DataOperation with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// result is a list of violation errors wrapped in DataOperationMultiError, or
 // nil if none found.
-func (m *WatchRequest) ValidateAll() error {
+func (m *DataOperation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchRequest) validate(all bool) error {
+func (m *DataOperation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := WatchRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
+	oneofTypePresent := false
+	switch v := m.Type.(type) {
+	case *DataOperation_Write:
+		if v == nil {
+			err := DataOperationValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetWrite()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataOperationValidationError{
+						field:  "Write",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataOperationValidationError{
+						field:  "Write",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetWrite()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataOperationValidationError{
+					field:  "Write",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if !_WatchRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := WatchRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+	case *DataOperation_Delete:
+		if v == nil {
+			err := DataOperationValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetDelete()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataOperationValidationError{
+						field:  "Delete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataOperationValidationError{
+						field:  "Delete",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDelete()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataOperationValidationError{
+					field:  "Delete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := DataOperationValidationError{
+			field:  "Type",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -3312,21 +11315,20 @@ func (m *WatchRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for SnapToken
-
 	if len(errors) > 0 {
-		return WatchRequestMultiError(errors)
+		return DataOperationMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchRequestMultiError is an error wrapping multiple validation errors
-// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
-type WatchRequestMultiError []error
+// DataOperationMultiError is an error wrapping multiple validation errors
+// returned by DataOperation.ValidateAll() if the designated constraints
+// aren't met.
+type DataOperationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchRequestMultiError) Error() string {
+func (m DataOperationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3335,11 +11337,11 @@ func (m WatchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchRequestMultiError) AllErrors() []error { return m }
+func (m DataOperationMultiError) AllErrors() []error { return m }
 
-// WatchRequestValidationError is the validation error returned by
-// WatchRequest.Validate if the designated constraints aren't met.
-type WatchRequestValidationError struct {
+// DataOperationValidationError is the validation error returned by
+// DataOperation.Validate if the designated constraints aren't met.
+type DataOperationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3347,22 +11349,22 @@ type WatchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchRequestValidationError) Field() string { return e.field }
+func (e DataOperationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchRequestValidationError) Reason() string { return e.reason }
+func (e DataOperationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchRequestValidationError) Cause() error { return e.cause }
+func (e DataOperationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchRequestValidationError) Key() bool { return e.key }
+func (e DataOperationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+func (e DataOperationValidationError) ErrorName() string { return "DataOperationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e WatchRequestValidationError) Error() string {
+func (e DataOperationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3374,14 +11376,14 @@ func (e WatchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchRequest.%s: %s%s",
+		"invalid %sDataOperation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchRequestValidationError{}
+var _ error = DataOperationValidationError{}
 
 var _ interface {
 	Field() string
@@ -3389,75 +11391,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchRequestValidationError{}
-
-var _WatchRequest_TenantId_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+} = DataOperationValidationError{}
 
-// Validate checks the field values on WatchResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WatchResponse) Validate() error {
+// Validate checks the field values on DataOperationWrite with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataOperationWrite) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WatchResponseMultiError, or
-// nil if none found.
-func (m *WatchResponse) ValidateAll() error {
+// ValidateAll checks the field values on DataOperationWrite with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataOperationWriteMultiError, or nil if none found.
+func (m *DataOperationWrite) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResponse) validate(all bool) error {
+func (m *DataOperationWrite) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetChanges()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResponseValidationError{
-					field:  "Changes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(m.GetTuples()) > 100 {
+		err := DataOperationWriteValidationError{
+			field:  "Tuples",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataOperationWriteValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataOperationWriteValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataOperationWriteValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResponseValidationError{
-					field:  "Changes",
+				return DataOperationWriteValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return WatchResponseValidationError{
-				field:  "Changes",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	if len(m.GetAttributes()) > 100 {
+		err := DataOperationWriteValidationError{
+			field:  "Attributes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataOperationWriteValidationError{
+				field:  fmt.Sprintf("Attributes[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataOperationWriteValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataOperationWriteValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataOperationWriteValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return WatchResponseMultiError(errors)
+		return DataOperationWriteMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResponseMultiError is an error wrapping multiple validation errors
-// returned by WatchResponse.ValidateAll() if the designated constraints
+// DataOperationWriteMultiError is an error wrapping multiple validation errors
+// returned by DataOperationWrite.ValidateAll() if the designated constraints
 // aren't met.
-type WatchResponseMultiError []error
+type DataOperationWriteMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResponseMultiError) Error() string {
+func (m DataOperationWriteMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3466,11 +11549,11 @@ func (m WatchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResponseMultiError) AllErrors() []error { return m }
+func (m DataOperationWriteMultiError) AllErrors() []error { return m }
 
-// WatchResponseValidationError is the validation error returned by
-// WatchResponse.Validate if the designated constraints aren't met.
-type WatchResponseValidationError struct {
+// DataOperationWriteValidationError is the validation error returned by
+// DataOperationWrite.Validate if the designated constraints aren't met.
+type DataOperationWriteValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3478,22 +11561,24 @@ type WatchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResponseValidationError) Field() string { return e.field }
+func (e DataOperationWriteValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResponseValidationError) Reason() string { return e.reason }
+func (e DataOperationWriteValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResponseValidationError) Cause() error { return e.cause }
+func (e DataOperationWriteValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResponseValidationError) Key() bool { return e.key }
+func (e DataOperationWriteValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResponseValidationError) ErrorName() string { return "WatchResponseValidationError" }
+func (e DataOperationWriteValidationError) ErrorName() string {
+	return "DataOperationWriteValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WatchResponseValidationError) Error() string {
+func (e DataOperationWriteValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3505,14 +11590,14 @@ func (e WatchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResponse.%s: %s%s",
+		"invalid %sDataOperationWrite.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResponseValidationError{}
+var _ error = DataOperationWriteValidationError{}
 
 var _ interface {
 	Field() string
@@ -3520,68 +11605,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResponseValidationError{}
+} = DataOperationWriteValidationError{}
 
-// Validate checks the field values on SchemaWriteRequest with the rules
+// Validate checks the field values on DataOperationDelete with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteRequest) Validate() error {
+func (m *DataOperationDelete) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteRequest with the rules
+// ValidateAll checks the field values on DataOperationDelete with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaWriteRequestMultiError, or nil if none found.
-func (m *SchemaWriteRequest) ValidateAll() error {
+// DataOperationDeleteMultiError, or nil if none found.
+func (m *DataOperationDelete) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteRequest) validate(all bool) error {
+func (m *DataOperationDelete) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
-
-	var errors []error
-
-	if len(m.GetTenantId()) > 128 {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTupleFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataOperationDeleteValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataOperationDeleteValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataOperationDeleteValidationError{
+				field:  "TupleFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+	if all {
+		switch v := interface{}(m.GetAttributeFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataOperationDeleteValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataOperationDeleteValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataOperationDeleteValidationError{
+				field:  "AttributeFilter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Schema
-
 	if len(errors) > 0 {
-		return SchemaWriteRequestMultiError(errors)
+		return DataOperationDeleteMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaWriteRequestMultiError []error
+// DataOperationDeleteMultiError is an error wrapping multiple validation
+// errors returned by DataOperationDelete.ValidateAll() if the designated
+// constraints aren't met.
+type DataOperationDeleteMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteRequestMultiError) Error() string {
+func (m DataOperationDeleteMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3590,11 +11709,11 @@ func (m SchemaWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
+func (m DataOperationDeleteMultiError) AllErrors() []error { return m }
 
-// SchemaWriteRequestValidationError is the validation error returned by
-// SchemaWriteRequest.Validate if the designated constraints aren't met.
-type SchemaWriteRequestValidationError struct {
+// DataOperationDeleteValidationError is the validation error returned by
+// DataOperationDelete.Validate if the designated constraints aren't met.
+type DataOperationDeleteValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3602,24 +11721,24 @@ type SchemaWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteRequestValidationError) Field() string { return e.field }
+func (e DataOperationDeleteValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
+func (e DataOperationDeleteValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
+func (e DataOperationDeleteValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
+func (e DataOperationDeleteValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteRequestValidationError) ErrorName() string {
-	return "SchemaWriteRequestValidationError"
+func (e DataOperationDeleteValidationError) ErrorName() string {
+	return "DataOperationDeleteValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteRequestValidationError) Error() string {
+func (e DataOperationDeleteValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3631,14 +11750,14 @@ func (e SchemaWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteRequest.%s: %s%s",
+		"invalid %sDataOperationDelete.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteRequestValidationError{}
+var _ error = DataOperationDeleteValidationError{}
 
 var _ interface {
 	Field() string
@@ -3646,48 +11765,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteRequestValidationError{}
+} = DataOperationDeleteValidationError{}
 
-var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
-
-// Validate checks the field values on SchemaWriteResponse with the rules
+// Validate checks the field values on DataTransactionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteResponse) Validate() error {
+func (m *DataTransactionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DataTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaWriteResponseMultiError, or nil if none found.
-func (m *SchemaWriteResponse) ValidateAll() error {
+// DataTransactionResponseMultiError, or nil if none found.
+func (m *DataTransactionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteResponse) validate(all bool) error {
+func (m *DataTransactionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	// no validation rules for SnapToken
 
 	if len(errors) > 0 {
-		return SchemaWriteResponseMultiError(errors)
+		return DataTransactionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by SchemaWriteResponse.ValidateAll() if the designated
+// DataTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by DataTransactionResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SchemaWriteResponseMultiError []error
+type DataTransactionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteResponseMultiError) Error() string {
+func (m DataTransactionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3696,11 +11813,11 @@ func (m SchemaWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
+func (m DataTransactionResponseMultiError) AllErrors() []error { return m }
 
-// SchemaWriteResponseValidationError is the validation error returned by
-// SchemaWriteResponse.Validate if the designated constraints aren't met.
-type SchemaWriteResponseValidationError struct {
+// DataTransactionResponseValidationError is the validation error returned by
+// DataTransactionResponse.Validate if the designated constraints aren't met.
+type DataTransactionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3708,24 +11825,24 @@ type SchemaWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteResponseValidationError) Field() string { return e.field }
+func (e DataTransactionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
+func (e DataTransactionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
+func (e DataTransactionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
+func (e DataTransactionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteResponseValidationError) ErrorName() string {
-	return "SchemaWriteResponseValidationError"
+func (e DataTransactionResponseValidationError) ErrorName() string {
+	return "DataTransactionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteResponseValidationError) Error() string {
+func (e DataTransactionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3737,14 +11854,14 @@ func (e SchemaWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteResponse.%s: %s%s",
+		"invalid %sDataTransactionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteResponseValidationError{}
+var _ error = DataTransactionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3752,34 +11869,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteResponseValidationError{}
+} = DataTransactionResponseValidationError{}
 
-// Validate checks the field values on SchemaReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequest) Validate() error {
+// Validate checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequest with the rules
+// ValidateAll checks the field values on TenantCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMultiError, or nil if none found.
-func (m *SchemaReadRequest) ValidateAll() error {
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequest) validate(all bool) error {
+func (m *TenantCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
+	if len(m.GetId()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
 			return err
@@ -3787,10 +11904,10 @@ func (m *SchemaReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
 		}
 		if !all {
 			return err
@@ -3798,10 +11915,10 @@ func (m *SchemaReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := SchemaReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
 			return err
@@ -3809,49 +11926,35 @@ func (m *SchemaReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.GetDatabase() != "" {
+
+		if len(m.GetDatabase()) > 64 {
+			err := TenantCreateRequestValidationError{
+				field:  "Database",
+				reason: "value length must be at most 64 bytes",
 			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMultiError(errors)
+		return TenantCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadRequestMultiError []error
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMultiError) Error() string {
+func (m TenantCreateRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3860,11 +11963,11 @@ func (m SchemaReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestValidationError is the validation error returned by
-// SchemaReadRequest.Validate if the designated constraints aren't met.
-type SchemaReadRequestValidationError struct {
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3872,24 +11975,24 @@ type SchemaReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestValidationError) Field() string { return e.field }
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestValidationError) ErrorName() string {
-	return "SchemaReadRequestValidationError"
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestValidationError) Error() string {
+func (e TenantCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3901,14 +12004,14 @@ func (e SchemaReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequest.%s: %s%s",
+		"invalid %sTenantCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestValidationError{}
+var _ error = TenantCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3916,48 +12019,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestValidationError{}
+} = TenantCreateRequestValidationError{}
 
-var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
 
-// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// Validate checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequestMetadata) Validate() error {
+func (m *TenantCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMetadataMultiError, or nil if none found.
-func (m *SchemaReadRequestMetadata) ValidateAll() error {
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequestMetadata) validate(all bool) error {
+func (m *TenantCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for SchemaVersion
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return SchemaReadRequestMetadataMultiError(errors)
+		return TenantCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
-// designated constraints aren't met.
-type SchemaReadRequestMetadataMultiError []error
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMetadataMultiError) Error() string {
+func (m TenantCreateResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3966,11 +12096,11 @@ func (m SchemaReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestMetadataValidationError is the validation error returned by
-// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
-type SchemaReadRequestMetadataValidationError struct {
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3978,24 +12108,24 @@ type SchemaReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
-	return "SchemaReadRequestMetadataValidationError"
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestMetadataValidationError) Error() string {
+func (e TenantCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4007,14 +12137,14 @@ func (e SchemaReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		"invalid %sTenantCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestMetadataValidationError{}
+var _ error = TenantCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4022,73 +12152,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestMetadataValidationError{}
+} = TenantCreateResponseValidationError{}
 
-// Validate checks the field values on SchemaReadResponse with the rules
+// Validate checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadResponse) Validate() error {
+func (m *TenantDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadResponse with the rules
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadResponseMultiError, or nil if none found.
-func (m *SchemaReadResponse) ValidateAll() error {
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadResponse) validate(all bool) error {
+func (m *TenantDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadResponseValidationError{
-				field:  "Schema",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return SchemaReadResponseMultiError(errors)
+		return TenantDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadResponseMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadResponseMultiError []error
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadResponseMultiError) Error() string {
+func (m TenantDeleteRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4097,11 +12198,11 @@ func (m SchemaReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
 
-// SchemaReadResponseValidationError is the validation error returned by
-// SchemaReadResponse.Validate if the designated constraints aren't met.
-type SchemaReadResponseValidationError struct {
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4109,24 +12210,24 @@ type SchemaReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadResponseValidationError) Field() string { return e.field }
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadResponseValidationError) ErrorName() string {
-	return "SchemaReadResponseValidationError"
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadResponseValidationError) Error() string {
+func (e TenantDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4138,14 +12239,14 @@ func (e SchemaReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadResponse.%s: %s%s",
+		"invalid %sTenantDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadResponseValidationError{}
+var _ error = TenantDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4153,166 +12254,167 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadResponseValidationError{}
+} = TenantDeleteRequestValidationError{}
 
-// Validate checks the field values on DataWriteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteRequest) Validate() error {
+// Validate checks the field values on TenantDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteRequest with the rules
+// ValidateAll checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteRequestMultiError, or nil if none found.
-func (m *DataWriteRequest) ValidateAll() error {
+// TenantDeleteResponseMultiError, or nil if none found.
+func (m *TenantDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteRequest) validate(all bool) error {
+func (m *TenantDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := DataWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_DataWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := DataWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := DataWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetTenant()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DataWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DataWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(m.GetTuples()) > 100 {
-		err := DataWriteRequestValidationError{
-			field:  "Tuples",
-			reason: "value must contain no more than 100 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
-
-		if item == nil {
-			err := DataWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return DataWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantDeleteResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return TenantDeleteResponseMultiError(errors)
 	}
 
-	if len(m.GetAttributes()) > 100 {
-		err := DataWriteRequestValidationError{
-			field:  "Attributes",
-			reason: "value must contain no more than 100 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// TenantDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetAttributes() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
 
-		if item == nil {
-			err := DataWriteRequestValidationError{
-				field:  fmt.Sprintf("Attributes[%v]", idx),
-				reason: "value is required",
+// TenantDeleteResponseValidationError is the validation error returned by
+// TenantDeleteResponse.Validate if the designated constraints aren't met.
+type TenantDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantDeleteResponseValidationError) ErrorName() string {
+	return "TenantDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantDeleteResponseValidationError{}
+
+// Validate checks the field values on TenantListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantListRequestMultiError, or nil if none found.
+func (m *TenantListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := TenantListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
 			}
 			if !all {
 				return err
@@ -4320,51 +12422,26 @@ func (m *DataWriteRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DataWriteRequestValidationError{
-					field:  fmt.Sprintf("Attributes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	}
+
+	if m.GetContinuousToken() != "" {
 
 	}
 
 	if len(errors) > 0 {
-		return DataWriteRequestMultiError(errors)
+		return TenantListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by DataWriteRequest.ValidateAll() if the designated constraints
+// TenantListRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantListRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DataWriteRequestMultiError []error
+type TenantListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteRequestMultiError) Error() string {
+func (m TenantListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4373,11 +12450,11 @@ func (m DataWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataWriteRequestMultiError) AllErrors() []error { return m }
+func (m TenantListRequestMultiError) AllErrors() []error { return m }
 
-// DataWriteRequestValidationError is the validation error returned by
-// DataWriteRequest.Validate if the designated constraints aren't met.
-type DataWriteRequestValidationError struct {
+// TenantListRequestValidationError is the validation error returned by
+// TenantListRequest.Validate if the designated constraints aren't met.
+type TenantListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4385,22 +12462,24 @@ type DataWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteRequestValidationError) Field() string { return e.field }
+func (e TenantListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteRequestValidationError) Reason() string { return e.reason }
+func (e TenantListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteRequestValidationError) Cause() error { return e.cause }
+func (e TenantListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteRequestValidationError) Key() bool { return e.key }
+func (e TenantListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteRequestValidationError) ErrorName() string { return "DataWriteRequestValidationError" }
+func (e TenantListRequestValidationError) ErrorName() string {
+	return "TenantListRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DataWriteRequestValidationError) Error() string {
+func (e TenantListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4412,14 +12491,14 @@ func (e DataWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteRequest.%s: %s%s",
+		"invalid %sTenantListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteRequestValidationError{}
+var _ error = TenantListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4427,48 +12506,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteRequestValidationError{}
-
-var _DataWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = TenantListRequestValidationError{}
 
-// Validate checks the field values on DataWriteRequestMetadata with the rules
+// Validate checks the field values on TenantListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteRequestMetadata) Validate() error {
+func (m *TenantListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TenantListResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteRequestMetadataMultiError, or nil if none found.
-func (m *DataWriteRequestMetadata) ValidateAll() error {
+// TenantListResponseMultiError, or nil if none found.
+func (m *TenantListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteRequestMetadata) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *TenantListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantListResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for SchemaVersion
+	// no validation rules for ContinuousToken
 
 	if len(errors) > 0 {
-		return DataWriteRequestMetadataMultiError(errors)
+		return TenantListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataWriteRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by DataWriteRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type DataWriteRequestMetadataMultiError []error
+// TenantListResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteRequestMetadataMultiError) Error() string {
+func (m TenantListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4477,11 +12588,11 @@ func (m DataWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m TenantListResponseMultiError) AllErrors() []error { return m }
 
-// DataWriteRequestMetadataValidationError is the validation error returned by
-// DataWriteRequestMetadata.Validate if the designated constraints aren't met.
-type DataWriteRequestMetadataValidationError struct {
+// TenantListResponseValidationError is the validation error returned by
+// TenantListResponse.Validate if the designated constraints aren't met.
+type TenantListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4489,24 +12600,24 @@ type DataWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e TenantListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e TenantListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e TenantListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e TenantListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteRequestMetadataValidationError) ErrorName() string {
-	return "DataWriteRequestMetadataValidationError"
+func (e TenantListResponseValidationError) ErrorName() string {
+	return "TenantListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataWriteRequestMetadataValidationError) Error() string {
+func (e TenantListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4518,14 +12629,14 @@ func (e DataWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteRequestMetadata.%s: %s%s",
+		"invalid %sTenantListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteRequestMetadataValidationError{}
+var _ error = TenantListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4533,46 +12644,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteRequestMetadataValidationError{}
+} = TenantListResponseValidationError{}
 
-// Validate checks the field values on DataWriteResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteResponse) Validate() error {
+// Validate checks the field values on AdminMigrationStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMigrationStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminMigrationStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteResponseMultiError, or nil if none found.
-func (m *DataWriteResponse) ValidateAll() error {
+// AdminMigrationStatusRequestMultiError, or nil if none found.
+func (m *AdminMigrationStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteResponse) validate(all bool) error {
+func (m *AdminMigrationStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
-
 	if len(errors) > 0 {
-		return DataWriteResponseMultiError(errors)
+		return AdminMigrationStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataWriteResponseMultiError is an error wrapping multiple validation errors
-// returned by DataWriteResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DataWriteResponseMultiError []error
+// AdminMigrationStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMigrationStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMigrationStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteResponseMultiError) Error() string {
+func (m AdminMigrationStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4581,11 +12690,12 @@ func (m DataWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataWriteResponseMultiError) AllErrors() []error { return m }
+func (m AdminMigrationStatusRequestMultiError) AllErrors() []error { return m }
 
-// DataWriteResponseValidationError is the validation error returned by
-// DataWriteResponse.Validate if the designated constraints aren't met.
-type DataWriteResponseValidationError struct {
+// AdminMigrationStatusRequestValidationError is the validation error returned
+// by AdminMigrationStatusRequest.Validate if the designated constraints
+// aren't met.
+type AdminMigrationStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4593,24 +12703,24 @@ type DataWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteResponseValidationError) Field() string { return e.field }
+func (e AdminMigrationStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteResponseValidationError) Reason() string { return e.reason }
+func (e AdminMigrationStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteResponseValidationError) Cause() error { return e.cause }
+func (e AdminMigrationStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteResponseValidationError) Key() bool { return e.key }
+func (e AdminMigrationStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteResponseValidationError) ErrorName() string {
-	return "DataWriteResponseValidationError"
+func (e AdminMigrationStatusRequestValidationError) ErrorName() string {
+	return "AdminMigrationStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataWriteResponseValidationError) Error() string {
+func (e AdminMigrationStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4622,14 +12732,14 @@ func (e DataWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteResponse.%s: %s%s",
+		"invalid %sAdminMigrationStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteResponseValidationError{}
+var _ error = AdminMigrationStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4637,162 +12747,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteResponseValidationError{}
+} = AdminMigrationStatusRequestValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminMigrationStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteRequest) Validate() error {
+func (m *AdminMigrationStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequest with the
+// ValidateAll checks the field values on AdminMigrationStatusResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMultiError, or nil if none found.
-func (m *RelationshipWriteRequest) ValidateAll() error {
+// AdminMigrationStatusResponseMultiError, or nil if none found.
+func (m *AdminMigrationStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequest) validate(all bool) error {
+func (m *AdminMigrationStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if l := len(m.GetTuples()); l < 1 || l > 100 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Tuples",
-			reason: "value must contain between 1 and 100 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
+	// no validation rules for Engine
 
-		if item == nil {
-			err := RelationshipWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for CurrentVersion
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RelationshipWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for LatestVersion
 
-	}
+	// no validation rules for Pending
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMultiError(errors)
+		return AdminMigrationStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMultiError []error
+// AdminMigrationStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminMigrationStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMigrationStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMultiError) Error() string {
+func (m AdminMigrationStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4801,11 +12801,12 @@ func (m RelationshipWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+func (m AdminMigrationStatusResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestValidationError is the validation error returned by
-// RelationshipWriteRequest.Validate if the designated constraints aren't met.
-type RelationshipWriteRequestValidationError struct {
+// AdminMigrationStatusResponseValidationError is the validation error returned
+// by AdminMigrationStatusResponse.Validate if the designated constraints
+// aren't met.
+type AdminMigrationStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4813,24 +12814,24 @@ type RelationshipWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+func (e AdminMigrationStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+func (e AdminMigrationStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+func (e AdminMigrationStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+func (e AdminMigrationStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestValidationError) ErrorName() string {
-	return "RelationshipWriteRequestValidationError"
+func (e AdminMigrationStatusResponseValidationError) ErrorName() string {
+	return "AdminMigrationStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestValidationError) Error() string {
+func (e AdminMigrationStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4842,14 +12843,14 @@ func (e RelationshipWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		"invalid %sAdminMigrationStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestValidationError{}
+var _ error = AdminMigrationStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4857,50 +12858,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestValidationError{}
-
-var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = AdminMigrationStatusResponseValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RelationshipWriteRequestMetadata) Validate() error {
+// Validate checks the field values on AdminDatabasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDatabasesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on AdminDatabasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDatabasesRequestMultiError, or nil if none found.
+func (m *AdminDatabasesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+func (m *AdminDatabasesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
-
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMetadataMultiError(errors)
+		return AdminDatabasesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// AdminDatabasesRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDatabasesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipWriteRequestMetadataMultiError []error
+type AdminDatabasesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+func (m AdminDatabasesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4909,12 +12904,11 @@ func (m RelationshipWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminDatabasesRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestMetadataValidationError is the validation error
-// returned by RelationshipWriteRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataValidationError struct {
+// AdminDatabasesRequestValidationError is the validation error returned by
+// AdminDatabasesRequest.Validate if the designated constraints aren't met.
+type AdminDatabasesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4922,24 +12916,24 @@ type RelationshipWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminDatabasesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminDatabasesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminDatabasesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminDatabasesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipWriteRequestMetadataValidationError"
+func (e AdminDatabasesRequestValidationError) ErrorName() string {
+	return "AdminDatabasesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+func (e AdminDatabasesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4951,14 +12945,14 @@ func (e RelationshipWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		"invalid %sAdminDatabasesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestMetadataValidationError{}
+var _ error = AdminDatabasesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4966,46 +12960,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestMetadataValidationError{}
+} = AdminDatabasesRequestValidationError{}
 
-// Validate checks the field values on RelationshipWriteResponse with the rules
+// Validate checks the field values on AdminDatabasesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteResponse) Validate() error {
+func (m *AdminDatabasesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDatabasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteResponseMultiError, or nil if none found.
-func (m *RelationshipWriteResponse) ValidateAll() error {
+// AdminDatabasesResponseMultiError, or nil if none found.
+func (m *AdminDatabasesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteResponse) validate(all bool) error {
+func (m *AdminDatabasesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	for idx, item := range m.GetDatabases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminDatabasesResponseValidationError{
+						field:  fmt.Sprintf("Databases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminDatabasesResponseValidationError{
+						field:  fmt.Sprintf("Databases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminDatabasesResponseValidationError{
+					field:  fmt.Sprintf("Databases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RelationshipWriteResponseMultiError(errors)
+		return AdminDatabasesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteResponse.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipWriteResponseMultiError []error
+// AdminDatabasesResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminDatabasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDatabasesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteResponseMultiError) Error() string {
+func (m AdminDatabasesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5014,11 +13040,11 @@ func (m RelationshipWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+func (m AdminDatabasesResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteResponseValidationError is the validation error returned by
-// RelationshipWriteResponse.Validate if the designated constraints aren't met.
-type RelationshipWriteResponseValidationError struct {
+// AdminDatabasesResponseValidationError is the validation error returned by
+// AdminDatabasesResponse.Validate if the designated constraints aren't met.
+type AdminDatabasesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5026,24 +13052,24 @@ type RelationshipWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+func (e AdminDatabasesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+func (e AdminDatabasesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+func (e AdminDatabasesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+func (e AdminDatabasesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteResponseValidationError) ErrorName() string {
-	return "RelationshipWriteResponseValidationError"
+func (e AdminDatabasesResponseValidationError) ErrorName() string {
+	return "AdminDatabasesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteResponseValidationError) Error() string {
+func (e AdminDatabasesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5055,14 +13081,14 @@ func (e RelationshipWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		"invalid %sAdminDatabasesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteResponseValidationError{}
+var _ error = AdminDatabasesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5070,165 +13096,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteResponseValidationError{}
+} = AdminDatabasesResponseValidationError{}
 
-// Validate checks the field values on RelationshipReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequest) Validate() error {
+// Validate checks the field values on AdminDatabase with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminDatabase) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMultiError, or nil if none found.
-func (m *RelationshipReadRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminDatabase with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminDatabaseMultiError, or
+// nil if none found.
+func (m *AdminDatabase) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequest) validate(all bool) error {
+func (m *AdminDatabase) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := RelationshipReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := RelationshipReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetFilter() == nil {
-		err := RelationshipReadRequestValidationError{
-			field:  "Filter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := RelationshipReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for Name
 
-	if m.GetContinuousToken() != "" {
+	// no validation rules for Healthy
 
-	}
+	// no validation rules for TenantCount
 
 	if len(errors) > 0 {
-		return RelationshipReadRequestMultiError(errors)
+		return AdminDatabaseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipReadRequestMultiError []error
+// AdminDatabaseMultiError is an error wrapping multiple validation errors
+// returned by AdminDatabase.ValidateAll() if the designated constraints
+// aren't met.
+type AdminDatabaseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMultiError) Error() string {
+func (m AdminDatabaseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5237,11 +13148,11 @@ func (m RelationshipReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+func (m AdminDatabaseMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestValidationError is the validation error returned by
-// RelationshipReadRequest.Validate if the designated constraints aren't met.
-type RelationshipReadRequestValidationError struct {
+// AdminDatabaseValidationError is the validation error returned by
+// AdminDatabase.Validate if the designated constraints aren't met.
+type AdminDatabaseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5249,24 +13160,22 @@ type RelationshipReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+func (e AdminDatabaseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+func (e AdminDatabaseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+func (e AdminDatabaseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+func (e AdminDatabaseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestValidationError) ErrorName() string {
-	return "RelationshipReadRequestValidationError"
-}
+func (e AdminDatabaseValidationError) ErrorName() string { return "AdminDatabaseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestValidationError) Error() string {
+func (e AdminDatabaseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5278,14 +13187,14 @@ func (e RelationshipReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequest.%s: %s%s",
+		"invalid %sAdminDatabase.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestValidationError{}
+var _ error = AdminDatabaseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5293,48 +13202,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestValidationError{}
-
-var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = AdminDatabaseValidationError{}
 
-// Validate checks the field values on RelationshipReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminErrorCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequestMetadata) Validate() error {
+func (m *AdminErrorCodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on AdminErrorCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminErrorCodesRequestMultiError, or nil if none found.
+func (m *AdminErrorCodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+func (m *AdminErrorCodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	// no validation rules for Locale
 
 	if len(errors) > 0 {
-		return RelationshipReadRequestMetadataMultiError(errors)
+		return AdminErrorCodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
-// if the designated constraints aren't met.
-type RelationshipReadRequestMetadataMultiError []error
+// AdminErrorCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminErrorCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminErrorCodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMetadataMultiError) Error() string {
+func (m AdminErrorCodesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5342,13 +13249,12 @@ func (m RelationshipReadRequestMetadataMultiError) Error() string {
 	return strings.Join(msgs, "; ")
 }
 
-// AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
-
-// RelationshipReadRequestMetadataValidationError is the validation error
-// returned by RelationshipReadRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipReadRequestMetadataValidationError struct {
+// AllErrors returns a list of validation violation errors.
+func (m AdminErrorCodesRequestMultiError) AllErrors() []error { return m }
+
+// AdminErrorCodesRequestValidationError is the validation error returned by
+// AdminErrorCodesRequest.Validate if the designated constraints aren't met.
+type AdminErrorCodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5356,24 +13262,24 @@ type RelationshipReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminErrorCodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminErrorCodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminErrorCodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminErrorCodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipReadRequestMetadataValidationError"
+func (e AdminErrorCodesRequestValidationError) ErrorName() string {
+	return "AdminErrorCodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestMetadataValidationError) Error() string {
+func (e AdminErrorCodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5385,14 +13291,14 @@ func (e RelationshipReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		"invalid %sAdminErrorCodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestMetadataValidationError{}
+var _ error = AdminErrorCodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5400,47 +13306,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestMetadataValidationError{}
+} = AdminErrorCodesRequestValidationError{}
 
-// Validate checks the field values on RelationshipReadResponse with the rules
+// Validate checks the field values on AdminErrorCodesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadResponse) Validate() error {
+func (m *AdminErrorCodesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadResponse with the
+// ValidateAll checks the field values on AdminErrorCodesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadResponseMultiError, or nil if none found.
-func (m *RelationshipReadResponse) ValidateAll() error {
+// AdminErrorCodesResponseMultiError, or nil if none found.
+func (m *AdminErrorCodesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadResponse) validate(all bool) error {
+func (m *AdminErrorCodesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTuples() {
+	// no validation rules for Locale
+
+	for idx, item := range m.GetErrorCodes() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
+					errors = append(errors, AdminErrorCodesResponseValidationError{
+						field:  fmt.Sprintf("ErrorCodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
+					errors = append(errors, AdminErrorCodesResponseValidationError{
+						field:  fmt.Sprintf("ErrorCodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5448,8 +13356,8 @@ func (m *RelationshipReadResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RelationshipReadResponseValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
+				return AdminErrorCodesResponseValidationError{
+					field:  fmt.Sprintf("ErrorCodes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5458,22 +13366,20 @@ func (m *RelationshipReadResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for ContinuousToken
-
 	if len(errors) > 0 {
-		return RelationshipReadResponseMultiError(errors)
+		return AdminErrorCodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// AdminErrorCodesResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminErrorCodesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipReadResponseMultiError []error
+type AdminErrorCodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadResponseMultiError) Error() string {
+func (m AdminErrorCodesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5482,11 +13388,11 @@ func (m RelationshipReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+func (m AdminErrorCodesResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipReadResponseValidationError is the validation error returned by
-// RelationshipReadResponse.Validate if the designated constraints aren't met.
-type RelationshipReadResponseValidationError struct {
+// AdminErrorCodesResponseValidationError is the validation error returned by
+// AdminErrorCodesResponse.Validate if the designated constraints aren't met.
+type AdminErrorCodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5494,24 +13400,24 @@ type RelationshipReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+func (e AdminErrorCodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+func (e AdminErrorCodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+func (e AdminErrorCodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+func (e AdminErrorCodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadResponseValidationError) ErrorName() string {
-	return "RelationshipReadResponseValidationError"
+func (e AdminErrorCodesResponseValidationError) ErrorName() string {
+	return "AdminErrorCodesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadResponseValidationError) Error() string {
+func (e AdminErrorCodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5523,14 +13429,14 @@ func (e RelationshipReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadResponse.%s: %s%s",
+		"invalid %sAdminErrorCodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadResponseValidationError{}
+var _ error = AdminErrorCodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5538,165 +13444,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadResponseValidationError{}
+} = AdminErrorCodesResponseValidationError{}
 
-// Validate checks the field values on AttributeReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadRequest) Validate() error {
+// Validate checks the field values on AdminErrorCode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminErrorCode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeReadRequestMultiError, or nil if none found.
-func (m *AttributeReadRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminErrorCode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminErrorCodeMultiError,
+// or nil if none found.
+func (m *AdminErrorCode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadRequest) validate(all bool) error {
+func (m *AdminErrorCode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := AttributeReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_AttributeReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := AttributeReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := AttributeReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetFilter() == nil {
-		err := AttributeReadRequestValidationError{
-			field:  "Filter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeReadRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := AttributeReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Code
 
-	}
+	// no validation rules for Number
 
-	if m.GetContinuousToken() != "" {
+	// no validation rules for Status
 
-	}
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return AttributeReadRequestMultiError(errors)
+		return AdminErrorCodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadRequestMultiError is an error wrapping multiple validation
-// errors returned by AttributeReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AttributeReadRequestMultiError []error
+// AdminErrorCodeMultiError is an error wrapping multiple validation errors
+// returned by AdminErrorCode.ValidateAll() if the designated constraints
+// aren't met.
+type AdminErrorCodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadRequestMultiError) Error() string {
+func (m AdminErrorCodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5705,11 +13498,11 @@ func (m AttributeReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadRequestMultiError) AllErrors() []error { return m }
+func (m AdminErrorCodeMultiError) AllErrors() []error { return m }
 
-// AttributeReadRequestValidationError is the validation error returned by
-// AttributeReadRequest.Validate if the designated constraints aren't met.
-type AttributeReadRequestValidationError struct {
+// AdminErrorCodeValidationError is the validation error returned by
+// AdminErrorCode.Validate if the designated constraints aren't met.
+type AdminErrorCodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5717,24 +13510,22 @@ type AttributeReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadRequestValidationError) Field() string { return e.field }
+func (e AdminErrorCodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadRequestValidationError) Reason() string { return e.reason }
+func (e AdminErrorCodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadRequestValidationError) Cause() error { return e.cause }
+func (e AdminErrorCodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadRequestValidationError) Key() bool { return e.key }
+func (e AdminErrorCodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadRequestValidationError) ErrorName() string {
-	return "AttributeReadRequestValidationError"
-}
+func (e AdminErrorCodeValidationError) ErrorName() string { return "AdminErrorCodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadRequestValidationError) Error() string {
+func (e AdminErrorCodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5746,14 +13537,14 @@ func (e AttributeReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadRequest.%s: %s%s",
+		"invalid %sAdminErrorCode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadRequestValidationError{}
+var _ error = AdminErrorCodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -5761,48 +13552,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadRequestValidationError{}
-
-var _AttributeReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = AdminErrorCodeValidationError{}
 
-// Validate checks the field values on AttributeReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadRequestMetadata) Validate() error {
+// Validate checks the field values on AdminRingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminRingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminRingRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AttributeReadRequestMetadataMultiError, or nil if none found.
-func (m *AttributeReadRequestMetadata) ValidateAll() error {
+// AdminRingRequestMultiError, or nil if none found.
+func (m *AdminRingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadRequestMetadata) validate(all bool) error {
+func (m *AdminRingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
-
 	if len(errors) > 0 {
-		return AttributeReadRequestMetadataMultiError(errors)
+		return AdminRingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by AttributeReadRequestMetadata.ValidateAll() if
-// the designated constraints aren't met.
-type AttributeReadRequestMetadataMultiError []error
+// AdminRingRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminRingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminRingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadRequestMetadataMultiError) Error() string {
+func (m AdminRingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5811,12 +13598,11 @@ func (m AttributeReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminRingRequestMultiError) AllErrors() []error { return m }
 
-// AttributeReadRequestMetadataValidationError is the validation error returned
-// by AttributeReadRequestMetadata.Validate if the designated constraints
-// aren't met.
-type AttributeReadRequestMetadataValidationError struct {
+// AdminRingRequestValidationError is the validation error returned by
+// AdminRingRequest.Validate if the designated constraints aren't met.
+type AdminRingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5824,24 +13610,22 @@ type AttributeReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminRingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminRingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminRingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminRingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadRequestMetadataValidationError) ErrorName() string {
-	return "AttributeReadRequestMetadataValidationError"
-}
+func (e AdminRingRequestValidationError) ErrorName() string { return "AdminRingRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadRequestMetadataValidationError) Error() string {
+func (e AdminRingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5853,14 +13637,14 @@ func (e AttributeReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadRequestMetadata.%s: %s%s",
+		"invalid %sAdminRingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadRequestMetadataValidationError{}
+var _ error = AdminRingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5868,47 +13652,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadRequestMetadataValidationError{}
+} = AdminRingRequestValidationError{}
 
-// Validate checks the field values on AttributeReadResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadResponse) Validate() error {
+// Validate checks the field values on AdminRingResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminRingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadResponse with the rules
+// ValidateAll checks the field values on AdminRingResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AttributeReadResponseMultiError, or nil if none found.
-func (m *AttributeReadResponse) ValidateAll() error {
+// AdminRingResponseMultiError, or nil if none found.
+func (m *AdminRingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadResponse) validate(all bool) error {
+func (m *AdminRingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAttributes() {
+	// no validation rules for Distributed
+
+	// no validation rules for Hash
+
+	// no validation rules for VirtualNodes
+
+	for idx, item := range m.GetNodes() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AttributeReadResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, AdminRingResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AttributeReadResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, AdminRingResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -5916,8 +13706,8 @@ func (m *AttributeReadResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AttributeReadResponseValidationError{
-					field:  fmt.Sprintf("Attributes[%v]", idx),
+				return AdminRingResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5926,22 +13716,49 @@ func (m *AttributeReadResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for ContinuousToken
+	if all {
+		switch v := interface{}(m.GetLastChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRingResponseValidationError{
+					field:  "LastChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRingResponseValidationError{
+					field:  "LastChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRingResponseValidationError{
+				field:  "LastChange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AttributeReadResponseMultiError(errors)
+		return AdminRingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadResponseMultiError is an error wrapping multiple validation
-// errors returned by AttributeReadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AttributeReadResponseMultiError []error
+// AdminRingResponseMultiError is an error wrapping multiple validation errors
+// returned by AdminRingResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AdminRingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadResponseMultiError) Error() string {
+func (m AdminRingResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5950,11 +13767,11 @@ func (m AttributeReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadResponseMultiError) AllErrors() []error { return m }
+func (m AdminRingResponseMultiError) AllErrors() []error { return m }
 
-// AttributeReadResponseValidationError is the validation error returned by
-// AttributeReadResponse.Validate if the designated constraints aren't met.
-type AttributeReadResponseValidationError struct {
+// AdminRingResponseValidationError is the validation error returned by
+// AdminRingResponse.Validate if the designated constraints aren't met.
+type AdminRingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5962,24 +13779,24 @@ type AttributeReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadResponseValidationError) Field() string { return e.field }
+func (e AdminRingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadResponseValidationError) Reason() string { return e.reason }
+func (e AdminRingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadResponseValidationError) Cause() error { return e.cause }
+func (e AdminRingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadResponseValidationError) Key() bool { return e.key }
+func (e AdminRingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadResponseValidationError) ErrorName() string {
-	return "AttributeReadResponseValidationError"
+func (e AdminRingResponseValidationError) ErrorName() string {
+	return "AdminRingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadResponseValidationError) Error() string {
+func (e AdminRingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5991,14 +13808,14 @@ func (e AttributeReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadResponse.%s: %s%s",
+		"invalid %sAdminRingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadResponseValidationError{}
+var _ error = AdminRingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6006,126 +13823,92 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadResponseValidationError{}
+} = AdminRingResponseValidationError{}
 
-// Validate checks the field values on DataDeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteRequest) Validate() error {
+// Validate checks the field values on AdminRingNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminRingNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DataDeleteRequestMultiError, or nil if none found.
-func (m *DataDeleteRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminRingNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminRingNodeMultiError, or
+// nil if none found.
+func (m *AdminRingNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteRequest) validate(all bool) error {
+func (m *AdminRingNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := DataDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Address
 
-	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := DataDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for VirtualNodes
 
-	if m.GetTupleFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "TupleFilter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Ownership
+
+	// no validation rules for Dispatches
+
+	// no validation rules for Failures
 
 	if all {
-		switch v := interface{}(m.GetTupleFilter()).(type) {
+		switch v := interface{}(m.GetLatency()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
+				errors = append(errors, AdminRingNodeValidationError{
+					field:  "Latency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
+				errors = append(errors, AdminRingNodeValidationError{
+					field:  "Latency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLatency()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "TupleFilter",
+			return AdminRingNodeValidationError{
+				field:  "Latency",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetAttributeFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "AttributeFilter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetAttributeFilter()).(type) {
+		switch v := interface{}(m.GetEjectedUntil()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
+				errors = append(errors, AdminRingNodeValidationError{
+					field:  "EjectedUntil",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
+				errors = append(errors, AdminRingNodeValidationError{
+					field:  "EjectedUntil",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEjectedUntil()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "AttributeFilter",
+			return AdminRingNodeValidationError{
+				field:  "EjectedUntil",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6133,19 +13916,19 @@ func (m *DataDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DataDeleteRequestMultiError(errors)
+		return AdminRingNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteRequest.ValidateAll() if the designated constraints
+// AdminRingNodeMultiError is an error wrapping multiple validation errors
+// returned by AdminRingNode.ValidateAll() if the designated constraints
 // aren't met.
-type DataDeleteRequestMultiError []error
+type AdminRingNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteRequestMultiError) Error() string {
+func (m AdminRingNodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6154,11 +13937,11 @@ func (m DataDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminRingNodeMultiError) AllErrors() []error { return m }
 
-// DataDeleteRequestValidationError is the validation error returned by
-// DataDeleteRequest.Validate if the designated constraints aren't met.
-type DataDeleteRequestValidationError struct {
+// AdminRingNodeValidationError is the validation error returned by
+// AdminRingNode.Validate if the designated constraints aren't met.
+type AdminRingNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6166,24 +13949,22 @@ type DataDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminRingNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminRingNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminRingNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminRingNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteRequestValidationError) ErrorName() string {
-	return "DataDeleteRequestValidationError"
-}
+func (e AdminRingNodeValidationError) ErrorName() string { return "AdminRingNodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteRequestValidationError) Error() string {
+func (e AdminRingNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6195,14 +13976,14 @@ func (e DataDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteRequest.%s: %s%s",
+		"invalid %sAdminRingNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteRequestValidationError{}
+var _ error = AdminRingNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6210,48 +13991,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteRequestValidationError{}
-
-var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = AdminRingNodeValidationError{}
 
-// Validate checks the field values on DataDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteResponse) Validate() error {
+// Validate checks the field values on AdminRingChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminRingChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteResponse with the rules
+// ValidateAll checks the field values on AdminRingChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataDeleteResponseMultiError, or nil if none found.
-func (m *DataDeleteResponse) ValidateAll() error {
+// AdminRingChangeMultiError, or nil if none found.
+func (m *AdminRingChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteResponse) validate(all bool) error {
+func (m *AdminRingChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminRingChangeValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminRingChangeValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminRingChangeValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Moved
+
+	// no validation rules for Minimum
 
 	if len(errors) > 0 {
-		return DataDeleteResponseMultiError(errors)
+		return AdminRingChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteResponseMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteResponse.ValidateAll() if the designated constraints
+// AdminRingChangeMultiError is an error wrapping multiple validation errors
+// returned by AdminRingChange.ValidateAll() if the designated constraints
 // aren't met.
-type DataDeleteResponseMultiError []error
+type AdminRingChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteResponseMultiError) Error() string {
+func (m AdminRingChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6260,11 +14070,11 @@ func (m DataDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AdminRingChangeMultiError) AllErrors() []error { return m }
 
-// DataDeleteResponseValidationError is the validation error returned by
-// DataDeleteResponse.Validate if the designated constraints aren't met.
-type DataDeleteResponseValidationError struct {
+// AdminRingChangeValidationError is the validation error returned by
+// AdminRingChange.Validate if the designated constraints aren't met.
+type AdminRingChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6272,24 +14082,22 @@ type DataDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteResponseValidationError) Field() string { return e.field }
+func (e AdminRingChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AdminRingChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AdminRingChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteResponseValidationError) Key() bool { return e.key }
+func (e AdminRingChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteResponseValidationError) ErrorName() string {
-	return "DataDeleteResponseValidationError"
-}
+func (e AdminRingChangeValidationError) ErrorName() string { return "AdminRingChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteResponseValidationError) Error() string {
+func (e AdminRingChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6301,14 +14109,14 @@ func (e DataDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteResponse.%s: %s%s",
+		"invalid %sAdminRingChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteResponseValidationError{}
+var _ error = AdminRingChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6316,95 +14124,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteResponseValidationError{}
+} = AdminRingChangeValidationError{}
 
-// Validate checks the field values on RelationshipDeleteRequest with the rules
+// Validate checks the field values on AdminTunablesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteRequest) Validate() error {
+func (m *AdminTunablesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminTunablesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteRequestMultiError, or nil if none found.
-func (m *RelationshipDeleteRequest) ValidateAll() error {
+// AdminTunablesRequestMultiError, or nil if none found.
+func (m *AdminTunablesRequest) ValidateAll() error {
 	return m.validate(true)
 }
-
-func (m *RelationshipDeleteRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if len(m.GetTenantId()) > 128 {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipDeleteRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+
+func (m *AdminTunablesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return RelationshipDeleteRequestMultiError(errors)
+		return AdminTunablesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipDeleteRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipDeleteRequestMultiError []error
+// AdminTunablesRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminTunablesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTunablesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteRequestMultiError) Error() string {
+func (m AdminTunablesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6413,11 +14170,11 @@ func (m RelationshipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminTunablesRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteRequestValidationError is the validation error returned by
-// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
-type RelationshipDeleteRequestValidationError struct {
+// AdminTunablesRequestValidationError is the validation error returned by
+// AdminTunablesRequest.Validate if the designated constraints aren't met.
+type AdminTunablesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6425,24 +14182,24 @@ type RelationshipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminTunablesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminTunablesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminTunablesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminTunablesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteRequestValidationError) ErrorName() string {
-	return "RelationshipDeleteRequestValidationError"
+func (e AdminTunablesRequestValidationError) ErrorName() string {
+	return "AdminTunablesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteRequestValidationError) Error() string {
+func (e AdminTunablesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6454,14 +14211,14 @@ func (e RelationshipDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		"invalid %sAdminTunablesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteRequestValidationError{}
+var _ error = AdminTunablesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6469,48 +14226,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteRequestValidationError{}
-
-var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = AdminTunablesRequestValidationError{}
 
-// Validate checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminTunablesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteResponse) Validate() error {
+func (m *AdminTunablesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminTunablesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteResponseMultiError, or nil if none found.
-func (m *RelationshipDeleteResponse) ValidateAll() error {
+// AdminTunablesResponseMultiError, or nil if none found.
+func (m *AdminTunablesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteResponse) validate(all bool) error {
+func (m *AdminTunablesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	for idx, item := range m.GetTunables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminTunablesResponseValidationError{
+						field:  fmt.Sprintf("Tunables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminTunablesResponseValidationError{
+						field:  fmt.Sprintf("Tunables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminTunablesResponseValidationError{
+					field:  fmt.Sprintf("Tunables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminTunablesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminTunablesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminTunablesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RelationshipDeleteResponseMultiError(errors)
+		return AdminTunablesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteResponseMultiError is an error wrapping multiple
-// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RelationshipDeleteResponseMultiError []error
+// AdminTunablesResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminTunablesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminTunablesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteResponseMultiError) Error() string {
+func (m AdminTunablesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6519,11 +14340,11 @@ func (m RelationshipDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AdminTunablesResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteResponseValidationError is the validation error returned
-// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
-type RelationshipDeleteResponseValidationError struct {
+// AdminTunablesResponseValidationError is the validation error returned by
+// AdminTunablesResponse.Validate if the designated constraints aren't met.
+type AdminTunablesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6531,24 +14352,24 @@ type RelationshipDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+func (e AdminTunablesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AdminTunablesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AdminTunablesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+func (e AdminTunablesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteResponseValidationError) ErrorName() string {
-	return "RelationshipDeleteResponseValidationError"
+func (e AdminTunablesResponseValidationError) ErrorName() string {
+	return "AdminTunablesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteResponseValidationError) Error() string {
+func (e AdminTunablesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6560,14 +14381,14 @@ func (e RelationshipDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		"invalid %sAdminTunablesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteResponseValidationError{}
+var _ error = AdminTunablesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6575,77 +14396,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteResponseValidationError{}
+} = AdminTunablesResponseValidationError{}
 
-// Validate checks the field values on TenantCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUpdateTunablesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateRequest) Validate() error {
+func (m *AdminUpdateTunablesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUpdateTunablesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateRequestMultiError, or nil if none found.
-func (m *TenantCreateRequest) ValidateAll() error {
+// AdminUpdateTunablesRequestMultiError, or nil if none found.
+func (m *AdminUpdateTunablesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateRequest) validate(all bool) error {
+func (m *AdminUpdateTunablesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetId()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Values
 
 	if len(errors) > 0 {
-		return TenantCreateRequestMultiError(errors)
+		return AdminUpdateTunablesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateRequestMultiError []error
+// AdminUpdateTunablesRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateTunablesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUpdateTunablesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateRequestMultiError) Error() string {
+func (m AdminUpdateTunablesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6654,11 +14444,11 @@ func (m TenantCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+func (m AdminUpdateTunablesRequestMultiError) AllErrors() []error { return m }
 
-// TenantCreateRequestValidationError is the validation error returned by
-// TenantCreateRequest.Validate if the designated constraints aren't met.
-type TenantCreateRequestValidationError struct {
+// AdminUpdateTunablesRequestValidationError is the validation error returned
+// by AdminUpdateTunablesRequest.Validate if the designated constraints aren't met.
+type AdminUpdateTunablesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6666,24 +14456,24 @@ type TenantCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateRequestValidationError) Field() string { return e.field }
+func (e AdminUpdateTunablesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+func (e AdminUpdateTunablesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+func (e AdminUpdateTunablesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+func (e AdminUpdateTunablesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateRequestValidationError) ErrorName() string {
-	return "TenantCreateRequestValidationError"
+func (e AdminUpdateTunablesRequestValidationError) ErrorName() string {
+	return "AdminUpdateTunablesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateRequestValidationError) Error() string {
+func (e AdminUpdateTunablesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6695,14 +14485,14 @@ func (e TenantCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateRequest.%s: %s%s",
+		"invalid %sAdminUpdateTunablesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateRequestValidationError{}
+var _ error = AdminUpdateTunablesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6710,75 +14500,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateRequestValidationError{}
+} = AdminUpdateTunablesRequestValidationError{}
 
-var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
-
-// Validate checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUpdateTunablesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateResponse) Validate() error {
+func (m *AdminUpdateTunablesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminUpdateTunablesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateResponseMultiError, or nil if none found.
-func (m *TenantCreateResponse) ValidateAll() error {
+// AdminUpdateTunablesResponseMultiError, or nil if none found.
+func (m *AdminUpdateTunablesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateResponse) validate(all bool) error {
+func (m *AdminUpdateTunablesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetTunables() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUpdateTunablesResponseValidationError{
+						field:  fmt.Sprintf("Tunables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUpdateTunablesResponseValidationError{
+						field:  fmt.Sprintf("Tunables[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
+				return AdminUpdateTunablesResponseValidationError{
+					field:  fmt.Sprintf("Tunables[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantCreateResponseValidationError{
-				field:  "Tenant",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUpdateTunablesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUpdateTunablesResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUpdateTunablesResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return TenantCreateResponseMultiError(errors)
+		return AdminUpdateTunablesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateResponseMultiError []error
+// AdminUpdateTunablesResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateTunablesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminUpdateTunablesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateResponseMultiError) Error() string {
+func (m AdminUpdateTunablesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6787,11 +14614,12 @@ func (m TenantCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+func (m AdminUpdateTunablesResponseMultiError) AllErrors() []error { return m }
 
-// TenantCreateResponseValidationError is the validation error returned by
-// TenantCreateResponse.Validate if the designated constraints aren't met.
-type TenantCreateResponseValidationError struct {
+// AdminUpdateTunablesResponseValidationError is the validation error returned
+// by AdminUpdateTunablesResponse.Validate if the designated constraints
+// aren't met.
+type AdminUpdateTunablesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6799,24 +14627,24 @@ type TenantCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateResponseValidationError) Field() string { return e.field }
+func (e AdminUpdateTunablesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+func (e AdminUpdateTunablesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+func (e AdminUpdateTunablesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+func (e AdminUpdateTunablesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateResponseValidationError) ErrorName() string {
-	return "TenantCreateResponseValidationError"
+func (e AdminUpdateTunablesResponseValidationError) ErrorName() string {
+	return "AdminUpdateTunablesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateResponseValidationError) Error() string {
+func (e AdminUpdateTunablesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6828,14 +14656,14 @@ func (e TenantCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateResponse.%s: %s%s",
+		"invalid %sAdminUpdateTunablesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateResponseValidationError{}
+var _ error = AdminUpdateTunablesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6843,44 +14671,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateResponseValidationError{}
+} = AdminUpdateTunablesResponseValidationError{}
 
-// Validate checks the field values on TenantDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteRequest) Validate() error {
+// Validate checks the field values on AdminTunable with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminTunable) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantDeleteRequestMultiError, or nil if none found.
-func (m *TenantDeleteRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminTunable with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminTunableMultiError, or
+// nil if none found.
+func (m *AdminTunable) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteRequest) validate(all bool) error {
+func (m *AdminTunable) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return TenantDeleteRequestMultiError(errors)
+		return AdminTunableMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantDeleteRequestMultiError []error
+// AdminTunableMultiError is an error wrapping multiple validation errors
+// returned by AdminTunable.ValidateAll() if the designated constraints aren't met.
+type AdminTunableMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteRequestMultiError) Error() string {
+func (m AdminTunableMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6889,11 +14722,11 @@ func (m TenantDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminTunableMultiError) AllErrors() []error { return m }
 
-// TenantDeleteRequestValidationError is the validation error returned by
-// TenantDeleteRequest.Validate if the designated constraints aren't met.
-type TenantDeleteRequestValidationError struct {
+// AdminTunableValidationError is the validation error returned by
+// AdminTunable.Validate if the designated constraints aren't met.
+type AdminTunableValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6901,24 +14734,22 @@ type TenantDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminTunableValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminTunableValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminTunableValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminTunableValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteRequestValidationError) ErrorName() string {
-	return "TenantDeleteRequestValidationError"
-}
+func (e AdminTunableValidationError) ErrorName() string { return "AdminTunableValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteRequestValidationError) Error() string {
+func (e AdminTunableValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6930,14 +14761,14 @@ func (e TenantDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteRequest.%s: %s%s",
+		"invalid %sAdminTunable.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteRequestValidationError{}
+var _ error = AdminTunableValidationError{}
 
 var _ interface {
 	Field() string
@@ -6945,24 +14776,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteRequestValidationError{}
+} = AdminTunableValidationError{}
 
-// Validate checks the field values on TenantDeleteResponse with the rules
+// Validate checks the field values on AdminTunableChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteResponse) Validate() error {
+func (m *AdminTunableChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteResponse with the rules
+// ValidateAll checks the field values on AdminTunableChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteResponseMultiError, or nil if none found.
-func (m *TenantDeleteResponse) ValidateAll() error {
+// AdminTunableChangeMultiError, or nil if none found.
+func (m *AdminTunableChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteResponse) validate(all bool) error {
+func (m *AdminTunableChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6970,48 +14801,56 @@ func (m *TenantDeleteResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
+		switch v := interface{}(m.GetTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
+				errors = append(errors, AdminTunableChangeValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
+				errors = append(errors, AdminTunableChangeValidationError{
+					field:  "Time",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TenantDeleteResponseValidationError{
-				field:  "Tenant",
+			return AdminTunableChangeValidationError{
+				field:  "Time",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Actor
+
+	// no validation rules for Name
+
+	// no validation rules for Previous
+
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return TenantDeleteResponseMultiError(errors)
+		return AdminTunableChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantDeleteResponseMultiError []error
+// AdminTunableChangeMultiError is an error wrapping multiple validation errors
+// returned by AdminTunableChange.ValidateAll() if the designated constraints
+// aren't met.
+type AdminTunableChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteResponseMultiError) Error() string {
+func (m AdminTunableChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7020,11 +14859,11 @@ func (m TenantDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AdminTunableChangeMultiError) AllErrors() []error { return m }
 
-// TenantDeleteResponseValidationError is the validation error returned by
-// TenantDeleteResponse.Validate if the designated constraints aren't met.
-type TenantDeleteResponseValidationError struct {
+// AdminTunableChangeValidationError is the validation error returned by
+// AdminTunableChange.Validate if the designated constraints aren't met.
+type AdminTunableChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7032,24 +14871,24 @@ type TenantDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+func (e AdminTunableChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AdminTunableChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AdminTunableChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+func (e AdminTunableChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteResponseValidationError) ErrorName() string {
-	return "TenantDeleteResponseValidationError"
+func (e AdminTunableChangeValidationError) ErrorName() string {
+	return "AdminTunableChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteResponseValidationError) Error() string {
+func (e AdminTunableChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7061,14 +14900,14 @@ func (e TenantDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteResponse.%s: %s%s",
+		"invalid %sAdminTunableChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteResponseValidationError{}
+var _ error = AdminTunableChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -7076,63 +14915,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteResponseValidationError{}
+} = AdminTunableChangeValidationError{}
 
-// Validate checks the field values on TenantListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantListRequest) Validate() error {
+// Validate checks the field values on AdminListenersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminListenersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListRequest with the rules
+// ValidateAll checks the field values on AdminListenersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListRequestMultiError, or nil if none found.
-func (m *TenantListRequest) ValidateAll() error {
+// AdminListenersRequestMultiError, or nil if none found.
+func (m *AdminListenersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListRequest) validate(all bool) error {
+func (m *AdminListenersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPageSize() != 0 {
-
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := TenantListRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetContinuousToken() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return TenantListRequestMultiError(errors)
+		return AdminListenersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListRequestMultiError []error
+// AdminListenersRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminListenersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListenersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListRequestMultiError) Error() string {
+func (m AdminListenersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7141,11 +14961,11 @@ func (m TenantListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListRequestMultiError) AllErrors() []error { return m }
+func (m AdminListenersRequestMultiError) AllErrors() []error { return m }
 
-// TenantListRequestValidationError is the validation error returned by
-// TenantListRequest.Validate if the designated constraints aren't met.
-type TenantListRequestValidationError struct {
+// AdminListenersRequestValidationError is the validation error returned by
+// AdminListenersRequest.Validate if the designated constraints aren't met.
+type AdminListenersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7153,24 +14973,24 @@ type TenantListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListRequestValidationError) Field() string { return e.field }
+func (e AdminListenersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListRequestValidationError) Reason() string { return e.reason }
+func (e AdminListenersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListRequestValidationError) Cause() error { return e.cause }
+func (e AdminListenersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListRequestValidationError) Key() bool { return e.key }
+func (e AdminListenersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListRequestValidationError) ErrorName() string {
-	return "TenantListRequestValidationError"
+func (e AdminListenersRequestValidationError) ErrorName() string {
+	return "AdminListenersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListRequestValidationError) Error() string {
+func (e AdminListenersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7182,14 +15002,14 @@ func (e TenantListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListRequest.%s: %s%s",
+		"invalid %sAdminListenersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListRequestValidationError{}
+var _ error = AdminListenersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7197,47 +15017,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListRequestValidationError{}
+} = AdminListenersRequestValidationError{}
 
-// Validate checks the field values on TenantListResponse with the rules
+// Validate checks the field values on AdminListenersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantListResponse) Validate() error {
+func (m *AdminListenersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListResponse with the rules
+// ValidateAll checks the field values on AdminListenersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListResponseMultiError, or nil if none found.
-func (m *TenantListResponse) ValidateAll() error {
+// AdminListenersResponseMultiError, or nil if none found.
+func (m *AdminListenersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListResponse) validate(all bool) error {
+func (m *AdminListenersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTenants() {
+	for idx, item := range m.GetListeners() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
+					errors = append(errors, AdminListenersResponseValidationError{
+						field:  fmt.Sprintf("Listeners[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
+					errors = append(errors, AdminListenersResponseValidationError{
+						field:  fmt.Sprintf("Listeners[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7245,8 +15065,8 @@ func (m *TenantListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TenantListResponseValidationError{
-					field:  fmt.Sprintf("Tenants[%v]", idx),
+				return AdminListenersResponseValidationError{
+					field:  fmt.Sprintf("Listeners[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7255,22 +15075,126 @@ func (m *TenantListResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for ContinuousToken
+	if len(errors) > 0 {
+		return AdminListenersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListenersResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminListenersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListenersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListenersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListenersResponseMultiError) AllErrors() []error { return m }
+
+// AdminListenersResponseValidationError is the validation error returned by
+// AdminListenersResponse.Validate if the designated constraints aren't met.
+type AdminListenersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListenersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListenersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListenersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListenersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListenersResponseValidationError) ErrorName() string {
+	return "AdminListenersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminListenersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListenersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListenersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListenersResponseValidationError{}
+
+// Validate checks the field values on AdminListener with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminListener) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListener with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminListenerMultiError, or
+// nil if none found.
+func (m *AdminListener) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListener) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Server
+
+	// no validation rules for Address
 
 	if len(errors) > 0 {
-		return TenantListResponseMultiError(errors)
+		return AdminListenerMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListResponseMultiError is an error wrapping multiple validation errors
-// returned by TenantListResponse.ValidateAll() if the designated constraints
+// AdminListenerMultiError is an error wrapping multiple validation errors
+// returned by AdminListener.ValidateAll() if the designated constraints
 // aren't met.
-type TenantListResponseMultiError []error
+type AdminListenerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListResponseMultiError) Error() string {
+func (m AdminListenerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7279,11 +15203,11 @@ func (m TenantListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListResponseMultiError) AllErrors() []error { return m }
+func (m AdminListenerMultiError) AllErrors() []error { return m }
 
-// TenantListResponseValidationError is the validation error returned by
-// TenantListResponse.Validate if the designated constraints aren't met.
-type TenantListResponseValidationError struct {
+// AdminListenerValidationError is the validation error returned by
+// AdminListener.Validate if the designated constraints aren't met.
+type AdminListenerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7291,24 +15215,22 @@ type TenantListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListResponseValidationError) Field() string { return e.field }
+func (e AdminListenerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListResponseValidationError) Reason() string { return e.reason }
+func (e AdminListenerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListResponseValidationError) Cause() error { return e.cause }
+func (e AdminListenerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListResponseValidationError) Key() bool { return e.key }
+func (e AdminListenerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListResponseValidationError) ErrorName() string {
-	return "TenantListResponseValidationError"
-}
+func (e AdminListenerValidationError) ErrorName() string { return "AdminListenerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantListResponseValidationError) Error() string {
+func (e AdminListenerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7320,14 +15242,14 @@ func (e TenantListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListResponse.%s: %s%s",
+		"invalid %sAdminListener.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListResponseValidationError{}
+var _ error = AdminListenerValidationError{}
 
 var _ interface {
 	Field() string
@@ -7335,4 +15257,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListResponseValidationError{}
+} = AdminListenerValidationError{}
@@ -19,12 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Permission_Check_FullMethodName              = "/base.v1.Permission/Check"
-	Permission_Expand_FullMethodName             = "/base.v1.Permission/Expand"
-	Permission_LookupEntity_FullMethodName       = "/base.v1.Permission/LookupEntity"
-	Permission_LookupEntityStream_FullMethodName = "/base.v1.Permission/LookupEntityStream"
-	Permission_LookupSubject_FullMethodName      = "/base.v1.Permission/LookupSubject"
-	Permission_SubjectPermission_FullMethodName  = "/base.v1.Permission/SubjectPermission"
+	Permission_Check_FullMethodName                       = "/base.v1.Permission/Check"
+	Permission_Expand_FullMethodName                      = "/base.v1.Permission/Expand"
+	Permission_LookupEntity_FullMethodName                = "/base.v1.Permission/LookupEntity"
+	Permission_LookupEntityStream_FullMethodName          = "/base.v1.Permission/LookupEntityStream"
+	Permission_LookupEntityWithPermissions_FullMethodName = "/base.v1.Permission/LookupEntityWithPermissions"
+	Permission_LookupSubject_FullMethodName               = "/base.v1.Permission/LookupSubject"
+	Permission_SubjectPermission_FullMethodName           = "/base.v1.Permission/SubjectPermission"
+	Permission_Paths_FullMethodName                       = "/base.v1.Permission/Paths"
+	Permission_Decision_FullMethodName                    = "/base.v1.Permission/Decision"
 )
 
 // PermissionClient is the client API for Permission service.
@@ -44,12 +47,22 @@ type PermissionClient interface {
 	// LookupEntityStream method receives a PermissionLookupEntityRequest and streams a series of PermissionLookupEntityStreamResponse messages.
 	// It is used to retrieve entities by their identifiers in a streaming fashion.
 	LookupEntityStream(ctx context.Context, in *PermissionLookupEntityRequest, opts ...grpc.CallOption) (Permission_LookupEntityStreamClient, error)
+	// LookupEntityWithPermissions method receives a PermissionLookupEntityWithPermissionsRequest and returns a PermissionLookupEntityWithPermissionsResponse.
+	// It is used to retrieve the entities on which the subject has any of the permissions, along with which of them it has.
+	LookupEntityWithPermissions(ctx context.Context, in *PermissionLookupEntityWithPermissionsRequest, opts ...grpc.CallOption) (*PermissionLookupEntityWithPermissionsResponse, error)
 	// LookupSubject method receives a PermissionLookupSubjectRequest and returns a PermissionLookupSubjectResponse.
 	// It is used to retrieve a subject by its identifier.
 	LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error)
 	// SubjectPermission method receives a PermissionSubjectPermissionRequest and returns a PermissionSubjectPermissionResponse.
 	// It is used to retrieve permissions related to a specific subject.
 	SubjectPermission(ctx context.Context, in *PermissionSubjectPermissionRequest, opts ...grpc.CallOption) (*PermissionSubjectPermissionResponse, error)
+	// Paths method receives a PermissionPathsRequest and returns a PermissionPathsResponse.
+	// It is used to explain why a subject has a permission, with the relationship paths connecting the subject to the entity.
+	Paths(ctx context.Context, in *PermissionPathsRequest, opts ...grpc.CallOption) (*PermissionPathsResponse, error)
+	// Decision method receives a PermissionDecisionRequest and returns a PermissionDecisionResponse.
+	// It answers an OPA-style authorization query, mapping its action to a permission through the translation table
+	// of the server, so that sidecars querying OPA can query Permify instead.
+	Decision(ctx context.Context, in *PermissionDecisionRequest, opts ...grpc.CallOption) (*PermissionDecisionResponse, error)
 }
 
 type permissionClient struct {
@@ -119,6 +132,15 @@ func (x *permissionLookupEntityStreamClient) Recv() (*PermissionLookupEntityStre
 	return m, nil
 }
 
+func (c *permissionClient) LookupEntityWithPermissions(ctx context.Context, in *PermissionLookupEntityWithPermissionsRequest, opts ...grpc.CallOption) (*PermissionLookupEntityWithPermissionsResponse, error) {
+	out := new(PermissionLookupEntityWithPermissionsResponse)
+	err := c.cc.Invoke(ctx, Permission_LookupEntityWithPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *permissionClient) LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error) {
 	out := new(PermissionLookupSubjectResponse)
 	err := c.cc.Invoke(ctx, Permission_LookupSubject_FullMethodName, in, out, opts...)
@@ -137,6 +159,24 @@ func (c *permissionClient) SubjectPermission(ctx context.Context, in *Permission
 	return out, nil
 }
 
+func (c *permissionClient) Paths(ctx context.Context, in *PermissionPathsRequest, opts ...grpc.CallOption) (*PermissionPathsResponse, error) {
+	out := new(PermissionPathsResponse)
+	err := c.cc.Invoke(ctx, Permission_Paths_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionClient) Decision(ctx context.Context, in *PermissionDecisionRequest, opts ...grpc.CallOption) (*PermissionDecisionResponse, error) {
+	out := new(PermissionDecisionResponse)
+	err := c.cc.Invoke(ctx, Permission_Decision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionServer is the server API for Permission service.
 // All implementations must embed UnimplementedPermissionServer
 // for forward compatibility
@@ -154,12 +194,22 @@ type PermissionServer interface {
 	// LookupEntityStream method receives a PermissionLookupEntityRequest and streams a series of PermissionLookupEntityStreamResponse messages.
 	// It is used to retrieve entities by their identifiers in a streaming fashion.
 	LookupEntityStream(*PermissionLookupEntityRequest, Permission_LookupEntityStreamServer) error
+	// LookupEntityWithPermissions method receives a PermissionLookupEntityWithPermissionsRequest and returns a PermissionLookupEntityWithPermissionsResponse.
+	// It is used to retrieve the entities on which the subject has any of the permissions, along with which of them it has.
+	LookupEntityWithPermissions(context.Context, *PermissionLookupEntityWithPermissionsRequest) (*PermissionLookupEntityWithPermissionsResponse, error)
 	// LookupSubject method receives a PermissionLookupSubjectRequest and returns a PermissionLookupSubjectResponse.
 	// It is used to retrieve a subject by its identifier.
 	LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error)
 	// SubjectPermission method receives a PermissionSubjectPermissionRequest and returns a PermissionSubjectPermissionResponse.
 	// It is used to retrieve permissions related to a specific subject.
 	SubjectPermission(context.Context, *PermissionSubjectPermissionRequest) (*PermissionSubjectPermissionResponse, error)
+	// Paths method receives a PermissionPathsRequest and returns a PermissionPathsResponse.
+	// It is used to explain why a subject has a permission, with the relationship paths connecting the subject to the entity.
+	Paths(context.Context, *PermissionPathsRequest) (*PermissionPathsResponse, error)
+	// Decision method receives a PermissionDecisionRequest and returns a PermissionDecisionResponse.
+	// It answers an OPA-style authorization query, mapping its action to a permission through the translation table
+	// of the server, so that sidecars querying OPA can query Permify instead.
+	Decision(context.Context, *PermissionDecisionRequest) (*PermissionDecisionResponse, error)
 	mustEmbedUnimplementedPermissionServer()
 }
 
@@ -179,12 +229,21 @@ func (UnimplementedPermissionServer) LookupEntity(context.Context, *PermissionLo
 func (UnimplementedPermissionServer) LookupEntityStream(*PermissionLookupEntityRequest, Permission_LookupEntityStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method LookupEntityStream not implemented")
 }
+func (UnimplementedPermissionServer) LookupEntityWithPermissions(context.Context, *PermissionLookupEntityWithPermissionsRequest) (*PermissionLookupEntityWithPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupEntityWithPermissions not implemented")
+}
 func (UnimplementedPermissionServer) LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupSubject not implemented")
 }
 func (UnimplementedPermissionServer) SubjectPermission(context.Context, *PermissionSubjectPermissionRequest) (*PermissionSubjectPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubjectPermission not implemented")
 }
+func (UnimplementedPermissionServer) Paths(context.Context, *PermissionPathsRequest) (*PermissionPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paths not implemented")
+}
+func (UnimplementedPermissionServer) Decision(context.Context, *PermissionDecisionRequest) (*PermissionDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decision not implemented")
+}
 func (UnimplementedPermissionServer) mustEmbedUnimplementedPermissionServer() {}
 
 // UnsafePermissionServer may be embedded to opt out of forward compatibility for this service.
@@ -273,6 +332,24 @@ func (x *permissionLookupEntityStreamServer) Send(m *PermissionLookupEntityStrea
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Permission_LookupEntityWithPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionLookupEntityWithPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).LookupEntityWithPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_LookupEntityWithPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).LookupEntityWithPermissions(ctx, req.(*PermissionLookupEntityWithPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Permission_LookupSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PermissionLookupSubjectRequest)
 	if err := dec(in); err != nil {
@@ -309,6 +386,42 @@ func _Permission_SubjectPermission_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Permission_Paths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).Paths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_Paths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).Paths(ctx, req.(*PermissionPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Permission_Decision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).Decision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_Decision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).Decision(ctx, req.(*PermissionDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Permission_ServiceDesc is the grpc.ServiceDesc for Permission service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -328,6 +441,10 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupEntity",
 			Handler:    _Permission_LookupEntity_Handler,
 		},
+		{
+			MethodName: "LookupEntityWithPermissions",
+			Handler:    _Permission_LookupEntityWithPermissions_Handler,
+		},
 		{
 			MethodName: "LookupSubject",
 			Handler:    _Permission_LookupSubject_Handler,
@@ -336,6 +453,14 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubjectPermission",
 			Handler:    _Permission_SubjectPermission_Handler,
 		},
+		{
+			MethodName: "Paths",
+			Handler:    _Permission_Paths_Handler,
+		},
+		{
+			MethodName: "Decision",
+			Handler:    _Permission_Decision_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -465,8 +590,11 @@ var Watch_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Schema_Write_FullMethodName = "/base.v1.Schema/Write"
-	Schema_Read_FullMethodName  = "/base.v1.Schema/Read"
+	Schema_Write_FullMethodName       = "/base.v1.Schema/Write"
+	Schema_Read_FullMethodName        = "/base.v1.Schema/Read"
+	Schema_ReadPartial_FullMethodName = "/base.v1.Schema/ReadPartial"
+	Schema_ApplyBundle_FullMethodName = "/base.v1.Schema/ApplyBundle"
+	Schema_Migrate_FullMethodName     = "/base.v1.Schema/Migrate"
 )
 
 // SchemaClient is the client API for Schema service.
@@ -477,6 +605,13 @@ type SchemaClient interface {
 	Write(ctx context.Context, in *SchemaWriteRequest, opts ...grpc.CallOption) (*SchemaWriteResponse, error)
 	// Read is an RPC that allows you to read your authorization model.
 	Read(ctx context.Context, in *SchemaReadRequest, opts ...grpc.CallOption) (*SchemaReadResponse, error)
+	// ReadPartial is an RPC that allows you to read some of the entity and rule definitions of your authorization model.
+	ReadPartial(ctx context.Context, in *SchemaReadPartialRequest, opts ...grpc.CallOption) (*SchemaReadPartialResponse, error)
+	// ApplyBundle is an RPC that allows you to apply a bundle, a schema along with its seed data, at once.
+	ApplyBundle(ctx context.Context, in *SchemaApplyBundleRequest, opts ...grpc.CallOption) (*SchemaApplyBundleResponse, error)
+	// Migrate is an RPC that allows you to write a new version of your authorization model along with the changes it
+	// makes to your data, such as renaming a relation and rewriting its relationships, in a single transaction.
+	Migrate(ctx context.Context, in *SchemaMigrateRequest, opts ...grpc.CallOption) (*SchemaMigrateResponse, error)
 }
 
 type schemaClient struct {
@@ -505,6 +640,33 @@ func (c *schemaClient) Read(ctx context.Context, in *SchemaReadRequest, opts ...
 	return out, nil
 }
 
+func (c *schemaClient) ReadPartial(ctx context.Context, in *SchemaReadPartialRequest, opts ...grpc.CallOption) (*SchemaReadPartialResponse, error) {
+	out := new(SchemaReadPartialResponse)
+	err := c.cc.Invoke(ctx, Schema_ReadPartial_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) ApplyBundle(ctx context.Context, in *SchemaApplyBundleRequest, opts ...grpc.CallOption) (*SchemaApplyBundleResponse, error) {
+	out := new(SchemaApplyBundleResponse)
+	err := c.cc.Invoke(ctx, Schema_ApplyBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Migrate(ctx context.Context, in *SchemaMigrateRequest, opts ...grpc.CallOption) (*SchemaMigrateResponse, error) {
+	out := new(SchemaMigrateResponse)
+	err := c.cc.Invoke(ctx, Schema_Migrate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchemaServer is the server API for Schema service.
 // All implementations must embed UnimplementedSchemaServer
 // for forward compatibility
@@ -513,6 +675,13 @@ type SchemaServer interface {
 	Write(context.Context, *SchemaWriteRequest) (*SchemaWriteResponse, error)
 	// Read is an RPC that allows you to read your authorization model.
 	Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error)
+	// ReadPartial is an RPC that allows you to read some of the entity and rule definitions of your authorization model.
+	ReadPartial(context.Context, *SchemaReadPartialRequest) (*SchemaReadPartialResponse, error)
+	// ApplyBundle is an RPC that allows you to apply a bundle, a schema along with its seed data, at once.
+	ApplyBundle(context.Context, *SchemaApplyBundleRequest) (*SchemaApplyBundleResponse, error)
+	// Migrate is an RPC that allows you to write a new version of your authorization model along with the changes it
+	// makes to your data, such as renaming a relation and rewriting its relationships, in a single transaction.
+	Migrate(context.Context, *SchemaMigrateRequest) (*SchemaMigrateResponse, error)
 	mustEmbedUnimplementedSchemaServer()
 }
 
@@ -526,6 +695,15 @@ func (UnimplementedSchemaServer) Write(context.Context, *SchemaWriteRequest) (*S
 func (UnimplementedSchemaServer) Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedSchemaServer) ReadPartial(context.Context, *SchemaReadPartialRequest) (*SchemaReadPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPartial not implemented")
+}
+func (UnimplementedSchemaServer) ApplyBundle(context.Context, *SchemaApplyBundleRequest) (*SchemaApplyBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBundle not implemented")
+}
+func (UnimplementedSchemaServer) Migrate(context.Context, *SchemaMigrateRequest) (*SchemaMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
 func (UnimplementedSchemaServer) mustEmbedUnimplementedSchemaServer() {}
 
 // UnsafeSchemaServer may be embedded to opt out of forward compatibility for this service.
@@ -575,6 +753,60 @@ func _Schema_Read_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Schema_ReadPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaReadPartialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ReadPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ReadPartial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ReadPartial(ctx, req.(*SchemaReadPartialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_ApplyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaApplyBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ApplyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ApplyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ApplyBundle(ctx, req.(*SchemaApplyBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_Migrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Migrate(ctx, req.(*SchemaMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Schema_ServiceDesc is the grpc.ServiceDesc for Schema service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -590,6 +822,18 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _Schema_Read_Handler,
 		},
+		{
+			MethodName: "ReadPartial",
+			Handler:    _Schema_ReadPartial_Handler,
+		},
+		{
+			MethodName: "ApplyBundle",
+			Handler:    _Schema_ApplyBundle_Handler,
+		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Schema_Migrate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -600,8 +844,10 @@ const (
 	Data_WriteRelationships_FullMethodName  = "/base.v1.Data/WriteRelationships"
 	Data_ReadRelationships_FullMethodName   = "/base.v1.Data/ReadRelationships"
 	Data_ReadAttributes_FullMethodName      = "/base.v1.Data/ReadAttributes"
+	Data_ReadHistory_FullMethodName         = "/base.v1.Data/ReadHistory"
 	Data_Delete_FullMethodName              = "/base.v1.Data/Delete"
 	Data_DeleteRelationships_FullMethodName = "/base.v1.Data/DeleteRelationships"
+	Data_RunTransaction_FullMethodName      = "/base.v1.Data/RunTransaction"
 )
 
 // DataClient is the client API for Data service.
@@ -616,10 +862,14 @@ type DataClient interface {
 	ReadRelationships(ctx context.Context, in *RelationshipReadRequest, opts ...grpc.CallOption) (*RelationshipReadResponse, error)
 	// The ReadAttributes RPC method reads attribute(s) of a relation.
 	ReadAttributes(ctx context.Context, in *AttributeReadRequest, opts ...grpc.CallOption) (*AttributeReadResponse, error)
+	// The ReadHistory RPC method reads the creation and deletion timeline of relation tuple(s).
+	ReadHistory(ctx context.Context, in *HistoryReadRequest, opts ...grpc.CallOption) (*HistoryReadResponse, error)
 	// The Delete RPC method deletes a relation tuple.
 	Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error)
 	// RPC method to delete relationships for a tenant, accessed via a POST request to the specified path, tagged as "Data" in OpenAPI documentation.
 	DeleteRelationships(ctx context.Context, in *RelationshipDeleteRequest, opts ...grpc.CallOption) (*RelationshipDeleteResponse, error)
+	// The RunTransaction RPC method writes and deletes relation tuples and attributes atomically, in a single transaction.
+	RunTransaction(ctx context.Context, in *DataTransactionRequest, opts ...grpc.CallOption) (*DataTransactionResponse, error)
 }
 
 type dataClient struct {
@@ -666,6 +916,15 @@ func (c *dataClient) ReadAttributes(ctx context.Context, in *AttributeReadReques
 	return out, nil
 }
 
+func (c *dataClient) ReadHistory(ctx context.Context, in *HistoryReadRequest, opts ...grpc.CallOption) (*HistoryReadResponse, error) {
+	out := new(HistoryReadResponse)
+	err := c.cc.Invoke(ctx, Data_ReadHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) Delete(ctx context.Context, in *DataDeleteRequest, opts ...grpc.CallOption) (*DataDeleteResponse, error) {
 	out := new(DataDeleteResponse)
 	err := c.cc.Invoke(ctx, Data_Delete_FullMethodName, in, out, opts...)
@@ -684,6 +943,15 @@ func (c *dataClient) DeleteRelationships(ctx context.Context, in *RelationshipDe
 	return out, nil
 }
 
+func (c *dataClient) RunTransaction(ctx context.Context, in *DataTransactionRequest, opts ...grpc.CallOption) (*DataTransactionResponse, error) {
+	out := new(DataTransactionResponse)
+	err := c.cc.Invoke(ctx, Data_RunTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataServer is the server API for Data service.
 // All implementations must embed UnimplementedDataServer
 // for forward compatibility
@@ -696,10 +964,14 @@ type DataServer interface {
 	ReadRelationships(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error)
 	// The ReadAttributes RPC method reads attribute(s) of a relation.
 	ReadAttributes(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error)
+	// The ReadHistory RPC method reads the creation and deletion timeline of relation tuple(s).
+	ReadHistory(context.Context, *HistoryReadRequest) (*HistoryReadResponse, error)
 	// The Delete RPC method deletes a relation tuple.
 	Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error)
 	// RPC method to delete relationships for a tenant, accessed via a POST request to the specified path, tagged as "Data" in OpenAPI documentation.
 	DeleteRelationships(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error)
+	// The RunTransaction RPC method writes and deletes relation tuples and attributes atomically, in a single transaction.
+	RunTransaction(context.Context, *DataTransactionRequest) (*DataTransactionResponse, error)
 	mustEmbedUnimplementedDataServer()
 }
 
@@ -719,12 +991,18 @@ func (UnimplementedDataServer) ReadRelationships(context.Context, *RelationshipR
 func (UnimplementedDataServer) ReadAttributes(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadAttributes not implemented")
 }
+func (UnimplementedDataServer) ReadHistory(context.Context, *HistoryReadRequest) (*HistoryReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadHistory not implemented")
+}
 func (UnimplementedDataServer) Delete(context.Context, *DataDeleteRequest) (*DataDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
 func (UnimplementedDataServer) DeleteRelationships(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationships not implemented")
 }
+func (UnimplementedDataServer) RunTransaction(context.Context, *DataTransactionRequest) (*DataTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTransaction not implemented")
+}
 func (UnimplementedDataServer) mustEmbedUnimplementedDataServer() {}
 
 // UnsafeDataServer may be embedded to opt out of forward compatibility for this service.
@@ -810,6 +1088,24 @@ func _Data_ReadAttributes_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ReadHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ReadHistory(ctx, req.(*HistoryReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DataDeleteRequest)
 	if err := dec(in); err != nil {
@@ -846,6 +1142,24 @@ func _Data_DeleteRelationships_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_RunTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RunTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RunTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RunTransaction(ctx, req.(*DataTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Data_ServiceDesc is the grpc.ServiceDesc for Data service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -869,6 +1183,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadAttributes",
 			Handler:    _Data_ReadAttributes_Handler,
 		},
+		{
+			MethodName: "ReadHistory",
+			Handler:    _Data_ReadHistory_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Data_Delete_Handler,
@@ -877,6 +1195,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRelationships",
 			Handler:    _Data_DeleteRelationships_Handler,
 		},
+		{
+			MethodName: "RunTransaction",
+			Handler:    _Data_RunTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -1057,3 +1379,359 @@ var Tenancy_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
 }
+
+const (
+	Admin_MigrationStatus_FullMethodName = "/base.v1.Admin/MigrationStatus"
+	Admin_Databases_FullMethodName       = "/base.v1.Admin/Databases"
+	Admin_ErrorCodes_FullMethodName      = "/base.v1.Admin/ErrorCodes"
+	Admin_Ring_FullMethodName            = "/base.v1.Admin/Ring"
+	Admin_Tunables_FullMethodName        = "/base.v1.Admin/Tunables"
+	Admin_UpdateTunables_FullMethodName  = "/base.v1.Admin/UpdateTunables"
+	Admin_Listeners_FullMethodName       = "/base.v1.Admin/Listeners"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// MigrationStatus is a unary RPC to get the migration version of the database of the server, along with the
+	// latest version the server can migrate it to.
+	// It requires an AdminMigrationStatusRequest and returns an AdminMigrationStatusResponse.
+	MigrationStatus(ctx context.Context, in *AdminMigrationStatusRequest, opts ...grpc.CallOption) (*AdminMigrationStatusResponse, error)
+	// Databases is a unary RPC to list the database regions of the server, along with their health and the number
+	// of tenants kept in them.
+	// It requires an AdminDatabasesRequest and returns an AdminDatabasesResponse.
+	Databases(ctx context.Context, in *AdminDatabasesRequest, opts ...grpc.CallOption) (*AdminDatabasesResponse, error)
+	// ErrorCodes is a unary RPC to list the catalog of the error codes of the server, along with the gRPC status and
+	// the message of each, so clients can map failures to behavior by code instead of matching messages.
+	// It requires an AdminErrorCodesRequest and returns an AdminErrorCodesResponse.
+	ErrorCodes(ctx context.Context, in *AdminErrorCodesRequest, opts ...grpc.CallOption) (*AdminErrorCodesResponse, error)
+	// Ring is a unary RPC to get the consistent hash ring the server dispatches checks to the nodes of the cluster
+	// with: its nodes, the share of the keys each owns, and the keys its last change moved between them.
+	// It requires an AdminRingRequest and returns an AdminRingResponse.
+	Ring(ctx context.Context, in *AdminRingRequest, opts ...grpc.CallOption) (*AdminRingResponse, error)
+	// Tunables is a unary RPC to list the settings of the server that can be changed at runtime, such as the log level
+	// or the rate limit, with their values, along with the recent changes of them.
+	// It requires an AdminTunablesRequest and returns an AdminTunablesResponse.
+	Tunables(ctx context.Context, in *AdminTunablesRequest, opts ...grpc.CallOption) (*AdminTunablesResponse, error)
+	// UpdateTunables is a unary RPC to change settings of the server at runtime, without restarting it. The changes
+	// apply to the server receiving the request only, and last until it restarts. Either all the values of the request
+	// are applied, or none of them.
+	// It requires an AdminUpdateTunablesRequest and returns an AdminUpdateTunablesResponse.
+	UpdateTunables(ctx context.Context, in *AdminUpdateTunablesRequest, opts ...grpc.CallOption) (*AdminUpdateTunablesResponse, error)
+	// Listeners is a unary RPC to list the addresses the servers of the node listen on, resolved once they started,
+	// e.g. with the ports chosen for the port 0.
+	// It requires an AdminListenersRequest and returns an AdminListenersResponse.
+	Listeners(ctx context.Context, in *AdminListenersRequest, opts ...grpc.CallOption) (*AdminListenersResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) MigrationStatus(ctx context.Context, in *AdminMigrationStatusRequest, opts ...grpc.CallOption) (*AdminMigrationStatusResponse, error) {
+	out := new(AdminMigrationStatusResponse)
+	err := c.cc.Invoke(ctx, Admin_MigrationStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Databases(ctx context.Context, in *AdminDatabasesRequest, opts ...grpc.CallOption) (*AdminDatabasesResponse, error) {
+	out := new(AdminDatabasesResponse)
+	err := c.cc.Invoke(ctx, Admin_Databases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ErrorCodes(ctx context.Context, in *AdminErrorCodesRequest, opts ...grpc.CallOption) (*AdminErrorCodesResponse, error) {
+	out := new(AdminErrorCodesResponse)
+	err := c.cc.Invoke(ctx, Admin_ErrorCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Ring(ctx context.Context, in *AdminRingRequest, opts ...grpc.CallOption) (*AdminRingResponse, error) {
+	out := new(AdminRingResponse)
+	err := c.cc.Invoke(ctx, Admin_Ring_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Tunables(ctx context.Context, in *AdminTunablesRequest, opts ...grpc.CallOption) (*AdminTunablesResponse, error) {
+	out := new(AdminTunablesResponse)
+	err := c.cc.Invoke(ctx, Admin_Tunables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateTunables(ctx context.Context, in *AdminUpdateTunablesRequest, opts ...grpc.CallOption) (*AdminUpdateTunablesResponse, error) {
+	out := new(AdminUpdateTunablesResponse)
+	err := c.cc.Invoke(ctx, Admin_UpdateTunables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Listeners(ctx context.Context, in *AdminListenersRequest, opts ...grpc.CallOption) (*AdminListenersResponse, error) {
+	out := new(AdminListenersResponse)
+	err := c.cc.Invoke(ctx, Admin_Listeners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// MigrationStatus is a unary RPC to get the migration version of the database of the server, along with the
+	// latest version the server can migrate it to.
+	// It requires an AdminMigrationStatusRequest and returns an AdminMigrationStatusResponse.
+	MigrationStatus(context.Context, *AdminMigrationStatusRequest) (*AdminMigrationStatusResponse, error)
+	// Databases is a unary RPC to list the database regions of the server, along with their health and the number
+	// of tenants kept in them.
+	// It requires an AdminDatabasesRequest and returns an AdminDatabasesResponse.
+	Databases(context.Context, *AdminDatabasesRequest) (*AdminDatabasesResponse, error)
+	// ErrorCodes is a unary RPC to list the catalog of the error codes of the server, along with the gRPC status and
+	// the message of each, so clients can map failures to behavior by code instead of matching messages.
+	// It requires an AdminErrorCodesRequest and returns an AdminErrorCodesResponse.
+	ErrorCodes(context.Context, *AdminErrorCodesRequest) (*AdminErrorCodesResponse, error)
+	// Ring is a unary RPC to get the consistent hash ring the server dispatches checks to the nodes of the cluster
+	// with: its nodes, the share of the keys each owns, and the keys its last change moved between them.
+	// It requires an AdminRingRequest and returns an AdminRingResponse.
+	Ring(context.Context, *AdminRingRequest) (*AdminRingResponse, error)
+	// Tunables is a unary RPC to list the settings of the server that can be changed at runtime, such as the log level
+	// or the rate limit, with their values, along with the recent changes of them.
+	// It requires an AdminTunablesRequest and returns an AdminTunablesResponse.
+	Tunables(context.Context, *AdminTunablesRequest) (*AdminTunablesResponse, error)
+	// UpdateTunables is a unary RPC to change settings of the server at runtime, without restarting it. The changes
+	// apply to the server receiving the request only, and last until it restarts. Either all the values of the request
+	// are applied, or none of them.
+	// It requires an AdminUpdateTunablesRequest and returns an AdminUpdateTunablesResponse.
+	UpdateTunables(context.Context, *AdminUpdateTunablesRequest) (*AdminUpdateTunablesResponse, error)
+	// Listeners is a unary RPC to list the addresses the servers of the node listen on, resolved once they started,
+	// e.g. with the ports chosen for the port 0.
+	// It requires an AdminListenersRequest and returns an AdminListenersResponse.
+	Listeners(context.Context, *AdminListenersRequest) (*AdminListenersResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) MigrationStatus(context.Context, *AdminMigrationStatusRequest) (*AdminMigrationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationStatus not implemented")
+}
+func (UnimplementedAdminServer) Databases(context.Context, *AdminDatabasesRequest) (*AdminDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Databases not implemented")
+}
+func (UnimplementedAdminServer) ErrorCodes(context.Context, *AdminErrorCodesRequest) (*AdminErrorCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErrorCodes not implemented")
+}
+func (UnimplementedAdminServer) Ring(context.Context, *AdminRingRequest) (*AdminRingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ring not implemented")
+}
+func (UnimplementedAdminServer) Tunables(context.Context, *AdminTunablesRequest) (*AdminTunablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tunables not implemented")
+}
+func (UnimplementedAdminServer) UpdateTunables(context.Context, *AdminUpdateTunablesRequest) (*AdminUpdateTunablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTunables not implemented")
+}
+func (UnimplementedAdminServer) Listeners(context.Context, *AdminListenersRequest) (*AdminListenersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Listeners not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_MigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminMigrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).MigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_MigrationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).MigrationStatus(ctx, req.(*AdminMigrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Databases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Databases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Databases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Databases(ctx, req.(*AdminDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ErrorCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminErrorCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ErrorCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ErrorCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ErrorCodes(ctx, req.(*AdminErrorCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Ring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Ring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Ring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Ring(ctx, req.(*AdminRingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Tunables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTunablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Tunables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Tunables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Tunables(ctx, req.(*AdminTunablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateTunables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateTunablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateTunables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UpdateTunables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateTunables(ctx, req.(*AdminUpdateTunablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Listeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListenersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Listeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_Listeners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Listeners(ctx, req.(*AdminListenersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MigrationStatus",
+			Handler:    _Admin_MigrationStatus_Handler,
+		},
+		{
+			MethodName: "Databases",
+			Handler:    _Admin_Databases_Handler,
+		},
+		{
+			MethodName: "ErrorCodes",
+			Handler:    _Admin_ErrorCodes_Handler,
+		},
+		{
+			MethodName: "Ring",
+			Handler:    _Admin_Ring_Handler,
+		},
+		{
+			MethodName: "Tunables",
+			Handler:    _Admin_Tunables_Handler,
+		},
+		{
+			MethodName: "UpdateTunables",
+			Handler:    _Admin_UpdateTunables_Handler,
+		},
+		{
+			MethodName: "Listeners",
+			Handler:    _Admin_Listeners_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
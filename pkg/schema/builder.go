@@ -77,8 +77,8 @@ func Entities(defs ...*base.EntityDefinition) []*base.EntityDefinition {
 // a map of argument names to attribute types, and an expression string.
 // The expression string is compiled and transformed to a checked expression.
 func Rule(name string, arguments map[string]base.AttributeType, expression string) *base.RuleDefinition {
-	// Initialize an empty slice of environment options.
-	var envOptions []cel.EnvOption
+	// Initialize the environment options with the request variable and the numeric helpers.
+	envOptions := utils.RuleEnvOptions()
 
 	// Iterate through each argument.
 	for name, ty := range arguments {
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// ErrLookupUnsupported is returned by the data readers that can't evaluate a lookup plan, or the relationships it
+// reads, in the storage. The lookup engine then falls back to walking the relationships itself.
+var ErrLookupUnsupported = errors.New("lookup unsupported by the storage")
+
+// EntityLookup - Optional interface of the data readers that evaluate the lookups of entities in the storage, with
+// the unions, intersections and exclusions of the permissions pushed down to it instead of fetching the candidate
+// entities of each of them.
+type EntityLookup interface {
+	// LookupEntities returns the identifiers of the entities of the plan the subject is in. It returns
+	// ErrLookupUnsupported when it can't evaluate the plan exactly, e.g. when the relationships it reads include
+	// wildcard entities or subjects the plan doesn't follow.
+	LookupEntities(ctx context.Context, tenantID string, plan *LookupPlan, subject *base.Subject, snap string) (ids []string, err error)
+}
+
+// LookupOperation - Operation of a node of a lookup plan
+type LookupOperation int
+
+const (
+	// LookupRelationships reads the entities whose relationships of the relation have the subject, directly or
+	// through the subjects of one of the branches of the plan.
+	LookupRelationships LookupOperation = iota
+	// LookupUnion is the union of the entities of the children of the plan.
+	LookupUnion
+	// LookupIntersection is the intersection of the entities of the children of the plan.
+	LookupIntersection
+	// LookupExclusion is the entities of the first child of the plan, except the entities of the others.
+	LookupExclusion
+)
+
+// LookupPlan - Set of the entities of a type the subject of a lookup is in, as a tree of set operations whose leaves
+// read the relationships of a relation. The same subtree may be shared by several nodes of the tree.
+type LookupPlan struct {
+	Operation LookupOperation
+	// Children are the operands of the set operations
+	Children []*LookupPlan
+	// EntityType and Relation are the relationships a LookupRelationships node reads, and Branches are the subjects
+	// of them it follows. The relationships of subjects none of the branches follow can't be evaluated by the plan.
+	EntityType string
+	Relation   string
+	Branches   []LookupBranch
+}
+
+// LookupBranch - Subjects of the relationships read by a node of a lookup plan
+type LookupBranch struct {
+	SubjectType string
+	// SubjectRelation is the relation of the subjects, empty for the direct subjects, which match the subject of the
+	// lookup or the wildcard of its type.
+	SubjectRelation string
+	// AnyRelation is whether the subjects match whatever their relation, as the ones of a tuple to user set.
+	AnyRelation bool
+	// Subjects is the set of the subjects whose relationships match, unless the branch is of direct subjects.
+	Subjects *LookupPlan
+}
@@ -0,0 +1,14 @@
+package storage
+
+// SchemaDefinition - Structure for Schema Definition
+type SchemaDefinition struct {
+	TenantID             string
+	Name                 string
+	SerializedDefinition []byte
+	Version              string
+}
+
+// Serialized - get schema serialized definition
+func (e SchemaDefinition) Serialized() string {
+	return string(e.SerializedDefinition)
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Permify/permify/pkg/database"
+)
+
+// Config - Database settings passed to a custom engine, taken from the database section of the configuration
+type Config struct {
+	URI                   string
+	MaxOpenConnections    int
+	MaxIdleConnections    int
+	MaxConnectionIdleTime time.Duration
+	MaxConnectionLifetime time.Duration
+}
+
+// Engine - Constructors of a custom storage engine. Open and every reader and writer constructor are required.
+// The database passed to the constructors is the one returned by Open.
+type Engine struct {
+	// Open connects to the database described by the configuration.
+	Open func(conf Config) (database.Database, error)
+	// Migrate prepares the database for the storage, e.g. by creating its tables. It runs on auto migration and
+	// on migrate up, and may be left nil if the engine needs no migration.
+	Migrate func(conf Config) error
+
+	NewDataReader   func(db database.Database) DataReader
+	NewDataWriter   func(db database.Database) DataWriter
+	NewSchemaReader func(db database.Database) SchemaReader
+	NewSchemaWriter func(db database.Database) SchemaWriter
+	NewTenantReader func(db database.Database) TenantReader
+	NewTenantWriter func(db database.Database) TenantWriter
+	// NewWatcher may be left nil if the engine does not support the Watch API.
+	NewWatcher func(db database.Database) Watcher
+}
+
+var (
+	enginesMu sync.RWMutex
+	engines   = map[string]Engine{}
+)
+
+// builtin holds the names of the engines shipped with Permify, which cannot be replaced.
+var builtin = map[string]bool{
+	database.POSTGRES.String(): true,
+	database.MEMORY.String():   true,
+	database.DYNAMODB.String(): true,
+	database.MONGODB.String():  true,
+	database.SPANNER.String():  true,
+}
+
+// Register makes a storage engine available under the given name, which is then selected with
+// database.engine: <name>. It is meant to be called from an init function. Register panics if the name is
+// empty, taken by a built-in or already registered engine, or if a required constructor is missing.
+// The GetEngineType method of the databases opened by the engine must return the name.
+func Register(name string, engine Engine) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+
+	if name == "" {
+		panic("storage: Register engine name is empty")
+	}
+	if builtin[name] {
+		panic(fmt.Sprintf("storage: Register called for built-in engine %s", name))
+	}
+	if _, dup := engines[name]; dup {
+		panic(fmt.Sprintf("storage: Register called twice for engine %s", name))
+	}
+	if engine.Open == nil || engine.NewDataReader == nil || engine.NewDataWriter == nil ||
+		engine.NewSchemaReader == nil || engine.NewSchemaWriter == nil ||
+		engine.NewTenantReader == nil || engine.NewTenantWriter == nil {
+		panic(fmt.Sprintf("storage: Register engine %s is missing a constructor", name))
+	}
+
+	engines[name] = engine
+}
+
+// Lookup returns the engine registered under the given name.
+func Lookup(name string) (Engine, bool) {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	engine, ok := engines[name]
+	return engine, ok
+}
+
+// Engines returns the sorted names of the registered engines.
+func Engines() []string {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// unregister removes the engine registered under the given name. It exists for tests.
+func unregister(name string) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+	delete(engines, name)
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Permify/permify/pkg/database"
+)
+
+type testDatabase struct{}
+
+func (testDatabase) GetEngineType() string                   { return "custom" }
+func (testDatabase) Close() error                            { return nil }
+func (testDatabase) IsReady(_ context.Context) (bool, error) { return true, nil }
+
+func testEngine() Engine {
+	return Engine{
+		Open:            func(_ Config) (database.Database, error) { return testDatabase{}, nil },
+		NewDataReader:   func(_ database.Database) DataReader { return nil },
+		NewDataWriter:   func(_ database.Database) DataWriter { return nil },
+		NewSchemaReader: func(_ database.Database) SchemaReader { return nil },
+		NewSchemaWriter: func(_ database.Database) SchemaWriter { return nil },
+		NewTenantReader: func(_ database.Database) TenantReader { return nil },
+		NewTenantWriter: func(_ database.Database) TenantWriter { return nil },
+	}
+}
+
+func TestRegister(t *testing.T) {
+	defer unregister("custom")
+
+	_, ok := Lookup("custom")
+	assert.False(t, ok)
+
+	Register("custom", testEngine())
+
+	engine, ok := Lookup("custom")
+	assert.True(t, ok)
+	db, err := engine.Open(Config{URI: "custom://"})
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", db.GetEngineType())
+	assert.Equal(t, []string{"custom"}, Engines())
+
+	assert.Panics(t, func() { Register("custom", testEngine()) })
+}
+
+func TestRegisterRejectsInvalidEngines(t *testing.T) {
+	assert.Panics(t, func() { Register("", testEngine()) })
+	assert.Panics(t, func() { Register(database.POSTGRES.String(), testEngine()) })
+
+	incomplete := testEngine()
+	incomplete.NewTenantWriter = nil
+	assert.Panics(t, func() { Register("incomplete", incomplete) })
+
+	_, ok := Lookup("incomplete")
+	assert.False(t, ok)
+}
@@ -0,0 +1,112 @@
+// Package storage defines the interfaces a storage engine implements, and a registry through which custom
+// engines are compiled into Permify and selected with the database.engine setting.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DataReader - Interface for reading Data from the storage.
+type DataReader interface {
+	// QueryRelationships reads relation tuples from the storage based on the given filter.
+	// It returns an iterator to iterate over the tuples and any error encountered.
+	QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (iterator *database.TupleIterator, err error)
+
+	// ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
+	// It returns a collection of tuples, a continuous token indicating the position in the data set, and any error encountered.
+	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
+
+	// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
+	// It returns the retrieved attribute and any error encountered.
+	QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error)
+
+	// QueryAttributes reads multiple attributes from the storage based on the given filter.
+	// It returns an iterator to iterate over the attributes and any error encountered.
+	QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (iterator *database.AttributeIterator, err error)
+
+	// ReadAttributes reads multiple attributes from the storage based on the given filter and pagination.
+	// It returns a collection of attributes, a continuous token indicating the position in the data set, and any error encountered.
+	ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (collection *database.AttributeCollection, ct database.EncodedContinuousToken, err error)
+
+	// QueryUniqueEntities reads unique entities from the storage based on the given filter and pagination.
+	// It returns a slice of entity IDs, a continuous token indicating the position in the data set, and any error encountered.
+	QueryUniqueEntities(ctx context.Context, tenantID, name, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
+
+	// QueryUniqueSubjectReferences reads unique subject references from the storage based on the given filter and pagination.
+	// It returns a slice of subject reference IDs, a continuous token indicating the position in the data set, and any error encountered.
+	QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
+
+	// ReadRelationshipHistory reads the creations and deletions of relation tuples matching the given filter, oldest first.
+	// Zero start or end times leave the corresponding side of the time range open.
+	// It returns the changes, a continuous token indicating the position in the data set, and any error encountered.
+	ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, start, end time.Time, pagination database.Pagination) (changes []*base.TupleChange, ct database.EncodedContinuousToken, err error)
+
+	// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant.
+	// It returns the snapshot token representing the version of the snapshot and any error encountered.
+	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
+
+	// SnapshotAt reads the version of the snapshot that was current at the given point in time for a specific tenant.
+	// It returns the snapshot token representing that version and any error encountered.
+	SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error)
+}
+
+// DataWriter - Writes relation tuples to the storage.
+type DataWriter interface {
+	// Write writes relation tuples to the storage.
+	Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error)
+	// Delete deletes relation tuples from the storage.
+	Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error)
+	// RunTransaction applies the deletions and then the writes of the transaction atomically, under a single snapshot.
+	RunTransaction(ctx context.Context, tenantID string, transaction *database.Transaction) (token token.EncodedSnapToken, err error)
+	// MigrateSchema writes the definitions of a schema version along with the transaction migrating the data to it
+	// atomically, under a single snapshot. Transactions expecting a schema version fail with
+	// ERROR_CODE_SCHEMA_VERSION_CONFLICT, writing nothing, when the latest version of the tenant is another one.
+	MigrateSchema(ctx context.Context, tenantID string, definitions []SchemaDefinition, transaction *database.Transaction) (token token.EncodedSnapToken, err error)
+}
+
+// SchemaReader - Reads schema definitions from the storage.
+type SchemaReader interface {
+	// ReadSchema reads entity config from the storage.
+	ReadSchema(ctx context.Context, tenantID, version string) (schema *base.SchemaDefinition, err error)
+	// ReadEntityDefinition reads entity config from the storage.
+	ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error)
+	// ReadRuleDefinition reads rule config from the storage.
+	ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (definition *base.RuleDefinition, v string, err error)
+	// HeadVersion reads the latest version of the schema from the storage.
+	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
+	// ReadSchemaDefinitions reads the serialized definitions of a schema version from the storage.
+	ReadSchemaDefinitions(ctx context.Context, tenantID, version string) (definitions []SchemaDefinition, err error)
+	// ReadSchemaVersions reads the versions of the schema of the tenant from the storage, oldest first.
+	ReadSchemaVersions(ctx context.Context, tenantID string) (versions []string, err error)
+}
+
+// SchemaWriter - Writes schema definitions to the storage.
+type SchemaWriter interface {
+	// WriteSchema writes schema to the storage.
+	WriteSchema(ctx context.Context, definitions []SchemaDefinition) (err error)
+}
+
+// Watcher - Watches relation tuple changes from the storage.
+type Watcher interface {
+	// Watch watches relation tuple changes from the storage.
+	Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error)
+}
+
+// TenantReader - Reads tenants from the storage.
+type TenantReader interface {
+	// ListTenants reads tenants from the storage.
+	ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
+}
+
+// TenantWriter - Writes tenants to the storage.
+type TenantWriter interface {
+	// CreateTenant writes tenant to the storage.
+	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
+	// DeleteTenant deletes tenant from the storage.
+	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
+}
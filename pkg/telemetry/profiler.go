@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/grafana/pyroscope-go"
+
+	"github.com/Permify/permify/internal"
+)
+
+// NewProfiler - Starts pushing the continuous profiles of the process to the pyroscope server, tagged with the node
+// and with the pprof labels of the goroutines, and returns the function stopping it
+func NewProfiler(endpoint, application, user, password string) (func() error, error) {
+	hostName, err := os.Hostname()
+	if err != nil {
+		hostName = "unknown"
+	}
+
+	profiler, err := pyroscope.Start(pyroscope.Config{
+		ApplicationName:   application,
+		ServerAddress:     endpoint,
+		BasicAuthUser:     user,
+		BasicAuthPassword: password,
+		Tags: map[string]string{
+			"id":        internal.Identifier,
+			"version":   internal.Version,
+			"host_name": hostName,
+			"os":        runtime.GOOS,
+			"arch":      runtime.GOARCH,
+		},
+		ProfileTypes: []pyroscope.ProfileType{
+			pyroscope.ProfileCPU,
+			pyroscope.ProfileAllocObjects,
+			pyroscope.ProfileAllocSpace,
+			pyroscope.ProfileInuseObjects,
+			pyroscope.ProfileInuseSpace,
+			pyroscope.ProfileGoroutines,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profiler.Stop, nil
+}
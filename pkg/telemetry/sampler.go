@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// RatioSampler - Sampler of a share of the traces, by their trace ID, whose ratio can be changed at runtime
+type RatioSampler struct {
+	sampler atomic.Pointer[ratioSampler]
+}
+
+// ratioSampler - Sampler of a ratio, replaced as a whole when the ratio changes
+type ratioSampler struct {
+	ratio   float64
+	sampler trace.Sampler
+}
+
+// NewRatioSampler - Creates a new sampler of the ratio of the traces, between 0 and 1
+func NewRatioSampler(ratio float64) (*RatioSampler, error) {
+	s := &RatioSampler{}
+	if err := s.SetRatio(ratio); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Ratio - Returns the ratio of the traces sampled
+func (s *RatioSampler) Ratio() float64 {
+	return s.sampler.Load().ratio
+}
+
+// SetRatio - Changes the ratio of the traces sampled, between 0 and 1
+func (s *RatioSampler) SetRatio(ratio float64) error {
+	if ratio < 0 || ratio > 1 {
+		return fmt.Errorf("sampling ratio must be between 0 and 1, got %v", ratio)
+	}
+	s.sampler.Store(&ratioSampler{ratio: ratio, sampler: trace.TraceIDRatioBased(ratio)})
+	return nil
+}
+
+// ShouldSample - Samples the span by the ratio of its trace ID
+func (s *RatioSampler) ShouldSample(parameters trace.SamplingParameters) trace.SamplingResult {
+	return s.sampler.Load().sampler.ShouldSample(parameters)
+}
+
+// Description - Returns the description of the sampler
+func (s *RatioSampler) Description() string {
+	return fmt.Sprintf("RatioSampler{%v}", s.Ratio())
+}
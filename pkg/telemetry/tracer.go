@@ -14,8 +14,9 @@ import (
 	"github.com/Permify/permify/internal"
 )
 
-// NewTracer - Creates new tracer
-func NewTracer(exporter trace.SpanExporter) func(context.Context) error {
+// NewTracer - Creates new tracer, sampling the root spans with the sampler. The spans of the children follow the
+// decision of their parent.
+func NewTracer(exporter trace.SpanExporter, sampler trace.Sampler) func(context.Context) error {
 	hostName, err := os.Hostname()
 	if err != nil {
 		return func(context.Context) error { return nil }
@@ -23,6 +24,7 @@ func NewTracer(exporter trace.SpanExporter) func(context.Context) error {
 
 	tp := trace.NewTracerProvider(
 		trace.WithSpanProcessor(trace.NewBatchSpanProcessor(exporter)),
+		trace.WithSampler(trace.ParentBased(sampler)),
 		trace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String("permify"),
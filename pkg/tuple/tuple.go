@@ -18,6 +18,7 @@ const (
 
 const (
 	ELLIPSIS = "..." // ellipsis string
+	WILDCARD = "*"   // subject id standing for every subject of its type, e.g. user:*
 )
 
 const (
@@ -42,6 +43,21 @@ func AreSubjectsEqual(s1, s2 *base.Subject) bool {
 	return NormalizeRelation(s1.GetRelation()) == NormalizeRelation(s2.GetRelation()) && s1.GetId() == s2.GetId() && s1.GetType() == s2.GetType()
 }
 
+// IsWildcardSubject checks if the subject stands for every subject of its type, e.g. user:*
+func IsWildcardSubject(subject *base.Subject) bool {
+	return subject.GetId() == WILDCARD && IsDirectSubject(subject)
+}
+
+// IsSubjectMatch checks if the subject of a tuple grants its relation to the subject of a request, either by being
+// equal to it or by being the wildcard of its type. A wildcard subject in a request only matches wildcard subjects
+// of tuples, so it stands for the public grants of its type.
+func IsSubjectMatch(tupleSubject, subject *base.Subject) bool {
+	if AreSubjectsEqual(tupleSubject, subject) {
+		return true
+	}
+	return IsWildcardSubject(tupleSubject) && IsDirectSubject(subject) && tupleSubject.GetType() == subject.GetType()
+}
+
 // AreQueryAndSubjectEqual checks if a query and a subject are equal
 func AreQueryAndSubjectEqual(en *base.Entity, permission string, s2 *base.Subject) bool {
 	return NormalizeRelation(permission) == NormalizeRelation(s2.GetRelation()) && en.GetId() == s2.GetId() && en.GetType() == s2.GetType()
@@ -660,6 +660,49 @@ var _ = Describe("tuple", func() {
 			}
 		})
 
+		It("IsSubjectMatch", func() {
+			tests := []struct {
+				tupleSubject *base.Subject
+				subject      *base.Subject
+				result       bool
+			}{
+				{
+					tupleSubject: &base.Subject{Type: "user", Id: "1"},
+					subject:      &base.Subject{Type: "user", Id: "1"},
+					result:       true,
+				},
+				{
+					tupleSubject: &base.Subject{Type: "user", Id: "*"},
+					subject:      &base.Subject{Type: "user", Id: "1"},
+					result:       true,
+				},
+				{
+					tupleSubject: &base.Subject{Type: "user", Id: "*"},
+					subject:      &base.Subject{Type: "user", Id: "*"},
+					result:       true,
+				},
+				{
+					tupleSubject: &base.Subject{Type: "user", Id: "1"},
+					subject:      &base.Subject{Type: "user", Id: "*"},
+					result:       false,
+				},
+				{
+					tupleSubject: &base.Subject{Type: "user", Id: "*"},
+					subject:      &base.Subject{Type: "organization", Id: "1"},
+					result:       false,
+				},
+				{
+					tupleSubject: &base.Subject{Type: "organization", Id: "*"},
+					subject:      &base.Subject{Type: "organization", Id: "1", Relation: "member"},
+					result:       false,
+				},
+			}
+
+			for _, tt := range tests {
+				Expect(IsSubjectMatch(tt.tupleSubject, tt.subject)).Should(Equal(tt.result))
+			}
+		})
+
 		It("AreSubjectsEqual", func() {
 			tests := []struct {
 				target1 *base.Subject